@@ -2,12 +2,22 @@ package main
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
+	"flag"
+	"fmt"
 	"log"
 	"log/slog"
+	"maps"
 	"net"
+	"net/http"
 	"os"
 	"os/signal"
+	"path/filepath"
+	"slices"
+	"strings"
 	"syscall"
+	"time"
 
 	"github.com/openkcm/common-sdk/pkg/commoncfg"
 	"github.com/openkcm/common-sdk/pkg/commongrpc"
@@ -28,9 +38,13 @@ import (
 	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
 	slogctx "github.com/veqryn/slog-context"
 
+	admingrpc "github.com/openkcm/registry/api/admin/v1"
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
 	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/encryption"
 	"github.com/openkcm/registry/internal/interceptor"
 	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/openapi"
 	"github.com/openkcm/registry/internal/repository/sql"
 	"github.com/openkcm/registry/internal/service"
 	validationpkg "github.com/openkcm/registry/internal/validation"
@@ -38,52 +52,301 @@ import (
 
 var BuildInfo = "{}"
 
+// configFileName is the name of the config file read from the config paths.
+const configFileName = "config.yaml"
+
+// verifyCommand runs the integrity checks of the database instead of the server, e.g. registry verify -fix.
+const verifyCommand = "verify"
+
+// configValidateCommand validates a config file instead of starting the server,
+// e.g. registry config validate -f config.yaml.
+var configValidateCommand = []string{"config", "validate"}
+
+// configPaths are the directories searched for the config.yaml, in order.
+var configPaths = []string{"/etc/registry", "."}
+
 func main() {
 	ctx := context.Background()
 
+	if len(os.Args) > 2 && slices.Equal(os.Args[1:3], configValidateCommand) {
+		os.Exit(runConfigValidate(os.Args[3:]))
+	}
+
 	cfg := loadConfig()
 	err := cfg.Validate()
 	handleErr("validating config", err)
 
-	initLogger(cfg)
+	logControl := initLogger(cfg)
+
+	if len(os.Args) > 1 && os.Args[1] == verifyCommand {
+		os.Exit(runVerify(ctx, cfg, os.Args[2:]))
+	}
+
+	startup := service.NewStartup(cfg.Startup)
 
-	initOTLP(ctx, cfg)
+	initOTLP(ctx, cfg, startup)
 
 	// Status server initialization
 	// Copy the gRPC client config to avoid race condition when modifying Client.Address
 	grpcClientCfg := cfg.GRPCServer.Client
 	grpcClientCfg.Address = cfg.GRPCServer.Address
-	go startStatusServer(ctx, cfg.BaseConfig, grpcClientCfg, cfg.Database)
+	warmup := service.NewWarmup(cfg.Warmup)
+	circuitBreaker := sql.NewCircuitBreaker(cfg.Database.CircuitBreaker)
+
+	// no capability is ready before the warm-up finished, so the checks added later apply from the start
+	capabilities := service.NewCapabilityReadiness(cfg.CapabilityReadiness)
+	capabilities.Add(service.CapabilityReads,
+		service.CapabilityCheck{Name: "warmup", Check: warmup.Check},
+		service.CapabilityCheck{Name: "database-circuit", Check: circuitBreaker.Check})
+	go startStatusServer(ctx, cfg, grpcClientCfg, warmup, circuitBreaker, logControl, capabilities)
+
+	ownerIDCipher := initOwnerIDEncryption(cfg.OwnerIDEncryption)
+
+	db := initDB(ctx, cfg, startup)
+	capabilities.Add(service.CapabilityReads, service.CapabilityCheck{Name: "database", Check: sql.Ping(db)})
+	capabilities.Add(service.CapabilityWrites, service.ReadOnlyProfileCheck(cfg.ReadOnly()))
+	capabilities.Add(service.CapabilityAsyncOps, service.OrbitalTargetsCheck(cfg.Orbital.Targets))
+
+	if !cfg.ReadOnly() {
+		startOwnerIDReencryption(ctx, db, ownerIDCipher, cfg.OwnerIDEncryption)
+	}
 
-	db := initDB(ctx, cfg)
+	meterRegistry := service.NewMeterRegistry(&cfg.Application, otel.GetMeterProvider())
+	meters := service.NewMeters(meterRegistry)
 
-	meters, err := service.InitMeters(ctx, &cfg.Application, db)
+	pools, err := sql.OpenPools(db, cfg.Database)
+	handleErr("opening database pools", err)
+
+	for _, poolDB := range pools {
+		sql.EnableSlowOperationLog(poolDB, cfg.Database.SlowOperationThreshold, meters.HandleSlowOperation)
+	}
+
+	err = service.RegisterPoolMeters(ctx, meterRegistry, pools)
+	handleErr("initializing database pool meters", err)
+
+	repository := sql.NewPooledRepository(pools)
+
+	err = repository.EnableTransactionRetry(cfg.Database.TransactionRetry, meters.HandleTransactionRetry)
+	handleErr("enabling transaction retries", err)
+
+	repository.EnableTransactionMetrics(meters.HandleTransaction)
+
+	err = service.RegisterTransactionMeters(ctx, meterRegistry, repository.OpenTransactions)
+	handleErr("initializing transaction meters", err)
+
+	err = repository.EnableCircuitBreaker(circuitBreaker)
+	handleErr("enabling the database circuit breaker", err)
+
+	err = repository.EnableStatusClock(cfg.Database.StatusClock)
+	handleErr("enabling the status clock", err)
+
+	err = service.RegisterCircuitBreakerMeters(ctx, meterRegistry, func() int64 {
+		return int64(circuitBreaker.State())
+	})
+	handleErr("initializing circuit breaker meters", err)
+
+	err = service.RegisterDBMeters(ctx, meterRegistry, repository)
 	handleErr("initializing meters", err)
 
-	repository := sql.NewRepository(db)
+	if cfg.Database.Maintenance.Enabled && !cfg.ReadOnly() {
+		dbMaintenance := service.NewDBMaintenance(repository, meters, cfg.Database.Maintenance)
 
-	orbital, err := service.NewOrbital(ctx, db, cfg.Orbital)
-	handleErr("initializing Orbital", err)
+		err = dbMaintenance.RegisterMeters(ctx, meterRegistry)
+		handleErr("initializing database maintenance meters", err)
 
-	validation := initValidation(cfg.Validations)
+		dbMaintenance.Start(ctx)
+	}
+
+	systemLinks := service.NewSystemLinkMetrics(repository, cfg.SystemLinkMetrics)
+
+	err = systemLinks.RegisterMeters(ctx, meterRegistry)
+	handleErr("initializing system link meters", err)
+
+	systemLinks.Start(ctx)
+
+	changeFeed := service.NewChangeFeed(repository, cfg.ChangeFeed)
+	if cfg.ChangeFeed.Enabled && !cfg.ReadOnly() {
+		repository.EnableChangeFeed(service.ChangeFeedResources...)
+		changeFeed.Start(ctx)
+	}
+
+	if cfg.Labels.Index.Enabled {
+		repository.EnableLabelIndex(service.LabelIndexResources...)
+	}
+
+	if cfg.Labels.Index.Enabled && !cfg.ReadOnly() {
+		err = repository.RebuildLabelIndex(ctx)
+		handleErr("rebuilding the label index", err)
+	}
+
+	if cfg.ExternalIDIndex.Enabled {
+		repository.EnableExternalIDIndex(service.ExternalIDIndexResources...)
+	}
+
+	if cfg.ExternalIDIndex.Enabled && !cfg.ReadOnly() {
+		err = repository.RebuildExternalIDIndex(ctx)
+		handleErr("rebuilding the external ID index", err)
+	}
 
-	tenantSrv := service.NewTenant(repository, orbital, meters, validation)
-	systemSrv := service.NewSystem(repository, meters, validation)
-	mappingSrv := service.NewMapping(repository, meters, validation)
-	authSrv := service.NewAuth(repository, orbital, validation)
+	orbital := initOrbital(ctx, cfg, startup, db, repository)
 
-	grpcServer, err := setupGRPCServer(ctx, cfg)
+	err = orbital.Workers().RegisterMeters(ctx, meterRegistry)
+	handleErr("initializing orbital worker meters", err)
+
+	validation := initValidation(cfg.Validations, cfg.ValidationErrors)
+
+	legacy := service.NewLegacyRequests(cfg.Compatibility, meters)
+	enums := service.NewEnumValues(cfg.Compatibility)
+	labels := service.NewLabels(validation, cfg.Labels)
+
+	tenantIDs := service.NewTenantIDs(cfg.TenantID)
+
+	notifications := service.NewTenantNotifications(repository, cfg.TenantNotifications, service.NewWebhookTenantNotifier(cfg.TenantNotifications.Webhook))
+	tenantSrv := service.NewTenant(repository, orbital, meters, validation, tenantIDs, legacy, enums, labels, service.NewTenantTemplates(cfg.TenantTemplates), service.NewTenantTerminations(cfg.TenantTermination), notifications)
+	l2KeyPolicy, err := service.NewRegionL2KeyPatterns(cfg.L2KeyPolicy)
+	handleErr("creating L2 key policy", err)
+	systemSrv := service.NewSystem(repository, meters, validation, initSystemApproval(cfg.SystemApproval), legacy, enums, labels, service.NewSystemStatuses(cfg.SystemStatus), l2KeyPolicy)
+	identifiers := service.NewSystemIdentifiers(validation, cfg.BatchValidation)
+	mappingSrv := service.NewMapping(repository, meters, validation, labels, identifiers)
+	authSrv := service.NewAuth(repository, orbital, validation, cfg.AuthPropertyBlobs)
+	identityProviders := service.NewTenantIdentityProvider(repository, orbital)
+
+	tenantBlocks := service.NewTenantBlocks(repository, tenantSrv, cfg.TenantBlock)
+	anonymization := service.NewTenantAnonymization(repository, cfg.TenantAnonymization, cfg.Database.TableScan)
+
+	maintenance := service.NewMaintenanceMode(repository, cfg.Admin)
+	capabilities.Add(service.CapabilityWrites, service.CapabilityCheck{Name: "maintenance-mode", Check: maintenance.Check})
+
+	hooks, err := service.NewConfiguredHooks(cfg.Hooks)
+	handleErr("creating hooks", err)
+
+	grpcServer, err := setupGRPCServer(ctx, cfg, tenantIDs, service.NewTenantStatuses(repository), maintenance, circuitBreaker, meterRegistry, validation.SchemaVersion(), logControl, hooks)
 	handleErr("initializing gRPC server", err)
 
 	tenantgrpc.RegisterServiceServer(grpcServer, tenantSrv)
 	mappinggrpc.RegisterServiceServer(grpcServer, mappingSrv)
 	systemgrpc.RegisterServiceServer(grpcServer, systemSrv)
 	authgrpc.RegisterServiceServer(grpcServer, authSrv)
+	extensiongrpc.RegisterTenantServiceServer(grpcServer, service.NewTenantExtension(service.TenantExtensionServices{
+		Placement:         service.NewTenantPlacement(repository, targetRegions(cfg.Orbital.Targets), cfg.TenantPlacement),
+		Auths:             authSrv,
+		Tenants:           tenantSrv,
+		IdentityProviders: identityProviders,
+		Blocks:            tenantBlocks,
+		Notifications:     notifications,
+	}))
+	displays := service.NewSystemDisplays(repository, validation)
+	extensiongrpc.RegisterSystemServiceServer(grpcServer, service.NewSystemExtension(service.SystemExtensionServices{
+		Credentials:     service.NewSystemCredentials(repository),
+		Systems:         systemSrv,
+		Classifications: service.NewSystemClassifications(repository, cfg.SystemClassification),
+		Registrations:   service.NewSystemRegistrations(repository, systemSrv, cfg.SystemRegistration),
+		Lookups:         service.NewSystemLookups(systemSrv, cfg.SystemLookup),
+		Displays:        displays,
+		Relationships:   service.NewSystemRelationships(repository, cfg.SystemRelationships),
+	}))
+	extensiongrpc.RegisterMappingServiceServer(grpcServer, service.NewMappingExtension(mappingSrv))
+	extensiongrpc.RegisterChangeFeedServiceServer(grpcServer, service.NewChangeFeedExtension(changeFeed))
+	extensiongrpc.RegisterSchemaServiceServer(grpcServer, service.NewSchemaExtension(service.NewResourceDescriptors(validation, cfg.Labels.Visibility)))
+	operations := service.NewOperations(repository)
+
+	extensiongrpc.RegisterOperationServiceServer(grpcServer, service.NewOperationExtension(operations))
+	extensiongrpc.RegisterUserGroupServiceServer(grpcServer, service.NewUserGroupExtension(service.NewTenantUserGroup(repository, validation)))
+	extensiongrpc.RegisterServiceAccountServiceServer(grpcServer, service.NewServiceAccountExtension(service.NewServiceAccount(repository, validation)))
+
+	backfills := service.NewBackfills(repository, cfg.Backfill, cfg.Database.TableScan)
+	discovery := service.NewSystemDiscovery(repository, meters, validation, labels)
+	inventory := service.NewInventory(repository, cfg.InventorySnapshot)
+
+	if cfg.Admin.Enabled {
+		var configIntrospection *service.ConfigIntrospection
+		if cfg.ConfigIntrospection.Enabled {
+			configIntrospection = newConfigIntrospection(cfg)
+		}
+
+		adminSrv := service.NewAdmin(cfg.Admin, service.AdminServices{
+			Integrity:    service.NewIntegrity(repository, cfg.Database.TableScan),
+			Backfills:    backfills,
+			SystemLinks:  systemLinks,
+			Destroyer:    service.NewTenantDestroyer(repository, orbital, cfg.TenantDestroy),
+			Systems:      systemSrv,
+			SystemGroups: service.NewSystemGroup(repository, validation, labels, identifiers),
+			Inventory:    inventory,
+			Usage:        service.NewRegionUsages(repository, cfg.RegionUsage, cfg.TenantPlacement),
+			Manifests:    service.NewManifests(repository, tenantSrv, authSrv, identifiers),
+			Exports:      service.NewTenantExports(repository, cfg.TenantExport, cfg.Database.TableScan),
+			Discovery:    discovery,
+			Tenants:      tenantSrv,
+			Operations:   operations,
+			Maintenance:  maintenance,
+			Jobs:         orbital,
+			ExternalIDs:  service.NewExternalIDs(repository, cfg.ExternalIDIndex),
+			Imports:      service.NewTenantImports(repository, tenantSrv, cfg.TenantImport),
+			Displays:     displays,
+			LabelBatches: service.NewSystemLabelBatches(repository, labels, cfg.Database.TableScan),
+			LogControl:   logControl,
+			Config:       configIntrospection,
+		})
+		admingrpc.RegisterServiceServer(grpcServer, adminSrv)
+
+		// The maintenance mode can only be disabled through the admin service, so it only applies if enabled.
+		maintenance.Start(ctx)
+	}
 
-	err = orbital.Start(ctx)
-	handleErr("starting orbital", err)
+	startOpenAPIServer(ctx, cfg, grpcServer)
+
+	if cfg.ReadOnly() {
+		slogctx.Info(ctx, "serving read calls only, background jobs are not started", "profile", cfg.Profile)
+	} else {
+		startBackgroundJobs(ctx, cfg, orbital, inventory, tenantBlocks, anonymization, discovery, backfills)
+	}
+
+	warmup.Start(ctx,
+		service.PreloadHotTenantsStep(repository, slices.Sorted(maps.Keys(pools)), cfg.Warmup.HotTenants),
+		service.ValidationStep(validationModels()...),
+		service.PingOrbitalTargetsStep(cfg.Orbital.Targets),
+	)
 
 	startGRPCServer(ctx, cfg, grpcServer)
+
+	// the pools are drained once the gRPC server stopped, so running queries can finish
+	err = pools.Close()
+	if err != nil {
+		slogctx.Error(ctx, "failed to close database pools", "error", err)
+	}
+}
+
+// startBackgroundJobs starts the jobs changing the data in the background, which only run on full instances.
+func startBackgroundJobs(ctx context.Context, cfg *config.Config, orbital *service.Orbital, inventory *service.Inventory,
+	tenantBlocks *service.TenantBlocks, anonymization *service.TenantAnonymization, discovery *service.SystemDiscovery,
+	backfills *service.Backfills,
+) {
+	err := orbital.Start(ctx)
+	handleErr("starting orbital", err)
+
+	inventory.Start(ctx)
+	tenantBlocks.Start(ctx)
+	anonymization.Start(ctx)
+
+	if cfg.SystemDiscovery.Enabled {
+		receiver, err := service.NewSystemObservedReceiver(ctx, cfg.SystemDiscovery)
+		handleErr("initializing system discovery", err)
+
+		discovery.Listen(ctx, receiver)
+	}
+
+	// Backfills of existing records are registered here, e.g. for new columns.
+	backfills.Start(ctx)
+}
+
+// targetRegions returns the regions of the orbital targets, the only regions tenants can be provisioned in.
+func targetRegions(targets []config.Target) []string {
+	regions := make([]string, 0, len(targets))
+	for _, target := range targets {
+		regions = append(regions, target.Region)
+	}
+
+	return regions
 }
 
 func startGRPCServer(ctx context.Context, cfg *config.Config, grpcServer *grpc.Server) {
@@ -107,8 +370,61 @@ func startGRPCServer(ctx context.Context, cfg *config.Config, grpcServer *grpc.S
 	handleErr("listening to gRPC requests", err)
 }
 
-func setupGRPCServer(ctx context.Context, cfg *config.Config) (*grpc.Server, error) {
+// startOpenAPIServer serves the OpenAPI document of the registered gRPC services if enabled.
+func startOpenAPIServer(ctx context.Context, cfg *config.Config, grpcServer *grpc.Server) {
+	if !cfg.OpenAPI.Enabled {
+		return
+	}
+
+	doc, err := openapi.Generate(grpcServer, openapi.Info{
+		Title:   cfg.Application.Name,
+		Version: cfg.Application.BuildInfo.Version,
+	})
+	handleErr("generating OpenAPI document", err)
+
+	handler, err := openapi.NewHandler(doc, cfg.OpenAPI.SwaggerUI, openapi.CachePolicy{
+		Default: cfg.OpenAPI.CacheControl,
+		Routes:  cfg.OpenAPI.RouteCacheControl,
+	})
+	handleErr("initializing OpenAPI handler", err)
+
+	server := &http.Server{
+		Addr:              cfg.OpenAPI.Address,
+		Handler:           handler,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		slogctx.Info(ctx, "OpenAPI server is listening", "address", cfg.OpenAPI.Address)
+
+		err := server.ListenAndServe()
+		if err != nil {
+			slogctx.Error(ctx, "Failure on the OpenAPI server", "error", err)
+		}
+	}()
+}
+
+func setupGRPCServer(ctx context.Context, cfg *config.Config, tenantIDs interceptor.TenantIDNormalizer, tenantStatuses interceptor.TenantStatusLookup, maintenance interceptor.MaintenanceModeLookup, circuit interceptor.CircuitBreakerLookup, meterRegistry *service.MeterRegistry, validationSchemaVersion string, logControl *service.LogControl, hooks *service.Hooks) (*grpc.Server, error) {
 	rec := interceptor.NewRecover()
+	reqMeta := interceptor.NewRequestMetadata()
+	logSampling := interceptor.NewLogSampling(logControl)
+	caller := interceptor.NewCallerIdentity(cfg.CallerIdentity)
+	deprecatedFields := interceptor.NewDeprecatedFields(service.NewMeters(meterRegistry).HandleDeprecatedField)
+	validationSchema := interceptor.NewValidationSchema(validationSchemaVersion)
+	concurrency := interceptor.NewConcurrencyLimiter(cfg.Concurrency)
+	pool := interceptor.NewPoolClass()
+	maintenanceMode := interceptor.NewMaintenanceMode(maintenance)
+	circuitBreaker := interceptor.NewCircuitBreaker(circuit)
+	readOnly := interceptor.NewReadOnly(cfg.ReadOnly())
+	warnings := interceptor.NewWarnings()
+	operationIDs := interceptor.NewOperationIDs()
+	normalization := interceptor.NewTenantIDNormalization(tenantIDs)
+	fairness := interceptor.NewFairScheduler(cfg.Fairness)
+	hooked := interceptor.NewHooks(hooks)
+	messageValidation := interceptor.NewMessageValidation(interceptor.GeneratedValidator{})
+	policy := interceptor.NewTenantStatusPolicy(cfg.TenantStatusPolicy, tenantStatuses)
+	coalescing := interceptor.NewRequestCoalescing(cfg.RequestCoalescing)
+	labelVisibility := interceptor.NewLabelVisibility(cfg.Labels.Visibility, cfg.Admin)
 
 	meter := otel.Meter(
 		cfg.Application.Name,
@@ -121,53 +437,272 @@ func setupGRPCServer(ctx context.Context, cfg *config.Config) (*grpc.Server, err
 		return nil, err
 	}
 
-	// Create a new gRPC server
-	grpcServer := commongrpc.NewServer(ctx, &cfg.GRPCServer.GRPCServer,
+	err = concurrency.RegisterMeters(ctx, meterRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	err = fairness.RegisterMeters(ctx, meterRegistry)
+	if err != nil {
+		return nil, err
+	}
+
+	// the recovery directly follows the metrics, so panics of all other interceptors are recovered;
+	// the fair scheduling follows the normalization, so the weights apply to the normalized tenant IDs;
+	// the hooks follow the message validation, so hooks are not called with malformed messages;
+	// the label visibility follows the coalescing, as coalesced requests have the same caller;
+	// streams are neither scheduled, hooked nor coalesced: they may be served for long and have no single
+	// request and response to hook or share
+	options := []grpc.ServerOption{
 		grpc.ChainUnaryInterceptor(
 			met.UnaryInterceptor,
 			rec.UnaryInterceptor,
+			reqMeta.UnaryInterceptor,
+			logSampling.UnaryInterceptor,
+			validationSchema.UnaryInterceptor,
+			concurrency.UnaryInterceptor,
+			pool.UnaryInterceptor,
+			caller.UnaryInterceptor,
+			readOnly.UnaryInterceptor,
+			maintenanceMode.UnaryInterceptor,
+			circuitBreaker.UnaryInterceptor,
+			deprecatedFields.UnaryInterceptor,
+			warnings.UnaryInterceptor,
+			operationIDs.UnaryInterceptor,
+			normalization.UnaryInterceptor,
+			fairness.UnaryInterceptor,
+			messageValidation.UnaryInterceptor,
+			hooked.UnaryInterceptor,
+			policy.UnaryInterceptor,
+			coalescing.UnaryInterceptor,
+			labelVisibility.UnaryInterceptor,
 		),
 		grpc.ChainStreamInterceptor(
 			met.StreamInterceptor,
 			rec.StreamInterceptor,
+			reqMeta.StreamInterceptor,
+			logSampling.StreamInterceptor,
+			validationSchema.StreamInterceptor,
+			concurrency.StreamInterceptor,
+			pool.StreamInterceptor,
+			caller.StreamInterceptor,
+			readOnly.StreamInterceptor,
+			maintenanceMode.StreamInterceptor,
+			circuitBreaker.StreamInterceptor,
+			deprecatedFields.StreamInterceptor,
+			warnings.StreamInterceptor,
+			operationIDs.StreamInterceptor,
+			normalization.StreamInterceptor,
+			messageValidation.StreamInterceptor,
+			policy.StreamInterceptor,
+			labelVisibility.StreamInterceptor,
 		),
-	)
+	}
 
-	return grpcServer, nil
+	if cfg.GRPCServer.MaxConcurrentStreams > 0 {
+		options = append(options, grpc.MaxConcurrentStreams(cfg.GRPCServer.MaxConcurrentStreams))
+	}
+
+	// Create a new gRPC server
+	return commongrpc.NewServer(ctx, &cfg.GRPCServer.GRPCServer, options...), nil
 }
 
-func initDB(ctx context.Context, cfg *config.Config) *gorm.DB {
-	db, err := sql.StartDB(ctx, cfg.Database)
-	handleErr("starting database", err)
+// initDB connects to the database, which is migrated unless the instance is read-only,
+// as a read-only instance may be pointed at a read replica. Both steps are retried within the startup budget.
+func initDB(ctx context.Context, cfg *config.Config, startup *service.Startup) *gorm.DB {
+	var db *gorm.DB
+
+	err := startup.Run(ctx, service.StartupStep{
+		Name:    "database",
+		Timeout: cfg.Startup.Timeouts.Database,
+		Run: func(ctx context.Context) error {
+			var err error
+			db, err = sql.OpenDB(ctx, cfg.Database)
+			return err
+		},
+	})
+	handleErr("opening database", err)
+
+	if cfg.ReadOnly() {
+		return db
+	}
+
+	err = startup.Run(ctx, service.StartupStep{
+		Name:    "migrations",
+		Timeout: cfg.Startup.Timeouts.Migrations,
+		Run: func(ctx context.Context) error {
+			if err := sql.Migrate(db.WithContext(ctx)); err != nil {
+				return err
+			}
+
+			return sql.EnforceAuthTenantTypeUniqueness(db.WithContext(ctx), cfg.AuthUniqueness.TenantType)
+		},
+	})
+	handleErr("migrating database", err)
 
 	return db
 }
 
-func initOTLP(ctx context.Context, cfg *config.Config) {
-	err := otlp.Init(ctx, &cfg.Application, &cfg.Telemetry, &cfg.Logger, otlp.WithLogger(slog.Default()))
-	handleErr("starting OpenTelemetry", err)
+// initOrbital initializes orbital once the brokers of its targets are reachable, retried within the startup budget.
+// The brokers are pinged within the timeout of an attempt, the clients of the targets keep ctx.
+func initOrbital(ctx context.Context, cfg *config.Config, startup *service.Startup, db *gorm.DB, repository *sql.ResourceRepository) *service.Orbital {
+	var orbital *service.Orbital
+
+	ping := service.PingOrbitalTargetsStep(cfg.Orbital.Targets)
+	err := startup.Run(ctx, service.StartupStep{
+		Name:    "orbital",
+		Timeout: cfg.Startup.Timeouts.Orbital,
+		Run: func(attemptCtx context.Context) error {
+			if err := ping.Run(attemptCtx); err != nil {
+				return err
+			}
+
+			var err error
+			orbital, err = service.NewOrbital(ctx, db, repository, cfg.Orbital)
+			return err
+		},
+	})
+	handleErr("initializing Orbital", err)
+
+	return orbital
 }
 
-func initLogger(cfg *config.Config) {
-	err := logger.InitAsDefault(cfg.Logger, cfg.Application)
-	handleErr("initializing logger", err)
+// initOwnerIDEncryption registers the cipher of the owner IDs, it must run before the models are used.
+func initOwnerIDEncryption(cfg config.OwnerIDEncryption) *encryption.Deterministic {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	keys := make(map[int][]byte, len(cfg.Keys))
+	for _, key := range cfg.Keys {
+		value, err := commoncfg.LoadValueFromSourceRef(key.Key)
+		handleErr("loading owner ID encryption key", err)
+
+		keys[key.Version], err = base64.StdEncoding.DecodeString(strings.TrimSpace(string(value)))
+		handleErr("decoding owner ID encryption key", err)
+	}
+
+	cipher, err := encryption.NewDeterministic(keys, cfg.ActiveVersion)
+	handleErr("initializing owner ID encryption", err)
+
+	model.RegisterEncryption(cipher)
+
+	return cipher
 }
 
-func initValidation(fields []validationpkg.ConfigField) *validationpkg.Validation {
-	validation, err := validationpkg.New(validationpkg.Config{
-		Fields: fields,
-		Models: []validationpkg.Model{
-			&model.Tenant{},
-			&model.Auth{},
-			&model.RegionalSystem{},
-			&model.System{},
+func startOwnerIDReencryption(ctx context.Context, db *gorm.DB, cipher *encryption.Deterministic, cfg config.OwnerIDEncryption) {
+	if cipher == nil || !cfg.Reencrypt {
+		return
+	}
+
+	go func() {
+		col := sql.EncryptedColumn{Table: (&model.Tenant{}).TableName(), Column: "owner_id", Key: "id"}
+
+		_, err := sql.Reencrypt(ctx, db, cipher, col, cfg.ReencryptBatchSize)
+		if err != nil {
+			slogctx.Error(ctx, "failed to re-encrypt owner IDs", "error", err)
+		}
+	}()
+}
+
+// runVerify reports the integrity findings as JSON on stdout and returns the exit code,
+// which is non-zero if findings of error severity remain.
+func runVerify(ctx context.Context, cfg *config.Config, args []string) int {
+	flags := flag.NewFlagSet(verifyCommand, flag.ExitOnError)
+	fix := flags.Bool("fix", false, "repair the anomalies of checks with a safe fix")
+	err := flags.Parse(args)
+	handleErr("parsing verify flags", err)
+
+	initOwnerIDEncryption(cfg.OwnerIDEncryption)
+
+	db := initDB(ctx, cfg, service.NewStartup(cfg.Startup))
+
+	findings, err := service.NewIntegrity(sql.NewRepository(db), cfg.Database.TableScan).Verify(ctx, *fix)
+	handleErr("verifying data integrity", err)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	err = encoder.Encode(findings)
+	handleErr("writing integrity findings", err)
+
+	if service.Unresolved(findings) {
+		return 1
+	}
+
+	return 0
+}
+
+// initOTLP starts OpenTelemetry, retried within the startup budget, e.g. while the collector is starting.
+func initOTLP(ctx context.Context, cfg *config.Config, startup *service.Startup) {
+	err := startup.Run(ctx, service.StartupStep{
+		Name:    "telemetry",
+		Timeout: cfg.Startup.Timeouts.Telemetry,
+		Run: func(ctx context.Context) error {
+			return otlp.Init(ctx, &cfg.Application, &cfg.Telemetry, &cfg.Logger, otlp.WithLogger(slog.Default()))
 		},
 	})
+	handleErr("starting OpenTelemetry", err)
+}
+
+// initLogger initializes the default logger, whose level is controlled at runtime if the log control is enabled.
+func initLogger(cfg *config.Config) *service.LogControl {
+	logControl, err := service.NewLogControl(cfg.LogControl, cfg.Logger.Level)
+	handleErr("initializing log control", err)
+
+	if !cfg.LogControl.Enabled {
+		err = logger.InitAsDefault(cfg.Logger, cfg.Application)
+		handleErr("initializing logger", err)
+
+		return logControl
+	}
+
+	// the handler handles all levels, the log control filters them
+	loggerCfg := cfg.Logger
+	loggerCfg.Level = "trace"
+
+	handler, err := logger.InitHandler(loggerCfg, cfg.Application)
+	handleErr("initializing logger", err)
+
+	slog.SetDefault(slog.New(logControl.Handler(handler)))
+
+	return logControl
+}
+
+func initValidation(fields []validationpkg.ConfigField, errCfg config.ValidationErrors) *validationpkg.Validation {
+	validation, err := newValidation(fields, errCfg)
 	handleErr("initializing validation", err)
 
 	return validation
 }
 
+func newValidation(fields []validationpkg.ConfigField, errCfg config.ValidationErrors) (*validationpkg.Validation, error) {
+	return validationpkg.New(validationpkg.Config{
+		Fields:           fields,
+		Models:           validationModels(),
+		MaxAllowedValues: errCfg.MaxAllowedValues,
+	})
+}
+
+// validationModels returns the models whose fields are validated.
+func validationModels() []validationpkg.Model {
+	return []validationpkg.Model{
+		&model.Tenant{},
+		&model.Auth{},
+		&model.RegionalSystem{},
+		&model.System{},
+		&model.SystemGroup{},
+		&model.ServiceAccount{},
+	}
+}
+
+func initSystemApproval(cfg config.SystemApproval) *service.SystemApproval {
+	if len(cfg.Types) == 0 {
+		return nil
+	}
+
+	return service.NewSystemApproval(cfg, service.NewWebhookApprovalNotifier(cfg.Webhook))
+}
+
 func handleErr(msg string, err error) {
 	if err != nil {
 		log.Fatalf("error %s: %v", msg, err)
@@ -175,27 +710,155 @@ func handleErr(msg string, err error) {
 }
 
 func loadConfig() *config.Config {
-	cfg := &config.Config{}
-	loader := commoncfg.NewLoader(cfg,
-		commoncfg.WithPaths("/etc/registry", "."),
-		commoncfg.WithEnvOverride(""))
-	err := loader.LoadConfig()
+	cfg, err := loadConfigFrom(configPaths...)
 	handleErr("loading config", err)
 
+	if cfg.StrictConfig {
+		err = checkUnknownConfigKeys(configPaths...)
+		handleErr("checking config in strict mode", err)
+	}
+
 	err = commoncfg.UpdateConfigVersion(&cfg.BaseConfig, BuildInfo)
 	handleErr("loading build version into config", err)
 
 	return cfg
 }
 
-func startStatusServer(ctx context.Context, baseCfg commoncfg.BaseConfig, grpcClientCfg commoncfg.GRPCClient, dbCfg config.DB) {
+func loadConfigFrom(paths ...string) (*config.Config, error) {
+	cfg := &config.Config{}
+	loader := commoncfg.NewLoader(cfg,
+		commoncfg.WithPaths(paths...),
+		commoncfg.WithEnvOverride(""))
+
+	return cfg, loader.LoadConfig()
+}
+
+// checkUnknownConfigKeys checks the config.yaml found first in the paths, like the loader, for unknown keys.
+func checkUnknownConfigKeys(paths ...string) error {
+	raw, err := readRawConfigFrom(paths...)
+	if err != nil || raw == nil {
+		return err
+	}
+
+	return config.CheckUnknownKeys(raw)
+}
+
+// readRawConfigFrom reads the config.yaml found first in the paths, like the loader, nil if there is none.
+func readRawConfigFrom(paths ...string) (map[string]any, error) {
+	for _, path := range paths {
+		file := filepath.Join(path, configFileName)
+		if _, err := os.Stat(file); err != nil {
+			continue
+		}
+
+		return config.ReadRawConfig(file)
+	}
+
+	return nil, nil //nolint:nilnil // without config file the defaults and the environment apply
+}
+
+// newConfigIntrospection returns the introspection of the loaded config, which it compares
+// to the config loaded again from the config paths and the environment on each request.
+func newConfigIntrospection(cfg *config.Config) *service.ConfigIntrospection {
+	redactedKeys := cfg.ConfigIntrospection.RedactedKeys
+
+	effectiveValues := func(cfg *config.Config) ([]config.EffectiveValue, error) {
+		raw, err := readRawConfigFrom(configPaths...)
+		if err != nil {
+			return nil, err
+		}
+
+		return config.EffectiveValues(cfg, raw, os.LookupEnv, redactedKeys), nil
+	}
+
+	loaded, err := effectiveValues(cfg)
+	handleErr("reading effective config", err)
+
+	return service.NewConfigIntrospection(loaded, func() ([]config.EffectiveValue, error) {
+		current, err := loadConfigFrom(configPaths...)
+		if err != nil {
+			return nil, err
+		}
+
+		err = commoncfg.UpdateConfigVersion(&current.BaseConfig, BuildInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		return effectiveValues(current)
+	})
+}
+
+// runConfigValidate loads the config file like the server and validates it without starting the server:
+// the configuration itself, its unknown keys, the validations, the source references and the mTLS files.
+// It reports the problems on stderr and returns the exit code, which is non-zero if there are problems.
+func runConfigValidate(args []string) int {
+	flags := flag.NewFlagSet(strings.Join(configValidateCommand, " "), flag.ExitOnError)
+	file := flags.String("f", configFileName, "path of the config file")
+	err := flags.Parse(args)
+	handleErr("parsing config validate flags", err)
+
+	// the loader reads the config.yaml of a directory, so the file is loaded from a copy named like that
+	dir, err := os.MkdirTemp("", "registry-config")
+	handleErr("creating config directory", err)
+	defer os.RemoveAll(dir)
+
+	data, err := os.ReadFile(*file)
+	handleErr("reading config file", err)
+
+	err = os.WriteFile(filepath.Join(dir, configFileName), data, 0o600)
+	handleErr("copying config file", err)
+
+	problems := validateConfig(dir)
+	for _, problem := range problems {
+		fmt.Fprintf(os.Stderr, "%s: %v\n", *file, problem)
+	}
+
+	if len(problems) > 0 {
+		return 1
+	}
+
+	fmt.Fprintf(os.Stdout, "%s: config is valid\n", *file)
+
+	return 0
+}
+
+// validateConfig returns the problems of the config.yaml in the directory.
+func validateConfig(dir string) []error {
+	cfg, err := loadConfigFrom(dir)
+	if err != nil {
+		return []error{fmt.Errorf("loading config: %w", err)}
+	}
+
+	var problems []error
+
+	if err := checkUnknownConfigKeys(dir); err != nil {
+		problems = append(problems, err)
+	}
+
+	if err := cfg.Validate(); err != nil {
+		problems = append(problems, err)
+	}
+
+	if _, err := newValidation(cfg.Validations, cfg.ValidationErrors); err != nil {
+		problems = append(problems, fmt.Errorf("invalid validations: %w", err))
+	}
+
+	if err := cfg.CheckReferences(); err != nil {
+		problems = append(problems, err)
+	}
+
+	return problems
+}
+
+func startStatusServer(ctx context.Context, cfg *config.Config, grpcClientCfg commoncfg.GRPCClient, warmup *service.Warmup, circuitBreaker *sql.CircuitBreaker, logControl *service.LogControl, capabilities *service.CapabilityReadiness) {
 	liveness := status.WithLiveness(
 		health.NewHandler(
 			health.NewChecker(health.WithDisabledAutostart()),
 		),
 	)
 
-	healthOptions := make([]health.Option, 0, 4)
+	healthOptions := make([]health.Option, 0, 6)
 	healthOptions = append(healthOptions,
 		health.WithDisabledAutostart(),
 		health.WithStatusListener(func(ctx context.Context, state health.State) {
@@ -209,20 +872,41 @@ func startStatusServer(ctx context.Context, baseCfg commoncfg.BaseConfig, grpcCl
 	)
 
 	// database health check
-	dsn, err := sql.GetDataSourceName(dbCfg)
+	dsn, err := sql.GetDataSourceName(cfg.Database)
 	handleErr("getting data source name", err)
 
 	healthOptions = append(healthOptions,
 		health.WithDatabaseChecker("pgx", dsn))
 
+	// the instance is only ready once it is warmed up
+	healthOptions = append(healthOptions,
+		health.WithCheck(health.Check{Name: "warmup", Check: warmup.Check}))
+
+	// the instance is not ready while the database circuit is open, the check probes a half-open circuit
+	healthOptions = append(healthOptions,
+		health.WithCheck(health.Check{Name: "database-circuit", Check: circuitBreaker.Check}))
+
 	readiness := status.WithReadiness(
 		health.NewHandler(
 			health.NewChecker(healthOptions...),
 		),
 	)
 
+	info := service.InstanceInfo{Version: cfg.Application.BuildInfo.Version, Profile: cfg.Profile}
+	probes := []status.ProbeOption{liveness, readiness, status.WithCustom("info", info.ServeHTTP)}
+	// the status server is not authenticated, so it only serves the state of the log control, changes are admin calls
+	if cfg.LogControl.Enabled {
+		probes = append(probes, status.WithCustom("log-level", logControl.ServeHTTP))
+	}
+	if cfg.CapabilityReadiness.Enabled {
+		for _, capability := range service.Capabilities {
+			probes = append(probes, status.WithCustom("ready-"+string(capability), capabilities.Handler(capability).ServeHTTP))
+		}
+		probes = append(probes, status.WithCustom("capabilities", capabilities.ServeHTTP))
+	}
+
 	// Start the status server
-	err = status.Start(ctx, &baseCfg, liveness, readiness)
+	err = status.Start(ctx, &cfg.BaseConfig, probes...)
 	if err != nil {
 		slogctx.Error(ctx, "Failure on the status server", "error", err)
 
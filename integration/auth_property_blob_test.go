@@ -0,0 +1,146 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+)
+
+func TestAuthPropertyBlobs(t *testing.T) {
+	// given
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	subj := authgrpc.NewServiceClient(conn)
+	extSubj := extensiongrpc.NewTenantServiceClient(conn)
+
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	tenant := validTenant()
+	tenant.Region = "non-existing-region"
+	require.NoError(t, repo.Create(ctx, tenant))
+
+	// larger than the default threshold and chunk size
+	jwks := strings.Repeat("a", 150000) + "end"
+	auth := validAuth()
+	auth.ExternalID = validRandID()
+
+	t.Cleanup(func() {
+		_, _ = repo.Delete(ctx, &model.Auth{ExternalID: auth.ExternalID})
+		_ = deleteOrbitalResources(ctx, db, auth.ExternalID)
+		_ = deleteTenantFromDB(ctx, db, tenant)
+	})
+
+	_, err = subj.ApplyAuth(ctx, &authgrpc.ApplyAuthRequest{
+		ExternalId: auth.ExternalID,
+		TenantId:   tenant.ID,
+		Type:       auth.Type,
+		Properties: map[string]string{
+			"issuer": "https://issuer.example.org",
+			"jwks":   jwks,
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("should store the large property value as blob", func(t *testing.T) {
+		// when
+		stored := &model.Auth{ExternalID: auth.ExternalID}
+		found, err := repo.Find(ctx, stored)
+
+		// then
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, map[string]string{"issuer": "https://issuer.example.org"}, stored.Properties)
+		assert.Equal(t, []string{"jwks"}, stored.BlobProperties)
+
+		blob := &model.AuthPropertyBlob{AuthID: auth.ExternalID, Key: "jwks"}
+		found, err = repo.Find(ctx, blob)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, len(jwks), blob.Size)
+	})
+
+	t.Run("should return the blob with the auth", func(t *testing.T) {
+		// when
+		resp, err := subj.GetAuth(ctx, &authgrpc.GetAuthRequest{ExternalId: auth.ExternalID})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, jwks, resp.GetAuth().GetProperties()["jwks"])
+		assert.Equal(t, "https://issuer.example.org", resp.GetAuth().GetProperties()["issuer"])
+	})
+
+	t.Run("should stream the property value in chunks", func(t *testing.T) {
+		for key, value := range map[string]string{"jwks": jwks, "issuer": "https://issuer.example.org"} {
+			// when
+			stream, err := extSubj.GetAuthProperty(ctx, &extensiongrpc.GetAuthPropertyRequest{ExternalId: auth.ExternalID, Key: key})
+			require.NoError(t, err)
+
+			var received strings.Builder
+			chunks := 0
+			var last *extensiongrpc.GetAuthPropertyResponse
+			for {
+				chunk, err := stream.Recv()
+				if errors.Is(err, io.EOF) {
+					break
+				}
+				require.NoError(t, err)
+				assert.Equal(t, int64(received.Len()), chunk.GetOffset())
+				received.Write(chunk.GetData())
+				chunks++
+				last = chunk
+			}
+
+			// then
+			digest := sha256.Sum256([]byte(value))
+			assert.Equal(t, value, received.String())
+			require.NotNil(t, last)
+			assert.Equal(t, int64(len(value)), last.GetSize())
+			assert.Equal(t, hex.EncodeToString(digest[:]), last.GetDigest())
+			if key == "jwks" {
+				assert.Equal(t, 3, chunks)
+			}
+		}
+	})
+
+	t.Run("should return an error if the property does not exist", func(t *testing.T) {
+		// when
+		stream, err := extSubj.GetAuthProperty(ctx, &extensiongrpc.GetAuthPropertyRequest{ExternalId: auth.ExternalID, Key: "missing"})
+		require.NoError(t, err)
+		_, err = stream.Recv()
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("should delete the blobs with the auth", func(t *testing.T) {
+		// when
+		_, err := repo.Delete(ctx, &model.Auth{ExternalID: auth.ExternalID})
+
+		// then
+		require.NoError(t, err)
+		found, err := repo.Find(ctx, &model.AuthPropertyBlob{AuthID: auth.ExternalID, Key: "jwks"})
+		require.NoError(t, err)
+		assert.False(t, found)
+	})
+}
@@ -102,6 +102,7 @@ func TestAuth(t *testing.T) {
 			}()
 
 			auth := validAuth()
+			auth.TenantID = tenant.ID
 			err = repo.Create(ctx, auth)
 			assert.NoError(t, err)
 			defer func() {
@@ -221,9 +222,18 @@ func TestAuth(t *testing.T) {
 
 			t.Run("auth is not in APPLIED status", func(t *testing.T) {
 				// given
+				tenant := validTenant()
+				err := repo.Create(ctx, tenant)
+				assert.NoError(t, err)
+				defer func() {
+					_, err := repo.Delete(ctx, tenant)
+					assert.NoError(t, err)
+				}()
+
 				auth := validAuth()
+				auth.TenantID = tenant.ID
 				auth.Status = authgrpc.AuthStatus_AUTH_STATUS_APPLYING_ERROR.String()
-				err := repo.Create(ctx, auth)
+				err = repo.Create(ctx, auth)
 				assert.NoError(t, err)
 				defer func() {
 					_, err := repo.Delete(ctx, auth)
@@ -245,7 +255,7 @@ func TestAuth(t *testing.T) {
 				// given
 				auth := validAuth()
 				auth.TenantID = "non-existing-tenant"
-				err := repo.Create(ctx, auth)
+				err := createViolatingInDB(ctx, db, auth)
 				assert.NoError(t, err)
 				defer func() {
 					_, err := repo.Delete(ctx, auth)
@@ -0,0 +1,98 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/repository/sql"
+)
+
+func TestAuthTenantTypeUniqueness(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	tenant := validTenant()
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+	t.Cleanup(func() {
+		_ = deleteTenantFromDB(ctx, db, tenant)
+	})
+
+	require.NoError(t, sql.EnforceAuthTenantTypeUniqueness(db, true))
+	t.Cleanup(func() {
+		_ = sql.EnforceAuthTenantTypeUniqueness(db, false)
+	})
+
+	applied := validAuth()
+	applied.TenantID = tenant.ID
+	require.NoError(t, repo.Create(ctx, applied))
+
+	t.Run("should reject a second auth of the tenant and type", func(t *testing.T) {
+		// given
+		auth := validAuth()
+		auth.TenantID = tenant.ID
+		auth.Status = authgrpc.AuthStatus_AUTH_STATUS_APPLYING.String()
+
+		// when
+		err := repo.Create(ctx, auth)
+
+		// then
+		var uniqueErr *repository.UniqueConstraintError
+		require.True(t, errors.As(err, &uniqueErr))
+		assert.Equal(t, model.AuthTenantTypeIndex, uniqueErr.Constraint)
+	})
+
+	t.Run("should accept a removed auth of the tenant and type", func(t *testing.T) {
+		// given
+		auth := validAuth()
+		auth.TenantID = tenant.ID
+		auth.Status = authgrpc.AuthStatus_AUTH_STATUS_REMOVED.String()
+
+		// when
+		err := repo.Create(ctx, auth)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should accept an auth of another type", func(t *testing.T) {
+		// given
+		auth := validAuth()
+		auth.TenantID = tenant.ID
+		auth.Type = "saml"
+
+		// when
+		err := repo.Create(ctx, auth)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not enforce the uniqueness if existing auths violate it", func(t *testing.T) {
+		// given
+		require.NoError(t, sql.EnforceAuthTenantTypeUniqueness(db, false))
+		auth := validAuth()
+		auth.TenantID = tenant.ID
+		require.NoError(t, repo.Create(ctx, auth))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, auth)
+		})
+
+		// when
+		err := sql.EnforceAuthTenantTypeUniqueness(db, true)
+
+		// then
+		assert.ErrorIs(t, err, sql.ErrAuthUniquenessViolated)
+	})
+}
@@ -0,0 +1,88 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestBackfill(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+
+	records := []string{"a", "b", "c", "d", "e"}
+	name := "test-" + validRandID()
+	t.Cleanup(func() {
+		db.Delete(&model.Backfill{Name: name})
+	})
+
+	var applied []string
+	job := service.BackfillJob{
+		Name: name,
+		Next: func(_ context.Context, _ repository.Repository, after string, limit int) ([]string, error) {
+			var keys []string
+			for _, r := range records {
+				if r > after && len(keys) < limit {
+					keys = append(keys, r)
+				}
+			}
+
+			return keys, nil
+		},
+		Apply: func(_ context.Context, _ repository.Repository, keys []string) error {
+			applied = append(applied, keys...)
+			return nil
+		},
+	}
+
+	subj := service.NewBackfills(sql.NewRepository(db), config.Backfill{Enabled: true, BatchSize: 2, BatchInterval: time.Millisecond, BatchTimeout: 5 * time.Second}, config.TableScan{})
+
+	// when
+	var batches int
+	for {
+		completed, err := subj.RunBatch(ctx, job)
+		require.NoError(t, err)
+		batches++
+
+		if completed {
+			break
+		}
+	}
+
+	// then
+	assert.Equal(t, 3, batches)
+	assert.Equal(t, records, applied)
+
+	backfills, err := subj.ListBackfills(ctx)
+	require.NoError(t, err)
+
+	i := slices.IndexFunc(backfills, func(b model.Backfill) bool { return b.Name == name })
+	require.GreaterOrEqual(t, i, 0)
+	assert.Equal(t, int64(len(records)), backfills[i].Processed)
+	assert.Equal(t, "e", backfills[i].Cursor)
+	assert.True(t, backfills[i].IsCompleted())
+
+	t.Run("should not process records again once completed", func(t *testing.T) {
+		// when
+		completed, err := subj.RunBatch(ctx, job)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, completed)
+		assert.Equal(t, records, applied)
+	})
+}
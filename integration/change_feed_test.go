@@ -0,0 +1,103 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestChangeFeed(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	require.NoError(t, db.AutoMigrate(&model.Change{}))
+
+	repo := sql.NewRepository(db)
+	repo.EnableChangeFeed(service.ChangeFeedResources...)
+	subj := service.NewChangeFeed(repo, config.ChangeFeed{Enabled: true, Retention: time.Hour, PruneInterval: time.Hour})
+
+	since, err := subj.ListChanges(ctx, 0, nil, 1000)
+	require.NoError(t, err)
+	var sinceSeq int64
+	if len(since) > 0 {
+		sinceSeq = since[len(since)-1].Seq
+	}
+
+	tenant := validTenant()
+
+	// when
+	require.NoError(t, repo.Create(ctx, tenant))
+	tenant.Name = "renamed"
+	_, err = repo.Patch(ctx, tenant)
+	require.NoError(t, err)
+	_, err = repo.Delete(ctx, tenant)
+	require.NoError(t, err)
+
+	// then
+	t.Run("should list the changes in commit order", func(t *testing.T) {
+		changes, err := subj.ListChanges(ctx, sinceSeq, []string{tenant.TableName()}, 0)
+		require.NoError(t, err)
+
+		var ops []string
+		for _, change := range changes {
+			if change.ResourceKey["id"] == tenant.ID {
+				ops = append(ops, change.Operation)
+			}
+		}
+		assert.Equal(t, []string{model.ChangeOperationCreate, model.ChangeOperationUpdate, model.ChangeOperationDelete}, ops)
+
+		for i := 1; i < len(changes); i++ {
+			assert.Greater(t, changes[i].Seq, changes[i-1].Seq)
+		}
+	})
+
+	t.Run("should record the changes of PatchAll", func(t *testing.T) {
+		// given
+		patched := validTenant()
+		require.NoError(t, repo.Create(ctx, patched))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, patched)
+		})
+
+		// when
+		var result []model.Tenant
+		_, err := repo.PatchAll(ctx, &model.Tenant{Name: "patched"}, &result, *repository.NewQuery(&model.Tenant{}).
+			Where(repository.NewCompositeKey().Where(repository.IDField, patched.ID)))
+		require.NoError(t, err)
+
+		// then
+		changes, err := subj.ListChanges(ctx, sinceSeq, []string{patched.TableName()}, 1000)
+		require.NoError(t, err)
+
+		var ops []string
+		for _, change := range changes {
+			if change.ResourceKey["id"] == patched.ID {
+				ops = append(ops, change.Operation)
+			}
+		}
+		assert.Equal(t, []string{model.ChangeOperationCreate, model.ChangeOperationUpdate}, ops)
+	})
+
+	t.Run("should reject resource types which are not recorded", func(t *testing.T) {
+		_, err := subj.ListChanges(ctx, sinceSeq, []string{"job_delays"}, 0)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("should reject listing if the change feed is disabled", func(t *testing.T) {
+		_, err := service.NewChangeFeed(repo, config.ChangeFeed{}).ListChanges(ctx, sinceSeq, nil, 0)
+		assert.ErrorIs(t, err, service.ErrChangeFeedDisabled)
+	})
+}
@@ -0,0 +1,125 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestSystemDiscovery(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}, &model.RegionalSystem{}},
+	})
+	require.NoError(t, err)
+
+	meters := service.NewMeters(service.NewMeterRegistry(&commoncfg.Application{Name: "registry"}, noop.NewMeterProvider()))
+
+	subj := service.NewSystemDiscovery(repo, meters, v, service.NewLabels(v, config.Labels{}))
+
+	newEvent := func() service.SystemObservedEvent {
+		return service.SystemObservedEvent{
+			ExternalID: validRandID(),
+			Type:       allowedSystemType,
+			Region:     "region-discovery",
+			L2KeyID:    "l2-key",
+		}
+	}
+
+	cleanup := func(event service.SystemObservedEvent) {
+		t.Cleanup(func() {
+			_ = subj.DismissDiscoveredSystem(ctx, event.ExternalID, event.Type, event.Region)
+			_ = deleteSystemInDB(ctx, db, event.ExternalID, event.Type)
+		})
+	}
+
+	t.Run("should register an observed system once", func(t *testing.T) {
+		// given
+		event := newEvent()
+		cleanup(event)
+
+		// when
+		first, err := subj.HandleSystemObserved(ctx, event)
+		require.NoError(t, err)
+		second, err := subj.HandleSystemObserved(ctx, event)
+		require.NoError(t, err)
+
+		// then
+		assert.True(t, first)
+		assert.False(t, second)
+
+		discovered, err := subj.ListDiscoveredSystems(ctx, event.Region)
+		require.NoError(t, err)
+		assert.True(t, containsDiscovered(discovered, event.ExternalID))
+	})
+
+	t.Run("should confirm a discovered system", func(t *testing.T) {
+		// given
+		event := newEvent()
+		_, err := subj.HandleSystemObserved(ctx, event)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			system, err := getSystemFromDB(ctx, db, event.ExternalID, event.Type)
+			if err == nil && system != nil {
+				_, _ = repo.Delete(ctx, &model.RegionalSystem{SystemID: system.ID, Region: event.Region})
+				_, _ = repo.Delete(ctx, system)
+			}
+		})
+
+		// when
+		err = subj.ConfirmDiscoveredSystem(ctx, event.ExternalID, event.Type, event.Region)
+
+		// then
+		require.NoError(t, err)
+
+		discovered, err := subj.ListDiscoveredSystems(ctx, event.Region)
+		require.NoError(t, err)
+		assert.False(t, containsDiscovered(discovered, event.ExternalID))
+
+		err = subj.ConfirmDiscoveredSystem(ctx, event.ExternalID, event.Type, event.Region)
+		assert.ErrorIs(t, err, service.ErrSystemNotDiscovered)
+	})
+
+	t.Run("should dismiss a discovered system", func(t *testing.T) {
+		// given
+		event := newEvent()
+		_, err := subj.HandleSystemObserved(ctx, event)
+		require.NoError(t, err)
+
+		// when
+		err = subj.DismissDiscoveredSystem(ctx, event.ExternalID, event.Type, event.Region)
+
+		// then
+		require.NoError(t, err)
+
+		system, err := getSystemFromDB(ctx, db, event.ExternalID, event.Type)
+		require.NoError(t, err)
+		assert.Nil(t, system)
+	})
+}
+
+func containsDiscovered(systems []model.RegionalSystem, externalID string) bool {
+	for _, system := range systems {
+		if system.System != nil && system.System.ExternalID == externalID {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,84 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestExternalIDIndex(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+	repo.EnableExternalIDIndex(service.ExternalIDIndexResources...)
+	subj := service.NewExternalIDs(repo, config.ExternalIDIndex{Enabled: true})
+
+	t.Run("should resolve an external ID shared by a tenant and an auth", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		require.NoError(t, repo.Create(ctx, tenant))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, &model.Tenant{ID: tenant.ID})
+		})
+
+		auth := validAuth()
+		auth.ExternalID = tenant.ID
+		auth.TenantID = tenant.ID
+		require.NoError(t, repo.Create(ctx, auth))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, &model.Auth{ExternalID: auth.ExternalID})
+		})
+
+		// when
+		resources, err := subj.ResolveExternalID(ctx, tenant.ID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []service.ResolvedResource{
+			{Kind: service.ResourceKindAuth, ID: auth.ExternalID},
+			{Kind: service.ResourceKindTenant, ID: tenant.ID},
+		}, resources)
+	})
+
+	t.Run("should not resolve the external ID of a deleted resource", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		require.NoError(t, repo.Create(ctx, tenant))
+		_, err := repo.Delete(ctx, &model.Tenant{ID: tenant.ID})
+		require.NoError(t, err)
+
+		// when
+		resources, err := subj.ResolveExternalID(ctx, tenant.ID)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrExternalIDNotFound)
+		assert.Empty(t, resources)
+	})
+
+	t.Run("should index existing resources on rebuild", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+		t.Cleanup(func() {
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+
+		// when
+		require.NoError(t, repo.RebuildExternalIDIndex(ctx))
+		resources, err := subj.ResolveExternalID(ctx, tenant.ID)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []service.ResolvedResource{{Kind: service.ResourceKindTenant, ID: tenant.ID}}, resources)
+	})
+}
@@ -0,0 +1,119 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+)
+
+func TestForeignKeys(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	t.Run("should restrict deleting a system with regional systems", func(t *testing.T) {
+		// given
+		system := model.NewSystem(validRandID(), allowedSystemType)
+		require.NoError(t, createSystemInDB(ctx, db, system))
+		regionalSystem := &model.RegionalSystem{
+			SystemID: system.ID,
+			Region:   allowedSystemRegion,
+			Status:   typespb.Status_STATUS_AVAILABLE.String(),
+		}
+		require.NoError(t, repo.Create(ctx, regionalSystem))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, regionalSystem)
+			_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+		})
+
+		// when
+		_, err := repo.Delete(ctx, &model.System{ID: system.ID})
+
+		// then
+		assert.Error(t, err)
+		found, err := repo.Find(ctx, &model.System{ID: system.ID})
+		require.NoError(t, err)
+		assert.True(t, found)
+	})
+
+	t.Run("should unlink the systems and delete the auths of a deleted tenant", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+
+		system := model.NewSystem(validRandID(), allowedSystemType)
+		system.LinkTenant(tenant.ID)
+		require.NoError(t, createSystemInDB(ctx, db, system))
+		t.Cleanup(func() {
+			_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+		})
+
+		auth := validAuth()
+		auth.TenantID = tenant.ID
+		require.NoError(t, repo.Create(ctx, auth))
+
+		// when
+		_, err := repo.Delete(ctx, tenant)
+
+		// then
+		require.NoError(t, err)
+		found, err := getSystemFromDB(ctx, db, system.ExternalID, system.Type)
+		require.NoError(t, err)
+		assert.Nil(t, found.TenantID)
+
+		authFound, err := repo.Find(ctx, &model.Auth{ExternalID: auth.ExternalID})
+		require.NoError(t, err)
+		assert.False(t, authFound)
+	})
+
+	t.Run("should reject auths of missing tenants", func(t *testing.T) {
+		// when
+		err := repo.Create(ctx, validAuth())
+
+		// then
+		assert.Error(t, err)
+	})
+
+	t.Run("should report existing records violating a foreign key", func(t *testing.T) {
+		// given
+		auth := validAuth()
+		require.NoError(t, createViolatingInDB(ctx, db, auth))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, auth)
+		})
+
+		// when
+		violations, err := sql.VerifyForeignKeys(db, sql.ForeignKeys...)
+
+		// then
+		require.NoError(t, err)
+		i := slices.IndexFunc(violations, func(v sql.ForeignKeyViolation) bool { return v.Name == "fk_auths_tenant" })
+		require.GreaterOrEqual(t, i, 0)
+		assert.Positive(t, violations[i].Count)
+	})
+
+	t.Run("should keep the foreign keys when migrating again", func(t *testing.T) {
+		// when
+		err := sql.CreateForeignKeys(db, sql.ForeignKeys...)
+
+		// then
+		require.NoError(t, err)
+
+		var actions []string
+		require.NoError(t, db.Raw(`SELECT confdeltype::text FROM pg_constraint
+			WHERE conname IN ('fk_regional_systems_system', 'fk_systems_tenant', 'fk_auths_tenant')
+			ORDER BY conname`).Scan(&actions).Error)
+		assert.Equal(t, []string{"c", "r", "n"}, actions)
+	})
+}
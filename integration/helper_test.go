@@ -27,6 +27,7 @@ import (
 	"github.com/openkcm/registry/integration/operatortest"
 	"github.com/openkcm/registry/internal/config"
 	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
 	"github.com/openkcm/registry/internal/repository/sql"
 	"github.com/openkcm/registry/internal/service"
 )
@@ -225,6 +226,25 @@ func createSystemInDB(ctx context.Context, db *gorm.DB, system *model.System) er
 	return repo.Create(ctx, system)
 }
 
+// createViolatingInDB creates the resources without checking the foreign keys.
+// It can be used in tests to simulate records which violated them before the foreign keys existed.
+func createViolatingInDB(ctx context.Context, db *gorm.DB, resources ...repository.Resource) error {
+	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("SET LOCAL session_replication_role = replica").Error; err != nil {
+			return err
+		}
+
+		repo := sql.NewRepository(tx)
+		for _, resource := range resources {
+			if err := repo.Create(ctx, resource); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
+
 // getSystemFromDB retrieves a system from the database by its ID.
 func getSystemFromDB(ctx context.Context, db *gorm.DB, externalID, systemType string) (*model.System, error) {
 	repo := sql.NewRepository(db)
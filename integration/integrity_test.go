@@ -0,0 +1,105 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestIntegrity(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	// the pages are smaller than the findings, so the anomalies are counted across pages
+	subj := service.NewIntegrity(repo, config.TableScan{PageSize: 2, RowsPerSecond: 1000})
+
+	findingOf := func(findings []service.IntegrityFinding, check string) (service.IntegrityFinding, bool) {
+		i := slices.IndexFunc(findings, func(f service.IntegrityFinding) bool { return f.Check == check })
+		if i < 0 {
+			return service.IntegrityFinding{}, false
+		}
+
+		return findings[i], true
+	}
+
+	t.Run("should report auths of missing tenants without fixing them", func(t *testing.T) {
+		// given
+		auth := validAuth()
+		require.NoError(t, createViolatingInDB(ctx, db, auth))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, auth)
+		})
+
+		// when
+		findings, err := subj.Verify(ctx, true)
+
+		// then
+		require.NoError(t, err)
+		finding, ok := findingOf(findings, "auth-with-missing-tenant")
+		require.True(t, ok)
+		assert.Equal(t, service.SeverityError, finding.Severity)
+		assert.False(t, finding.Fixable)
+		assert.Zero(t, finding.Fixed)
+		assert.True(t, service.Unresolved(findings))
+	})
+
+	t.Run("should count the anomalies of all scanned pages", func(t *testing.T) {
+		// given
+		var ids []string
+		for range 3 {
+			auth := validAuth()
+			require.NoError(t, createViolatingInDB(ctx, db, auth))
+			t.Cleanup(func() {
+				_, _ = repo.Delete(ctx, auth)
+			})
+			ids = append(ids, auth.ExternalID)
+		}
+
+		// when
+		findings, err := subj.Verify(ctx, false)
+
+		// then
+		require.NoError(t, err)
+		finding, ok := findingOf(findings, "auth-with-missing-tenant")
+		require.True(t, ok)
+		assert.GreaterOrEqual(t, finding.Count, int64(3))
+		assert.Subset(t, finding.Keys, ids)
+		assert.True(t, slices.IsSorted(finding.Keys))
+	})
+
+	t.Run("should fix systems with empty tenant ID", func(t *testing.T) {
+		// given
+		system := model.NewSystem(validRandID(), allowedSystemType)
+		system.LinkTenant("")
+		require.NoError(t, createViolatingInDB(ctx, db, system))
+		t.Cleanup(func() {
+			_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+		})
+
+		// when
+		findings, err := subj.Verify(ctx, true)
+
+		// then
+		require.NoError(t, err)
+		finding, ok := findingOf(findings, "system-with-empty-tenant")
+		require.True(t, ok)
+		assert.Contains(t, finding.Keys, system.ExternalID+"/"+system.Type)
+		assert.Positive(t, finding.Fixed)
+
+		found, err := getSystemFromDB(ctx, db, system.ExternalID, system.Type)
+		require.NoError(t, err)
+		assert.Nil(t, found.TenantID)
+	})
+}
@@ -0,0 +1,154 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestLabelIndex(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+	repo.EnableLabelIndex(service.LabelIndexResources...)
+
+	listTenantIDs := func(t *testing.T, labels map[string]any) []string {
+		t.Helper()
+		var tenants []model.Tenant
+		require.NoError(t, repo.List(ctx, &tenants, *repository.NewQuery(&model.Tenant{}).
+			Where(repository.NewCompositeKey().Where(repository.LabelsField, labels))))
+
+		ids := make([]string, 0, len(tenants))
+		for _, tenant := range tenants {
+			ids = append(ids, tenant.ID)
+		}
+		return ids
+	}
+
+	indexedLabels := func(t *testing.T, resourceType, resourceID string) map[string]string {
+		t.Helper()
+		var entries []model.LabelIndexEntry
+		require.NoError(t, db.Where("resource_type = ? AND resource_id = ?", resourceType, resourceID).Find(&entries).Error)
+
+		labels := make(map[string]string, len(entries))
+		for _, entry := range entries {
+			labels[entry.Key] = entry.Value
+		}
+		return labels
+	}
+
+	t.Run("should index the labels of tenants with their mutations", func(t *testing.T) {
+		// given
+		suffix := validRandID()
+		tenant := validTenant()
+		tenant.Labels = map[string]string{"env": "prod-" + suffix, "team": "a-" + suffix}
+
+		// when
+		require.NoError(t, repo.Create(ctx, tenant))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, &model.Tenant{ID: tenant.ID})
+		})
+
+		// then
+		assert.Equal(t, tenant.Labels, indexedLabels(t, "tenants", tenant.ID))
+		assert.Equal(t, []string{tenant.ID}, listTenantIDs(t, map[string]any{"env": "prod-" + suffix, "team": "a-" + suffix}))
+		assert.Empty(t, listTenantIDs(t, map[string]any{"env": "prod-" + suffix, "team": "b-" + suffix}))
+
+		// when
+		_, err := repo.Patch(ctx, &model.Tenant{ID: tenant.ID, Labels: map[string]string{"env": "dev-" + suffix}})
+		require.NoError(t, err)
+
+		// then
+		assert.Equal(t, map[string]string{"env": "dev-" + suffix}, indexedLabels(t, "tenants", tenant.ID))
+		assert.Empty(t, listTenantIDs(t, map[string]any{"env": "prod-" + suffix}))
+		assert.Equal(t, []string{tenant.ID}, listTenantIDs(t, map[string]any{"env": []string{"dev-" + suffix, "test-" + suffix}}))
+
+		// when
+		_, err = repo.Delete(ctx, &model.Tenant{ID: tenant.ID})
+		require.NoError(t, err)
+
+		// then
+		assert.Empty(t, indexedLabels(t, "tenants", tenant.ID))
+	})
+
+	t.Run("should not index the labels of a failed transaction", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		tenant.Labels = map[string]string{"env": "prod"}
+
+		// when
+		err := repo.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
+			if err := r.Create(ctx, tenant); err != nil {
+				return err
+			}
+			return assert.AnError
+		})
+
+		// then
+		require.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, indexedLabels(t, "tenants", tenant.ID))
+	})
+
+	t.Run("should index the labels of regional systems by their composite key", func(t *testing.T) {
+		// given
+		system := model.NewSystem(validRandID(), allowedSystemType)
+		require.NoError(t, createSystemInDB(ctx, db, system))
+		regionalSystem := &model.RegionalSystem{
+			SystemID: system.ID,
+			Region:   allowedSystemRegion,
+			Status:   typespb.Status_STATUS_AVAILABLE.String(),
+			Labels:   map[string]string{"tier": "gold"},
+		}
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, regionalSystem)
+			_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+		})
+
+		// when
+		require.NoError(t, repo.Create(ctx, regionalSystem))
+
+		// then
+		assert.Equal(t, regionalSystem.Labels, indexedLabels(t, "regional_systems", allowedSystemRegion+"/"+system.ID.String()))
+
+		var found []model.RegionalSystem
+		require.NoError(t, repo.List(ctx, &found, *repository.NewQuery(&model.RegionalSystem{}).
+			Where(repository.NewCompositeKey().
+				Where(repository.SystemIDField, system.ID).
+				Where(repository.LabelsField, map[string]any{"tier": "gold"}))))
+		assert.Len(t, found, 1)
+	})
+
+	t.Run("should rebuild the index of the labels mutated while it was disabled", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		tenant.Labels = map[string]string{"env": "prod"}
+		require.NoError(t, repo.Create(ctx, tenant))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, &model.Tenant{ID: tenant.ID})
+		})
+
+		_, err := sql.NewRepository(db).Patch(ctx, &model.Tenant{ID: tenant.ID, Labels: map[string]string{"env": "dev"}})
+		require.NoError(t, err)
+		require.Equal(t, map[string]string{"env": "prod"}, indexedLabels(t, "tenants", tenant.ID))
+
+		// when
+		err = repo.RebuildLabelIndex(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"env": "dev"}, indexedLabels(t, "tenants", tenant.ID))
+	})
+}
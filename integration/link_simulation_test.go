@@ -0,0 +1,100 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	"google.golang.org/grpc/status"
+
+	mappinggrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/mapping/v1"
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestLinkSimulation(t *testing.T) {
+	// given
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	sSubj := systemgrpc.NewServiceClient(conn)
+	mSubj := mappinggrpc.NewServiceClient(conn)
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}, &model.RegionalSystem{}},
+	})
+	require.NoError(t, err)
+
+	meters := service.NewMeters(service.NewMeterRegistry(&commoncfg.Application{Name: "registry"}, noop.NewMeterProvider()))
+	subj := service.NewMapping(sql.NewRepository(db), meters, v, service.NewLabels(v, config.Labels{}), service.NewSystemIdentifiers(v, config.BatchValidation{}))
+
+	tenant := validTenant()
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+	defer func() {
+		assert.NoError(t, deleteTenantFromDB(ctx, db, tenant))
+	}()
+
+	linkedID, linkedType, linkedRegion := registerRegionalSystem(t, ctx, sSubj, tenant.ID, false, allowedSystemType, nil, nil)
+	defer cleanupSystem(t, ctx, sSubj, mSubj, linkedID, tenant.ID, linkedType, linkedRegion, false)
+
+	claimedID, claimedType, claimedRegion := registerRegionalSystem(t, ctx, sSubj, tenant.ID, true, allowedSystemType, nil, nil)
+	defer cleanupSystem(t, ctx, sSubj, mSubj, claimedID, tenant.ID, claimedType, claimedRegion, true)
+
+	linked := model.SystemIdentifier{ExternalID: linkedID, Type: linkedType}
+	claimed := model.SystemIdentifier{ExternalID: claimedID, Type: claimedType}
+	unknown := model.SystemIdentifier{ExternalID: validRandID(), Type: allowedSystemType}
+
+	t.Run("SimulateUnlink", func(t *testing.T) {
+		// when
+		outcomes, err := subj.SimulateUnlink(ctx, tenant.ID, []model.SystemIdentifier{linked, claimed, unknown})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, outcomes, 3)
+		assert.True(t, outcomes[0].Succeeds())
+		assert.Equal(t, status.Code(service.ErrSystemHasL1KeyClaim), status.Code(outcomes[1].Err))
+		assert.Equal(t, status.Code(service.ErrSystemNotFound), status.Code(outcomes[2].Err))
+
+		system, err := getSystemFromDB(ctx, db, linkedID, linkedType)
+		require.NoError(t, err)
+		assert.Equal(t, tenant.ID, *system.TenantID, "the simulation must not unlink the system")
+	})
+
+	t.Run("SimulateLink", func(t *testing.T) {
+		// when
+		outcomes, err := subj.SimulateLink(ctx, tenant.ID, []model.SystemIdentifier{linked, unknown})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, outcomes, 2)
+		assert.Equal(t, status.Code(service.ErrSystemIsLinkedToTenant), status.Code(outcomes[0].Err))
+		assert.True(t, outcomes[1].Succeeds())
+
+		system, err := getSystemFromDB(ctx, db, unknown.ExternalID, unknown.Type)
+		require.NoError(t, err)
+		assert.Nil(t, system, "the simulation must not create the system")
+	})
+
+	t.Run("should not simulate without tenant", func(t *testing.T) {
+		// when
+		_, err := subj.SimulateLink(ctx, "", []model.SystemIdentifier{linked})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrNoTenantID)
+	})
+}
@@ -0,0 +1,68 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestMaintenanceMode(t *testing.T) {
+	// given
+	ctx := repository.WithCaller(t.Context(), "spiffe://example.org/operator")
+	db, err := startDB()
+	require.NoError(t, err)
+
+	subj := service.NewMaintenanceMode(sql.NewRepository(db), config.Admin{})
+	t.Cleanup(func() {
+		_ = subj.SetMaintenanceMode(ctx, false, "")
+	})
+
+	t.Run("should enable the maintenance mode", func(t *testing.T) {
+		// when
+		err := subj.SetMaintenanceMode(ctx, true, "database migration")
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, subj.Enabled())
+
+		mode, enabled, err := subj.GetMaintenanceMode(ctx)
+		require.NoError(t, err)
+		assert.True(t, enabled)
+		assert.Equal(t, "database migration", mode.Reason)
+		assert.Equal(t, "spiffe://example.org/operator", mode.EnabledBy)
+	})
+
+	t.Run("should be seen by other replicas", func(t *testing.T) {
+		// given
+		replica := service.NewMaintenanceMode(sql.NewRepository(db), config.Admin{})
+
+		// when
+		_, enabled, err := replica.GetMaintenanceMode(ctx)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, enabled)
+		assert.True(t, replica.Enabled())
+	})
+
+	t.Run("should disable the maintenance mode", func(t *testing.T) {
+		// when
+		err := subj.SetMaintenanceMode(ctx, false, "")
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, subj.Enabled())
+
+		_, enabled, err := subj.GetMaintenanceMode(ctx)
+		require.NoError(t, err)
+		assert.False(t, enabled)
+	})
+}
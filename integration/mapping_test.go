@@ -125,6 +125,7 @@ func TestMappingService(t *testing.T) {
 				assert.Error(t, err)
 				assert.Nil(t, res)
 				assert.Equal(t, status.Code(err), status.Code(service.ErrSystemHasL1KeyClaim))
+				assert.Contains(t, status.Convert(err).Message(), "claimTenantID="+existingTenantID)
 			})
 		})
 		t.Run("should unmap system from tenant successfully", func(t *testing.T) {
@@ -138,6 +139,10 @@ func TestMappingService(t *testing.T) {
 			})
 			assert.NoError(t, err)
 			assert.NotNil(t, res)
+
+			system, err := getSystemFromDB(ctx, db, systemID, systemType)
+			require.NoError(t, err)
+			assert.Nil(t, system.TenantID)
 		})
 	})
 
@@ -0,0 +1,83 @@
+//go:build integration
+// +build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestOperations(t *testing.T) {
+	// given
+	testCtx := newTenantTestContext(t)
+	subj := service.NewOperations(testCtx.repo)
+	ctx := t.Context()
+
+	t.Run("should return the operation started by an asynchronous call", func(t *testing.T) {
+		// given
+		activeTenant := validTenant()
+		activeTenant.Status = model.TenantStatus(tenantgrpc.Status_STATUS_ACTIVE.String())
+		err := createTenantInDB(ctx, testCtx.db, activeTenant)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := deleteTenantFromDB(ctx, testCtx.db, activeTenant)
+			assert.NoError(t, err)
+		})
+
+		var trailer metadata.MD
+		_, err = testCtx.tenantClient.BlockTenant(ctx, &tenantgrpc.BlockTenantRequest{
+			Id: activeTenant.ID,
+		}, grpc.Trailer(&trailer))
+		require.NoError(t, err)
+
+		operationIDs := trailer.Get(interceptor.OperationIDTrailerKey)
+		require.Len(t, operationIDs, 1)
+
+		// when
+		operation, err := subj.GetOperation(ctx, operationIDs[0])
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, operationIDs[0], operation.ID)
+		assert.Equal(t, activeTenant.ID, operation.ExternalID)
+		assert.Equal(t, tenantgrpc.ACTION_ACTION_BLOCK_TENANT.String(), operation.Type)
+
+		// when
+		operations, err := subj.ListOperations(ctx, activeTenant.ID)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, operations, 1)
+		assert.Equal(t, operationIDs[0], operations[0].ID)
+
+		// when
+		waited, err := subj.WaitOperation(ctx, operationIDs[0], 0)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, waited.Done)
+	})
+
+	t.Run("should return an error if the operation cannot be found", func(t *testing.T) {
+		// when
+		operation, err := subj.GetOperation(ctx, uuid.Must(uuid.NewV4()).String())
+
+		// then
+		assert.Nil(t, operation)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
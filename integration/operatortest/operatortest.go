@@ -11,12 +11,18 @@
 // - Auths with External ID "test-auth-fail" will get a failed handler response.
 //
 // For any other tenant IDs or auth external IDs, it will return a processing response.
+//
+// A Harness runs operators for several simulated regions, each with its own Behavior,
+// and records the tenants handled by each region, so multi-target routing can be tested.
 package operatortest
 
 import (
 	"context"
 	"errors"
 	"fmt"
+	"math/rand/v2"
+	"slices"
+	"sync"
 	"time"
 
 	"github.com/openkcm/common-sdk/pkg/commoncfg"
@@ -31,7 +37,11 @@ import (
 	"github.com/openkcm/registry/internal/config"
 )
 
-const Region = "test-region"
+// Regions of the targets configured in the config.yaml.
+const (
+	Region       = "test-region"
+	SecondRegion = "test-region-2"
+)
 
 const (
 	TenantIDFail    = "test-tenant-fail"
@@ -46,7 +56,73 @@ const (
 
 var ErrNoTestRegion = errors.New("no test region found in configuration")
 
+// Behavior simulates the conditions of a region.
+type Behavior struct {
+	// Latency delays every response of the region.
+	Latency time.Duration
+	// FailureRate is the share of tasks, between 0 and 1, failed regardless of their tenant or auth.
+	FailureRate float64
+}
+
+// Harness runs the operators of several simulated regions.
+type Harness struct {
+	operators map[string]*orbital.Operator
+
+	mu      sync.Mutex
+	handled map[string][]string
+}
+
+// New creates the operator of the test region, which responds without latency and failures.
 func New(ctx context.Context) (*orbital.Operator, error) {
+	h := &Harness{handled: make(map[string][]string)}
+
+	return h.newOperator(ctx, Region, Behavior{})
+}
+
+// NewHarness creates the operators of the regions with their behaviors.
+// Every region must be configured as orbital target in the config.yaml.
+func NewHarness(ctx context.Context, behaviors map[string]Behavior) (*Harness, error) {
+	h := &Harness{
+		operators: make(map[string]*orbital.Operator, len(behaviors)),
+		handled:   make(map[string][]string, len(behaviors)),
+	}
+
+	for region, behavior := range behaviors {
+		operator, err := h.newOperator(ctx, region, behavior)
+		if err != nil {
+			return nil, err
+		}
+
+		h.operators[region] = operator
+	}
+
+	return h, nil
+}
+
+// ListenAndRespond starts the operators of all regions.
+func (h *Harness) ListenAndRespond(ctx context.Context) {
+	for _, operator := range h.operators {
+		go operator.ListenAndRespond(ctx)
+	}
+}
+
+// TenantIDs returns the IDs of the tenants whose tasks were handled by the region, in the order they were handled.
+// A tenant is listed once per handled task.
+func (h *Harness) TenantIDs(region string) []string {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	return slices.Clone(h.handled[region])
+}
+
+func (h *Harness) recordTenant(region, tenantID string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.handled[region] = append(h.handled[region], tenantID)
+}
+
+func (h *Harness) newOperator(ctx context.Context, region string, behavior Behavior) (*orbital.Operator, error) {
 	cfg, err := loadConfig()
 	if err != nil {
 		return nil, err
@@ -54,13 +130,13 @@ func New(ctx context.Context) (*orbital.Operator, error) {
 
 	var target *config.Target
 	for _, t := range cfg.Orbital.Targets {
-		if t.Region == Region {
+		if t.Region == region {
 			target = &t
 			break
 		}
 	}
 	if target == nil {
-		return nil, ErrNoTestRegion
+		return nil, fmt.Errorf("%w: %s", ErrNoTestRegion, region)
 	}
 
 	option, err := getClientOption(target)
@@ -86,7 +162,9 @@ func New(ctx context.Context) (*orbital.Operator, error) {
 		return nil, err
 	}
 
-	err = registerHandlers(operator)
+	r := &regionHandlers{harness: h, region: region, behavior: behavior}
+
+	err = r.register(operator)
 	if err != nil {
 		return nil, err
 	}
@@ -130,14 +208,21 @@ func loadConfig() (*config.Config, error) {
 	return cfg, nil
 }
 
-func registerHandlers(operator *orbital.Operator) error {
+// regionHandlers handle the tasks of a region with its behavior.
+type regionHandlers struct {
+	harness  *Harness
+	region   string
+	behavior Behavior
+}
+
+func (r *regionHandlers) register(operator *orbital.Operator) error {
 	for _, jobType := range []string{
 		tenantgrpc.ACTION_ACTION_PROVISION_TENANT.String(),
 		tenantgrpc.ACTION_ACTION_BLOCK_TENANT.String(),
 		tenantgrpc.ACTION_ACTION_UNBLOCK_TENANT.String(),
 		tenantgrpc.ACTION_ACTION_TERMINATE_TENANT.String(),
 	} {
-		err := operator.RegisterHandler(jobType, handleTenant)
+		err := operator.RegisterHandler(jobType, r.handleTenant)
 		if err != nil {
 			return err
 		}
@@ -147,7 +232,7 @@ func registerHandlers(operator *orbital.Operator) error {
 		authgrpc.AuthAction_AUTH_ACTION_APPLY_AUTH.String(),
 		authgrpc.AuthAction_AUTH_ACTION_REMOVE_AUTH.String(),
 	} {
-		err := operator.RegisterHandler(jobType, handleAuth)
+		err := operator.RegisterHandler(jobType, r.handleAuth)
 		if err != nil {
 			return err
 		}
@@ -155,7 +240,24 @@ func registerHandlers(operator *orbital.Operator) error {
 	return nil
 }
 
-func handleTenant(_ context.Context,
+// simulate applies the behavior of the region. It returns false if the task is failed.
+func (r *regionHandlers) simulate(ctx context.Context, handlerResponse *orbital.HandlerResponse) bool {
+	if r.behavior.Latency > 0 {
+		select {
+		case <-ctx.Done():
+		case <-time.After(r.behavior.Latency):
+		}
+	}
+
+	if r.behavior.FailureRate > 0 && rand.Float64() < r.behavior.FailureRate {
+		handlerResponse.Fail("simulated region failure of " + r.region)
+		return false
+	}
+
+	return true
+}
+
+func (r *regionHandlers) handleTenant(ctx context.Context,
 	handlerRequest orbital.HandlerRequest,
 	handlerResponse *orbital.HandlerResponse) {
 	var tenant tenantgrpc.Tenant
@@ -166,6 +268,12 @@ func handleTenant(_ context.Context,
 		return
 	}
 
+	r.harness.recordTenant(r.region, tenant.GetId())
+
+	if !r.simulate(ctx, handlerResponse) {
+		return
+	}
+
 	switch tenant.GetId() {
 	case TenantIDSuccess:
 		handlerResponse.Complete()
@@ -176,7 +284,7 @@ func handleTenant(_ context.Context,
 	}
 }
 
-func handleAuth(_ context.Context,
+func (r *regionHandlers) handleAuth(ctx context.Context,
 	handlerRequest orbital.HandlerRequest,
 	handlerResponse *orbital.HandlerResponse) {
 	var auth authgrpc.Auth
@@ -187,6 +295,10 @@ func handleAuth(_ context.Context,
 		return
 	}
 
+	if !r.simulate(ctx, handlerResponse) {
+		return
+	}
+
 	switch auth.GetExternalId() {
 	case AuthExternalIDSuccess:
 		handlerResponse.Complete()
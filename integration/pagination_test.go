@@ -0,0 +1,106 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"testing"
+	"testing/quick"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/repository/sql"
+)
+
+func TestPaginationIndexes(t *testing.T) {
+	// given
+	db, err := startDB()
+	require.NoError(t, err)
+
+	// when
+	err = sql.VerifyPaginationIndexes(db, sql.PaginatedResources...)
+
+	// then
+	assert.NoError(t, err)
+}
+
+func TestKeysetPagination(t *testing.T) {
+	// given
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	// pages returns true if paging through tenants, many of them created at the same time,
+	// returns each tenant exactly once in keyset order.
+	pages := func(seed uint64, size, timestamps, limit uint8) bool {
+		count := int(size%40) + 1
+		rnd := rand.New(rand.NewPCG(seed, seed))
+		region := "region-pagination-" + validRandID()[:8]
+		base := time.Now().UTC().Truncate(time.Microsecond)
+
+		tenants := make([]*model.Tenant, count)
+		for i := range tenants {
+			tenants[i] = &model.Tenant{
+				ID:        validRandID(),
+				Name:      "pagination",
+				Region:    region,
+				OwnerID:   "owner",
+				OwnerType: allowedOwnerType,
+				Status:    model.TenantStatus("STATUS_ACTIVE"),
+				Role:      "ROLE_TEST",
+				CreatedAt: base.Add(time.Duration(rnd.IntN(int(timestamps%4)+1)) * time.Microsecond),
+			}
+		}
+		require.NoError(t, db.Create(&tenants).Error)
+		defer db.Where("region = ?", region).Delete(&model.Tenant{})
+
+		expected := make([]string, count)
+		for i, tenant := range tenants {
+			expected[i] = tenant.ID
+		}
+		slices.SortFunc(expected, func(a, b string) int {
+			ta := tenants[slices.IndexFunc(tenants, func(t *model.Tenant) bool { return t.ID == a })]
+			tb := tenants[slices.IndexFunc(tenants, func(t *model.Tenant) bool { return t.ID == b })]
+			if c := tb.CreatedAt.Compare(ta.CreatedAt); c != 0 {
+				return c
+			}
+			return strings.Compare(b, a)
+		})
+
+		var listed []string
+		token := ""
+		for {
+			query := repository.NewQuery(&model.Tenant{}).
+				Where(repository.NewCompositeKey().Where(repository.RegionField, region))
+			require.NoError(t, query.ApplyPagination(int32(limit%7)+1, token))
+
+			var page []model.Tenant
+			require.NoError(t, repo.List(t.Context(), &page, *query))
+			for _, tenant := range page {
+				listed = append(listed, tenant.ID)
+			}
+
+			if len(page) < query.Limit {
+				break
+			}
+
+			last := page[len(page)-1]
+			token, err = repository.PageInfo{LastCreatedAt: last.CreatedAt, LastKey: last.PaginationKey()}.Encode()
+			require.NoError(t, err)
+		}
+
+		return slices.Equal(expected, listed)
+	}
+
+	// when
+	err = quick.Check(pages, &quick.Config{MaxCount: 20})
+
+	// then
+	assert.NoError(t, err)
+}
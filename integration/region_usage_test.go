@@ -0,0 +1,84 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestGetRegionUsage(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	region := "region-usage-" + validRandID()[:8]
+	subj := service.NewRegionUsages(repo,
+		config.RegionUsage{SoftLimits: map[string]config.RegionSoftLimits{region: {RegionalSystems: 1000}}},
+		config.TenantPlacement{Capacity: map[string]int64{region: 100}})
+
+	tenant := validTenant()
+	tenant.Region = region
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+
+	system := model.NewSystem(validRandID(), allowedSystemType)
+	system.LinkTenant(tenant.ID)
+	require.NoError(t, createSystemInDB(ctx, db, system))
+
+	claimed := true
+	regionalSystem := &model.RegionalSystem{
+		SystemID:      system.ID,
+		Region:        region,
+		Status:        typespb.Status_STATUS_AVAILABLE.String(),
+		HasL1KeyClaim: &claimed,
+	}
+	require.NoError(t, repo.Create(ctx, regionalSystem))
+
+	auth := validAuth()
+	auth.TenantID = tenant.ID
+	require.NoError(t, repo.Create(ctx, auth))
+
+	t.Cleanup(func() {
+		_, _ = repo.Delete(ctx, auth)
+		_, _ = repo.Delete(ctx, regionalSystem)
+		_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+		_ = deleteTenantFromDB(ctx, db, tenant)
+	})
+
+	t.Run("should count the resources of the region with its soft limits", func(t *testing.T) {
+		// when
+		usage, err := subj.GetRegionUsage(ctx, region)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []model.InventoryCount{{Status: string(tenant.Status), Count: 1}}, usage.Tenants)
+		assert.Equal(t, int64(1), usage.Systems)
+		assert.Equal(t, []model.InventoryCount{{Status: regionalSystem.Status, Count: 1}}, usage.RegionalSystems)
+		assert.Equal(t, int64(1), usage.L1KeyClaims)
+		assert.Equal(t, int64(1), usage.Auths)
+		assert.Equal(t, config.RegionSoftLimits{Tenants: 100, RegionalSystems: 1000}, usage.SoftLimits)
+	})
+
+	t.Run("should return no usage of a region without resources", func(t *testing.T) {
+		// when
+		usage, err := subj.GetRegionUsage(ctx, region+"-empty")
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, usage.Tenants)
+		assert.Zero(t, usage.Systems)
+		assert.Zero(t, usage.Auths)
+		assert.Equal(t, config.RegionSoftLimits{}, usage.SoftLimits)
+	})
+}
@@ -0,0 +1,155 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestServiceAccounts(t *testing.T) {
+	// given
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	defer conn.Close()
+
+	authSubj := authgrpc.NewServiceClient(conn)
+
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.ServiceAccount{}},
+	})
+	require.NoError(t, err)
+
+	subj := service.NewServiceAccount(repo, v)
+
+	tenant := validTenant()
+	tenant.Region = "non-existing-region"
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+	t.Cleanup(func() {
+		db.Where("tenant_id = ?", tenant.ID).Delete(&model.Auth{})
+		db.Where("tenant_id = ?", tenant.ID).Delete(&model.ServiceAccount{})
+		_ = deleteTenantFromDB(ctx, db, tenant)
+	})
+
+	t.Run("should create, disable and delete a service account", func(t *testing.T) {
+		// given
+		id := validRandID()
+
+		// when
+		err := subj.CreateServiceAccount(ctx, &model.ServiceAccount{ID: id, TenantID: tenant.ID, Purpose: "key rotation"})
+
+		// then
+		require.NoError(t, err)
+
+		active, accountStatus, err := subj.CheckServiceAccount(ctx, tenant.ID, id)
+		require.NoError(t, err)
+		assert.True(t, active)
+		assert.Equal(t, model.ServiceAccountStatusActive, accountStatus)
+
+		// when
+		err = subj.UpdateServiceAccount(ctx, tenant.ID, id, "", model.ServiceAccountStatusDisabled)
+
+		// then
+		require.NoError(t, err)
+
+		active, accountStatus, err = subj.CheckServiceAccount(ctx, tenant.ID, id)
+		require.NoError(t, err)
+		assert.False(t, active)
+		assert.Equal(t, model.ServiceAccountStatusDisabled, accountStatus)
+
+		account, err := subj.GetServiceAccount(ctx, tenant.ID, id)
+		require.NoError(t, err)
+		assert.Equal(t, "key rotation", account.Purpose)
+
+		// when
+		err = subj.DeleteServiceAccount(ctx, tenant.ID, id)
+
+		// then
+		require.NoError(t, err)
+
+		_, err = subj.GetServiceAccount(ctx, tenant.ID, id)
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("should not create a service account of an unknown tenant", func(t *testing.T) {
+		// when
+		err := subj.CreateServiceAccount(ctx, &model.ServiceAccount{ID: validRandID(), TenantID: validRandID()})
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("should not reveal the service account to another tenant", func(t *testing.T) {
+		// given
+		id := validRandID()
+		require.NoError(t, subj.CreateServiceAccount(ctx, &model.ServiceAccount{ID: id, TenantID: tenant.ID}))
+
+		// when
+		active, accountStatus, err := subj.CheckServiceAccount(ctx, validRandID(), id)
+
+		// then
+		require.NoError(t, err)
+		assert.False(t, active)
+		assert.Empty(t, accountStatus)
+	})
+
+	t.Run("should not delete a service account referenced by an auth", func(t *testing.T) {
+		// given
+		id := validRandID()
+		require.NoError(t, subj.CreateServiceAccount(ctx, &model.ServiceAccount{ID: id, TenantID: tenant.ID}))
+
+		auth := validAuth()
+		auth.TenantID = tenant.ID
+		auth.ServiceAccountID = id
+		require.NoError(t, repo.Create(ctx, auth))
+
+		// when
+		err := subj.DeleteServiceAccount(ctx, tenant.ID, id)
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = subj.GetServiceAccount(ctx, tenant.ID, id)
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not apply an auth of a disabled service account", func(t *testing.T) {
+		// given
+		id := validRandID()
+		require.NoError(t, subj.CreateServiceAccount(ctx, &model.ServiceAccount{
+			ID:       id,
+			TenantID: tenant.ID,
+			Status:   model.ServiceAccountStatusDisabled,
+		}))
+
+		// when
+		_, err := authSubj.ApplyAuth(ctx, &authgrpc.ApplyAuthRequest{
+			ExternalId: validRandID(),
+			TenantId:   tenant.ID,
+			Type:       "oidc",
+			Properties: map[string]string{
+				"issuer":                         "https://issuer.example.org",
+				model.AuthServiceAccountProperty: id,
+			},
+		})
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
@@ -0,0 +1,118 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/repository/sql"
+)
+
+func TestStatusClock(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+	require.NoError(t, repo.EnableStatusClock(config.StatusClock{Enabled: true, MaxSkew: time.Minute}))
+
+	blocked := model.TenantStatus(tenantgrpc.Status_STATUS_BLOCKED.String())
+
+	newTenant := func(t *testing.T, statusUpdatedAt time.Time) *model.Tenant {
+		t.Helper()
+		tenant := validTenant()
+		tenant.StatusUpdatedAt = statusUpdatedAt
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+		t.Cleanup(func() {
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+		return tenant
+	}
+
+	storedStatusTime := func(t *testing.T, id string) time.Time {
+		t.Helper()
+		stored := &model.Tenant{ID: id}
+		found, err := repo.Find(ctx, stored)
+		require.NoError(t, err)
+		require.True(t, found)
+		return stored.StatusUpdatedAt
+	}
+
+	t.Run("should take the status time of a created tenant from the database clock", func(t *testing.T) {
+		// when
+		tenant := newTenant(t, time.Time{})
+
+		// then
+		assert.WithinDuration(t, time.Now(), tenant.StatusUpdatedAt, 10*time.Second)
+		assert.True(t, tenant.StatusUpdatedAt.Equal(storedStatusTime(t, tenant.ID)))
+	})
+
+	t.Run("should take the status time of a status change from the database clock", func(t *testing.T) {
+		// given
+		tenant := newTenant(t, time.Now().Add(-time.Hour))
+		tenant.SetStatus(blocked)
+
+		// when
+		patched, err := repo.Patch(ctx, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, patched)
+		assert.WithinDuration(t, time.Now(), tenant.StatusUpdatedAt, 10*time.Second)
+		assert.True(t, tenant.StatusUpdatedAt.Equal(storedStatusTime(t, tenant.ID)))
+		assert.False(t, tenant.StatusChanged())
+	})
+
+	t.Run("should keep the status time if the status did not change", func(t *testing.T) {
+		// given
+		statusUpdatedAt := time.Now().Add(-time.Hour).Truncate(time.Microsecond)
+		tenant := newTenant(t, statusUpdatedAt)
+		tenant.Name = validRandID()
+
+		// when
+		_, err := repo.Patch(ctx, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, statusUpdatedAt.Equal(storedStatusTime(t, tenant.ID)))
+	})
+
+	t.Run("should keep a status time ahead of the database clock within the skew", func(t *testing.T) {
+		// given
+		statusUpdatedAt := time.Now().Add(30 * time.Second).Truncate(time.Microsecond)
+		tenant := newTenant(t, statusUpdatedAt)
+		tenant.SetStatus(blocked)
+
+		// when
+		_, err := repo.Patch(ctx, tenant)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, statusUpdatedAt.Equal(storedStatusTime(t, tenant.ID)))
+	})
+
+	t.Run("should reject a status change if the status time is ahead of the database clock beyond the skew", func(t *testing.T) {
+		// given
+		tenant := newTenant(t, time.Now().Add(time.Hour))
+		tenant.SetStatus(blocked)
+
+		// when
+		_, err := repo.Patch(ctx, tenant)
+
+		// then
+		assert.ErrorIs(t, err, repository.ErrStatusTimeRegression)
+		stored := &model.Tenant{ID: tenant.ID}
+		_, err = repo.Find(ctx, stored)
+		require.NoError(t, err)
+		assert.Equal(t, model.TenantStatus(tenantgrpc.Status_STATUS_ACTIVE.String()), stored.Status)
+	})
+}
@@ -0,0 +1,110 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mappinggrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/mapping/v1"
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestClassifySystem(t *testing.T) {
+	// given
+	ctx := t.Context()
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	mSubj := mappinggrpc.NewServiceClient(conn)
+
+	db, err := startDB()
+	require.NoError(t, err)
+
+	subj := service.NewSystemClassifications(sql.NewRepository(db), config.SystemClassification{
+		Environments:  []string{model.SystemEnvironmentProd, model.SystemEnvironmentNonProd},
+		Criticalities: []string{model.SystemCriticalityLow, model.SystemCriticalityMedium, model.SystemCriticalityHigh},
+	})
+
+	testTenant := validTenant()
+	testTenant.Role = tenantgrpc.Role_ROLE_TEST.String()
+	require.NoError(t, createTenantInDB(ctx, db, testTenant))
+
+	system := model.NewSystem(validRandID(), allowedSystemType)
+	require.NoError(t, createSystemInDB(ctx, db, system))
+
+	t.Cleanup(func() {
+		_ = db.Where("tenant_id = ?", testTenant.ID).Delete(&model.SystemLink{}).Error
+		_ = deleteSystemInDB(ctx, db, system.ExternalID, allowedSystemType)
+		_ = deleteTenantFromDB(ctx, db, testTenant)
+	})
+
+	mapReq := &mappinggrpc.MapSystemToTenantRequest{
+		ExternalId: system.ExternalID,
+		Type:       allowedSystemType,
+		TenantId:   testTenant.ID,
+	}
+
+	t.Run("should not link a prod system to a test tenant", func(t *testing.T) {
+		// given
+		err := subj.ClassifySystem(ctx, system.ExternalID, allowedSystemType, model.SystemEnvironmentProd, model.SystemCriticalityHigh)
+		require.NoError(t, err)
+
+		// when
+		_, err = mSubj.MapSystemToTenant(ctx, mapReq)
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		actual, err := getSystemFromDB(ctx, db, system.ExternalID, allowedSystemType)
+		require.NoError(t, err)
+		assert.Equal(t, model.SystemEnvironmentProd, actual.Environment)
+		assert.Equal(t, model.SystemCriticalityHigh, actual.Criticality)
+		assert.False(t, actual.IsLinkedToTenant())
+	})
+
+	t.Run("should link a nonprod system to a test tenant", func(t *testing.T) {
+		// given
+		err := subj.ClassifySystem(ctx, system.ExternalID, allowedSystemType, model.SystemEnvironmentNonProd, "")
+		require.NoError(t, err)
+
+		// when
+		_, err = mSubj.MapSystemToTenant(ctx, mapReq)
+
+		// then
+		require.NoError(t, err)
+
+		actual, err := getSystemFromDB(ctx, db, system.ExternalID, allowedSystemType)
+		require.NoError(t, err)
+		assert.Equal(t, model.SystemEnvironmentNonProd, actual.Environment)
+		assert.Equal(t, model.SystemCriticalityHigh, actual.Criticality)
+		assert.True(t, actual.IsLinkedToTenant())
+	})
+
+	t.Run("should not classify a system linked to a test tenant as prod", func(t *testing.T) {
+		// when
+		err := subj.ClassifySystem(ctx, system.ExternalID, allowedSystemType, model.SystemEnvironmentProd, "")
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("should return an error if the system cannot be found", func(t *testing.T) {
+		// when
+		err := subj.ClassifySystem(ctx, validRandID(), allowedSystemType, model.SystemEnvironmentProd, "")
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
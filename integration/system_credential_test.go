@@ -0,0 +1,101 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestSystemCredentials(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	subj := service.NewSystemCredentials(repo)
+
+	system := model.NewSystem(validRandID(), allowedSystemType)
+	require.NoError(t, createSystemInDB(ctx, db, system))
+	regionalSystem := &model.RegionalSystem{
+		SystemID: system.ID,
+		Region:   "region-system",
+		Status:   typespb.Status_STATUS_AVAILABLE.String(),
+	}
+	require.NoError(t, repo.Create(ctx, regionalSystem))
+	t.Cleanup(func() {
+		_, _ = repo.Delete(ctx, regionalSystem)
+		_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+	})
+
+	thumbprint := "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+	newCredential := func() *model.SystemCredential {
+		return &model.SystemCredential{
+			Thumbprint: thumbprint,
+			NotBefore:  time.Now().Add(-time.Hour),
+			NotAfter:   time.Now().Add(24 * time.Hour),
+		}
+	}
+
+	t.Run("should add, list and revoke a credential", func(t *testing.T) {
+		// when
+		err := subj.AddSystemCredential(ctx, system.ExternalID, system.Type, regionalSystem.Region, newCredential())
+
+		// then
+		require.NoError(t, err)
+
+		credentials, err := subj.ListSystemCredentials(ctx, system.ExternalID, system.Type, regionalSystem.Region)
+		require.NoError(t, err)
+		require.Len(t, credentials, 1)
+		assert.Equal(t, thumbprint, credentials[0].Thumbprint)
+		assert.Equal(t, model.SystemCredentialStatusActive, credentials[0].Status)
+
+		// when
+		err = subj.RevokeSystemCredential(ctx, system.ExternalID, system.Type, regionalSystem.Region, thumbprint)
+
+		// then
+		require.NoError(t, err)
+
+		credentials, err = subj.ListSystemCredentials(ctx, system.ExternalID, system.Type, regionalSystem.Region)
+		require.NoError(t, err)
+		require.Len(t, credentials, 1)
+		assert.Equal(t, model.SystemCredentialStatusRevoked, credentials[0].Status)
+		assert.NotNil(t, credentials[0].RevokedAt)
+	})
+
+	t.Run("should not add a revoked credential again", func(t *testing.T) {
+		// when
+		err := subj.AddSystemCredential(ctx, system.ExternalID, system.Type, regionalSystem.Region, newCredential())
+
+		// then
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("should not revoke a revoked credential", func(t *testing.T) {
+		// when
+		err := subj.RevokeSystemCredential(ctx, system.ExternalID, system.Type, regionalSystem.Region, thumbprint)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrSystemCredentialRevoked)
+	})
+
+	t.Run("should return error for unknown regional system", func(t *testing.T) {
+		// when
+		err := subj.AddSystemCredential(ctx, system.ExternalID, system.Type, "unknown-region", newCredential())
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,100 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestUpdateSystemDisplay(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}},
+	})
+	require.NoError(t, err)
+
+	subj := service.NewSystemDisplays(sql.NewRepository(db), v)
+
+	system := model.NewSystem(validRandID(), allowedSystemType)
+	require.NoError(t, createSystemInDB(ctx, db, system))
+	other := model.NewSystem(validRandID(), allowedSystemType)
+	require.NoError(t, createSystemInDB(ctx, db, other))
+
+	t.Cleanup(func() {
+		_ = deleteSystemInDB(ctx, db, system.ExternalID, allowedSystemType)
+		_ = deleteSystemInDB(ctx, db, other.ExternalID, allowedSystemType)
+	})
+
+	displayName := "Payroll (EU)"
+	description := "Payroll of the European subsidiaries"
+
+	t.Run("should set the display name and description", func(t *testing.T) {
+		// when
+		updated, err := subj.UpdateSystem(ctx, system.ExternalID, allowedSystemType, &displayName, &description)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, displayName, updated.DisplayName)
+		assert.Equal(t, description, updated.Description)
+
+		actual, err := getSystemFromDB(ctx, db, system.ExternalID, allowedSystemType)
+		require.NoError(t, err)
+		assert.Equal(t, displayName, actual.DisplayName)
+		assert.Equal(t, description, actual.Description)
+	})
+
+	t.Run("should return the displays of the listed systems", func(t *testing.T) {
+		// when
+		displays, err := subj.Displays(ctx, []*systemgrpc.System{
+			{ExternalId: system.ExternalID, Type: allowedSystemType, Region: "region-a"},
+			{ExternalId: system.ExternalID, Type: allowedSystemType, Region: "region-b"},
+			{ExternalId: other.ExternalID, Type: allowedSystemType},
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, map[string]service.SystemDisplay{
+			system.ExternalID + "/" + allowedSystemType: {DisplayName: displayName, Description: description},
+		}, displays)
+	})
+
+	t.Run("should clear the description and keep the display name", func(t *testing.T) {
+		// given
+		empty := ""
+
+		// when
+		_, err := subj.UpdateSystem(ctx, system.ExternalID, allowedSystemType, nil, &empty)
+
+		// then
+		require.NoError(t, err)
+
+		actual, err := getSystemFromDB(ctx, db, system.ExternalID, allowedSystemType)
+		require.NoError(t, err)
+		assert.Equal(t, displayName, actual.DisplayName)
+		assert.Empty(t, actual.Description)
+	})
+
+	t.Run("should return an error if the system cannot be found", func(t *testing.T) {
+		// when
+		_, err := subj.UpdateSystem(ctx, validRandID(), allowedSystemType, &displayName, nil)
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
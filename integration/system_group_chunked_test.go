@@ -0,0 +1,169 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// systemGroupChunkStream collects the progress and cancels its context after cancelAfter messages if set.
+type systemGroupChunkStream struct {
+	ctx         context.Context
+	cancel      context.CancelFunc
+	cancelAfter int
+	progress    []service.SystemGroupChunkProgress
+}
+
+func (s *systemGroupChunkStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *systemGroupChunkStream) Send(progress *service.SystemGroupChunkProgress) error {
+	s.progress = append(s.progress, *progress)
+	if s.cancelAfter > 0 && len(s.progress) == s.cancelAfter {
+		s.cancel()
+	}
+
+	return nil
+}
+
+func TestSystemGroupChunked(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}, &model.RegionalSystem{}, &model.SystemGroup{}},
+	})
+	require.NoError(t, err)
+
+	subj := service.NewSystemGroup(repo, v, service.NewLabels(v, config.Labels{}), nil)
+
+	tenant := validTenant()
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+	otherTenant := validTenant()
+	require.NoError(t, createTenantInDB(ctx, db, otherTenant))
+
+	members := []model.SystemIdentifier{
+		{ExternalID: validRandID(), Type: allowedSystemType},
+		{ExternalID: validRandID(), Type: allowedSystemType},
+		{ExternalID: validRandID(), Type: allowedSystemType},
+	}
+	group := &model.SystemGroup{TenantID: tenant.ID, Name: "fleet", Members: members}
+	require.NoError(t, repo.Create(ctx, group))
+
+	t.Cleanup(func() {
+		_, _ = repo.Delete(ctx, group)
+		_ = db.Where("tenant_id IN ?", []string{tenant.ID, otherTenant.ID}).Delete(&model.SystemLink{}).Error
+		for _, member := range members {
+			_ = deleteSystemInDB(ctx, db, member.ExternalID, member.Type)
+		}
+		_ = deleteTenantFromDB(ctx, db, tenant)
+		_ = deleteTenantFromDB(ctx, db, otherTenant)
+	})
+
+	req := service.SystemGroupChunkRequest{TenantID: tenant.ID, Name: group.Name, ChunkSize: 2, AbortOnFailure: true}
+
+	assertLinked := func(t *testing.T, tenantID string, members ...model.SystemIdentifier) {
+		t.Helper()
+		for _, member := range members {
+			system, err := getSystemFromDB(ctx, db, member.ExternalID, member.Type)
+			require.NoError(t, err)
+			require.NotNil(t, system)
+			if tenantID == "" {
+				assert.False(t, system.IsLinkedToTenant())
+				continue
+			}
+			require.True(t, system.IsLinkedToTenant())
+			assert.Equal(t, tenantID, *system.TenantID)
+		}
+	}
+
+	t.Run("should link the members in chunks and report the progress", func(t *testing.T) {
+		// given
+		stream := &systemGroupChunkStream{ctx: ctx}
+
+		// when
+		err := subj.LinkSystemGroupChunked(stream, req)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, stream.progress, 2)
+		assert.Equal(t, service.SystemGroupChunkProgress{Chunk: 1, Chunks: 2, Succeeded: 2}, stream.progress[0])
+		assert.Equal(t, service.SystemGroupChunkProgress{Chunk: 2, Chunks: 2, Succeeded: 3}, stream.progress[1])
+		assertLinked(t, tenant.ID, members...)
+	})
+
+	t.Run("should unlink the members in chunks", func(t *testing.T) {
+		// given
+		stream := &systemGroupChunkStream{ctx: ctx}
+
+		// when
+		err := subj.UnlinkSystemGroupChunked(stream, req)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, stream.progress, 2)
+		assert.Equal(t, 3, stream.progress[1].Succeeded)
+		assertLinked(t, "", members...)
+	})
+
+	t.Run("should revert the linked chunks if a chunk fails", func(t *testing.T) {
+		// given
+		mSubj := service.NewSystemGroup(repo, v, service.NewLabels(v, config.Labels{}), nil)
+		otherGroup := &model.SystemGroup{TenantID: otherTenant.ID, Name: "other", Members: members[2:]}
+		require.NoError(t, repo.Create(ctx, otherGroup))
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, otherGroup)
+		})
+		require.NoError(t, mSubj.LinkSystemGroup(ctx, otherTenant.ID, otherGroup.Name))
+		stream := &systemGroupChunkStream{ctx: ctx}
+
+		// when
+		err := subj.LinkSystemGroupChunked(stream, req)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, stream.progress, 2)
+		last := stream.progress[1]
+		assert.Equal(t, 2, last.Succeeded)
+		assert.Equal(t, 1, last.Failed)
+		assert.True(t, last.Reverted)
+		require.Len(t, last.Failures, 1)
+		assert.Equal(t, members[2], last.Failures[0].Member)
+		assert.Equal(t, codes.FailedPrecondition, status.Code(last.Failures[0].Err))
+		assertLinked(t, "", members[:2]...)
+		assertLinked(t, otherTenant.ID, members[2])
+
+		require.NoError(t, mSubj.UnlinkSystemGroup(ctx, otherTenant.ID, otherGroup.Name))
+	})
+
+	t.Run("should revert the linked chunks if the call is canceled", func(t *testing.T) {
+		// given
+		cancelCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream := &systemGroupChunkStream{ctx: cancelCtx, cancel: cancel, cancelAfter: 1}
+
+		// when
+		err := subj.LinkSystemGroupChunked(stream, req)
+
+		// then
+		assert.Equal(t, codes.Canceled, status.Code(err))
+		require.Len(t, stream.progress, 1)
+		assertLinked(t, "", members...)
+	})
+}
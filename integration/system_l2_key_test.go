@@ -0,0 +1,108 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestSystemL2KeyHistory(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}, &model.RegionalSystem{}},
+	})
+	require.NoError(t, err)
+
+	meters := service.NewMeters(service.NewMeterRegistry(&commoncfg.Application{Name: "registry"}, noop.NewMeterProvider()))
+
+	subj := service.NewSystem(repo, meters, v,
+		service.NewSystemApproval(config.SystemApproval{}, nil),
+		service.NewLegacyRequests(config.Compatibility{}, meters),
+		service.NewEnumValues(config.Compatibility{}),
+		service.NewLabels(v, config.Labels{}),
+		service.NewSystemStatuses(config.SystemStatus{}), nil)
+
+	// the regional system is created without history, as by a registration before the history was recorded
+	system := model.NewSystem(validRandID(), allowedSystemType)
+	require.NoError(t, createSystemInDB(ctx, db, system))
+	regionalSystem := &model.RegionalSystem{
+		SystemID: system.ID,
+		Region:   "region-l2-key",
+		Status:   typespb.Status_STATUS_AVAILABLE.String(),
+		L2KeyID:  "l2-key-1",
+	}
+	require.NoError(t, repo.Create(ctx, regionalSystem))
+	t.Cleanup(func() {
+		_, _ = repo.Delete(ctx, regionalSystem)
+		_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+	})
+
+	t.Run("should record the previous and the rotated key", func(t *testing.T) {
+		// when
+		err := subj.UpdateSystemL2Key(ctx, system.ExternalID, system.Type, regionalSystem.Region, "l2-key-2")
+
+		// then
+		require.NoError(t, err)
+
+		history, err := subj.GetSystemKeyHistory(ctx, system.ExternalID, system.Type, regionalSystem.Region)
+		require.NoError(t, err)
+		require.Len(t, history, 2)
+		assert.Equal(t, "l2-key-2", history[0].L2KeyID)
+		assert.True(t, history[0].IsCurrent())
+		assert.Equal(t, "l2-key-1", history[1].L2KeyID)
+		assert.False(t, history[1].IsCurrent())
+
+		updated := &model.RegionalSystem{SystemID: system.ID, Region: regionalSystem.Region}
+		_, err = repo.Find(ctx, updated)
+		require.NoError(t, err)
+		assert.Equal(t, "l2-key-2", updated.L2KeyID)
+	})
+
+	t.Run("should not record an unchanged key", func(t *testing.T) {
+		// when
+		err := subj.UpdateSystemL2Key(ctx, system.ExternalID, system.Type, regionalSystem.Region, "l2-key-2")
+
+		// then
+		require.NoError(t, err)
+
+		history, err := subj.GetSystemKeyHistory(ctx, system.ExternalID, system.Type, regionalSystem.Region)
+		require.NoError(t, err)
+		assert.Len(t, history, 2)
+	})
+
+	t.Run("should return error for empty key", func(t *testing.T) {
+		// when
+		err := subj.UpdateSystemL2Key(ctx, system.ExternalID, system.Type, regionalSystem.Region, "")
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("should return error for unknown regional system", func(t *testing.T) {
+		// when
+		err := subj.UpdateSystemL2Key(ctx, system.ExternalID, system.Type, "unknown-region", "l2-key-3")
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,131 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestSystemLabelBatches(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.RegionalSystem{}},
+	})
+	require.NoError(t, err)
+
+	// a page size of two spreads the systems over several pages
+	subj := service.NewSystemLabelBatches(repo, service.NewLabels(v, config.Labels{}), config.TableScan{PageSize: 2})
+
+	wave := validRandID()
+	filter := service.SystemLabelFilter{Region: allowedSystemRegion, Labels: map[string]string{"rollout": wave}}
+
+	statuses := []typespb.Status{
+		typespb.Status_STATUS_AVAILABLE,
+		typespb.Status_STATUS_AVAILABLE,
+		typespb.Status_STATUS_AVAILABLE,
+		typespb.Status_STATUS_PROCESSING,
+	}
+	regionalSystems := make([]*model.RegionalSystem, 0, len(statuses))
+	for _, systemStatus := range statuses {
+		system := model.NewSystem(validRandID(), allowedSystemType)
+		require.NoError(t, createSystemInDB(ctx, db, system))
+
+		regionalSystem := &model.RegionalSystem{
+			SystemID: system.ID,
+			Region:   allowedSystemRegion,
+			Status:   systemStatus.String(),
+			Labels:   map[string]string{"rollout": wave},
+		}
+		require.NoError(t, repo.Create(ctx, regionalSystem))
+		regionalSystems = append(regionalSystems, regionalSystem)
+
+		t.Cleanup(func() {
+			_, _ = repo.Delete(ctx, regionalSystem)
+			_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+		})
+	}
+
+	labelsOf := func(t *testing.T, regionalSystem *model.RegionalSystem) map[string]string {
+		t.Helper()
+		found := &model.RegionalSystem{SystemID: regionalSystem.SystemID, Region: regionalSystem.Region}
+		ok, err := repo.Find(ctx, found)
+		require.NoError(t, err)
+		require.True(t, ok)
+		return found.Labels
+	}
+
+	t.Run("should count the systems without updating them in a dry run", func(t *testing.T) {
+		// when
+		summary, err := subj.BatchSetSystemLabels(ctx, filter, map[string]string{"patch-wave": "3"}, true)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, service.SystemLabelBatchSummary{Matched: 4, Updated: 3, Skipped: 1, DryRun: true}, *summary)
+		for _, regionalSystem := range regionalSystems {
+			assert.NotContains(t, labelsOf(t, regionalSystem), "patch-wave")
+		}
+	})
+
+	t.Run("should set the labels on the available systems matching the filter", func(t *testing.T) {
+		// when
+		summary, err := subj.BatchSetSystemLabels(ctx, filter, map[string]string{"patch-wave": "3"}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, service.SystemLabelBatchSummary{Matched: 4, Updated: 3, Skipped: 1}, *summary)
+		for _, regionalSystem := range regionalSystems[:3] {
+			assert.Equal(t, map[string]string{"rollout": wave, "patch-wave": "3"}, labelsOf(t, regionalSystem))
+		}
+		assert.NotContains(t, labelsOf(t, regionalSystems[3]), "patch-wave")
+	})
+
+	t.Run("should not update the systems which have the labels already", func(t *testing.T) {
+		// when
+		summary, err := subj.BatchSetSystemLabels(ctx, filter, map[string]string{"patch-wave": "3"}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, service.SystemLabelBatchSummary{Matched: 4, Unchanged: 3, Skipped: 1}, *summary)
+	})
+
+	t.Run("should remove the labels from the systems matching the filter", func(t *testing.T) {
+		// when
+		summary, err := subj.BatchRemoveSystemLabels(ctx, filter, []string{"patch-wave"}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, service.SystemLabelBatchSummary{Matched: 4, Updated: 3, Skipped: 1}, *summary)
+		for _, regionalSystem := range regionalSystems {
+			assert.Equal(t, map[string]string{"rollout": wave}, labelsOf(t, regionalSystem))
+		}
+	})
+
+	t.Run("should match no systems of another type", func(t *testing.T) {
+		// given
+		filter := filter
+		filter.Type = "non-existing-type"
+
+		// when
+		summary, err := subj.BatchSetSystemLabels(ctx, filter, map[string]string{"patch-wave": "3"}, false)
+
+		// then
+		require.NoError(t, err)
+		assert.Zero(t, summary.Matched)
+	})
+}
@@ -0,0 +1,42 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestRecalculateSystemLinkMetrics(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+
+	subj := service.NewSystemLinkMetrics(sql.NewRepository(db), config.SystemLinkMetrics{})
+
+	before, err := subj.RecalculateSystemLinkMetrics(ctx)
+	require.NoError(t, err)
+
+	system := model.NewSystem(validRandID(), allowedSystemType)
+	require.NoError(t, createSystemInDB(ctx, db, system))
+	t.Cleanup(func() {
+		_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+	})
+
+	// when
+	after, err := subj.RecalculateSystemLinkMetrics(ctx)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, before.Counts["false"]+1, after.Counts["false"])
+	assert.Equal(t, int64(1), after.Drift["false"])
+	assert.Equal(t, int64(0), after.Drift["true"])
+}
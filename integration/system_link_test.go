@@ -0,0 +1,104 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	mappinggrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/mapping/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestListSystemsAsOf(t *testing.T) {
+	// given
+	ctx := t.Context()
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+	mSubj := mappinggrpc.NewServiceClient(conn)
+
+	db, err := startDB()
+	require.NoError(t, err)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}, &model.RegionalSystem{}},
+	})
+	require.NoError(t, err)
+
+	meters := service.NewMeters(service.NewMeterRegistry(&commoncfg.Application{Name: "registry"}, noop.NewMeterProvider()))
+
+	subj := service.NewSystem(sql.NewRepository(db), meters, v,
+		service.NewSystemApproval(config.SystemApproval{}, nil),
+		service.NewLegacyRequests(config.Compatibility{}, meters),
+		service.NewEnumValues(config.Compatibility{}),
+		service.NewLabels(v, config.Labels{}),
+		service.NewSystemStatuses(config.SystemStatus{}), nil)
+
+	tenant := validTenant()
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+
+	externalID := validRandID()
+	t.Cleanup(func() {
+		_ = db.Where("tenant_id = ?", tenant.ID).Delete(&model.SystemLink{}).Error
+		_ = deleteSystemInDB(ctx, db, externalID, allowedSystemType)
+		_ = deleteTenantFromDB(ctx, db, tenant)
+	})
+
+	beforeLink := time.Now()
+	_, err = mSubj.MapSystemToTenant(ctx, &mappinggrpc.MapSystemToTenantRequest{
+		ExternalId: externalID,
+		Type:       allowedSystemType,
+		TenantId:   tenant.ID,
+	})
+	require.NoError(t, err)
+
+	whileLinked := time.Now()
+	_, err = mSubj.UnmapSystemFromTenant(ctx, &mappinggrpc.UnmapSystemFromTenantRequest{
+		ExternalId: externalID,
+		Type:       allowedSystemType,
+		TenantId:   tenant.ID,
+	})
+	require.NoError(t, err)
+
+	t.Run("should return the system linked at the time", func(t *testing.T) {
+		// when
+		links, err := subj.ListSystemsAsOf(ctx, tenant.ID, whileLinked)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, links, 1)
+		assert.Equal(t, externalID, links[0].ExternalID)
+		assert.Equal(t, allowedSystemType, links[0].Type)
+	})
+
+	t.Run("should not return the system before it was linked", func(t *testing.T) {
+		// when
+		links, err := subj.ListSystemsAsOf(ctx, tenant.ID, beforeLink)
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, links)
+	})
+
+	t.Run("should not return the system after it was unlinked", func(t *testing.T) {
+		// when
+		links, err := subj.ListSystemsAsOf(ctx, tenant.ID, time.Now())
+
+		// then
+		require.NoError(t, err)
+		assert.Empty(t, links)
+	})
+}
@@ -0,0 +1,106 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestBatchGetSystems(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}, &model.RegionalSystem{}},
+	})
+	require.NoError(t, err)
+
+	meters := service.NewMeters(service.NewMeterRegistry(&commoncfg.Application{Name: "registry"}, noop.NewMeterProvider()))
+
+	systems := service.NewSystem(repo, meters, v,
+		service.NewSystemApproval(config.SystemApproval{}, nil),
+		service.NewLegacyRequests(config.Compatibility{}, meters),
+		service.NewEnumValues(config.Compatibility{}),
+		service.NewLabels(v, config.Labels{}),
+		service.NewSystemStatuses(config.SystemStatus{RollupOrder: []string{
+			typespb.Status_STATUS_UNAVAILABLE.String(),
+			typespb.Status_STATUS_AVAILABLE.String(),
+		}}), nil)
+	subj := service.NewSystemLookups(systems, config.SystemLookup{Enabled: true, MaxBatchSize: 10})
+
+	multiRegion := model.NewSystem(validRandID(), allowedSystemType)
+	singleRegion := model.NewSystem(validRandID(), allowedSystemType)
+	for _, system := range []*model.System{multiRegion, singleRegion} {
+		require.NoError(t, createSystemInDB(ctx, db, system))
+	}
+	regionalSystems := []*model.RegionalSystem{
+		{SystemID: multiRegion.ID, Region: "region-lookup-1", Status: typespb.Status_STATUS_AVAILABLE.String()},
+		{SystemID: multiRegion.ID, Region: "region-lookup-2", Status: typespb.Status_STATUS_UNAVAILABLE.String()},
+		{SystemID: singleRegion.ID, Region: "region-lookup-2", Status: typespb.Status_STATUS_AVAILABLE.String()},
+	}
+	for _, regionalSystem := range regionalSystems {
+		require.NoError(t, repo.Create(ctx, regionalSystem))
+	}
+	t.Cleanup(func() {
+		for _, regionalSystem := range regionalSystems {
+			_, _ = repo.Delete(ctx, regionalSystem)
+		}
+		for _, system := range []*model.System{multiRegion, singleRegion} {
+			_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+		}
+	})
+
+	unknown := model.SystemIdentifier{ExternalID: validRandID(), Type: allowedSystemType}
+	identifiers := []model.SystemIdentifier{
+		{ExternalID: multiRegion.ExternalID, Type: multiRegion.Type},
+		unknown,
+		{ExternalID: singleRegion.ExternalID, Type: singleRegion.Type},
+		{ExternalID: multiRegion.ExternalID, Type: multiRegion.Type},
+	}
+
+	t.Run("should return the regional systems with their rollups and the missing identifiers", func(t *testing.T) {
+		// when
+		batch, err := subj.BatchGetSystems(ctx, identifiers, "")
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, batch.Systems, 3)
+		assert.Equal(t, map[string]string{
+			multiRegion.ExternalID + "/" + multiRegion.Type:   typespb.Status_STATUS_UNAVAILABLE.String(),
+			singleRegion.ExternalID + "/" + singleRegion.Type: typespb.Status_STATUS_AVAILABLE.String(),
+		}, batch.Rollups)
+		assert.Equal(t, []model.SystemIdentifier{unknown}, batch.Missing)
+	})
+
+	t.Run("should return the regional systems of the region only", func(t *testing.T) {
+		// when
+		batch, err := subj.BatchGetSystems(ctx, identifiers, "region-lookup-1")
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, batch.Systems, 1)
+		assert.Equal(t, multiRegion.ExternalID, batch.Systems[0].GetExternalId())
+		assert.Equal(t, "region-lookup-1", batch.Systems[0].GetRegion())
+		assert.Nil(t, batch.Rollups)
+		assert.Equal(t, []model.SystemIdentifier{
+			unknown,
+			{ExternalID: singleRegion.ExternalID, Type: singleRegion.Type},
+		}, batch.Missing)
+	})
+}
@@ -0,0 +1,149 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// registerSystemsStream sends its requests to the server and collects the batch results.
+type registerSystemsStream struct {
+	ctx     context.Context
+	reqs    []*systemgrpc.RegisterSystemRequest
+	results []*service.RegisterSystemsBatchResult
+}
+
+func (s *registerSystemsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *registerSystemsStream) Recv() (*systemgrpc.RegisterSystemRequest, error) {
+	if len(s.reqs) == 0 {
+		return nil, io.EOF
+	}
+
+	req := s.reqs[0]
+	s.reqs = s.reqs[1:]
+
+	return req, nil
+}
+
+func (s *registerSystemsStream) Send(result *service.RegisterSystemsBatchResult) error {
+	s.results = append(s.results, result)
+	return nil
+}
+
+func TestRegisterSystems(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}, &model.RegionalSystem{}},
+	})
+	require.NoError(t, err)
+
+	meters := service.NewMeters(service.NewMeterRegistry(&commoncfg.Application{Name: "registry"}, noop.NewMeterProvider()))
+
+	repo := sql.NewRepository(db)
+	systems := service.NewSystem(repo, meters, v,
+		service.NewSystemApproval(config.SystemApproval{}, nil),
+		service.NewLegacyRequests(config.Compatibility{}, meters),
+		service.NewEnumValues(config.Compatibility{}),
+		service.NewLabels(v, config.Labels{}),
+		service.NewSystemStatuses(config.SystemStatus{}), nil)
+
+	subj := service.NewSystemRegistrations(repo, systems,
+		config.SystemRegistration{Enabled: true, BatchSize: 2, FlushInterval: time.Minute})
+
+	req1 := validRegisterSystemReq()
+	req1.Region = "region-batch-1"
+	req2 := validRegisterSystemReq()
+	req2.ExternalId = req1.GetExternalId()
+	req2.Region = "region-batch-2"
+	req3 := validRegisterSystemReq()
+	req3.Region = "region-batch-1"
+
+	t.Cleanup(func() {
+		for _, externalID := range []string{req1.GetExternalId(), req3.GetExternalId()} {
+			system, err := getSystemFromDB(ctx, db, externalID, allowedSystemType)
+			if err != nil || system == nil {
+				continue
+			}
+			db.Where("system_id = ?", system.ID).Delete(&model.SystemL2Key{})
+			db.Where("system_id = ?", system.ID).Delete(&model.RegionalSystem{})
+			_ = deleteSystemInDB(ctx, db, externalID, allowedSystemType)
+		}
+	})
+
+	t.Run("should register the requests in batches", func(t *testing.T) {
+		// given
+		stream := &registerSystemsStream{ctx: ctx, reqs: []*systemgrpc.RegisterSystemRequest{req1, req2, req3}}
+
+		// when
+		err := subj.RegisterSystems(stream)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, stream.results, 2)
+		assert.Equal(t, 1, stream.results[0].Batch)
+		assert.Equal(t, 2, stream.results[0].Registered)
+		assert.Equal(t, 2, stream.results[1].Batch)
+		assert.Equal(t, 1, stream.results[1].Registered)
+
+		system, err := getSystemFromDB(ctx, db, req1.GetExternalId(), allowedSystemType)
+		require.NoError(t, err)
+		require.NotNil(t, system)
+
+		var regionalSystems []model.RegionalSystem
+		require.NoError(t, db.Where("system_id = ?", system.ID).Order("region").Find(&regionalSystems).Error)
+		require.Len(t, regionalSystems, 2)
+		assert.Equal(t, "region-batch-1", regionalSystems[0].Region)
+		assert.Equal(t, "region-batch-2", regionalSystems[1].Region)
+
+		var l2Keys []model.SystemL2Key
+		require.NoError(t, db.Where("system_id = ?", system.ID).Find(&l2Keys).Error)
+		assert.Len(t, l2Keys, 2)
+	})
+
+	t.Run("should report registered and invalid requests as failures", func(t *testing.T) {
+		// given
+		invalid := validRegisterSystemReq()
+		invalid.Region = ""
+		stream := &registerSystemsStream{ctx: ctx, reqs: []*systemgrpc.RegisterSystemRequest{req1, invalid}}
+
+		// when
+		err := subj.RegisterSystems(stream)
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, stream.results, 1)
+		assert.Equal(t, 0, stream.results[0].Registered)
+		require.Len(t, stream.results[0].Failures, 2)
+
+		failures := map[int]codes.Code{}
+		for _, failure := range stream.results[0].Failures {
+			failures[failure.Index] = status.Code(failure.Err)
+		}
+		assert.Equal(t, map[int]codes.Code{0: codes.AlreadyExists, 1: codes.InvalidArgument}, failures)
+	})
+}
@@ -0,0 +1,106 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestSystemRelationships(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+
+	subj := service.NewSystemRelationships(sql.NewRepository(db), config.SystemRelationships{
+		Types:          []string{"partOf", "dependsOn"},
+		ExclusiveTypes: []string{"partOf"},
+	})
+
+	landscape := model.NewSystem(validRandID(), allowedSystemType)
+	application := model.NewSystem(validRandID(), allowedSystemType)
+	database := model.NewSystem(validRandID(), allowedSystemType)
+	for _, system := range []*model.System{landscape, application, database} {
+		require.NoError(t, createSystemInDB(ctx, db, system))
+	}
+
+	t.Cleanup(func() {
+		for _, system := range []*model.System{landscape, application, database} {
+			_ = deleteSystemInDB(ctx, db, system.ExternalID, allowedSystemType)
+		}
+	})
+
+	identifier := func(system *model.System) model.SystemIdentifier {
+		return model.SystemIdentifier{ExternalID: system.ExternalID, Type: system.Type}
+	}
+
+	t.Run("should create and list the relationships of a system", func(t *testing.T) {
+		// when
+		_, err := subj.CreateSystemRelationship(ctx, identifier(application), identifier(landscape), "partOf")
+		require.NoError(t, err)
+		_, err = subj.CreateSystemRelationship(ctx, identifier(application), identifier(database), "dependsOn")
+		require.NoError(t, err)
+
+		// then
+		relationships, err := subj.ListSystemRelationships(ctx, identifier(application))
+		require.NoError(t, err)
+		require.Len(t, relationships, 2)
+		assert.Equal(t, identifier(landscape), relationships[0].Target)
+		assert.Equal(t, "partOf", relationships[0].Type)
+		assert.Equal(t, identifier(database), relationships[1].Target)
+
+		relationships, err = subj.ListSystemRelationships(ctx, identifier(landscape))
+		require.NoError(t, err)
+		require.Len(t, relationships, 1)
+		assert.Equal(t, identifier(application), relationships[0].Source)
+	})
+
+	t.Run("should not create a second relationship of an exclusive type", func(t *testing.T) {
+		// when
+		_, err := subj.CreateSystemRelationship(ctx, identifier(application), identifier(database), "partOf")
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("should not create a relationship forming a cycle", func(t *testing.T) {
+		// given
+		_, err := subj.CreateSystemRelationship(ctx, identifier(database), identifier(landscape), "dependsOn")
+		require.NoError(t, err)
+
+		// when
+		_, err = subj.CreateSystemRelationship(ctx, identifier(landscape), identifier(application), "dependsOn")
+
+		// then
+		assert.ErrorIs(t, err, service.ErrSystemRelationshipCycle)
+	})
+
+	t.Run("should not create a relationship twice", func(t *testing.T) {
+		// when
+		_, err := subj.CreateSystemRelationship(ctx, identifier(application), identifier(database), "dependsOn")
+
+		// then
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+
+	t.Run("should delete a relationship", func(t *testing.T) {
+		// when
+		err := subj.DeleteSystemRelationship(ctx, identifier(application), identifier(database), "dependsOn")
+
+		// then
+		require.NoError(t, err)
+
+		err = subj.DeleteSystemRelationship(ctx, identifier(application), identifier(database), "dependsOn")
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,87 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestSystemStatusRollup(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}, &model.RegionalSystem{}},
+	})
+	require.NoError(t, err)
+
+	meters := service.NewMeters(service.NewMeterRegistry(&commoncfg.Application{Name: "registry"}, noop.NewMeterProvider()))
+
+	subj := service.NewSystem(repo, meters, v,
+		service.NewSystemApproval(config.SystemApproval{}, nil),
+		service.NewLegacyRequests(config.Compatibility{}, meters),
+		service.NewEnumValues(config.Compatibility{}),
+		service.NewLabels(v, config.Labels{}),
+		service.NewSystemStatuses(config.SystemStatus{RollupOrder: []string{
+			typespb.Status_STATUS_UNAVAILABLE.String(),
+			typespb.Status_STATUS_AVAILABLE.String(),
+		}}), nil)
+
+	system := model.NewSystem(validRandID(), allowedSystemType)
+	require.NoError(t, createSystemInDB(ctx, db, system))
+	regionalSystems := []*model.RegionalSystem{
+		{SystemID: system.ID, Region: "region-rollup-1", Status: typespb.Status_STATUS_AVAILABLE.String()},
+		{SystemID: system.ID, Region: "region-rollup-2", Status: typespb.Status_STATUS_UNAVAILABLE.String()},
+	}
+	for _, regionalSystem := range regionalSystems {
+		require.NoError(t, repo.Create(ctx, regionalSystem))
+	}
+	t.Cleanup(func() {
+		for _, regionalSystem := range regionalSystems {
+			_, _ = repo.Delete(ctx, regionalSystem)
+		}
+		_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+	})
+
+	expRollup := typespb.Status_STATUS_UNAVAILABLE.String()
+
+	t.Run("should return the rollup status of the system", func(t *testing.T) {
+		// when
+		systems, rollup, err := subj.GetSystem(ctx, system.ExternalID, system.Type)
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, systems, 2)
+		assert.Equal(t, expRollup, rollup)
+	})
+
+	t.Run("should list the regional systems with the rollup status of all regional systems", func(t *testing.T) {
+		// when
+		resp, rollups, err := subj.ListSystemsWithRollups(ctx, &systemgrpc.ListSystemsRequest{
+			ExternalId: system.ExternalID,
+			Region:     "region-rollup-1",
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, resp.GetSystems(), 1)
+		assert.Equal(t, map[string]string{system.ExternalID + "/" + system.Type: expRollup}, rollups)
+	})
+}
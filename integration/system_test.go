@@ -5,12 +5,15 @@ package integration_test
 
 import (
 	"context"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/gofrs/uuid/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 
 	mappinggrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/mapping/v1"
@@ -546,6 +549,21 @@ func TestSystemService(t *testing.T) {
 			assert.Nil(t, resp)
 		})
 
+		t.Run("should return an empty list if no entries exist and the client asks for empty lists", func(t *testing.T) {
+			// given
+			ctx := metadata.AppendToOutgoingContext(ctx, service.EmptyListMetadataKey, "true")
+
+			// when
+			resp, err := sSubj.ListSystems(ctx, &systemgrpc.ListSystemsRequest{
+				TenantId: "random-tenant-id",
+			})
+
+			// then
+			require.NoError(t, err)
+			assert.Empty(t, resp.GetSystems())
+			assert.Empty(t, resp.GetNextPageToken())
+		})
+
 		t.Run("when entries exist", func(t *testing.T) {
 			// given
 			externalID1, type1, region1 := registerRegionalSystem(t, ctx, sSubj, existingTenantID, false, allowedSystemType, nil, nil)
@@ -1635,6 +1653,95 @@ func TestSystemService(t *testing.T) {
 	})
 }
 
+func TestRegisterSystemConcurrently(t *testing.T) {
+	// given
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, conn.Close())
+	}()
+
+	subj := systemgrpc.NewServiceClient(conn)
+	ctx := t.Context()
+
+	const registrations = 8
+
+	registerConcurrently := func(reqs []*systemgrpc.RegisterSystemRequest) []error {
+		errs := make([]error, len(reqs))
+
+		wg := sync.WaitGroup{}
+		for i, req := range reqs {
+			wg.Go(func() {
+				_, errs[i] = subj.RegisterSystem(ctx, req)
+			})
+		}
+		wg.Wait()
+
+		return errs
+	}
+
+	t.Run("should register the regional system once if registered with different L2 keys", func(t *testing.T) {
+		// given
+		externalID := validRandID()
+		reqs := make([]*systemgrpc.RegisterSystemRequest, 0, registrations)
+		for i := range registrations {
+			req := validRegisterSystemReq()
+			req.ExternalId = externalID
+			req.L2KeyId = fmt.Sprintf("key%d", i)
+			reqs = append(reqs, req)
+		}
+		defer func() {
+			assert.NoError(t, deleteSystem(ctx, subj, externalID, allowedSystemType, allowedSystemRegion))
+		}()
+
+		// when
+		errs := registerConcurrently(reqs)
+
+		// then
+		winner := -1
+		for i, err := range errs {
+			if err == nil {
+				assert.Equal(t, -1, winner, "expected exactly one registration to succeed")
+				winner = i
+				continue
+			}
+			assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			assert.ErrorContains(t, err, service.ErrRegionalSystemL2KeyConflict.Error())
+		}
+		require.NotEqual(t, -1, winner)
+
+		actSys, err := getRegionalSystem(t, ctx, subj, externalID, allowedSystemRegion, allowedSystemType)
+		require.NoError(t, err)
+		assert.Equal(t, reqs[winner].GetL2KeyId(), actSys.GetL2KeyId())
+	})
+
+	t.Run("should register the regional system once if registered with the same L2 key", func(t *testing.T) {
+		// given
+		req := validRegisterSystemReq()
+		reqs := make([]*systemgrpc.RegisterSystemRequest, 0, registrations)
+		for range registrations {
+			reqs = append(reqs, req)
+		}
+		defer func() {
+			assert.NoError(t, deleteSystem(ctx, subj, req.GetExternalId(), req.GetType(), req.GetRegion()))
+		}()
+
+		// when
+		errs := registerConcurrently(reqs)
+
+		// then
+		succeeded := 0
+		for _, err := range errs {
+			if err == nil {
+				succeeded++
+				continue
+			}
+			assert.Equal(t, codes.AlreadyExists, status.Code(err))
+		}
+		assert.Equal(t, 1, succeeded)
+	})
+}
+
 func listSystems(ctx context.Context, subj systemgrpc.ServiceClient, tenantID string) (*systemgrpc.ListSystemsResponse, error) {
 	req := &systemgrpc.ListSystemsRequest{}
 	if tenantID != "" {
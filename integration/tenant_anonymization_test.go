@@ -0,0 +1,98 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestTenantAnonymization(t *testing.T) {
+	// given
+	testCtx := newTenantTestContext(t)
+	db := testCtx.db
+	ctx := t.Context()
+
+	newTerminatedTenant := func(t *testing.T, terminatedAt time.Time) *model.Tenant {
+		t.Helper()
+		tenant := validTenant()
+		tenant.Name = validRandID()
+		tenant.Status = model.TenantStatus(tenantgrpc.Status_STATUS_TERMINATED.String())
+		tenant.StatusUpdatedAt = terminatedAt
+		tenant.Contacts = model.TenantContacts{TechnicalContact: "admin@example.org"}
+		tenant.Labels = map[string]string{"owner-mail": "admin@example.org", "env": "prod"}
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+		t.Cleanup(func() {
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+		return tenant
+	}
+
+	expired := newTerminatedTenant(t, time.Now().Add(-48*time.Hour))
+	expiredEarlier := newTerminatedTenant(t, time.Now().Add(-72*time.Hour))
+	retained := newTerminatedTenant(t, time.Now())
+
+	subj := service.NewTenantAnonymization(testCtx.repo, config.TenantAnonymization{
+		Enabled:       true,
+		Retention:     24 * time.Hour,
+		CheckInterval: 50 * time.Millisecond,
+		Fields:        []string{config.AnonymizedFieldOwnerID, config.AnonymizedFieldContacts},
+		LabelKeys:     []string{"owner-mail"},
+	}, config.TableScan{PageSize: 1}) // a page per tenant, so the scan resumes across pages
+
+	// when
+	subj.Start(ctx)
+
+	// then
+	assert.Eventually(t, func() bool {
+		tenant := &model.Tenant{ID: expired.ID}
+		found, err := testCtx.repo.Find(ctx, tenant)
+		return err == nil && found && tenant.Anonymized
+	}, 5*time.Second, 50*time.Millisecond, "the tenant terminated longer than the retention must be anonymized")
+
+	assert.Eventually(t, func() bool {
+		tenant := &model.Tenant{ID: expiredEarlier.ID}
+		found, err := testCtx.repo.Find(ctx, tenant)
+		return err == nil && found && tenant.Anonymized
+	}, 5*time.Second, 50*time.Millisecond, "the tenants of all pages must be anonymized")
+
+	tenant := &model.Tenant{ID: expired.ID}
+	_, err := testCtx.repo.Find(ctx, tenant)
+	require.NoError(t, err)
+	assert.Empty(t, tenant.OwnerID)
+	assert.False(t, tenant.Contacts.HasContacts())
+	assert.Equal(t, map[string]string{"env": "prod"}, tenant.Labels)
+	assert.Equal(t, expired.OwnerType, tenant.OwnerType)
+
+	tenant = &model.Tenant{ID: retained.ID}
+	_, err = testCtx.repo.Find(ctx, tenant)
+	require.NoError(t, err)
+	assert.False(t, tenant.Anonymized)
+	assert.Equal(t, retained.OwnerID, tenant.OwnerID)
+
+	t.Run("should not match anonymized tenants by their owner", func(t *testing.T) {
+		// when
+		_, err := testCtx.tenantClient.ListTenants(ctx, &tenantgrpc.ListTenantsRequest{Name: expired.Name, OwnerType: expired.OwnerType})
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+
+		// when
+		resp, err := testCtx.tenantClient.ListTenants(ctx, &tenantgrpc.ListTenantsRequest{Name: retained.Name, OwnerType: retained.OwnerType})
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, resp.GetTenants(), 1)
+	})
+}
@@ -0,0 +1,106 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/integration/operatortest"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestTenantBlockExpiry(t *testing.T) {
+	// given
+	testCtx := newTenantTestContext(t)
+	subj := testCtx.tenantClient
+	db := testCtx.db
+	ctx := t.Context()
+
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+	extSubj := extensiongrpc.NewTenantServiceClient(conn)
+
+	operator, err := operatortest.New(ctx)
+	require.NoError(t, err)
+
+	go operator.ListenAndRespond(ctx)
+
+	t.Run("should reject blocks which do not expire in the future", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+		t.Cleanup(func() {
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+
+		for name, req := range map[string]*extensiongrpc.BlockTenantUntilRequest{
+			"without expiry": {Id: tenant.ID},
+			"in the past":    {Id: tenant.ID, Until: timestamppb.New(time.Now().Add(-time.Hour))},
+			"both":           {Id: tenant.ID, Until: timestamppb.New(time.Now().Add(time.Hour)), Duration: durationpb.New(time.Hour)},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// when
+				resp, err := extSubj.BlockTenantUntil(ctx, req)
+
+				// then
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Nil(t, resp)
+			})
+		}
+	})
+
+	t.Run("should unblock the tenant once its block expires", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		tenant.ID = operatortest.TenantIDSuccess
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+		t.Cleanup(func() {
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+
+		// when
+		resp, err := extSubj.BlockTenantUntil(ctx, &extensiongrpc.BlockTenantUntilRequest{
+			Id:       tenant.ID,
+			Duration: durationpb.New(3 * time.Second),
+		})
+
+		// then
+		require.NoError(t, err)
+		blockedUntil := resp.GetBlockedUntil().AsTime()
+		assert.WithinDuration(t, time.Now().Add(3*time.Second), blockedUntil, 2*time.Second)
+
+		var header metadata.MD
+		_, err = subj.GetTenant(ctx, &tenantgrpc.GetTenantRequest{Id: tenant.ID}, grpc.Header(&header))
+		require.NoError(t, err)
+		assert.Equal(t, []string{blockedUntil.Format(time.RFC3339)}, header.Get(service.BlockedUntilHeaderKey))
+
+		block, err := extSubj.GetTenantBlock(ctx, &extensiongrpc.GetTenantBlockRequest{Id: tenant.ID})
+		require.NoError(t, err)
+		assert.True(t, blockedUntil.Equal(block.GetBlockedUntil().AsTime()))
+
+		err = waitForTenantReconciliation(ctx, subj, tenant.ID, func(t *tenantgrpc.Tenant) bool {
+			return t.GetStatus() == tenantgrpc.Status_STATUS_ACTIVE
+		})
+		require.NoError(t, err)
+
+		block, err = extSubj.GetTenantBlock(ctx, &extensiongrpc.GetTenantBlockRequest{Id: tenant.ID})
+		require.NoError(t, err)
+		assert.Nil(t, block.GetBlockedUntil())
+	})
+}
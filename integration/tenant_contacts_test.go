@@ -0,0 +1,88 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+)
+
+func TestTenantContacts(t *testing.T) {
+	// given
+	testCtx := newTenantTestContext(t)
+	db := testCtx.db
+	ctx := t.Context()
+
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+	subj := extensiongrpc.NewTenantServiceClient(conn)
+
+	t.Run("should set and get the contacts of the tenant", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+		t.Cleanup(func() {
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+		contacts := &extensiongrpc.TenantContacts{
+			TechnicalContact:  "tech@example.com",
+			SecurityContact:   "security@example.com",
+			EscalationChannel: "https://chat.example.com/channels/escalation",
+		}
+
+		// when
+		resp, err := subj.SetTenantContacts(ctx, &extensiongrpc.SetTenantContactsRequest{TenantId: tenant.ID, Contacts: contacts})
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, resp.GetSuccess())
+
+		got, err := subj.GetTenantContacts(ctx, &extensiongrpc.GetTenantContactsRequest{TenantId: tenant.ID})
+		require.NoError(t, err)
+		assert.Equal(t, contacts.GetTechnicalContact(), got.GetContacts().GetTechnicalContact())
+		assert.Equal(t, contacts.GetSecurityContact(), got.GetContacts().GetSecurityContact())
+		assert.Equal(t, contacts.GetEscalationChannel(), got.GetContacts().GetEscalationChannel())
+	})
+
+	t.Run("should reject invalid contacts", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+		t.Cleanup(func() {
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+
+		for name, contacts := range map[string]*extensiongrpc.TenantContacts{
+			"without contacts":           {},
+			"invalid security contact":   {SecurityContact: "security team"},
+			"invalid escalation channel": {EscalationChannel: "#escalation"},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// when
+				resp, err := subj.SetTenantContacts(ctx, &extensiongrpc.SetTenantContactsRequest{TenantId: tenant.ID, Contacts: contacts})
+
+				// then
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Nil(t, resp)
+			})
+		}
+	})
+
+	t.Run("should return NotFound for an unknown tenant", func(t *testing.T) {
+		// when
+		resp, err := subj.GetTenantContacts(ctx, &extensiongrpc.GetTenantContactsRequest{TenantId: "unknown-tenant"})
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+		assert.Nil(t, resp)
+	})
+}
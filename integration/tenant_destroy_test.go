@@ -0,0 +1,78 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestDestroyTenant(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	subj := service.NewTenantDestroyer(repo, nil, config.TenantDestroy{Enabled: true})
+
+	tenant := validTenant()
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+
+	auth := validAuth()
+	auth.TenantID = tenant.ID
+	require.NoError(t, repo.Create(ctx, auth))
+
+	system := model.NewSystem(validRandID(), allowedSystemType)
+	system.LinkTenant(tenant.ID)
+	require.NoError(t, createSystemInDB(ctx, db, system))
+	t.Cleanup(func() {
+		_ = deleteSystemInDB(ctx, db, system.ExternalID, system.Type)
+	})
+
+	t.Run("should destroy the tenant and everything attached", func(t *testing.T) {
+		// when
+		destroyed, err := subj.DestroyTenant(ctx, tenant.ID)
+
+		// then
+		require.NoError(t, err)
+
+		assert.Equal(t, "tenants", destroyed.Resource)
+		assert.Equal(t, []string{tenant.ID}, destroyed.Keys)
+
+		keys := make(map[string][]string, len(destroyed.Dependents))
+		for _, dependent := range destroyed.Dependents {
+			keys[dependent.Resource] = dependent.Keys
+		}
+		assert.Equal(t, []string{auth.ExternalID}, keys["auths"])
+		assert.Equal(t, []string{system.ExternalID + "/" + system.Type}, keys["systems"])
+
+		found, err := repo.Find(ctx, &model.Tenant{ID: tenant.ID})
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		found, err = repo.Find(ctx, &model.Auth{ExternalID: auth.ExternalID})
+		require.NoError(t, err)
+		assert.False(t, found)
+
+		unlinked, err := getSystemFromDB(ctx, db, system.ExternalID, system.Type)
+		require.NoError(t, err)
+		assert.False(t, unlinked.IsLinkedToTenant())
+		assert.Nil(t, unlinked.TenantID)
+	})
+
+	t.Run("should return error for unknown tenant", func(t *testing.T) {
+		// when
+		_, err := subj.DestroyTenant(ctx, validRandID())
+
+		// then
+		assert.ErrorIs(t, err, service.ErrTenantNotFound)
+	})
+}
@@ -0,0 +1,100 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestTenantImport(t *testing.T) {
+	// given
+	ctx := repository.WithCaller(t.Context(), "spiffe://example.org/migration")
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.Tenant{}},
+	})
+	require.NoError(t, err)
+
+	tenants := service.NewTenant(repo, nil, nil, v, nil, nil, nil, service.NewLabels(v, config.Labels{}), nil, nil, nil)
+	subj := service.NewTenantImports(repo, tenants, config.TenantImport{Enabled: true})
+
+	createdAt := time.Now().Add(-365 * 24 * time.Hour).UTC().Truncate(time.Microsecond)
+	blockedAt := createdAt.Add(30 * 24 * time.Hour)
+
+	t.Run("should import the tenant with its creation time and status history", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		tenant.Status = model.TenantStatus(tenantgrpc.Status_STATUS_BLOCKED.String())
+		tenant.CreatedAt = createdAt
+		history := []model.TenantStatusChange{
+			{Status: model.TenantStatus(tenantgrpc.Status_STATUS_ACTIVE.String()), ChangedAt: createdAt},
+			{Status: model.TenantStatus(tenantgrpc.Status_STATUS_BLOCKED.String()), ChangedAt: blockedAt},
+		}
+		t.Cleanup(func() {
+			db.Where("tenant_id = ?", tenant.ID).Delete(&model.TenantStatusChange{})
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+
+		// when
+		err := subj.ImportTenant(ctx, tenant, history, "legacy migration")
+
+		// then
+		require.NoError(t, err)
+
+		imported := &model.Tenant{ID: tenant.ID}
+		found, err := repo.Find(ctx, imported)
+		require.NoError(t, err)
+		require.True(t, found)
+		assert.Equal(t, tenant.Status, imported.Status)
+		assert.True(t, createdAt.Equal(imported.CreatedAt))
+		assert.True(t, blockedAt.Equal(imported.StatusUpdatedAt))
+
+		var changes []model.TenantStatusChange
+		require.NoError(t, db.Where("tenant_id = ?", tenant.ID).Order("changed_at").Find(&changes).Error)
+		require.Len(t, changes, 2)
+		assert.Equal(t, history[0].Status, changes[0].Status)
+		assert.Equal(t, history[1].Status, changes[1].Status)
+		assert.Equal(t, "spiffe://example.org/migration", changes[1].CreatedBy)
+
+		var jobs int64
+		require.NoError(t, db.Table("jobs").Where("external_id = ?", tenant.ID).Count(&jobs).Error)
+		assert.Zero(t, jobs)
+	})
+
+	t.Run("should not import an existing tenant", func(t *testing.T) {
+		// given
+		tenant := validTenant()
+		require.NoError(t, createTenantInDB(ctx, db, tenant))
+		t.Cleanup(func() {
+			_ = deleteTenantFromDB(ctx, db, tenant)
+		})
+
+		imported := validTenant()
+		imported.ID = tenant.ID
+		imported.CreatedAt = createdAt
+
+		// when
+		err := subj.ImportTenant(ctx, imported, nil, "")
+
+		// then
+		assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	})
+}
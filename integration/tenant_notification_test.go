@@ -0,0 +1,86 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+)
+
+func TestTenantNotificationPreferences(t *testing.T) {
+	// given
+	testCtx := newTenantTestContext(t)
+	db := testCtx.db
+	ctx := t.Context()
+
+	conn, err := newGRPCClientConn()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		conn.Close()
+	})
+	subj := extensiongrpc.NewTenantServiceClient(conn)
+
+	tenant := validTenant()
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+	t.Cleanup(func() {
+		_ = deleteTenantFromDB(ctx, db, tenant)
+	})
+
+	t.Run("should set and get the notification preferences of the tenant", func(t *testing.T) {
+		// when
+		_, err := subj.SetTenantNotificationPreferences(ctx, &extensiongrpc.SetTenantNotificationPreferencesRequest{
+			TenantId: tenant.ID,
+			Events:   []string{"TENANT_TERMINATED", "TENANT_BLOCKED"},
+			Channels: []string{"mailto:admins@example.org", "https://hooks.example.org/tenant"},
+		})
+		require.NoError(t, err)
+
+		resp, err := subj.GetTenantNotificationPreferences(ctx, &extensiongrpc.GetTenantNotificationPreferencesRequest{TenantId: tenant.ID})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, []string{"TENANT_BLOCKED", "TENANT_TERMINATED"}, resp.GetPreferences().GetEvents())
+		assert.Equal(t, []string{"https://hooks.example.org/tenant", "mailto:admins@example.org"}, resp.GetPreferences().GetChannels())
+	})
+
+	t.Run("should reject invalid channels", func(t *testing.T) {
+		// when
+		_, err := subj.SetTenantNotificationPreferences(ctx, &extensiongrpc.SetTenantNotificationPreferencesRequest{
+			TenantId: tenant.ID,
+			Events:   []string{"TENANT_BLOCKED"},
+			Channels: []string{"sms:+49123456"},
+		})
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+
+	t.Run("should remove the preferences without events", func(t *testing.T) {
+		// when
+		_, err := subj.SetTenantNotificationPreferences(ctx, &extensiongrpc.SetTenantNotificationPreferencesRequest{TenantId: tenant.ID})
+		require.NoError(t, err)
+
+		_, err = subj.GetTenantNotificationPreferences(ctx, &extensiongrpc.GetTenantNotificationPreferencesRequest{TenantId: tenant.ID})
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("should reject preferences of unknown tenants", func(t *testing.T) {
+		// when
+		_, err := subj.SetTenantNotificationPreferences(ctx, &extensiongrpc.SetTenantNotificationPreferencesRequest{
+			TenantId: validRandID(),
+			Events:   []string{"TENANT_BLOCKED"},
+			Channels: []string{"mailto:admins@example.org"},
+		})
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -0,0 +1,52 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/integration/operatortest"
+	"github.com/openkcm/registry/internal/model"
+)
+
+func TestTenantRegionRouting(t *testing.T) {
+	// given
+	testCtx := newTenantTestContext(t)
+	subj := testCtx.tenantClient
+	db := testCtx.db
+	ctx := t.Context()
+
+	harness, err := operatortest.NewHarness(ctx, map[string]operatortest.Behavior{
+		operatortest.SecondRegion: {Latency: 100 * time.Millisecond, FailureRate: 1},
+	})
+	require.NoError(t, err)
+
+	harness.ListenAndRespond(ctx)
+
+	t.Run("should route the tenant to the operator of its region", func(t *testing.T) {
+		// given
+		req := validRegisterTenantReq()
+		req.Region = operatortest.SecondRegion
+
+		// when
+		_, err := subj.RegisterTenant(ctx, req)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			err := deleteTenantFromDB(ctx, db, &model.Tenant{ID: req.GetId()})
+			assert.NoError(t, err)
+		})
+
+		// then
+		err = waitForTenantReconciliation(ctx, subj, req.GetId(), func(t *tenantgrpc.Tenant) bool {
+			return t.GetStatus() == tenantgrpc.Status_STATUS_PROVISIONING_ERROR
+		})
+		require.NoError(t, err)
+		assert.Contains(t, harness.TenantIDs(operatortest.SecondRegion), req.GetId())
+	})
+}
@@ -0,0 +1,112 @@
+//go:build integration
+
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository/sql"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestTenantUserGroups(t *testing.T) {
+	// given
+	ctx := t.Context()
+	db, err := startDB()
+	require.NoError(t, err)
+	repo := sql.NewRepository(db)
+
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.Tenant{}},
+	})
+	require.NoError(t, err)
+
+	subj := service.NewTenantUserGroup(repo, v)
+
+	tenant := validTenant()
+	tenant.UserGroups = []string{}
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+	t.Cleanup(func() {
+		db.Where("tenant_id = ?", tenant.ID).Delete(&model.TenantUserGroup{})
+		db.Where("tenant_id = ?", tenant.ID).Delete(&model.Auth{})
+		_ = deleteTenantFromDB(ctx, db, tenant)
+	})
+
+	t.Run("should create, update and delete a group", func(t *testing.T) {
+		// when
+		err := subj.CreateTenantUserGroup(ctx, &model.TenantUserGroup{
+			TenantID:    tenant.ID,
+			Name:        "admins",
+			Description: "administrators",
+			SourceIdP:   "idp",
+		})
+
+		// then
+		require.NoError(t, err)
+
+		stored := &model.Tenant{ID: tenant.ID}
+		_, err = repo.Find(ctx, stored)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"admins"}, stored.UserGroups)
+
+		// when
+		err = subj.UpdateTenantUserGroup(ctx, tenant.ID, "admins", "tenant administrators", "")
+
+		// then
+		require.NoError(t, err)
+
+		group, err := subj.GetTenantUserGroup(ctx, tenant.ID, "admins")
+		require.NoError(t, err)
+		assert.Equal(t, "tenant administrators", group.Description)
+		assert.Equal(t, "idp", group.SourceIdP)
+
+		// when
+		err = subj.DeleteTenantUserGroup(ctx, tenant.ID, "admins")
+
+		// then
+		require.NoError(t, err)
+
+		groups, err := subj.ListTenantUserGroups(ctx, tenant.ID)
+		require.NoError(t, err)
+		assert.Empty(t, groups)
+
+		stored = &model.Tenant{ID: tenant.ID}
+		_, err = repo.Find(ctx, stored)
+		require.NoError(t, err)
+		assert.Empty(t, stored.UserGroups)
+	})
+
+	t.Run("should not delete a group required by an auth", func(t *testing.T) {
+		// given
+		require.NoError(t, subj.CreateTenantUserGroup(ctx, &model.TenantUserGroup{TenantID: tenant.ID, Name: "auditors"}))
+
+		auth := validAuth()
+		auth.TenantID = tenant.ID
+		auth.RequiredUserGroups = []string{"auditors"}
+		require.NoError(t, repo.Create(ctx, auth))
+
+		// when
+		err := subj.DeleteTenantUserGroup(ctx, tenant.ID, "auditors")
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+		_, err = subj.GetTenantUserGroup(ctx, tenant.ID, "auditors")
+		assert.NoError(t, err)
+	})
+
+	t.Run("should not get an unknown group", func(t *testing.T) {
+		// when
+		_, err := subj.GetTenantUserGroup(ctx, tenant.ID, "unknown")
+
+		// then
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+}
@@ -10,9 +10,9 @@ import (
 	"testing"
 	"time"
 
-	"github.com/gofrs/uuid/v5"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
 
 	"github.com/openkcm/registry/internal/model"
 	"github.com/openkcm/registry/internal/repository"
@@ -84,9 +84,7 @@ func TestExecuteTransactionError(t *testing.T) {
 		assert.Error(t, err)
 
 		// given
-		newTenantIDUUID, err := uuid.NewV4()
-		require.NoError(t, err)
-		newTenantID := newTenantIDUUID.String()
+		newTenantID := persistTenantID(t, ctx, db)
 
 		// when
 		err = subj.Transaction(ctx,
@@ -146,9 +144,7 @@ func TestExecuteTransactionRaceConditions(t *testing.T) {
 		wg := sync.WaitGroup{}
 		wg.Add(2)
 
-		newTenantIDUUID, err := uuid.NewV4()
-		require.NoError(t, err)
-		newTenantID := newTenantIDUUID.String()
+		newTenantID := persistTenantID(t, ctx, db)
 		go func() {
 			assert.Equal(t, "1st transaction start", <-transactor1)
 			defer wg.Done()
@@ -216,9 +212,7 @@ func TestExecuteTransactionRaceConditions(t *testing.T) {
 		wg.Add(2)
 
 		// when
-		newTenantID1UUID, err := uuid.NewV4()
-		require.NoError(t, err)
-		newTenantID1 := newTenantID1UUID.String()
+		newTenantID1 := persistTenantID(t, ctx, db)
 		go func() {
 			assert.Equal(t, "1st transaction start", <-transactor1)
 			defer wg.Done()
@@ -244,9 +238,7 @@ func TestExecuteTransactionRaceConditions(t *testing.T) {
 			assert.NoError(t, err)
 		}()
 
-		newTenantID2UUID, err := uuid.NewV4()
-		require.NoError(t, err)
-		newTenantID2 := newTenantID2UUID.String()
+		newTenantID2 := persistTenantID(t, ctx, db)
 		go func() {
 			assert.Equal(t, "2nd transaction start", <-transactor2)
 			defer wg.Done()
@@ -326,9 +318,7 @@ func TestExecuteTransactionWithoutRaceConditions(t *testing.T) {
 		wg.Add(2)
 
 		// when
-		newTenantID1UUID, err := uuid.NewV4()
-		require.NoError(t, err)
-		newTenantID1 := newTenantID1UUID.String()
+		newTenantID1 := persistTenantID(t, ctx, db)
 		go func() {
 			assert.Equal(t, "1st transaction start", <-transactor1)
 			defer wg.Done()
@@ -354,9 +344,7 @@ func TestExecuteTransactionWithoutRaceConditions(t *testing.T) {
 			transactor1 <- "1st transaction finish"
 		}()
 
-		newTenantID2UUID, err := uuid.NewV4()
-		require.NoError(t, err)
-		newTenantID2 := newTenantID2UUID.String()
+		newTenantID2 := persistTenantID(t, ctx, db)
 		go func() {
 			assert.Equal(t, "2nd transaction start", <-transactor2)
 			defer wg.Done()
@@ -412,3 +400,67 @@ func TestExecuteTransactionWithoutRaceConditions(t *testing.T) {
 		assert.Equal(t, newTenantID2, *actSys2.TenantID)
 	})
 }
+
+func TestExecuteTransactionPreCommit(t *testing.T) {
+	// given
+	db, err := startDB()
+	require.NoError(t, err)
+	subj := sql.NewRepository(db)
+
+	t.Run("should roll back the transaction if the pre-commit function fails", func(t *testing.T) {
+		// given
+		system := model.NewSystem(validRandID(), allowedSystemType)
+		ctx := repository.WithPreCommit(t.Context(), func(context.Context) error {
+			return errSomething
+		})
+
+		// when
+		err := subj.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
+			return r.Create(ctx, system)
+		})
+
+		// then
+		assert.ErrorIs(t, err, errSomething)
+		found, err := subj.Find(t.Context(), &model.System{ID: system.ID})
+		assert.NoError(t, err)
+		assert.False(t, found)
+	})
+
+	t.Run("should call the pre-commit function once for nested transactions", func(t *testing.T) {
+		// given
+		system := model.NewSystem(validRandID(), allowedSystemType)
+		defer db.Delete(system)
+		calls := 0
+		ctx := repository.WithPreCommit(t.Context(), func(context.Context) error {
+			calls++
+			return nil
+		})
+
+		// when
+		err := subj.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
+			return r.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
+				return r.Create(ctx, system)
+			})
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 1, calls)
+		found, err := subj.Find(t.Context(), &model.System{ID: system.ID})
+		assert.NoError(t, err)
+		assert.True(t, found)
+	})
+}
+
+// persistTenantID creates a tenant the systems can be linked to and returns its ID.
+func persistTenantID(t *testing.T, ctx context.Context, db *gorm.DB) string {
+	t.Helper()
+
+	tenant := validTenant()
+	require.NoError(t, createTenantInDB(ctx, db, tenant))
+	t.Cleanup(func() {
+		_ = deleteTenantFromDB(ctx, db, tenant)
+	})
+
+	return tenant.ID
+}
@@ -0,0 +1,228 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"gopkg.in/yaml.v3"
+)
+
+// ReadRawConfig reads the config file as generic YAML, e.g. to check it for unknown keys.
+func ReadRawConfig(path string) (map[string]any, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	raw := map[string]any{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+
+	return raw, nil
+}
+
+// CheckUnknownKeys returns ErrUnknownConfigKeys listing the keys of the raw config which do not match a field
+// of the Config, e.g. misspelled keys, which are otherwise ignored and leave the field at its default.
+// Keys are matched case-insensitively, like the config loader does.
+func CheckUnknownKeys(raw map[string]any) error {
+	var unknown []string
+	collectUnknownKeys(reflect.TypeFor[Config](), raw, "", &unknown)
+
+	if len(unknown) == 0 {
+		return nil
+	}
+
+	slices.Sort(unknown)
+
+	return fmt.Errorf("%w: %s", ErrUnknownConfigKeys, strings.Join(unknown, ", "))
+}
+
+// CheckReferences resolves the source references of the secrets and checks that the files of the mTLS
+// connections exist, which the server otherwise only does when it first uses them.
+func (c *Config) CheckReferences() error {
+	var errs []error
+
+	for name, ref := range map[string]commoncfg.SourceRef{
+		"database.user":     c.Database.User,
+		"database.password": c.Database.Password,
+	} {
+		if _, err := commoncfg.LoadValueFromSourceRef(ref); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", name, err))
+		}
+	}
+
+	if c.OwnerIDEncryption.Enabled {
+		for _, key := range c.OwnerIDEncryption.Keys {
+			if _, err := commoncfg.LoadValueFromSourceRef(key.Key); err != nil {
+				errs = append(errs, fmt.Errorf("ownerIdEncryption key version %d: %w", key.Version, err))
+			}
+		}
+	}
+
+	for _, target := range c.Orbital.Targets {
+		if err := target.Connection.checkFiles(); err != nil {
+			errs = append(errs, fmt.Errorf("orbital target %s: %w", target.Region, err))
+		}
+	}
+
+	if c.SystemDiscovery.Enabled {
+		if err := c.SystemDiscovery.Connection.checkFiles(); err != nil {
+			errs = append(errs, fmt.Errorf("system discovery: %w", err))
+		}
+	}
+
+	if c.Hooks.Enabled {
+		for _, callout := range c.Hooks.Callouts {
+			if err := callout.Auth.checkFiles(); err != nil {
+				errs = append(errs, fmt.Errorf("hook %s: %w", callout.Name, err))
+			}
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// checkFiles returns an error for each file of the mTLS authentication of the connection which does not exist.
+func (c *Connection) checkFiles() error {
+	if c == nil {
+		return nil
+	}
+
+	return c.Auth.checkFiles()
+}
+
+// checkFiles returns an error for each file of the mTLS authentication which does not exist.
+func (a *Auth) checkFiles() error {
+	if a.Type != AuthTypeMTLS || a.MTLS == nil {
+		return nil
+	}
+
+	var errs []error
+
+	for _, file := range []string{a.MTLS.CAFile, a.MTLS.CertFile, a.MTLS.KeyFile} {
+		if _, err := os.Stat(file); err != nil {
+			errs = append(errs, fmt.Errorf("%w: %s", ErrConfigFileMissing, file))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// collectUnknownKeys appends the paths of the keys of value which do not match a field of the type t.
+func collectUnknownKeys(t reflect.Type, value any, path string, unknown *[]string) {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	switch t.Kind() { //nolint:exhaustive
+	case reflect.Struct:
+		values, ok := value.(map[string]any)
+		if !ok || t.PkgPath() == "time" {
+			return
+		}
+
+		fields := configFields(t)
+		for key, v := range values {
+			field, ok := fields[strings.ToLower(key)]
+			if !ok {
+				*unknown = append(*unknown, keyPath(path, key))
+				continue
+			}
+
+			collectUnknownKeys(field, v, keyPath(path, key), unknown)
+		}
+	case reflect.Map:
+		values, ok := value.(map[string]any)
+		if !ok {
+			return
+		}
+
+		for key, v := range values {
+			collectUnknownKeys(t.Elem(), v, keyPath(path, key), unknown)
+		}
+	case reflect.Slice, reflect.Array:
+		items, ok := value.([]any)
+		if !ok {
+			return
+		}
+
+		for i, item := range items {
+			collectUnknownKeys(t.Elem(), item, fmt.Sprintf("%s[%d]", path, i), unknown)
+		}
+	}
+}
+
+// configFields returns the types of the fields of the struct type t by their lower-cased key,
+// with the fields of embedded and squashed structs inlined.
+func configFields(t reflect.Type) map[string]reflect.Type {
+	indexes := configFieldIndexes(t)
+	fields := make(map[string]reflect.Type, len(indexes))
+
+	for name, index := range indexes {
+		fields[strings.ToLower(name)] = t.FieldByIndex(index).Type
+	}
+
+	return fields
+}
+
+// configFieldIndexes returns the indexes of the fields of the struct type t by their key,
+// with the fields of embedded and squashed structs inlined.
+func configFieldIndexes(t reflect.Type) map[string][]int {
+	fields := make(map[string][]int, t.NumField())
+
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		name, _, _ := strings.Cut(field.Tag.Get("yaml"), ",")
+		squash := strings.Contains(field.Tag.Get("mapstructure"), "squash") ||
+			strings.Contains(field.Tag.Get("yaml"), "inline")
+
+		if squash || (field.Anonymous && name == "") {
+			fieldType := field.Type
+			for fieldType.Kind() == reflect.Pointer {
+				fieldType = fieldType.Elem()
+			}
+
+			if fieldType.Kind() == reflect.Struct {
+				for key, inlined := range configFieldIndexes(fieldType) {
+					fields[key] = append([]int{i}, inlined...)
+				}
+
+				continue
+			}
+		}
+
+		if name == "-" {
+			continue
+		}
+
+		if name == "" {
+			name, _, _ = strings.Cut(field.Tag.Get("mapstructure"), ",")
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		fields[name] = []int{i}
+	}
+
+	return fields
+}
+
+func keyPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
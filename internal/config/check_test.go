@@ -0,0 +1,118 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+func TestCheckUnknownKeys(t *testing.T) {
+	tests := []struct {
+		name       string
+		yaml       string
+		expUnknown []string
+	}{
+		{
+			name: "known keys",
+			yaml: `
+database:
+  host: localhost
+  slowOperationThreshold: 1s
+admin:
+  enabled: true
+  callers: [spiffe://example.org/operator]
+orbital:
+  targets:
+    - region: eu10
+      connection:
+        type: amqp
+validations:
+  - id: System.Type
+    constraints:
+      - type: list
+`,
+		},
+		{
+			name: "keys matched case-insensitively",
+			yaml: `
+Database:
+  HOST: localhost
+`,
+		},
+		{
+			name: "misspelled keys",
+			yaml: `
+admin:
+  enabeld: true
+orbital:
+  targets:
+    - regoin: eu10
+databse:
+  host: localhost
+`,
+			expUnknown: []string{"admin.enabeld", "databse", "orbital.targets[0].regoin"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			file := filepath.Join(t.TempDir(), "config.yaml")
+			require.NoError(t, os.WriteFile(file, []byte(tt.yaml), 0o600))
+
+			raw, err := config.ReadRawConfig(file)
+			require.NoError(t, err)
+
+			// when
+			err = config.CheckUnknownKeys(raw)
+
+			// then
+			if len(tt.expUnknown) == 0 {
+				assert.NoError(t, err)
+				return
+			}
+
+			assert.ErrorIs(t, err, config.ErrUnknownConfigKeys)
+			for _, key := range tt.expUnknown {
+				assert.ErrorContains(t, err, key)
+			}
+		})
+	}
+}
+
+func TestCheckReferences(t *testing.T) {
+	t.Run("should report missing mTLS files of orbital targets", func(t *testing.T) {
+		// given
+		existing := filepath.Join(t.TempDir(), "ca.pem")
+		require.NoError(t, os.WriteFile(existing, []byte("ca"), 0o600))
+
+		cfg := config.Config{
+			Orbital: config.Orbital{
+				Targets: []config.Target{{
+					Region: "eu10",
+					Connection: &config.Connection{
+						Type: config.ConnectionTypeAMQP,
+						Auth: config.Auth{
+							Type: config.AuthTypeMTLS,
+							MTLS: &config.MTLS{CAFile: existing, CertFile: "/missing/cert.pem", KeyFile: "/missing/key.pem"},
+						},
+					},
+				}},
+			},
+		}
+
+		// when
+		err := cfg.CheckReferences()
+
+		// then
+		assert.ErrorIs(t, err, config.ErrConfigFileMissing)
+		assert.ErrorContains(t, err, "/missing/cert.pem")
+		assert.ErrorContains(t, err, "/missing/key.pem")
+		assert.NotContains(t, err.Error(), existing)
+	})
+}
@@ -3,16 +3,28 @@ package config
 import (
 	"errors"
 	"fmt"
+	"path"
+	"regexp"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/openkcm/common-sdk/pkg/commoncfg"
 
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
 	"github.com/openkcm/registry/internal/validation"
 )
 
 type (
 	ConnectionType string
 	AuthType       string
+	TenantIDFormat string
+	LabelConflict  string
+	Profile        string
 )
 
 const (
@@ -24,6 +36,24 @@ const (
 	AuthTypeNone AuthType = "none"
 )
 
+const (
+	TenantIDFormatAny      TenantIDFormat = ""
+	TenantIDFormatUUIDv7   TenantIDFormat = "uuidv7"
+	TenantIDFormatPrefixed TenantIDFormat = "prefixed"
+)
+
+const (
+	LabelConflictKeepSystem LabelConflict = "keepSystem"
+	LabelConflictOverwrite  LabelConflict = "overwrite"
+)
+
+const (
+	// ProfileFull serves all procedure calls and runs the background jobs.
+	ProfileFull Profile = "full"
+	// ProfileReadOnly only serves the read calls, e.g. for read-scaling replicas near the consumers.
+	ProfileReadOnly Profile = "readOnly"
+)
+
 const (
 	WorkerNameConfirmJob  = "confirm-job"
 	WorkerNameCreateTask  = "create-task"
@@ -47,17 +77,160 @@ var (
 	ErrEmptyCertFile = errors.New("certificate file must not be empty")
 	ErrEmptyKeyFile  = errors.New("key file must not be empty")
 
-	ErrEmptyWorkerName                      = errors.New("worker name must not be empty")
-	ErrExecIntervalMustBeGreaterThanZero    = errors.New("worker exec interval must be greater than zero")
-	ErrUnsupportedWorkerName                = errors.New("worker name is not supported, please use one of the predefined worker names (confirm-job, create-task, reconcile, notify-event)")
-	ErrNumberOfWorkersMustBeGreaterThanZero = errors.New("number of workers must be greater than zero")
-	ErrTimeoutMustBeGreaterThanZero         = errors.New("timeout must be greater than zero")
+	ErrEmptyWorkerName                       = errors.New("worker name must not be empty")
+	ErrExecIntervalMustBeGreaterThanZero     = errors.New("worker exec interval must be greater than zero")
+	ErrUnsupportedWorkerName                 = errors.New("worker name is not supported, please use one of the predefined worker names (confirm-job, create-task, reconcile, notify-event)")
+	ErrNumberOfWorkersMustBeGreaterThanZero  = errors.New("number of workers must be greater than zero")
+	ErrTimeoutMustBeGreaterThanZero          = errors.New("timeout must be greater than zero")
+	ErrMaxWorkersLessThanNoOfWorkers         = errors.New("max number of workers must not be less than the number of workers")
+	ErrBacklogPerWorkerMustBeGreaterThanZero = errors.New("backlog per worker must be greater than zero when scaling workers")
 
 	ErrConfirmJobAfterMustBeEqualGreaterThanZero = errors.New("confirm job delay must be equal or greater than zero")
 	ErrTaskLimitNumMustBeGreaterThanZero         = errors.New("task limit number must be greater than zero")
 	ErrMaxPendingReconcilesMustBeGreaterThanZero = errors.New("max pending reconcile count must be greater than zero")
 	ErrBackoffBaseIntervalMustBeGreaterThanZero  = errors.New("backoff base interval must be greater than zero")
 	ErrBackoffMaxIntervalMustBeGreaterThanZero   = errors.New("backoff max interval must be greater than zero")
+
+	ErrApprovalWebhookMissing          = errors.New("approval webhook URL must be set when system types require approval")
+	ErrWebhookTimeoutMustNotBeNegative = errors.New("webhook timeout must not be negative")
+	ErrEmptyApprovalSystemType         = errors.New("system type requiring approval must not be empty")
+
+	ErrUnsupportedTenantIDFormat  = errors.New("tenant ID format is not supported, please use one of uuidv7, prefixed")
+	ErrTenantIDGenerationNoFormat = errors.New("tenant ID format must be set when tenant ID generation is enabled")
+	ErrEmptyTenantIDPrefix        = errors.New("tenant ID prefix must not be empty for the prefixed format")
+
+	ErrSnapshotIntervalMustBeGreaterThanZero = errors.New("inventory snapshot interval must be greater than zero")
+
+	ErrRegionCapacityMustBeGreaterThanZero = errors.New("region capacity must be greater than zero")
+	ErrNearCapacityRatioOutOfRange         = errors.New("near capacity ratio must be greater than zero and at most one")
+	ErrSoftLimitNegative                   = errors.New("soft limit must not be negative")
+
+	ErrEmptyOpenAPIAddress = errors.New("OpenAPI address must not be empty")
+	ErrInvalidOpenAPIRoute = errors.New("OpenAPI route must be a path, e.g. /openapi.json")
+
+	ErrActiveEncryptionKeyMissing    = errors.New("active encryption key version must be one of the configured key versions")
+	ErrDuplicateEncryptionKeyVersion = errors.New("encryption key versions must be unique")
+	ErrReencryptBatchSizeNotPositive = errors.New("re-encryption batch size must be greater than zero")
+	ErrTooManyEncryptionKeys         = errors.New("too many encryption key versions, remove keys once no owner ID is encrypted with them")
+
+	ErrInvalidRedactionPattern = errors.New("redaction pattern is not valid")
+	ErrExportChunkSizeInvalid  = errors.New("export chunk size must not be negative or exceed the maximum")
+
+	ErrAuthPropertyThresholdNegative = errors.New("auth property blob threshold must not be negative")
+	ErrAuthPropertyMaxSizeTooSmall   = errors.New("auth property blob max size must not be less than the threshold")
+	ErrAuthPropertyChunkSizeInvalid  = errors.New("auth property chunk size must not be negative or exceed the maximum")
+
+	ErrInvalidPolicyMethod           = errors.New("tenant status policy method must be a full gRPC method name, e.g. /package.Service/Method")
+	ErrDuplicatePolicyMethod         = errors.New("tenant status policy method must only have one rule")
+	ErrPolicyAllowedAndBlocked       = errors.New("tenant status policy rule must either have allowed or blocked statuses")
+	ErrUnsupportedPolicyTenantStatus = errors.New("tenant status of the policy rule is not supported")
+
+	ErrBackfillBatchSizeNotPositive     = errors.New("backfill batch size must be greater than zero")
+	ErrBackfillBatchIntervalNotPositive = errors.New("backfill batch interval must be greater than zero")
+	ErrBackfillBatchTimeoutNotPositive  = errors.New("backfill batch timeout must be greater than zero")
+
+	ErrMaxLabelsNegative        = errors.New("maximum number of labels must not be negative")
+	ErrMaxLabelValueLenNegative = errors.New("maximum label value length must not be negative")
+	ErrEmptyLabelKey            = errors.New("label key must not be empty")
+	ErrEmptyInternalLabelPrefix = errors.New("internal label prefix must not be empty")
+	ErrDefaultLabelsExceedLimit = errors.New("default labels exceed the label limits")
+	ErrUnsupportedLabelConflict = errors.New("label inheritance conflict rule is not supported")
+
+	ErrLinkMetricsIntervalNegative = errors.New("system link metrics recalculation interval must not be negative")
+
+	ErrDiscoveryConnectionMissing = errors.New("system discovery connection must be configured")
+
+	ErrEmptySystemClassification = errors.New("system environments and criticalities must not be empty")
+
+	ErrEmptySystemRelationshipType      = errors.New("system relationship type must not be empty")
+	ErrDuplicateSystemRelationshipType  = errors.New("system relationship type must only be configured once")
+	ErrUnknownExclusiveRelationshipType = errors.New("exclusive system relationship type must be one of the types")
+
+	ErrDBPoolLimitNegative      = errors.New("database pool limits must not be negative")
+	ErrDBPoolIdleExceedsOpen    = errors.New("database pool idle connections must not exceed the open connections")
+	ErrDBPoolTimeoutNegative    = errors.New("database pool timeouts must not be negative")
+	ErrTransactionRetryNegative = errors.New("transaction retry attempts and backoffs must not be negative")
+	ErrCircuitBreakerInvalid    = errors.New("circuit breaker threshold and open duration must be greater than zero")
+	ErrStatusClockSkewNegative  = errors.New("maximum clock skew of the status clock must not be negative")
+	ErrTableScanPageSizeInvalid = errors.New("page size of table scans must be between 0 and 1000")
+	ErrTableScanRateNegative    = errors.New("rows per second of table scans must not be negative")
+
+	ErrDBMaintenanceCheckIntervalNotPositive = errors.New("check interval of the database maintenance must be greater than zero")
+	ErrDBMaintenanceThresholdOutOfRange      = errors.New("database maintenance threshold must be greater than zero and at most one")
+	ErrDBMaintenanceWithoutWindows           = errors.New("scheduled database maintenance requires at least one maintenance window")
+
+	ErrWarmupHotTenantsNegative = errors.New("number of hot tenants to warm up must not be negative")
+	ErrWarmupTimeoutNotPositive = errors.New("warm-up timeout must be greater than zero")
+
+	ErrStartupDurationNegative = errors.New("startup budget, backoffs and timeouts must not be negative")
+
+	ErrCapabilityReadinessTimeoutNotPositive = errors.New("capability readiness timeout must be greater than zero")
+
+	ErrInvalidConcurrencyMethod     = errors.New("concurrency limit method must be a full gRPC method name, e.g. /package.Service/Method")
+	ErrDuplicateConcurrencyMethod   = errors.New("concurrency limit method must only have one limit")
+	ErrConcurrencyLimitNotPositive  = errors.New("maximum number of concurrent requests must be greater than zero")
+	ErrConcurrencyQueueInvalid      = errors.New("queued requests require a positive queue timeout")
+	ErrInvalidCoalescingMethod      = errors.New("coalesced method must be a full gRPC method name, e.g. /package.Service/Method")
+	ErrCoalescingTimeoutNotPositive = errors.New("timeout of coalesced requests must be greater than zero")
+
+	ErrMaxAllowedValuesNegative = errors.New("maximum number of allowed values must not be negative")
+	ErrBatchValidationNegative  = errors.New("limits of the validation of batches must not be negative")
+
+	ErrRegistrationBatchSizeInvalid     = errors.New("registration batch size must be between 1 and 1000")
+	ErrRegistrationFlushIntervalInvalid = errors.New("registration flush interval must be greater than zero")
+	ErrLookupBatchSizeInvalid           = errors.New("lookup batch size must be between 1 and 1000")
+
+	ErrMaintenancePolicyInvalid = errors.New("maintenance policy must be schedule or reject")
+	ErrJobReplayLimitNegative   = errors.New("job replay limits must not be negative")
+
+	ErrLogControlDurationNotPositive = errors.New("maximum duration of a log level change must be greater than zero")
+
+	ErrTenantBlockDurationNegative         = errors.New("maximum duration of a tenant block must not be negative")
+	ErrTenantBlockCheckIntervalNotPositive = errors.New("check interval of tenant blocks must be greater than zero")
+
+	ErrUnsupportedProfile = errors.New("profile is not supported")
+
+	ErrFairnessMaxInFlightNotPositive = errors.New("maximum number of requests in flight must be greater than zero")
+	ErrFairnessQueueInvalid           = errors.New("queued requests of tenants require a positive queue timeout")
+	ErrFairnessMaxWaitNotPositive     = errors.New("maximum wait of queued requests must be greater than zero")
+	ErrFairnessWeightNotPositive      = errors.New("weight of a tenant must be greater than zero")
+
+	ErrTenantNotificationsWebhookMissing = errors.New("tenant notification webhook URL must be set when tenant notifications are enabled")
+
+	ErrTenantAnonymizationRetentionNotPositive     = errors.New("retention of terminated tenants must be greater than zero")
+	ErrTenantAnonymizationCheckIntervalNotPositive = errors.New("check interval of the anonymization must be greater than zero")
+	ErrUnsupportedAnonymizedField                  = errors.New("anonymized field is not supported, please use one of ownerId, contacts")
+
+	ErrEmptyHookName                = errors.New("hook name must not be empty")
+	ErrInvalidHookOperation         = errors.New("hook operation must be a full gRPC method name, e.g. /package.Service/Method")
+	ErrUnsupportedHookPhase         = errors.New("hook phase is not supported, please use one of preValidate, preCommit, postCommit")
+	ErrHookAddressMissing           = errors.New("hook address must be set")
+	ErrHookTimeoutNotPositive       = errors.New("hook timeout must be greater than zero")
+	ErrUnsupportedHookFailurePolicy = errors.New("hook failure policy must be fail or ignore")
+
+	ErrEmptyTenantTemplateName       = errors.New("tenant template name must not be empty")
+	ErrUnsupportedTenantTemplateRole = errors.New("tenant template role is not supported")
+	ErrEmptyTenantTemplateAuthType   = errors.New("tenant template auth type must not be empty")
+
+	ErrChangeFeedRetentionNotPositive     = errors.New("change feed retention must be greater than zero")
+	ErrChangeFeedPruneIntervalNotPositive = errors.New("change feed prune interval must be greater than zero")
+
+	ErrNegativeTerminationGracePeriod = errors.New("tenant termination grace period must not be negative")
+
+	ErrUnsupportedSystemStatus = errors.New("system status is not supported")
+	ErrDuplicateRollupStatus   = errors.New("system status is ranked more than once")
+
+	ErrInvalidL2KeyPattern  = errors.New("L2 key pattern is not a valid regular expression")
+	ErrL2KeyPolicyNoPattern = errors.New("L2 key policy requires at least one pattern")
+
+	ErrAdminWithoutCallers         = errors.New("admin service requires at least one permitted caller")
+	ErrMaintenanceIntervalNegative = errors.New("maintenance mode refresh interval must not be negative")
+	ErrApprovalWithoutAdmin        = errors.New("system approval requires the admin service to be enabled")
+	ErrLogControlWithoutAdmin      = errors.New("log control requires the admin service to be enabled")
+	ErrIntrospectionWithoutAdmin   = errors.New("config introspection requires the admin service to be enabled")
+
+	ErrUnknownConfigKeys = errors.New("config contains unknown keys")
+	ErrConfigFileMissing = errors.New("config file does not exist")
 )
 
 // Config holds all application configuration parameters.
@@ -70,232 +243,2136 @@ type Config struct {
 	Database DB `yaml:"database" json:"database"`
 	// Orbital configuration
 	Orbital Orbital `yaml:"orbital" json:"orbital"`
+	// CallerIdentity configuration
+	CallerIdentity CallerIdentity `yaml:"callerIdentity" json:"callerIdentity"`
 	// Validations configuration
 	Validations []validation.ConfigField `yaml:"validations"`
+	// SystemApproval configuration
+	SystemApproval SystemApproval `yaml:"systemApproval" json:"systemApproval"`
+	// TenantID configuration
+	TenantID TenantID `yaml:"tenantId" json:"tenantId"`
+	// Compatibility configuration
+	Compatibility Compatibility `yaml:"compatibility" json:"compatibility"`
+	// InventorySnapshot configuration
+	InventorySnapshot InventorySnapshot `yaml:"inventorySnapshot" json:"inventorySnapshot"`
+	// TenantPlacement configuration
+	TenantPlacement TenantPlacement `yaml:"tenantPlacement" json:"tenantPlacement"`
+	// RegionUsage configuration
+	RegionUsage RegionUsage `yaml:"regionUsage" json:"regionUsage"`
+	// OpenAPI configuration
+	OpenAPI OpenAPI `yaml:"openAPI" json:"openAPI"`
+	// OwnerIDEncryption configuration
+	OwnerIDEncryption OwnerIDEncryption `yaml:"ownerIdEncryption" json:"ownerIdEncryption"`
+	// TenantExport configuration
+	TenantExport TenantExport `yaml:"tenantExport" json:"tenantExport"`
+	// TenantDestroy configuration
+	TenantDestroy TenantDestroy `yaml:"tenantDestroy" json:"tenantDestroy"`
+	// TenantStatusPolicy configuration
+	TenantStatusPolicy TenantStatusPolicy `yaml:"tenantStatusPolicy" json:"tenantStatusPolicy"`
+	// Backfill configuration
+	Backfill Backfill `yaml:"backfill" json:"backfill"`
+	// Labels configuration
+	Labels Labels `yaml:"labels" json:"labels"`
+	// SystemLinkMetrics configuration
+	SystemLinkMetrics SystemLinkMetrics `yaml:"systemLinkMetrics" json:"systemLinkMetrics"`
+	// SystemDiscovery configuration
+	SystemDiscovery SystemDiscovery `yaml:"systemDiscovery" json:"systemDiscovery"`
+	// SystemClassification configuration
+	SystemClassification SystemClassification `yaml:"systemClassification" json:"systemClassification"`
+	// SystemRelationships configuration
+	SystemRelationships SystemRelationships `yaml:"systemRelationships" json:"systemRelationships"`
+	// Warmup configuration
+	Warmup Warmup `yaml:"warmup" json:"warmup"`
+	// Startup configuration
+	Startup Startup `yaml:"startup" json:"startup"`
+	// Concurrency configuration
+	Concurrency Concurrency `yaml:"concurrency" json:"concurrency"`
+	// RequestCoalescing configuration
+	RequestCoalescing RequestCoalescing `yaml:"requestCoalescing" json:"requestCoalescing"`
+	// ValidationErrors configuration
+	ValidationErrors ValidationErrors `yaml:"validationErrors" json:"validationErrors"`
+	// BatchValidation configuration
+	BatchValidation BatchValidation `yaml:"batchValidation" json:"batchValidation"`
+	// SystemRegistration configuration
+	SystemRegistration SystemRegistration `yaml:"systemRegistration" json:"systemRegistration"`
+	// SystemLookup configuration
+	SystemLookup SystemLookup `yaml:"systemLookup" json:"systemLookup"`
+	// LogControl configuration
+	LogControl LogControl `yaml:"logControl" json:"logControl"`
+	// TenantTemplates configuration
+	TenantTemplates TenantTemplates `yaml:"tenantTemplates" json:"tenantTemplates"`
+	// ChangeFeed configuration
+	ChangeFeed ChangeFeed `yaml:"changeFeed" json:"changeFeed"`
+	// TenantTermination configuration
+	TenantTermination TenantTermination `yaml:"tenantTermination" json:"tenantTermination"`
+	// TenantBlock configuration
+	TenantBlock TenantBlock `yaml:"tenantBlock" json:"tenantBlock"`
+	// Fairness configuration
+	Fairness Fairness `yaml:"fairness" json:"fairness"`
+	// TenantNotifications configuration
+	TenantNotifications TenantNotifications `yaml:"tenantNotifications" json:"tenantNotifications"`
+	// TenantAnonymization configuration
+	TenantAnonymization TenantAnonymization `yaml:"tenantAnonymization" json:"tenantAnonymization"`
+	// ConfigIntrospection configuration
+	ConfigIntrospection ConfigIntrospection `yaml:"configIntrospection" json:"configIntrospection"`
+	// Hooks configuration
+	Hooks Hooks `yaml:"hooks" json:"hooks"`
+	// SystemStatus configuration
+	SystemStatus SystemStatus `yaml:"systemStatus" json:"systemStatus"`
+	// L2KeyPolicy configuration
+	L2KeyPolicy L2KeyPolicy `yaml:"l2KeyPolicy" json:"l2KeyPolicy"`
+	// AuthUniqueness configuration
+	AuthUniqueness AuthUniqueness `yaml:"authUniqueness" json:"authUniqueness"`
+	// AuthPropertyBlobs configuration
+	AuthPropertyBlobs AuthPropertyBlobs `yaml:"authPropertyBlobs" json:"authPropertyBlobs"`
+	// ExternalIDIndex configuration
+	ExternalIDIndex ExternalIDIndex `yaml:"externalIdIndex" json:"externalIdIndex"`
+	// TenantImport configuration
+	TenantImport TenantImport `yaml:"tenantImport" json:"tenantImport"`
+	// CapabilityReadiness configuration
+	CapabilityReadiness CapabilityReadiness `yaml:"capabilityReadiness" json:"capabilityReadiness"`
+	// Admin configuration
+	Admin Admin `yaml:"admin" json:"admin"`
+	// Profile selects the procedure calls served by the instance. Instances with the readOnly profile reject
+	// the changing calls, do not migrate the database and do not run the background jobs,
+	// so they can be pointed at read replicas of the database.
+	Profile Profile `yaml:"profile" json:"profile" default:"full"`
+	// StrictConfig fails the start if the config file contains keys which do not match a configuration field,
+	// e.g. misspelled keys, which are otherwise ignored and leave the field at its default.
+	StrictConfig bool `yaml:"strictConfig" json:"strictConfig"`
 }
 
 // Validate validates the configuration.
 func (c *Config) Validate() error {
-	return c.Orbital.Validate()
-}
+	switch c.Profile {
+	case "", ProfileFull, ProfileReadOnly:
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedProfile, c.Profile)
+	}
 
-// DB holds DB config.
-type DB struct {
-	Host     string              `yaml:"host" json:"host"`
-	User     commoncfg.SourceRef `yaml:"user" json:"user"`
-	Password commoncfg.SourceRef `yaml:"password" json:"password"`
-	Name     string              `yaml:"name" json:"name"` // database name
-	Port     string              `yaml:"port" json:"port"`
-	LogLevel int                 `yaml:"logLevel" json:"logLevel" default:"1"`
-}
+	err := c.Orbital.Validate()
+	if err != nil {
+		return err
+	}
 
-// Server holds server config.
-type Server struct {
-	Port string `yaml:"port"`
-}
+	err = c.Database.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid database configuration: %w", err)
+	}
 
-// GRPCServer configuration.
-type GRPCServer struct {
-	commoncfg.GRPCServer `mapstructure:",squash"`
+	err = c.SystemApproval.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid system approval configuration: %w", err)
+	}
 
-	// also embed client attributes for the gRPC health check client
-	Client commoncfg.GRPCClient `yaml:"client" json:"client"`
-}
+	// pending systems are approved via the admin service
+	if len(c.SystemApproval.Types) > 0 && !c.Admin.Enabled {
+		return fmt.Errorf("invalid system approval configuration: %w", ErrApprovalWithoutAdmin)
+	}
 
-type Orbital struct {
-	ConfirmJobAfter        time.Duration `yaml:"confirmJobAfter" json:"confirmJobAfter"`
-	TaskLimitNum           int           `yaml:"taskLimitNum" json:"taskLimitNum"`
-	MaxPendingReconciles   uint64        `yaml:"maxPendingReconciles" json:"maxPendingReconciles"`
-	BackoffBaseIntervalSec uint64        `yaml:"backoffBaseIntervalSec" json:"backoffBaseIntervalSec"`
-	BackoffMaxIntervalSec  uint64        `yaml:"backoffMaxIntervalSec" json:"backoffMaxIntervalSec"`
-	Targets                []Target      `yaml:"targets" json:"targets"`
-	Workers                []Worker      `yaml:"workers" json:"workers"`
-}
+	// the log level is changed via the admin service
+	if c.LogControl.Enabled && !c.Admin.Enabled {
+		return fmt.Errorf("invalid log control configuration: %w", ErrLogControlWithoutAdmin)
+	}
 
-func (o *Orbital) Validate() error {
-	if o.ConfirmJobAfter < 0 {
-		return fmt.Errorf("%w: %v", ErrConfirmJobAfterMustBeEqualGreaterThanZero, o.ConfirmJobAfter)
+	// the effective configuration is returned by the admin service
+	if c.ConfigIntrospection.Enabled && !c.Admin.Enabled {
+		return fmt.Errorf("invalid config introspection configuration: %w", ErrIntrospectionWithoutAdmin)
 	}
 
-	if o.TaskLimitNum <= 0 {
-		return fmt.Errorf("%w: %d", ErrTaskLimitNumMustBeGreaterThanZero, o.TaskLimitNum)
+	err = c.TenantID.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant ID configuration: %w", err)
 	}
 
-	if o.MaxPendingReconciles == 0 {
-		return fmt.Errorf("%w: %d", ErrMaxPendingReconcilesMustBeGreaterThanZero, o.MaxPendingReconciles)
+	err = c.InventorySnapshot.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid inventory snapshot configuration: %w", err)
 	}
 
-	if o.BackoffBaseIntervalSec == 0 {
-		return fmt.Errorf("%w: %d", ErrBackoffBaseIntervalMustBeGreaterThanZero, o.BackoffBaseIntervalSec)
+	err = c.TenantPlacement.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant placement configuration: %w", err)
 	}
 
-	if o.BackoffMaxIntervalSec == 0 {
-		return fmt.Errorf("%w: %d", ErrBackoffMaxIntervalMustBeGreaterThanZero, o.BackoffMaxIntervalSec)
+	err = c.RegionUsage.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid region usage configuration: %w", err)
 	}
 
-	for _, target := range o.Targets {
-		err := target.validate()
-		if err != nil {
-			return fmt.Errorf("invalid target configuration: %w", err)
-		}
+	err = c.OpenAPI.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid OpenAPI configuration: %w", err)
 	}
 
-	for _, worker := range o.Workers {
-		err := worker.validate()
-		if err != nil {
-			return fmt.Errorf("invalid worker configuration for %s: %w", worker.Name, err)
-		}
+	err = c.OwnerIDEncryption.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid owner ID encryption configuration: %w", err)
 	}
 
-	return nil
-}
+	err = c.TenantExport.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant export configuration: %w", err)
+	}
 
-func (o *Orbital) GetWorker(workerName string) *Worker {
-	for i := range o.Workers {
-		if o.Workers[i].Name == workerName {
-			return &o.Workers[i]
-		}
+	err = c.AuthPropertyBlobs.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid auth property blobs configuration: %w", err)
 	}
 
-	return nil
-}
+	err = c.TenantStatusPolicy.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant status policy configuration: %w", err)
+	}
 
-type Target struct {
-	Region     string      `yaml:"region" json:"region"`
-	Connection *Connection `yaml:"connection" json:"connection"`
-}
+	err = c.Backfill.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid backfill configuration: %w", err)
+	}
 
-func (t *Target) validate() error {
-	if t.Region == "" {
-		return ErrEmptyRegion
+	err = c.Labels.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid labels configuration: %w", err)
 	}
 
-	if t.Connection == nil {
-		return fmt.Errorf("%w, target %s", ErrNilConnection, t.Region)
+	err = c.SystemLinkMetrics.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid system link metrics configuration: %w", err)
 	}
 
-	err := t.Connection.validate()
+	err = c.SystemDiscovery.Validate()
 	if err != nil {
-		return fmt.Errorf("invalid connection configuration for target %s: %w", t.Region, err)
+		return fmt.Errorf("invalid system discovery configuration: %w", err)
 	}
 
-	return nil
-}
+	err = c.SystemClassification.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid system classification configuration: %w", err)
+	}
 
-type Worker struct {
-	Name         string        `yaml:"name" json:"name"`
-	NoOfWorkers  int           `yaml:"noOfWorkers" json:"noOfWorkers"`
-	ExecInterval time.Duration `yaml:"execInterval" json:"execInterval"`
-	Timeout      time.Duration `yaml:"timeout" json:"timeout"`
-}
+	err = c.SystemRelationships.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid system relationships configuration: %w", err)
+	}
 
-func (w *Worker) validate() error {
-	if w.NoOfWorkers <= 0 {
-		return ErrNumberOfWorkersMustBeGreaterThanZero
+	err = c.Warmup.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid warm-up configuration: %w", err)
 	}
 
-	if w.ExecInterval <= 0 {
-		return ErrExecIntervalMustBeGreaterThanZero
+	err = c.Startup.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid startup configuration: %w", err)
 	}
 
-	if w.Timeout <= 0 {
-		return ErrTimeoutMustBeGreaterThanZero
+	err = c.CapabilityReadiness.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid capability readiness configuration: %w", err)
 	}
 
-	switch w.Name {
-	case WorkerNameConfirmJob, WorkerNameCreateTask, WorkerNameReconcile, WorkerNameNotifyEvent:
-		return nil
-	case "":
-		return ErrEmptyWorkerName
-	default:
-		return ErrUnsupportedWorkerName
+	err = c.Concurrency.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid concurrency configuration: %w", err)
 	}
-}
 
-type Connection struct {
-	Type ConnectionType `yaml:"type" json:"type"`
-	AMQP *AMQP          `yaml:"amqp" json:"amqp"`
-	Auth Auth           `yaml:"auth" json:"auth"`
-}
+	err = c.RequestCoalescing.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid request coalescing configuration: %w", err)
+	}
 
-func (c *Connection) validate() error {
-	switch c.Type {
-	case ConnectionTypeAMQP:
-		if c.AMQP == nil {
-			return ErrAMQPConfigMissing
-		}
+	err = c.ValidationErrors.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid validation errors configuration: %w", err)
+	}
 
-		err := c.AMQP.validate()
-		if err != nil {
-			return fmt.Errorf("invalid AMQP configuration: %w", err)
-		}
-	default:
-		return fmt.Errorf("%w: %s", ErrUnsupportedConnectionType, c.Type)
+	err = c.BatchValidation.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid batch validation configuration: %w", err)
 	}
 
-	return c.Auth.validate()
-}
+	err = c.SystemRegistration.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid system registration configuration: %w", err)
+	}
 
-type AMQP struct {
-	URL    string `yaml:"url" json:"url"`
-	Source string `yaml:"source" json:"source"`
-	Target string `yaml:"target" json:"target"`
-}
+	err = c.SystemLookup.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid system lookup configuration: %w", err)
+	}
 
-func (a *AMQP) validate() error {
-	if a.URL == "" {
-		return ErrEmptyURL
+	err = c.LogControl.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid log control configuration: %w", err)
 	}
 
-	if a.Source == "" {
-		return ErrEmptySource
+	err = c.ConfigIntrospection.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid config introspection configuration: %w", err)
 	}
 
-	if a.Target == "" {
-		return ErrEmptyTarget
+	err = c.TenantTemplates.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant templates configuration: %w", err)
 	}
 
-	return nil
-}
+	err = c.ChangeFeed.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid change feed configuration: %w", err)
+	}
 
-type Auth struct {
-	Type AuthType `yaml:"type" json:"type"`
-	MTLS *MTLS    `yaml:"mtls" json:"mtls"`
-}
+	err = c.TenantTermination.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant termination configuration: %w", err)
+	}
 
-func (a *Auth) validate() error {
-	switch a.Type {
-	case AuthTypeMTLS:
-		if a.MTLS == nil {
-			return ErrNilAuth
-		}
+	err = c.TenantBlock.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant block configuration: %w", err)
+	}
 
-		return a.MTLS.validate()
-	case AuthTypeNone:
-		return nil
-	default:
-		return fmt.Errorf("%w: %s", ErrUnsupportedAuthType, a.Type)
+	err = c.Fairness.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid fairness configuration: %w", err)
 	}
-}
 
-type MTLS struct {
-	CAFile   string `yaml:"caFile" json:"caFile"`
-	CertFile string `yaml:"certFile" json:"certFile"`
-	KeyFile  string `yaml:"keyFile" json:"keyFile"`
-}
+	err = c.TenantNotifications.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant notifications configuration: %w", err)
+	}
 
-func (m *MTLS) validate() error {
-	if m.CAFile == "" {
-		return ErrEmptyCAFile
+	err = c.TenantAnonymization.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid tenant anonymization configuration: %w", err)
 	}
 
-	if m.CertFile == "" {
-		return ErrEmptyCertFile
+	err = c.Hooks.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid hooks configuration: %w", err)
 	}
 
-	if m.KeyFile == "" {
+	err = c.SystemStatus.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid system status configuration: %w", err)
+	}
+
+	err = c.L2KeyPolicy.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid L2 key policy configuration: %w", err)
+	}
+
+	err = c.Admin.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid admin configuration: %w", err)
+	}
+
+	return nil
+}
+
+// DB holds DB config.
+type DB struct {
+	Host     string              `yaml:"host" json:"host"`
+	User     commoncfg.SourceRef `yaml:"user" json:"user"`
+	Password commoncfg.SourceRef `yaml:"password" json:"password"`
+	Name     string              `yaml:"name" json:"name"` // database name
+	Port     string              `yaml:"port" json:"port"`
+	LogLevel int                 `yaml:"logLevel" json:"logLevel" default:"1"`
+	// SlowOperationThreshold is the duration after which a repository operation is logged as slow.
+	// Zero disables slow operation logging.
+	SlowOperationThreshold time.Duration `yaml:"slowOperationThreshold" json:"slowOperationThreshold" default:"1s"`
+	// Pools are the dedicated connection pools of the request classes,
+	// classes without enabled pool are served by the default connection pool.
+	Pools DBPools `yaml:"pools" json:"pools"`
+	// TransactionRetry retries transactions failing with a serialization failure or a deadlock.
+	TransactionRetry TransactionRetry `yaml:"transactionRetry" json:"transactionRetry"`
+	// CircuitBreaker fails the repository operations fast while the database is unreachable, e.g. during a failover.
+	CircuitBreaker CircuitBreaker `yaml:"circuitBreaker" json:"circuitBreaker"`
+	// StatusClock rejects status changes of resources whose status time is ahead of the database clock.
+	StatusClock StatusClock `yaml:"statusClock" json:"statusClock"`
+	// Maintenance monitors the bloat of high-churn tables and maintains them within maintenance windows.
+	Maintenance DBMaintenance `yaml:"maintenance" json:"maintenance"`
+	// TableScan paces the full scans of tables by exports, integrity checks, backfills and anonymization.
+	TableScan TableScan `yaml:"tableScan" json:"tableScan"`
+}
+
+func (d *DB) Validate() error {
+	if err := d.Pools.Read.Validate(); err != nil {
+		return fmt.Errorf("read pool: %w", err)
+	}
+
+	if err := d.Pools.Write.Validate(); err != nil {
+		return fmt.Errorf("write pool: %w", err)
+	}
+
+	if err := d.Pools.Admin.Validate(); err != nil {
+		return fmt.Errorf("admin pool: %w", err)
+	}
+
+	if err := d.TransactionRetry.Validate(); err != nil {
+		return err
+	}
+
+	if err := d.Maintenance.Validate(); err != nil {
+		return fmt.Errorf("maintenance: %w", err)
+	}
+
+	if err := d.StatusClock.Validate(); err != nil {
+		return err
+	}
+
+	if err := d.TableScan.Validate(); err != nil {
+		return err
+	}
+
+	return d.CircuitBreaker.Validate()
+}
+
+// DBMaintenance configures the monitoring of the bloat of tables and their indexes, which is exposed as metrics,
+// and the maintenance of bloated tables. The maintenance operations run only within the maintenance windows,
+// and by one instance at a time.
+type DBMaintenance struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+	// CheckInterval is how often the bloat of the tables is estimated.
+	CheckInterval time.Duration `yaml:"checkInterval" json:"checkInterval" default:"15m"`
+	// Tables are the monitored tables, by default the high-churn tables of the jobs and the change feed.
+	Tables []string `yaml:"tables" json:"tables" default:"[\"jobs\",\"tasks\",\"job_event\",\"job_outcomes\",\"changes\"]"`
+	// Vacuum vacuums and analyzes the tables whose share of dead rows reaches the threshold.
+	Vacuum DBMaintenanceOperation `yaml:"vacuum" json:"vacuum"`
+	// Reindex rebuilds the indexes of the tables whose share of index bloat reaches the threshold.
+	Reindex DBMaintenanceOperation `yaml:"reindex" json:"reindex"`
+	// Windows are the weekly windows the maintenance operations may run in, in UTC.
+	Windows []DBMaintenanceWindow `yaml:"windows" json:"windows"`
+}
+
+// DBMaintenanceOperation configures when a maintenance operation runs on a table.
+type DBMaintenanceOperation struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+	// Threshold is the share of bloat of a table, from 0 to 1, at which the operation runs.
+	Threshold float64 `yaml:"threshold" json:"threshold" default:"0.2"`
+}
+
+// DBMaintenanceWindow is a weekly window starting at StartTime on each of the Weekdays and lasting Duration.
+type DBMaintenanceWindow struct {
+	// Weekdays are the English names of the days the window starts on, e.g. Sunday.
+	Weekdays []string `yaml:"weekdays" json:"weekdays"`
+	// StartTime is the time of day the window starts at, e.g. 02:00.
+	StartTime string        `yaml:"startTime" json:"startTime"`
+	Duration  time.Duration `yaml:"duration" json:"duration"`
+}
+
+func (m *DBMaintenance) Validate() error {
+	if !m.Enabled {
+		return nil
+	}
+
+	if m.CheckInterval <= 0 {
+		return fmt.Errorf("%w: %v", ErrDBMaintenanceCheckIntervalNotPositive, m.CheckInterval)
+	}
+
+	if err := m.Vacuum.validate(); err != nil {
+		return fmt.Errorf("vacuum: %w", err)
+	}
+
+	if err := m.Reindex.validate(); err != nil {
+		return fmt.Errorf("reindex: %w", err)
+	}
+
+	if (m.Vacuum.Enabled || m.Reindex.Enabled) && len(m.Windows) == 0 {
+		return ErrDBMaintenanceWithoutWindows
+	}
+
+	return m.MaintenanceWindows().Validate()
+}
+
+func (o *DBMaintenanceOperation) validate() error {
+	if o.Enabled && (o.Threshold <= 0 || o.Threshold > 1) {
+		return fmt.Errorf("%w: %v", ErrDBMaintenanceThresholdOutOfRange, o.Threshold)
+	}
+
+	return nil
+}
+
+// MaintenanceWindows returns the windows the maintenance operations may run in.
+func (m *DBMaintenance) MaintenanceWindows() model.MaintenanceWindows {
+	windows := make(model.MaintenanceWindows, 0, len(m.Windows))
+	for _, window := range m.Windows {
+		windows = append(windows, model.MaintenanceWindow{
+			Weekdays:  window.Weekdays,
+			StartTime: window.StartTime,
+			Duration:  window.Duration.String(),
+		})
+	}
+
+	return windows
+}
+
+// CircuitBreaker configures the circuit breaker of the repository operations.
+// The circuit opens after the configured number of consecutive connection errors and rejects
+// the operations for the open duration. Then a single probe operation is let through,
+// which closes the circuit if the database is reachable and opens it again otherwise.
+type CircuitBreaker struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// FailureThreshold is the number of consecutive connection errors opening the circuit.
+	FailureThreshold int `yaml:"failureThreshold" json:"failureThreshold" default:"5"`
+	// OpenDuration is the duration the circuit stays open before it is probed.
+	OpenDuration time.Duration `yaml:"openDuration" json:"openDuration" default:"5s"`
+}
+
+func (b *CircuitBreaker) Validate() error {
+	if b.Enabled && (b.FailureThreshold <= 0 || b.OpenDuration <= 0) {
+		return fmt.Errorf("%w: threshold %d, open duration %v", ErrCircuitBreakerInvalid, b.FailureThreshold, b.OpenDuration)
+	}
+
+	return nil
+}
+
+// StatusClock configures the guard of the status times of the resources, which are taken from the database clock.
+// A status change is rejected if the stored status time is ahead of the database clock by more than MaxSkew,
+// e.g. because it was taken from the skewed clock of a host or the database failed over to a server with
+// a clock behind, as the status history would go back in time. Within MaxSkew the status time is kept.
+type StatusClock struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"true"`
+	// MaxSkew is the tolerated duration the stored status time may be ahead of the database clock.
+	MaxSkew time.Duration `yaml:"maxSkew" json:"maxSkew" default:"1m"`
+}
+
+func (c *StatusClock) Validate() error {
+	if c.Enabled && c.MaxSkew < 0 {
+		return fmt.Errorf("%w: %v", ErrStatusClockSkewNegative, c.MaxSkew)
+	}
+
+	return nil
+}
+
+// MaxTableScanPageSize is the maximum number of records read at once by a table scan.
+const MaxTableScanPageSize = 1000
+
+// TableScan configures the scans reading all records of a table page by page in the order of their keyset,
+// which are paced, so long-running scans do not starve the interactive requests of the database.
+type TableScan struct {
+	// PageSize is the number of records read at once, at most MaxTableScanPageSize.
+	// If zero, the default page size of the repository is read.
+	PageSize int `yaml:"pageSize" json:"pageSize" default:"500"`
+	// RowsPerSecond is the maximum rate of the records read by a scan, zero does not limit the rate.
+	RowsPerSecond int `yaml:"rowsPerSecond" json:"rowsPerSecond" default:"5000"`
+}
+
+func (s *TableScan) Validate() error {
+	if s.PageSize < 0 || s.PageSize > MaxTableScanPageSize {
+		return fmt.Errorf("%w: %d", ErrTableScanPageSizeInvalid, s.PageSize)
+	}
+
+	if s.RowsPerSecond < 0 {
+		return fmt.Errorf("%w: %d", ErrTableScanRateNegative, s.RowsPerSecond)
+	}
+
+	return nil
+}
+
+// TransactionRetry configures the retries of transactions failing with a retryable error.
+// The backoff doubles with each attempt up to MaxBackoff and is jittered, so the retried transactions spread out.
+type TransactionRetry struct {
+	// MaxAttempts is the maximum number of attempts of a transaction, 0 or 1 disable retries.
+	MaxAttempts int `yaml:"maxAttempts" json:"maxAttempts" default:"3"`
+	// InitialBackoff is the backoff before the first retry.
+	InitialBackoff time.Duration `yaml:"initialBackoff" json:"initialBackoff" default:"20ms"`
+	// MaxBackoff bounds the backoff before a retry.
+	MaxBackoff time.Duration `yaml:"maxBackoff" json:"maxBackoff" default:"500ms"`
+}
+
+func (r *TransactionRetry) Validate() error {
+	if r.MaxAttempts < 0 || r.InitialBackoff < 0 || r.MaxBackoff < 0 {
+		return fmt.Errorf("%w: attempts %d, initial backoff %v, max backoff %v",
+			ErrTransactionRetryNegative, r.MaxAttempts, r.InitialBackoff, r.MaxBackoff)
+	}
+
+	return nil
+}
+
+// DBPools configures the dedicated connection pools of the request classes.
+// Get and List calls are read requests, other calls are write requests and exports are admin requests.
+type DBPools struct {
+	Read  DBPool `yaml:"read" json:"read"`
+	Write DBPool `yaml:"write" json:"write"`
+	Admin DBPool `yaml:"admin" json:"admin"`
+}
+
+// DBPool configures a dedicated connection pool. Zero disables a limit or timeout.
+type DBPool struct {
+	Enabled bool `yaml:"enabled" json:"enabled"`
+	// MaxOpenConns is the maximum number of open connections of the pool.
+	MaxOpenConns int `yaml:"maxOpenConns" json:"maxOpenConns"`
+	// MaxIdleConns is the maximum number of idle connections kept by the pool.
+	MaxIdleConns int `yaml:"maxIdleConns" json:"maxIdleConns"`
+	// ConnMaxIdleTime drains idle connections, so the pool shrinks after a load peak.
+	ConnMaxIdleTime time.Duration `yaml:"connMaxIdleTime" json:"connMaxIdleTime"`
+	// StatementTimeout aborts statements of the pool running longer, e.g. list queries blocking the pool.
+	StatementTimeout time.Duration `yaml:"statementTimeout" json:"statementTimeout"`
+}
+
+func (p *DBPool) Validate() error {
+	if p.MaxOpenConns < 0 || p.MaxIdleConns < 0 {
+		return fmt.Errorf("%w: open %d, idle %d", ErrDBPoolLimitNegative, p.MaxOpenConns, p.MaxIdleConns)
+	}
+
+	if p.MaxOpenConns > 0 && p.MaxIdleConns > p.MaxOpenConns {
+		return fmt.Errorf("%w: open %d, idle %d", ErrDBPoolIdleExceedsOpen, p.MaxOpenConns, p.MaxIdleConns)
+	}
+
+	if p.ConnMaxIdleTime < 0 || p.StatementTimeout < 0 {
+		return fmt.Errorf("%w: idle time %v, statement timeout %v", ErrDBPoolTimeoutNegative, p.ConnMaxIdleTime, p.StatementTimeout)
+	}
+
+	return nil
+}
+
+// Server holds server config.
+type Server struct {
+	Port string `yaml:"port"`
+}
+
+// GRPCServer configuration.
+type GRPCServer struct {
+	commoncfg.GRPCServer `mapstructure:",squash"`
+
+	// also embed client attributes for the gRPC health check client
+	Client commoncfg.GRPCClient `yaml:"client" json:"client"`
+
+	// MaxConcurrentStreams is the maximum number of concurrent streams of a client connection.
+	// Zero keeps the default of gRPC.
+	MaxConcurrentStreams uint32 `yaml:"maxConcurrentStreams" json:"maxConcurrentStreams"`
+}
+
+// CallerIdentity configures how the identity of a client is determined, which is recorded
+// as creator and last modifier of the resources.
+type CallerIdentity struct {
+	// Header is the gRPC metadata key a trusted proxy, e.g. the service mesh terminating mTLS, sets to the
+	// identity of the client. The client certificate of TLS connections takes precedence. Empty disables the key.
+	Header string `yaml:"header" json:"header"`
+	// ClientDataHeaders are the gRPC metadata keys of the client data of common-sdk, e.g. x-client-data and
+	// x-client-data-signature, which are propagated to the webhooks and hooks the registry calls while handling
+	// a request, so they can attribute their calls to the original client. Empty does not propagate client data.
+	ClientDataHeaders []string `yaml:"clientDataHeaders" json:"clientDataHeaders"`
+}
+
+type Orbital struct {
+	ConfirmJobAfter        time.Duration `yaml:"confirmJobAfter" json:"confirmJobAfter"`
+	TaskLimitNum           int           `yaml:"taskLimitNum" json:"taskLimitNum"`
+	MaxPendingReconciles   uint64        `yaml:"maxPendingReconciles" json:"maxPendingReconciles"`
+	BackoffBaseIntervalSec uint64        `yaml:"backoffBaseIntervalSec" json:"backoffBaseIntervalSec"`
+	BackoffMaxIntervalSec  uint64        `yaml:"backoffMaxIntervalSec" json:"backoffMaxIntervalSec"`
+	Targets                []Target      `yaml:"targets" json:"targets"`
+	Workers                []Worker      `yaml:"workers" json:"workers"`
+	// MaintenancePolicy defines how jobs disrupting a tenant are handled outside its maintenance windows.
+	// With schedule, they are delayed to the next window, with reject, they fail unless forced.
+	MaintenancePolicy string `yaml:"maintenancePolicy" json:"maintenancePolicy" default:"schedule"`
+	// Replay limits how often admins replay the outcomes of finished jobs, see JobReplay.
+	Replay JobReplay `yaml:"replay" json:"replay"`
+}
+
+// JobReplay limits the replays of the outcomes of finished jobs, which run the handling of the outcome again,
+// e.g. when a downstream consumer lost the change of a tenant to ACTIVE.
+// The limits hold across replicas, as the replays are recorded with the outcomes. Zero disables a limit.
+type JobReplay struct {
+	// Interval is the minimum interval between two replays of the outcome of the same job.
+	Interval time.Duration `yaml:"interval" json:"interval" default:"5m"`
+	// MaxPerHour is the maximum number of jobs whose outcomes are replayed within the last hour.
+	MaxPerHour int `yaml:"maxPerHour" json:"maxPerHour" default:"60"`
+}
+
+const (
+	MaintenancePolicySchedule = "schedule"
+	MaintenancePolicyReject   = "reject"
+)
+
+func (o *Orbital) Validate() error {
+	if o.ConfirmJobAfter < 0 {
+		return fmt.Errorf("%w: %v", ErrConfirmJobAfterMustBeEqualGreaterThanZero, o.ConfirmJobAfter)
+	}
+
+	if o.TaskLimitNum <= 0 {
+		return fmt.Errorf("%w: %d", ErrTaskLimitNumMustBeGreaterThanZero, o.TaskLimitNum)
+	}
+
+	if o.MaxPendingReconciles == 0 {
+		return fmt.Errorf("%w: %d", ErrMaxPendingReconcilesMustBeGreaterThanZero, o.MaxPendingReconciles)
+	}
+
+	if o.BackoffBaseIntervalSec == 0 {
+		return fmt.Errorf("%w: %d", ErrBackoffBaseIntervalMustBeGreaterThanZero, o.BackoffBaseIntervalSec)
+	}
+
+	if o.BackoffMaxIntervalSec == 0 {
+		return fmt.Errorf("%w: %d", ErrBackoffMaxIntervalMustBeGreaterThanZero, o.BackoffMaxIntervalSec)
+	}
+
+	switch o.MaintenancePolicy {
+	case "", MaintenancePolicySchedule, MaintenancePolicyReject:
+	default:
+		return fmt.Errorf("%w: %s", ErrMaintenancePolicyInvalid, o.MaintenancePolicy)
+	}
+
+	if o.Replay.Interval < 0 || o.Replay.MaxPerHour < 0 {
+		return fmt.Errorf("%w: interval %v, max per hour %d", ErrJobReplayLimitNegative, o.Replay.Interval, o.Replay.MaxPerHour)
+	}
+
+	for _, target := range o.Targets {
+		err := target.validate()
+		if err != nil {
+			return fmt.Errorf("invalid target configuration: %w", err)
+		}
+	}
+
+	for _, worker := range o.Workers {
+		err := worker.validate()
+		if err != nil {
+			return fmt.Errorf("invalid worker configuration for %s: %w", worker.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (o *Orbital) GetWorker(workerName string) *Worker {
+	for i := range o.Workers {
+		if o.Workers[i].Name == workerName {
+			return &o.Workers[i]
+		}
+	}
+
+	return nil
+}
+
+type Target struct {
+	Region     string      `yaml:"region" json:"region"`
+	Connection *Connection `yaml:"connection" json:"connection"`
+}
+
+func (t *Target) validate() error {
+	if t.Region == "" {
+		return ErrEmptyRegion
+	}
+
+	if t.Connection == nil {
+		return fmt.Errorf("%w, target %s", ErrNilConnection, t.Region)
+	}
+
+	err := t.Connection.validate()
+	if err != nil {
+		return fmt.Errorf("invalid connection configuration for target %s: %w", t.Region, err)
+	}
+
+	return nil
+}
+
+type Worker struct {
+	Name string `yaml:"name" json:"name"`
+	// NoOfWorkers is the number of workers, or the minimum number if the worker is scaled.
+	NoOfWorkers  int           `yaml:"noOfWorkers" json:"noOfWorkers"`
+	ExecInterval time.Duration `yaml:"execInterval" json:"execInterval"`
+	Timeout      time.Duration `yaml:"timeout" json:"timeout"`
+	// MaxWorkers enables scaling the number of workers up to this value based on the backlog of the worker.
+	MaxWorkers int `yaml:"maxWorkers" json:"maxWorkers"`
+	// BacklogPerWorker is the number of pending items a single worker is expected to handle.
+	BacklogPerWorker int64 `yaml:"backlogPerWorker" json:"backlogPerWorker"`
+}
+
+// Scaled returns true if the number of workers is scaled based on the backlog.
+func (w *Worker) Scaled() bool {
+	return w.MaxWorkers > 0
+}
+
+func (w *Worker) validate() error {
+	if w.NoOfWorkers <= 0 {
+		return ErrNumberOfWorkersMustBeGreaterThanZero
+	}
+
+	if w.ExecInterval <= 0 {
+		return ErrExecIntervalMustBeGreaterThanZero
+	}
+
+	if w.Timeout <= 0 {
+		return ErrTimeoutMustBeGreaterThanZero
+	}
+
+	if w.Scaled() {
+		if w.MaxWorkers < w.NoOfWorkers {
+			return fmt.Errorf("%w: %d < %d", ErrMaxWorkersLessThanNoOfWorkers, w.MaxWorkers, w.NoOfWorkers)
+		}
+
+		if w.BacklogPerWorker <= 0 {
+			return fmt.Errorf("%w: %d", ErrBacklogPerWorkerMustBeGreaterThanZero, w.BacklogPerWorker)
+		}
+	}
+
+	switch w.Name {
+	case WorkerNameConfirmJob, WorkerNameCreateTask, WorkerNameReconcile, WorkerNameNotifyEvent:
+		return nil
+	case "":
+		return ErrEmptyWorkerName
+	default:
+		return ErrUnsupportedWorkerName
+	}
+}
+
+type Connection struct {
+	Type ConnectionType `yaml:"type" json:"type"`
+	AMQP *AMQP          `yaml:"amqp" json:"amqp"`
+	Auth Auth           `yaml:"auth" json:"auth"`
+}
+
+func (c *Connection) validate() error {
+	switch c.Type {
+	case ConnectionTypeAMQP:
+		if c.AMQP == nil {
+			return ErrAMQPConfigMissing
+		}
+
+		err := c.AMQP.validate()
+		if err != nil {
+			return fmt.Errorf("invalid AMQP configuration: %w", err)
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedConnectionType, c.Type)
+	}
+
+	return c.Auth.validate()
+}
+
+type AMQP struct {
+	URL    string `yaml:"url" json:"url"`
+	Source string `yaml:"source" json:"source"`
+	Target string `yaml:"target" json:"target"`
+}
+
+func (a *AMQP) validate() error {
+	if a.URL == "" {
+		return ErrEmptyURL
+	}
+
+	if a.Source == "" {
+		return ErrEmptySource
+	}
+
+	if a.Target == "" {
+		return ErrEmptyTarget
+	}
+
+	return nil
+}
+
+type Auth struct {
+	Type AuthType `yaml:"type" json:"type"`
+	MTLS *MTLS    `yaml:"mtls" json:"mtls"`
+}
+
+func (a *Auth) validate() error {
+	switch a.Type {
+	case AuthTypeMTLS:
+		if a.MTLS == nil {
+			return ErrNilAuth
+		}
+
+		return a.MTLS.validate()
+	case AuthTypeNone:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedAuthType, a.Type)
+	}
+}
+
+type MTLS struct {
+	CAFile   string `yaml:"caFile" json:"caFile"`
+	CertFile string `yaml:"certFile" json:"certFile"`
+	KeyFile  string `yaml:"keyFile" json:"keyFile"`
+}
+
+func (m *MTLS) validate() error {
+	if m.CAFile == "" {
+		return ErrEmptyCAFile
+	}
+
+	if m.CertFile == "" {
+		return ErrEmptyCertFile
+	}
+
+	if m.KeyFile == "" {
 		return ErrEmptyKeyFile
 	}
 
 	return nil
 }
+
+// SystemApproval configures the optional approval gate for system registrations.
+// Systems of the listed types are registered as pending and must be approved before they can be used.
+type SystemApproval struct {
+	Types   []string `yaml:"types" json:"types"`
+	Webhook Webhook  `yaml:"webhook" json:"webhook"`
+}
+
+// RequiresApproval returns true if systems of the given type must be approved.
+func (s *SystemApproval) RequiresApproval(systemType string) bool {
+	return slices.Contains(s.Types, systemType)
+}
+
+func (s *SystemApproval) Validate() error {
+	if len(s.Types) == 0 {
+		return nil
+	}
+
+	if slices.Contains(s.Types, "") {
+		return ErrEmptyApprovalSystemType
+	}
+
+	if s.Webhook.URL == "" {
+		return ErrApprovalWebhookMissing
+	}
+
+	return s.Webhook.validate()
+}
+
+// Webhook holds the configuration of an outgoing HTTP notification endpoint.
+type Webhook struct {
+	URL     string        `yaml:"url" json:"url"`
+	Timeout time.Duration `yaml:"timeout" json:"timeout" default:"5s"`
+}
+
+func (w *Webhook) validate() error {
+	if w.Timeout < 0 {
+		return fmt.Errorf("%w: %v", ErrWebhookTimeoutMustNotBeNegative, w.Timeout)
+	}
+
+	return nil
+}
+
+// TenantID configures how tenant IDs are generated, validated and normalized.
+// With the zero value any non-empty caller-supplied ID is accepted as is.
+type TenantID struct {
+	// Format all tenant IDs must follow. Caller-supplied IDs not matching the format are rejected.
+	Format TenantIDFormat `yaml:"format" json:"format"`
+	// Prefix is prepended to generated IDs of the prefixed format.
+	Prefix string `yaml:"prefix" json:"prefix" default:"t-"`
+	// Generate lets the registry generate an ID if the caller does not supply one.
+	Generate bool `yaml:"generate" json:"generate"`
+	// Normalize trims and lowercases caller-supplied IDs, both of registered tenants and of the tenants requests refer to.
+	Normalize bool `yaml:"normalize" json:"normalize"`
+}
+
+func (t *TenantID) Validate() error {
+	switch t.Format {
+	case TenantIDFormatAny:
+		if t.Generate {
+			return ErrTenantIDGenerationNoFormat
+		}
+	case TenantIDFormatUUIDv7:
+	case TenantIDFormatPrefixed:
+		if t.Prefix == "" {
+			return ErrEmptyTenantIDPrefix
+		}
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedTenantIDFormat, t.Format)
+	}
+
+	return nil
+}
+
+// Compatibility configures the support of request shapes deprecated by api-sdk changes.
+// Legacy requests are counted by the requests.legacy metric, so they can be disabled once unused.
+type Compatibility struct {
+	// AcceptLegacyRequests accepts deprecated request shapes, e.g. system requests without type
+	// or ListTenants requests filtering by the deprecated id field.
+	AcceptLegacyRequests bool `yaml:"acceptLegacyRequests" json:"acceptLegacyRequests" default:"true"`
+	// AcceptUnknownEnumValues stores enum values unknown to this server, e.g. a status sent by a newer client,
+	// as UNKNOWN instead of rejecting the request.
+	AcceptUnknownEnumValues bool `yaml:"acceptUnknownEnumValues" json:"acceptUnknownEnumValues"`
+	// EmptyLists answers list requests without matching resources, e.g. ListSystems and ListTenants,
+	// with an empty page instead of NotFound. Clients may ask for either answer per request via metadata.
+	EmptyLists bool `yaml:"emptyLists" json:"emptyLists"`
+}
+
+// InventorySnapshot configures the worker materializing daily inventory aggregates for reporting.
+// The snapshot of the current day is refreshed every interval, so it is final once the day has passed.
+type InventorySnapshot struct {
+	Enabled  bool          `yaml:"enabled" json:"enabled"`
+	Interval time.Duration `yaml:"interval" json:"interval" default:"1h"`
+}
+
+func (i *InventorySnapshot) Validate() error {
+	if i.Enabled && i.Interval <= 0 {
+		return fmt.Errorf("%w: %v", ErrSnapshotIntervalMustBeGreaterThanZero, i.Interval)
+	}
+
+	return nil
+}
+
+// TenantPlacement configures the region capacities consulted for tenant placement hints.
+type TenantPlacement struct {
+	// Capacity is the maximum number of tenants per region. Regions without capacity are unbounded.
+	Capacity map[string]int64 `yaml:"capacity" json:"capacity"`
+	// NearCapacityRatio is the share of the capacity from which a region is considered near capacity.
+	NearCapacityRatio float64 `yaml:"nearCapacityRatio" json:"nearCapacityRatio" default:"0.9"`
+}
+
+func (t *TenantPlacement) Validate() error {
+	for region, capacity := range t.Capacity {
+		if capacity <= 0 {
+			return fmt.Errorf("%w: %s", ErrRegionCapacityMustBeGreaterThanZero, region)
+		}
+	}
+
+	if len(t.Capacity) > 0 && (t.NearCapacityRatio <= 0 || t.NearCapacityRatio > 1) {
+		return fmt.Errorf("%w: %v", ErrNearCapacityRatioOutOfRange, t.NearCapacityRatio)
+	}
+
+	return nil
+}
+
+// RegionUsage configures the soft limits reported with the usage of regions for capacity planning.
+// The soft limits are not enforced.
+type RegionUsage struct {
+	// SoftLimits are the soft limits by region. Without a tenant limit, the capacity of TenantPlacement is reported.
+	SoftLimits map[string]RegionSoftLimits `yaml:"softLimits" json:"softLimits"`
+}
+
+// RegionSoftLimits are the soft limits of a region, zero if a resource is not limited.
+type RegionSoftLimits struct {
+	Tenants         int64 `yaml:"tenants" json:"tenants"`
+	Systems         int64 `yaml:"systems" json:"systems"`
+	RegionalSystems int64 `yaml:"regionalSystems" json:"regionalSystems"`
+	L1KeyClaims     int64 `yaml:"l1KeyClaims" json:"l1KeyClaims"`
+	Auths           int64 `yaml:"auths" json:"auths"`
+}
+
+func (r *RegionUsage) Validate() error {
+	for region, limits := range r.SoftLimits {
+		if limits.Tenants < 0 || limits.Systems < 0 || limits.RegionalSystems < 0 || limits.L1KeyClaims < 0 || limits.Auths < 0 {
+			return fmt.Errorf("%w: %s", ErrSoftLimitNegative, region)
+		}
+	}
+
+	return nil
+}
+
+// OpenAPI configures the HTTP server serving the OpenAPI document generated from the gRPC services.
+type OpenAPI struct {
+	Enabled bool   `yaml:"enabled" json:"enabled"`
+	Address string `yaml:"address" json:"address" default:":8080"`
+	// SwaggerUI additionally serves a Swagger UI rendering the document, with its assets embedded into the binary.
+	SwaggerUI bool `yaml:"swaggerUI" json:"swaggerUI"`
+	// CacheControl is the Cache-Control header of the routes without a header in RouteCacheControl.
+	// The routes are served with an ETag, so clients revalidating them get a 304 while they are unchanged.
+	// Empty omits the header.
+	CacheControl string `yaml:"cacheControl" json:"cacheControl" default:"no-cache"`
+	// RouteCacheControl are the Cache-Control headers by route, e.g. /swagger: max-age=3600.
+	RouteCacheControl map[string]string `yaml:"routeCacheControl" json:"routeCacheControl"`
+}
+
+func (o *OpenAPI) Validate() error {
+	if o.Enabled && o.Address == "" {
+		return ErrEmptyOpenAPIAddress
+	}
+
+	for route := range o.RouteCacheControl {
+		if !strings.HasPrefix(route, "/") {
+			return fmt.Errorf("%w: %s", ErrInvalidOpenAPIRoute, route)
+		}
+	}
+
+	return nil
+}
+
+// OwnerIDEncryption configures the deterministic encryption of tenant owner IDs at rest.
+// Owner IDs are encrypted with the active key version, keys of previous versions are kept
+// to read owner IDs which have not been re-encrypted yet.
+type OwnerIDEncryption struct {
+	Enabled       bool            `yaml:"enabled" json:"enabled"`
+	ActiveVersion int             `yaml:"activeVersion" json:"activeVersion"`
+	Keys          []EncryptionKey `yaml:"keys" json:"keys"`
+	// Reencrypt re-encrypts owner IDs in plaintext or of previous key versions with the active key at startup.
+	Reencrypt bool `yaml:"reencrypt" json:"reencrypt"`
+	// ReencryptBatchSize is the number of tenants re-encrypted per transaction.
+	ReencryptBatchSize int `yaml:"reencryptBatchSize" json:"reencryptBatchSize" default:"500"`
+}
+
+// EncryptionKey is a base64 encoded 32 byte key of a key version.
+type EncryptionKey struct {
+	Version int                 `yaml:"version" json:"version"`
+	Key     commoncfg.SourceRef `yaml:"key" json:"key"`
+}
+
+func (o *OwnerIDEncryption) Validate() error {
+	if !o.Enabled {
+		return nil
+	}
+
+	// an owner ID filter matches the encryption under every key version and the plaintext,
+	// which must stay within the filter value limit of the repository
+	if len(o.Keys) >= repository.MaxFilterValues {
+		return fmt.Errorf("%w: %d keys, maximum is %d", ErrTooManyEncryptionKeys, len(o.Keys), repository.MaxFilterValues-1)
+	}
+
+	versions := make(map[int]struct{}, len(o.Keys))
+	for _, key := range o.Keys {
+		if _, ok := versions[key.Version]; ok {
+			return fmt.Errorf("%w: %d", ErrDuplicateEncryptionKeyVersion, key.Version)
+		}
+		versions[key.Version] = struct{}{}
+	}
+
+	if _, ok := versions[o.ActiveVersion]; !ok {
+		return fmt.Errorf("%w: %d", ErrActiveEncryptionKeyMissing, o.ActiveVersion)
+	}
+
+	if o.Reencrypt && o.ReencryptBatchSize <= 0 {
+		return fmt.Errorf("%w: %d", ErrReencryptBatchSizeNotPositive, o.ReencryptBatchSize)
+	}
+
+	return nil
+}
+
+// TenantExport configures the export of all data stored about a tenant, e.g. for data subject requests.
+type TenantExport struct {
+	// Directory the exports are written to, e.g. a mounted object store bucket.
+	// If empty, exports are returned directly.
+	Directory string `yaml:"directory" json:"directory"`
+	// RedactedKeys are patterns of label and property keys whose values are redacted in exports.
+	// Patterns use path.Match syntax and are matched case-insensitively.
+	RedactedKeys []string `yaml:"redactedKeys" json:"redactedKeys" default:"[\"*secret*\",\"*password*\",\"*token*\",\"*credential*\",\"*private*\"]"`
+	// ChunkSize is the size in bytes of the uncompressed data of the chunks of streamed exports,
+	// at most MaxExportChunkSize, which it defaults to if zero.
+	ChunkSize int `yaml:"chunkSize" json:"chunkSize" default:"1048576"`
+}
+
+// MaxExportChunkSize is the maximum size of the chunks of streamed exports,
+// which leaves room for their metadata within the default maximum gRPC message size of 4 MiB.
+const MaxExportChunkSize = 3 << 20
+
+func (t *TenantExport) Validate() error {
+	if t.ChunkSize < 0 || t.ChunkSize > MaxExportChunkSize {
+		return fmt.Errorf("%w: %d", ErrExportChunkSizeInvalid, t.ChunkSize)
+	}
+
+	for _, pattern := range t.RedactedKeys {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidRedactionPattern, pattern)
+		}
+	}
+
+	return nil
+}
+
+// TenantDestroy configures the hard deletion of tenants with everything attached to them.
+type TenantDestroy struct {
+	// Enabled allows tenants to be destroyed. It must only be enabled in ephemeral test environments,
+	// as destroyed tenants can not be restored and their history is lost.
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+}
+
+// TenantStatusPolicy restricts gRPC methods depending on the status of the tenant
+// a request refers to, either by its tenant ID or by the system it is linked to.
+// Methods without rule are not restricted.
+type TenantStatusPolicy struct {
+	Rules []TenantStatusRule `yaml:"rules" json:"rules"`
+}
+
+// TenantStatusRule permits a method only for tenants in one of the allowed statuses,
+// or rejects it for tenants in one of the blocked statuses.
+type TenantStatusRule struct {
+	// Method is the full gRPC method name, e.g. /kms.api.cmk.registry.system.v1.Service/SetSystemLabels.
+	Method  string   `yaml:"method" json:"method"`
+	Allowed []string `yaml:"allowed" json:"allowed"`
+	Blocked []string `yaml:"blocked" json:"blocked"`
+}
+
+// Permits returns true if the method may be called for a tenant in the given status.
+func (r *TenantStatusRule) Permits(status string) bool {
+	if len(r.Allowed) > 0 {
+		return slices.Contains(r.Allowed, status)
+	}
+
+	return !slices.Contains(r.Blocked, status)
+}
+
+func (t *TenantStatusPolicy) Validate() error {
+	methods := make(map[string]struct{}, len(t.Rules))
+	for _, rule := range t.Rules {
+		if !strings.HasPrefix(rule.Method, "/") || strings.Count(rule.Method, "/") != 2 {
+			return fmt.Errorf("%w: %s", ErrInvalidPolicyMethod, rule.Method)
+		}
+
+		if _, ok := methods[rule.Method]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicatePolicyMethod, rule.Method)
+		}
+		methods[rule.Method] = struct{}{}
+
+		if (len(rule.Allowed) > 0) == (len(rule.Blocked) > 0) {
+			return fmt.Errorf("%w: %s", ErrPolicyAllowedAndBlocked, rule.Method)
+		}
+
+		for _, status := range slices.Concat(rule.Allowed, rule.Blocked) {
+			if _, ok := tenantgrpc.Status_value[status]; !ok {
+				return fmt.Errorf("%w: %s", ErrUnsupportedPolicyTenantStatus, status)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Backfill configures the background backfills of existing records, e.g. for new columns.
+// Backfills process one batch per interval, which bounds the load they put on the database.
+type Backfill struct {
+	Enabled   bool `yaml:"enabled" json:"enabled"`
+	BatchSize int  `yaml:"batchSize" json:"batchSize" default:"500"`
+	// BatchInterval is the pause between two batches of a backfill.
+	BatchInterval time.Duration `yaml:"batchInterval" json:"batchInterval" default:"1s"`
+	// BatchTimeout is the maximum duration of the transaction of a batch.
+	BatchTimeout time.Duration `yaml:"batchTimeout" json:"batchTimeout" default:"30s"`
+}
+
+func (b *Backfill) Validate() error {
+	if !b.Enabled {
+		return nil
+	}
+
+	if b.BatchSize <= 0 {
+		return fmt.Errorf("%w: %d", ErrBackfillBatchSizeNotPositive, b.BatchSize)
+	}
+
+	if b.BatchInterval <= 0 {
+		return fmt.Errorf("%w: %v", ErrBackfillBatchIntervalNotPositive, b.BatchInterval)
+	}
+
+	if b.BatchTimeout <= 0 {
+		return fmt.Errorf("%w: %v", ErrBackfillBatchTimeoutNotPositive, b.BatchTimeout)
+	}
+
+	return nil
+}
+
+// Labels configures the limits of the labels of all resources with labels, e.g. tenants and systems.
+// Zero disables a limit.
+type Labels struct {
+	// MaxLabels is the maximum number of labels of a resource.
+	MaxLabels int `yaml:"maxLabels" json:"maxLabels"`
+	// MaxValueLength is the maximum length of a label value in bytes.
+	MaxValueLength int `yaml:"maxValueLength" json:"maxValueLength"`
+	// Defaults are stamped on new resources, e.g. the environment or the deployment ring.
+	Defaults LabelDefaults `yaml:"defaults" json:"defaults"`
+	// Inheritance propagates tenant labels to the regional systems of the systems linked to the tenant.
+	Inheritance LabelInheritance `yaml:"inheritance" json:"inheritance"`
+	// Visibility hides internal labels from the callers which are not privileged.
+	Visibility LabelVisibility `yaml:"visibility" json:"visibility"`
+	// Index matches label selectors by a normalized index of the labels instead of evaluating the labels of each resource.
+	Index LabelIndex `yaml:"index" json:"index"`
+}
+
+// LabelDefaults are the labels stamped on new resources.
+// Labels of the request take precedence over the defaults with the same keys.
+type LabelDefaults struct {
+	Tenant map[string]string `yaml:"tenant" json:"tenant"`
+	System map[string]string `yaml:"system" json:"system"`
+}
+
+// LabelInheritance configures the tenant labels inherited by the regional systems of linked systems.
+// Labels are inherited when a system is linked and whenever the labels of the tenant change.
+// Inheritance is disabled without keys.
+type LabelInheritance struct {
+	// Keys are the keys of the inherited tenant labels.
+	Keys []string `yaml:"keys" json:"keys"`
+	// Conflict decides which value wins if a regional system has its own value for an inherited key.
+	// With keepSystem the value of the regional system is kept, with overwrite it is overwritten by the tenant value.
+	Conflict LabelConflict `yaml:"conflict" json:"conflict" default:"keepSystem"`
+}
+
+// LabelVisibility classifies labels as public or internal by the prefixes of their keys, e.g. labels carrying
+// routing or cost-center data. Internal labels are removed from the responses to all callers
+// but the operators and the admin callers. All labels are public without prefixes.
+type LabelVisibility struct {
+	// InternalPrefixes are the key prefixes of the internal labels.
+	InternalPrefixes []string `yaml:"internalPrefixes" json:"internalPrefixes"`
+	// Operators are the identities of the clients which see internal labels besides the admin callers,
+	// see CallerIdentity.
+	Operators []string `yaml:"operators" json:"operators"`
+}
+
+// LabelIndex configures the label index, which stores a row per label of the tenants, regional systems
+// and system groups and is maintained within the transactions mutating them.
+// The index of the existing resources is rebuilt at startup.
+type LabelIndex struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+}
+
+func (l *Labels) Validate() error {
+	if l.MaxLabels < 0 {
+		return fmt.Errorf("%w: %d", ErrMaxLabelsNegative, l.MaxLabels)
+	}
+
+	if l.MaxValueLength < 0 {
+		return fmt.Errorf("%w: %d", ErrMaxLabelValueLenNegative, l.MaxValueLength)
+	}
+
+	for _, defaults := range []map[string]string{l.Defaults.Tenant, l.Defaults.System} {
+		if err := l.validateDefaults(defaults); err != nil {
+			return err
+		}
+	}
+
+	if slices.Contains(l.Inheritance.Keys, "") {
+		return ErrEmptyLabelKey
+	}
+
+	// an empty prefix would make all labels internal
+	if slices.Contains(l.Visibility.InternalPrefixes, "") {
+		return ErrEmptyInternalLabelPrefix
+	}
+
+	switch l.Inheritance.Conflict {
+	case "", LabelConflictKeepSystem, LabelConflictOverwrite:
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedLabelConflict, l.Inheritance.Conflict)
+	}
+
+	return nil
+}
+
+// validateDefaults makes sure that the default labels do not exceed the limits on their own,
+// otherwise every new resource would be rejected.
+func (l *Labels) validateDefaults(defaults map[string]string) error {
+	if l.MaxLabels > 0 && len(defaults) > l.MaxLabels {
+		return fmt.Errorf("%w: %d labels", ErrDefaultLabelsExceedLimit, len(defaults))
+	}
+
+	for k, v := range defaults {
+		if k == "" {
+			return ErrEmptyLabelKey
+		}
+
+		if l.MaxValueLength > 0 && len(v) > l.MaxValueLength {
+			return fmt.Errorf("%w: value of %s", ErrDefaultLabelsExceedLimit, k)
+		}
+	}
+
+	return nil
+}
+
+// SystemLinkMetrics configures the recalculation of the system counts partitioned by tenant link status,
+// which resets the reported counts and reports their drift.
+// Zero disables the scheduled recalculation, the counts are then only recalculated at start and on demand.
+type SystemLinkMetrics struct {
+	RecalculationInterval time.Duration `yaml:"recalculationInterval" json:"recalculationInterval" default:"5m"`
+}
+
+func (m *SystemLinkMetrics) Validate() error {
+	if m.RecalculationInterval < 0 {
+		return fmt.Errorf("%w: %v", ErrLinkMetricsIntervalNegative, m.RecalculationInterval)
+	}
+
+	return nil
+}
+
+// SystemDiscovery configures the inbound channel on which regional operators report the systems they observe.
+type SystemDiscovery struct {
+	Enabled    bool        `yaml:"enabled" json:"enabled"`
+	Connection *Connection `yaml:"connection" json:"connection"`
+}
+
+func (d *SystemDiscovery) Validate() error {
+	if !d.Enabled {
+		return nil
+	}
+
+	if d.Connection == nil {
+		return ErrDiscoveryConnectionMissing
+	}
+
+	return d.Connection.validate()
+}
+
+// SystemClassification configures the allowed environments and criticalities of systems.
+// Unlike labels, the classification is structured, so it can be used for policy decisions.
+type SystemClassification struct {
+	Environments  []string `yaml:"environments" json:"environments" default:"[\"prod\",\"nonprod\"]"`
+	Criticalities []string `yaml:"criticalities" json:"criticalities" default:"[\"low\",\"medium\",\"high\"]"`
+}
+
+func (s *SystemClassification) Validate() error {
+	if slices.Contains(s.Environments, "") || slices.Contains(s.Criticalities, "") {
+		return ErrEmptySystemClassification
+	}
+
+	return nil
+}
+
+// SystemRelationships configures the allowed types of the relationships between systems,
+// e.g. an application system which is part of a landscape system or depends on another system.
+// The relationships of a type must not form a cycle.
+type SystemRelationships struct {
+	Types []string `yaml:"types" json:"types" default:"[\"partOf\",\"dependsOn\"]"`
+	// ExclusiveTypes are the types of which a system has at most one relationship, e.g. the landscape it is part of.
+	ExclusiveTypes []string `yaml:"exclusiveTypes" json:"exclusiveTypes" default:"[\"partOf\"]"`
+}
+
+func (r *SystemRelationships) Validate() error {
+	seen := make(map[string]struct{}, len(r.Types))
+	for _, relationshipType := range r.Types {
+		if relationshipType == "" {
+			return ErrEmptySystemRelationshipType
+		}
+
+		if _, ok := seen[relationshipType]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateSystemRelationshipType, relationshipType)
+		}
+		seen[relationshipType] = struct{}{}
+	}
+
+	for _, relationshipType := range r.ExclusiveTypes {
+		if _, ok := seen[relationshipType]; !ok {
+			return fmt.Errorf("%w: %s", ErrUnknownExclusiveRelationshipType, relationshipType)
+		}
+	}
+
+	return nil
+}
+
+// Warmup configures the warm-up of a starting instance, e.g. loading the records of hot tenants.
+// The instance reports ready once the warm-up finished or timed out,
+// so load balancers do not route the slow first requests to it.
+type Warmup struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"true"`
+	// HotTenants is the number of most recently updated tenants whose systems are loaded.
+	HotTenants int           `yaml:"hotTenants" json:"hotTenants" default:"100"`
+	Timeout    time.Duration `yaml:"timeout" json:"timeout" default:"1m"`
+}
+
+func (w *Warmup) Validate() error {
+	if !w.Enabled {
+		return nil
+	}
+
+	if w.HotTenants < 0 {
+		return fmt.Errorf("%w: %d", ErrWarmupHotTenantsNegative, w.HotTenants)
+	}
+
+	if w.Timeout <= 0 {
+		return fmt.Errorf("%w: %v", ErrWarmupTimeoutNotPositive, w.Timeout)
+	}
+
+	return nil
+}
+
+// Startup configures the retries of the dependencies of a starting instance, e.g. the database during a cold start
+// of the cluster. A failing dependency is retried with a backoff doubling up to MaxBackoff, each attempt within the
+// timeout of the dependency, and the instance only fails once the startup took longer than Budget.
+type Startup struct {
+	// Budget bounds the duration of the startup of all dependencies, 0 disables retries.
+	Budget time.Duration `yaml:"budget" json:"budget" default:"5m"`
+	// InitialBackoff is the backoff before the first retry of a dependency.
+	InitialBackoff time.Duration `yaml:"initialBackoff" json:"initialBackoff" default:"1s"`
+	// MaxBackoff bounds the backoff before a retry of a dependency.
+	MaxBackoff time.Duration   `yaml:"maxBackoff" json:"maxBackoff" default:"30s"`
+	Timeouts   StartupTimeouts `yaml:"timeouts" json:"timeouts"`
+}
+
+// StartupTimeouts bound the attempts to start the dependencies, 0 does not bound an attempt.
+type StartupTimeouts struct {
+	Telemetry  time.Duration `yaml:"telemetry" json:"telemetry" default:"30s"`
+	Database   time.Duration `yaml:"database" json:"database" default:"30s"`
+	Migrations time.Duration `yaml:"migrations" json:"migrations" default:"5m"`
+	Orbital    time.Duration `yaml:"orbital" json:"orbital" default:"1m"`
+}
+
+func (s *Startup) Validate() error {
+	durations := []time.Duration{
+		s.Budget, s.InitialBackoff, s.MaxBackoff,
+		s.Timeouts.Telemetry, s.Timeouts.Database, s.Timeouts.Migrations, s.Timeouts.Orbital,
+	}
+	if slices.ContainsFunc(durations, func(d time.Duration) bool { return d < 0 }) {
+		return fmt.Errorf("%w: %v", ErrStartupDurationNegative, durations)
+	}
+
+	return nil
+}
+
+// CapabilityReadiness configures the readiness of the instance per capability, served by the status server
+// in addition to its overall readiness, e.g. an instance whose AMQP brokers are unreachable still serves reads.
+// Each capability is checked within the timeout.
+type CapabilityReadiness struct {
+	Enabled bool          `yaml:"enabled" json:"enabled" default:"false"`
+	Timeout time.Duration `yaml:"timeout" json:"timeout" default:"5s"`
+}
+
+func (c *CapabilityReadiness) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Timeout <= 0 {
+		return fmt.Errorf("%w: %v", ErrCapabilityReadinessTimeoutNotPositive, c.Timeout)
+	}
+
+	return nil
+}
+
+// Concurrency limits the requests served concurrently per gRPC method,
+// so a client retrying aggressively can not exhaust the database connections.
+// Methods without limit are not restricted.
+type Concurrency struct {
+	Limits []ConcurrencyLimit `yaml:"limits" json:"limits"`
+}
+
+// ConcurrencyLimit limits the concurrent requests of a method. Requests exceeding MaxInFlight wait in a queue
+// of at most MaxQueued requests for up to QueueTimeout, they are rejected if the queue is full or the timeout elapses.
+type ConcurrencyLimit struct {
+	// Method is the full gRPC method name, e.g. /kms.api.cmk.registry.system.v1.Service/ListSystems.
+	Method       string        `yaml:"method" json:"method"`
+	MaxInFlight  int           `yaml:"maxInFlight" json:"maxInFlight"`
+	MaxQueued    int           `yaml:"maxQueued" json:"maxQueued"`
+	QueueTimeout time.Duration `yaml:"queueTimeout" json:"queueTimeout"`
+}
+
+func (c *Concurrency) Validate() error {
+	methods := make(map[string]struct{}, len(c.Limits))
+	for _, limit := range c.Limits {
+		if !strings.HasPrefix(limit.Method, "/") || strings.Count(limit.Method, "/") != 2 {
+			return fmt.Errorf("%w: %s", ErrInvalidConcurrencyMethod, limit.Method)
+		}
+
+		if _, ok := methods[limit.Method]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateConcurrencyMethod, limit.Method)
+		}
+		methods[limit.Method] = struct{}{}
+
+		if limit.MaxInFlight <= 0 {
+			return fmt.Errorf("%w: %s", ErrConcurrencyLimitNotPositive, limit.Method)
+		}
+
+		if limit.MaxQueued < 0 || (limit.MaxQueued > 0 && limit.QueueTimeout <= 0) {
+			return fmt.Errorf("%w: %s", ErrConcurrencyQueueInvalid, limit.Method)
+		}
+	}
+
+	return nil
+}
+
+// RequestCoalescing serves identical concurrent requests of the methods once, e.g. a client hedging
+// a MapSystemToTenant call, so the requests do not contend for the same rows.
+type RequestCoalescing struct {
+	// Methods are full gRPC method names, e.g. /kms.api.cmk.registry.mapping.v1.Service/MapSystemToTenant.
+	Methods []string `yaml:"methods" json:"methods" default:"[\"/kms.api.cmk.registry.mapping.v1.Service/MapSystemToTenant\",\"/kms.api.cmk.registry.mapping.v1.Service/UnmapSystemFromTenant\"]"`
+	// Timeout bounds the shared call of identical requests, which is not cancelled with the first request.
+	Timeout time.Duration `yaml:"timeout" json:"timeout" default:"30s"`
+}
+
+func (r *RequestCoalescing) Validate() error {
+	for _, method := range r.Methods {
+		if !strings.HasPrefix(method, "/") || strings.Count(method, "/") != 2 {
+			return fmt.Errorf("%w: %s", ErrInvalidCoalescingMethod, method)
+		}
+	}
+
+	if len(r.Methods) > 0 && r.Timeout <= 0 {
+		return fmt.Errorf("%w: %v", ErrCoalescingTimeoutNotPositive, r.Timeout)
+	}
+
+	return nil
+}
+
+// ValidationErrors configures the details of errors of rejected values.
+type ValidationErrors struct {
+	// MaxAllowedValues is the maximum number of allowed values listed by the error of a value which is not allowed.
+	// Zero lists all allowed values.
+	MaxAllowedValues int `yaml:"maxAllowedValues" json:"maxAllowedValues" default:"20"`
+}
+
+func (v *ValidationErrors) Validate() error {
+	if v.MaxAllowedValues < 0 {
+		return fmt.Errorf("%w: %d", ErrMaxAllowedValuesNegative, v.MaxAllowedValues)
+	}
+
+	return nil
+}
+
+// BatchValidation bounds the validation of the system identifiers of batch requests, e.g. the members of
+// a system group, which are validated concurrently and rejected with a violation per invalid identifier.
+type BatchValidation struct {
+	// MaxIdentifiers is the maximum number of identifiers of a request, larger requests are rejected
+	// before any identifier is validated. Zero allows any number.
+	MaxIdentifiers int `yaml:"maxIdentifiers" json:"maxIdentifiers" default:"1000"`
+	// MaxViolations is the maximum number of violations returned, the validation stops once they are found.
+	// Zero returns all violations.
+	MaxViolations int `yaml:"maxViolations" json:"maxViolations" default:"100"`
+	// Parallelism is the number of goroutines validating a batch, zero validates it sequentially.
+	Parallelism int `yaml:"parallelism" json:"parallelism" default:"4"`
+}
+
+func (b *BatchValidation) Validate() error {
+	if b.MaxIdentifiers < 0 || b.MaxViolations < 0 || b.Parallelism < 0 {
+		return fmt.Errorf("%w: %d, %d, %d", ErrBatchValidationNegative, b.MaxIdentifiers, b.MaxViolations, b.Parallelism)
+	}
+
+	return nil
+}
+
+// MaxRegistrationBatchSize bounds the batch size of RegisterSystems, so the parameters of a multi-row insert
+// stay below the limit of the database.
+const MaxRegistrationBatchSize = 1000
+
+// SystemRegistration configures the batches of systems registered by a stream of RegisterSystems.
+// A batch is inserted once it holds BatchSize requests or FlushInterval elapsed since the last batch.
+type SystemRegistration struct {
+	Enabled       bool          `yaml:"enabled" json:"enabled" default:"true"`
+	BatchSize     int           `yaml:"batchSize" json:"batchSize" default:"500"`
+	FlushInterval time.Duration `yaml:"flushInterval" json:"flushInterval" default:"1s"`
+}
+
+func (s *SystemRegistration) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	if s.BatchSize < 1 || s.BatchSize > MaxRegistrationBatchSize {
+		return fmt.Errorf("%w: %d", ErrRegistrationBatchSizeInvalid, s.BatchSize)
+	}
+
+	if s.FlushInterval <= 0 {
+		return fmt.Errorf("%w: %v", ErrRegistrationFlushIntervalInvalid, s.FlushInterval)
+	}
+
+	return nil
+}
+
+// MaxLookupBatchSize bounds the batch size of BatchGetSystems, so the parameters of its query
+// stay below the limit of the database.
+const MaxLookupBatchSize = 1000
+
+// SystemLookup configures the lookups of systems by their identifiers with BatchGetSystems.
+type SystemLookup struct {
+	Enabled      bool `yaml:"enabled" json:"enabled" default:"true"`
+	MaxBatchSize int  `yaml:"maxBatchSize" json:"maxBatchSize" default:"200"`
+}
+
+func (s *SystemLookup) Validate() error {
+	if !s.Enabled {
+		return nil
+	}
+
+	if s.MaxBatchSize < 1 || s.MaxBatchSize > MaxLookupBatchSize {
+		return fmt.Errorf("%w: %d", ErrLookupBatchSizeInvalid, s.MaxBatchSize)
+	}
+
+	return nil
+}
+
+// LogControl configures the runtime control of the log level, changed by admin calls and served read-only
+// by the status server. Changes of the level and debug sampling of gRPC methods revert after their duration,
+// which is at most MaxDuration.
+type LogControl struct {
+	Enabled     bool          `yaml:"enabled" json:"enabled" default:"false"`
+	MaxDuration time.Duration `yaml:"maxDuration" json:"maxDuration" default:"1h"`
+}
+
+func (l *LogControl) Validate() error {
+	if !l.Enabled {
+		return nil
+	}
+
+	if l.MaxDuration <= 0 {
+		return fmt.Errorf("%w: %v", ErrLogControlDurationNotPositive, l.MaxDuration)
+	}
+
+	return nil
+}
+
+// ConfigIntrospection configures the effective configuration returned by the admin service, with the source of
+// each value and the changes of the config files and environment since the configuration was loaded.
+type ConfigIntrospection struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+	// RedactedKeys are patterns of keys whose values are redacted, in addition to all secret references.
+	// Patterns use path.Match syntax and are matched case-insensitively.
+	RedactedKeys []string `yaml:"redactedKeys" json:"redactedKeys" default:"[\"*secret*\",\"*password*\",\"*token*\",\"*credential*\",\"*private*\"]"`
+}
+
+func (c *ConfigIntrospection) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	for _, pattern := range c.RedactedKeys {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidRedactionPattern, pattern)
+		}
+	}
+
+	return nil
+}
+
+// TenantTemplates are the onboarding templates of tenants by their names.
+// A tenant registered with a template is expanded by the server with the values of the template.
+type TenantTemplates map[string]TenantTemplate
+
+// TenantTemplate holds the values a tenant registered with the template starts with.
+// Labels and the role of the request take precedence over those of the template.
+type TenantTemplate struct {
+	// Labels are added to the labels of the tenant, taking precedence over the default tenant labels.
+	Labels map[string]string `yaml:"labels" json:"labels"`
+	// UserGroups are the user groups of the tenant.
+	UserGroups []string `yaml:"userGroups" json:"userGroups"`
+	// Role is the role of the tenant if the request does not set one, e.g. ROLE_LIVE.
+	Role string `yaml:"role" json:"role"`
+	// Auth is applied to the tenant once it is provisioned; optional.
+	Auth *TenantTemplateAuth `yaml:"auth" json:"auth"`
+	// FeatureFlags are the feature flags of the tenant.
+	FeatureFlags map[string]bool `yaml:"featureFlags" json:"featureFlags"`
+}
+
+// TenantTemplateAuth describes the auth applied to tenants of a template.
+type TenantTemplateAuth struct {
+	Type       string            `yaml:"type" json:"type"`
+	Properties map[string]string `yaml:"properties" json:"properties"`
+}
+
+func (t TenantTemplates) Validate() error {
+	for name, template := range t {
+		if name == "" {
+			return ErrEmptyTenantTemplateName
+		}
+
+		if template.Role != "" {
+			if _, ok := tenantgrpc.Role_value[template.Role]; !ok {
+				return fmt.Errorf("%w: %s: %s", ErrUnsupportedTenantTemplateRole, name, template.Role)
+			}
+		}
+
+		if template.Auth != nil && template.Auth.Type == "" {
+			return fmt.Errorf("%w: %s", ErrEmptyTenantTemplateAuthType, name)
+		}
+	}
+
+	return nil
+}
+
+// ChangeFeed configures the recording of the mutations of the resources as changes with a sequence,
+// which downstream indexers list to ingest every change exactly once.
+// Changes older than the retention are pruned every prune interval.
+type ChangeFeed struct {
+	Enabled       bool          `yaml:"enabled" json:"enabled" default:"false"`
+	Retention     time.Duration `yaml:"retention" json:"retention" default:"168h"`
+	PruneInterval time.Duration `yaml:"pruneInterval" json:"pruneInterval" default:"1h"`
+}
+
+func (c *ChangeFeed) Validate() error {
+	if !c.Enabled {
+		return nil
+	}
+
+	if c.Retention <= 0 {
+		return fmt.Errorf("%w: %v", ErrChangeFeedRetentionNotPositive, c.Retention)
+	}
+
+	if c.PruneInterval <= 0 {
+		return fmt.Errorf("%w: %v", ErrChangeFeedPruneIntervalNotPositive, c.PruneInterval)
+	}
+
+	return nil
+}
+
+// TenantTermination configures the termination of tenants.
+type TenantTermination struct {
+	// GracePeriod delays the terminate job of a tenant, so the termination can be canceled until it takes effect.
+	// Zero terminates tenants immediately.
+	GracePeriod time.Duration `yaml:"gracePeriod" json:"gracePeriod" default:"0s"`
+}
+
+func (t *TenantTermination) Validate() error {
+	if t.GracePeriod < 0 {
+		return fmt.Errorf("%w: %v", ErrNegativeTerminationGracePeriod, t.GracePeriod)
+	}
+
+	return nil
+}
+
+// TenantBlock configures the blocks of tenants for a period, which are unblocked once their blocks expire.
+type TenantBlock struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"true"`
+	// MaxDuration is the longest period a tenant can be blocked for. Zero does not restrict the period.
+	MaxDuration time.Duration `yaml:"maxDuration" json:"maxDuration" default:"720h"`
+	// CheckInterval is how often the expired blocks are unblocked.
+	CheckInterval time.Duration `yaml:"checkInterval" json:"checkInterval" default:"1m"`
+}
+
+func (t *TenantBlock) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+
+	if t.MaxDuration < 0 {
+		return fmt.Errorf("%w: %v", ErrTenantBlockDurationNegative, t.MaxDuration)
+	}
+
+	if t.CheckInterval <= 0 {
+		return fmt.Errorf("%w: %v", ErrTenantBlockCheckIntervalNotPositive, t.CheckInterval)
+	}
+
+	return nil
+}
+
+// ReadOnly returns true if the instance only serves the read calls.
+func (c *Config) ReadOnly() bool {
+	return c.Profile == ProfileReadOnly
+}
+
+// Fairness schedules the requests of the tenants by weighted fair queuing, so the automation of a single tenant
+// can not starve the others. Requests exceeding MaxInFlight wait in the queue of their tenant, the queues
+// are served in proportion to the weights of the tenants. Requests without tenant share one queue.
+type Fairness struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+	// MaxInFlight is the maximum number of requests served concurrently.
+	MaxInFlight int `yaml:"maxInFlight" json:"maxInFlight" default:"64"`
+	// MaxQueuedPerTenant is the maximum number of queued requests of a tenant, further requests are rejected.
+	MaxQueuedPerTenant int `yaml:"maxQueuedPerTenant" json:"maxQueuedPerTenant" default:"32"`
+	// QueueTimeout is how long a request waits in the queue before it is rejected.
+	QueueTimeout time.Duration `yaml:"queueTimeout" json:"queueTimeout" default:"2s"`
+	// MaxWait protects the tenants with low weights from starvation: a request waiting longer is served next.
+	MaxWait time.Duration `yaml:"maxWait" json:"maxWait" default:"500ms"`
+	// Weights are the weights of the tenants by tenant ID. Tenants without weight have the weight 1.
+	Weights map[string]int `yaml:"weights" json:"weights"`
+}
+
+func (f *Fairness) Validate() error {
+	if !f.Enabled {
+		return nil
+	}
+
+	if f.MaxInFlight <= 0 {
+		return fmt.Errorf("%w: %d", ErrFairnessMaxInFlightNotPositive, f.MaxInFlight)
+	}
+
+	if f.MaxQueuedPerTenant < 0 || (f.MaxQueuedPerTenant > 0 && f.QueueTimeout <= 0) {
+		return fmt.Errorf("%w: %d, %v", ErrFairnessQueueInvalid, f.MaxQueuedPerTenant, f.QueueTimeout)
+	}
+
+	if f.MaxWait <= 0 {
+		return fmt.Errorf("%w: %v", ErrFairnessMaxWaitNotPositive, f.MaxWait)
+	}
+
+	for tenantID, weight := range f.Weights {
+		if weight <= 0 {
+			return fmt.Errorf("%w: %s", ErrFairnessWeightNotPositive, tenantID)
+		}
+	}
+
+	return nil
+}
+
+// TenantNotifications configures the notifications of the lifecycle events of tenants. The events the admins
+// of a tenant opted into are posted to the webhook of the notification service, which delivers them to the channels
+// of the tenant, as the registry does not send mails itself.
+type TenantNotifications struct {
+	Enabled bool    `yaml:"enabled" json:"enabled" default:"false"`
+	Webhook Webhook `yaml:"webhook" json:"webhook"`
+}
+
+func (n *TenantNotifications) Validate() error {
+	if !n.Enabled {
+		return nil
+	}
+
+	if n.Webhook.URL == "" {
+		return ErrTenantNotificationsWebhookMissing
+	}
+
+	return n.Webhook.validate()
+}
+
+// Personal fields of tenants which are anonymized, see TenantAnonymization.
+const (
+	AnonymizedFieldOwnerID  = "ownerId"
+	AnonymizedFieldContacts = "contacts"
+)
+
+// TenantAnonymization configures the anonymization of the personal data of terminated tenants once the retention
+// period after their termination elapsed. The tenants are kept, so aggregates over the tenants stay complete.
+type TenantAnonymization struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+	// Retention is how long the personal data of a tenant is kept after its termination.
+	Retention time.Duration `yaml:"retention" json:"retention" default:"2160h"`
+	// CheckInterval is how often the tenants whose retention elapsed are anonymized.
+	CheckInterval time.Duration `yaml:"checkInterval" json:"checkInterval" default:"1h"`
+	// Fields are the personal fields cleared, ownerId and contacts.
+	Fields []string `yaml:"fields" json:"fields" default:"[\"ownerId\",\"contacts\"]"`
+	// LabelKeys are the keys of the labels removed, e.g. labels holding names or mail addresses.
+	LabelKeys []string `yaml:"labelKeys" json:"labelKeys"`
+}
+
+func (t *TenantAnonymization) Validate() error {
+	if !t.Enabled {
+		return nil
+	}
+
+	if t.Retention <= 0 {
+		return fmt.Errorf("%w: %v", ErrTenantAnonymizationRetentionNotPositive, t.Retention)
+	}
+
+	if t.CheckInterval <= 0 {
+		return fmt.Errorf("%w: %v", ErrTenantAnonymizationCheckIntervalNotPositive, t.CheckInterval)
+	}
+
+	for _, field := range t.Fields {
+		if field != AnonymizedFieldOwnerID && field != AnonymizedFieldContacts {
+			return fmt.Errorf("%w: %s", ErrUnsupportedAnonymizedField, field)
+		}
+	}
+
+	return nil
+}
+
+// AuthUniqueness configures the uniqueness of auths in addition to the uniqueness of their external ID.
+type AuthUniqueness struct {
+	// TenantType allows only one auth per tenant and type which is not removed, e.g. one OIDC auth per tenant.
+	// It is enforced by a partial unique index, which is dropped again once disabled.
+	TenantType bool `yaml:"tenantType" json:"tenantType" default:"false"`
+}
+
+// AuthPropertyBlobs configures the storage of large auth property values, e.g. certificates or JWKS documents,
+// in a table of their own instead of the properties of the auth. They are read with the auth transparently.
+type AuthPropertyBlobs struct {
+	// Threshold is the size in bytes above which a property value is stored as blob. Zero stores all values inline.
+	Threshold int `yaml:"threshold" json:"threshold" default:"8192"`
+	// MaxSize is the maximum size in bytes of a property value stored as blob, larger values are rejected.
+	MaxSize int `yaml:"maxSize" json:"maxSize" default:"1048576"`
+	// ChunkSize is the size in bytes of the chunks GetAuthProperty streams a property value in,
+	// at most MaxExportChunkSize, which it defaults to if zero.
+	ChunkSize int `yaml:"chunkSize" json:"chunkSize" default:"65536"`
+}
+
+func (a *AuthPropertyBlobs) Validate() error {
+	if a.Threshold < 0 {
+		return fmt.Errorf("%w: %d", ErrAuthPropertyThresholdNegative, a.Threshold)
+	}
+
+	if a.Threshold > 0 && a.MaxSize < a.Threshold {
+		return fmt.Errorf("%w: %d", ErrAuthPropertyMaxSizeTooSmall, a.MaxSize)
+	}
+
+	if a.ChunkSize < 0 || a.ChunkSize > MaxExportChunkSize {
+		return fmt.Errorf("%w: %d", ErrAuthPropertyChunkSizeInvalid, a.ChunkSize)
+	}
+
+	return nil
+}
+
+// ExternalIDIndex configures the external ID index, which stores a row per external ID of the tenants, systems
+// and auths, so a resource is resolved by its external ID regardless of its kind. The index is maintained
+// within the transactions mutating the resources and the index of the existing resources is rebuilt at startup.
+type ExternalIDIndex struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+}
+
+// TenantImport configures the import of tenants migrated from a legacy registry through the admin service,
+// which keeps their creation time, status and status history and does not provision them. It is meant
+// to be enabled for the duration of a migration only.
+type TenantImport struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+}
+
+// HookPhase is a phase of an operation at which hooks are called.
+type HookPhase string
+
+const (
+	// HookPhasePreValidate is once the message of the request is valid, before the operation validates the request.
+	HookPhasePreValidate HookPhase = "preValidate"
+	// HookPhasePreCommit is within each transaction of the operation before it commits.
+	HookPhasePreCommit HookPhase = "preCommit"
+	// HookPhasePostCommit is once the operation succeeded.
+	HookPhasePostCommit HookPhase = "postCommit"
+)
+
+// HookFailurePolicy defines how a failure of a hook, e.g. a timeout, is handled.
+// A hook rejecting an operation fails it regardless of the policy.
+type HookFailurePolicy string
+
+const (
+	// HookFailurePolicyFail fails the operation if the hook fails.
+	HookFailurePolicyFail HookFailurePolicy = "fail"
+	// HookFailurePolicyIgnore logs the failure of the hook and continues the operation.
+	HookFailurePolicyIgnore HookFailurePolicy = "ignore"
+)
+
+// Hooks configures the external hooks called by gRPC at the phases of operations, e.g. to check that a CMDB
+// entry exists before a system is registered. The hooks implement the HookService of the extension API.
+// Failures of postCommit hooks are logged only, as the operation is committed already.
+type Hooks struct {
+	Enabled  bool          `yaml:"enabled" json:"enabled" default:"false"`
+	Callouts []HookCallout `yaml:"callouts" json:"callouts"`
+}
+
+// HookCallout is an external hook of an operation.
+type HookCallout struct {
+	// Name identifies the hook in logs and errors.
+	Name string `yaml:"name" json:"name"`
+	// Operation is the full gRPC method name of the operation, e.g. /kms.api.cmk.registry.system.v1.Service/RegisterSystem.
+	Operation string `yaml:"operation" json:"operation"`
+	// Phases are the phases of the operation the hook is called at.
+	Phases []HookPhase `yaml:"phases" json:"phases"`
+	// Address is the address of the gRPC server of the hook.
+	Address string `yaml:"address" json:"address"`
+	Auth    Auth   `yaml:"auth" json:"auth"`
+	// Timeout is the maximum duration of a call of the hook, after which the call fails.
+	Timeout       time.Duration     `yaml:"timeout" json:"timeout" default:"2s"`
+	FailurePolicy HookFailurePolicy `yaml:"failurePolicy" json:"failurePolicy" default:"fail"`
+}
+
+func (h *Hooks) Validate() error {
+	if !h.Enabled {
+		return nil
+	}
+
+	for _, callout := range h.Callouts {
+		if err := callout.validate(); err != nil {
+			return fmt.Errorf("hook %s: %w", callout.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func (h *HookCallout) validate() error {
+	if h.Name == "" {
+		return ErrEmptyHookName
+	}
+
+	if !strings.HasPrefix(h.Operation, "/") || strings.Count(h.Operation, "/") != 2 {
+		return fmt.Errorf("%w: %s", ErrInvalidHookOperation, h.Operation)
+	}
+
+	for _, phase := range h.Phases {
+		switch phase {
+		case HookPhasePreValidate, HookPhasePreCommit, HookPhasePostCommit:
+		default:
+			return fmt.Errorf("%w: %s", ErrUnsupportedHookPhase, phase)
+		}
+	}
+
+	if h.Address == "" {
+		return ErrHookAddressMissing
+	}
+
+	if h.Timeout <= 0 {
+		return fmt.Errorf("%w: %v", ErrHookTimeoutNotPositive, h.Timeout)
+	}
+
+	switch h.FailurePolicy {
+	case HookFailurePolicyFail, HookFailurePolicyIgnore:
+	default:
+		return fmt.Errorf("%w: %s", ErrUnsupportedHookFailurePolicy, h.FailurePolicy)
+	}
+
+	return h.Auth.validate()
+}
+
+// SystemStatus configures the transitions between the statuses of regional systems
+// and the rollup of the statuses of the regional systems of a system.
+type SystemStatus struct {
+	// Transitions are the statuses a regional system may change to by its current status.
+	// Statuses without transitions may change to any status, so no transitions allow every change.
+	Transitions map[string][]string `yaml:"transitions" json:"transitions"`
+	// RollupOrder ranks the statuses from the least to the most available. The rollup status of a system
+	// is the least available status of its regional systems, statuses not ranked are the least available.
+	RollupOrder []string `yaml:"rollupOrder" json:"rollupOrder" default:"[\"STATUS_PROCESSING\",\"STATUS_AVAILABLE\"]"`
+}
+
+func (s *SystemStatus) Validate() error {
+	for from, to := range s.Transitions {
+		for _, status := range append([]string{from}, to...) {
+			if !isSystemStatus(status) {
+				return fmt.Errorf("%w: %s", ErrUnsupportedSystemStatus, status)
+			}
+		}
+	}
+
+	ranked := make(map[string]struct{}, len(s.RollupOrder))
+	for _, status := range s.RollupOrder {
+		if !isSystemStatus(status) {
+			return fmt.Errorf("%w: %s", ErrUnsupportedSystemStatus, status)
+		}
+
+		if _, ok := ranked[status]; ok {
+			return fmt.Errorf("%w: %s", ErrDuplicateRollupStatus, status)
+		}
+		ranked[status] = struct{}{}
+	}
+
+	return nil
+}
+
+// L2KeyPolicy configures the checks of the L2 key IDs of regional systems by their region when they are registered
+// or their L2 key is updated, so references to e.g. the keys of another region are rejected at the API.
+type L2KeyPolicy struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+	// Regions are the patterns of the L2 key IDs of the regional systems by region, an L2 key ID must match
+	// one pattern of its region. Patterns are regular expressions matching the whole L2 key ID, e.g. "eu10-.+".
+	Regions map[string][]string `yaml:"regions" json:"regions"`
+	// Default are the patterns of the regions without patterns. Without default patterns,
+	// the L2 key IDs of the regions without patterns are not checked.
+	Default []string `yaml:"default" json:"default"`
+}
+
+func (l *L2KeyPolicy) Validate() error {
+	if !l.Enabled {
+		return nil
+	}
+
+	if len(l.Regions) == 0 && len(l.Default) == 0 {
+		return ErrL2KeyPolicyNoPattern
+	}
+
+	for region, patterns := range l.Regions {
+		if len(patterns) == 0 {
+			return fmt.Errorf("%w: %s", ErrL2KeyPolicyNoPattern, region)
+		}
+
+		if err := validateL2KeyPatterns(patterns); err != nil {
+			return err
+		}
+	}
+
+	return validateL2KeyPatterns(l.Default)
+}
+
+func validateL2KeyPatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("%w: %s", ErrInvalidL2KeyPattern, pattern)
+		}
+	}
+
+	return nil
+}
+
+func isSystemStatus(status string) bool {
+	_, ok := typespb.Status_value[status]
+	return ok && status != typespb.Status_STATUS_UNSPECIFIED.String()
+}
+
+// Admin configures the admin gRPC service serving the administrative procedure calls,
+// e.g. verifying the integrity of the database or destroying tenants of test environments.
+type Admin struct {
+	Enabled bool `yaml:"enabled" json:"enabled" default:"false"`
+	// Callers are the identities of the clients permitted to call the admin service, see CallerIdentity.
+	Callers []string `yaml:"callers" json:"callers"`
+	// MaintenanceRefreshInterval is the interval in which each replica reads the maintenance mode set by an admin.
+	// Zero only reads it at start, replicas then only see the maintenance mode they set themselves.
+	MaintenanceRefreshInterval time.Duration `yaml:"maintenanceRefreshInterval" json:"maintenanceRefreshInterval" default:"10s"`
+}
+
+func (a *Admin) Validate() error {
+	if a.Enabled && len(a.Callers) == 0 {
+		return ErrAdminWithoutCallers
+	}
+
+	if a.MaintenanceRefreshInterval < 0 {
+		return fmt.Errorf("%w: %v", ErrMaintenanceIntervalNegative, a.MaintenanceRefreshInterval)
+	}
+
+	return nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
 )
 
 func TestValidateTarget(t *testing.T) {
@@ -268,6 +270,33 @@ func TestValidateWorker(t *testing.T) {
 			},
 			expErr: config.ErrTimeoutMustBeGreaterThanZero,
 		},
+		{
+			name: "scaled worker",
+			patchWorker: func(w config.Worker) config.Worker {
+				w.MaxWorkers = 5
+				w.BacklogPerWorker = 10
+				return w
+			},
+			expErr: nil,
+		},
+		{
+			name: "max workers less than number of workers",
+			patchWorker: func(w config.Worker) config.Worker {
+				w.NoOfWorkers = 5
+				w.MaxWorkers = 2
+				w.BacklogPerWorker = 10
+				return w
+			},
+			expErr: config.ErrMaxWorkersLessThanNoOfWorkers,
+		},
+		{
+			name: "scaled worker without backlog per worker",
+			patchWorker: func(w config.Worker) config.Worker {
+				w.MaxWorkers = 5
+				return w
+			},
+			expErr: config.ErrBacklogPerWorkerMustBeGreaterThanZero,
+		},
 	}
 
 	for _, tt := range tests {
@@ -357,6 +386,30 @@ func TestValidateOrbitalFields(t *testing.T) {
 			},
 			expErr: config.ErrBackoffMaxIntervalMustBeGreaterThanZero,
 		},
+		{
+			name: "unknown maintenance policy",
+			patch: func(o config.Orbital) config.Orbital {
+				o.MaintenancePolicy = "ignore"
+				return o
+			},
+			expErr: config.ErrMaintenancePolicyInvalid,
+		},
+		{
+			name: "negative replay interval",
+			patch: func(o config.Orbital) config.Orbital {
+				o.Replay.Interval = -time.Minute
+				return o
+			},
+			expErr: config.ErrJobReplayLimitNegative,
+		},
+		{
+			name: "negative replays per hour",
+			patch: func(o config.Orbital) config.Orbital {
+				o.Replay.MaxPerHour = -1
+				return o
+			},
+			expErr: config.ErrJobReplayLimitNegative,
+		},
 	}
 
 	for _, tt := range tests {
@@ -370,24 +423,1799 @@ func TestValidateOrbitalFields(t *testing.T) {
 	}
 }
 
-func deepCopyTarget(t config.Target) config.Target {
-	return config.Target{
-		Region: t.Region,
-		Connection: &config.Connection{
-			Type: t.Connection.Type,
-			AMQP: &config.AMQP{
-				URL:    t.Connection.AMQP.URL,
-				Source: t.Connection.AMQP.Source,
-				Target: t.Connection.AMQP.Target,
+func TestValidateSystemApproval(t *testing.T) {
+	validOrbital := config.Orbital{
+		TaskLimitNum:           10,
+		MaxPendingReconciles:   5,
+		BackoffBaseIntervalSec: 1,
+		BackoffMaxIntervalSec:  10,
+	}
+	validAdmin := config.Admin{Enabled: true, Callers: []string{"operator"}}
+
+	tests := []struct {
+		name     string
+		approval config.SystemApproval
+		expErr   error
+	}{
+		{
+			name:     "no approval configured",
+			approval: config.SystemApproval{},
+			expErr:   nil,
+		},
+		{
+			name: "valid approval",
+			approval: config.SystemApproval{
+				Types:   []string{"system"},
+				Webhook: config.Webhook{URL: "http://localhost:8080/approvals", Timeout: time.Second},
 			},
-			Auth: config.Auth{
-				Type: t.Connection.Auth.Type,
-				MTLS: &config.MTLS{
-					CertFile: t.Connection.Auth.MTLS.CertFile,
-					KeyFile:  t.Connection.Auth.MTLS.KeyFile,
-					CAFile:   t.Connection.Auth.MTLS.CAFile,
-				},
+			expErr: nil,
+		},
+		{
+			name: "missing webhook URL",
+			approval: config.SystemApproval{
+				Types: []string{"system"},
+			},
+			expErr: config.ErrApprovalWebhookMissing,
+		},
+		{
+			name: "empty system type",
+			approval: config.SystemApproval{
+				Types:   []string{""},
+				Webhook: config.Webhook{URL: "http://localhost:8080/approvals"},
+			},
+			expErr: config.ErrEmptyApprovalSystemType,
+		},
+		{
+			name: "negative webhook timeout",
+			approval: config.SystemApproval{
+				Types:   []string{"system"},
+				Webhook: config.Webhook{URL: "http://localhost:8080/approvals", Timeout: -time.Second},
+			},
+			expErr: config.ErrWebhookTimeoutMustNotBeNegative,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := config.Config{Orbital: validOrbital, SystemApproval: tt.approval, Admin: validAdmin}
+			err := c.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("requires the admin service", func(t *testing.T) {
+		c := config.Config{
+			Orbital: validOrbital,
+			SystemApproval: config.SystemApproval{
+				Types:   []string{"system"},
+				Webhook: config.Webhook{URL: "http://localhost:8080/approvals"},
 			},
+		}
+		assert.ErrorIs(t, c.Validate(), config.ErrApprovalWithoutAdmin)
+	})
+
+	t.Run("log control requires the admin service", func(t *testing.T) {
+		c := config.Config{
+			Orbital:    validOrbital,
+			LogControl: config.LogControl{Enabled: true, MaxDuration: time.Hour},
+		}
+		assert.ErrorIs(t, c.Validate(), config.ErrLogControlWithoutAdmin)
+	})
+
+	t.Run("config introspection requires the admin service", func(t *testing.T) {
+		c := config.Config{
+			Orbital:             validOrbital,
+			ConfigIntrospection: config.ConfigIntrospection{Enabled: true},
+		}
+		assert.ErrorIs(t, c.Validate(), config.ErrIntrospectionWithoutAdmin)
+	})
+
+	t.Run("requires approval only for configured types", func(t *testing.T) {
+		approval := config.SystemApproval{Types: []string{"system"}}
+		assert.True(t, approval.RequiresApproval("system"))
+		assert.False(t, approval.RequiresApproval("application"))
+	})
+}
+
+func TestValidateProfile(t *testing.T) {
+	validOrbital := config.Orbital{
+		TaskLimitNum:           10,
+		MaxPendingReconciles:   5,
+		BackoffBaseIntervalSec: 1,
+		BackoffMaxIntervalSec:  10,
+	}
+
+	tests := []struct {
+		name     string
+		profile  config.Profile
+		readOnly bool
+		expErr   error
+	}{
+		{name: "unset", profile: ""},
+		{name: "full", profile: config.ProfileFull},
+		{name: "read-only", profile: config.ProfileReadOnly, readOnly: true},
+		{name: "unsupported", profile: "writeOnly", expErr: config.ErrUnsupportedProfile},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := config.Config{Orbital: validOrbital, Profile: tt.profile}
+			err := c.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.readOnly, c.ReadOnly())
+		})
+	}
+}
+
+func TestValidateTenantID(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenantID config.TenantID
+		expErr   error
+	}{
+		{
+			name:     "any format",
+			tenantID: config.TenantID{},
+			expErr:   nil,
+		},
+		{
+			name:     "uuidv7 format with generation",
+			tenantID: config.TenantID{Format: config.TenantIDFormatUUIDv7, Generate: true},
+			expErr:   nil,
+		},
+		{
+			name:     "prefixed format",
+			tenantID: config.TenantID{Format: config.TenantIDFormatPrefixed, Prefix: "t-"},
+			expErr:   nil,
+		},
+		{
+			name:     "prefixed format without prefix",
+			tenantID: config.TenantID{Format: config.TenantIDFormatPrefixed},
+			expErr:   config.ErrEmptyTenantIDPrefix,
+		},
+		{
+			name:     "generation without format",
+			tenantID: config.TenantID{Generate: true},
+			expErr:   config.ErrTenantIDGenerationNoFormat,
+		},
+		{
+			name:     "unsupported format",
+			tenantID: config.TenantID{Format: "ulid"},
+			expErr:   config.ErrUnsupportedTenantIDFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.tenantID.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateInventorySnapshot(t *testing.T) {
+	tests := []struct {
+		name     string
+		snapshot config.InventorySnapshot
+		expErr   error
+	}{
+		{
+			name:     "disabled",
+			snapshot: config.InventorySnapshot{},
+			expErr:   nil,
+		},
+		{
+			name:     "enabled with interval",
+			snapshot: config.InventorySnapshot{Enabled: true, Interval: time.Hour},
+			expErr:   nil,
+		},
+		{
+			name:     "enabled without interval",
+			snapshot: config.InventorySnapshot{Enabled: true},
+			expErr:   config.ErrSnapshotIntervalMustBeGreaterThanZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.snapshot.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTenantPlacement(t *testing.T) {
+	tests := []struct {
+		name      string
+		placement config.TenantPlacement
+		expErr    error
+	}{
+		{
+			name:      "no capacities",
+			placement: config.TenantPlacement{},
+			expErr:    nil,
+		},
+		{
+			name:      "valid capacities",
+			placement: config.TenantPlacement{Capacity: map[string]int64{"region-1": 100}, NearCapacityRatio: 0.9},
+			expErr:    nil,
+		},
+		{
+			name:      "zero capacity",
+			placement: config.TenantPlacement{Capacity: map[string]int64{"region-1": 0}, NearCapacityRatio: 0.9},
+			expErr:    config.ErrRegionCapacityMustBeGreaterThanZero,
+		},
+		{
+			name:      "ratio out of range",
+			placement: config.TenantPlacement{Capacity: map[string]int64{"region-1": 100}, NearCapacityRatio: 1.5},
+			expErr:    config.ErrNearCapacityRatioOutOfRange,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.placement.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRegionUsage(t *testing.T) {
+	tests := []struct {
+		name   string
+		usage  config.RegionUsage
+		expErr error
+	}{
+		{
+			name:  "no soft limits",
+			usage: config.RegionUsage{},
+		},
+		{
+			name: "valid soft limits",
+			usage: config.RegionUsage{SoftLimits: map[string]config.RegionSoftLimits{
+				"region-1": {Tenants: 100, RegionalSystems: 1000},
+			}},
+		},
+		{
+			name: "negative soft limit",
+			usage: config.RegionUsage{SoftLimits: map[string]config.RegionSoftLimits{
+				"region-1": {Auths: -1},
+			}},
+			expErr: config.ErrSoftLimitNegative,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.usage.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateOpenAPI(t *testing.T) {
+	tests := []struct {
+		name    string
+		openAPI config.OpenAPI
+		expErr  error
+	}{
+		{
+			name:    "disabled without address",
+			openAPI: config.OpenAPI{},
+			expErr:  nil,
+		},
+		{
+			name:    "enabled with address",
+			openAPI: config.OpenAPI{Enabled: true, Address: ":8080", SwaggerUI: true},
+			expErr:  nil,
+		},
+		{
+			name:    "enabled without address",
+			openAPI: config.OpenAPI{Enabled: true},
+			expErr:  config.ErrEmptyOpenAPIAddress,
+		},
+		{
+			name:    "route cache control",
+			openAPI: config.OpenAPI{Enabled: true, Address: ":8080", RouteCacheControl: map[string]string{"/swagger": "max-age=3600"}},
+			expErr:  nil,
+		},
+		{
+			name:    "route cache control of a route which is not a path",
+			openAPI: config.OpenAPI{Enabled: true, Address: ":8080", RouteCacheControl: map[string]string{"swagger": "max-age=3600"}},
+			expErr:  config.ErrInvalidOpenAPIRoute,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.openAPI.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateOwnerIDEncryption(t *testing.T) {
+	keys := []config.EncryptionKey{{Version: 1}, {Version: 2}}
+
+	tests := []struct {
+		name       string
+		encryption config.OwnerIDEncryption
+		expErr     error
+	}{
+		{
+			name:       "disabled",
+			encryption: config.OwnerIDEncryption{},
+			expErr:     nil,
+		},
+		{
+			name:       "enabled with active key",
+			encryption: config.OwnerIDEncryption{Enabled: true, ActiveVersion: 2, Keys: keys},
+			expErr:     nil,
+		},
+		{
+			name:       "active key missing",
+			encryption: config.OwnerIDEncryption{Enabled: true, ActiveVersion: 3, Keys: keys},
+			expErr:     config.ErrActiveEncryptionKeyMissing,
+		},
+		{
+			name:       "duplicate key version",
+			encryption: config.OwnerIDEncryption{Enabled: true, ActiveVersion: 1, Keys: append(keys, config.EncryptionKey{Version: 1})},
+			expErr:     config.ErrDuplicateEncryptionKeyVersion,
 		},
+		{
+			name:       "re-encryption without batch size",
+			encryption: config.OwnerIDEncryption{Enabled: true, ActiveVersion: 1, Keys: keys, Reencrypt: true},
+			expErr:     config.ErrReencryptBatchSizeNotPositive,
+		},
+		{
+			name:       "more keys than owner ID filter values",
+			encryption: config.OwnerIDEncryption{Enabled: true, ActiveVersion: 1, Keys: encryptionKeys(repository.MaxFilterValues)},
+			expErr:     config.ErrTooManyEncryptionKeys,
+		},
+		{
+			name:       "most keys within owner ID filter values",
+			encryption: config.OwnerIDEncryption{Enabled: true, ActiveVersion: 1, Keys: encryptionKeys(repository.MaxFilterValues - 1)},
+			expErr:     nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.encryption.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func encryptionKeys(n int) []config.EncryptionKey {
+	keys := make([]config.EncryptionKey, 0, n)
+	for version := 1; version <= n; version++ {
+		keys = append(keys, config.EncryptionKey{Version: version})
+	}
+
+	return keys
+}
+
+func TestValidateTenantExport(t *testing.T) {
+	tests := []struct {
+		name   string
+		export config.TenantExport
+		expErr error
+	}{
+		{
+			name:   "no redaction",
+			export: config.TenantExport{},
+			expErr: nil,
+		},
+		{
+			name:   "valid patterns",
+			export: config.TenantExport{Directory: "/exports", RedactedKeys: []string{"*secret*", "token"}},
+			expErr: nil,
+		},
+		{
+			name:   "invalid pattern",
+			export: config.TenantExport{RedactedKeys: []string{"[secret"}},
+			expErr: config.ErrInvalidRedactionPattern,
+		},
+		{
+			name:   "negative chunk size",
+			export: config.TenantExport{ChunkSize: -1},
+			expErr: config.ErrExportChunkSizeInvalid,
+		},
+		{
+			name:   "chunk size above maximum",
+			export: config.TenantExport{ChunkSize: config.MaxExportChunkSize + 1},
+			expErr: config.ErrExportChunkSizeInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.export.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAuthPropertyBlobs(t *testing.T) {
+	tests := []struct {
+		name   string
+		blobs  config.AuthPropertyBlobs
+		expErr error
+	}{
+		{name: "default", blobs: config.AuthPropertyBlobs{Threshold: 8192, MaxSize: 1 << 20, ChunkSize: 1 << 16}},
+		{name: "inline only", blobs: config.AuthPropertyBlobs{}},
+		{name: "negative threshold", blobs: config.AuthPropertyBlobs{Threshold: -1}, expErr: config.ErrAuthPropertyThresholdNegative},
+		{name: "max size below threshold", blobs: config.AuthPropertyBlobs{Threshold: 8192, MaxSize: 4096}, expErr: config.ErrAuthPropertyMaxSizeTooSmall},
+		{name: "negative chunk size", blobs: config.AuthPropertyBlobs{ChunkSize: -1}, expErr: config.ErrAuthPropertyChunkSizeInvalid},
+		{
+			name:   "chunk size above maximum",
+			blobs:  config.AuthPropertyBlobs{ChunkSize: config.MaxExportChunkSize + 1},
+			expErr: config.ErrAuthPropertyChunkSizeInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.blobs.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTenantStatusPolicy(t *testing.T) {
+	const method = "/kms.api.cmk.registry.system.v1.Service/SetSystemLabels"
+
+	tests := []struct {
+		name   string
+		policy config.TenantStatusPolicy
+		expErr error
+	}{
+		{
+			name:   "no rules",
+			policy: config.TenantStatusPolicy{},
+			expErr: nil,
+		},
+		{
+			name: "valid rules",
+			policy: config.TenantStatusPolicy{Rules: []config.TenantStatusRule{
+				{Method: method, Blocked: []string{"STATUS_BLOCKED"}},
+				{Method: "/kms.api.cmk.registry.system.v1.Service/UpdateSystemStatus", Allowed: []string{"STATUS_ACTIVE"}},
+			}},
+			expErr: nil,
+		},
+		{
+			name:   "method without service",
+			policy: config.TenantStatusPolicy{Rules: []config.TenantStatusRule{{Method: "SetSystemLabels", Blocked: []string{"STATUS_BLOCKED"}}}},
+			expErr: config.ErrInvalidPolicyMethod,
+		},
+		{
+			name: "duplicate method",
+			policy: config.TenantStatusPolicy{Rules: []config.TenantStatusRule{
+				{Method: method, Blocked: []string{"STATUS_BLOCKED"}},
+				{Method: method, Blocked: []string{"STATUS_TERMINATED"}},
+			}},
+			expErr: config.ErrDuplicatePolicyMethod,
+		},
+		{
+			name:   "allowed and blocked statuses",
+			policy: config.TenantStatusPolicy{Rules: []config.TenantStatusRule{{Method: method, Allowed: []string{"STATUS_ACTIVE"}, Blocked: []string{"STATUS_BLOCKED"}}}},
+			expErr: config.ErrPolicyAllowedAndBlocked,
+		},
+		{
+			name:   "no statuses",
+			policy: config.TenantStatusPolicy{Rules: []config.TenantStatusRule{{Method: method}}},
+			expErr: config.ErrPolicyAllowedAndBlocked,
+		},
+		{
+			name:   "unknown status",
+			policy: config.TenantStatusPolicy{Rules: []config.TenantStatusRule{{Method: method, Blocked: []string{"BLOCKED"}}}},
+			expErr: config.ErrUnsupportedPolicyTenantStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestTenantStatusRulePermits(t *testing.T) {
+	blocking := config.TenantStatusRule{Blocked: []string{"STATUS_BLOCKED"}}
+	allowing := config.TenantStatusRule{Allowed: []string{"STATUS_ACTIVE"}}
+
+	assert.True(t, blocking.Permits("STATUS_ACTIVE"))
+	assert.False(t, blocking.Permits("STATUS_BLOCKED"))
+	assert.True(t, allowing.Permits("STATUS_ACTIVE"))
+	assert.False(t, allowing.Permits("STATUS_BLOCKED"))
+}
+
+func TestValidateBackfill(t *testing.T) {
+	valid := config.Backfill{Enabled: true, BatchSize: 500, BatchInterval: time.Second, BatchTimeout: 30 * time.Second}
+
+	tests := []struct {
+		name     string
+		backfill func(b config.Backfill) config.Backfill
+		expErr   error
+	}{
+		{
+			name:     "valid",
+			backfill: func(b config.Backfill) config.Backfill { return b },
+			expErr:   nil,
+		},
+		{
+			name: "disabled with zero values",
+			backfill: func(_ config.Backfill) config.Backfill {
+				return config.Backfill{}
+			},
+			expErr: nil,
+		},
+		{
+			name: "zero batch size",
+			backfill: func(b config.Backfill) config.Backfill {
+				b.BatchSize = 0
+				return b
+			},
+			expErr: config.ErrBackfillBatchSizeNotPositive,
+		},
+		{
+			name: "zero batch interval",
+			backfill: func(b config.Backfill) config.Backfill {
+				b.BatchInterval = 0
+				return b
+			},
+			expErr: config.ErrBackfillBatchIntervalNotPositive,
+		},
+		{
+			name: "negative batch timeout",
+			backfill: func(b config.Backfill) config.Backfill {
+				b.BatchTimeout = -time.Second
+				return b
+			},
+			expErr: config.ErrBackfillBatchTimeoutNotPositive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			backfill := tt.backfill(valid)
+
+			err := backfill.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDBPools(t *testing.T) {
+	tests := []struct {
+		name   string
+		pool   config.DBPool
+		expErr error
+	}{
+		{name: "disabled", pool: config.DBPool{}},
+		{
+			name: "limits and timeouts",
+			pool: config.DBPool{Enabled: true, MaxOpenConns: 10, MaxIdleConns: 5, ConnMaxIdleTime: time.Minute, StatementTimeout: 5 * time.Second},
+		},
+		{name: "idle without open limit", pool: config.DBPool{Enabled: true, MaxIdleConns: 5}},
+		{name: "negative open connections", pool: config.DBPool{MaxOpenConns: -1}, expErr: config.ErrDBPoolLimitNegative},
+		{name: "idle exceeds open", pool: config.DBPool{MaxOpenConns: 2, MaxIdleConns: 3}, expErr: config.ErrDBPoolIdleExceedsOpen},
+		{name: "negative statement timeout", pool: config.DBPool{StatementTimeout: -time.Second}, expErr: config.ErrDBPoolTimeoutNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := config.DB{Pools: config.DBPools{Admin: tt.pool}}
+
+			err := db.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTransactionRetry(t *testing.T) {
+	tests := []struct {
+		name   string
+		retry  config.TransactionRetry
+		expErr error
+	}{
+		{name: "retries", retry: config.TransactionRetry{MaxAttempts: 3, InitialBackoff: 20 * time.Millisecond, MaxBackoff: time.Second}},
+		{name: "no retries", retry: config.TransactionRetry{MaxAttempts: 1}},
+		{name: "disabled", retry: config.TransactionRetry{}},
+		{name: "negative attempts", retry: config.TransactionRetry{MaxAttempts: -1}, expErr: config.ErrTransactionRetryNegative},
+		{name: "negative backoff", retry: config.TransactionRetry{MaxAttempts: 3, InitialBackoff: -time.Millisecond}, expErr: config.ErrTransactionRetryNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.retry.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCircuitBreaker(t *testing.T) {
+	tests := []struct {
+		name    string
+		breaker config.CircuitBreaker
+		expErr  error
+	}{
+		{name: "enabled", breaker: config.CircuitBreaker{Enabled: true, FailureThreshold: 5, OpenDuration: 5 * time.Second}},
+		{name: "disabled", breaker: config.CircuitBreaker{}},
+		{name: "zero threshold", breaker: config.CircuitBreaker{Enabled: true, OpenDuration: 5 * time.Second}, expErr: config.ErrCircuitBreakerInvalid},
+		{name: "negative open duration", breaker: config.CircuitBreaker{Enabled: true, FailureThreshold: 5, OpenDuration: -time.Second}, expErr: config.ErrCircuitBreakerInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.breaker.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateStatusClock(t *testing.T) {
+	tests := []struct {
+		name   string
+		clock  config.StatusClock
+		expErr error
+	}{
+		{name: "enabled", clock: config.StatusClock{Enabled: true, MaxSkew: time.Minute}},
+		{name: "enabled without skew", clock: config.StatusClock{Enabled: true}},
+		{name: "disabled", clock: config.StatusClock{MaxSkew: -time.Minute}},
+		{name: "negative skew", clock: config.StatusClock{Enabled: true, MaxSkew: -time.Minute}, expErr: config.ErrStatusClockSkewNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.clock.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTableScan(t *testing.T) {
+	tests := []struct {
+		name   string
+		scan   config.TableScan
+		expErr error
+	}{
+		{name: "paced", scan: config.TableScan{PageSize: 500, RowsPerSecond: 5000}},
+		{name: "unlimited rate", scan: config.TableScan{PageSize: 1000}},
+		{name: "default page size", scan: config.TableScan{RowsPerSecond: 5000}},
+		{name: "negative page size", scan: config.TableScan{PageSize: -1}, expErr: config.ErrTableScanPageSizeInvalid},
+		{name: "page size above maximum", scan: config.TableScan{PageSize: 1001}, expErr: config.ErrTableScanPageSizeInvalid},
+		{name: "negative rate", scan: config.TableScan{PageSize: 500, RowsPerSecond: -1}, expErr: config.ErrTableScanRateNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.scan.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateStartup(t *testing.T) {
+	tests := []struct {
+		name    string
+		startup config.Startup
+		expErr  error
+	}{
+		{name: "retries", startup: config.Startup{Budget: 5 * time.Minute, InitialBackoff: time.Second, MaxBackoff: 30 * time.Second}},
+		{name: "without retries", startup: config.Startup{}},
+		{name: "negative budget", startup: config.Startup{Budget: -time.Second}, expErr: config.ErrStartupDurationNegative},
+		{
+			name:    "negative timeout",
+			startup: config.Startup{Budget: time.Minute, Timeouts: config.StartupTimeouts{Database: -time.Second}},
+			expErr:  config.ErrStartupDurationNegative,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.startup.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateDBMaintenance(t *testing.T) {
+	window := config.DBMaintenanceWindow{Weekdays: []string{"Sunday"}, StartTime: "02:00", Duration: 4 * time.Hour}
+	operation := config.DBMaintenanceOperation{Enabled: true, Threshold: 0.2}
+
+	tests := []struct {
+		name        string
+		maintenance config.DBMaintenance
+		expErr      error
+	}{
+		{name: "disabled", maintenance: config.DBMaintenance{}},
+		{name: "monitoring only", maintenance: config.DBMaintenance{Enabled: true, CheckInterval: time.Minute}},
+		{
+			name:        "scheduled operations",
+			maintenance: config.DBMaintenance{Enabled: true, CheckInterval: time.Minute, Vacuum: operation, Reindex: operation, Windows: []config.DBMaintenanceWindow{window}},
+		},
+		{name: "zero check interval", maintenance: config.DBMaintenance{Enabled: true}, expErr: config.ErrDBMaintenanceCheckIntervalNotPositive},
+		{
+			name:        "threshold above one",
+			maintenance: config.DBMaintenance{Enabled: true, CheckInterval: time.Minute, Reindex: config.DBMaintenanceOperation{Enabled: true, Threshold: 1.5}, Windows: []config.DBMaintenanceWindow{window}},
+			expErr:      config.ErrDBMaintenanceThresholdOutOfRange,
+		},
+		{
+			name:        "operations without windows",
+			maintenance: config.DBMaintenance{Enabled: true, CheckInterval: time.Minute, Vacuum: operation},
+			expErr:      config.ErrDBMaintenanceWithoutWindows,
+		},
+		{
+			name: "invalid window",
+			maintenance: config.DBMaintenance{Enabled: true, CheckInterval: time.Minute, Vacuum: operation,
+				Windows: []config.DBMaintenanceWindow{{Weekdays: []string{"Someday"}, StartTime: "02:00", Duration: time.Hour}}},
+			expErr: model.ErrInvalidMaintenanceWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.maintenance.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateLabels(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels config.Labels
+		expErr error
+	}{
+		{
+			name:   "no limits",
+			labels: config.Labels{},
+			expErr: nil,
+		},
+		{
+			name:   "limits",
+			labels: config.Labels{MaxLabels: 64, MaxValueLength: 256},
+			expErr: nil,
+		},
+		{
+			name:   "negative max labels",
+			labels: config.Labels{MaxLabels: -1},
+			expErr: config.ErrMaxLabelsNegative,
+		},
+		{
+			name:   "negative max value length",
+			labels: config.Labels{MaxValueLength: -1},
+			expErr: config.ErrMaxLabelValueLenNegative,
+		},
+		{
+			name: "defaults and inheritance",
+			labels: config.Labels{
+				MaxLabels: 2,
+				Defaults: config.LabelDefaults{
+					Tenant: map[string]string{"environment": "prod"},
+					System: map[string]string{"environment": "prod", "ring": "0"},
+				},
+				Inheritance: config.LabelInheritance{Keys: []string{"ring"}, Conflict: config.LabelConflictOverwrite},
+			},
+			expErr: nil,
+		},
+		{
+			name:   "defaults exceed max labels",
+			labels: config.Labels{MaxLabels: 1, Defaults: config.LabelDefaults{System: map[string]string{"a": "1", "b": "2"}}},
+			expErr: config.ErrDefaultLabelsExceedLimit,
+		},
+		{
+			name:   "default value too long",
+			labels: config.Labels{MaxValueLength: 1, Defaults: config.LabelDefaults{Tenant: map[string]string{"a": "12"}}},
+			expErr: config.ErrDefaultLabelsExceedLimit,
+		},
+		{
+			name:   "empty default key",
+			labels: config.Labels{Defaults: config.LabelDefaults{Tenant: map[string]string{"": "1"}}},
+			expErr: config.ErrEmptyLabelKey,
+		},
+		{
+			name:   "empty inherited key",
+			labels: config.Labels{Inheritance: config.LabelInheritance{Keys: []string{""}}},
+			expErr: config.ErrEmptyLabelKey,
+		},
+		{
+			name:   "unsupported conflict rule",
+			labels: config.Labels{Inheritance: config.LabelInheritance{Conflict: "merge"}},
+			expErr: config.ErrUnsupportedLabelConflict,
+		},
+		{
+			name: "internal labels",
+			labels: config.Labels{Visibility: config.LabelVisibility{
+				InternalPrefixes: []string{"routing.", "cost-center"},
+				Operators:        []string{"spiffe://example.org/operator"},
+			}},
+			expErr: nil,
+		},
+		{
+			name:   "empty internal label prefix",
+			labels: config.Labels{Visibility: config.LabelVisibility{InternalPrefixes: []string{""}}},
+			expErr: config.ErrEmptyInternalLabelPrefix,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.labels.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSystemLinkMetrics(t *testing.T) {
+	tests := []struct {
+		name     string
+		interval time.Duration
+		expErr   error
+	}{
+		{name: "valid", interval: 5 * time.Minute},
+		{name: "disabled schedule", interval: 0},
+		{name: "negative interval", interval: -time.Minute, expErr: config.ErrLinkMetricsIntervalNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := config.SystemLinkMetrics{RecalculationInterval: tt.interval}
+
+			err := cfg.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSystemDiscovery(t *testing.T) {
+	tests := []struct {
+		name      string
+		discovery config.SystemDiscovery
+		expErr    error
+	}{
+		{
+			name:      "disabled without connection",
+			discovery: config.SystemDiscovery{},
+		},
+		{
+			name: "enabled with connection",
+			discovery: config.SystemDiscovery{
+				Enabled: true,
+				Connection: &config.Connection{
+					Type: config.ConnectionTypeAMQP,
+					AMQP: &config.AMQP{URL: "amqp://localhost:5672", Source: "discovery", Target: "discovery-replies"},
+					Auth: config.Auth{Type: config.AuthTypeNone},
+				},
+			},
+		},
+		{
+			name:      "enabled without connection",
+			discovery: config.SystemDiscovery{Enabled: true},
+			expErr:    config.ErrDiscoveryConnectionMissing,
+		},
+		{
+			name: "enabled without AMQP configuration",
+			discovery: config.SystemDiscovery{
+				Enabled: true,
+				Connection: &config.Connection{
+					Type: config.ConnectionTypeAMQP,
+					Auth: config.Auth{Type: config.AuthTypeNone},
+				},
+			},
+			expErr: config.ErrAMQPConfigMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.discovery.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSystemClassification(t *testing.T) {
+	tests := []struct {
+		name           string
+		classification config.SystemClassification
+		expErr         error
+	}{
+		{
+			name: "environments and criticalities",
+			classification: config.SystemClassification{
+				Environments:  []string{"prod", "nonprod"},
+				Criticalities: []string{"low", "medium", "high"},
+			},
+		},
+		{
+			name:           "no environments and criticalities",
+			classification: config.SystemClassification{},
+		},
+		{
+			name:           "empty environment",
+			classification: config.SystemClassification{Environments: []string{"prod", ""}},
+			expErr:         config.ErrEmptySystemClassification,
+		},
+		{
+			name:           "empty criticality",
+			classification: config.SystemClassification{Criticalities: []string{""}},
+			expErr:         config.ErrEmptySystemClassification,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.classification.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSystemRelationships(t *testing.T) {
+	tests := []struct {
+		name          string
+		relationships config.SystemRelationships
+		expErr        error
+	}{
+		{
+			name:          "types and exclusive types",
+			relationships: config.SystemRelationships{Types: []string{"partOf", "dependsOn"}, ExclusiveTypes: []string{"partOf"}},
+		},
+		{
+			name:          "no types",
+			relationships: config.SystemRelationships{},
+		},
+		{
+			name:          "empty type",
+			relationships: config.SystemRelationships{Types: []string{"partOf", ""}},
+			expErr:        config.ErrEmptySystemRelationshipType,
+		},
+		{
+			name:          "duplicate type",
+			relationships: config.SystemRelationships{Types: []string{"partOf", "partOf"}},
+			expErr:        config.ErrDuplicateSystemRelationshipType,
+		},
+		{
+			name:          "unknown exclusive type",
+			relationships: config.SystemRelationships{Types: []string{"dependsOn"}, ExclusiveTypes: []string{"partOf"}},
+			expErr:        config.ErrUnknownExclusiveRelationshipType,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.relationships.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateWarmup(t *testing.T) {
+	tests := []struct {
+		name   string
+		warmup config.Warmup
+		expErr error
+	}{
+		{
+			name:   "enabled warm-up",
+			warmup: config.Warmup{Enabled: true, HotTenants: 100, Timeout: time.Minute},
+		},
+		{
+			name:   "disabled warm-up without timeout",
+			warmup: config.Warmup{},
+		},
+		{
+			name:   "negative hot tenants",
+			warmup: config.Warmup{Enabled: true, HotTenants: -1, Timeout: time.Minute},
+			expErr: config.ErrWarmupHotTenantsNegative,
+		},
+		{
+			name:   "zero timeout",
+			warmup: config.Warmup{Enabled: true, HotTenants: 100},
+			expErr: config.ErrWarmupTimeoutNotPositive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.warmup.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateCapabilityReadiness(t *testing.T) {
+	tests := []struct {
+		name      string
+		readiness config.CapabilityReadiness
+		expErr    error
+	}{
+		{
+			name:      "enabled capability readiness",
+			readiness: config.CapabilityReadiness{Enabled: true, Timeout: 5 * time.Second},
+		},
+		{
+			name:      "disabled capability readiness without timeout",
+			readiness: config.CapabilityReadiness{},
+		},
+		{
+			name:      "zero timeout",
+			readiness: config.CapabilityReadiness{Enabled: true},
+			expErr:    config.ErrCapabilityReadinessTimeoutNotPositive,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.readiness.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateConcurrency(t *testing.T) {
+	const method = "/kms.api.cmk.registry.system.v1.Service/ListSystems"
+
+	tests := []struct {
+		name   string
+		limits []config.ConcurrencyLimit
+		expErr error
+	}{
+		{
+			name:   "limit with queue",
+			limits: []config.ConcurrencyLimit{{Method: method, MaxInFlight: 10, MaxQueued: 20, QueueTimeout: time.Second}},
+		},
+		{
+			name:   "limit without queue",
+			limits: []config.ConcurrencyLimit{{Method: method, MaxInFlight: 10}},
+		},
+		{
+			name:   "invalid method",
+			limits: []config.ConcurrencyLimit{{Method: "ListSystems", MaxInFlight: 10}},
+			expErr: config.ErrInvalidConcurrencyMethod,
+		},
+		{
+			name:   "duplicate method",
+			limits: []config.ConcurrencyLimit{{Method: method, MaxInFlight: 10}, {Method: method, MaxInFlight: 5}},
+			expErr: config.ErrDuplicateConcurrencyMethod,
+		},
+		{
+			name:   "zero in flight",
+			limits: []config.ConcurrencyLimit{{Method: method}},
+			expErr: config.ErrConcurrencyLimitNotPositive,
+		},
+		{
+			name:   "queue without timeout",
+			limits: []config.ConcurrencyLimit{{Method: method, MaxInFlight: 10, MaxQueued: 20}},
+			expErr: config.ErrConcurrencyQueueInvalid,
+		},
+		{
+			name:   "negative queue",
+			limits: []config.ConcurrencyLimit{{Method: method, MaxInFlight: 10, MaxQueued: -1}},
+			expErr: config.ErrConcurrencyQueueInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			concurrency := config.Concurrency{Limits: tt.limits}
+
+			err := concurrency.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateRequestCoalescing(t *testing.T) {
+	tests := []struct {
+		name    string
+		methods []string
+		timeout time.Duration
+		expErr  error
+	}{
+		{name: "no methods"},
+		{name: "full method name", methods: []string{"/kms.api.cmk.registry.mapping.v1.Service/MapSystemToTenant"}, timeout: time.Second},
+		{name: "method name only", methods: []string{"MapSystemToTenant"}, timeout: time.Second, expErr: config.ErrInvalidCoalescingMethod},
+		{name: "no timeout", methods: []string{"/kms.api.cmk.registry.mapping.v1.Service/MapSystemToTenant"}, expErr: config.ErrCoalescingTimeoutNotPositive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			coalescing := config.RequestCoalescing{Methods: tt.methods, Timeout: tt.timeout}
+
+			err := coalescing.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func deepCopyTarget(t config.Target) config.Target {
+	return config.Target{
+		Region: t.Region,
+		Connection: &config.Connection{
+			Type: t.Connection.Type,
+			AMQP: &config.AMQP{
+				URL:    t.Connection.AMQP.URL,
+				Source: t.Connection.AMQP.Source,
+				Target: t.Connection.AMQP.Target,
+			},
+			Auth: config.Auth{
+				Type: t.Connection.Auth.Type,
+				MTLS: &config.MTLS{
+					CertFile: t.Connection.Auth.MTLS.CertFile,
+					KeyFile:  t.Connection.Auth.MTLS.KeyFile,
+					CAFile:   t.Connection.Auth.MTLS.CAFile,
+				},
+			},
+		},
+	}
+}
+
+func TestValidateValidationErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.ValidationErrors
+		expErr error
+	}{
+		{
+			name: "all allowed values",
+			cfg:  config.ValidationErrors{},
+		},
+		{
+			name: "limited allowed values",
+			cfg:  config.ValidationErrors{MaxAllowedValues: 20},
+		},
+		{
+			name:   "negative allowed values",
+			cfg:    config.ValidationErrors{MaxAllowedValues: -1},
+			expErr: config.ErrMaxAllowedValuesNegative,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateBatchValidation(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.BatchValidation
+		expErr error
+	}{
+		{name: "unbounded", cfg: config.BatchValidation{}},
+		{name: "bounded", cfg: config.BatchValidation{MaxIdentifiers: 1000, MaxViolations: 100, Parallelism: 4}},
+		{name: "negative identifiers", cfg: config.BatchValidation{MaxIdentifiers: -1}, expErr: config.ErrBatchValidationNegative},
+		{name: "negative violations", cfg: config.BatchValidation{MaxViolations: -1}, expErr: config.ErrBatchValidationNegative},
+		{name: "negative parallelism", cfg: config.BatchValidation{Parallelism: -1}, expErr: config.ErrBatchValidationNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSystemRegistration(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.SystemRegistration
+		expErr error
+	}{
+		{
+			name: "enabled registration",
+			cfg:  config.SystemRegistration{Enabled: true, BatchSize: 500, FlushInterval: time.Second},
+		},
+		{
+			name: "disabled registration without batch size",
+			cfg:  config.SystemRegistration{},
+		},
+		{
+			name:   "zero batch size",
+			cfg:    config.SystemRegistration{Enabled: true, FlushInterval: time.Second},
+			expErr: config.ErrRegistrationBatchSizeInvalid,
+		},
+		{
+			name:   "batch size exceeding the maximum",
+			cfg:    config.SystemRegistration{Enabled: true, BatchSize: config.MaxRegistrationBatchSize + 1, FlushInterval: time.Second},
+			expErr: config.ErrRegistrationBatchSizeInvalid,
+		},
+		{
+			name:   "zero flush interval",
+			cfg:    config.SystemRegistration{Enabled: true, BatchSize: 500},
+			expErr: config.ErrRegistrationFlushIntervalInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSystemLookup(t *testing.T) {
+	tests := []struct {
+		name   string
+		cfg    config.SystemLookup
+		expErr error
+	}{
+		{
+			name: "enabled lookup",
+			cfg:  config.SystemLookup{Enabled: true, MaxBatchSize: 200},
+		},
+		{
+			name: "disabled lookup without batch size",
+			cfg:  config.SystemLookup{},
+		},
+		{
+			name:   "zero batch size",
+			cfg:    config.SystemLookup{Enabled: true},
+			expErr: config.ErrLookupBatchSizeInvalid,
+		},
+		{
+			name:   "batch size exceeding the maximum",
+			cfg:    config.SystemLookup{Enabled: true, MaxBatchSize: config.MaxLookupBatchSize + 1},
+			expErr: config.ErrLookupBatchSizeInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.cfg.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateLogControl(t *testing.T) {
+	tests := []struct {
+		name       string
+		logControl config.LogControl
+		expErr     error
+	}{
+		{name: "enabled", logControl: config.LogControl{Enabled: true, MaxDuration: time.Hour}},
+		{name: "disabled without duration", logControl: config.LogControl{}},
+		{name: "zero duration", logControl: config.LogControl{Enabled: true}, expErr: config.ErrLogControlDurationNotPositive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.logControl.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateConfigIntrospection(t *testing.T) {
+	tests := []struct {
+		name          string
+		introspection config.ConfigIntrospection
+		expErr        error
+	}{
+		{name: "enabled", introspection: config.ConfigIntrospection{Enabled: true, RedactedKeys: []string{"*secret*"}}},
+		{name: "disabled with invalid pattern", introspection: config.ConfigIntrospection{RedactedKeys: []string{"["}}},
+		{
+			name:          "invalid pattern",
+			introspection: config.ConfigIntrospection{Enabled: true, RedactedKeys: []string{"["}},
+			expErr:        config.ErrInvalidRedactionPattern,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.introspection.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTenantTemplates(t *testing.T) {
+	tests := []struct {
+		name      string
+		templates config.TenantTemplates
+		expErr    error
+	}{
+		{name: "no templates"},
+		{
+			name: "valid template",
+			templates: config.TenantTemplates{"live": {
+				Labels:       map[string]string{"ring": "1"},
+				UserGroups:   []string{"admins"},
+				Role:         "ROLE_LIVE",
+				Auth:         &config.TenantTemplateAuth{Type: "OIDC", Properties: map[string]string{"issuer": "https://issuer"}},
+				FeatureFlags: map[string]bool{"keyRotation": true},
+			}},
+		},
+		{name: "empty name", templates: config.TenantTemplates{"": {}}, expErr: config.ErrEmptyTenantTemplateName},
+		{name: "unsupported role", templates: config.TenantTemplates{"live": {Role: "ROLE_UNKNOWN"}}, expErr: config.ErrUnsupportedTenantTemplateRole},
+		{name: "auth without type", templates: config.TenantTemplates{"live": {Auth: &config.TenantTemplateAuth{}}}, expErr: config.ErrEmptyTenantTemplateAuthType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.templates.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateChangeFeed(t *testing.T) {
+	tests := []struct {
+		name       string
+		changeFeed config.ChangeFeed
+		expErr     error
+	}{
+		{name: "enabled", changeFeed: config.ChangeFeed{Enabled: true, Retention: 168 * time.Hour, PruneInterval: time.Hour}},
+		{name: "disabled without retention", changeFeed: config.ChangeFeed{}},
+		{name: "zero retention", changeFeed: config.ChangeFeed{Enabled: true, PruneInterval: time.Hour}, expErr: config.ErrChangeFeedRetentionNotPositive},
+		{name: "zero prune interval", changeFeed: config.ChangeFeed{Enabled: true, Retention: time.Hour}, expErr: config.ErrChangeFeedPruneIntervalNotPositive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.changeFeed.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTenantTermination(t *testing.T) {
+	tests := []struct {
+		name        string
+		termination config.TenantTermination
+		expErr      error
+	}{
+		{name: "immediate", termination: config.TenantTermination{}},
+		{name: "grace period", termination: config.TenantTermination{GracePeriod: 72 * time.Hour}},
+		{name: "negative grace period", termination: config.TenantTermination{GracePeriod: -time.Hour}, expErr: config.ErrNegativeTerminationGracePeriod},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.termination.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTenantBlock(t *testing.T) {
+	tests := []struct {
+		name   string
+		block  config.TenantBlock
+		expErr error
+	}{
+		{name: "enabled", block: config.TenantBlock{Enabled: true, MaxDuration: 720 * time.Hour, CheckInterval: time.Minute}},
+		{name: "unrestricted duration", block: config.TenantBlock{Enabled: true, CheckInterval: time.Minute}},
+		{name: "disabled without check interval", block: config.TenantBlock{}},
+		{name: "negative duration", block: config.TenantBlock{Enabled: true, MaxDuration: -time.Hour, CheckInterval: time.Minute}, expErr: config.ErrTenantBlockDurationNegative},
+		{name: "zero check interval", block: config.TenantBlock{Enabled: true}, expErr: config.ErrTenantBlockCheckIntervalNotPositive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.block.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateTenantNotifications(t *testing.T) {
+	tests := []struct {
+		name          string
+		notifications config.TenantNotifications
+		expErr        error
+	}{
+		{name: "enabled", notifications: config.TenantNotifications{Enabled: true, Webhook: config.Webhook{URL: "http://localhost:8080/notifications", Timeout: time.Second}}},
+		{name: "disabled without webhook", notifications: config.TenantNotifications{}},
+		{name: "missing webhook URL", notifications: config.TenantNotifications{Enabled: true}, expErr: config.ErrTenantNotificationsWebhookMissing},
+		{
+			name:          "negative timeout",
+			notifications: config.TenantNotifications{Enabled: true, Webhook: config.Webhook{URL: "http://localhost:8080/notifications", Timeout: -time.Second}},
+			expErr:        config.ErrWebhookTimeoutMustNotBeNegative,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.notifications.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateHooks(t *testing.T) {
+	valid := config.HookCallout{
+		Name:          "cmdb",
+		Operation:     "/kms.api.cmk.registry.system.v1.Service/RegisterSystem",
+		Phases:        []config.HookPhase{config.HookPhasePreValidate, config.HookPhasePostCommit},
+		Address:       "cmdb-hook:9092",
+		Auth:          config.Auth{Type: config.AuthTypeNone},
+		Timeout:       time.Second,
+		FailurePolicy: config.HookFailurePolicyFail,
+	}
+
+	tests := []struct {
+		name   string
+		modify func(*config.HookCallout)
+		expErr error
+	}{
+		{name: "valid", modify: func(*config.HookCallout) {}},
+		{name: "empty name", modify: func(h *config.HookCallout) { h.Name = "" }, expErr: config.ErrEmptyHookName},
+		{name: "invalid operation", modify: func(h *config.HookCallout) { h.Operation = "RegisterSystem" }, expErr: config.ErrInvalidHookOperation},
+		{name: "unsupported phase", modify: func(h *config.HookCallout) { h.Phases = []config.HookPhase{"preDelete"} }, expErr: config.ErrUnsupportedHookPhase},
+		{name: "missing address", modify: func(h *config.HookCallout) { h.Address = "" }, expErr: config.ErrHookAddressMissing},
+		{name: "zero timeout", modify: func(h *config.HookCallout) { h.Timeout = 0 }, expErr: config.ErrHookTimeoutNotPositive},
+		{name: "unsupported failure policy", modify: func(h *config.HookCallout) { h.FailurePolicy = "retry" }, expErr: config.ErrUnsupportedHookFailurePolicy},
+		{name: "unsupported auth", modify: func(h *config.HookCallout) { h.Auth.Type = "basic" }, expErr: config.ErrUnsupportedAuthType},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callout := valid
+			tt.modify(&callout)
+			hooks := config.Hooks{Enabled: true, Callouts: []config.HookCallout{callout}}
+
+			err := hooks.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("disabled", func(t *testing.T) {
+		hooks := config.Hooks{Callouts: []config.HookCallout{{}}}
+		assert.NoError(t, hooks.Validate())
+	})
+}
+
+func TestValidateTenantAnonymization(t *testing.T) {
+	valid := config.TenantAnonymization{
+		Enabled:       true,
+		Retention:     2160 * time.Hour,
+		CheckInterval: time.Hour,
+		Fields:        []string{config.AnonymizedFieldOwnerID, config.AnonymizedFieldContacts},
+		LabelKeys:     []string{"owner-mail"},
+	}
+
+	tests := []struct {
+		name   string
+		modify func(a *config.TenantAnonymization)
+		expErr error
+	}{
+		{name: "enabled", modify: func(*config.TenantAnonymization) {}},
+		{name: "disabled without retention", modify: func(a *config.TenantAnonymization) { *a = config.TenantAnonymization{} }},
+		{name: "zero retention", modify: func(a *config.TenantAnonymization) { a.Retention = 0 }, expErr: config.ErrTenantAnonymizationRetentionNotPositive},
+		{name: "zero check interval", modify: func(a *config.TenantAnonymization) { a.CheckInterval = 0 }, expErr: config.ErrTenantAnonymizationCheckIntervalNotPositive},
+		{name: "unsupported field", modify: func(a *config.TenantAnonymization) { a.Fields = []string{"name"} }, expErr: config.ErrUnsupportedAnonymizedField},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			anonymization := valid
+			tt.modify(&anonymization)
+
+			err := anonymization.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateFairness(t *testing.T) {
+	valid := func() config.Fairness {
+		return config.Fairness{Enabled: true, MaxInFlight: 64, MaxQueuedPerTenant: 32, QueueTimeout: 2 * time.Second, MaxWait: time.Second}
+	}
+
+	tests := []struct {
+		name     string
+		fairness func(f *config.Fairness)
+		expErr   error
+	}{
+		{name: "enabled", fairness: func(*config.Fairness) {}},
+		{name: "weights", fairness: func(f *config.Fairness) { f.Weights = map[string]int{"tenant-a": 3} }},
+		{name: "without queue", fairness: func(f *config.Fairness) { f.MaxQueuedPerTenant, f.QueueTimeout = 0, 0 }},
+		{name: "disabled", fairness: func(f *config.Fairness) { *f = config.Fairness{} }},
+		{name: "zero in flight", fairness: func(f *config.Fairness) { f.MaxInFlight = 0 }, expErr: config.ErrFairnessMaxInFlightNotPositive},
+		{name: "negative queue", fairness: func(f *config.Fairness) { f.MaxQueuedPerTenant = -1 }, expErr: config.ErrFairnessQueueInvalid},
+		{name: "queue without timeout", fairness: func(f *config.Fairness) { f.QueueTimeout = 0 }, expErr: config.ErrFairnessQueueInvalid},
+		{name: "zero max wait", fairness: func(f *config.Fairness) { f.MaxWait = 0 }, expErr: config.ErrFairnessMaxWaitNotPositive},
+		{name: "zero weight", fairness: func(f *config.Fairness) { f.Weights = map[string]int{"tenant-a": 0} }, expErr: config.ErrFairnessWeightNotPositive},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fairness := valid()
+			tt.fairness(&fairness)
+
+			err := fairness.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateSystemStatus(t *testing.T) {
+	tests := []struct {
+		name         string
+		systemStatus config.SystemStatus
+		expErr       error
+	}{
+		{name: "default", systemStatus: config.SystemStatus{RollupOrder: []string{"STATUS_PROCESSING", "STATUS_AVAILABLE"}}},
+		{
+			name: "transitions",
+			systemStatus: config.SystemStatus{Transitions: map[string][]string{
+				"STATUS_PROCESSING": {"STATUS_AVAILABLE"},
+				"STATUS_AVAILABLE":  {"STATUS_PROCESSING"},
+			}},
+		},
+		{
+			name:         "unsupported transition status",
+			systemStatus: config.SystemStatus{Transitions: map[string][]string{"STATUS_PROCESSING": {"STATUS_GONE"}}},
+			expErr:       config.ErrUnsupportedSystemStatus,
+		},
+		{
+			name:         "unspecified transition status",
+			systemStatus: config.SystemStatus{Transitions: map[string][]string{"STATUS_UNSPECIFIED": {"STATUS_AVAILABLE"}}},
+			expErr:       config.ErrUnsupportedSystemStatus,
+		},
+		{
+			name:         "unsupported rollup status",
+			systemStatus: config.SystemStatus{RollupOrder: []string{"STATUS_GONE"}},
+			expErr:       config.ErrUnsupportedSystemStatus,
+		},
+		{
+			name:         "duplicate rollup status",
+			systemStatus: config.SystemStatus{RollupOrder: []string{"STATUS_AVAILABLE", "STATUS_AVAILABLE"}},
+			expErr:       config.ErrDuplicateRollupStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.systemStatus.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateL2KeyPolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy config.L2KeyPolicy
+		expErr error
+	}{
+		{name: "disabled", policy: config.L2KeyPolicy{}},
+		{name: "regions", policy: config.L2KeyPolicy{Enabled: true, Regions: map[string][]string{"eu10": {"eu10-.+"}}}},
+		{name: "default", policy: config.L2KeyPolicy{Enabled: true, Default: []string{"[a-z0-9-]+"}}},
+		{name: "no patterns", policy: config.L2KeyPolicy{Enabled: true}, expErr: config.ErrL2KeyPolicyNoPattern},
+		{
+			name:   "region without patterns",
+			policy: config.L2KeyPolicy{Enabled: true, Regions: map[string][]string{"eu10": {}}},
+			expErr: config.ErrL2KeyPolicyNoPattern,
+		},
+		{
+			name:   "invalid region pattern",
+			policy: config.L2KeyPolicy{Enabled: true, Regions: map[string][]string{"eu10": {"eu10-("}}},
+			expErr: config.ErrInvalidL2KeyPattern,
+		},
+		{
+			name:   "invalid default pattern",
+			policy: config.L2KeyPolicy{Enabled: true, Default: []string{"["}},
+			expErr: config.ErrInvalidL2KeyPattern,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.policy.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestValidateAdmin(t *testing.T) {
+	tests := []struct {
+		name   string
+		admin  config.Admin
+		expErr error
+	}{
+		{name: "disabled", admin: config.Admin{}},
+		{name: "enabled", admin: config.Admin{Enabled: true, Callers: []string{"spiffe://example.org/operator"}}},
+		{name: "enabled without callers", admin: config.Admin{Enabled: true}, expErr: config.ErrAdminWithoutCallers},
+		{name: "negative maintenance refresh interval", admin: config.Admin{MaintenanceRefreshInterval: -time.Second}, expErr: config.ErrMaintenanceIntervalNegative},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.admin.Validate()
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
 	}
 }
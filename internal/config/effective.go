@@ -0,0 +1,227 @@
+package config
+
+import (
+	"fmt"
+	"path"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+)
+
+// RedactedConfigValue replaces the values of secrets in the effective configuration.
+const RedactedConfigValue = "[REDACTED]"
+
+// Sources of the values of the effective configuration.
+const (
+	ValueSourceDefault = "default"
+	ValueSourceFile    = "file"
+	ValueSourceEnv     = "env"
+)
+
+// EffectiveValue is a value of the effective configuration with its source.
+type EffectiveValue struct {
+	// Path is the path of the keys of the value, e.g. database.host.
+	Path  string `json:"path"`
+	Value any    `json:"value"`
+	// Source is where the value comes from: the environment, the config file or the default.
+	Source string `json:"source"`
+}
+
+// ConfigChange is a value which differs between two versions of the effective configuration.
+// The previous or current value is nil if the value exists only in the other version.
+type ConfigChange struct {
+	Path     string `json:"path"`
+	Previous any    `json:"previous"`
+	Current  any    `json:"current"`
+}
+
+var sourceRefType = reflect.TypeFor[commoncfg.SourceRef]()
+
+// EffectiveValues returns the values of the configuration ordered by their path, with their sources determined
+// by the raw config file and the environment overrides, which the loader maps by upper-casing the path and
+// replacing dots by underscores, e.g. DATABASE_HOST. Slices and maps are values as a whole. Secret references
+// and the values with keys matching the redacted patterns, in path.Match syntax, are redacted.
+func EffectiveValues(cfg *Config, raw map[string]any, lookupEnv func(string) (string, bool), redactedKeys []string) []EffectiveValue {
+	var values []EffectiveValue
+	collectEffectiveValues(reflect.ValueOf(cfg).Elem(), "", redactedKeys, func(valuePath string, value any) {
+		values = append(values, EffectiveValue{
+			Path:   valuePath,
+			Value:  value,
+			Source: valueSource(valuePath, raw, lookupEnv),
+		})
+	})
+
+	slices.SortFunc(values, func(a, b EffectiveValue) int { return strings.Compare(a.Path, b.Path) })
+
+	return values
+}
+
+// DiffEffectiveValues returns the changes from the previous to the current effective configuration ordered by path.
+func DiffEffectiveValues(previous, current []EffectiveValue) []ConfigChange {
+	changes := []ConfigChange{}
+
+	previousByPath := make(map[string]any, len(previous))
+	for _, v := range previous {
+		previousByPath[v.Path] = v.Value
+	}
+
+	for _, v := range current {
+		before, ok := previousByPath[v.Path]
+		delete(previousByPath, v.Path)
+		if !ok || !reflect.DeepEqual(before, v.Value) {
+			changes = append(changes, ConfigChange{Path: v.Path, Previous: before, Current: v.Value})
+		}
+	}
+
+	for valuePath, before := range previousByPath {
+		changes = append(changes, ConfigChange{Path: valuePath, Previous: before})
+	}
+
+	slices.SortFunc(changes, func(a, b ConfigChange) int { return strings.Compare(a.Path, b.Path) })
+
+	return changes
+}
+
+// collectEffectiveValues calls add with the path and the redacted value of each value below the struct value v.
+func collectEffectiveValues(v reflect.Value, valuePath string, redactedKeys []string, add func(string, any)) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			add(valuePath, nil)
+			return
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct || v.Type() == sourceRefType || v.Type().PkgPath() == "time" {
+		add(valuePath, redactedConfigValue(v, valuePath, redactedKeys))
+		return
+	}
+
+	for key, field := range configFieldValues(v) {
+		collectEffectiveValues(field, keyPath(valuePath, key), redactedKeys, add)
+	}
+}
+
+// redactedConfigValue returns the value as plain data for JSON, with secret references and values
+// with redacted keys replaced by RedactedConfigValue and durations in their readable form.
+func redactedConfigValue(v reflect.Value, valuePath string, redactedKeys []string) any {
+	if isRedactedConfigKey(valuePath, redactedKeys) {
+		return RedactedConfigValue
+	}
+
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	switch {
+	case v.Type() == sourceRefType:
+		return RedactedConfigValue
+	case v.Type() == reflect.TypeFor[time.Duration]():
+		return time.Duration(v.Int()).String()
+	}
+
+	switch v.Kind() { //nolint:exhaustive
+	case reflect.Struct:
+		if v.Type().PkgPath() == "time" {
+			return fmt.Sprint(v.Interface())
+		}
+		values := make(map[string]any)
+		for key, field := range configFieldValues(v) {
+			values[key] = redactedConfigValue(field, keyPath(valuePath, key), redactedKeys)
+		}
+		return values
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		values := make([]any, 0, v.Len())
+		for i := range v.Len() {
+			values = append(values, redactedConfigValue(v.Index(i), fmt.Sprintf("%s[%d]", valuePath, i), redactedKeys))
+		}
+		return values
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		values := make(map[string]any, v.Len())
+		for iter := v.MapRange(); iter.Next(); {
+			key := fmt.Sprint(iter.Key().Interface())
+			values[key] = redactedConfigValue(iter.Value(), keyPath(valuePath, key), redactedKeys)
+		}
+		return values
+	case reflect.Func, reflect.Chan, reflect.UnsafePointer:
+		return nil
+	default:
+		return v.Interface()
+	}
+}
+
+// configFieldValues returns the values of the fields of the struct value v by their key, with the fields
+// of embedded and squashed structs inlined. The fields of nil embedded structs are skipped.
+func configFieldValues(v reflect.Value) map[string]reflect.Value {
+	indexes := configFieldIndexes(v.Type())
+	fields := make(map[string]reflect.Value, len(indexes))
+
+	for name, index := range indexes {
+		field, err := v.FieldByIndexErr(index)
+		if err != nil {
+			continue
+		}
+
+		fields[name] = field
+	}
+
+	return fields
+}
+
+// isRedactedConfigKey returns true if the last key of the path matches a redacted pattern, case-insensitively.
+func isRedactedConfigKey(valuePath string, patterns []string) bool {
+	key := strings.ToLower(valuePath[strings.LastIndex(valuePath, ".")+1:])
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), key); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// valueSource returns where the value of the path comes from.
+func valueSource(valuePath string, raw map[string]any, lookupEnv func(string) (string, bool)) string {
+	if lookupEnv != nil {
+		if _, ok := lookupEnv(strings.ToUpper(strings.ReplaceAll(valuePath, ".", "_"))); ok {
+			return ValueSourceEnv
+		}
+	}
+
+	if hasRawKey(raw, strings.Split(valuePath, ".")) {
+		return ValueSourceFile
+	}
+
+	return ValueSourceDefault
+}
+
+// hasRawKey returns true if the raw config contains the keys, matched case-insensitively like the loader does.
+func hasRawKey(raw map[string]any, keys []string) bool {
+	for key, value := range raw {
+		if !strings.EqualFold(key, keys[0]) {
+			continue
+		}
+
+		if len(keys) == 1 {
+			return true
+		}
+
+		if nested, ok := value.(map[string]any); ok && hasRawKey(nested, keys[1:]) {
+			return true
+		}
+	}
+
+	return false
+}
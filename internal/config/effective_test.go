@@ -0,0 +1,86 @@
+package config_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+func TestEffectiveValues(t *testing.T) {
+	// given
+	cfg := &config.Config{
+		Database: config.DB{
+			Host:     "db.example.org",
+			Password: commoncfg.SourceRef{},
+		},
+		LogControl: config.LogControl{MaxDuration: time.Hour},
+		TenantTemplates: config.TenantTemplates{"live": {
+			Labels: map[string]string{"ring": "1", "apiToken": "t0k3n"},
+		}},
+	}
+	raw := map[string]any{"Database": map[string]any{"host": "db.example.org"}}
+	env := map[string]string{"LOGCONTROL_MAXDURATION": "1h"}
+	lookupEnv := func(key string) (string, bool) {
+		value, ok := env[key]
+		return value, ok
+	}
+
+	// when
+	values := config.EffectiveValues(cfg, raw, lookupEnv, []string{"*token*"})
+
+	// then
+	byPath := make(map[string]config.EffectiveValue, len(values))
+	for _, v := range values {
+		byPath[v.Path] = v
+	}
+
+	assert.Equal(t, config.EffectiveValue{Path: "database.host", Value: "db.example.org", Source: config.ValueSourceFile}, byPath["database.host"])
+	assert.Equal(t, config.RedactedConfigValue, byPath["database.password"].Value)
+	assert.Equal(t, config.EffectiveValue{Path: "logControl.maxDuration", Value: "1h0m0s", Source: config.ValueSourceEnv}, byPath["logControl.maxDuration"])
+	assert.Equal(t, config.EffectiveValue{Path: "logControl.enabled", Value: false, Source: config.ValueSourceDefault}, byPath["logControl.enabled"])
+	assert.Equal(t, map[string]any{"live": map[string]any{
+		"labels":       map[string]any{"ring": "1", "apiToken": config.RedactedConfigValue},
+		"userGroups":   nil,
+		"role":         "",
+		"auth":         nil,
+		"featureFlags": nil,
+	}}, byPath["tenantTemplates"].Value)
+	assert.IsIncreasing(t, paths(values))
+}
+
+func TestDiffEffectiveValues(t *testing.T) {
+	// given
+	previous := []config.EffectiveValue{
+		{Path: "a", Value: "1"},
+		{Path: "b", Value: []any{"x"}},
+		{Path: "c", Value: true},
+	}
+	current := []config.EffectiveValue{
+		{Path: "a", Value: "1", Source: config.ValueSourceEnv},
+		{Path: "b", Value: []any{"y"}},
+		{Path: "d", Value: 2},
+	}
+
+	// when
+	changes := config.DiffEffectiveValues(previous, current)
+
+	// then
+	assert.Equal(t, []config.ConfigChange{
+		{Path: "b", Previous: []any{"x"}, Current: []any{"y"}},
+		{Path: "c", Previous: true},
+		{Path: "d", Current: 2},
+	}, changes)
+}
+
+func paths(values []config.EffectiveValue) []string {
+	result := make([]string, 0, len(values))
+	for _, v := range values {
+		result = append(result, v.Path)
+	}
+
+	return result
+}
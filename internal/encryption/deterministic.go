@@ -0,0 +1,194 @@
+// Package encryption provides the encryption of personal data stored by the registry.
+package encryption
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hkdf"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// KeySize is the size of the keys in bytes.
+const KeySize = 32
+
+const prefix = "enc:v"
+
+var (
+	ErrInvalidKeySize     = errors.New("encryption key must be 32 bytes")
+	ErrActiveKeyMissing   = errors.New("active encryption key version has no key")
+	ErrUnknownKeyVersion  = errors.New("value is encrypted with an unknown key version")
+	ErrMalformedValue     = errors.New("encrypted value is malformed")
+	ErrDecryptionFailed   = errors.New("failed to decrypt value")
+	ErrEncryptionDisabled = errors.New("value is encrypted but encryption is not configured")
+)
+
+// Deterministic encrypts values so that equal plaintexts under the same key version result in equal ciphertexts,
+// which allows to find encrypted values by equality.
+// The nonce of AES-GCM is derived from an HMAC of the plaintext (synthetic IV), so it only repeats for equal plaintexts.
+//
+// Encrypted values are prefixed by the key version, e.g. enc:v2:<base64>, so keys can be rotated:
+// values are encrypted with the active version and decrypted with the version they were encrypted with.
+// Values without prefix are treated as plaintext stored before encryption was enabled.
+type Deterministic struct {
+	keys   map[int]versionKey
+	active int
+}
+
+type versionKey struct {
+	aead cipher.AEAD
+	mac  []byte
+}
+
+// NewDeterministic creates a cipher with the given keys by version, encrypting with the active version.
+func NewDeterministic(keys map[int][]byte, active int) (*Deterministic, error) {
+	d := &Deterministic{
+		keys:   make(map[int]versionKey, len(keys)),
+		active: active,
+	}
+
+	for version, key := range keys {
+		if len(key) != KeySize {
+			return nil, fmt.Errorf("%w: version %d", ErrInvalidKeySize, version)
+		}
+
+		encKey, err := hkdf.Key(sha256.New, key, nil, "encryption", KeySize)
+		if err != nil {
+			return nil, err
+		}
+
+		macKey, err := hkdf.Key(sha256.New, key, nil, "synthetic-iv", KeySize)
+		if err != nil {
+			return nil, err
+		}
+
+		block, err := aes.NewCipher(encKey)
+		if err != nil {
+			return nil, err
+		}
+
+		aead, err := cipher.NewGCM(block)
+		if err != nil {
+			return nil, err
+		}
+
+		d.keys[version] = versionKey{aead: aead, mac: macKey}
+	}
+
+	if _, ok := d.keys[active]; !ok {
+		return nil, fmt.Errorf("%w: version %d", ErrActiveKeyMissing, active)
+	}
+
+	return d, nil
+}
+
+// Encrypt encrypts the plaintext with the active key version. Empty values are not encrypted.
+func (d *Deterministic) Encrypt(plaintext string) string {
+	return d.encrypt(d.active, plaintext)
+}
+
+// Decrypt returns the plaintext of an encrypted value, or the value itself if it is not encrypted.
+func (d *Deterministic) Decrypt(value string) (string, error) {
+	version, data, ok, err := parse(value)
+	if err != nil || !ok {
+		return value, err
+	}
+
+	key, ok := d.keys[version]
+	if !ok {
+		return "", fmt.Errorf("%w: version %d", ErrUnknownKeyVersion, version)
+	}
+
+	nonceSize := key.aead.NonceSize()
+	if len(data) < nonceSize {
+		return "", ErrMalformedValue
+	}
+
+	plaintext, err := key.aead.Open(nil, data[:nonceSize], data[nonceSize:], versionData(version))
+	if err != nil {
+		return "", ErrDecryptionFailed
+	}
+
+	return string(plaintext), nil
+}
+
+// Candidates returns all values the plaintext may be stored as: its encryption under every key version
+// and the plaintext itself, so values are found while a rotation is in progress.
+func (d *Deterministic) Candidates(plaintext string) []string {
+	candidates := make([]string, 0, len(d.keys)+1)
+	for version := range d.keys {
+		candidates = append(candidates, d.encrypt(version, plaintext))
+	}
+
+	return append(candidates, plaintext)
+}
+
+// Current returns true if the value is empty or encrypted with the active key version.
+func (d *Deterministic) Current(value string) bool {
+	return value == "" || strings.HasPrefix(value, d.prefix(d.active))
+}
+
+// ActivePrefix returns the prefix of values encrypted with the active key version.
+func (d *Deterministic) ActivePrefix() string {
+	return d.prefix(d.active)
+}
+
+func (d *Deterministic) encrypt(version int, plaintext string) string {
+	if plaintext == "" {
+		return ""
+	}
+
+	key := d.keys[version]
+
+	mac := hmac.New(sha256.New, key.mac)
+	mac.Write([]byte(plaintext))
+	nonce := mac.Sum(nil)[:key.aead.NonceSize()]
+
+	data := key.aead.Seal(nonce, nonce, []byte(plaintext), versionData(version))
+
+	return d.prefix(version) + base64.RawURLEncoding.EncodeToString(data)
+}
+
+func (d *Deterministic) prefix(version int) string {
+	return prefix + strconv.Itoa(version) + ":"
+}
+
+// IsEncrypted returns true if the value carries the prefix of an encrypted value.
+func IsEncrypted(value string) bool {
+	return strings.HasPrefix(value, prefix)
+}
+
+// parse splits an encrypted value into its key version and data.
+// ok is false if the value is not encrypted.
+func parse(value string) (version int, data []byte, ok bool, err error) {
+	if !IsEncrypted(value) {
+		return 0, nil, false, nil
+	}
+
+	versionStr, encoded, found := strings.Cut(strings.TrimPrefix(value, prefix), ":")
+	if !found {
+		return 0, nil, true, ErrMalformedValue
+	}
+
+	version, err = strconv.Atoi(versionStr)
+	if err != nil {
+		return 0, nil, true, ErrMalformedValue
+	}
+
+	data, err = base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, nil, true, ErrMalformedValue
+	}
+
+	return version, data, true, nil
+}
+
+// versionData binds the ciphertext to its key version.
+func versionData(version int) []byte {
+	return []byte(strconv.Itoa(version))
+}
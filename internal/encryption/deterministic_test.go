@@ -0,0 +1,149 @@
+package encryption_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/encryption"
+)
+
+var (
+	key1 = bytes.Repeat([]byte{1}, encryption.KeySize)
+	key2 = bytes.Repeat([]byte{2}, encryption.KeySize)
+)
+
+func TestNewDeterministic(t *testing.T) {
+	tests := []struct {
+		name   string
+		keys   map[int][]byte
+		active int
+		expErr error
+	}{
+		{
+			name:   "valid keys",
+			keys:   map[int][]byte{1: key1, 2: key2},
+			active: 2,
+		},
+		{
+			name:   "invalid key size",
+			keys:   map[int][]byte{1: []byte("short")},
+			active: 1,
+			expErr: encryption.ErrInvalidKeySize,
+		},
+		{
+			name:   "active key missing",
+			keys:   map[int][]byte{1: key1},
+			active: 2,
+			expErr: encryption.ErrActiveKeyMissing,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := encryption.NewDeterministic(tt.keys, tt.active)
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeterministicEncrypt(t *testing.T) {
+	// given
+	cipher, err := encryption.NewDeterministic(map[int][]byte{1: key1}, 1)
+	require.NoError(t, err)
+
+	// when
+	encrypted := cipher.Encrypt("owner@example.com")
+
+	// then
+	assert.True(t, strings.HasPrefix(encrypted, "enc:v1:"))
+	assert.NotContains(t, encrypted, "owner@example.com")
+	assert.Equal(t, encrypted, cipher.Encrypt("owner@example.com"), "encryption must be deterministic")
+	assert.NotEqual(t, encrypted, cipher.Encrypt("other@example.com"))
+	assert.Empty(t, cipher.Encrypt(""))
+
+	decrypted, err := cipher.Decrypt(encrypted)
+	require.NoError(t, err)
+	assert.Equal(t, "owner@example.com", decrypted)
+}
+
+func TestDeterministicRotation(t *testing.T) {
+	// given
+	previous, err := encryption.NewDeterministic(map[int][]byte{1: key1}, 1)
+	require.NoError(t, err)
+	current, err := encryption.NewDeterministic(map[int][]byte{1: key1, 2: key2}, 2)
+	require.NoError(t, err)
+
+	encrypted := previous.Encrypt("owner")
+
+	// when
+	decrypted, err := current.Decrypt(encrypted)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "owner", decrypted)
+	assert.False(t, current.Current(encrypted))
+	assert.True(t, current.Current(current.Encrypt("owner")))
+	assert.ElementsMatch(t, []string{encrypted, current.Encrypt("owner"), "owner"}, current.Candidates("owner"))
+}
+
+func TestDeterministicDecrypt(t *testing.T) {
+	cipher, err := encryption.NewDeterministic(map[int][]byte{1: key1}, 1)
+	require.NoError(t, err)
+
+	other, err := encryption.NewDeterministic(map[int][]byte{1: key2}, 1)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name     string
+		value    string
+		expValue string
+		expErr   error
+	}{
+		{
+			name:     "plaintext",
+			value:    "owner",
+			expValue: "owner",
+		},
+		{
+			name:   "unknown key version",
+			value:  "enc:v3:AAAA",
+			expErr: encryption.ErrUnknownKeyVersion,
+		},
+		{
+			name:   "malformed version",
+			value:  "enc:vx:AAAA",
+			expErr: encryption.ErrMalformedValue,
+		},
+		{
+			name:   "malformed data",
+			value:  "enc:v1:!",
+			expErr: encryption.ErrMalformedValue,
+		},
+		{
+			name:   "encrypted with another key",
+			value:  other.Encrypt("owner"),
+			expErr: encryption.ErrDecryptionFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			value, err := cipher.Decrypt(tt.value)
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				return
+			}
+
+			require.NoError(t, err)
+			assert.Equal(t, tt.expValue, value)
+		})
+	}
+}
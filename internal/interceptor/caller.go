@@ -0,0 +1,105 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// CallerIdentity records the authenticated identity of the client on the resources it creates and modifies.
+// The identity is the first URI, e.g. the SPIFFE ID, or the common name of the verified client certificate.
+// Without TLS connection, e.g. if a service mesh terminates mTLS, it is the value of the configured metadata key.
+// The configured client data headers of the client are added to the context as well, see repository.WithClientData.
+type CallerIdentity struct {
+	header            string
+	clientDataHeaders []string
+}
+
+// NewCallerIdentity will create a CallerIdentity instance.
+func NewCallerIdentity(cfg config.CallerIdentity) *CallerIdentity {
+	return &CallerIdentity{header: cfg.Header, clientDataHeaders: cfg.ClientDataHeaders}
+}
+
+// UnaryInterceptor adds the identity of the client to the context.
+func (c *CallerIdentity) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(c.withCaller(ctx), req)
+}
+
+// StreamInterceptor adds the identity of the client to the stream context.
+func (c *CallerIdentity) StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &metadataServerStream{
+		ServerStream: stream,
+		ctx:          c.withCaller(stream.Context()),
+	})
+}
+
+func (c *CallerIdentity) withCaller(ctx context.Context) context.Context {
+	if clientData := c.clientData(ctx); len(clientData) > 0 {
+		ctx = repository.WithClientData(ctx, clientData)
+	}
+
+	caller := c.caller(ctx)
+	if caller == "" {
+		return ctx
+	}
+
+	return repository.WithCaller(ctx, caller)
+}
+
+// clientData returns the first values of the client data headers of the request by header, skipping missing headers.
+func (c *CallerIdentity) clientData(ctx context.Context) map[string]string {
+	if len(c.clientDataHeaders) == 0 {
+		return nil
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	clientData := make(map[string]string, len(c.clientDataHeaders))
+	for _, header := range c.clientDataHeaders {
+		if values := md.Get(header); len(values) > 0 {
+			clientData[header] = values[0]
+		}
+	}
+
+	return clientData
+}
+
+// caller returns the identity of the client, empty if the client is not identified.
+func (c *CallerIdentity) caller(ctx context.Context) string {
+	if p, ok := peer.FromContext(ctx); ok {
+		if info, ok := p.AuthInfo.(credentials.TLSInfo); ok && len(info.State.VerifiedChains) > 0 && len(info.State.VerifiedChains[0]) > 0 {
+			cert := info.State.VerifiedChains[0][0]
+			if len(cert.URIs) > 0 {
+				return cert.URIs[0].String()
+			}
+			if cert.Subject.CommonName != "" {
+				return cert.Subject.CommonName
+			}
+		}
+	}
+
+	if c.header == "" {
+		return ""
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(c.header)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
@@ -0,0 +1,143 @@
+package interceptor_test
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+func TestCallerIdentityUnaryInterceptor(t *testing.T) {
+	const header = "x-client-id"
+
+	// withCert returns a context of a TLS connection with the verified client certificate.
+	withCert := func(ctx context.Context, cert *x509.Certificate) context.Context {
+		return peer.NewContext(ctx, &peer.Peer{AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}},
+		}})
+	}
+	spiffeID, err := url.Parse("spiffe://cluster.local/ns/cmk/sa/tenant-manager")
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		header    string
+		ctx       func(ctx context.Context) context.Context
+		expCaller string
+	}{
+		{
+			name:   "URI of the client certificate",
+			header: header,
+			ctx: func(ctx context.Context) context.Context {
+				return withCert(ctx, &x509.Certificate{URIs: []*url.URL{spiffeID}, Subject: pkix.Name{CommonName: "tenant-manager"}})
+			},
+			expCaller: spiffeID.String(),
+		},
+		{
+			name:   "common name of the client certificate",
+			header: header,
+			ctx: func(ctx context.Context) context.Context {
+				return withCert(ctx, &x509.Certificate{Subject: pkix.Name{CommonName: "tenant-manager"}})
+			},
+			expCaller: "tenant-manager",
+		},
+		{
+			name:   "configured header",
+			header: header,
+			ctx: func(ctx context.Context) context.Context {
+				return metadata.NewIncomingContext(ctx, metadata.Pairs(header, "system-manager"))
+			},
+			expCaller: "system-manager",
+		},
+		{
+			name:   "header is ignored if not configured",
+			header: "",
+			ctx: func(ctx context.Context) context.Context {
+				return metadata.NewIncomingContext(ctx, metadata.Pairs(header, "system-manager"))
+			},
+			expCaller: "",
+		},
+		{
+			name:      "unidentified client",
+			header:    header,
+			ctx:       func(ctx context.Context) context.Context { return ctx },
+			expCaller: "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := interceptor.NewCallerIdentity(config.CallerIdentity{Header: tt.header})
+
+			var caller string
+			handler := func(ctx context.Context, _ any) (any, error) {
+				caller = repository.CallerFromContext(ctx)
+				return "handled", nil
+			}
+
+			// when
+			_, err := subj.UnaryInterceptor(tt.ctx(t.Context()), nil, &grpc.UnaryServerInfo{}, handler)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expCaller, caller)
+		})
+	}
+}
+
+func TestCallerIdentityClientData(t *testing.T) {
+	subj := interceptor.NewCallerIdentity(config.CallerIdentity{ClientDataHeaders: []string{"x-client-data", "x-client-data-signature"}})
+
+	tests := []struct {
+		name          string
+		md            metadata.MD
+		expClientData map[string]string
+	}{
+		{
+			name:          "configured headers",
+			md:            metadata.Pairs("x-client-data", "data", "x-client-data-signature", "signature", "x-other", "other"),
+			expClientData: map[string]string{"x-client-data": "data", "x-client-data-signature": "signature"},
+		},
+		{
+			name:          "missing headers",
+			md:            metadata.Pairs("x-client-data", "data"),
+			expClientData: map[string]string{"x-client-data": "data"},
+		},
+		{
+			name: "no headers",
+			md:   metadata.Pairs("x-other", "other"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			var clientData map[string]string
+			handler := func(ctx context.Context, _ any) (any, error) {
+				clientData = repository.ClientDataFromContext(ctx)
+				return "handled", nil
+			}
+
+			// when
+			_, err := subj.UnaryInterceptor(metadata.NewIncomingContext(t.Context(), tt.md), nil, &grpc.UnaryServerInfo{}, handler)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expClientData, clientData)
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package interceptor
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+// minRetryDelay is the retry delay of the calls failing while the circuit is half-open.
+const minRetryDelay = time.Second
+
+// CircuitBreakerLookup reports the state of the database circuit breaker.
+type CircuitBreakerLookup interface {
+	// RetryAfter returns the remaining duration the circuit is open and true if the circuit is not closed.
+	RetryAfter() (time.Duration, bool)
+}
+
+// CircuitBreaker fails the calls fast with Unavailable and a retry delay while the database circuit is open,
+// instead of letting them wait for the database. Calls failing with an internal error while the circuit
+// is not closed, e.g. as their repository operations were rejected, fail with Unavailable as well,
+// so clients retry them instead of reporting internal errors during a database failover.
+// While the circuit is half-open, the calls pass, so one of them can probe the database.
+type CircuitBreaker struct {
+	lookup CircuitBreakerLookup
+}
+
+// NewCircuitBreaker will create a CircuitBreaker instance.
+func NewCircuitBreaker(lookup CircuitBreakerLookup) *CircuitBreaker {
+	return &CircuitBreaker{
+		lookup: lookup,
+	}
+}
+
+// UnaryInterceptor rejects the call while the circuit is open and maps its internal errors while it is not closed.
+func (c *CircuitBreaker) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := c.reject(ctx, info.FullMethod); err != nil {
+		return nil, err
+	}
+
+	resp, err := handler(ctx, req)
+
+	return resp, c.mapError(err)
+}
+
+// StreamInterceptor rejects the stream while the circuit is open and maps its internal errors while it is not closed.
+func (c *CircuitBreaker) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := c.reject(stream.Context(), info.FullMethod); err != nil {
+		return err
+	}
+
+	return c.mapError(handler(srv, stream))
+}
+
+// reject returns ErrDatabaseUnavailable with the remaining open duration as retry delay if the circuit is open.
+func (c *CircuitBreaker) reject(ctx context.Context, fullMethod string) error {
+	delay, open := c.lookup.RetryAfter()
+	if !open || delay <= 0 {
+		return nil
+	}
+
+	slogctx.Debug(ctx, "request rejected by open database circuit", "method", fullMethod, "retryDelay", delay)
+
+	return service.ErrorWithRetryDelay(service.ErrDatabaseUnavailable, delay)
+}
+
+// mapError returns ErrDatabaseUnavailable for internal errors while the circuit is not closed.
+func (c *CircuitBreaker) mapError(err error) error {
+	if status.Code(err) != codes.Internal {
+		return err
+	}
+
+	delay, open := c.lookup.RetryAfter()
+	if !open {
+		return err
+	}
+
+	return service.ErrorWithRetryDelay(service.ErrDatabaseUnavailable, max(delay, minRetryDelay))
+}
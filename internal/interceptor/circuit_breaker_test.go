@@ -0,0 +1,96 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// circuitLookup is a circuit which is not closed if open is set.
+type circuitLookup struct {
+	delay time.Duration
+	open  bool
+}
+
+func (l circuitLookup) RetryAfter() (time.Duration, bool) {
+	return l.delay, l.open
+}
+
+func TestCircuitBreakerUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name       string
+		circuit    circuitLookup
+		handlerErr error
+		expCalled  bool
+		expCode    codes.Code
+		expDelay   time.Duration
+	}{
+		{
+			name:      "should pass calls while the circuit is closed",
+			expCalled: true,
+			expCode:   codes.OK,
+		},
+		{
+			name:       "should keep internal errors while the circuit is closed",
+			handlerErr: service.ErrTenantSelect,
+			expCalled:  true,
+			expCode:    codes.Internal,
+		},
+		{
+			name:     "should reject calls while the circuit is open",
+			circuit:  circuitLookup{delay: 3 * time.Second, open: true},
+			expCode:  codes.Unavailable,
+			expDelay: 3 * time.Second,
+		},
+		{
+			name:       "should map internal errors while the circuit is half-open",
+			circuit:    circuitLookup{open: true},
+			handlerErr: service.ErrTenantSelect,
+			expCalled:  true,
+			expCode:    codes.Unavailable,
+			expDelay:   time.Second,
+		},
+		{
+			name:       "should keep other errors while the circuit is half-open",
+			circuit:    circuitLookup{open: true},
+			handlerErr: service.ErrTenantNotFound,
+			expCalled:  true,
+			expCode:    codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := interceptor.NewCircuitBreaker(tt.circuit)
+			called := false
+			handler := func(_ context.Context, _ any) (any, error) {
+				called = true
+				return "handled", tt.handlerErr
+			}
+
+			// when
+			_, err := subj.UnaryInterceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+
+			// then
+			assert.Equal(t, tt.expCalled, called)
+			sts, _ := status.FromError(err)
+			assert.Equal(t, tt.expCode, sts.Code())
+			if tt.expDelay > 0 {
+				assert.Len(t, sts.Details(), 1)
+				retryInfo, ok := sts.Details()[0].(*errdetails.RetryInfo)
+				assert.True(t, ok)
+				assert.Equal(t, tt.expDelay, retryInfo.GetRetryDelay().AsDuration())
+			}
+		})
+	}
+}
@@ -0,0 +1,168 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+var (
+	errQueueFull    = errors.New("queue of the method is full")
+	errQueueTimeout = errors.New("queue timeout elapsed")
+)
+
+// ConcurrencyLimiter counts the requests in flight per method and limits the concurrent requests of the configured methods,
+// so a client retrying aggressively can not exhaust the database connections.
+// Requests exceeding the limit of their method wait in a bounded queue for a free slot,
+// they are rejected with ResourceExhausted if the queue is full or the queue timeout elapses.
+type ConcurrencyLimiter struct {
+	limits map[string]*methodLimit
+
+	mu       sync.Mutex
+	inFlight map[string]int64
+	queued   map[string]int64
+}
+
+// methodLimit holds the slots of the requests of a method served concurrently.
+type methodLimit struct {
+	slots        chan struct{}
+	maxQueued    int64
+	queueTimeout time.Duration
+}
+
+// NewConcurrencyLimiter will create a ConcurrencyLimiter instance.
+func NewConcurrencyLimiter(cfg config.Concurrency) *ConcurrencyLimiter {
+	limits := make(map[string]*methodLimit, len(cfg.Limits))
+	for _, limit := range cfg.Limits {
+		limits[limit.Method] = &methodLimit{
+			slots:        make(chan struct{}, limit.MaxInFlight),
+			maxQueued:    int64(limit.MaxQueued),
+			queueTimeout: limit.QueueTimeout,
+		}
+	}
+
+	return &ConcurrencyLimiter{
+		limits:   limits,
+		inFlight: make(map[string]int64),
+		queued:   make(map[string]int64),
+	}
+}
+
+// RegisterMeters registers the gauges of the requests in flight and queued, partitioned by method.
+func (l *ConcurrencyLimiter) RegisterMeters(ctx context.Context, registry *service.MeterRegistry) error {
+	err := registry.ObservableGauge(ctx, "grpc.requests.in_flight", "Gauge of gRPC requests in flight, partitioned by method",
+		func(_ context.Context, observer metric.Int64Observer) error {
+			l.observe(observer, l.inFlight)
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	return registry.ObservableGauge(ctx, "grpc.requests.queued", "Gauge of gRPC requests waiting for the concurrency limit, partitioned by method",
+		func(_ context.Context, observer metric.Int64Observer) error {
+			l.observe(observer, l.queued)
+			return nil
+		})
+}
+
+// UnaryInterceptor serves the request once the limit of its method permits it.
+func (l *ConcurrencyLimiter) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	release, err := l.acquire(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor serves the stream once the limit of its method permits it.
+func (l *ConcurrencyLimiter) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	release, err := l.acquire(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	defer release()
+
+	return handler(srv, stream)
+}
+
+// acquire waits for a slot of the method and counts the request as in flight.
+// The returned function releases the slot once the request is served.
+func (l *ConcurrencyLimiter) acquire(ctx context.Context, method string) (func(), error) {
+	limit, ok := l.limits[method]
+	if ok {
+		err := l.waitForSlot(ctx, method, limit)
+		if err != nil {
+			slogctx.Warn(ctx, "request rejected by concurrency limit", "method", method, "error", err)
+			return nil, service.ErrConcurrencyLimit
+		}
+	}
+
+	l.add(l.inFlight, method, 1)
+
+	return func() {
+		l.add(l.inFlight, method, -1)
+
+		if ok {
+			<-limit.slots
+		}
+	}, nil
+}
+
+// waitForSlot takes a free slot of the method, or waits in the queue for one if the queue is not full.
+func (l *ConcurrencyLimiter) waitForSlot(ctx context.Context, method string, limit *methodLimit) error {
+	select {
+	case limit.slots <- struct{}{}:
+		return nil
+	default:
+	}
+
+	if l.add(l.queued, method, 1) > limit.maxQueued {
+		l.add(l.queued, method, -1)
+		return errQueueFull
+	}
+	defer l.add(l.queued, method, -1)
+
+	timer := time.NewTimer(limit.queueTimeout)
+	defer timer.Stop()
+
+	select {
+	case limit.slots <- struct{}{}:
+		return nil
+	case <-timer.C:
+		return errQueueTimeout
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// add adds delta to the count of the method and returns the new count.
+func (l *ConcurrencyLimiter) add(counts map[string]int64, method string, delta int64) int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	counts[method] += delta
+
+	return counts[method]
+}
+
+func (l *ConcurrencyLimiter) observe(observer metric.Int64Observer, counts map[string]int64) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	for method, count := range counts {
+		observer.Observe(count, metric.WithAttributes(attribute.String(service.AttrRPCMethod, method)))
+	}
+}
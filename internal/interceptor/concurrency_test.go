@@ -0,0 +1,157 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+const listSystemsMethod = "/kms.api.cmk.registry.system.v1.Service/ListSystems"
+
+func TestConcurrencyLimiter(t *testing.T) {
+	// blockingHandler signals each started request and blocks it until release is closed.
+	blockingHandler := func(started chan<- struct{}, release <-chan struct{}) grpc.UnaryHandler {
+		return func(_ context.Context, _ any) (any, error) {
+			started <- struct{}{}
+			<-release
+			return "handled", nil
+		}
+	}
+
+	t.Run("request exceeding the limit without queue is rejected", func(t *testing.T) {
+		// given
+		subj := interceptor.NewConcurrencyLimiter(config.Concurrency{
+			Limits: []config.ConcurrencyLimit{{Method: listSystemsMethod, MaxInFlight: 1}},
+		})
+		info := &grpc.UnaryServerInfo{FullMethod: listSystemsMethod}
+		started, release := make(chan struct{}), make(chan struct{})
+		defer close(release)
+
+		go func() {
+			_, _ = subj.UnaryInterceptor(t.Context(), nil, info, blockingHandler(started, release))
+		}()
+		<-started
+
+		// when
+		_, err := subj.UnaryInterceptor(t.Context(), nil, info, blockingHandler(started, release))
+
+		// then
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	t.Run("queued request is served once a slot is free", func(t *testing.T) {
+		// given
+		subj := interceptor.NewConcurrencyLimiter(config.Concurrency{
+			Limits: []config.ConcurrencyLimit{{Method: listSystemsMethod, MaxInFlight: 1, MaxQueued: 1, QueueTimeout: time.Minute}},
+		})
+		info := &grpc.UnaryServerInfo{FullMethod: listSystemsMethod}
+		started, release := make(chan struct{}), make(chan struct{})
+
+		go func() {
+			_, _ = subj.UnaryInterceptor(t.Context(), nil, info, blockingHandler(started, release))
+		}()
+		<-started
+
+		// when
+		result := make(chan error)
+		go func() {
+			_, err := subj.UnaryInterceptor(t.Context(), nil, info, func(_ context.Context, _ any) (any, error) {
+				return "handled", nil
+			})
+			result <- err
+		}()
+		close(release)
+
+		// then
+		assert.NoError(t, <-result)
+	})
+
+	t.Run("queued request is rejected after the queue timeout", func(t *testing.T) {
+		// given
+		subj := interceptor.NewConcurrencyLimiter(config.Concurrency{
+			Limits: []config.ConcurrencyLimit{{Method: listSystemsMethod, MaxInFlight: 1, MaxQueued: 1, QueueTimeout: time.Millisecond}},
+		})
+		info := &grpc.UnaryServerInfo{FullMethod: listSystemsMethod}
+		started, release := make(chan struct{}), make(chan struct{})
+		defer close(release)
+
+		go func() {
+			_, _ = subj.UnaryInterceptor(t.Context(), nil, info, blockingHandler(started, release))
+		}()
+		<-started
+
+		// when
+		_, err := subj.UnaryInterceptor(t.Context(), nil, info, blockingHandler(started, release))
+
+		// then
+		assert.Equal(t, service.ErrConcurrencyLimit, err)
+	})
+
+	t.Run("method without limit is not restricted", func(t *testing.T) {
+		// given
+		subj := interceptor.NewConcurrencyLimiter(config.Concurrency{
+			Limits: []config.ConcurrencyLimit{{Method: listSystemsMethod, MaxInFlight: 1}},
+		})
+
+		// when
+		resp, err := subj.UnaryInterceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: setSystemLabelsMethod},
+			func(_ context.Context, _ any) (any, error) {
+				return "handled", nil
+			})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+	})
+
+	t.Run("requests in flight are reported by method", func(t *testing.T) {
+		// given
+		reader := sdkmetric.NewManualReader()
+		registry := service.NewMeterRegistry(&commoncfg.Application{Name: "test"},
+			sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)))
+		subj := interceptor.NewConcurrencyLimiter(config.Concurrency{})
+		require.NoError(t, subj.RegisterMeters(t.Context(), registry))
+
+		started, release := make(chan struct{}), make(chan struct{})
+		defer close(release)
+
+		go func() {
+			_, _ = subj.UnaryInterceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: listSystemsMethod},
+				blockingHandler(started, release))
+		}()
+		<-started
+
+		// when
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(t.Context(), &rm))
+
+		// then
+		require.Len(t, rm.ScopeMetrics, 1)
+
+		var inFlight metricdata.Gauge[int64]
+		for _, m := range rm.ScopeMetrics[0].Metrics {
+			if m.Name == "grpc.requests.in_flight" {
+				inFlight, _ = m.Data.(metricdata.Gauge[int64])
+			}
+		}
+		require.Len(t, inFlight.DataPoints, 1)
+		assert.Equal(t, int64(1), inFlight.DataPoints[0].Value)
+
+		method, _ := inFlight.DataPoints[0].Attributes.Value(service.AttrRPCMethod)
+		assert.Equal(t, listSystemsMethod, method.AsString())
+	})
+}
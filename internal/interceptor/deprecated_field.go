@@ -0,0 +1,165 @@
+package interceptor
+
+import (
+	"context"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	slogctx "github.com/veqryn/slog-context"
+)
+
+// DeprecatedFieldHandler is called for every deprecated field set by a request of the method.
+type DeprecatedFieldHandler func(ctx context.Context, method, field string)
+
+// DeprecatedFields counts the requests setting fields marked as deprecated in the protobufs per caller,
+// so clients still using them can be found before the fields are removed.
+// It must run after the CallerIdentity interceptor.
+type DeprecatedFields struct {
+	onDeprecated DeprecatedFieldHandler
+
+	// deprecated caches whether a message type has deprecated fields, including those of nested messages.
+	deprecated sync.Map
+}
+
+// NewDeprecatedFields will create a DeprecatedFields instance.
+func NewDeprecatedFields(onDeprecated DeprecatedFieldHandler) *DeprecatedFields {
+	return &DeprecatedFields{
+		onDeprecated: onDeprecated,
+	}
+}
+
+// UnaryInterceptor records the deprecated fields set by the request.
+func (d *DeprecatedFields) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	d.record(ctx, info.FullMethod, req)
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor records the deprecated fields set by each message received by the stream.
+func (d *DeprecatedFields) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &deprecatedFieldsServerStream{
+		ServerStream: stream,
+		fields:       d,
+		method:       info.FullMethod,
+	})
+}
+
+func (d *DeprecatedFields) record(ctx context.Context, method string, req any) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return
+	}
+
+	for _, field := range d.setDeprecatedFields(msg.ProtoReflect(), "") {
+		slogctx.Debug(ctx, "deprecated field used", "method", method, "field", field)
+		d.onDeprecated(ctx, method, field)
+	}
+}
+
+// setDeprecatedFields returns the paths of the deprecated fields set in the message, e.g. system.external_id.
+func (d *DeprecatedFields) setDeprecatedFields(msg protoreflect.Message, prefix string) []string {
+	if !d.hasDeprecatedFields(msg.Descriptor()) {
+		return nil
+	}
+
+	var paths []string
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		path := prefix + string(field.Name())
+		if isDeprecated(field) {
+			paths = append(paths, path)
+		}
+
+		if field.Message() == nil {
+			return true
+		}
+
+		switch {
+		case field.IsList():
+			list := value.List()
+			for i := range list.Len() {
+				paths = append(paths, d.setDeprecatedFields(list.Get(i).Message(), path+".")...)
+			}
+		case field.IsMap():
+			if field.MapValue().Message() == nil {
+				return true
+			}
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				paths = append(paths, d.setDeprecatedFields(v.Message(), path+".")...)
+				return true
+			})
+		default:
+			paths = append(paths, d.setDeprecatedFields(value.Message(), path+".")...)
+		}
+
+		return true
+	})
+
+	return paths
+}
+
+// hasDeprecatedFields returns true if the message type or one of its nested message types has deprecated fields.
+func (d *DeprecatedFields) hasDeprecatedFields(desc protoreflect.MessageDescriptor) bool {
+	if cached, ok := d.deprecated.Load(desc.FullName()); ok {
+		return cached.(bool)
+	}
+
+	has := containsDeprecatedFields(desc, map[protoreflect.FullName]bool{})
+	d.deprecated.Store(desc.FullName(), has)
+
+	return has
+}
+
+// containsDeprecatedFields inspects the message type and its nested message types,
+// each type is only inspected once, so recursive types terminate.
+func containsDeprecatedFields(desc protoreflect.MessageDescriptor, visited map[protoreflect.FullName]bool) bool {
+	if visited[desc.FullName()] {
+		return false
+	}
+	visited[desc.FullName()] = true
+
+	fields := desc.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		if isDeprecated(field) {
+			return true
+		}
+
+		if field.IsMap() {
+			field = field.MapValue()
+		}
+
+		if field.Message() != nil && containsDeprecatedFields(field.Message(), visited) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isDeprecated(field protoreflect.FieldDescriptor) bool {
+	opts, ok := field.Options().(*descriptorpb.FieldOptions)
+
+	return ok && opts.GetDeprecated()
+}
+
+// deprecatedFieldsServerStream records the deprecated fields of the received messages.
+type deprecatedFieldsServerStream struct {
+	grpc.ServerStream
+
+	fields *DeprecatedFields
+	method string
+}
+
+func (s *deprecatedFieldsServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.fields.record(s.Context(), s.method, m)
+
+	return nil
+}
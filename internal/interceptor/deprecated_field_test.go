@@ -0,0 +1,77 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/interceptor"
+)
+
+func TestDeprecatedFieldsUnaryInterceptor(t *testing.T) {
+	// deprecatedFile sets a deprecated field of a nested message
+	deprecatedFile := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("file.proto"),
+		Options: &descriptorpb.FileOptions{JavaGenerateEqualsAndHash: proto.Bool(true)},
+	}
+
+	tests := []struct {
+		name      string
+		req       any
+		expFields []string
+	}{
+		{
+			name:      "deprecated field",
+			req:       &tenantgrpc.ListTenantsRequest{Id: "tenant-1", Name: "name"},
+			expFields: []string{"id"},
+		},
+		{
+			name: "no deprecated field",
+			req:  &tenantgrpc.ListTenantsRequest{Name: "name"},
+		},
+		{
+			name:      "deprecated field of nested message",
+			req:       deprecatedFile,
+			expFields: []string{"options.java_generate_equals_and_hash"},
+		},
+		{
+			name:      "deprecated field of listed messages",
+			req:       &descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{deprecatedFile, {Name: proto.String("other.proto")}}},
+			expFields: []string{"file.options.java_generate_equals_and_hash"},
+		},
+		{
+			name: "no protobuf",
+			req:  "request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			var fields []string
+			subj := interceptor.NewDeprecatedFields(func(_ context.Context, method, field string) {
+				assert.Equal(t, "/test", method)
+				fields = append(fields, field)
+			})
+
+			handler := func(_ context.Context, _ any) (any, error) {
+				return "handled", nil
+			}
+
+			// when
+			resp, err := subj.UnaryInterceptor(t.Context(), tt.req, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, "handled", resp)
+			assert.Equal(t, tt.expFields, fields)
+		})
+	}
+}
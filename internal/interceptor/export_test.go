@@ -0,0 +1,21 @@
+package interceptor
+
+import "time"
+
+// SetFairSchedulerClock sets the clock of the scheduler.
+func SetFairSchedulerClock(s *FairScheduler, now func() time.Time) {
+	s.now = now
+}
+
+// QueuedRequests returns the number of queued requests of the scheduler.
+func QueuedRequests(s *FairScheduler) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var queued int
+	for _, queue := range s.queues {
+		queued += len(queue.requests)
+	}
+
+	return queued
+}
@@ -0,0 +1,236 @@
+package interceptor
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// tenantServicePrefix is the prefix of the methods of the tenant service, whose requests refer to tenants by their ID.
+const tenantServicePrefix = "/kms.api.cmk.registry.tenant.v1.Service/"
+
+// FairScheduler schedules the requests of the tenants by weighted fair queuing, so the automation of a single
+// tenant can not starve the others under load. Requests exceeding the requests in flight wait in the bounded
+// queue of their tenant. Each queued request is tagged with a virtual finish time, which advances by the inverse
+// of the weight of its tenant, and the request with the earliest tag is served next, so the queues are served
+// in proportion to the weights. A request waiting longer than the maximum wait is served next regardless of its tag.
+// Streams are not scheduled, as they may be served for long.
+type FairScheduler struct {
+	cfg       config.Fairness
+	queueTime metric.Float64Histogram
+	now       func() time.Time
+
+	mu          sync.Mutex
+	inFlight    int
+	virtualTime float64
+	queues      map[string]*tenantQueue
+}
+
+// tenantQueue holds the queued requests of a tenant.
+type tenantQueue struct {
+	requests []*queuedRequest
+	// finish is the virtual finish time of the last queued request of the tenant.
+	finish float64
+}
+
+// queuedRequest is a request waiting to be served, ready is closed once it is served.
+type queuedRequest struct {
+	tenantID string
+	finish   float64
+	queuedAt time.Time
+	ready    chan struct{}
+}
+
+// NewFairScheduler will create a FairScheduler instance.
+func NewFairScheduler(cfg config.Fairness) *FairScheduler {
+	return &FairScheduler{
+		cfg:    cfg,
+		now:    time.Now,
+		queues: make(map[string]*tenantQueue),
+	}
+}
+
+// RegisterMeters registers the histogram of the time the requests waited to be served, partitioned by tenant.
+func (s *FairScheduler) RegisterMeters(ctx context.Context, registry *service.MeterRegistry) error {
+	hist, err := registry.Histogram(ctx, "grpc.tenant.queue_time",
+		"Histogram of the time gRPC requests waited for their turn in seconds, partitioned by tenant")
+	if err != nil {
+		return err
+	}
+
+	s.queueTime = hist
+
+	return nil
+}
+
+// UnaryInterceptor serves the request once it is its turn.
+func (s *FairScheduler) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if !s.cfg.Enabled {
+		return handler(ctx, req)
+	}
+
+	tenantID := requestTenantID(info.FullMethod, req)
+
+	start := s.now()
+	err := s.acquire(ctx, tenantID)
+	if err != nil {
+		slogctx.Warn(ctx, "request rejected by fair scheduling", "tenantId", tenantID, "error", err)
+		return nil, service.ErrTenantQueueFull
+	}
+	defer s.release()
+
+	if s.queueTime != nil {
+		s.queueTime.Record(ctx, s.now().Sub(start).Seconds(), metric.WithAttributes(attribute.String(service.AttrTenantID, tenantID)))
+	}
+
+	return handler(ctx, req)
+}
+
+// requestTenantID returns the ID of the tenant of the request, empty if it does not refer to a tenant.
+func requestTenantID(method string, req any) string {
+	if r, ok := req.(tenantIDGetter); ok && r.GetTenantId() != "" {
+		return r.GetTenantId()
+	}
+
+	if r, ok := req.(idGetter); ok && strings.HasPrefix(method, tenantServicePrefix) {
+		return r.GetId()
+	}
+
+	return ""
+}
+
+// acquire serves the request right away if there is a free slot and no request is queued,
+// otherwise it queues the request until it is served.
+func (s *FairScheduler) acquire(ctx context.Context, tenantID string) error {
+	s.mu.Lock()
+	if s.inFlight < s.cfg.MaxInFlight && len(s.queues) == 0 {
+		s.inFlight++
+		s.mu.Unlock()
+		return nil
+	}
+
+	queue, ok := s.queues[tenantID]
+	if !ok {
+		queue = &tenantQueue{finish: s.virtualTime}
+	}
+
+	if len(queue.requests) >= s.cfg.MaxQueuedPerTenant {
+		s.mu.Unlock()
+		return errQueueFull
+	}
+
+	request := &queuedRequest{
+		tenantID: tenantID,
+		finish:   max(s.virtualTime, queue.finish) + 1/float64(s.weight(tenantID)),
+		queuedAt: s.now(),
+		ready:    make(chan struct{}),
+	}
+	queue.requests = append(queue.requests, request)
+	queue.finish = request.finish
+	s.queues[tenantID] = queue
+	s.mu.Unlock()
+
+	timer := time.NewTimer(s.cfg.QueueTimeout)
+	defer timer.Stop()
+
+	select {
+	case <-request.ready:
+		return nil
+	case <-timer.C:
+		return s.cancel(request, errQueueTimeout)
+	case <-ctx.Done():
+		return s.cancel(request, ctx.Err())
+	}
+}
+
+// cancel removes the request from its queue and returns err. If the request was served meanwhile,
+// its slot is released for the next request.
+func (s *FairScheduler) cancel(request *queuedRequest, err error) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-request.ready:
+		s.inFlight--
+		s.dispatch()
+		return err
+	default:
+	}
+
+	queue := s.queues[request.tenantID]
+	queue.requests = slices.DeleteFunc(queue.requests, func(r *queuedRequest) bool { return r == request })
+	if len(queue.requests) == 0 {
+		delete(s.queues, request.tenantID)
+	}
+
+	return err
+}
+
+// release frees the slot of a served request and serves the next queued requests.
+func (s *FairScheduler) release() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.inFlight--
+	s.dispatch()
+}
+
+// dispatch serves the queued requests while there are free slots. The caller holds the lock.
+func (s *FairScheduler) dispatch() {
+	for s.inFlight < s.cfg.MaxInFlight && len(s.queues) > 0 {
+		queue := s.next()
+
+		request := queue.requests[0]
+		queue.requests = queue.requests[1:]
+		if len(queue.requests) == 0 {
+			delete(s.queues, request.tenantID)
+		}
+
+		s.virtualTime = max(s.virtualTime, request.finish)
+		s.inFlight++
+		close(request.ready)
+	}
+}
+
+// next returns the queue whose first request is served next: the request waiting longer than the maximum wait
+// for the longest time, or else the request with the earliest virtual finish time, the earlier queued on a tie.
+func (s *FairScheduler) next() *tenantQueue {
+	var earliest, oldest *tenantQueue
+
+	for _, queue := range s.queues {
+		first := queue.requests[0]
+		if earliest == nil || first.finish < earliest.requests[0].finish ||
+			(first.finish == earliest.requests[0].finish && first.queuedAt.Before(earliest.requests[0].queuedAt)) {
+			earliest = queue
+		}
+		if oldest == nil || first.queuedAt.Before(oldest.requests[0].queuedAt) {
+			oldest = queue
+		}
+	}
+
+	if s.now().Sub(oldest.requests[0].queuedAt) > s.cfg.MaxWait {
+		return oldest
+	}
+
+	return earliest
+}
+
+// weight returns the configured weight of the tenant, 1 without weight.
+func (s *FairScheduler) weight(tenantID string) int {
+	if weight, ok := s.cfg.Weights[tenantID]; ok {
+		return weight
+	}
+
+	return 1
+}
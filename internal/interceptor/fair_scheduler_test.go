@@ -0,0 +1,172 @@
+package interceptor_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+type tenantRequest struct{ tenantID string }
+
+func (r tenantRequest) GetTenantId() string { return r.tenantID }
+
+func TestFairScheduler(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: listSystemsMethod}
+	handled := func(_ context.Context, _ any) (any, error) {
+		return "handled", nil
+	}
+
+	// occupy serves a request blocking the only slot until the returned function is called.
+	occupy := func(t *testing.T, subj *interceptor.FairScheduler) func() {
+		t.Helper()
+		started, release := make(chan struct{}), make(chan struct{})
+		go func() {
+			_, _ = subj.UnaryInterceptor(t.Context(), tenantRequest{tenantID: "busy"}, info, func(_ context.Context, _ any) (any, error) {
+				close(started)
+				<-release
+				return "handled", nil
+			})
+		}()
+		<-started
+
+		return func() { close(release) }
+	}
+
+	// enqueue queues a request of the tenant, which records the tenant once it is served.
+	enqueue := func(t *testing.T, subj *interceptor.FairScheduler, wg *sync.WaitGroup, served *[]string, mu *sync.Mutex, tenantID string) {
+		t.Helper()
+		queued := interceptor.QueuedRequests(subj)
+		wg.Go(func() {
+			_, err := subj.UnaryInterceptor(t.Context(), tenantRequest{tenantID: tenantID}, info, func(_ context.Context, _ any) (any, error) {
+				mu.Lock()
+				defer mu.Unlock()
+				*served = append(*served, tenantID)
+				return "handled", nil
+			})
+			assert.NoError(t, err)
+		})
+		assert.Eventually(t, func() bool { return interceptor.QueuedRequests(subj) == queued+1 }, time.Second, time.Millisecond)
+	}
+
+	fairness := config.Fairness{Enabled: true, MaxInFlight: 1, MaxQueuedPerTenant: 2, QueueTimeout: time.Minute, MaxWait: time.Minute}
+
+	t.Run("should serve a request with a free slot right away", func(t *testing.T) {
+		// given
+		subj := interceptor.NewFairScheduler(fairness)
+
+		// when
+		resp, err := subj.UnaryInterceptor(t.Context(), tenantRequest{tenantID: "tenant-a"}, info, handled)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+	})
+
+	t.Run("should reject a request if the queue of its tenant is full", func(t *testing.T) {
+		// given
+		subj := interceptor.NewFairScheduler(fairness)
+		release := occupy(t, subj)
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var served []string
+		enqueue(t, subj, &wg, &served, &mu, "tenant-a")
+		enqueue(t, subj, &wg, &served, &mu, "tenant-a")
+
+		// when
+		_, err := subj.UnaryInterceptor(t.Context(), tenantRequest{tenantID: "tenant-a"}, info, handled)
+
+		// then
+		assert.Equal(t, service.ErrTenantQueueFull, err)
+
+		release()
+		wg.Wait()
+		assert.Equal(t, []string{"tenant-a", "tenant-a"}, served)
+	})
+
+	t.Run("should reject a queued request after the queue timeout", func(t *testing.T) {
+		// given
+		cfg := fairness
+		cfg.QueueTimeout = time.Millisecond
+		subj := interceptor.NewFairScheduler(cfg)
+		release := occupy(t, subj)
+		defer release()
+
+		// when
+		_, err := subj.UnaryInterceptor(t.Context(), tenantRequest{tenantID: "tenant-a"}, info, handled)
+
+		// then
+		assert.Equal(t, service.ErrTenantQueueFull, err)
+		assert.Zero(t, interceptor.QueuedRequests(subj))
+	})
+
+	t.Run("should serve the queues of the tenants in proportion to their weights", func(t *testing.T) {
+		// given
+		cfg := fairness
+		cfg.MaxQueuedPerTenant = 4
+		cfg.Weights = map[string]int{"tenant-b": 2}
+		subj := interceptor.NewFairScheduler(cfg)
+		release := occupy(t, subj)
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var served []string
+		for _, tenantID := range []string{"tenant-a", "tenant-a", "tenant-a", "tenant-b", "tenant-b", "tenant-b", "tenant-b"} {
+			enqueue(t, subj, &wg, &served, &mu, tenantID)
+		}
+
+		// when
+		release()
+		wg.Wait()
+
+		// then
+		assert.Equal(t, []string{"tenant-b", "tenant-a", "tenant-b", "tenant-b", "tenant-a", "tenant-b", "tenant-a"}, served)
+	})
+
+	t.Run("should serve a request waiting longer than the maximum wait next", func(t *testing.T) {
+		// given
+		cfg := fairness
+		cfg.MaxWait = time.Second
+		cfg.Weights = map[string]int{"tenant-b": 100}
+		subj := interceptor.NewFairScheduler(cfg)
+		var now atomic.Int64
+		now.Store(time.Now().UnixNano())
+		interceptor.SetFairSchedulerClock(subj, func() time.Time { return time.Unix(0, now.Load()) })
+
+		release := occupy(t, subj)
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		var served []string
+		enqueue(t, subj, &wg, &served, &mu, "tenant-a")
+		now.Add(int64(time.Millisecond))
+		enqueue(t, subj, &wg, &served, &mu, "tenant-b")
+		enqueue(t, subj, &wg, &served, &mu, "tenant-b")
+
+		// when
+		now.Add(int64(2 * time.Second))
+		release()
+		wg.Wait()
+
+		// then
+		assert.Equal(t, []string{"tenant-a", "tenant-b", "tenant-b"}, served)
+	})
+
+	t.Run("should not schedule requests if disabled", func(t *testing.T) {
+		// given
+		subj := interceptor.NewFairScheduler(config.Fairness{})
+
+		// when
+		resp, err := subj.UnaryInterceptor(t.Context(), tenantRequest{tenantID: "tenant-a"}, info, handled)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+	})
+}
@@ -0,0 +1,53 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// Hooks calls the hooks of the operation of a request: the preValidate hooks before the operation validates
+// the request, the preCommit hooks within each transaction of the operation before it commits, and the postCommit
+// hooks once the request succeeded. The hooks follow the message validation, so they are not called with malformed
+// messages. Streams are not hooked.
+type Hooks struct {
+	hooks *service.Hooks
+}
+
+// NewHooks will create a Hooks instance calling the hooks.
+func NewHooks(hooks *service.Hooks) *Hooks {
+	return &Hooks{
+		hooks: hooks,
+	}
+}
+
+// UnaryInterceptor calls the hooks of the method around the handler.
+func (h *Hooks) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	msg, ok := req.(proto.Message)
+	if !ok || !h.hooks.Hooked(info.FullMethod) {
+		return handler(ctx, req)
+	}
+
+	if err := h.hooks.Call(ctx, config.HookPhasePreValidate, info.FullMethod, msg); err != nil {
+		return nil, err
+	}
+
+	hookedCtx := repository.WithPreCommit(ctx, func(ctx context.Context) error {
+		return h.hooks.Call(ctx, config.HookPhasePreCommit, info.FullMethod, msg)
+	})
+
+	resp, err := handler(hookedCtx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	// the errors of postCommit hooks are logged only
+	_ = h.hooks.Call(ctx, config.HookPhasePostCommit, info.FullMethod, msg)
+
+	return resp, nil
+}
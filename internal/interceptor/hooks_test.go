@@ -0,0 +1,124 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+const registerTenantMethod = "/kms.api.cmk.registry.tenant.v1.Service/RegisterTenant"
+
+type recordingHook struct {
+	phases []config.HookPhase
+	reject config.HookPhase
+}
+
+func (h *recordingHook) CallHook(_ context.Context, call service.HookCall) error {
+	h.phases = append(h.phases, call.Phase)
+	if call.Phase == h.reject {
+		return &service.HookRejection{Reason: "denied"}
+	}
+
+	return nil
+}
+
+func TestHooksUnaryInterceptor(t *testing.T) {
+	allPhases := []config.HookPhase{config.HookPhasePreValidate, config.HookPhasePreCommit, config.HookPhasePostCommit}
+
+	tests := []struct {
+		name       string
+		method     string
+		reject     config.HookPhase
+		handlerErr error
+		expPhases  []config.HookPhase
+		expHandled bool
+		expCode    codes.Code
+	}{
+		{
+			name:       "not hooked",
+			method:     "/kms.api.cmk.registry.tenant.v1.Service/GetTenant",
+			expHandled: true,
+		},
+		{
+			name:       "all phases",
+			method:     registerTenantMethod,
+			expPhases:  allPhases,
+			expHandled: true,
+		},
+		{
+			name:      "rejected before validation",
+			method:    registerTenantMethod,
+			reject:    config.HookPhasePreValidate,
+			expPhases: []config.HookPhase{config.HookPhasePreValidate},
+			expCode:   codes.FailedPrecondition,
+		},
+		{
+			name:       "rejected before commit",
+			method:     registerTenantMethod,
+			reject:     config.HookPhasePreCommit,
+			expPhases:  []config.HookPhase{config.HookPhasePreValidate, config.HookPhasePreCommit},
+			expHandled: true,
+			expCode:    codes.FailedPrecondition,
+		},
+		{
+			name:       "failed request",
+			method:     registerTenantMethod,
+			handlerErr: service.ErrTenantNotFound,
+			expPhases:  []config.HookPhase{config.HookPhasePreValidate, config.HookPhasePreCommit},
+			expHandled: true,
+			expCode:    codes.NotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			hook := &recordingHook{reject: tt.reject}
+			hooks := service.NewHooks()
+			hooks.Register(registerTenantMethod, "recording", hook, service.HookOptions{Phases: allPhases})
+			subj := interceptor.NewHooks(hooks)
+
+			handled := false
+			handler := func(ctx context.Context, _ any) (any, error) {
+				handled = true
+				// the transactions of the request call the preCommit hooks before they commit
+				if preCommit := repository.PreCommitFromContext(ctx); preCommit != nil {
+					if err := preCommit(ctx); err != nil {
+						return nil, err
+					}
+				}
+				if tt.handlerErr != nil {
+					return nil, tt.handlerErr
+				}
+				return "handled", nil
+			}
+
+			// when
+			resp, err := subj.UnaryInterceptor(t.Context(), &tenantgrpc.RegisterTenantRequest{}, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			// then
+			assert.Equal(t, tt.expHandled, handled)
+			assert.Equal(t, tt.expPhases, hook.phases)
+			if tt.expCode != codes.OK {
+				require.Error(t, err)
+				assert.Equal(t, tt.expCode, status.Code(err))
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "handled", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// labelsField is the name of the label fields of the protobufs.
+const labelsField protoreflect.Name = "labels"
+
+// LabelVisibility removes the internal labels from the responses to callers which are neither operators
+// nor admin callers, so tenant-facing tools do not see e.g. routing or cost-center labels.
+// Labels are the string maps named labels of the responses and their nested messages, so all procedure calls
+// are covered regardless of how their responses are mapped. It must run after the CallerIdentity interceptor.
+type LabelVisibility struct {
+	prefixes   []string
+	privileged map[string]struct{}
+}
+
+// NewLabelVisibility will create a LabelVisibility instance.
+func NewLabelVisibility(cfg config.LabelVisibility, admin config.Admin) *LabelVisibility {
+	privileged := make(map[string]struct{}, len(cfg.Operators)+len(admin.Callers))
+	for _, caller := range cfg.Operators {
+		privileged[caller] = struct{}{}
+	}
+	for _, caller := range admin.Callers {
+		privileged[caller] = struct{}{}
+	}
+
+	return &LabelVisibility{
+		prefixes:   cfg.InternalPrefixes,
+		privileged: privileged,
+	}
+}
+
+// UnaryInterceptor removes the internal labels from the response.
+func (v *LabelVisibility) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	resp, err := handler(ctx, req)
+	if err != nil || !v.hides(ctx) {
+		return resp, err
+	}
+
+	return v.redact(resp), nil
+}
+
+// StreamInterceptor removes the internal labels from each message sent by the stream.
+func (v *LabelVisibility) StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !v.hides(stream.Context()) {
+		return handler(srv, stream)
+	}
+
+	return handler(srv, &labelVisibilityServerStream{
+		ServerStream: stream,
+		visibility:   v,
+	})
+}
+
+// hides returns true if internal labels are hidden from the caller.
+func (v *LabelVisibility) hides(ctx context.Context) bool {
+	if len(v.prefixes) == 0 {
+		return false
+	}
+
+	_, ok := v.privileged[repository.CallerFromContext(ctx)]
+
+	return !ok
+}
+
+// redact returns the message without internal labels. Messages with internal labels are copied,
+// as the services may share them, e.g. the response of coalesced requests.
+func (v *LabelVisibility) redact(m any) any {
+	msg, ok := m.(proto.Message)
+	if !ok {
+		return m
+	}
+
+	found := false
+	visitLabels(msg.ProtoReflect(), func(labels protoreflect.Map) {
+		labels.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			found = found || v.isInternal(key.String())
+			return !found
+		})
+	})
+
+	if !found {
+		return m
+	}
+
+	clone := proto.Clone(msg)
+	visitLabels(clone.ProtoReflect(), func(labels protoreflect.Map) {
+		labels.Range(func(key protoreflect.MapKey, _ protoreflect.Value) bool {
+			if v.isInternal(key.String()) {
+				labels.Clear(key)
+			}
+			return true
+		})
+	})
+
+	return clone
+}
+
+func (v *LabelVisibility) isInternal(key string) bool {
+	for _, prefix := range v.prefixes {
+		if strings.HasPrefix(key, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// visitLabels calls visit with each label field set in the message and its nested messages.
+func visitLabels(msg protoreflect.Message, visit func(protoreflect.Map)) {
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case isLabels(field):
+			visit(value.Map())
+		case field.IsList() && field.Message() != nil:
+			list := value.List()
+			for i := range list.Len() {
+				visitLabels(list.Get(i).Message(), visit)
+			}
+		case field.IsMap() && field.MapValue().Message() != nil:
+			value.Map().Range(func(_ protoreflect.MapKey, v protoreflect.Value) bool {
+				visitLabels(v.Message(), visit)
+				return true
+			})
+		case !field.IsMap() && field.Message() != nil:
+			visitLabels(value.Message(), visit)
+		}
+
+		return true
+	})
+}
+
+func isLabels(field protoreflect.FieldDescriptor) bool {
+	return field.IsMap() && field.Name() == labelsField &&
+		field.MapKey().Kind() == protoreflect.StringKind && field.MapValue().Kind() == protoreflect.StringKind
+}
+
+// labelVisibilityServerStream removes the internal labels from the sent messages.
+type labelVisibilityServerStream struct {
+	grpc.ServerStream
+
+	visibility *LabelVisibility
+}
+
+func (s *labelVisibilityServerStream) SendMsg(m any) error {
+	return s.ServerStream.SendMsg(s.visibility.redact(m))
+}
@@ -0,0 +1,127 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+func TestLabelVisibilityUnaryInterceptor(t *testing.T) {
+	subj := interceptor.NewLabelVisibility(config.LabelVisibility{
+		InternalPrefixes: []string{"routing.", "cost-center"},
+		Operators:        []string{"spiffe://example.org/operator"},
+	}, config.Admin{Callers: []string{"spiffe://example.org/admin"}})
+
+	newResponse := func() *tenantgrpc.ListTenantsResponse {
+		return &tenantgrpc.ListTenantsResponse{
+			Tenants: []*tenantgrpc.Tenant{
+				{Id: "tenant-1", Labels: map[string]string{"env": "prod", "routing.cell": "c1", "cost-center": "42"}},
+				{Id: "tenant-2", Labels: map[string]string{"env": "dev"}},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		caller    string
+		expLabels []map[string]string
+	}{
+		{
+			name:   "should remove internal labels for tenant-facing callers",
+			caller: "spiffe://example.org/portal",
+			expLabels: []map[string]string{
+				{"env": "prod"},
+				{"env": "dev"},
+			},
+		},
+		{
+			name: "should remove internal labels for unidentified callers",
+			expLabels: []map[string]string{
+				{"env": "prod"},
+				{"env": "dev"},
+			},
+		},
+		{
+			name:   "should keep internal labels for operators",
+			caller: "spiffe://example.org/operator",
+			expLabels: []map[string]string{
+				{"env": "prod", "routing.cell": "c1", "cost-center": "42"},
+				{"env": "dev"},
+			},
+		},
+		{
+			name:   "should keep internal labels for admin callers",
+			caller: "spiffe://example.org/admin",
+			expLabels: []map[string]string{
+				{"env": "prod", "routing.cell": "c1", "cost-center": "42"},
+				{"env": "dev"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctx := t.Context()
+			if tt.caller != "" {
+				ctx = repository.WithCaller(ctx, tt.caller)
+			}
+
+			shared := newResponse()
+			handler := func(_ context.Context, _ any) (any, error) {
+				return shared, nil
+			}
+
+			// when
+			resp, err := subj.UnaryInterceptor(ctx, &tenantgrpc.ListTenantsRequest{}, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+
+			// then
+			require.NoError(t, err)
+			tenants := resp.(*tenantgrpc.ListTenantsResponse).GetTenants()
+			require.Len(t, tenants, len(tt.expLabels))
+			for i, labels := range tt.expLabels {
+				assert.Equal(t, labels, tenants[i].GetLabels())
+			}
+			assert.Equal(t, newResponse().GetTenants()[0].GetLabels(), shared.GetTenants()[0].GetLabels(), "the response of the service must not be modified")
+		})
+	}
+
+	t.Run("should return the response unchanged without internal labels", func(t *testing.T) {
+		// given
+		resp := &tenantgrpc.ListTenantsResponse{Tenants: []*tenantgrpc.Tenant{{Id: "tenant-1", Labels: map[string]string{"env": "prod"}}}}
+		handler := func(_ context.Context, _ any) (any, error) {
+			return resp, nil
+		}
+
+		// when
+		result, err := subj.UnaryInterceptor(t.Context(), &tenantgrpc.ListTenantsRequest{}, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+
+		// then
+		require.NoError(t, err)
+		assert.Same(t, resp, result)
+	})
+
+	t.Run("should keep all labels without internal prefixes", func(t *testing.T) {
+		// given
+		subj := interceptor.NewLabelVisibility(config.LabelVisibility{}, config.Admin{})
+		handler := func(_ context.Context, _ any) (any, error) {
+			return newResponse(), nil
+		}
+
+		// when
+		resp, err := subj.UnaryInterceptor(t.Context(), &tenantgrpc.ListTenantsRequest{}, &grpc.UnaryServerInfo{FullMethod: "/test"}, handler)
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, resp.(*tenantgrpc.ListTenantsResponse).GetTenants()[0].GetLabels(), 3)
+	})
+}
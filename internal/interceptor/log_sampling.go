@@ -0,0 +1,34 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+// LogSampling marks the sampled calls of the methods whose debug messages are logged, see service.LogControl.
+type LogSampling struct {
+	control *service.LogControl
+}
+
+// NewLogSampling will create a LogSampling instance.
+func NewLogSampling(control *service.LogControl) *LogSampling {
+	return &LogSampling{
+		control: control,
+	}
+}
+
+// UnaryInterceptor marks the context of a sampled call.
+func (l *LogSampling) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(l.control.Sample(ctx, info.FullMethod), req)
+}
+
+// StreamInterceptor marks the stream context of a sampled call.
+func (l *LogSampling) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &metadataServerStream{
+		ServerStream: stream,
+		ctx:          l.control.Sample(stream.Context(), info.FullMethod),
+	})
+}
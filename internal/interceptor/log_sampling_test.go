@@ -0,0 +1,47 @@
+package interceptor_test
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestLogSamplingUnaryInterceptor(t *testing.T) {
+	// given
+	control, err := service.NewLogControl(config.LogControl{Enabled: true, MaxDuration: time.Minute}, "info")
+	require.NoError(t, err)
+	require.NoError(t, control.SetSampling(t.Context(), "/sampled", 1, time.Minute))
+
+	logHandler := control.Handler(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: service.LevelTrace}))
+	subj := interceptor.NewLogSampling(control)
+
+	for method, expDebug := range map[string]bool{
+		"/sampled":     true,
+		"/not-sampled": false,
+	} {
+		t.Run(method, func(t *testing.T) {
+			var debug bool
+			handler := func(ctx context.Context, _ any) (any, error) {
+				debug = logHandler.Enabled(ctx, slog.LevelDebug)
+				return "handled", nil
+			}
+
+			// when
+			_, err := subj.UnaryInterceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, expDebug, debug)
+		})
+	}
+}
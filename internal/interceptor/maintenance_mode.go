@@ -0,0 +1,66 @@
+package interceptor
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// adminServicePrefix is the prefix of the full method names of the admin service.
+const adminServicePrefix = "/kms.api.cmk.registry.admin.v1.Service/"
+
+// MaintenanceModeLookup reports whether the registry is in maintenance mode.
+type MaintenanceModeLookup interface {
+	Enabled() bool
+}
+
+// MaintenanceMode rejects the changing requests while the registry is in maintenance mode,
+// so the data can not change e.g. during a database migration.
+// Requests are classified like PoolClass, so Get and List calls pass.
+// The calls of the admin service always pass, so admins can still repair data and disable the maintenance mode.
+type MaintenanceMode struct {
+	lookup MaintenanceModeLookup
+}
+
+// NewMaintenanceMode will create a MaintenanceMode instance.
+func NewMaintenanceMode(lookup MaintenanceModeLookup) *MaintenanceMode {
+	return &MaintenanceMode{
+		lookup: lookup,
+	}
+}
+
+// UnaryInterceptor rejects changing requests with Unavailable while the registry is in maintenance mode.
+func (m *MaintenanceMode) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if m.rejects(info.FullMethod) {
+		slogctx.Info(ctx, "request rejected in maintenance mode", "method", info.FullMethod)
+		return nil, service.ErrMaintenanceMode
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects changing streams with Unavailable while the registry is in maintenance mode.
+// Streams which have been opened before the maintenance mode was enabled are not ended.
+func (m *MaintenanceMode) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if m.rejects(info.FullMethod) {
+		slogctx.Info(stream.Context(), "stream rejected in maintenance mode", "method", info.FullMethod)
+		return service.ErrMaintenanceMode
+	}
+
+	return handler(srv, stream)
+}
+
+// rejects reports whether the method is rejected in the current mode.
+func (m *MaintenanceMode) rejects(fullMethod string) bool {
+	if !m.lookup.Enabled() || strings.HasPrefix(fullMethod, adminServicePrefix) {
+		return false
+	}
+
+	return methodPool(fullMethod) == repository.PoolWrite
+}
@@ -0,0 +1,59 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+type maintenanceModeLookup bool
+
+func (l maintenanceModeLookup) Enabled() bool {
+	return bool(l)
+}
+
+func TestMaintenanceModeUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		method  string
+		expErr  error
+	}{
+		{name: "disabled", method: "/kms.api.cmk.registry.tenant.v1.Service/RegisterTenant"},
+		{name: "enabled read request", enabled: true, method: "/kms.api.cmk.registry.tenant.v1.Service/GetTenant"},
+		{name: "enabled admin request", enabled: true, method: "/kms.api.cmk.registry.admin.v1.Service/SetMaintenanceMode"},
+		{
+			name:    "enabled write request",
+			enabled: true,
+			method:  "/kms.api.cmk.registry.tenant.v1.Service/RegisterTenant",
+			expErr:  service.ErrMaintenanceMode,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := interceptor.NewMaintenanceMode(maintenanceModeLookup(tt.enabled))
+			handler := func(_ context.Context, _ any) (any, error) {
+				return "handled", nil
+			}
+
+			// when
+			resp, err := subj.UnaryInterceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			// then
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "handled", resp)
+			}
+		})
+	}
+}
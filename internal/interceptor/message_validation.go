@@ -0,0 +1,153 @@
+package interceptor
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+// MessageValidator validates a request message against the rules annotated in its descriptor,
+// e.g. the buf protovalidate rules of api-sdk.
+type MessageValidator interface {
+	Validate(msg proto.Message) error
+}
+
+// FieldViolationError is a validation error reporting the violated fields,
+// which are returned to clients as BadRequest details.
+type FieldViolationError interface {
+	error
+	FieldViolations() []*errdetails.BadRequest_FieldViolation
+}
+
+// GeneratedValidator validates messages by the validation methods generated for their rules,
+// e.g. ValidateAll of protoc-gen-validate. Messages without generated methods are valid.
+type GeneratedValidator struct{}
+
+// Validate validates the message by ValidateAll if generated, otherwise by Validate.
+func (GeneratedValidator) Validate(msg proto.Message) error {
+	var err error
+
+	switch validatable := msg.(type) {
+	case interface{ ValidateAll() error }:
+		err = validatable.ValidateAll()
+	case interface{ Validate() error }:
+		err = validatable.Validate()
+	default:
+		return nil
+	}
+
+	if err == nil {
+		return nil
+	}
+
+	return &generatedViolations{err: err}
+}
+
+// fieldError is a violation of a generated rule, reporting the violated field.
+type fieldError interface {
+	Field() string
+	Reason() string
+}
+
+// generatedViolations reports the violations of the generated rules as field violations.
+type generatedViolations struct {
+	err error
+}
+
+func (v *generatedViolations) Error() string {
+	return v.err.Error()
+}
+
+func (v *generatedViolations) Unwrap() error {
+	return v.err
+}
+
+// FieldViolations returns a violation per error, all errors of the error if it is a multi error.
+func (v *generatedViolations) FieldViolations() []*errdetails.BadRequest_FieldViolation {
+	errs := []error{v.err}
+	if multi, ok := v.err.(interface{ AllErrors() []error }); ok {
+		errs = multi.AllErrors()
+	}
+
+	violations := make([]*errdetails.BadRequest_FieldViolation, 0, len(errs))
+	for _, err := range errs {
+		violation := &errdetails.BadRequest_FieldViolation{Description: err.Error()}
+		if fieldErr, ok := errors.AsType[fieldError](err); ok {
+			violation.Field = fieldErr.Field()
+			violation.Description = fieldErr.Reason()
+		}
+
+		violations = append(violations, violation)
+	}
+
+	return violations
+}
+
+// MessageValidation validates request messages before they are handled, so the rules annotated
+// in api-sdk are enforced without hand-written validation in the services.
+// Violations are returned as InvalidArgument with BadRequest details.
+type MessageValidation struct {
+	validator MessageValidator
+}
+
+// NewMessageValidation will create a MessageValidation instance validating by the validator.
+func NewMessageValidation(validator MessageValidator) *MessageValidation {
+	return &MessageValidation{
+		validator: validator,
+	}
+}
+
+// UnaryInterceptor validates the request before it is handled.
+func (v *MessageValidation) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := v.validate(req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor validates every message received on the stream.
+func (v *MessageValidation) StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &validatingServerStream{ServerStream: stream, validation: v})
+}
+
+// validate validates the request if it is a message, returning the violations as BadRequest details.
+func (v *MessageValidation) validate(req any) error {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	err := v.validator.Validate(msg)
+	if err == nil {
+		return nil
+	}
+
+	if violationErr, ok := errors.AsType[FieldViolationError](err); ok {
+		return service.ErrorWithFieldViolations(service.ErrValidationFailed, violationErr.FieldViolations()...)
+	}
+
+	return service.ErrorWithFieldViolations(service.ErrValidationFailed, &errdetails.BadRequest_FieldViolation{
+		Description: err.Error(),
+	})
+}
+
+// validatingServerStream validates the messages received by the wrapped stream.
+type validatingServerStream struct {
+	grpc.ServerStream
+
+	validation *MessageValidation
+}
+
+func (s *validatingServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.validation.validate(m)
+}
@@ -0,0 +1,174 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/interceptor"
+)
+
+// validatorFunc adapts a function to a MessageValidator.
+type validatorFunc func(msg proto.Message) error
+
+func (f validatorFunc) Validate(msg proto.Message) error {
+	return f(msg)
+}
+
+// violationError reports the violated fields of a message.
+type violationError struct {
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+func (e *violationError) Error() string {
+	return "validation error"
+}
+
+func (e *violationError) FieldViolations() []*errdetails.BadRequest_FieldViolation {
+	return e.violations
+}
+
+func TestMessageValidationUnaryInterceptor(t *testing.T) {
+	info := &grpc.UnaryServerInfo{FullMethod: "/kms.api.cmk.registry.tenant.v1.Service/GetTenant"}
+	handler := func(_ context.Context, _ any) (any, error) {
+		return "handled", nil
+	}
+
+	t.Run("should handle a valid request", func(t *testing.T) {
+		// given
+		subj := interceptor.NewMessageValidation(validatorFunc(func(proto.Message) error { return nil }))
+
+		// when
+		resp, err := subj.UnaryInterceptor(t.Context(), &tenantgrpc.GetTenantRequest{Id: "tenant"}, info, handler)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+	})
+
+	t.Run("should return the field violations as BadRequest details", func(t *testing.T) {
+		// given
+		violation := &errdetails.BadRequest_FieldViolation{Field: "id", Description: "value is required"}
+		subj := interceptor.NewMessageValidation(validatorFunc(func(proto.Message) error {
+			return &violationError{violations: []*errdetails.BadRequest_FieldViolation{violation}}
+		}))
+
+		// when
+		resp, err := subj.UnaryInterceptor(t.Context(), &tenantgrpc.GetTenantRequest{}, info, handler)
+
+		// then
+		assert.Nil(t, resp)
+		sts, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, sts.Code())
+		require.Len(t, sts.Details(), 1)
+
+		badRequest, ok := sts.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.GetFieldViolations(), 1)
+		assert.Equal(t, "id", badRequest.GetFieldViolations()[0].GetField())
+	})
+
+	t.Run("should return other validation errors as violation without field", func(t *testing.T) {
+		// given
+		subj := interceptor.NewMessageValidation(validatorFunc(func(proto.Message) error {
+			return errors.New("rules could not be compiled")
+		}))
+
+		// when
+		_, err := subj.UnaryInterceptor(t.Context(), &tenantgrpc.GetTenantRequest{}, info, handler)
+
+		// then
+		sts, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, sts.Code())
+
+		badRequest, ok := sts.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		assert.Equal(t, "rules could not be compiled", badRequest.GetFieldViolations()[0].GetDescription())
+	})
+}
+
+// generatedRequest is a request with generated validation methods.
+type generatedRequest struct {
+	*tenantgrpc.GetTenantRequest
+
+	err error
+}
+
+func (r generatedRequest) ValidateAll() error {
+	return r.err
+}
+
+// generatedFieldError is a violation of a generated rule.
+type generatedFieldError struct {
+	field  string
+	reason string
+}
+
+func (e generatedFieldError) Error() string  { return e.field + ": " + e.reason }
+func (e generatedFieldError) Field() string  { return e.field }
+func (e generatedFieldError) Reason() string { return e.reason }
+
+// generatedMultiError is the multi error of the generated ValidateAll methods.
+type generatedMultiError []error
+
+func (m generatedMultiError) Error() string      { return errors.Join(m...).Error() }
+func (m generatedMultiError) AllErrors() []error { return m }
+
+func TestGeneratedValidator(t *testing.T) {
+	subj := interceptor.GeneratedValidator{}
+
+	t.Run("should accept messages without generated methods", func(t *testing.T) {
+		// when
+		err := subj.Validate(&tenantgrpc.GetTenantRequest{})
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should accept messages without violations", func(t *testing.T) {
+		// when
+		err := subj.Validate(generatedRequest{GetTenantRequest: &tenantgrpc.GetTenantRequest{Id: "tenant"}})
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should report every violation as field violation", func(t *testing.T) {
+		// given
+		req := generatedRequest{
+			GetTenantRequest: &tenantgrpc.GetTenantRequest{},
+			err: generatedMultiError{
+				generatedFieldError{field: "id", reason: "value is required"},
+				errors.New("invalid message"),
+			},
+		}
+
+		// when
+		_, err := interceptor.NewMessageValidation(subj).UnaryInterceptor(t.Context(), req, &grpc.UnaryServerInfo{},
+			func(_ context.Context, _ any) (any, error) { return "handled", nil })
+
+		// then
+		sts, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, sts.Code())
+
+		badRequest, ok := sts.Details()[0].(*errdetails.BadRequest)
+		require.True(t, ok)
+		require.Len(t, badRequest.GetFieldViolations(), 2)
+		assert.Equal(t, "id", badRequest.GetFieldViolations()[0].GetField())
+		assert.Equal(t, "value is required", badRequest.GetFieldViolations()[0].GetDescription())
+		assert.Equal(t, "invalid message", badRequest.GetFieldViolations()[1].GetDescription())
+	})
+}
@@ -11,6 +11,8 @@ import (
 	"go.opentelemetry.io/otel/metric"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/service"
 )
 
 const ErrDomainMetrics = "metrics"
@@ -39,10 +41,21 @@ func InitMeters(ctx context.Context, cfgApp *commoncfg.Application, meter metric
 			Wrapf(err, "creating grpc_request_duration meter")
 	}
 
+	errorCounts, err := meter.Int64Counter(
+		"grpc.error_count",
+		metric.WithDescription("Counter of failed gRPC requests, partitioned by method and error class."),
+	)
+	if err != nil {
+		return nil, oops.In(ErrDomainMetrics).
+			WithContext(ctx).
+			Wrapf(err, "creating grpc_error_count meter")
+	}
+
 	return &Meters{
 		application:      cfgApp,
 		requestCounts:    requestCounts,
 		requestDurations: requestDurations,
+		errorCounts:      errorCounts,
 	}, nil
 }
 
@@ -51,6 +64,7 @@ type Meters struct {
 	application      *commoncfg.Application
 	requestCounts    metric.Int64Counter
 	requestDurations metric.Float64Histogram
+	errorCounts      metric.Int64Counter
 }
 
 // UnaryInterceptor tracks the duration and count of unary gRPC calls.
@@ -70,6 +84,7 @@ func (m *Meters) UnaryInterceptor(ctx context.Context, req any, info *grpc.Unary
 	)
 	m.requestDurations.Record(ctx, elapsedTime, attrs)
 	m.requestCounts.Add(ctx, 1, attrs)
+	m.countError(ctx, info.FullMethod, err)
 
 	return resp, err
 }
@@ -91,6 +106,21 @@ func (m *Meters) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc
 	)
 	m.requestDurations.Record(stream.Context(), elapsedTime, attrs)
 	m.requestCounts.Add(stream.Context(), 1, attrs)
+	m.countError(stream.Context(), info.FullMethod, err)
 
 	return err
 }
+
+// countError counts the error of a request by its class, see service.ErrorClass.
+func (m *Meters) countError(ctx context.Context, method string, err error) {
+	if err == nil {
+		return
+	}
+
+	m.errorCounts.Add(ctx, 1, metric.WithAttributes(
+		otlp.CreateAttributesFrom(*m.application,
+			attribute.String(commoncfg.AttrOperation, method),
+			attribute.String(service.AttrErrorClass, service.ErrorClass(err)),
+		)...,
+	))
+}
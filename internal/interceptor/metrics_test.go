@@ -17,6 +17,7 @@ import (
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 
 	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
 )
 
 // mockServerStream is a minimal grpc.ServerStream for testing.
@@ -153,3 +154,42 @@ func TestMetricsStreamInterceptor(t *testing.T) {
 		}
 	}
 }
+
+func TestMetricsErrorCount(t *testing.T) {
+	ctx := t.Context()
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	met, err := interceptor.InitMeters(ctx, &commoncfg.Application{}, provider.Meter("test"))
+	require.NoError(t, err)
+
+	// when
+	for _, handlerErr := range []error{nil, service.ErrTenantNotFound} {
+		_, _ = met.UnaryInterceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.method"},
+			func(_ context.Context, _ any) (any, error) { return nil, handlerErr })
+	}
+
+	// then
+	var out metricdata.ResourceMetrics
+	require.NoError(t, reader.Collect(ctx, &out))
+
+	var classes []string
+	for _, scopeMetrics := range out.ScopeMetrics {
+		for _, m := range scopeMetrics.Metrics {
+			if m.Name != "grpc.error_count" {
+				continue
+			}
+
+			sum, ok := m.Data.(metricdata.Sum[int64])
+			require.True(t, ok, "unexpected data type")
+
+			for _, dp := range sum.DataPoints {
+				class, _ := dp.Attributes.Value(service.AttrErrorClass)
+				classes = append(classes, class.AsString())
+				assert.Equal(t, int64(1), dp.Value)
+			}
+		}
+	}
+
+	assert.Equal(t, []string{service.ErrorClassNotFound}, classes)
+}
@@ -0,0 +1,72 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+// OperationIDTrailerKey is the trailer key of the IDs of the operations started by a successful call.
+// Clients pass the IDs to the operations service to wait for the asynchronous work to be done.
+const OperationIDTrailerKey = "registry-operation-id"
+
+// OperationIDs attaches the IDs of the operations started while handling a successful call to the gRPC trailers,
+// so clients can wait for asynchronous calls without the response messages defining an operation field.
+type OperationIDs struct{}
+
+// NewOperationIDs will create an OperationIDs instance.
+func NewOperationIDs() *OperationIDs {
+	return &OperationIDs{}
+}
+
+// UnaryInterceptor collects the operation IDs of the call and sets them as trailers of a successful response.
+func (o *OperationIDs) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx = service.WithOperationIDs(ctx)
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if trailer := operationIDTrailer(ctx); trailer != nil {
+		if err := grpc.SetTrailer(ctx, trailer); err != nil {
+			slogctx.Warn(ctx, "failed to set operation ID trailer", "error", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// StreamInterceptor collects the operation IDs of the stream and sets them as trailers if the stream succeeds.
+func (o *OperationIDs) StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := service.WithOperationIDs(stream.Context())
+
+	err := handler(srv, &metadataServerStream{
+		ServerStream: stream,
+		ctx:          ctx,
+	})
+	if err != nil {
+		return err
+	}
+
+	if trailer := operationIDTrailer(ctx); trailer != nil {
+		stream.SetTrailer(trailer)
+	}
+
+	return nil
+}
+
+// operationIDTrailer returns the trailer of the operation IDs in the context, or nil if there are none.
+func operationIDTrailer(ctx context.Context) metadata.MD {
+	ids := service.OperationIDsFromContext(ctx)
+	if len(ids) == 0 {
+		return nil
+	}
+
+	return metadata.MD{OperationIDTrailerKey: ids}
+}
@@ -0,0 +1,72 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestOperationIDsUnaryInterceptor(t *testing.T) {
+	subj := interceptor.NewOperationIDs()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	t.Run("should set the operation IDs as trailer of a successful call", func(t *testing.T) {
+		// given
+		stream := &trailerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+		handler := func(ctx context.Context, _ any) (any, error) {
+			service.AddOperationID(ctx, "operation-1")
+			service.AddOperationID(ctx, "operation-2")
+			return "handled", nil
+		}
+
+		// when
+		resp, err := subj.UnaryInterceptor(ctx, nil, info, handler)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+		assert.Equal(t, []string{"operation-1", "operation-2"}, stream.trailer.Get(interceptor.OperationIDTrailerKey))
+	})
+
+	t.Run("should not set a trailer without operations", func(t *testing.T) {
+		// given
+		stream := &trailerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+		handler := func(_ context.Context, _ any) (any, error) {
+			return "handled", nil
+		}
+
+		// when
+		_, err := subj.UnaryInterceptor(ctx, nil, info, handler)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, stream.trailer)
+	})
+
+	t.Run("should not set a trailer for a failed call", func(t *testing.T) {
+		// given
+		stream := &trailerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+		expErr := errors.New("failed")
+		handler := func(ctx context.Context, _ any) (any, error) {
+			service.AddOperationID(ctx, "operation-1")
+			return nil, expErr
+		}
+
+		// when
+		_, err := subj.UnaryInterceptor(ctx, nil, info, handler)
+
+		// then
+		assert.ErrorIs(t, err, expErr)
+		assert.Nil(t, stream.trailer)
+	})
+}
@@ -0,0 +1,49 @@
+package interceptor
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"google.golang.org/grpc"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// readMethodPrefixes are the prefixes of the names of read-only methods.
+var readMethodPrefixes = []string{"Get", "List", "Watch"}
+
+// PoolClass serves the repository operations of a request by the connection pool of its class,
+// so long-running list queries can not exhaust the connections of requests changing resources.
+// Get, List and Watch calls are read requests, all other calls are write requests.
+type PoolClass struct{}
+
+// NewPoolClass will create a PoolClass instance.
+func NewPoolClass() *PoolClass {
+	return &PoolClass{}
+}
+
+// UnaryInterceptor adds the pool class of the method to the context.
+func (p *PoolClass) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(repository.WithPool(ctx, methodPool(info.FullMethod)), req)
+}
+
+// StreamInterceptor adds the pool class of the method to the stream context.
+func (p *PoolClass) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &metadataServerStream{
+		ServerStream: stream,
+		ctx:          repository.WithPool(stream.Context(), methodPool(info.FullMethod)),
+	})
+}
+
+// methodPool returns the pool class of the full gRPC method name.
+func methodPool(fullMethod string) repository.Pool {
+	name := path.Base(fullMethod)
+	for _, prefix := range readMethodPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return repository.PoolRead
+		}
+	}
+
+	return repository.PoolWrite
+}
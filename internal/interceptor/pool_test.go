@@ -0,0 +1,45 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+func TestPoolClassUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		method  string
+		expPool repository.Pool
+	}{
+		{method: "/kms.api.cmk.registry.tenant.v1.Service/GetTenant", expPool: repository.PoolRead},
+		{method: "/kms.api.cmk.registry.system.v1.Service/ListSystems", expPool: repository.PoolRead},
+		{method: "/kms.api.cmk.registry.tenant.v1.Service/RegisterTenant", expPool: repository.PoolWrite},
+		{method: "/kms.api.cmk.registry.system.v1.Service/SetSystemLabels", expPool: repository.PoolWrite},
+	}
+
+	subj := interceptor.NewPoolClass()
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			// given
+			var pool repository.Pool
+			handler := func(ctx context.Context, _ any) (any, error) {
+				pool = repository.PoolFromContext(ctx)
+				return "handled", nil
+			}
+
+			// when
+			_, err := subj.UnaryInterceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expPool, pool)
+		})
+	}
+}
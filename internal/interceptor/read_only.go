@@ -0,0 +1,63 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// ReadOnly rejects the changing requests of an instance with the read-only profile,
+// which serves the read calls near the consumers, possibly from a read replica of the database.
+// Requests are classified like PoolClass, so Get, List and Watch calls pass.
+// Unlike in maintenance mode, the calls of the admin service are classified the same way,
+// except for the calls which only change the instance, e.g. its log level.
+type ReadOnly struct {
+	enabled bool
+}
+
+// NewReadOnly will create a ReadOnly instance, which only rejects requests if enabled.
+func NewReadOnly(enabled bool) *ReadOnly {
+	return &ReadOnly{
+		enabled: enabled,
+	}
+}
+
+// UnaryInterceptor rejects changing requests with FailedPrecondition.
+func (r *ReadOnly) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if r.rejects(info.FullMethod) {
+		slogctx.Debug(ctx, "request rejected by read-only profile", "method", info.FullMethod)
+		return nil, service.ErrReadOnlyProfile
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects changing streams with FailedPrecondition.
+func (r *ReadOnly) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if r.rejects(info.FullMethod) {
+		slogctx.Debug(stream.Context(), "stream rejected by read-only profile", "method", info.FullMethod)
+		return service.ErrReadOnlyProfile
+	}
+
+	return handler(srv, stream)
+}
+
+// instanceMethods are the full method names of the changing calls which only change the instance.
+var instanceMethods = map[string]struct{}{
+	adminServicePrefix + "SetLogLevel":    {},
+	adminServicePrefix + "SetLogSampling": {},
+}
+
+// rejects reports whether the method changes resources and is rejected.
+func (r *ReadOnly) rejects(fullMethod string) bool {
+	if _, ok := instanceMethods[fullMethod]; ok {
+		return false
+	}
+
+	return r.enabled && methodPool(fullMethod) == repository.PoolWrite
+}
@@ -0,0 +1,64 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestReadOnlyUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled bool
+		method  string
+		expErr  error
+	}{
+		{name: "disabled", method: "/kms.api.cmk.registry.tenant.v1.Service/RegisterTenant"},
+		{name: "get request", enabled: true, method: "/kms.api.cmk.registry.tenant.v1.Service/GetTenant"},
+		{name: "list request", enabled: true, method: listSystemsMethod},
+		{name: "watch request", enabled: true, method: "/kms.api.cmk.registry.extension.v1.ChangeFeedService/WatchChanges"},
+		{
+			name:    "write request",
+			enabled: true,
+			method:  "/kms.api.cmk.registry.tenant.v1.Service/RegisterTenant",
+			expErr:  service.ErrReadOnlyProfile,
+		},
+		{
+			name:    "admin write request",
+			enabled: true,
+			method:  "/kms.api.cmk.registry.admin.v1.Service/SetMaintenanceMode",
+			expErr:  service.ErrReadOnlyProfile,
+		},
+		{name: "admin instance request", enabled: true, method: "/kms.api.cmk.registry.admin.v1.Service/SetLogLevel"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := interceptor.NewReadOnly(tt.enabled)
+			handler := func(_ context.Context, _ any) (any, error) {
+				return "handled", nil
+			}
+
+			// when
+			resp, err := subj.UnaryInterceptor(t.Context(), nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			// then
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+				assert.Nil(t, resp)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, "handled", resp)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+package interceptor
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+var errRequestNotMessage = errors.New("request is not a message")
+
+// RequestCoalescing serves identical concurrent requests of the configured methods once,
+// e.g. a client hedging a MapSystemToTenant call, so the requests do not contend for the same rows.
+// Requests are identical if they have the same method, caller and message. The later requests wait for the first
+// and receive its response or error, with its warnings and operation IDs. Streams are not coalesced.
+// The shared call is not cancelled with the first request but bounded by the timeout, and if it fails
+// with a context error, the later requests are served on their own.
+type RequestCoalescing struct {
+	methods map[string]struct{}
+	timeout time.Duration
+	group   singleflight.Group
+	// recover recovers panics of the handler, which is called in a goroutine of its own
+	recover *Recover
+}
+
+// coalescedResult is the outcome of a coalesced request shared with the identical requests.
+type coalescedResult struct {
+	resp         any
+	warnings     []service.Warning
+	operationIDs []string
+}
+
+// NewRequestCoalescing will create a RequestCoalescing instance.
+func NewRequestCoalescing(cfg config.RequestCoalescing) *RequestCoalescing {
+	methods := make(map[string]struct{}, len(cfg.Methods))
+	for _, method := range cfg.Methods {
+		methods[method] = struct{}{}
+	}
+
+	return &RequestCoalescing{
+		methods: methods,
+		timeout: cfg.Timeout,
+		recover: NewRecover(),
+	}
+}
+
+// UnaryInterceptor serves the request, or waits for an identical request in flight and returns its outcome.
+func (c *RequestCoalescing) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if _, ok := c.methods[info.FullMethod]; !ok {
+		return handler(ctx, req)
+	}
+
+	key, err := coalescingKey(ctx, info.FullMethod, req)
+	if err != nil {
+		slogctx.Warn(ctx, "failed to encode request for coalescing", "error", err)
+		return handler(ctx, req)
+	}
+
+	leader := false
+	results := c.group.DoChan(key, func() (any, error) {
+		leader = true
+
+		// the identical requests must not fail because the first one was cancelled
+		ctxShared, cancel := context.WithTimeout(context.WithoutCancel(ctx), c.timeout)
+		defer cancel()
+
+		resp, err := c.recover.UnaryInterceptor(ctxShared, req, info, handler)
+
+		return &coalescedResult{
+			resp:         resp,
+			warnings:     service.WarningsFromContext(ctx),
+			operationIDs: service.OperationIDsFromContext(ctx),
+		}, err
+	})
+
+	select {
+	case res := <-results:
+		if leader {
+			return res.Val.(*coalescedResult).resp, res.Err
+		}
+
+		if isContextError(res.Err) {
+			slogctx.Debug(ctx, "identical request in flight failed with a context error, serving request", "method", info.FullMethod, "error", res.Err)
+			return handler(ctx, req)
+		}
+
+		slogctx.Debug(ctx, "served by identical request in flight", "method", info.FullMethod)
+
+		return shareResult(ctx, res)
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+}
+
+// shareResult returns a copy of the response of the identical request and adds its warnings and operation IDs to ctx.
+func shareResult(ctx context.Context, res singleflight.Result) (any, error) {
+	if res.Err != nil {
+		return nil, res.Err
+	}
+
+	result := res.Val.(*coalescedResult)
+	for _, warning := range result.warnings {
+		service.AddWarning(ctx, warning.Code, warning.Message)
+	}
+
+	for _, id := range result.operationIDs {
+		service.AddOperationID(ctx, id)
+	}
+
+	if msg, ok := result.resp.(proto.Message); ok {
+		return proto.Clone(msg), nil
+	}
+
+	return result.resp, nil
+}
+
+// isContextError reports whether err is the error of a cancelled context or an exceeded deadline.
+func isContextError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	code := status.Code(err)
+
+	return code == codes.Canceled || code == codes.DeadlineExceeded
+}
+
+// coalescingKey returns the hash of the method, the caller and the deterministically encoded message.
+func coalescingKey(ctx context.Context, method string, req any) (string, error) {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return "", errRequestNotMessage
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.New()
+	hash.Write([]byte(method))
+	hash.Write([]byte{0})
+	hash.Write([]byte(repository.CallerFromContext(ctx)))
+	hash.Write([]byte{0})
+	hash.Write(data)
+
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
@@ -0,0 +1,186 @@
+package interceptor_test
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mappinggrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/mapping/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestRequestCoalescingUnaryInterceptor(t *testing.T) {
+	const method = "/kms.api.cmk.registry.mapping.v1.Service/MapSystemToTenant"
+
+	// serve calls the interceptor with the requests while the first one is in flight,
+	// the handler returns once it has been called expCalls times
+	serve := func(t *testing.T, fullMethod string, expCalls int32, reqs ...*mappinggrpc.MapSystemToTenantRequest) (int32, []any, []context.Context) {
+		t.Helper()
+
+		subj := interceptor.NewRequestCoalescing(config.RequestCoalescing{Methods: []string{method}, Timeout: time.Second})
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+		handler := func(ctx context.Context, _ any) (any, error) {
+			calls.Add(1)
+			service.AddOperationID(ctx, "operation")
+			<-release
+
+			return &mappinggrpc.MapSystemToTenantResponse{Success: true}, nil
+		}
+
+		var wg sync.WaitGroup
+		resps := make([]any, len(reqs))
+		ctxs := make([]context.Context, len(reqs))
+		for i, req := range reqs {
+			ctxs[i] = service.WithOperationIDs(t.Context())
+			wg.Go(func() {
+				resp, err := subj.UnaryInterceptor(ctxs[i], req, &grpc.UnaryServerInfo{FullMethod: fullMethod}, handler)
+				assert.NoError(t, err)
+				resps[i] = resp
+			})
+
+			if i == 0 {
+				require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+			}
+		}
+
+		require.Eventually(t, func() bool { return calls.Load() == expCalls }, time.Second, time.Millisecond)
+		// lets the coalesced requests join the request in flight
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		return calls.Load(), resps, ctxs
+	}
+
+	t.Run("should serve identical requests once", func(t *testing.T) {
+		// given
+		req := &mappinggrpc.MapSystemToTenantRequest{TenantId: "T1", ExternalId: "EXT1", Type: "system"}
+
+		// when
+		calls, resps, ctxs := serve(t, method, 1, req, req)
+
+		// then
+		assert.Equal(t, int32(1), calls)
+		for i := range resps {
+			assert.True(t, resps[i].(*mappinggrpc.MapSystemToTenantResponse).GetSuccess())
+			assert.Equal(t, []string{"operation"}, service.OperationIDsFromContext(ctxs[i]))
+		}
+	})
+
+	t.Run("should serve different requests each", func(t *testing.T) {
+		// given
+		req := &mappinggrpc.MapSystemToTenantRequest{TenantId: "T1", ExternalId: "EXT1", Type: "system"}
+		other := &mappinggrpc.MapSystemToTenantRequest{TenantId: "T1", ExternalId: "EXT2", Type: "system"}
+
+		// when
+		calls, _, _ := serve(t, method, 2, req, other)
+
+		// then
+		assert.Equal(t, int32(2), calls)
+	})
+
+	t.Run("should not coalesce requests of other methods", func(t *testing.T) {
+		// given
+		req := &mappinggrpc.MapSystemToTenantRequest{TenantId: "T1", ExternalId: "EXT1", Type: "system"}
+
+		// when
+		calls, _, _ := serve(t, "/kms.api.cmk.registry.mapping.v1.Service/UnmapSystemFromTenant", 2, req, req)
+
+		// then
+		assert.Equal(t, int32(2), calls)
+	})
+
+	t.Run("should serve identical requests if the first one is cancelled", func(t *testing.T) {
+		// given
+		subj := interceptor.NewRequestCoalescing(config.RequestCoalescing{Methods: []string{method}, Timeout: time.Second})
+		req := &mappinggrpc.MapSystemToTenantRequest{TenantId: "T1", ExternalId: "EXT1", Type: "system"}
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+
+		var calls atomic.Int32
+		release := make(chan struct{})
+		handler := func(ctx context.Context, _ any) (any, error) {
+			calls.Add(1)
+			select {
+			case <-release:
+				return &mappinggrpc.MapSystemToTenantResponse{Success: true}, nil
+			case <-ctx.Done():
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+		}
+
+		ctxLeader, cancel := context.WithCancel(t.Context())
+		var errLeader error
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			_, errLeader = subj.UnaryInterceptor(ctxLeader, req, info, handler)
+		})
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+
+		var resp any
+		var err error
+		wg.Go(func() {
+			resp, err = subj.UnaryInterceptor(t.Context(), req, info, handler)
+		})
+		// lets the coalesced request join the request in flight
+		time.Sleep(50 * time.Millisecond)
+
+		// when
+		cancel()
+		time.Sleep(50 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		// then
+		assert.Equal(t, codes.Canceled, status.Code(errLeader))
+		require.NoError(t, err)
+		assert.True(t, resp.(*mappinggrpc.MapSystemToTenantResponse).GetSuccess())
+		assert.Equal(t, int32(1), calls.Load())
+	})
+
+	t.Run("should serve identical requests on their own if the shared call fails with a context error", func(t *testing.T) {
+		// given
+		subj := interceptor.NewRequestCoalescing(config.RequestCoalescing{Methods: []string{method}, Timeout: 50 * time.Millisecond})
+		req := &mappinggrpc.MapSystemToTenantRequest{TenantId: "T1", ExternalId: "EXT1", Type: "system"}
+		info := &grpc.UnaryServerInfo{FullMethod: method}
+
+		var calls atomic.Int32
+		handler := func(ctx context.Context, _ any) (any, error) {
+			if calls.Add(1) == 1 {
+				<-ctx.Done()
+				return nil, status.FromContextError(ctx.Err()).Err()
+			}
+
+			return &mappinggrpc.MapSystemToTenantResponse{Success: true}, nil
+		}
+
+		var errLeader error
+		var wg sync.WaitGroup
+		wg.Go(func() {
+			_, errLeader = subj.UnaryInterceptor(t.Context(), req, info, handler)
+		})
+		require.Eventually(t, func() bool { return calls.Load() == 1 }, time.Second, time.Millisecond)
+
+		// when
+		resp, err := subj.UnaryInterceptor(t.Context(), req, info, handler)
+		wg.Wait()
+
+		// then
+		assert.Equal(t, codes.DeadlineExceeded, status.Code(errLeader))
+		require.NoError(t, err)
+		assert.True(t, resp.(*mappinggrpc.MapSystemToTenantResponse).GetSuccess())
+		assert.Equal(t, int32(2), calls.Load())
+	})
+}
@@ -0,0 +1,80 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	slogctx "github.com/veqryn/slog-context"
+)
+
+type (
+	idGetter         interface{ GetId() string }
+	tenantIDGetter   interface{ GetTenantId() string }
+	externalIDGetter interface{ GetExternalId() string }
+	typeGetter       interface{ GetType() string }
+	regionGetter     interface{ GetRegion() string }
+)
+
+// RequestMetadata adds the gRPC method and the tenant and system identifiers of a request
+// to the logger in the context, so that logs written while handling the request, e.g. slow
+// repository operations, can be traced back to the calling RPC.
+type RequestMetadata struct{}
+
+// NewRequestMetadata will create a RequestMetadata instance.
+func NewRequestMetadata() *RequestMetadata {
+	return &RequestMetadata{}
+}
+
+// UnaryInterceptor adds the request metadata to the logger in the context.
+func (r *RequestMetadata) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	return handler(slogctx.With(ctx, requestAttrs(info.FullMethod, req)...), req)
+}
+
+// StreamInterceptor adds the gRPC method to the logger in the stream context.
+func (r *RequestMetadata) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &metadataServerStream{
+		ServerStream: stream,
+		ctx:          slogctx.With(stream.Context(), "rpcMethod", info.FullMethod),
+	})
+}
+
+// requestAttrs returns the method and all non-empty identifiers of the request as key value pairs.
+func requestAttrs(method string, req any) []any {
+	attrs := []any{"rpcMethod", method}
+
+	add := func(key, value string) {
+		if value != "" {
+			attrs = append(attrs, key, value)
+		}
+	}
+
+	if r, ok := req.(idGetter); ok {
+		add("id", r.GetId())
+	}
+	if r, ok := req.(tenantIDGetter); ok {
+		add("tenantId", r.GetTenantId())
+	}
+	if r, ok := req.(externalIDGetter); ok {
+		add("externalId", r.GetExternalId())
+	}
+	if r, ok := req.(typeGetter); ok {
+		add("type", r.GetType())
+	}
+	if r, ok := req.(regionGetter); ok {
+		add("region", r.GetRegion())
+	}
+
+	return attrs
+}
+
+// metadataServerStream overrides the context of the wrapped stream.
+type metadataServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context //nolint:containedctx
+}
+
+func (s *metadataServerStream) Context() context.Context {
+	return s.ctx
+}
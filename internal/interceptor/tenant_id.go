@@ -0,0 +1,101 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// tenantIDField is the name of the fields referring to tenants by their ID.
+const tenantIDField = "tenant_id"
+
+// TenantIDNormalizer normalizes tenant IDs, e.g. service.TenantIDs.
+type TenantIDNormalizer interface {
+	Normalizes() bool
+	Normalize(id string) string
+}
+
+// TenantIDNormalization normalizes the tenant_id fields of requests, including those of nested messages,
+// so all procedure calls refer to tenants by the normalized ID they are registered with.
+// The tenant service normalizes the IDs of its requests itself.
+type TenantIDNormalization struct {
+	ids TenantIDNormalizer
+}
+
+// NewTenantIDNormalization will create a TenantIDNormalization instance.
+func NewTenantIDNormalization(ids TenantIDNormalizer) *TenantIDNormalization {
+	return &TenantIDNormalization{ids: ids}
+}
+
+// UnaryInterceptor normalizes the tenant IDs of the request.
+func (n *TenantIDNormalization) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	n.normalize(req)
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor normalizes the tenant IDs of the received requests.
+func (n *TenantIDNormalization) StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if !n.ids.Normalizes() {
+		return handler(srv, stream)
+	}
+
+	return handler(srv, &tenantIDServerStream{
+		ServerStream:  stream,
+		normalization: n,
+	})
+}
+
+func (n *TenantIDNormalization) normalize(req any) {
+	msg, ok := req.(proto.Message)
+	if !ok || !n.ids.Normalizes() {
+		return
+	}
+
+	n.normalizeMessage(msg.ProtoReflect())
+}
+
+func (n *TenantIDNormalization) normalizeMessage(msg protoreflect.Message) {
+	var tenantIDs []protoreflect.FieldDescriptor
+
+	msg.Range(func(field protoreflect.FieldDescriptor, value protoreflect.Value) bool {
+		switch {
+		case field.IsMap():
+		case field.Kind() == protoreflect.StringKind && field.Name() == tenantIDField && !field.IsList():
+			tenantIDs = append(tenantIDs, field)
+		case field.Kind() == protoreflect.MessageKind && field.IsList():
+			list := value.List()
+			for i := range list.Len() {
+				n.normalizeMessage(list.Get(i).Message())
+			}
+		case field.Kind() == protoreflect.MessageKind:
+			n.normalizeMessage(value.Message())
+		}
+
+		return true
+	})
+
+	// the fields are set once ranging is done, as the message must not be modified while ranging
+	for _, field := range tenantIDs {
+		msg.Set(field, protoreflect.ValueOfString(n.ids.Normalize(msg.Get(field).String())))
+	}
+}
+
+// tenantIDServerStream normalizes the tenant IDs of the messages received by the stream.
+type tenantIDServerStream struct {
+	grpc.ServerStream
+
+	normalization *TenantIDNormalization
+}
+
+func (s *tenantIDServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	s.normalization.normalize(m)
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package interceptor_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+
+	mappinggrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/mapping/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// recvServerStream receives the message of the stream once.
+type recvServerStream struct {
+	grpc.ServerStream
+
+	ctx context.Context
+	msg proto.Message
+}
+
+func (s *recvServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *recvServerStream) RecvMsg(m any) error {
+	proto.Merge(m.(proto.Message), s.msg)
+	return nil
+}
+
+func TestTenantIDNormalizationUnaryInterceptor(t *testing.T) {
+	tests := []struct {
+		name        string
+		normalize   bool
+		expTenantID string
+	}{
+		{name: "normalizes the tenant ID", normalize: true, expTenantID: "t1"},
+		{name: "keeps the tenant ID if normalization is disabled", normalize: false, expTenantID: " T1 "},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := interceptor.NewTenantIDNormalization(service.NewTenantIDs(config.TenantID{Normalize: tt.normalize}))
+			req := &mappinggrpc.MapSystemToTenantRequest{TenantId: " T1 ", ExternalId: " EXT1 ", Type: "system"}
+
+			var handled *mappinggrpc.MapSystemToTenantRequest
+			handler := func(_ context.Context, req any) (any, error) {
+				handled = req.(*mappinggrpc.MapSystemToTenantRequest)
+				return "handled", nil
+			}
+
+			// when
+			_, err := subj.UnaryInterceptor(t.Context(), req, &grpc.UnaryServerInfo{}, handler)
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, tt.expTenantID, handled.GetTenantId())
+			assert.Equal(t, " EXT1 ", handled.GetExternalId(), "only tenant IDs are normalized")
+		})
+	}
+}
+
+func TestTenantIDNormalizationStreamInterceptor(t *testing.T) {
+	// given
+	subj := interceptor.NewTenantIDNormalization(service.NewTenantIDs(config.TenantID{Normalize: true}))
+	stream := &recvServerStream{
+		ctx: t.Context(),
+		msg: &mappinggrpc.MapSystemToTenantRequest{TenantId: " T1 "},
+	}
+
+	received := &mappinggrpc.MapSystemToTenantRequest{}
+	handler := func(_ any, stream grpc.ServerStream) error {
+		return stream.RecvMsg(received)
+	}
+
+	// when
+	err := subj.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{}, handler)
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "t1", received.GetTenantId())
+}
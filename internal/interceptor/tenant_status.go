@@ -0,0 +1,139 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// TenantStatusLookup looks up the status of the tenant a request refers to.
+type TenantStatusLookup interface {
+	TenantStatus(ctx context.Context, tenantID string) (string, bool, error)
+	LinkedTenantID(ctx context.Context, externalID, systemType string) (string, bool, error)
+}
+
+// TenantStatusPolicy rejects the configured methods depending on the status of the tenant
+// the request refers to, either by its tenant ID or by the system identified by external ID and type.
+// Requests of systems which do not exist or are not linked pass, so the handler reports them.
+// Requests referring to a tenant which does not exist are rejected, as its status can not be checked.
+// The messages of streams are checked as they are received.
+type TenantStatusPolicy struct {
+	rules  map[string]config.TenantStatusRule
+	lookup TenantStatusLookup
+}
+
+// NewTenantStatusPolicy will create a TenantStatusPolicy instance.
+func NewTenantStatusPolicy(cfg config.TenantStatusPolicy, lookup TenantStatusLookup) *TenantStatusPolicy {
+	rules := make(map[string]config.TenantStatusRule, len(cfg.Rules))
+	for _, rule := range cfg.Rules {
+		rules[rule.Method] = rule
+	}
+
+	return &TenantStatusPolicy{
+		rules:  rules,
+		lookup: lookup,
+	}
+}
+
+// UnaryInterceptor rejects the request with FailedPrecondition if the rule of the method
+// does not permit the status of the tenant.
+func (p *TenantStatusPolicy) UnaryInterceptor(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	rule, ok := p.rules[info.FullMethod]
+	if !ok {
+		return handler(ctx, req)
+	}
+
+	if err := p.check(ctx, rule, req); err != nil {
+		return nil, err
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor rejects each received message of the stream like UnaryInterceptor, which ends the stream.
+func (p *TenantStatusPolicy) StreamInterceptor(srv any, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	rule, ok := p.rules[info.FullMethod]
+	if !ok {
+		return handler(srv, stream)
+	}
+
+	return handler(srv, &tenantStatusServerStream{
+		ServerStream: stream,
+		policy:       p,
+		rule:         rule,
+	})
+}
+
+// check returns an error if the rule does not permit the status of the tenant of the request.
+func (p *TenantStatusPolicy) check(ctx context.Context, rule config.TenantStatusRule, req any) error {
+	tenantID, err := p.tenantID(ctx, req)
+	if err != nil {
+		slogctx.Error(ctx, "failed to look up linked tenant for policy", "error", err)
+		return service.ErrTenantSelect
+	}
+
+	if tenantID == "" {
+		return nil
+	}
+
+	status, found, err := p.lookup.TenantStatus(ctx, tenantID)
+	if err != nil {
+		slogctx.Error(ctx, "failed to look up tenant status for policy", "error", err)
+		return service.ErrTenantSelect
+	}
+
+	if !found {
+		slogctx.Warn(ctx, "request of unknown tenant rejected by tenant status policy", "tenantId", tenantID)
+		return service.ErrTenantNotFound
+	}
+
+	if !rule.Permits(status) {
+		slogctx.Warn(ctx, "request rejected by tenant status policy", "tenantStatus", status)
+		return service.ErrorWithParams(service.ErrTenantStatusNotPermitted, "status", status)
+	}
+
+	return nil
+}
+
+// tenantID returns the ID of the tenant of the request, empty if it does not refer to a tenant.
+// The tenant ID takes precedence over the linked tenant of the system.
+func (p *TenantStatusPolicy) tenantID(ctx context.Context, req any) (string, error) {
+	if r, ok := req.(tenantIDGetter); ok && r.GetTenantId() != "" {
+		return r.GetTenantId(), nil
+	}
+
+	r, ok := req.(externalIDGetter)
+	if !ok || r.GetExternalId() == "" {
+		return "", nil
+	}
+
+	var systemType string
+	if t, ok := req.(typeGetter); ok {
+		systemType = t.GetType()
+	}
+
+	tenantID, _, err := p.lookup.LinkedTenantID(ctx, r.GetExternalId(), systemType)
+
+	return tenantID, err
+}
+
+// tenantStatusServerStream checks the messages received by the stream against the rule of its method.
+type tenantStatusServerStream struct {
+	grpc.ServerStream
+
+	policy *TenantStatusPolicy
+	rule   config.TenantStatusRule
+}
+
+func (s *tenantStatusServerStream) RecvMsg(m any) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+
+	return s.policy.check(s.Context(), s.rule, m)
+}
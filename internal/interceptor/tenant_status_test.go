@@ -0,0 +1,171 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	mappinggrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/mapping/v1"
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+const setSystemLabelsMethod = "/kms.api.cmk.registry.system.v1.Service/SetSystemLabels"
+
+type fakeTenantStatuses struct {
+	tenants map[string]string
+	systems map[string]string
+	err     error
+}
+
+func (f *fakeTenantStatuses) TenantStatus(_ context.Context, tenantID string) (string, bool, error) {
+	status, ok := f.tenants[tenantID]
+	return status, ok, f.err
+}
+
+func (f *fakeTenantStatuses) LinkedTenantID(_ context.Context, externalID, systemType string) (string, bool, error) {
+	tenantID, ok := f.systems[externalID+"/"+systemType]
+	return tenantID, ok, f.err
+}
+
+func TestTenantStatusPolicy(t *testing.T) {
+	lookup := &fakeTenantStatuses{
+		tenants: map[string]string{
+			"tenant-active":  "STATUS_ACTIVE",
+			"tenant-blocked": "STATUS_BLOCKED",
+		},
+		systems: map[string]string{
+			"system-active/SYSTEM":  "tenant-active",
+			"system-blocked/SYSTEM": "tenant-blocked",
+			"system-orphan/SYSTEM":  "tenant-deleted",
+		},
+	}
+	policy := interceptor.NewTenantStatusPolicy(config.TenantStatusPolicy{
+		Rules: []config.TenantStatusRule{{Method: setSystemLabelsMethod, Blocked: []string{"STATUS_BLOCKED"}}},
+	}, lookup)
+
+	handler := func(_ context.Context, _ any) (any, error) {
+		return "handled", nil
+	}
+
+	tests := []struct {
+		name    string
+		method  string
+		req     any
+		expCode codes.Code
+	}{
+		{
+			name:    "system of active tenant passes",
+			method:  setSystemLabelsMethod,
+			req:     &systemgrpc.SetSystemLabelsRequest{ExternalId: "system-active", Type: "SYSTEM"},
+			expCode: codes.OK,
+		},
+		{
+			name:    "system of blocked tenant is rejected",
+			method:  setSystemLabelsMethod,
+			req:     &systemgrpc.SetSystemLabelsRequest{ExternalId: "system-blocked", Type: "SYSTEM"},
+			expCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "unlinked system passes",
+			method:  setSystemLabelsMethod,
+			req:     &systemgrpc.SetSystemLabelsRequest{ExternalId: "system-unlinked", Type: "SYSTEM"},
+			expCode: codes.OK,
+		},
+		{
+			name:    "system of unknown tenant is rejected",
+			method:  setSystemLabelsMethod,
+			req:     &systemgrpc.SetSystemLabelsRequest{ExternalId: "system-orphan", Type: "SYSTEM"},
+			expCode: codes.NotFound,
+		},
+		{
+			name:    "unknown tenant is rejected",
+			method:  setSystemLabelsMethod,
+			req:     &mappinggrpc.MapSystemToTenantRequest{ExternalId: "system-active", Type: "SYSTEM", TenantId: "tenant-deleted"},
+			expCode: codes.NotFound,
+		},
+		{
+			name:    "tenant ID takes precedence over the linked tenant",
+			method:  setSystemLabelsMethod,
+			req:     &mappinggrpc.MapSystemToTenantRequest{ExternalId: "system-active", Type: "SYSTEM", TenantId: "tenant-blocked"},
+			expCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "method without rule passes",
+			method:  "/kms.api.cmk.registry.system.v1.Service/UpdateSystemStatus",
+			req:     &systemgrpc.UpdateSystemStatusRequest{ExternalId: "system-blocked", Type: "SYSTEM"},
+			expCode: codes.OK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			resp, err := policy.UnaryInterceptor(t.Context(), tt.req, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+			// then
+			assert.Equal(t, tt.expCode, status.Code(err))
+			if tt.expCode == codes.OK {
+				assert.Equal(t, "handled", resp)
+			}
+		})
+	}
+
+	t.Run("received stream messages are rejected", func(t *testing.T) {
+		// given
+		stream := &recvServerStream{
+			ctx: t.Context(),
+			msg: &systemgrpc.SetSystemLabelsRequest{ExternalId: "system-blocked", Type: "SYSTEM"},
+		}
+		streamHandler := func(_ any, stream grpc.ServerStream) error {
+			return stream.RecvMsg(&systemgrpc.SetSystemLabelsRequest{})
+		}
+
+		// when
+		err := policy.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: setSystemLabelsMethod}, streamHandler)
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("received stream messages of methods without rule pass", func(t *testing.T) {
+		// given
+		stream := &recvServerStream{
+			ctx: t.Context(),
+			msg: &systemgrpc.SetSystemLabelsRequest{ExternalId: "system-blocked", Type: "SYSTEM"},
+		}
+		streamHandler := func(_ any, stream grpc.ServerStream) error {
+			return stream.RecvMsg(&systemgrpc.SetSystemLabelsRequest{})
+		}
+
+		// when
+		err := policy.StreamInterceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/test.Service/Stream"}, streamHandler)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("lookup failure is returned as internal error", func(t *testing.T) {
+		// given
+		failing := interceptor.NewTenantStatusPolicy(config.TenantStatusPolicy{
+			Rules: []config.TenantStatusRule{{Method: setSystemLabelsMethod, Allowed: []string{"STATUS_ACTIVE"}}},
+		}, &fakeTenantStatuses{err: errors.New("database unavailable")})
+
+		// when
+		_, err := failing.UnaryInterceptor(t.Context(), &systemgrpc.SetSystemLabelsRequest{ExternalId: "system-active", Type: "SYSTEM"},
+			&grpc.UnaryServerInfo{FullMethod: setSystemLabelsMethod}, handler)
+
+		// then
+		require.Error(t, err)
+		assert.Equal(t, service.ErrTenantSelect, err)
+	})
+}
@@ -0,0 +1,45 @@
+package interceptor
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	slogctx "github.com/veqryn/slog-context"
+)
+
+// ValidationSchemaHeaderKey is the header key of the version of the validations applied to the request.
+// Clients compare the version to detect that the configured allowlists or constraints changed.
+const ValidationSchemaHeaderKey = "registry-validation-schema-version"
+
+// ValidationSchema sets the version of the validation schema as gRPC header of every response,
+// including the responses of rejected requests.
+type ValidationSchema struct {
+	header metadata.MD
+}
+
+// NewValidationSchema will create a ValidationSchema instance for the given schema version.
+func NewValidationSchema(version string) *ValidationSchema {
+	return &ValidationSchema{
+		header: metadata.Pairs(ValidationSchemaHeaderKey, version),
+	}
+}
+
+// UnaryInterceptor sets the schema version header before the request is handled.
+func (v *ValidationSchema) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	if err := grpc.SetHeader(ctx, v.header); err != nil {
+		slogctx.Warn(ctx, "failed to set validation schema header", "error", err)
+	}
+
+	return handler(ctx, req)
+}
+
+// StreamInterceptor sets the schema version header before the stream is handled.
+func (v *ValidationSchema) StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if err := stream.SetHeader(v.header); err != nil {
+		slogctx.Warn(stream.Context(), "failed to set validation schema header", "error", err)
+	}
+
+	return handler(srv, stream)
+}
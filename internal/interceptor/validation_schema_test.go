@@ -0,0 +1,61 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/openkcm/registry/internal/interceptor"
+)
+
+type headerStream struct {
+	grpc.ServerTransportStream
+
+	header metadata.MD
+}
+
+func (s *headerStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func TestValidationSchemaUnaryInterceptor(t *testing.T) {
+	subj := interceptor.NewValidationSchema("0123456789ab")
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	t.Run("should set the schema version header of a successful call", func(t *testing.T) {
+		// given
+		stream := &headerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+
+		// when
+		resp, err := subj.UnaryInterceptor(ctx, nil, info, func(_ context.Context, _ any) (any, error) {
+			return "handled", nil
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+		assert.Equal(t, []string{"0123456789ab"}, stream.header.Get(interceptor.ValidationSchemaHeaderKey))
+	})
+
+	t.Run("should set the schema version header of a rejected call", func(t *testing.T) {
+		// given
+		stream := &headerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+		expErr := errors.New("invalid")
+
+		// when
+		_, err := subj.UnaryInterceptor(ctx, nil, info, func(_ context.Context, _ any) (any, error) {
+			return nil, expErr
+		})
+
+		// then
+		assert.ErrorIs(t, err, expErr)
+		assert.Equal(t, []string{"0123456789ab"}, stream.header.Get(interceptor.ValidationSchemaHeaderKey))
+	})
+}
@@ -0,0 +1,83 @@
+package interceptor
+
+import (
+	"context"
+	"encoding/json"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+// WarningTrailerKey is the trailer key of the warnings of a successful call.
+// Each warning is a separate value, encoded as JSON object with code and message.
+const WarningTrailerKey = "registry-warning"
+
+// Warnings attaches the warnings collected while handling a successful call to the gRPC trailers,
+// so clients can surface non-fatal issues without the response messages defining a warning field.
+type Warnings struct{}
+
+// NewWarnings will create a Warnings instance.
+func NewWarnings() *Warnings {
+	return &Warnings{}
+}
+
+// UnaryInterceptor collects the warnings of the call and sets them as trailers of a successful response.
+func (w *Warnings) UnaryInterceptor(ctx context.Context, req any, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx = service.WithWarnings(ctx)
+
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return resp, err
+	}
+
+	if trailer := warningTrailer(ctx); trailer != nil {
+		if err := grpc.SetTrailer(ctx, trailer); err != nil {
+			slogctx.Warn(ctx, "failed to set warning trailer", "error", err)
+		}
+	}
+
+	return resp, nil
+}
+
+// StreamInterceptor collects the warnings of the stream and sets them as trailers if the stream succeeds.
+func (w *Warnings) StreamInterceptor(srv any, stream grpc.ServerStream, _ *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx := service.WithWarnings(stream.Context())
+
+	err := handler(srv, &metadataServerStream{
+		ServerStream: stream,
+		ctx:          ctx,
+	})
+	if err != nil {
+		return err
+	}
+
+	if trailer := warningTrailer(ctx); trailer != nil {
+		stream.SetTrailer(trailer)
+	}
+
+	return nil
+}
+
+// warningTrailer returns the trailer of the warnings in the context, or nil if there are none.
+func warningTrailer(ctx context.Context) metadata.MD {
+	warnings := service.WarningsFromContext(ctx)
+	if len(warnings) == 0 {
+		return nil
+	}
+
+	trailer := metadata.MD{}
+	for _, warning := range warnings {
+		value, err := json.Marshal(warning)
+		if err != nil {
+			continue
+		}
+
+		trailer.Append(WarningTrailerKey, string(value))
+	}
+
+	return trailer
+}
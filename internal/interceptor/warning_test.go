@@ -0,0 +1,89 @@
+package interceptor_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/openkcm/registry/internal/interceptor"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// trailerStream records the trailer set by the interceptor.
+type trailerStream struct {
+	grpc.ServerTransportStream
+
+	trailer metadata.MD
+}
+
+func (s *trailerStream) Method() string {
+	return "/test/Method"
+}
+
+func (s *trailerStream) SetTrailer(md metadata.MD) error {
+	s.trailer = metadata.Join(s.trailer, md)
+	return nil
+}
+
+func TestWarningsUnaryInterceptor(t *testing.T) {
+	subj := interceptor.NewWarnings()
+	info := &grpc.UnaryServerInfo{FullMethod: "/test/Method"}
+
+	t.Run("should set the warnings as trailer of a successful call", func(t *testing.T) {
+		// given
+		stream := &trailerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+		handler := func(ctx context.Context, _ any) (any, error) {
+			service.AddWarning(ctx, service.WarningLabelKeysNotFound, "label keys not found and ignored: a")
+			return "handled", nil
+		}
+
+		// when
+		resp, err := subj.UnaryInterceptor(ctx, nil, info, handler)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "handled", resp)
+		assert.Equal(t, []string{`{"code":"LABEL_KEYS_NOT_FOUND","message":"label keys not found and ignored: a"}`},
+			stream.trailer.Get(interceptor.WarningTrailerKey))
+	})
+
+	t.Run("should not set a trailer without warnings", func(t *testing.T) {
+		// given
+		stream := &trailerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+		handler := func(_ context.Context, _ any) (any, error) {
+			return "handled", nil
+		}
+
+		// when
+		_, err := subj.UnaryInterceptor(ctx, nil, info, handler)
+
+		// then
+		require.NoError(t, err)
+		assert.Nil(t, stream.trailer)
+	})
+
+	t.Run("should not set a trailer for a failed call", func(t *testing.T) {
+		// given
+		stream := &trailerStream{}
+		ctx := grpc.NewContextWithServerTransportStream(t.Context(), stream)
+		expErr := errors.New("failed")
+		handler := func(ctx context.Context, _ any) (any, error) {
+			service.AddWarning(ctx, service.WarningLabelKeysNotFound, "ignored")
+			return nil, expErr
+		}
+
+		// when
+		_, err := subj.UnaryInterceptor(ctx, nil, info, handler)
+
+		// then
+		assert.ErrorIs(t, err, expErr)
+		assert.Nil(t, stream.trailer)
+	})
+}
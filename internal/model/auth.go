@@ -2,6 +2,8 @@ package model
 
 import (
 	"fmt"
+	"maps"
+	"strings"
 	"time"
 
 	pb "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
@@ -19,6 +21,33 @@ const (
 	AuthStatusValidationID     validation.ID = "Auth.Status"
 )
 
+// AuthRequiredUserGroupsProperty is the reserved property listing the comma-separated user groups of the tenant
+// an auth is scoped to. The property is stored as Auth.RequiredUserGroups and passed on to the operators.
+const AuthRequiredUserGroupsProperty = "requiredUserGroups"
+
+// AuthServiceAccountProperty is the reserved property identifying the ServiceAccount of the tenant
+// an auth is applied for. The property is stored as Auth.ServiceAccountID and passed on to the operators.
+const AuthServiceAccountProperty = "serviceAccountId"
+
+// AuthTenantTypeIndex is the name of the partial unique index which, if enabled, allows only one auth
+// per tenant and type which is not removed.
+const AuthTenantTypeIndex = "auths_tenant_type_unique_idx"
+
+// Outcomes of the propagation of an auth to a region, see AuthRegionAck.
+const (
+	AuthRegionPending = "PENDING"
+	AuthRegionApplied = "APPLIED"
+	AuthRegionFailed  = "FAILED"
+)
+
+// AuthRegionAck is the outcome of the task of the last apply-auth job for a region,
+// as acknowledged by the regional operator.
+type AuthRegionAck struct {
+	Outcome      string    `json:"outcome"`
+	ErrorMessage string    `json:"errorMessage,omitempty"`
+	At           time.Time `json:"at"`
+}
+
 // Auth represents an auth method associated with a tenant.
 type Auth struct {
 	ExternalID   string            `gorm:"column:id;primaryKey" validationID:"Auth.ExternalID"`
@@ -27,8 +56,36 @@ type Auth struct {
 	Properties   map[string]string `gorm:"column:properties;type:jsonb;serializer:json" validationID:"Auth.Properties"`
 	Status       string            `gorm:"column:status;not null" validationID:"Auth.Status"`
 	ErrorMessage string            `gorm:"column:error_message"`
-	UpdatedAt    time.Time         `gorm:"column:updated_at;autoUpdateTime"`
-	CreatedAt    time.Time         `gorm:"column:created_at;autoCreateTime"`
+	// RequiredUserGroups are the user groups of the tenant the auth is scoped to; optional
+	RequiredUserGroups []string `gorm:"column:required_user_groups;type:jsonb;serializer:json"`
+	// ServiceAccountID is the service account of the tenant the auth is applied for; optional
+	ServiceAccountID string `gorm:"column:service_account_id;index"`
+	// BlobProperties are the keys of the properties whose values are stored as AuthPropertyBlob,
+	// they are not part of Properties as stored
+	BlobProperties []string `gorm:"column:blob_properties;type:jsonb;serializer:json"`
+	// RegionAcks are the outcomes of the last apply-auth job by target region, set when the job ends
+	RegionAcks     map[string]AuthRegionAck `gorm:"column:region_acks;type:jsonb;serializer:json"`
+	CreatedBy      string                   `gorm:"column:created_by"`       // client creating the auth; optional
+	LastModifiedBy string                   `gorm:"column:last_modified_by"` // client last modifying the auth; optional
+	UpdatedAt      time.Time                `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt      time.Time                `gorm:"column:created_at;autoCreateTime"`
+}
+
+// AuthFields are the query fields of auths.
+var AuthFields = struct {
+	ExternalID       repository.Field[*Auth, string]
+	TenantID         repository.Field[*Auth, string]
+	Type             repository.Field[*Auth, string]
+	Status           repository.Field[*Auth, string]
+	ServiceAccountID repository.Field[*Auth, string]
+	CreatedAt        repository.Field[*Auth, time.Time]
+}{
+	ExternalID:       repository.NewField[*Auth, string](repository.IDField),
+	TenantID:         repository.NewField[*Auth, string](repository.TenantIDField),
+	Type:             repository.NewField[*Auth, string](repository.TypeField),
+	Status:           repository.NewField[*Auth, string](repository.StatusField),
+	ServiceAccountID: repository.NewField[*Auth, string]("service_account_id"),
+	CreatedAt:        repository.NewField[*Auth, time.Time](repository.CreatedAtField),
 }
 
 // TableName specifies the database table name for the Auth model.
@@ -36,6 +93,11 @@ func (a *Auth) TableName() string {
 	return "auths"
 }
 
+// ExternalIDColumn returns the column of the external ID of the auth.
+func (a *Auth) ExternalIDColumn() repository.QueryField {
+	return repository.IDField
+}
+
 // PaginationKey returns a map representing the pagination key for the Auth model.
 func (a *Auth) PaginationKey() map[repository.QueryField]any {
 	key := make(map[repository.QueryField]any)
@@ -43,13 +105,72 @@ func (a *Auth) PaginationKey() map[repository.QueryField]any {
 	return key
 }
 
+// SetCreatedBy records the client creating the auth.
+func (a *Auth) SetCreatedBy(caller string) {
+	a.CreatedBy = caller
+}
+
+// SetLastModifiedBy records the client last modifying the auth.
+func (a *Auth) SetLastModifiedBy(caller string) {
+	a.LastModifiedBy = caller
+}
+
+// ExtractRequiredUserGroups moves the reserved AuthRequiredUserGroupsProperty from the properties
+// to the required user groups of the auth.
+func (a *Auth) ExtractRequiredUserGroups() {
+	value, ok := a.Properties[AuthRequiredUserGroupsProperty]
+	if !ok {
+		return
+	}
+
+	a.Properties = maps.Clone(a.Properties)
+	delete(a.Properties, AuthRequiredUserGroupsProperty)
+
+	a.RequiredUserGroups = nil
+	for _, group := range strings.Split(value, ",") {
+		if group = strings.TrimSpace(group); group != "" {
+			a.RequiredUserGroups = append(a.RequiredUserGroups, group)
+		}
+	}
+}
+
+// ExtractServiceAccount moves the reserved AuthServiceAccountProperty from the properties
+// to the service account of the auth.
+func (a *Auth) ExtractServiceAccount() {
+	value, ok := a.Properties[AuthServiceAccountProperty]
+	if !ok {
+		return
+	}
+
+	a.Properties = maps.Clone(a.Properties)
+	delete(a.Properties, AuthServiceAccountProperty)
+
+	a.ServiceAccountID = strings.TrimSpace(value)
+}
+
 // ToProto converts the Auth model to its protobuf representation.
+// The required user groups and the service account are returned as the reserved AuthRequiredUserGroupsProperty
+// and AuthServiceAccountProperty.
 func (a *Auth) ToProto() *pb.Auth {
+	properties := a.Properties
+	if len(a.RequiredUserGroups) > 0 || a.ServiceAccountID != "" {
+		properties = maps.Clone(a.Properties)
+		if properties == nil {
+			properties = make(map[string]string, 2)
+		}
+	}
+	if len(a.RequiredUserGroups) > 0 {
+		properties[AuthRequiredUserGroupsProperty] = strings.Join(a.RequiredUserGroups, ",")
+	}
+	if a.ServiceAccountID != "" {
+		properties[AuthServiceAccountProperty] = a.ServiceAccountID
+	}
+
 	return &pb.Auth{
 		ExternalId:   a.ExternalID,
 		TenantId:     a.TenantID,
 		Type:         a.Type,
-		Properties:   a.Properties,
+		Properties:   properties,
 		Status:       pb.AuthStatus(pb.AuthStatus_value[a.Status]),
 		ErrorMessage: a.ErrorMessage,
 		UpdatedAt:    formatTime(a.UpdatedAt),
@@ -114,7 +235,12 @@ func (c AuthStatusConstraint) Validate(value any) error {
 	}
 
 	if _, ok := validAuthStatuses[statusValue]; !ok {
-		return validation.ErrValueNotAllowed
+		return notAllowedEnumValue(statusValue, validAuthStatuses)
 	}
 	return nil
 }
+
+// Describe returns the list constraint of the valid Auth statuses.
+func (c AuthStatusConstraint) Describe() validation.Constraint {
+	return describeEnum(validAuthStatuses)
+}
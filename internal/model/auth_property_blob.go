@@ -0,0 +1,56 @@
+package model
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// AuthPropertyBlob is a large property value of an auth, e.g. a certificate or a JWKS document, stored apart
+// from the properties of the auth to keep them small. The keys of these properties are Auth.BlobProperties.
+type AuthPropertyBlob struct {
+	AuthID    string    `gorm:"column:auth_id;primaryKey"`
+	Key       string    `gorm:"column:key;primaryKey"`
+	Value     string    `gorm:"column:value;not null"`
+	Size      int       `gorm:"column:size"`
+	Digest    string    `gorm:"column:digest"` // lowercase hex SHA-256 of the value
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// AuthPropertyBlobFields are the query fields of auth property blobs.
+var AuthPropertyBlobFields = struct {
+	AuthID repository.Field[*AuthPropertyBlob, string]
+	Key    repository.Field[*AuthPropertyBlob, string]
+}{
+	AuthID: repository.NewField[*AuthPropertyBlob, string](repository.AuthIDField),
+	Key:    repository.NewField[*AuthPropertyBlob, string](repository.KeyField),
+}
+
+// NewAuthPropertyBlob creates the blob of the property value of the auth with its size and digest.
+func NewAuthPropertyBlob(authID, key, value string) *AuthPropertyBlob {
+	digest := sha256.Sum256([]byte(value))
+
+	return &AuthPropertyBlob{
+		AuthID: authID,
+		Key:    key,
+		Value:  value,
+		Size:   len(value),
+		Digest: hex.EncodeToString(digest[:]),
+	}
+}
+
+// TableName returns the table name of the AuthPropertyBlob entity.
+func (b *AuthPropertyBlob) TableName() string {
+	return "auth_property_blobs"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (b *AuthPropertyBlob) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.AuthIDField] = b.AuthID
+	key[repository.KeyField] = b.Key
+
+	return key
+}
@@ -36,6 +36,58 @@ func TestAuthToProto(t *testing.T) {
 	assert.Equal(t, pb.AuthStatus_AUTH_STATUS_APPLIED, authProto.Status)
 }
 
+func TestAuthRequiredUserGroups(t *testing.T) {
+	// given
+	properties := map[string]string{
+		"key":                                "value",
+		model.AuthRequiredUserGroupsProperty: "admins, auditors,",
+	}
+	auth := model.Auth{Properties: properties}
+
+	// when
+	auth.ExtractRequiredUserGroups()
+	authProto := auth.ToProto()
+
+	// then
+	assert.Equal(t, []string{"admins", "auditors"}, auth.RequiredUserGroups)
+	assert.Equal(t, map[string]string{"key": "value"}, auth.Properties)
+	assert.Contains(t, properties, model.AuthRequiredUserGroupsProperty, "the given properties must not be modified")
+	assert.Equal(t, "admins,auditors", authProto.Properties[model.AuthRequiredUserGroupsProperty])
+	assert.NotContains(t, auth.Properties, model.AuthRequiredUserGroupsProperty)
+}
+
+func TestAuthServiceAccount(t *testing.T) {
+	// given
+	properties := map[string]string{
+		"key":                            "value",
+		model.AuthServiceAccountProperty: " sa-kms-eu10 ",
+	}
+	auth := model.Auth{Properties: properties}
+
+	// when
+	auth.ExtractServiceAccount()
+	authProto := auth.ToProto()
+
+	// then
+	assert.Equal(t, "sa-kms-eu10", auth.ServiceAccountID)
+	assert.Equal(t, map[string]string{"key": "value"}, auth.Properties)
+	assert.Contains(t, properties, model.AuthServiceAccountProperty, "the given properties must not be modified")
+	assert.Equal(t, "sa-kms-eu10", authProto.Properties[model.AuthServiceAccountProperty])
+	assert.NotContains(t, auth.Properties, model.AuthServiceAccountProperty)
+}
+
+func TestNewAuthPropertyBlob(t *testing.T) {
+	// when
+	blob := model.NewAuthPropertyBlob("external-id", "jwks", "value")
+
+	// then
+	assert.Equal(t, "external-id", blob.AuthID)
+	assert.Equal(t, "jwks", blob.Key)
+	assert.Equal(t, "value", blob.Value)
+	assert.Equal(t, 5, blob.Size)
+	assert.Equal(t, "cd42404d52ad55ccfa9aca4adc828aa5800ad9d385a0671fbcbf724118320619", blob.Digest)
+}
+
 func TestAuthValidationIDs(t *testing.T) {
 	// given
 	authType := reflect.TypeFor[model.Auth]()
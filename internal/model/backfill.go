@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Backfill is the checkpoint of a backfill, so it resumes after the last processed batch across restarts.
+type Backfill struct {
+	Name        string     `gorm:"column:name;primaryKey"`
+	Cursor      string     `gorm:"column:cursor"`    // key of the last processed record
+	Processed   int64      `gorm:"column:processed"` // number of processed records
+	LastError   string     `gorm:"column:last_error"`
+	CompletedAt *time.Time `gorm:"column:completed_at"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt   time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the Backfill entity.
+func (b *Backfill) TableName() string {
+	return "backfills"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (b *Backfill) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.NameField] = b.Name
+
+	return key
+}
+
+// IsCompleted returns true if all records have been processed.
+func (b *Backfill) IsCompleted() bool {
+	return b.CompletedAt != nil
+}
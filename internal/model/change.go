@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Operations of a Change.
+const (
+	ChangeOperationCreate = "CREATE"
+	ChangeOperationUpdate = "UPDATE"
+	ChangeOperationDelete = "DELETE"
+)
+
+// Change records a mutation of a resource in the change feed. The sequence increases monotonically
+// in the order the mutations are committed, so a reader having seen a sequence never misses a change before it.
+type Change struct {
+	Seq int64 `gorm:"column:seq;primaryKey;autoIncrement"`
+	// ResourceType is the table of the resource, e.g. tenants.
+	ResourceType string `gorm:"column:resource_type;index"`
+	// ResourceKey holds the key of the resource by column, e.g. its ID.
+	ResourceKey map[string]any `gorm:"column:resource_key;type:jsonb;serializer:json"`
+	Operation   string         `gorm:"column:operation"`
+	Caller      string         `gorm:"column:caller"` // client mutating the resource; optional
+	CreatedAt   time.Time      `gorm:"column:created_at;autoCreateTime;index"`
+}
+
+// TableName returns the table name of the Change entity.
+func (c *Change) TableName() string {
+	return "changes"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (c *Change) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key["seq"] = c.Seq
+
+	return key
+}
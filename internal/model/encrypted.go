@@ -0,0 +1,90 @@
+package model
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"gorm.io/gorm/schema"
+
+	"github.com/openkcm/registry/internal/encryption"
+)
+
+// EncryptedSerializerName is the name of the GORM serializer encrypting string columns at rest.
+const EncryptedSerializerName = "encrypted"
+
+func init() {
+	schema.RegisterSerializer(EncryptedSerializerName, EncryptedSerializer{})
+}
+
+// EncryptedSerializer deterministically encrypts string fields when writing and decrypts them when reading.
+// Struct conditions are encrypted as well, so records are found by the encrypted value.
+// Without cipher, values are stored as plaintext.
+type EncryptedSerializer struct {
+	Cipher *encryption.Deterministic
+}
+
+// RegisterEncryption registers the cipher of the encrypted columns.
+// It must be called before the models are used the first time, as GORM caches their schema.
+func RegisterEncryption(cipher *encryption.Deterministic) {
+	schema.RegisterSerializer(EncryptedSerializerName, EncryptedSerializer{Cipher: cipher})
+}
+
+// EncryptedFilterValues returns the values an encrypted column may store the plaintext as,
+// to be used in filters of encrypted columns.
+func EncryptedFilterValues(plaintext string) []string {
+	serializer, _ := schema.GetSerializer(EncryptedSerializerName)
+
+	s, ok := serializer.(EncryptedSerializer)
+	if !ok || s.Cipher == nil {
+		return []string{plaintext}
+	}
+
+	return s.Cipher.Candidates(plaintext)
+}
+
+// Scan implements the schema.SerializerInterface.
+func (s EncryptedSerializer) Scan(ctx context.Context, field *schema.Field, dst reflect.Value, dbValue any) error {
+	var value string
+	switch v := dbValue.(type) {
+	case nil:
+		return nil
+	case string:
+		value = v
+	case []byte:
+		value = string(v)
+	default:
+		return fmt.Errorf("failed to scan encrypted value of type %T", dbValue)
+	}
+
+	plaintext := value
+	if encryption.IsEncrypted(value) {
+		if s.Cipher == nil {
+			return encryption.ErrEncryptionDisabled
+		}
+
+		var err error
+		plaintext, err = s.Cipher.Decrypt(value)
+		if err != nil {
+			return err
+		}
+	}
+
+	field.ReflectValueOf(ctx, dst).SetString(plaintext)
+
+	return nil
+}
+
+// Value implements the schema.SerializerValuerInterface.
+func (s EncryptedSerializer) Value(_ context.Context, _ *schema.Field, _ reflect.Value, fieldValue any) (any, error) {
+	value, ok := fieldValue.(string)
+	if !ok {
+		return nil, fmt.Errorf("failed to encrypt value of type %T", fieldValue)
+	}
+
+	if s.Cipher == nil {
+		return value, nil
+	}
+
+	return s.Cipher.Encrypt(value), nil
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// notAllowedEnumValue returns the error of an enum value which is not one of the valid values.
+func notAllowedEnumValue(value string, valid map[string]struct{}) error {
+	return &validation.NotAllowedError{
+		Value:   value,
+		Allowed: slices.Sorted(maps.Keys(valid)),
+	}
+}
+
+// describeEnum returns the list constraint of the valid enum values.
+func describeEnum(valid map[string]struct{}) validation.Constraint {
+	return validation.Constraint{
+		Type: validation.ConstraintTypeList,
+		Spec: &validation.ConstraintSpec{AllowList: slices.Sorted(maps.Keys(valid))},
+	}
+}
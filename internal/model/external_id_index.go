@@ -0,0 +1,31 @@
+package model
+
+import (
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// ExternalIDIndexEntry indexes the external ID of a resource, so a resource is resolved by its external ID
+// regardless of its kind. An external ID may resolve to several resources, e.g. to a tenant and an auth,
+// or to systems of several types. The primary key leads with the external ID, so lookups are index scans.
+type ExternalIDIndexEntry struct {
+	ExternalID string `gorm:"column:external_id;primaryKey"`
+	// ResourceType is the table of the resource, e.g. systems.
+	ResourceType string `gorm:"column:resource_type;primaryKey"`
+	// ResourceID is the canonical key of the resource, its key columns joined by slashes in the order of their names.
+	ResourceID string `gorm:"column:resource_id;primaryKey"`
+}
+
+// TableName returns the table name of the ExternalIDIndexEntry entity.
+func (e *ExternalIDIndexEntry) TableName() string {
+	return "external_id_index"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (e *ExternalIDIndexEntry) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.ExternalIDField] = e.ExternalID
+	key["resource_type"] = e.ResourceType
+	key["resource_id"] = e.ResourceID
+
+	return key
+}
@@ -0,0 +1,65 @@
+package model_test
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm/schema"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// field is a repository.Field of any resource and value type.
+type field interface {
+	Column() repository.QueryField
+	ValueType() reflect.Type
+}
+
+func TestFieldsMatchSchema(t *testing.T) {
+	tests := []struct {
+		name     string
+		resource repository.Resource
+		fields   any
+	}{
+		{name: "tenant", resource: &model.Tenant{}, fields: model.TenantFields},
+		{name: "system", resource: &model.System{}, fields: model.SystemFields},
+		{name: "regional system", resource: &model.RegionalSystem{}, fields: model.RegionalSystemFields},
+		{name: "auth", resource: &model.Auth{}, fields: model.AuthFields},
+		{name: "auth property blob", resource: &model.AuthPropertyBlob{}, fields: model.AuthPropertyBlobFields},
+		{name: "service account", resource: &model.ServiceAccount{}, fields: model.ServiceAccountFields},
+		{name: "system relationship", resource: &model.SystemRelationship{}, fields: model.SystemRelationshipFields},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			s, err := schema.Parse(tt.resource, &sync.Map{}, schema.NamingStrategy{})
+			require.NoError(t, err)
+
+			fields := reflect.ValueOf(tt.fields)
+			for i := range fields.NumField() {
+				name := fields.Type().Field(i).Name
+				f, ok := fields.Field(i).Interface().(field)
+				require.True(t, ok, name)
+
+				// when
+				column := s.LookUpField(f.Column())
+
+				// then
+				require.NotNil(t, column, "column %s of %s", f.Column(), name)
+				assert.Equal(t, f.Column(), column.DBName, name)
+				assert.Equal(t, name, column.Name)
+
+				valueType := column.FieldType
+				if valueType.Kind() == reflect.Pointer {
+					valueType = valueType.Elem()
+				}
+				assert.Equal(t, valueType, f.ValueType(), name)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// IdentityProviderStatus is the status of the propagation of the identity provider of a tenant.
+type IdentityProviderStatus string
+
+// Statuses of an IdentityProvider.
+const (
+	IdentityProviderStatusApplying      IdentityProviderStatus = "APPLYING"
+	IdentityProviderStatusApplied       IdentityProviderStatus = "APPLIED"
+	IdentityProviderStatusApplyingError IdentityProviderStatus = "APPLYING_ERROR"
+	IdentityProviderStatusRemoving      IdentityProviderStatus = "REMOVING"
+	IdentityProviderStatusRemovingError IdentityProviderStatus = "REMOVING_ERROR"
+)
+
+// Types of the orbital jobs propagating the identity provider of a tenant to the region of the tenant.
+// The jobs are keyed by the ID of the tenant.
+const (
+	IdentityProviderJobApply  = "IDENTITY_PROVIDER_ACTION_APPLY"
+	IdentityProviderJobRemove = "IDENTITY_PROVIDER_ACTION_REMOVE"
+)
+
+// IdentityProvider is the identity provider configuration of a tenant, of which a tenant has at most one.
+// It is kept apart from the auths of the tenant, as the regional services treat it specially.
+// The record is deleted once the removal of the identity provider is done.
+type IdentityProvider struct {
+	TenantID       string                 `gorm:"column:tenant_id;primaryKey"`
+	Issuer         string                 `gorm:"column:issuer;not null"`
+	MetadataURL    string                 `gorm:"column:metadata_url"`
+	Status         IdentityProviderStatus `gorm:"column:status;not null"`
+	ErrorMessage   string                 `gorm:"column:error_message"`
+	CreatedBy      string                 `gorm:"column:created_by"`       // client creating the identity provider; optional
+	LastModifiedBy string                 `gorm:"column:last_modified_by"` // client last modifying the identity provider; optional
+	UpdatedAt      time.Time              `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt      time.Time              `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the IdentityProvider entity.
+func (p *IdentityProvider) TableName() string {
+	return "tenant_identity_providers"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (p *IdentityProvider) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.TenantIDField] = p.TenantID
+
+	return key
+}
+
+// SetCreatedBy records the client creating the identity provider.
+func (p *IdentityProvider) SetCreatedBy(caller string) {
+	p.CreatedBy = caller
+}
+
+// SetLastModifiedBy records the client last modifying the identity provider.
+func (p *IdentityProvider) SetLastModifiedBy(caller string) {
+	p.LastModifiedBy = caller
+}
+
+// InTransition returns true while a job propagating the identity provider is unfinished.
+func (p *IdentityProvider) InTransition() bool {
+	return p.Status == IdentityProviderStatusApplying || p.Status == IdentityProviderStatusRemoving
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// InventorySnapshot holds the aggregated inventory of one day.
+// Snapshots are materialized by a background worker, so reporting does not need to scan the live tables.
+type InventorySnapshot struct {
+	Date          time.Time        `gorm:"column:date;type:date;primaryKey"`
+	Tenants       []InventoryCount `gorm:"column:tenants;type:jsonb;serializer:json"`       // by region and status
+	Systems       []InventoryCount `gorm:"column:systems;type:jsonb;serializer:json"`       // regional systems by region, status and type
+	L1KeyClaims   []InventoryCount `gorm:"column:l1_key_claims;type:jsonb;serializer:json"` // active L1 key claims by region
+	TotalSystems  int64            `gorm:"column:total_systems"`
+	LinkedSystems int64            `gorm:"column:linked_systems"`
+	UpdatedAt     time.Time        `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt     time.Time        `gorm:"column:created_at;autoCreateTime"`
+}
+
+// InventoryCount is the number of entities sharing the same region, status and type.
+// Dimensions which are not aggregated by are left empty.
+type InventoryCount struct {
+	Region string `json:"region,omitempty"`
+	Status string `json:"status,omitempty"`
+	Type   string `json:"type,omitempty"`
+	Count  int64  `json:"count"`
+}
+
+// TableName returns the table name of the InventorySnapshot entity.
+func (s *InventorySnapshot) TableName() string {
+	return "inventory_snapshots"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (s *InventorySnapshot) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.DateField] = s.Date
+
+	return key
+}
+
+// LinkRatio returns the share of systems linked to a tenant, or zero if there are no systems.
+func (s *InventorySnapshot) LinkRatio() float64 {
+	if s.TotalSystems == 0 {
+		return 0
+	}
+
+	return float64(s.LinkedSystems) / float64(s.TotalSystems)
+}
@@ -0,0 +1,21 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+)
+
+func TestInventorySnapshotLinkRatio(t *testing.T) {
+	t.Run("should return zero without systems", func(t *testing.T) {
+		snapshot := model.InventorySnapshot{}
+		assert.Zero(t, snapshot.LinkRatio())
+	})
+
+	t.Run("should return share of linked systems", func(t *testing.T) {
+		snapshot := model.InventorySnapshot{TotalSystems: 4, LinkedSystems: 1}
+		assert.InDelta(t, 0.25, snapshot.LinkRatio(), 0.0001)
+	})
+}
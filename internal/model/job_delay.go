@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// JobDelay holds back the confirmation of an orbital job until NotBefore, e.g. the next maintenance window.
+// It is keyed by the ID of the job, so it never holds back another job of the same external ID and type.
+type JobDelay struct {
+	JobID      string    `gorm:"column:job_id;primaryKey"`
+	ExternalID string    `gorm:"column:external_id"`
+	JobType    string    `gorm:"column:job_type"`
+	NotBefore  time.Time `gorm:"column:not_before"`
+	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the JobDelay entity.
+func (d *JobDelay) TableName() string {
+	return "job_delays"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (d *JobDelay) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key["job_id"] = d.JobID
+
+	return key
+}
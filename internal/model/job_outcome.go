@@ -0,0 +1,51 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Outcomes of a JobOutcome, each handled by the respective handler of the job type.
+const (
+	JobOutcomeDone     = "DONE"
+	JobOutcomeFailed   = "FAILED"
+	JobOutcomeCanceled = "CANCELED"
+)
+
+// JobOutcome records the outcome of a finished orbital job with the data its handler needs,
+// so the handling of the outcome can be replayed, e.g. when a downstream consumer lost the resulting change.
+// The outcome keeps a copy of the data of the job, as the tables of orbital are owned by orbital.
+type JobOutcome struct {
+	JobID          string     `gorm:"column:job_id;primaryKey"`
+	ExternalID     string     `gorm:"column:external_id;index"`
+	Type           string     `gorm:"column:type"`
+	Outcome        string     `gorm:"column:outcome"`
+	ErrorMessage   string     `gorm:"column:error_message"`
+	Data           []byte     `gorm:"column:data"`
+	ReplayCount    int        `gorm:"column:replay_count"`
+	LastReplayedAt *time.Time `gorm:"column:last_replayed_at;index"`
+	LastReplayedBy string     `gorm:"column:last_replayed_by"` // client last replaying the outcome; optional
+	CreatedAt      time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the JobOutcome entity.
+func (o *JobOutcome) TableName() string {
+	return "job_outcomes"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (o *JobOutcome) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key["job_id"] = o.JobID
+
+	return key
+}
+
+// SetCreatedBy is a no-op, as outcomes are recorded by orbital and not by clients.
+func (o *JobOutcome) SetCreatedBy(string) {}
+
+// SetLastModifiedBy records the client replaying the outcome, the only modification of an outcome.
+func (o *JobOutcome) SetLastModifiedBy(caller string) {
+	o.LastReplayedBy = caller
+}
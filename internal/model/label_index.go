@@ -0,0 +1,32 @@
+package model
+
+import (
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// LabelIndexEntry indexes a label of a resource, so label selectors look up the matching resources
+// by the key and value of the label instead of evaluating the labels of every resource.
+// The lookup index leads with the key and value and includes the resource, so lookups are index-only scans.
+type LabelIndexEntry struct {
+	// ResourceType is the table of the resource, e.g. tenants.
+	ResourceType string `gorm:"column:resource_type;primaryKey;index:idx_label_index_lookup,priority:1"`
+	// ResourceID is the key of the resource, its key columns joined by slashes in the order of their names.
+	ResourceID string `gorm:"column:resource_id;primaryKey;index:idx_label_index_lookup,priority:4"`
+	Key        string `gorm:"column:key;primaryKey;index:idx_label_index_lookup,priority:2"`
+	Value      string `gorm:"column:value;index:idx_label_index_lookup,priority:3"`
+}
+
+// TableName returns the table name of the LabelIndexEntry entity.
+func (e *LabelIndexEntry) TableName() string {
+	return "label_index"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (e *LabelIndexEntry) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key["resource_type"] = e.ResourceType
+	key["resource_id"] = e.ResourceID
+	key["key"] = e.Key
+
+	return key
+}
@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// MaintenanceModeName is the name of the single maintenance mode record of the registry.
+const MaintenanceModeName = "registry"
+
+// MaintenanceMode is the record of the enabled maintenance mode, in which the registry rejects changing requests.
+// The maintenance mode is disabled if the record does not exist.
+type MaintenanceMode struct {
+	Name      string    `gorm:"column:name;primaryKey"`
+	Reason    string    `gorm:"column:reason"`
+	EnabledBy string    `gorm:"column:enabled_by"` // caller who enabled the maintenance mode
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the MaintenanceMode entity.
+func (m *MaintenanceMode) TableName() string {
+	return "maintenance_modes"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (m *MaintenanceMode) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.NameField] = m.Name
+
+	return key
+}
@@ -0,0 +1,164 @@
+package model
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// maintenanceWindowTimeLayout is the layout of the start time of a weekly maintenance window.
+const maintenanceWindowTimeLayout = "15:04"
+
+const week = 7 * 24 * time.Hour
+
+var ErrInvalidMaintenanceWindow = errors.New("invalid maintenance window")
+
+// MaintenanceWindow is a period in which operations disrupting a tenant, e.g. blocking it, may run.
+// A window is either a one-off window from Start to End, or a weekly window starting at StartTime
+// on each of the Weekdays and lasting Duration, like a cron schedule. All times are UTC.
+type MaintenanceWindow struct {
+	Start *time.Time `json:"start,omitempty"`
+	End   *time.Time `json:"end,omitempty"`
+	// Weekdays are the English names of the days the weekly window starts on, e.g. Saturday.
+	Weekdays []string `json:"weekdays,omitempty"`
+	// StartTime is the time of day the weekly window starts at, e.g. 02:00.
+	StartTime string `json:"startTime,omitempty"`
+	// Duration is the length of the weekly window, e.g. 4h.
+	Duration string `json:"duration,omitempty"`
+}
+
+// MaintenanceWindows are the maintenance windows of a tenant.
+// Operations of tenants without maintenance windows are not restricted.
+type MaintenanceWindows []MaintenanceWindow
+
+// Validate returns an error if any of the windows is not valid.
+func (w MaintenanceWindows) Validate() error {
+	for i, window := range w {
+		if err := window.Validate(); err != nil {
+			return fmt.Errorf("window %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
+
+// NextOpen returns now if a window is open at now, otherwise the start of the next window.
+// It returns false if no window is open or upcoming, e.g. all one-off windows ended.
+func (w MaintenanceWindows) NextOpen(now time.Time) (time.Time, bool) {
+	var next time.Time
+	for _, window := range w {
+		start, ok := window.next(now)
+		if !ok {
+			continue
+		}
+
+		if !start.After(now) {
+			return now, true
+		}
+
+		if next.IsZero() || start.Before(next) {
+			next = start
+		}
+	}
+
+	return next, !next.IsZero()
+}
+
+// Validate returns an error if the window is neither a valid one-off nor a valid weekly window.
+func (w MaintenanceWindow) Validate() error {
+	if w.isOneOff() {
+		if w.Start == nil || w.End == nil || len(w.Weekdays) > 0 || w.StartTime != "" || w.Duration != "" {
+			return fmt.Errorf("%w: a one-off window must only have a start and an end", ErrInvalidMaintenanceWindow)
+		}
+
+		if !w.End.After(*w.Start) {
+			return fmt.Errorf("%w: end must be after start", ErrInvalidMaintenanceWindow)
+		}
+
+		return nil
+	}
+
+	_, _, _, err := w.weekly()
+
+	return err
+}
+
+// next returns the start of the occurrence of the window which is open at now or starts next.
+// A start before now means the window is open. It returns false if the window does not occur again.
+func (w MaintenanceWindow) next(now time.Time) (time.Time, bool) {
+	now = now.UTC()
+
+	if w.isOneOff() {
+		if w.Start == nil || w.End == nil || !now.Before(*w.End) {
+			return time.Time{}, false
+		}
+
+		return w.Start.UTC(), true
+	}
+
+	weekdays, startTime, duration, err := w.weekly()
+	if err != nil {
+		return time.Time{}, false
+	}
+
+	// occurrences started up to a week ago may still be open
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	for day := midnight.Add(-week); !day.After(midnight.Add(week)); day = day.AddDate(0, 0, 1) {
+		if !slices.Contains(weekdays, day.Weekday()) {
+			continue
+		}
+
+		start := day.Add(startTime)
+		if now.Before(start.Add(duration)) {
+			return start, true
+		}
+	}
+
+	return time.Time{}, false
+}
+
+func (w MaintenanceWindow) isOneOff() bool {
+	return w.Start != nil || w.End != nil
+}
+
+// weekly returns the parsed weekdays, start time and duration of a weekly window.
+func (w MaintenanceWindow) weekly() ([]time.Weekday, time.Duration, time.Duration, error) {
+	if len(w.Weekdays) == 0 {
+		return nil, 0, 0, fmt.Errorf("%w: a weekly window must have weekdays", ErrInvalidMaintenanceWindow)
+	}
+
+	weekdays := make([]time.Weekday, 0, len(w.Weekdays))
+	for _, name := range w.Weekdays {
+		weekday, ok := parseWeekday(name)
+		if !ok {
+			return nil, 0, 0, fmt.Errorf("%w: unknown weekday %s", ErrInvalidMaintenanceWindow, name)
+		}
+		weekdays = append(weekdays, weekday)
+	}
+
+	startTime, err := time.Parse(maintenanceWindowTimeLayout, w.StartTime)
+	if err != nil {
+		return nil, 0, 0, fmt.Errorf("%w: start time must be formatted as HH:MM: %s", ErrInvalidMaintenanceWindow, w.StartTime)
+	}
+
+	duration, err := time.ParseDuration(w.Duration)
+	if err != nil || duration <= 0 || duration > week {
+		return nil, 0, 0, fmt.Errorf("%w: duration must be positive and at most a week: %s", ErrInvalidMaintenanceWindow, w.Duration)
+	}
+
+	sinceMidnight := time.Duration(startTime.Hour())*time.Hour + time.Duration(startTime.Minute())*time.Minute
+
+	return weekdays, sinceMidnight, duration, nil
+}
+
+func parseWeekday(name string) (time.Weekday, bool) {
+	for day := time.Sunday; day <= time.Saturday; day++ {
+		if strings.EqualFold(day.String(), name) {
+			return day, true
+		}
+	}
+
+	return 0, false
+}
@@ -0,0 +1,126 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+)
+
+func TestMaintenanceWindowValidate(t *testing.T) {
+	start := time.Date(2026, 1, 10, 2, 0, 0, 0, time.UTC)
+	end := start.Add(4 * time.Hour)
+
+	tests := map[string]struct {
+		window    model.MaintenanceWindow
+		expectErr bool
+	}{
+		"valid one-off window": {
+			window: model.MaintenanceWindow{Start: &start, End: &end},
+		},
+		"valid weekly window": {
+			window: model.MaintenanceWindow{Weekdays: []string{"Saturday", "sunday"}, StartTime: "02:00", Duration: "4h"},
+		},
+		"one-off window without end": {
+			window:    model.MaintenanceWindow{Start: &start},
+			expectErr: true,
+		},
+		"one-off window ending before start": {
+			window:    model.MaintenanceWindow{Start: &end, End: &start},
+			expectErr: true,
+		},
+		"one-off window with weekdays": {
+			window:    model.MaintenanceWindow{Start: &start, End: &end, Weekdays: []string{"Saturday"}},
+			expectErr: true,
+		},
+		"weekly window without weekdays": {
+			window:    model.MaintenanceWindow{StartTime: "02:00", Duration: "4h"},
+			expectErr: true,
+		},
+		"weekly window with unknown weekday": {
+			window:    model.MaintenanceWindow{Weekdays: []string{"Caturday"}, StartTime: "02:00", Duration: "4h"},
+			expectErr: true,
+		},
+		"weekly window with invalid start time": {
+			window:    model.MaintenanceWindow{Weekdays: []string{"Saturday"}, StartTime: "2am", Duration: "4h"},
+			expectErr: true,
+		},
+		"weekly window longer than a week": {
+			window:    model.MaintenanceWindow{Weekdays: []string{"Saturday"}, StartTime: "02:00", Duration: "200h"},
+			expectErr: true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			err := model.MaintenanceWindows{tt.window}.Validate()
+
+			// then
+			if tt.expectErr {
+				assert.ErrorIs(t, err, model.ErrInvalidMaintenanceWindow)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMaintenanceWindowsNextOpen(t *testing.T) {
+	// Saturday, 10 January 2026
+	saturday := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	weekly := model.MaintenanceWindow{Weekdays: []string{"Saturday"}, StartTime: "02:00", Duration: "4h"}
+	oneOffStart := saturday.AddDate(0, 0, 2)
+	oneOffEnd := oneOffStart.Add(time.Hour)
+	oneOff := model.MaintenanceWindow{Start: &oneOffStart, End: &oneOffEnd}
+
+	tests := map[string]struct {
+		windows model.MaintenanceWindows
+		now     time.Time
+		expNext time.Time
+		expOK   bool
+	}{
+		"inside weekly window": {
+			windows: model.MaintenanceWindows{weekly},
+			now:     saturday.Add(3 * time.Hour),
+			expNext: saturday.Add(3 * time.Hour),
+			expOK:   true,
+		},
+		"before weekly window": {
+			windows: model.MaintenanceWindows{weekly},
+			now:     saturday.Add(time.Hour),
+			expNext: saturday.Add(2 * time.Hour),
+			expOK:   true,
+		},
+		"after weekly window": {
+			windows: model.MaintenanceWindows{weekly},
+			now:     saturday.Add(6 * time.Hour),
+			expNext: saturday.AddDate(0, 0, 7).Add(2 * time.Hour),
+			expOK:   true,
+		},
+		"earliest of several windows": {
+			windows: model.MaintenanceWindows{weekly, oneOff},
+			now:     saturday.Add(6 * time.Hour),
+			expNext: oneOffStart,
+			expOK:   true,
+		},
+		"ended one-off window": {
+			windows: model.MaintenanceWindows{oneOff},
+			now:     oneOffEnd,
+			expOK:   false,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			// when
+			next, ok := tt.windows.NextOpen(tt.now)
+
+			// then
+			assert.Equal(t, tt.expOK, ok)
+			assert.Equal(t, tt.expNext, next)
+		})
+	}
+}
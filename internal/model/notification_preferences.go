@@ -0,0 +1,70 @@
+package model
+
+import (
+	"slices"
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// NotificationEvent is a lifecycle event of a tenant its admins may be notified of.
+type NotificationEvent string
+
+// Lifecycle events of a tenant, notified once the job of the operation is done or aborted.
+const (
+	NotificationEventProvisioned     NotificationEvent = "TENANT_PROVISIONED"
+	NotificationEventBlocked         NotificationEvent = "TENANT_BLOCKED"
+	NotificationEventUnblocked       NotificationEvent = "TENANT_UNBLOCKED"
+	NotificationEventTerminated      NotificationEvent = "TENANT_TERMINATED"
+	NotificationEventOperationFailed NotificationEvent = "TENANT_OPERATION_FAILED"
+)
+
+// NotificationEvents are the lifecycle events a tenant may opt into.
+var NotificationEvents = []NotificationEvent{
+	NotificationEventProvisioned,
+	NotificationEventBlocked,
+	NotificationEventUnblocked,
+	NotificationEventTerminated,
+	NotificationEventOperationFailed,
+}
+
+// NotificationPreferences are the lifecycle events the admins of a tenant opted into and the channels
+// they are notified by, of which a tenant has at most one. Without preferences a tenant is not notified.
+type NotificationPreferences struct {
+	TenantID string              `gorm:"column:tenant_id;primaryKey"`
+	Events   []NotificationEvent `gorm:"column:events;type:jsonb;serializer:json"`
+	// Channels are the references of the delivery channels, mailto:<address> or https:// webhook URLs.
+	Channels       []string  `gorm:"column:channels;type:jsonb;serializer:json"`
+	CreatedBy      string    `gorm:"column:created_by"`       // client creating the preferences; optional
+	LastModifiedBy string    `gorm:"column:last_modified_by"` // client last modifying the preferences; optional
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the NotificationPreferences entity.
+func (p *NotificationPreferences) TableName() string {
+	return "tenant_notification_preferences"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (p *NotificationPreferences) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.TenantIDField] = p.TenantID
+
+	return key
+}
+
+// SetCreatedBy records the client creating the preferences.
+func (p *NotificationPreferences) SetCreatedBy(caller string) {
+	p.CreatedBy = caller
+}
+
+// SetLastModifiedBy records the client last modifying the preferences.
+func (p *NotificationPreferences) SetLastModifiedBy(caller string) {
+	p.LastModifiedBy = caller
+}
+
+// OptedInto returns true if the tenant is notified of the event.
+func (p *NotificationPreferences) OptedInto(event NotificationEvent) bool {
+	return len(p.Channels) > 0 && slices.Contains(p.Events, event)
+}
@@ -0,0 +1,21 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+)
+
+func TestNotificationPreferencesOptedInto(t *testing.T) {
+	preferences := &model.NotificationPreferences{
+		Events:   []model.NotificationEvent{model.NotificationEventBlocked},
+		Channels: []string{"mailto:admins@example.org"},
+	}
+
+	assert.True(t, preferences.OptedInto(model.NotificationEventBlocked))
+	assert.False(t, preferences.OptedInto(model.NotificationEventUnblocked))
+	assert.False(t, (&model.NotificationPreferences{Events: preferences.Events}).OptedInto(model.NotificationEventBlocked),
+		"a tenant without channels must not be notified")
+}
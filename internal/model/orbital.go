@@ -0,0 +1,72 @@
+package model
+
+import (
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Job is a read-only projection of the jobs of orbital, which owns and writes the table.
+// The times are stored by orbital as Unix nanoseconds.
+type Job struct {
+	ID           string  `gorm:"column:id;primaryKey"`
+	ExternalID   string  `gorm:"column:external_id"`
+	Type         string  `gorm:"column:type"`
+	Status       string  `gorm:"column:status"`
+	ErrorMessage *string `gorm:"column:error_message"`
+	UpdatedAt    int64   `gorm:"column:updated_at"`
+	CreatedAt    int64   `gorm:"column:created_at"`
+}
+
+// TableName returns the table name of the Job entity.
+func (j *Job) TableName() string {
+	return "jobs"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (j *Job) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = j.ID
+
+	return key
+}
+
+// Task is a read-only projection of the tasks of the orbital jobs.
+type Task struct {
+	ID           string  `gorm:"column:id;primaryKey"`
+	JobID        string  `gorm:"column:job_id"`
+	Target       string  `gorm:"column:target"` // region of the target the task is sent to
+	Status       string  `gorm:"column:status"`
+	ErrorMessage *string `gorm:"column:error_message"`
+	UpdatedAt    int64   `gorm:"column:updated_at"`
+}
+
+// TableName returns the table name of the Task entity.
+func (t *Task) TableName() string {
+	return "tasks"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (t *Task) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = t.ID
+
+	return key
+}
+
+// JobEvent is a read-only projection of the termination events of the orbital jobs.
+type JobEvent struct {
+	ID         string `gorm:"column:id;primaryKey"`
+	IsNotified bool   `gorm:"column:is_notified"`
+}
+
+// TableName returns the table name of the JobEvent entity.
+func (e *JobEvent) TableName() string {
+	return "job_event"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (e *JobEvent) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = e.ID
+
+	return key
+}
@@ -14,23 +14,68 @@ import (
 
 // Validation IDs for the System model fields that are validated individually.
 const (
-	RegionalSystemRegionValidationID validation.ID = "RegionalSystem.Region"
-	SystemStatusValidationID         validation.ID = "RegionalSystem.Status"
-	RegionalSystemLabelsValidationID validation.ID = "RegionalSystem.Labels"
+	RegionalSystemRegionValidationID  validation.ID = "RegionalSystem.Region"
+	SystemStatusValidationID          validation.ID = "RegionalSystem.Status"
+	RegionalSystemLabelsValidationID  validation.ID = "RegionalSystem.Labels"
+	RegionalSystemL2KeyIDValidationID validation.ID = "RegionalSystem.L2KeyID"
 )
 
+// Approval states of a RegionalSystem registered with a system type that requires approval,
+// or reported by a regional operator. Regional systems of other types keep an empty approval status.
+const (
+	ApprovalStatusPending  = "PENDING_APPROVAL"
+	ApprovalStatusApproved = "APPROVED"
+	// ApprovalStatusDiscovered marks a regional system observed by a regional operator,
+	// which awaits confirmation before it can be used.
+	ApprovalStatusDiscovered = "DISCOVERED"
+)
+
+// UnknownEnumValue is stored for enum values which are unknown to this server,
+// if unknown enum values are accepted.
+const UnknownEnumValue = "UNKNOWN"
+
 // RegionalSystem represents a customer-exposed "tenant" of any kind.
 type RegionalSystem struct {
-	SystemID      uuid.UUID         `gorm:"type:uuid;column:system_id;primaryKey"`
-	Region        string            `gorm:"column:region;primaryKey" validationID:"RegionalSystem.Region"`
-	Status        string            `gorm:"column:status" validationID:"RegionalSystem.Status"`
-	L2KeyID       string            `gorm:"column:l2key_id" validationID:"RegionalSystem.L2KeyID"`
-	HasL1KeyClaim *bool             `gorm:"column:has_l1_key_claim"` // claim status of related L1 key
-	Labels        map[string]string `gorm:"column:labels;type:jsonb;serializer:json" validationID:"RegionalSystem.Labels"`
-	UpdatedAt     time.Time         `gorm:"column:updated_at;autoUpdateTime"`
-	CreatedAt     time.Time         `gorm:"column:created_at;autoCreateTime"`
+	SystemID       uuid.UUID         `gorm:"type:uuid;column:system_id;primaryKey"`
+	Region         string            `gorm:"column:region;primaryKey" validationID:"RegionalSystem.Region"`
+	Status         string            `gorm:"column:status" validationID:"RegionalSystem.Status"`
+	L2KeyID        string            `gorm:"column:l2key_id" validationID:"RegionalSystem.L2KeyID"`
+	HasL1KeyClaim  *bool             `gorm:"column:has_l1_key_claim"` // claim status of related L1 key
+	Labels         map[string]string `gorm:"column:labels;type:jsonb;serializer:json" validationID:"RegionalSystem.Labels"`
+	ApprovalStatus string            `gorm:"column:approval_status"`
+	CreatedBy      string            `gorm:"column:created_by"`       // client creating the regional system; optional
+	LastModifiedBy string            `gorm:"column:last_modified_by"` // client last modifying the regional system; optional
+	UpdatedAt      time.Time         `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt      time.Time         `gorm:"column:created_at;autoCreateTime"`
+
+	// L1KeyClaimedAt, L1KeyClaimedBy and L1KeyClaimTenantID describe the last claim of the L1 key, see RecordL1KeyClaim.
+	// They are kept when the claim is released, and they are empty if the key was not claimed since they are recorded.
+	L1KeyClaimedAt     *time.Time `gorm:"column:l1_key_claimed_at"`
+	L1KeyClaimedBy     string     `gorm:"column:l1_key_claimed_by"`
+	L1KeyClaimTenantID string     `gorm:"column:l1_key_claim_tenant_id"`
+
+	System *System `gorm:"foreignKey:SystemID;references:ID;constraint:OnDelete:RESTRICT"`
+}
 
-	System *System `gorm:"foreignKey:SystemID;references:ID"`
+// RegionalSystemFields are the query fields of regional systems.
+var RegionalSystemFields = struct {
+	SystemID       repository.Field[*RegionalSystem, uuid.UUID]
+	Region         repository.Field[*RegionalSystem, string]
+	Status         repository.Field[*RegionalSystem, string]
+	L2KeyID        repository.Field[*RegionalSystem, string]
+	HasL1KeyClaim  repository.Field[*RegionalSystem, bool]
+	ApprovalStatus repository.Field[*RegionalSystem, string]
+	LastModifiedBy repository.Field[*RegionalSystem, string]
+	CreatedAt      repository.Field[*RegionalSystem, time.Time]
+}{
+	SystemID:       repository.NewField[*RegionalSystem, uuid.UUID](repository.SystemIDField),
+	Region:         repository.NewField[*RegionalSystem, string](repository.RegionField),
+	Status:         repository.NewField[*RegionalSystem, string](repository.StatusField),
+	L2KeyID:        repository.NewField[*RegionalSystem, string]("l2key_id"),
+	HasL1KeyClaim:  repository.NewField[*RegionalSystem, bool](repository.L1KeyClaimField),
+	ApprovalStatus: repository.NewField[*RegionalSystem, string](repository.ApprovalStatusField),
+	LastModifiedBy: repository.NewField[*RegionalSystem, string]("last_modified_by"),
+	CreatedAt:      repository.NewField[*RegionalSystem, time.Time](repository.CreatedAtField),
 }
 
 // TableName returns the table name of the System entity.
@@ -43,6 +88,13 @@ func (s *RegionalSystem) HasActiveL1KeyClaim() bool {
 	return s.HasL1KeyClaim != nil && *s.HasL1KeyClaim
 }
 
+// RecordL1KeyClaim records the claim of the L1 key at the time by the client for the tenant.
+func (s *RegionalSystem) RecordL1KeyClaim(at time.Time, caller, tenantID string) {
+	s.L1KeyClaimedAt = &at
+	s.L1KeyClaimedBy = caller
+	s.L1KeyClaimTenantID = tenantID
+}
+
 // PaginationKey returns the fields used for pagination.
 func (s *RegionalSystem) PaginationKey() map[repository.QueryField]any {
 	// The pagination key is a combination of ExternalID and Region.
@@ -53,6 +105,16 @@ func (s *RegionalSystem) PaginationKey() map[repository.QueryField]any {
 	return keys
 }
 
+// SetCreatedBy records the client creating the regional system.
+func (s *RegionalSystem) SetCreatedBy(caller string) {
+	s.CreatedBy = caller
+}
+
+// SetLastModifiedBy records the client last modifying the regional system.
+func (s *RegionalSystem) SetLastModifiedBy(caller string) {
+	s.LastModifiedBy = caller
+}
+
 // ToProto converts the System to its gRPC representation.
 func (s *RegionalSystem) ToProto() (*systemgrpc.System, error) {
 	if s.System == nil {
@@ -83,6 +145,16 @@ func (s *RegionalSystem) ToProto() (*systemgrpc.System, error) {
 	}, nil
 }
 
+// IsPendingApproval returns true if the RegionalSystem still awaits approval.
+func (s *RegionalSystem) IsPendingApproval() bool {
+	return s.ApprovalStatus == ApprovalStatusPending
+}
+
+// IsDiscovered returns true if the RegionalSystem was observed by a regional operator and awaits confirmation.
+func (s *RegionalSystem) IsDiscovered() bool {
+	return s.ApprovalStatus == ApprovalStatusDiscovered
+}
+
 // IsAvailable returns true if the System status is STATUS_AVAILABLE.
 func (s *RegionalSystem) IsAvailable() bool {
 	return s.Status == typespb.Status_STATUS_AVAILABLE.String()
@@ -107,7 +179,7 @@ func (s *RegionalSystem) Validations() []validation.Field {
 	})
 
 	fields = append(fields, validation.Field{
-		ID: "RegionalSystem.L2KeyID",
+		ID: RegionalSystemL2KeyIDValidationID,
 		Validators: []validation.Validator{
 			validation.NonEmptyConstraint{},
 		},
@@ -130,12 +202,13 @@ type RegionalSystemStatusConstraint struct{}
 var validSystemStatuses map[string]struct{}
 
 func init() {
-	validSystemStatuses = make(map[string]struct{}, len(typespb.Status_name)-1)
+	validSystemStatuses = make(map[string]struct{}, len(typespb.Status_name))
 	for _, v := range typespb.Status_name {
 		if v != typespb.Status_STATUS_UNSPECIFIED.String() {
 			validSystemStatuses[v] = struct{}{}
 		}
 	}
+	validSystemStatuses[UnknownEnumValue] = struct{}{}
 }
 
 // Validate checks if the provided system status is valid.
@@ -146,8 +219,13 @@ func (c RegionalSystemStatusConstraint) Validate(value any) error {
 	}
 
 	if _, exists := validSystemStatuses[status]; !exists {
-		return validation.ErrValueNotAllowed
+		return notAllowedEnumValue(status, validSystemStatuses)
 	}
 
 	return nil
 }
+
+// Describe returns the list constraint of the valid system statuses.
+func (c RegionalSystemStatusConstraint) Describe() validation.Constraint {
+	return describeEnum(validSystemStatuses)
+}
@@ -0,0 +1,30 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// ScanCheckpoint is the position of an interrupted table scan, so it resumes after the last scanned page
+// across restarts. It is deleted once the scan is completed.
+type ScanCheckpoint struct {
+	Name      string    `gorm:"column:name;primaryKey"`
+	Position  string    `gorm:"column:position"` // page token of the last scanned page
+	Scanned   int64     `gorm:"column:scanned"`  // number of records scanned up to the position
+	UpdatedAt time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the ScanCheckpoint entity.
+func (c *ScanCheckpoint) TableName() string {
+	return "scan_checkpoints"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (c *ScanCheckpoint) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.NameField] = c.Name
+
+	return key
+}
@@ -0,0 +1,110 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+const (
+	ServiceAccountIDValidationID       validation.ID = "ServiceAccount.ID"
+	ServiceAccountTenantIDValidationID validation.ID = "ServiceAccount.TenantID"
+	ServiceAccountPurposeValidationID  validation.ID = "ServiceAccount.Purpose"
+	ServiceAccountStatusValidationID   validation.ID = "ServiceAccount.Status"
+)
+
+// States of a ServiceAccount. Only an active service account is accepted by the regions.
+const (
+	ServiceAccountStatusActive   = "ACTIVE"
+	ServiceAccountStatusDisabled = "DISABLED"
+)
+
+// maxServiceAccountPurposeLength is the maximum length of the purpose of a service account.
+const maxServiceAccountPurposeLength = 1024
+
+// ServiceAccount is an account of a tenant which the regional components of KMS authenticate as,
+// e.g. to act on the keys of the tenant. Its ID is the identity the components present.
+type ServiceAccount struct {
+	ID             string    `gorm:"column:id;primaryKey" validationID:"ServiceAccount.ID"`
+	TenantID       string    `gorm:"column:tenant_id;not null;index" validationID:"ServiceAccount.TenantID"`
+	Purpose        string    `gorm:"column:purpose" validationID:"ServiceAccount.Purpose"`
+	Status         string    `gorm:"column:status;not null" validationID:"ServiceAccount.Status"`
+	CreatedBy      string    `gorm:"column:created_by"`       // client creating the service account; optional
+	LastModifiedBy string    `gorm:"column:last_modified_by"` // client last modifying the service account; optional
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// ServiceAccountFields are the query fields of service accounts.
+var ServiceAccountFields = struct {
+	ID        repository.Field[*ServiceAccount, string]
+	TenantID  repository.Field[*ServiceAccount, string]
+	Status    repository.Field[*ServiceAccount, string]
+	CreatedAt repository.Field[*ServiceAccount, time.Time]
+}{
+	ID:        repository.NewField[*ServiceAccount, string](repository.IDField),
+	TenantID:  repository.NewField[*ServiceAccount, string](repository.TenantIDField),
+	Status:    repository.NewField[*ServiceAccount, string](repository.StatusField),
+	CreatedAt: repository.NewField[*ServiceAccount, time.Time](repository.CreatedAtField),
+}
+
+var _ validation.Model = &ServiceAccount{}
+
+// TableName returns the table name of the ServiceAccount entity.
+func (s *ServiceAccount) TableName() string {
+	return "service_accounts"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (s *ServiceAccount) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = s.ID
+
+	return key
+}
+
+// SetCreatedBy records the client creating the service account.
+func (s *ServiceAccount) SetCreatedBy(caller string) {
+	s.CreatedBy = caller
+}
+
+// SetLastModifiedBy records the client last modifying the service account.
+func (s *ServiceAccount) SetLastModifiedBy(caller string) {
+	s.LastModifiedBy = caller
+}
+
+// IsActiveFor returns true if the service account belongs to the tenant and is active.
+func (s *ServiceAccount) IsActiveFor(tenantID string) bool {
+	return s.TenantID == tenantID && s.Status == ServiceAccountStatusActive
+}
+
+// Validations returns the validation fields for the ServiceAccount Model.
+func (s *ServiceAccount) Validations() []validation.Field {
+	return []validation.Field{
+		{
+			ID: ServiceAccountIDValidationID,
+			Validators: []validation.Validator{
+				validation.NonEmptyConstraint{},
+			},
+		},
+		{
+			ID: ServiceAccountTenantIDValidationID,
+			Validators: []validation.Validator{
+				validation.NonEmptyConstraint{},
+			},
+		},
+		{
+			ID: ServiceAccountPurposeValidationID,
+			Validators: []validation.Validator{
+				validation.MaxLengthConstraint{MaxLength: maxServiceAccountPurposeLength},
+			},
+		},
+		{
+			ID: ServiceAccountStatusValidationID,
+			Validators: []validation.Validator{
+				validation.ListConstraint{AllowList: []string{ServiceAccountStatusActive, ServiceAccountStatusDisabled}},
+			},
+		},
+	}
+}
@@ -0,0 +1,105 @@
+package model_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestServiceAccountValidations(t *testing.T) {
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.ServiceAccount{}},
+	})
+	assert.NoError(t, err)
+
+	validAccount := model.ServiceAccount{
+		ID:       "sa-kms-eu10",
+		TenantID: "tenant-id",
+		Purpose:  "key management in eu10",
+		Status:   model.ServiceAccountStatusActive,
+	}
+
+	type mutateAccount func(s model.ServiceAccount) model.ServiceAccount
+
+	tests := []struct {
+		name   string
+		mutate mutateAccount
+		expErr error
+	}{
+		{
+			name: "should return error for empty ID",
+			mutate: func(s model.ServiceAccount) model.ServiceAccount {
+				s.ID = ""
+				return s
+			},
+			expErr: validation.ErrValueEmpty,
+		},
+		{
+			name: "should return error for empty TenantID",
+			mutate: func(s model.ServiceAccount) model.ServiceAccount {
+				s.TenantID = ""
+				return s
+			},
+			expErr: validation.ErrValueEmpty,
+		},
+		{
+			name: "should return error for too long Purpose",
+			mutate: func(s model.ServiceAccount) model.ServiceAccount {
+				s.Purpose = strings.Repeat("p", 1025)
+				return s
+			},
+			expErr: validation.ErrValueTooLong,
+		},
+		{
+			name: "should return error for unknown Status",
+			mutate: func(s model.ServiceAccount) model.ServiceAccount {
+				s.Status = "SUSPENDED"
+				return s
+			},
+			expErr: validation.ErrValueNotAllowed,
+		},
+		{
+			name: "should pass for disabled ServiceAccount",
+			mutate: func(s model.ServiceAccount) model.ServiceAccount {
+				s.Status = model.ServiceAccountStatusDisabled
+				return s
+			},
+		},
+		{
+			name: "should pass for valid ServiceAccount",
+			mutate: func(s model.ServiceAccount) model.ServiceAccount {
+				return s
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			account := tt.mutate(validAccount)
+			values, err := validation.GetValues(&account)
+			assert.NoError(t, err)
+
+			err = v.ValidateAll(values)
+
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestServiceAccountIsActiveFor(t *testing.T) {
+	account := model.ServiceAccount{ID: "sa-kms-eu10", TenantID: "tenant-id", Status: model.ServiceAccountStatusActive}
+
+	assert.True(t, account.IsActiveFor("tenant-id"))
+	assert.False(t, account.IsActiveFor("other-tenant-id"))
+
+	account.Status = model.ServiceAccountStatusDisabled
+	assert.False(t, account.IsActiveFor("tenant-id"))
+}
@@ -6,6 +6,8 @@ import (
 
 	"github.com/gofrs/uuid/v5"
 
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
 	"github.com/openkcm/registry/internal/repository"
 	"github.com/openkcm/registry/internal/validation"
 )
@@ -13,17 +15,69 @@ import (
 var ErrSystemNotLoaded = errors.New("system for regional system is not loaded")
 
 const (
-	SystemExternalIDValidationID validation.ID = "System.ExternalID"
-	SystemTypeValidationID       validation.ID = "System.Type"
+	SystemExternalIDValidationID  validation.ID = "System.ExternalID"
+	SystemTypeValidationID        validation.ID = "System.Type"
+	SystemDisplayNameValidationID validation.ID = "System.DisplayName"
+	SystemDescriptionValidationID validation.ID = "System.Description"
+)
+
+// Maximum lengths in characters of the display name and the description of a system.
+const (
+	MaxSystemDisplayNameLength = 128
+	MaxSystemDescriptionLength = 1024
+)
+
+// Environments and criticalities of the default system classification.
+// An empty environment or criticality means the system is not classified.
+const (
+	SystemEnvironmentProd    = "prod"
+	SystemEnvironmentNonProd = "nonprod"
+
+	SystemCriticalityLow    = "low"
+	SystemCriticalityMedium = "medium"
+	SystemCriticalityHigh   = "high"
 )
 
 type System struct {
-	ID         uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
-	ExternalID string    `gorm:"column:external_id;uniqueIndex:ext_type" validationID:"System.ExternalID"`
-	TenantID   *string   `gorm:"column:tenant_id"` // related tenant id; optional
-	Type       string    `gorm:"column:type;uniqueIndex:ext_type" validationID:"System.Type"`
-	UpdatedAt  time.Time `gorm:"column:updated_at;autoUpdateTime"`
-	CreatedAt  time.Time `gorm:"column:created_at;autoCreateTime"`
+	ID             uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	ExternalID     string    `gorm:"column:external_id;uniqueIndex:ext_type" validationID:"System.ExternalID"`
+	TenantID       *string   `gorm:"column:tenant_id;index"` // related tenant id; optional
+	Type           string    `gorm:"column:type;uniqueIndex:ext_type" validationID:"System.Type"`
+	Environment    string    `gorm:"column:environment;index"` // e.g. prod or nonprod; optional
+	Criticality    string    `gorm:"column:criticality"`       // e.g. low, medium or high; optional
+	CreatedBy      string    `gorm:"column:created_by"`        // client creating the system; optional
+	LastModifiedBy string    `gorm:"column:last_modified_by"`  // client last modifying the system; optional
+	UpdatedAt      time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+
+	// DisplayName and Description describe the system to humans, e.g. in UIs instead of its external ID; optional
+	DisplayName string `gorm:"column:display_name" validationID:"System.DisplayName"`
+	Description string `gorm:"column:description" validationID:"System.Description"`
+}
+
+// SystemFields are the query fields of systems.
+var SystemFields = struct {
+	ID          repository.Field[*System, uuid.UUID]
+	ExternalID  repository.Field[*System, string]
+	TenantID    repository.Field[*System, string]
+	Type        repository.Field[*System, string]
+	Environment repository.Field[*System, string]
+	Criticality repository.Field[*System, string]
+	CreatedBy   repository.Field[*System, string]
+	DisplayName repository.Field[*System, string]
+	Description repository.Field[*System, string]
+	CreatedAt   repository.Field[*System, time.Time]
+}{
+	ID:          repository.NewField[*System, uuid.UUID](repository.IDField),
+	ExternalID:  repository.NewField[*System, string](repository.ExternalIDField),
+	TenantID:    repository.NewField[*System, string](repository.TenantIDField),
+	Type:        repository.NewField[*System, string](repository.TypeField),
+	Environment: repository.NewField[*System, string]("environment"),
+	Criticality: repository.NewField[*System, string]("criticality"),
+	CreatedBy:   repository.NewField[*System, string]("created_by"),
+	DisplayName: repository.NewField[*System, string](repository.DisplayNameField),
+	Description: repository.NewField[*System, string](repository.DescriptionField),
+	CreatedAt:   repository.NewField[*System, time.Time](repository.CreatedAtField),
 }
 
 func NewSystem(externalID, systemType string) *System {
@@ -43,11 +97,22 @@ func (s *System) IsLinkedToTenant() bool {
 	return s.TenantID != nil && *s.TenantID != ""
 }
 
+// CanLinkToTenantRole reports whether the system may be linked to a tenant of the given role.
+// Production systems must not be linked to test tenants.
+func (s *System) CanLinkToTenantRole(role string) bool {
+	return s.Environment != SystemEnvironmentProd || role != tenantgrpc.Role_ROLE_TEST.String()
+}
+
 // TableName returns the table name of the GlobalSystem entity.
 func (s *System) TableName() string {
 	return "systems"
 }
 
+// ExternalIDColumn returns the column of the external ID of the system.
+func (s *System) ExternalIDColumn() repository.QueryField {
+	return repository.ExternalIDField
+}
+
 // PaginationKey returns the fields used for pagination.
 func (s *System) PaginationKey() map[repository.QueryField]any {
 	key := make(map[repository.QueryField]any)
@@ -56,6 +121,16 @@ func (s *System) PaginationKey() map[repository.QueryField]any {
 	return key
 }
 
+// SetCreatedBy records the client creating the system.
+func (s *System) SetCreatedBy(caller string) {
+	s.CreatedBy = caller
+}
+
+// SetLastModifiedBy records the client last modifying the system.
+func (s *System) SetLastModifiedBy(caller string) {
+	s.LastModifiedBy = caller
+}
+
 func (s *System) Validations() []validation.Field {
 	return []validation.Field{
 		{
@@ -70,5 +145,19 @@ func (s *System) Validations() []validation.Field {
 				validation.NonEmptyConstraint{},
 			},
 		},
+		{
+			ID: SystemDisplayNameValidationID,
+			Validators: []validation.Validator{
+				validation.MaxLengthConstraint{MaxLength: MaxSystemDisplayNameLength},
+				validation.PrintableConstraint{},
+			},
+		},
+		{
+			ID: SystemDescriptionValidationID,
+			Validators: []validation.Validator{
+				validation.MaxLengthConstraint{MaxLength: MaxSystemDescriptionLength},
+				validation.PrintableConstraint{},
+			},
+		},
 	}
 }
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// States of a SystemCredential. A revoked credential is kept, so its thumbprint is not accepted again.
+const (
+	SystemCredentialStatusActive  = "ACTIVE"
+	SystemCredentialStatusRevoked = "REVOKED"
+)
+
+// SystemCredential is a certificate a regional system authenticates with to KMS.
+// Only the thumbprint of the certificate is registered, never the key material.
+type SystemCredential struct {
+	ID         uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	SystemID   uuid.UUID  `gorm:"type:uuid;column:system_id;uniqueIndex:system_region_thumbprint"`
+	Region     string     `gorm:"column:region;uniqueIndex:system_region_thumbprint"`
+	Thumbprint string     `gorm:"column:thumbprint;uniqueIndex:system_region_thumbprint"` // lowercase hex SHA-256 of the DER certificate
+	NotBefore  time.Time  `gorm:"column:not_before"`
+	NotAfter   time.Time  `gorm:"column:not_after;index"`
+	Status     string     `gorm:"column:status"`
+	RevokedAt  *time.Time `gorm:"column:revoked_at"`
+	UpdatedAt  time.Time  `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the SystemCredential entity.
+func (c *SystemCredential) TableName() string {
+	return "system_credentials"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (c *SystemCredential) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = c.ID
+
+	return key
+}
+
+// IsValidAt returns true if the credential is active and within its validity window at t.
+func (c *SystemCredential) IsValidAt(t time.Time) bool {
+	return c.Status == SystemCredentialStatusActive && !t.Before(c.NotBefore) && t.Before(c.NotAfter)
+}
@@ -0,0 +1,74 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+const (
+	SystemGroupNameValidationID     validation.ID = "SystemGroup.Name"
+	SystemGroupTenantIDValidationID validation.ID = "SystemGroup.TenantID"
+	SystemGroupLabelsValidationID   validation.ID = "SystemGroup.Labels"
+)
+
+// SystemGroup is a named set of systems within the namespace of a tenant,
+// so that a fleet of systems can be managed as a unit.
+type SystemGroup struct {
+	ID        uuid.UUID          `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	Name      string             `gorm:"column:name;uniqueIndex:tenant_name" validationID:"SystemGroup.Name"`
+	TenantID  string             `gorm:"column:tenant_id;uniqueIndex:tenant_name" validationID:"SystemGroup.TenantID"`
+	Members   []SystemIdentifier `gorm:"column:members;type:jsonb;serializer:json"`
+	Labels    map[string]string  `gorm:"column:labels;type:jsonb;serializer:json" validationID:"SystemGroup.Labels"`
+	UpdatedAt time.Time          `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt time.Time          `gorm:"column:created_at;autoCreateTime"`
+}
+
+// SystemIdentifier identifies a system by its external ID and type.
+type SystemIdentifier struct {
+	ExternalID string `json:"externalId"`
+	Type       string `json:"type"`
+}
+
+var _ validation.Model = &SystemGroup{}
+
+// TableName returns the table name of the SystemGroup entity.
+func (g *SystemGroup) TableName() string {
+	return "system_groups"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (g *SystemGroup) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = g.ID
+
+	return key
+}
+
+// Validations returns the validation fields for the SystemGroup Model.
+func (g *SystemGroup) Validations() []validation.Field {
+	return []validation.Field{
+		{
+			ID: SystemGroupNameValidationID,
+			Validators: []validation.Validator{
+				validation.NonEmptyConstraint{},
+			},
+		},
+		{
+			ID: SystemGroupTenantIDValidationID,
+			Validators: []validation.Validator{
+				validation.NonEmptyConstraint{},
+			},
+		},
+		{
+			ID: SystemGroupLabelsValidationID,
+			Validators: []validation.Validator{
+				validation.NonEmptyKeysConstraint{},
+				validation.NonEmptyValConstraint{},
+			},
+		},
+	}
+}
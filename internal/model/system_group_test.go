@@ -0,0 +1,82 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestSystemGroupValidations(t *testing.T) {
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.SystemGroup{}},
+	})
+	assert.NoError(t, err)
+
+	validGroup := model.SystemGroup{
+		Name:     "erp-prod",
+		TenantID: "tenant-id",
+		Members: []model.SystemIdentifier{
+			{ExternalID: "external-id", Type: "system"},
+		},
+		Labels: map[string]string{"env": "prod"},
+	}
+
+	type mutateGroup func(g model.SystemGroup) model.SystemGroup
+
+	tests := []struct {
+		name   string
+		mutate mutateGroup
+		expErr error
+	}{
+		{
+			name: "should return error for empty Name",
+			mutate: func(g model.SystemGroup) model.SystemGroup {
+				g.Name = ""
+				return g
+			},
+			expErr: validation.ErrValueEmpty,
+		},
+		{
+			name: "should return error for empty TenantID",
+			mutate: func(g model.SystemGroup) model.SystemGroup {
+				g.TenantID = ""
+				return g
+			},
+			expErr: validation.ErrValueEmpty,
+		},
+		{
+			name: "should return error for empty label value",
+			mutate: func(g model.SystemGroup) model.SystemGroup {
+				g.Labels = map[string]string{"env": ""}
+				return g
+			},
+			expErr: validation.ErrValueEmpty,
+		},
+		{
+			name: "should pass for valid SystemGroup",
+			mutate: func(g model.SystemGroup) model.SystemGroup {
+				return g
+			},
+			expErr: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := tt.mutate(validGroup)
+			values, err := validation.GetValues(&group)
+			assert.NoError(t, err)
+
+			err = v.ValidateAll(values)
+
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
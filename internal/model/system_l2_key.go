@@ -0,0 +1,38 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// SystemL2Key records an L2 key a regional system was assigned, so the key rotations of a system can be audited.
+// The current key of a regional system is the one without RetiredAt.
+type SystemL2Key struct {
+	ID        uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	SystemID  uuid.UUID  `gorm:"type:uuid;column:system_id;index:system_region_l2key"`
+	Region    string     `gorm:"column:region;index:system_region_l2key"`
+	L2KeyID   string     `gorm:"column:l2key_id"`
+	RetiredAt *time.Time `gorm:"column:retired_at"`
+	CreatedAt time.Time  `gorm:"column:created_at;autoCreateTime"` // time the key was assigned
+}
+
+// TableName returns the table name of the SystemL2Key entity.
+func (k *SystemL2Key) TableName() string {
+	return "system_l2_keys"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (k *SystemL2Key) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = k.ID
+
+	return key
+}
+
+// IsCurrent returns true if the key is the current L2 key of the regional system.
+func (k *SystemL2Key) IsCurrent() bool {
+	return k.RetiredAt == nil
+}
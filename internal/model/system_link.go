@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// SystemLink records a period a system was linked to a tenant, so the links can be reconstructed as of a past time.
+// The external ID and type of the system are kept, so the link can be reported after the system is deleted.
+// The current link of a system is the one without UnlinkedAt.
+type SystemLink struct {
+	ID         uuid.UUID  `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	SystemID   uuid.UUID  `gorm:"type:uuid;column:system_id;index"`
+	ExternalID string     `gorm:"column:external_id"`
+	Type       string     `gorm:"column:type"`
+	TenantID   string     `gorm:"column:tenant_id;index"`
+	UnlinkedAt *time.Time `gorm:"column:unlinked_at"`
+	CreatedAt  time.Time  `gorm:"column:created_at;autoCreateTime"` // time the system was linked
+}
+
+// TableName returns the table name of the SystemLink entity.
+func (l *SystemLink) TableName() string {
+	return "system_links"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (l *SystemLink) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = l.ID
+
+	return key
+}
+
+// IsLinkedAt returns true if the system was linked to the tenant at t.
+func (l *SystemLink) IsLinkedAt(t time.Time) bool {
+	return !t.Before(l.CreatedAt) && (l.UnlinkedAt == nil || t.Before(*l.UnlinkedAt))
+}
@@ -0,0 +1,38 @@
+package model_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+)
+
+func TestSystemLinkIsLinkedAt(t *testing.T) {
+	linkedAt := time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC)
+	unlinkedAt := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		link   model.SystemLink
+		at     time.Time
+		expRes bool
+	}{
+		{name: "before link", link: model.SystemLink{CreatedAt: linkedAt}, at: linkedAt.Add(-time.Second), expRes: false},
+		{name: "at link", link: model.SystemLink{CreatedAt: linkedAt}, at: linkedAt, expRes: true},
+		{name: "current link", link: model.SystemLink{CreatedAt: linkedAt}, at: unlinkedAt, expRes: true},
+		{name: "within ended link", link: model.SystemLink{CreatedAt: linkedAt, UnlinkedAt: &unlinkedAt}, at: unlinkedAt.Add(-time.Second), expRes: true},
+		{name: "at unlink", link: model.SystemLink{CreatedAt: linkedAt, UnlinkedAt: &unlinkedAt}, at: unlinkedAt, expRes: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			res := tt.link.IsLinkedAt(tt.at)
+
+			// then
+			assert.Equal(t, tt.expRes, res)
+		})
+	}
+}
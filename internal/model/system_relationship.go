@@ -0,0 +1,53 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// SystemRelationship is a typed edge from a source system to a target system, e.g. an application system
+// which is part of a landscape system or depends on another system. The allowed types are configured.
+type SystemRelationship struct {
+	ID             uuid.UUID `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	SourceSystemID uuid.UUID `gorm:"type:uuid;column:source_system_id;uniqueIndex:source_target_type"`
+	TargetSystemID uuid.UUID `gorm:"type:uuid;column:target_system_id;uniqueIndex:source_target_type;index"`
+	Type           string    `gorm:"column:type;uniqueIndex:source_target_type"`
+	CreatedBy      string    `gorm:"column:created_by"` // client creating the relationship; optional
+	CreatedAt      time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// SystemRelationshipFields are the query fields of system relationships.
+var SystemRelationshipFields = struct {
+	ID             repository.Field[*SystemRelationship, uuid.UUID]
+	SourceSystemID repository.Field[*SystemRelationship, uuid.UUID]
+	TargetSystemID repository.Field[*SystemRelationship, uuid.UUID]
+	Type           repository.Field[*SystemRelationship, string]
+	CreatedAt      repository.Field[*SystemRelationship, time.Time]
+}{
+	ID:             repository.NewField[*SystemRelationship, uuid.UUID](repository.IDField),
+	SourceSystemID: repository.NewField[*SystemRelationship, uuid.UUID]("source_system_id"),
+	TargetSystemID: repository.NewField[*SystemRelationship, uuid.UUID]("target_system_id"),
+	Type:           repository.NewField[*SystemRelationship, string](repository.TypeField),
+	CreatedAt:      repository.NewField[*SystemRelationship, time.Time](repository.CreatedAtField),
+}
+
+// TableName returns the table name of the SystemRelationship entity.
+func (r *SystemRelationship) TableName() string {
+	return "system_relationships"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (r *SystemRelationship) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = r.ID
+
+	return key
+}
+
+// SetCreatedBy records the client creating the relationship.
+func (r *SystemRelationship) SetCreatedBy(caller string) {
+	r.CreatedBy = caller
+}
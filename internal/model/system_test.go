@@ -113,3 +113,31 @@ func TestSystemValidations(t *testing.T) {
 		})
 	}
 }
+
+func TestSystemCanLinkToTenantRole(t *testing.T) {
+	tests := []struct {
+		name        string
+		environment string
+		role        string
+		expected    bool
+	}{
+		{name: "prod system to live tenant", environment: model.SystemEnvironmentProd, role: "ROLE_LIVE", expected: true},
+		{name: "prod system to test tenant", environment: model.SystemEnvironmentProd, role: "ROLE_TEST", expected: false},
+		{name: "nonprod system to test tenant", environment: model.SystemEnvironmentNonProd, role: "ROLE_TEST", expected: true},
+		{name: "unclassified system to test tenant", environment: "", role: "ROLE_TEST", expected: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			sys := model.NewSystem("ext-1", "TYPE")
+			sys.Environment = tt.environment
+
+			// when
+			actual := sys.CanLinkToTenantRole(tt.role)
+
+			// then
+			assert.Equal(t, tt.expected, actual)
+		})
+	}
+}
@@ -15,6 +15,10 @@ const (
 	TenantOwnerTypeValidationID  = "Tenant.OwnerType"
 	TenantUserGroupsValidationID = "Tenant.UserGroups"
 	TenantLabelsValidationID     = "Tenant.Labels"
+
+	TenantTechnicalContactValidationID  = "Tenant.Contacts.TechnicalContact"
+	TenantSecurityContactValidationID   = "Tenant.Contacts.SecurityContact"
+	TenantEscalationChannelValidationID = "Tenant.Contacts.EscalationChannel"
 )
 
 // Tenant represents the customer-managed key (CMK) tenant entity.
@@ -22,15 +26,75 @@ type Tenant struct {
 	ID              string            `gorm:"column:id;primaryKey" validationID:"Tenant.ID"`
 	Name            string            `gorm:"column:name" validationID:"Tenant.Name"`
 	Region          string            `gorm:"column:region" validationID:"Tenant.Region"`
-	OwnerID         string            `gorm:"column:owner_id" validationID:"Tenant.OwnerID"`
+	OwnerID         string            `gorm:"column:owner_id;serializer:encrypted" validationID:"Tenant.OwnerID"`
 	OwnerType       string            `gorm:"column:owner_type" validationID:"Tenant.OwnerType"`
 	Status          TenantStatus      `gorm:"column:status"`
-	StatusUpdatedAt time.Time         `gorm:"column:status_updated_at"`
+	StatusUpdatedAt time.Time         `gorm:"column:status_updated_at;default:now()"` // taken from the database clock
 	Role            string            `gorm:"column:role" validationID:"Tenant.Role"`
 	Labels          map[string]string `gorm:"column:labels;type:jsonb;serializer:json" validationID:"Tenant.Labels"`
 	UserGroups      []string          `gorm:"column:user_groups;serializer:json" validationID:"Tenant.UserGroups"`
-	UpdatedAt       time.Time         `gorm:"column:updated_at;autoUpdateTime"`
-	CreatedAt       time.Time         `gorm:"column:created_at;autoCreateTime"`
+	Contacts        TenantContacts    `gorm:"column:contacts;type:jsonb;serializer:json" validationID:"Tenant.Contacts"`
+	// MaintenanceWindows restrict when operations disrupting the tenant run, see MaintenanceWindow.
+	MaintenanceWindows MaintenanceWindows `gorm:"column:maintenance_windows;type:jsonb;serializer:json"`
+	Template           string             `gorm:"column:template"` // onboarding template the tenant is registered with; optional
+	FeatureFlags       map[string]bool    `gorm:"column:feature_flags;type:jsonb;serializer:json"`
+	CreatedBy          string             `gorm:"column:created_by"`       // client creating the tenant; optional
+	LastModifiedBy     string             `gorm:"column:last_modified_by"` // client last modifying the tenant; optional
+	UpdatedAt          time.Time          `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt          time.Time          `gorm:"column:created_at;autoCreateTime"`
+	// TerminationEffectiveAt is when the termination of the tenant takes effect, see IsPendingTermination.
+	TerminationEffectiveAt time.Time `gorm:"column:termination_effective_at"`
+	// BlockedUntil is when the block of a tenant blocked for a period expires and the tenant is unblocked,
+	// nil if the tenant is not blocked for a period.
+	BlockedUntil *time.Time `gorm:"column:blocked_until"`
+	// Anonymized is true once the personal data of the terminated tenant is anonymized, see TenantAnonymization.
+	Anonymized bool `gorm:"column:anonymized;not null;default:false"`
+
+	// statusChanged is true if the status was set and the status time is not stored yet.
+	statusChanged bool
+}
+
+// TenantFields are the query fields of tenants.
+var TenantFields = struct {
+	ID                     repository.Field[*Tenant, string]
+	Name                   repository.Field[*Tenant, string]
+	Region                 repository.Field[*Tenant, string]
+	OwnerID                repository.Field[*Tenant, string]
+	OwnerType              repository.Field[*Tenant, string]
+	Status                 repository.Field[*Tenant, TenantStatus]
+	StatusUpdatedAt        repository.Field[*Tenant, time.Time]
+	Role                   repository.Field[*Tenant, string]
+	Template               repository.Field[*Tenant, string]
+	TerminationEffectiveAt repository.Field[*Tenant, time.Time]
+	BlockedUntil           repository.Field[*Tenant, time.Time]
+	Anonymized             repository.Field[*Tenant, bool]
+	CreatedAt              repository.Field[*Tenant, time.Time]
+}{
+	ID:                     repository.NewField[*Tenant, string](repository.IDField),
+	Name:                   repository.NewField[*Tenant, string](repository.NameField),
+	Region:                 repository.NewField[*Tenant, string](repository.RegionField),
+	OwnerID:                repository.NewField[*Tenant, string](repository.OwnerIDField),
+	OwnerType:              repository.NewField[*Tenant, string](repository.OwnerTypeField),
+	Status:                 repository.NewField[*Tenant, TenantStatus](repository.StatusField),
+	StatusUpdatedAt:        repository.NewField[*Tenant, time.Time](repository.StatusUpdatedAtField),
+	Role:                   repository.NewField[*Tenant, string]("role"),
+	Template:               repository.NewField[*Tenant, string]("template"),
+	TerminationEffectiveAt: repository.NewField[*Tenant, time.Time]("termination_effective_at"),
+	BlockedUntil:           repository.NewField[*Tenant, time.Time](repository.BlockedUntilField),
+	Anonymized:             repository.NewField[*Tenant, bool]("anonymized"),
+	CreatedAt:              repository.NewField[*Tenant, time.Time](repository.CreatedAtField),
+}
+
+// TenantContacts holds the contact and escalation information of a tenant.
+type TenantContacts struct {
+	TechnicalContact  string `json:"technicalContact,omitempty" validationID:"TechnicalContact"`
+	SecurityContact   string `json:"securityContact,omitempty" validationID:"SecurityContact"`
+	EscalationChannel string `json:"escalationChannel,omitempty" validationID:"EscalationChannel"`
+}
+
+// HasContacts returns true if at least one contact of the tenant is set.
+func (c TenantContacts) HasContacts() bool {
+	return c != TenantContacts{}
 }
 
 var _ validation.Model = &Tenant{}
@@ -42,7 +106,7 @@ func (t *Tenant) TableName() string {
 
 // Validations returns the validation fields for the Tenant Model.
 func (t *Tenant) Validations() []validation.Field {
-	validations := make([]validation.Field, 0, 8)
+	validations := make([]validation.Field, 0, 11)
 	validations = append(validations, validation.Field{
 		ID: TenantIDValidationID,
 		Validators: []validation.Validator{
@@ -86,6 +150,24 @@ func (t *Tenant) Validations() []validation.Field {
 			validation.NonEmptyValConstraint{},
 		},
 	})
+	validations = append(validations, validation.Field{
+		ID: TenantTechnicalContactValidationID,
+		Validators: []validation.Validator{
+			validation.EmailConstraint{},
+		},
+	})
+	validations = append(validations, validation.Field{
+		ID: TenantSecurityContactValidationID,
+		Validators: []validation.Validator{
+			validation.EmailConstraint{},
+		},
+	})
+	validations = append(validations, validation.Field{
+		ID: TenantEscalationChannelValidationID,
+		Validators: []validation.Validator{
+			validation.URLConstraint{},
+		},
+	})
 
 	return validations
 }
@@ -96,12 +178,13 @@ type TenantRoleConstraint struct{}
 var validTenantRoles map[string]struct{}
 
 func init() {
-	validTenantRoles = make(map[string]struct{}, len(tenantgrpc.Role_name)-1)
+	validTenantRoles = make(map[string]struct{}, len(tenantgrpc.Role_name))
 	for _, v := range tenantgrpc.Role_name {
 		if v != tenantgrpc.Role_ROLE_UNSPECIFIED.String() {
 			validTenantRoles[v] = struct{}{}
 		}
 	}
+	validTenantRoles[UnknownEnumValue] = struct{}{}
 }
 
 // Validate checks if the provided value is a valid Tenant role.
@@ -112,11 +195,21 @@ func (t TenantRoleConstraint) Validate(value any) error {
 		return fmt.Errorf("%w: %T", validation.ErrWrongType, value)
 	}
 	if _, ok := validTenantRoles[roleValue]; !ok {
-		return validation.ErrValueNotAllowed
+		return notAllowedEnumValue(roleValue, validTenantRoles)
 	}
 	return nil
 }
 
+// Describe returns the list constraint of the valid Tenant roles.
+func (t TenantRoleConstraint) Describe() validation.Constraint {
+	return describeEnum(validTenantRoles)
+}
+
+// ExternalIDColumn returns the column of the external ID of the tenant.
+func (t *Tenant) ExternalIDColumn() repository.QueryField {
+	return repository.IDField
+}
+
 // PaginationKey returns the fields used for pagination.
 func (t *Tenant) PaginationKey() map[repository.QueryField]any {
 	key := make(map[repository.QueryField]any)
@@ -125,6 +218,16 @@ func (t *Tenant) PaginationKey() map[repository.QueryField]any {
 	return key
 }
 
+// SetCreatedBy records the client creating the tenant.
+func (t *Tenant) SetCreatedBy(caller string) {
+	t.CreatedBy = caller
+}
+
+// SetLastModifiedBy records the client last modifying the tenant.
+func (t *Tenant) SetLastModifiedBy(caller string) {
+	t.LastModifiedBy = caller
+}
+
 func (t *Tenant) ToProto() *tenantgrpc.Tenant {
 	return &tenantgrpc.Tenant{
 		Id:              t.ID,
@@ -132,7 +235,7 @@ func (t *Tenant) ToProto() *tenantgrpc.Tenant {
 		Region:          t.Region,
 		OwnerType:       t.OwnerType,
 		OwnerId:         t.OwnerID,
-		Status:          tenantgrpc.Status(tenantgrpc.Status_value[string(t.Status)]),
+		Status:          t.Status.ToProto(),
 		StatusUpdatedAt: formatTime(t.StatusUpdatedAt),
 		Role:            tenantgrpc.Role(tenantgrpc.Role_value[t.Role]),
 		Labels:          t.Labels,
@@ -142,7 +245,30 @@ func (t *Tenant) ToProto() *tenantgrpc.Tenant {
 	}
 }
 
+// SetStatus sets the status of the tenant. The status time is set by the repository from the database clock
+// when the tenant is patched, see repository.StatusClocked.
 func (t *Tenant) SetStatus(status TenantStatus) {
 	t.Status = status
-	t.StatusUpdatedAt = time.Now()
+	t.statusChanged = true
+}
+
+// StatusChanged implements repository.StatusClocked.
+func (t *Tenant) StatusChanged() bool {
+	return t.statusChanged
+}
+
+// StatusTimeColumn implements repository.StatusClocked.
+func (t *Tenant) StatusTimeColumn() repository.QueryField {
+	return repository.StatusUpdatedAtField
+}
+
+// ClearStatusChanged implements repository.StatusClocked.
+func (t *Tenant) ClearStatusChanged() {
+	t.statusChanged = false
+}
+
+// IsPendingTermination returns true if the termination of the tenant does not take effect yet,
+// so it can still be canceled.
+func (t *Tenant) IsPendingTermination() bool {
+	return t.Status == TenantStatusPendingTermination
 }
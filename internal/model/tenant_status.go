@@ -11,6 +11,11 @@ import (
 // TenantStatus represents the status of the tenant.
 type TenantStatus string
 
+// TenantStatusPendingTermination is the status of a tenant whose termination is requested,
+// but does not take effect before the grace period ends, so it can still be canceled.
+// The tenant proto does not define it, so the tenant is TERMINATING to clients of api-sdk.
+const TenantStatusPendingTermination TenantStatus = "STATUS_PENDING_TERMINATION"
+
 var ErrInvalidTransition = errors.New("invalid tenant status transition")
 
 var (
@@ -59,6 +64,7 @@ var (
 )
 
 // ValidateTransition checks if the transition from the current status to the target status is valid.
+// A tenant PENDING_TERMINATION can not transition to any of the statuses of the tenant proto.
 func (ts TenantStatus) ValidateTransition(to pb.Status) error {
 	from := pb.Status_STATUS_UNSPECIFIED
 	if ts != "" {
@@ -74,6 +80,15 @@ func (ts TenantStatus) ValidateTransition(to pb.Status) error {
 	return fmt.Errorf("%w from %s to %s", ErrInvalidTransition, from, to)
 }
 
+// ToProto returns the status of the tenant proto, which is TERMINATING for a tenant PENDING_TERMINATION.
+func (ts TenantStatus) ToProto() pb.Status {
+	if ts == TenantStatusPendingTermination {
+		return pb.Status_STATUS_TERMINATING
+	}
+
+	return pb.Status(pb.Status_value[string(ts)])
+}
+
 // IsActive checks if Status is active.
 func (ts TenantStatus) IsActive() bool {
 	return string(ts) == pb.Status_STATUS_ACTIVE.String()
@@ -0,0 +1,40 @@
+package model
+
+import (
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// TenantStatusChange records a past status of a tenant imported from a legacy registry, so the status history
+// of migrated tenants is kept. The registry does not record the status changes of the tenants it provisions.
+type TenantStatusChange struct {
+	ID        uuid.UUID    `gorm:"type:uuid;default:gen_random_uuid();primaryKey"`
+	TenantID  string       `gorm:"column:tenant_id;index"`
+	Status    TenantStatus `gorm:"column:status"`
+	ChangedAt time.Time    `gorm:"column:changed_at"` // time the tenant changed to the status
+	CreatedBy string       `gorm:"column:created_by"` // client importing the status change
+}
+
+// TableName returns the table name of the TenantStatusChange entity.
+func (c *TenantStatusChange) TableName() string {
+	return "tenant_status_changes"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (c *TenantStatusChange) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.IDField] = c.ID
+
+	return key
+}
+
+// SetCreatedBy records the client importing the status change.
+func (c *TenantStatusChange) SetCreatedBy(caller string) {
+	c.CreatedBy = caller
+}
+
+// SetLastModifiedBy is a no-op, as status changes are not modified once imported.
+func (c *TenantStatusChange) SetLastModifiedBy(string) {}
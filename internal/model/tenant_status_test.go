@@ -30,6 +30,13 @@ func TestTenantStatus_ValidateTransition(t *testing.T) {
 			expErr:        model.ErrInvalidTransition,
 			expErrMsg:     "invalid tenant status transition from STATUS_ACTIVE to STATUS_BLOCKED",
 		},
+		{
+			name:          "Invalid transition from PENDING_TERMINATION to TERMINATING",
+			currentStatus: model.TenantStatusPendingTermination,
+			targetStatus:  pb.Status_STATUS_TERMINATING,
+			expErr:        model.ErrInvalidTransition,
+			expErrMsg:     "invalid tenant status transition from STATUS_UNSPECIFIED to STATUS_TERMINATING",
+		},
 		{
 			name:          "Current status is UNSPECIFIED",
 			currentStatus: "",
@@ -76,3 +83,25 @@ func TestTenantStatus_IsActive(t *testing.T) {
 		})
 	}
 }
+
+func TestTenantStatus_ToProto(t *testing.T) {
+	tests := map[string]struct {
+		status   model.TenantStatus
+		expected pb.Status
+	}{
+		"Status of the tenant proto": {
+			status:   model.TenantStatus(pb.Status_STATUS_BLOCKED.String()),
+			expected: pb.Status_STATUS_BLOCKED,
+		},
+		"Pending termination": {
+			status:   model.TenantStatusPendingTermination,
+			expected: pb.Status_STATUS_TERMINATING,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, test.status.ToProto())
+		})
+	}
+}
@@ -129,6 +129,53 @@ func TestTenantValidation(t *testing.T) {
 			},
 			expectErr: true,
 		},
+		"Tenant with valid contacts": {
+			tenant: model.Tenant{
+				Name:      "SuccessFactor",
+				ID:        "1234567890-asdfghjkl~qwertyuio._zxcvbnmp",
+				Region:    "CMK_REGION_EU",
+				Status:    tenantStatusActive,
+				OwnerType: tenantOwnerType1,
+				OwnerID:   "owner-id-123",
+				Role:      "ROLE_TRIAL",
+				Contacts: model.TenantContacts{
+					TechnicalContact:  "tech@example.com",
+					SecurityContact:   "security@example.com",
+					EscalationChannel: "https://chat.example.com/channels/escalation",
+				},
+			},
+			expectErr: false,
+		},
+		"Tenant with invalid security contact": {
+			tenant: model.Tenant{
+				Name:      "SuccessFactor",
+				ID:        "1234567890-asdfghjkl~qwertyuio._zxcvbnmp",
+				Region:    "CMK_REGION_EU",
+				Status:    tenantStatusActive,
+				OwnerType: tenantOwnerType1,
+				OwnerID:   "owner-id-123",
+				Role:      "ROLE_TRIAL",
+				Contacts: model.TenantContacts{
+					SecurityContact: "security team",
+				},
+			},
+			expectErr: true,
+		},
+		"Tenant with invalid escalation channel": {
+			tenant: model.Tenant{
+				Name:      "SuccessFactor",
+				ID:        "1234567890-asdfghjkl~qwertyuio._zxcvbnmp",
+				Region:    "CMK_REGION_EU",
+				Status:    tenantStatusActive,
+				OwnerType: tenantOwnerType1,
+				OwnerID:   "owner-id-123",
+				Role:      "ROLE_TRIAL",
+				Contacts: model.TenantContacts{
+					EscalationChannel: "#escalation",
+				},
+			},
+			expectErr: true,
+		},
 	}
 
 	v, err := validation.New(validation.Config{
@@ -183,3 +230,36 @@ func TestTenantToProto(t *testing.T) {
 	assert.Equal(t, tenant.UpdatedAt.UTC().Format(time.RFC3339Nano), protoTenant.GetUpdatedAt())
 	assert.Equal(t, tenant.CreatedAt.UTC().Format(time.RFC3339Nano), protoTenant.GetCreatedAt())
 }
+
+func TestTenantIsPendingTermination(t *testing.T) {
+	now := time.Date(2025, 8, 5, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		tenant model.Tenant
+		exp    bool
+	}{
+		"pending termination": {
+			tenant: model.Tenant{Status: model.TenantStatusPendingTermination, TerminationEffectiveAt: now.Add(time.Hour)},
+			exp:    true,
+		},
+		"pending termination held back after the termination took effect": {
+			tenant: model.Tenant{Status: model.TenantStatusPendingTermination, TerminationEffectiveAt: now.Add(-time.Hour)},
+			exp:    true,
+		},
+		"terminating": {
+			tenant: model.Tenant{Status: model.TenantStatus(tenantpb.Status_STATUS_TERMINATING.String())},
+		},
+		"blocked after the termination is canceled": {
+			tenant: model.Tenant{
+				Status:                 model.TenantStatus(tenantpb.Status_STATUS_BLOCKED.String()),
+				TerminationEffectiveAt: now.Add(time.Hour),
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, tt.tenant.IsPendingTermination())
+		})
+	}
+}
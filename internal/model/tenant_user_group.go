@@ -0,0 +1,32 @@
+package model
+
+import (
+	"time"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// TenantUserGroup is a user group of a tenant, whose members are granted access to the tenant.
+// The names of the groups of a tenant are also kept in Tenant.UserGroups, which is part of the tenant proto.
+type TenantUserGroup struct {
+	TenantID    string    `gorm:"column:tenant_id;primaryKey"`
+	Name        string    `gorm:"column:name;primaryKey"`
+	Description string    `gorm:"column:description"`
+	SourceIdP   string    `gorm:"column:source_idp"` // identity provider the group is managed in; optional
+	UpdatedAt   time.Time `gorm:"column:updated_at;autoUpdateTime"`
+	CreatedAt   time.Time `gorm:"column:created_at;autoCreateTime"`
+}
+
+// TableName returns the table name of the TenantUserGroup entity.
+func (g *TenantUserGroup) TableName() string {
+	return "tenant_user_groups"
+}
+
+// PaginationKey returns the fields used for pagination.
+func (g *TenantUserGroup) PaginationKey() map[repository.QueryField]any {
+	key := make(map[repository.QueryField]any)
+	key[repository.TenantIDField] = g.TenantID
+	key[repository.NameField] = g.Name
+
+	return key
+}
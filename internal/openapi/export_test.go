@@ -0,0 +1,15 @@
+package openapi
+
+import (
+	"io/fs"
+	"testing"
+)
+
+// SetSwaggerUIAssets sets the file system of the Swagger UI assets until the test ends.
+func SetSwaggerUIAssets(t *testing.T, fsys fs.FS) {
+	t.Helper()
+
+	previous := swaggerUIFS
+	swaggerUIFS = fsys
+	t.Cleanup(func() { swaggerUIFS = previous })
+}
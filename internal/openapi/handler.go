@@ -0,0 +1,125 @@
+package openapi
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"io/fs"
+	"net/http"
+	"time"
+)
+
+const (
+	DocumentPath  = "/openapi.json"
+	SwaggerUIPath = "/swagger"
+)
+
+var ErrSwaggerUIAssetsMissing = errors.New("swagger UI assets are not embedded, run make swagger-ui")
+
+// swaggerUIDist holds the assets of the swagger-ui-dist package of the version pinned by swaggerui/VERSION,
+// which are downloaded by make swagger-ui, so the Swagger UI does not load scripts from a CDN.
+//
+//go:embed swaggerui
+var swaggerUIDist embed.FS
+
+// swaggerUIAssets are the assets of the Swagger UI served below SwaggerUIPath by their content type.
+var swaggerUIAssets = map[string]string{
+	"swagger-ui.css":       "text/css; charset=utf-8",
+	"swagger-ui-bundle.js": "text/javascript; charset=utf-8",
+}
+
+// swaggerUIFS is the file system of the Swagger UI assets.
+var swaggerUIFS, _ = fs.Sub(swaggerUIDist, "swaggerui")
+
+// swaggerUIPage renders the document with the embedded Swagger UI assets.
+const swaggerUIPage = `<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <title>%[1]s</title>
+  <link rel="stylesheet" href="%[3]s/swagger-ui.css">
+</head>
+<body>
+  <div id="swagger-ui"></div>
+  <script src="%[3]s/swagger-ui-bundle.js"></script>
+  <script>
+    window.onload = () => {
+      window.ui = SwaggerUIBundle({ url: "%[2]s", dom_id: "#swagger-ui" });
+    };
+  </script>
+</body>
+</html>
+`
+
+// CachePolicy is the Cache-Control header of the routes served by the handler.
+type CachePolicy struct {
+	// Default is the header of the routes without a header of their own, empty omits the header.
+	Default string
+	// Routes are the headers by route, e.g. /openapi.json, an empty header omits the header of the route.
+	Routes map[string]string
+}
+
+// header returns the Cache-Control header of the route.
+func (p CachePolicy) header(route string) string {
+	if header, ok := p.Routes[route]; ok {
+		return header
+	}
+
+	return p.Default
+}
+
+// NewHandler returns a handler serving the document at DocumentPath,
+// and the Swagger UI at SwaggerUIPath with its embedded assets below it if swaggerUI is true.
+// The document is encoded once, as the registered services do not change at runtime.
+// Every route is served with an ETag of its content, so clients polling it with If-None-Match get a 304 while
+// it is unchanged, and with the Cache-Control header of the route by the cache policy.
+// The registry does not serve the resources over HTTP, so there are no resource reads to answer by their version.
+func NewHandler(doc *Document, swaggerUI bool, cache CachePolicy) (http.Handler, error) {
+	body, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	handleContent(mux, DocumentPath, "application/json", body, cache.header(DocumentPath))
+
+	if swaggerUI {
+		for name, contentType := range swaggerUIAssets {
+			asset, err := fs.ReadFile(swaggerUIFS, name)
+			if err != nil {
+				return nil, fmt.Errorf("%w: %w", ErrSwaggerUIAssetsMissing, err)
+			}
+
+			route := SwaggerUIPath + "/" + name
+			handleContent(mux, route, contentType, asset, cache.header(route))
+		}
+
+		page := fmt.Sprintf(swaggerUIPage, html.EscapeString(doc.Info.Title), DocumentPath, SwaggerUIPath)
+		handleContent(mux, SwaggerUIPath, "text/html; charset=utf-8", []byte(page), cache.header(SwaggerUIPath))
+	}
+
+	return mux, nil
+}
+
+// handleContent serves the content at the route with the ETag of the content and the Cache-Control header
+// unless it is empty.
+func handleContent(mux *http.ServeMux, route, contentType string, content []byte, cacheControl string) {
+	sum := sha256.Sum256(content)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	mux.HandleFunc("GET "+route, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("ETag", etag)
+		if cacheControl != "" {
+			w.Header().Set("Cache-Control", cacheControl)
+		}
+
+		// ServeContent answers conditional requests by the ETag
+		http.ServeContent(w, r, route, time.Time{}, bytes.NewReader(content))
+	})
+}
@@ -0,0 +1,246 @@
+// Package openapi generates an OpenAPI v3 document from the registered gRPC services,
+// so integrators can explore the API without the api-sdk protos.
+//
+// Every unary method is described as a POST operation on /{service}/{method},
+// with the request and response messages in their proto3 JSON mapping.
+package openapi
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const openAPIVersion = "3.0.3"
+
+var ErrServiceNotFound = errors.New("service descriptor not found")
+
+// wellKnownSchemas are the well-known types with a special proto3 JSON mapping.
+var wellKnownSchemas = map[protoreflect.FullName]Schema{
+	"google.protobuf.Timestamp": {Type: "string", Format: "date-time"},
+	"google.protobuf.Duration":  {Type: "string"},
+	"google.protobuf.FieldMask": {Type: "string"},
+	"google.protobuf.Struct":    {Type: "object"},
+	"google.protobuf.Empty":     {Type: "object"},
+}
+
+// ServiceInfoProvider is implemented by *grpc.Server.
+type ServiceInfoProvider interface {
+	GetServiceInfo() map[string]grpc.ServiceInfo
+}
+
+type (
+	// Document is an OpenAPI v3 document.
+	Document struct {
+		OpenAPI    string              `json:"openapi"`
+		Info       Info                `json:"info"`
+		Paths      map[string]PathItem `json:"paths"`
+		Components Components          `json:"components"`
+	}
+
+	Info struct {
+		Title   string `json:"title"`
+		Version string `json:"version"`
+	}
+
+	PathItem struct {
+		Post *Operation `json:"post,omitempty"`
+	}
+
+	Operation struct {
+		OperationID string              `json:"operationId"`
+		Tags        []string            `json:"tags"`
+		RequestBody RequestBody         `json:"requestBody"`
+		Responses   map[string]Response `json:"responses"`
+	}
+
+	RequestBody struct {
+		Required bool                 `json:"required"`
+		Content  map[string]MediaType `json:"content"`
+	}
+
+	Response struct {
+		Description string               `json:"description"`
+		Content     map[string]MediaType `json:"content,omitempty"`
+	}
+
+	MediaType struct {
+		Schema *Schema `json:"schema"`
+	}
+
+	Components struct {
+		Schemas map[string]*Schema `json:"schemas"`
+	}
+
+	Schema struct {
+		Ref                  string             `json:"$ref,omitempty"`
+		Type                 string             `json:"type,omitempty"`
+		Format               string             `json:"format,omitempty"`
+		Enum                 []string           `json:"enum,omitempty"`
+		Items                *Schema            `json:"items,omitempty"`
+		Properties           map[string]*Schema `json:"properties,omitempty"`
+		AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	}
+)
+
+// Generate builds the document of the services registered on the gRPC server.
+func Generate(server ServiceInfoProvider, info Info) (*Document, error) {
+	names := make([]string, 0)
+	for name := range server.GetServiceInfo() {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	services := make([]protoreflect.ServiceDescriptor, 0, len(names))
+	for _, name := range names {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(name))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %s: %w", ErrServiceNotFound, name, err)
+		}
+
+		service, ok := desc.(protoreflect.ServiceDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrServiceNotFound, name)
+		}
+
+		services = append(services, service)
+	}
+
+	return GenerateFromDescriptors(services, info), nil
+}
+
+// GenerateFromDescriptors builds the document of the given services.
+// Streaming methods are skipped, as they can not be described as a single request and response.
+func GenerateFromDescriptors(services []protoreflect.ServiceDescriptor, info Info) *Document {
+	doc := &Document{
+		OpenAPI: openAPIVersion,
+		Info:    info,
+		Paths:   make(map[string]PathItem),
+		Components: Components{
+			Schemas: make(map[string]*Schema),
+		},
+	}
+
+	for _, service := range services {
+		methods := service.Methods()
+		for i := range methods.Len() {
+			method := methods.Get(i)
+			if method.IsStreamingClient() || method.IsStreamingServer() {
+				continue
+			}
+
+			doc.Paths[fmt.Sprintf("/%s/%s", service.FullName(), method.Name())] = PathItem{
+				Post: &Operation{
+					OperationID: string(method.Name()),
+					Tags:        []string{string(service.FullName())},
+					RequestBody: RequestBody{
+						Required: true,
+						Content:  jsonContent(doc.addMessage(method.Input())),
+					},
+					Responses: map[string]Response{
+						"200": {
+							Description: "OK",
+							Content:     jsonContent(doc.addMessage(method.Output())),
+						},
+					},
+				},
+			}
+		}
+	}
+
+	return doc
+}
+
+// addMessage adds the schema of the message and all nested messages to the components
+// and returns a reference to it.
+func (d *Document) addMessage(msg protoreflect.MessageDescriptor) *Schema {
+	if schema, ok := wellKnownSchemas[msg.FullName()]; ok {
+		return &schema
+	}
+
+	name := string(msg.FullName())
+	ref := &Schema{Ref: "#/components/schemas/" + name}
+
+	if _, ok := d.Components.Schemas[name]; ok {
+		return ref
+	}
+
+	schema := &Schema{
+		Type:       "object",
+		Properties: make(map[string]*Schema),
+	}
+	// Register before resolving the fields to terminate on recursive messages.
+	d.Components.Schemas[name] = schema
+
+	fields := msg.Fields()
+	for i := range fields.Len() {
+		field := fields.Get(i)
+		schema.Properties[field.JSONName()] = d.fieldSchema(field)
+	}
+
+	return ref
+}
+
+func (d *Document) fieldSchema(field protoreflect.FieldDescriptor) *Schema {
+	switch {
+	case field.IsMap():
+		return &Schema{
+			Type:                 "object",
+			AdditionalProperties: d.singularSchema(field.MapValue()),
+		}
+	case field.IsList():
+		return &Schema{
+			Type:  "array",
+			Items: d.singularSchema(field),
+		}
+	default:
+		return d.singularSchema(field)
+	}
+}
+
+// singularSchema returns the schema of a single value of the field in the proto3 JSON mapping.
+func (d *Document) singularSchema(field protoreflect.FieldDescriptor) *Schema {
+	switch field.Kind() {
+	case protoreflect.BoolKind:
+		return &Schema{Type: "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return &Schema{Type: "integer", Format: "int32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// 64-bit integers are encoded as strings in JSON.
+		return &Schema{Type: "string", Format: "int64"}
+	case protoreflect.FloatKind:
+		return &Schema{Type: "number", Format: "float"}
+	case protoreflect.DoubleKind:
+		return &Schema{Type: "number", Format: "double"}
+	case protoreflect.BytesKind:
+		return &Schema{Type: "string", Format: "byte"}
+	case protoreflect.EnumKind:
+		return &Schema{Type: "string", Enum: enumNames(field.Enum())}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return d.addMessage(field.Message())
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+func enumNames(enum protoreflect.EnumDescriptor) []string {
+	values := enum.Values()
+	names := make([]string, 0, values.Len())
+	for i := range values.Len() {
+		names = append(names, string(values.Get(i).Name()))
+	}
+
+	return names
+}
+
+func jsonContent(schema *Schema) map[string]MediaType {
+	return map[string]MediaType{
+		"application/json": {Schema: schema},
+	}
+}
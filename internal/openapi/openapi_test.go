@@ -0,0 +1,262 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/openapi"
+)
+
+const tenantService = "kms.api.cmk.registry.tenant.v1.Service"
+
+// swaggerUIAssets are stand-ins of the Swagger UI assets, which are not embedded until make swagger-ui ran.
+var swaggerUIAssets = fstest.MapFS{
+	"swagger-ui.css":       {Data: []byte(".swagger-ui {}")},
+	"swagger-ui-bundle.js": {Data: []byte("function SwaggerUIBundle() {}")},
+}
+
+func newServer() *grpc.Server {
+	server := grpc.NewServer()
+	tenantgrpc.RegisterServiceServer(server, &tenantgrpc.UnimplementedServiceServer{})
+
+	return server
+}
+
+func TestGenerate(t *testing.T) {
+	// given
+	server := newServer()
+
+	// when
+	doc, err := openapi.Generate(server, openapi.Info{Title: "registry", Version: "1.0.0"})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, "3.0.3", doc.OpenAPI)
+	assert.Equal(t, "registry", doc.Info.Title)
+
+	path, ok := doc.Paths["/"+tenantService+"/RegisterTenant"]
+	require.True(t, ok)
+	require.NotNil(t, path.Post)
+	assert.Equal(t, "RegisterTenant", path.Post.OperationID)
+	assert.Equal(t, []string{tenantService}, path.Post.Tags)
+	assert.Equal(t, "#/components/schemas/kms.api.cmk.registry.tenant.v1.RegisterTenantRequest",
+		path.Post.RequestBody.Content["application/json"].Schema.Ref)
+
+	req, ok := doc.Components.Schemas["kms.api.cmk.registry.tenant.v1.RegisterTenantRequest"]
+	require.True(t, ok)
+	assert.Equal(t, "object", req.Type)
+	assert.Equal(t, "string", req.Properties["ownerId"].Type)
+	assert.Contains(t, req.Properties["role"].Enum, tenantgrpc.Role_ROLE_LIVE.String())
+	assert.Equal(t, "object", req.Properties["labels"].Type)
+	assert.Equal(t, "string", req.Properties["labels"].AdditionalProperties.Type)
+
+	_, ok = doc.Components.Schemas["kms.api.cmk.registry.tenant.v1.Tenant"]
+	assert.True(t, ok, "nested messages are added to the components")
+}
+
+func TestGenerateUnknownService(t *testing.T) {
+	// given
+	server := unknownServices{}
+
+	// when
+	_, err := openapi.Generate(server, openapi.Info{})
+
+	// then
+	assert.ErrorIs(t, err, openapi.ErrServiceNotFound)
+}
+
+func TestHandler(t *testing.T) {
+	doc, err := openapi.Generate(newServer(), openapi.Info{Title: "registry"})
+	require.NoError(t, err)
+	openapi.SetSwaggerUIAssets(t, swaggerUIAssets)
+
+	tests := []struct {
+		name           string
+		swaggerUI      bool
+		path           string
+		expStatus      int
+		expContentType string
+	}{
+		{
+			name:           "document",
+			path:           openapi.DocumentPath,
+			expStatus:      http.StatusOK,
+			expContentType: "application/json",
+		},
+		{
+			name:           "swagger UI enabled",
+			swaggerUI:      true,
+			path:           openapi.SwaggerUIPath,
+			expStatus:      http.StatusOK,
+			expContentType: "text/html; charset=utf-8",
+		},
+		{
+			name:           "swagger UI asset",
+			swaggerUI:      true,
+			path:           openapi.SwaggerUIPath + "/swagger-ui-bundle.js",
+			expStatus:      http.StatusOK,
+			expContentType: "text/javascript; charset=utf-8",
+		},
+		{
+			name:      "swagger UI disabled",
+			path:      openapi.SwaggerUIPath,
+			expStatus: http.StatusNotFound,
+		},
+		{
+			name:      "swagger UI asset disabled",
+			path:      openapi.SwaggerUIPath + "/swagger-ui.css",
+			expStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			handler, err := openapi.NewHandler(doc, tt.swaggerUI, openapi.CachePolicy{})
+			require.NoError(t, err)
+
+			rec := httptest.NewRecorder()
+
+			// when
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			// then
+			assert.Equal(t, tt.expStatus, rec.Code)
+			if tt.expContentType != "" {
+				assert.Equal(t, tt.expContentType, rec.Header().Get("Content-Type"))
+			}
+
+			if tt.path == openapi.DocumentPath {
+				var got openapi.Document
+				require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &got))
+				assert.Len(t, got.Paths, len(doc.Paths))
+			}
+			if tt.path == openapi.SwaggerUIPath && tt.swaggerUI {
+				assert.Contains(t, rec.Body.String(), `src="/swagger/swagger-ui-bundle.js"`)
+				assert.NotContains(t, rec.Body.String(), "https://")
+			}
+		})
+	}
+}
+
+func TestHandlerSwaggerUIAssetsMissing(t *testing.T) {
+	// given
+	doc, err := openapi.Generate(newServer(), openapi.Info{Title: "registry"})
+	require.NoError(t, err)
+	openapi.SetSwaggerUIAssets(t, fstest.MapFS{})
+
+	// when
+	_, err = openapi.NewHandler(doc, true, openapi.CachePolicy{})
+
+	// then
+	assert.ErrorIs(t, err, openapi.ErrSwaggerUIAssetsMissing)
+}
+
+func TestHandlerConditionalRequests(t *testing.T) {
+	// given
+	doc, err := openapi.Generate(newServer(), openapi.Info{Title: "registry"})
+	require.NoError(t, err)
+
+	handler, err := openapi.NewHandler(doc, false, openapi.CachePolicy{Default: "no-cache"})
+	require.NoError(t, err)
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, openapi.DocumentPath, nil))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+	assert.Equal(t, "no-cache", rec.Header().Get("Cache-Control"))
+
+	t.Run("should not return the document if it matches the ETag", func(t *testing.T) {
+		// given
+		req := httptest.NewRequest(http.MethodGet, openapi.DocumentPath, nil)
+		req.Header.Set("If-None-Match", etag)
+		rec := httptest.NewRecorder()
+
+		// when
+		handler.ServeHTTP(rec, req)
+
+		// then
+		assert.Equal(t, http.StatusNotModified, rec.Code)
+		assert.Empty(t, rec.Body.Bytes())
+		assert.Equal(t, etag, rec.Header().Get("ETag"))
+	})
+
+	t.Run("should return the document if it does not match the ETag", func(t *testing.T) {
+		// given
+		req := httptest.NewRequest(http.MethodGet, openapi.DocumentPath, nil)
+		req.Header.Set("If-None-Match", `"outdated"`)
+		rec := httptest.NewRecorder()
+
+		// when
+		handler.ServeHTTP(rec, req)
+
+		// then
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.NotEmpty(t, rec.Body.Bytes())
+	})
+}
+
+func TestHandlerCachePolicy(t *testing.T) {
+	// given
+	doc, err := openapi.Generate(newServer(), openapi.Info{Title: "registry"})
+	require.NoError(t, err)
+	openapi.SetSwaggerUIAssets(t, swaggerUIAssets)
+
+	handler, err := openapi.NewHandler(doc, true, openapi.CachePolicy{
+		Default: "no-cache",
+		Routes: map[string]string{
+			openapi.SwaggerUIPath:                     "max-age=3600",
+			openapi.SwaggerUIPath + "/swagger-ui.css": "max-age=86400",
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name            string
+		path            string
+		expCacheControl string
+	}{
+		{name: "default", path: openapi.DocumentPath, expCacheControl: "no-cache"},
+		{name: "route", path: openapi.SwaggerUIPath, expCacheControl: "max-age=3600"},
+		{name: "asset route", path: openapi.SwaggerUIPath + "/swagger-ui.css", expCacheControl: "max-age=86400"},
+		{name: "asset default", path: openapi.SwaggerUIPath + "/swagger-ui-bundle.js", expCacheControl: "no-cache"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, tt.path, nil))
+
+			// then
+			require.Equal(t, http.StatusOK, rec.Code)
+			assert.Equal(t, tt.expCacheControl, rec.Header().Get("Cache-Control"))
+
+			// when
+			req := httptest.NewRequest(http.MethodGet, tt.path, nil)
+			req.Header.Set("If-None-Match", rec.Header().Get("ETag"))
+			rec = httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, http.StatusNotModified, rec.Code)
+		})
+	}
+}
+
+type unknownServices struct{}
+
+func (unknownServices) GetServiceInfo() map[string]grpc.ServiceInfo {
+	return map[string]grpc.ServiceInfo{"unknown.v1.Service": {}}
+}
@@ -0,0 +1,139 @@
+package repository
+
+import (
+	"reflect"
+)
+
+// Field describes a column of the resources of type R whose values are of type V. The conditions built
+// by a Field are checked by the compiler against the resource of the query and the type of the values,
+// unlike a QueryField with a value of any type. The Fields of the models are declared with the models,
+// which check them against their schema.
+type Field[R Resource, V any] struct {
+	column QueryField
+}
+
+// NewField creates and returns the Field of the column of the resources of type R.
+func NewField[R Resource, V any](column QueryField) Field[R, V] {
+	return Field[R, V]{column: column}
+}
+
+// Column returns the column of the field.
+func (f Field[R, V]) Column() QueryField {
+	return f.column
+}
+
+// Qualified returns the column of the field qualified by the table of R, e.g. for the conditions
+// and the join columns of queries joining other resources.
+func (f Field[R, V]) Qualified() QueryField {
+	return newResource[R]().TableName() + "." + f.column
+}
+
+// ValueType returns the type of the values of the field, e.g. to check it against the schema of the resource.
+func (f Field[R, V]) ValueType() reflect.Type {
+	return reflect.TypeFor[V]()
+}
+
+// Eq matches the records whose value equals value.
+func (f Field[R, V]) Eq(value V) Condition[R] {
+	return Condition[R]{column: f.column, value: value}
+}
+
+// In matches the records whose value is one of values.
+func (f Field[R, V]) In(values ...V) Condition[R] {
+	return Condition[R]{column: f.column, value: values}
+}
+
+// Ne matches the records whose value does not equal value, including the records without value, see Not.
+func (f Field[R, V]) Ne(value V) Condition[R] {
+	return Condition[R]{column: f.column, value: Not{Value: value}}
+}
+
+// NotIn matches the records whose value is none of values, including the records without value, see Not.
+func (f Field[R, V]) NotIn(values ...V) Condition[R] {
+	return Condition[R]{column: f.column, value: Not{Value: values}}
+}
+
+// Between matches the records whose value is between from and to, both inclusive.
+func (f Field[R, V]) Between(from, to V) Condition[R] {
+	return Condition[R]{column: f.column, value: Range{From: from, To: to}}
+}
+
+// AtLeast matches the records whose value is from or after.
+func (f Field[R, V]) AtLeast(from V) Condition[R] {
+	return Condition[R]{column: f.column, value: Range{From: from}}
+}
+
+// AtMost matches the records whose value is to or before.
+func (f Field[R, V]) AtMost(to V) Condition[R] {
+	return Condition[R]{column: f.column, value: Range{To: to}}
+}
+
+// IsEmpty matches the records without value or with an empty value.
+func (f Field[R, V]) IsEmpty() Condition[R] {
+	return Condition[R]{column: f.column, value: Empty}
+}
+
+// IsNotEmpty matches the records with a non-empty value.
+func (f Field[R, V]) IsNotEmpty() Condition[R] {
+	return Condition[R]{column: f.column, value: NotEmpty}
+}
+
+// Condition is a condition of a Field of the resources of type R.
+type Condition[R Resource] struct {
+	column QueryField
+	value  any
+}
+
+// Key returns the CompositeKey of the conditions, which all must match. Conditions of the same field
+// must all match as well, see All.
+func Key[R Resource](conditions ...Condition[R]) CompositeKey {
+	key := NewCompositeKey()
+	for _, condition := range conditions {
+		existing, ok := key[condition.column]
+		if !ok {
+			key.Where(condition.column, condition.value)
+			continue
+		}
+
+		all, ok := existing.(All)
+		if !ok {
+			all = All{existing}
+		}
+		key.Where(condition.column, append(all, condition.value))
+	}
+
+	return key
+}
+
+// QueryOf creates and returns a new query of the resources of type R matching all conditions.
+// Without conditions the query matches all resources.
+func QueryOf[R Resource](conditions ...Condition[R]) *Query {
+	query := NewQuery(newResource[R]())
+	if len(conditions) > 0 {
+		query.Where(Key(conditions...))
+	}
+
+	return query
+}
+
+// JoinOn returns the Join of the resources of type J whose field on equals the field of the resources of the query.
+// The fields must have values of the same type.
+func JoinOn[R, J Resource, V any](field Field[R, V], on Field[J, V]) Join {
+	return Join{Resource: newResource[J](), OnColumn: on.column, Column: field.column}
+}
+
+// MissingOn returns the Missing of the resources of type M whose field on equals the field of the resources of the query.
+// The fields must have values of the same type.
+func MissingOn[R, M Resource, V any](field Field[R, V], on Field[M, V]) Missing {
+	return Missing{Resource: newResource[M](), OnColumns: []QueryField{on.column}, Columns: []QueryField{field.column}}
+}
+
+// newResource returns a new resource of type R, which is a pointer to the model of the resource.
+func newResource[R Resource]() R {
+	var resource R
+	if t := reflect.TypeFor[R](); t.Kind() == reflect.Pointer {
+		resource, _ = reflect.New(t.Elem()).Interface().(R)
+	}
+
+	return resource
+}
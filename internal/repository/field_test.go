@@ -0,0 +1,88 @@
+package repository_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+func TestField(t *testing.T) {
+	t.Run("should build the composite key of the conditions", func(t *testing.T) {
+		// given
+		blockedUntil := time.Date(2025, 6, 3, 12, 0, 0, 0, time.UTC)
+
+		// when
+		key := repository.Key(
+			model.TenantFields.Region.In("eu10", "us10"),
+			model.TenantFields.Status.Ne(model.TenantStatusPendingTermination),
+			model.TenantFields.BlockedUntil.AtMost(blockedUntil),
+			model.TenantFields.Template.IsNotEmpty(),
+		)
+
+		// then
+		assert.Equal(t, repository.CompositeKey{
+			repository.RegionField:       []string{"eu10", "us10"},
+			repository.StatusField:       repository.Not{Value: model.TenantStatusPendingTermination},
+			repository.BlockedUntilField: repository.Range{To: blockedUntil},
+			"template":                   repository.NotEmpty,
+		}, key)
+	})
+
+	t.Run("should match all conditions of the same field", func(t *testing.T) {
+		// when
+		key := repository.Key(
+			model.AuthFields.Status.Ne("AUTH_STATUS_REMOVED"),
+			model.AuthFields.Status.NotIn("AUTH_STATUS_APPLYING", "AUTH_STATUS_REMOVING"),
+		)
+
+		// then
+		assert.Equal(t, repository.CompositeKey{
+			repository.StatusField: repository.All{
+				repository.Not{Value: "AUTH_STATUS_REMOVED"},
+				repository.Not{Value: []string{"AUTH_STATUS_APPLYING", "AUTH_STATUS_REMOVING"}},
+			},
+		}, key)
+	})
+
+	t.Run("should create the query of the resource", func(t *testing.T) {
+		// when
+		query := repository.QueryOf(model.AuthFields.TenantID.Eq("tenant-1"))
+
+		// then
+		assert.Equal(t, &model.Auth{}, query.Resource)
+		assert.Equal(t, []repository.CompositeKey{{repository.TenantIDField: "tenant-1"}}, query.CompositeKeys)
+	})
+
+	t.Run("should create the query of all resources without conditions", func(t *testing.T) {
+		// when
+		query := repository.QueryOf[*model.System]()
+
+		// then
+		assert.Equal(t, &model.System{}, query.Resource)
+		assert.Empty(t, query.CompositeKeys)
+	})
+
+	t.Run("should join the resources on the fields", func(t *testing.T) {
+		// when
+		join := repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)
+
+		// then
+		assert.Equal(t, repository.Join{
+			Resource: &model.System{},
+			OnColumn: repository.IDField,
+			Column:   repository.SystemIDField,
+		}, join)
+	})
+
+	t.Run("should qualify the column by the table of the resource", func(t *testing.T) {
+		// when
+		column := model.SystemFields.ExternalID.Qualified()
+
+		// then
+		assert.Equal(t, "systems.external_id", column)
+	})
+}
@@ -0,0 +1,320 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// Limits of filter expressions, so a single expression can not produce an arbitrarily large query.
+const (
+	MaxFilterExpressionLength      = 1024
+	MaxFilterExpressionComparisons = 20
+)
+
+var (
+	ErrInvalidFilterExpression = errors.New("filter expression is invalid")
+	ErrFilterFieldNotAllowed   = errors.New("filter field is not allowed")
+)
+
+// FilterField is a field which may be used in a filter expression.
+type FilterField struct {
+	// Column is the queried column, qualified by its table if the query has joins.
+	Column QueryField
+	// Labels marks a labels column, which is filtered by label key as <field>.<key>.
+	Labels bool
+}
+
+// FilterFields is the allowlist of the fields of a filter expression by their name.
+type FilterFields map[string]FilterField
+
+// ParseFilterExpression compiles the filter expression into the composite keys of a query.
+// Only the allowed fields may be used. The grammar, with case-insensitive keywords, is
+//
+//	expression  = conjunction { "OR" conjunction }
+//	conjunction = comparison { "AND" comparison }
+//	comparison  = field ( "=" | "!=" ) value | field [ "NOT" ] "IN" "(" value { "," value } ")"
+//	field       = name | name "." label-key
+//	value       = word | "'" string "'" | '"' string '"'
+//
+// for example status=ACTIVE AND region IN (eu01,eu02) AND labels.env!=prod.
+// Negated comparisons also match records without value.
+func ParseFilterExpression(expr string, fields FilterFields) ([]CompositeKey, error) {
+	if len(expr) > MaxFilterExpressionLength {
+		return nil, fmt.Errorf("%w: longer than %d characters", ErrInvalidFilterExpression, MaxFilterExpressionLength)
+	}
+
+	tokens, err := tokenizeFilterExpression(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &filterParser{tokens: tokens, fields: fields}
+
+	return p.parseExpression()
+}
+
+type filterTokenKind int
+
+const (
+	filterTokenWord filterTokenKind = iota
+	filterTokenString
+	filterTokenOperator
+	filterTokenEnd
+)
+
+type filterToken struct {
+	kind  filterTokenKind
+	value string
+	pos   int
+}
+
+// keyword returns true if the token is the unquoted keyword, ignoring case.
+func (t filterToken) keyword(keyword string) bool {
+	return t.kind == filterTokenWord && strings.EqualFold(t.value, keyword)
+}
+
+// tokenizeFilterExpression splits the expression into words, quoted strings and operators.
+func tokenizeFilterExpression(expr string) ([]filterToken, error) {
+	var tokens []filterToken
+
+	for i := 0; i < len(expr); {
+		c := rune(expr[i])
+
+		switch {
+		case unicode.IsSpace(c):
+			i++
+		case c == '(' || c == ')' || c == ',' || c == '=':
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, value: string(c), pos: i})
+			i++
+		case c == '!':
+			if i+1 >= len(expr) || expr[i+1] != '=' {
+				return nil, fmt.Errorf("%w: expected != at position %d", ErrInvalidFilterExpression, i)
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenOperator, value: "!=", pos: i})
+			i += 2
+		case c == '\'' || c == '"':
+			end := strings.IndexByte(expr[i+1:], expr[i])
+			if end < 0 {
+				return nil, fmt.Errorf("%w: unterminated string at position %d", ErrInvalidFilterExpression, i)
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenString, value: expr[i+1 : i+1+end], pos: i})
+			i += end + 2
+		case isFilterWordChar(c):
+			start := i
+			for i < len(expr) && isFilterWordChar(rune(expr[i])) {
+				i++
+			}
+
+			tokens = append(tokens, filterToken{kind: filterTokenWord, value: expr[start:i], pos: start})
+		default:
+			return nil, fmt.Errorf("%w: unexpected character %q at position %d", ErrInvalidFilterExpression, c, i)
+		}
+	}
+
+	return append(tokens, filterToken{kind: filterTokenEnd, pos: len(expr)}), nil
+}
+
+func isFilterWordChar(c rune) bool {
+	return c < unicode.MaxASCII && (unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune("_-.:/", c))
+}
+
+type filterParser struct {
+	tokens      []filterToken
+	pos         int
+	fields      FilterFields
+	comparisons int
+}
+
+func (p *filterParser) peek() filterToken {
+	return p.tokens[p.pos]
+}
+
+func (p *filterParser) next() filterToken {
+	t := p.tokens[p.pos]
+	if t.kind != filterTokenEnd {
+		p.pos++
+	}
+
+	return t
+}
+
+func (p *filterParser) errorf(t filterToken, format string, args ...any) error {
+	return fmt.Errorf("%w: %s at position %d", ErrInvalidFilterExpression, fmt.Sprintf(format, args...), t.pos)
+}
+
+func (p *filterParser) parseExpression() ([]CompositeKey, error) {
+	var keys []CompositeKey
+
+	for {
+		key, err := p.parseConjunction()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+
+		t := p.next()
+		switch {
+		case t.kind == filterTokenEnd:
+			return keys, nil
+		case !t.keyword("OR"):
+			return nil, p.errorf(t, "expected AND or OR")
+		}
+	}
+}
+
+func (p *filterParser) parseConjunction() (CompositeKey, error) {
+	key := NewCompositeKey()
+
+	for {
+		if err := p.parseComparison(key); err != nil {
+			return nil, err
+		}
+
+		if !p.peek().keyword("AND") {
+			return key, nil
+		}
+
+		p.next()
+	}
+}
+
+// parseComparison adds the comparison to the composite key. Several comparisons of the same column
+// are combined by All, as the composite key holds one value per column.
+func (p *filterParser) parseComparison(key CompositeKey) error {
+	p.comparisons++
+	if p.comparisons > MaxFilterExpressionComparisons {
+		return fmt.Errorf("%w: more than %d comparisons", ErrInvalidFilterExpression, MaxFilterExpressionComparisons)
+	}
+
+	t := p.next()
+	if t.kind != filterTokenWord {
+		return p.errorf(t, "expected field")
+	}
+
+	column, labelKey, err := p.resolveField(t)
+	if err != nil {
+		return err
+	}
+
+	value, err := p.parseCondition()
+	if err != nil {
+		return err
+	}
+
+	if labelKey != "" {
+		value = labelCondition(labelKey, value)
+	}
+
+	if existing, ok := key[column]; ok {
+		all, isAll := existing.(All)
+		if !isAll {
+			all = All{existing}
+		}
+
+		value = append(all, value)
+	}
+
+	key.Where(column, value)
+
+	return nil
+}
+
+// resolveField returns the column of the allowed field and the label key, if the field is a label.
+func (p *filterParser) resolveField(t filterToken) (QueryField, string, error) {
+	name, labelKey, isLabel := strings.Cut(t.value, ".")
+
+	field, ok := p.fields[name]
+	if !ok || field.Labels != isLabel {
+		return "", "", fmt.Errorf("%w: %s", ErrFilterFieldNotAllowed, t.value)
+	}
+
+	if isLabel && labelKey == "" {
+		return "", "", p.errorf(t, "expected label key")
+	}
+
+	return field.Column, labelKey, nil
+}
+
+// parseCondition parses the operator and the values of a comparison.
+func (p *filterParser) parseCondition() (any, error) {
+	t := p.next()
+
+	switch {
+	case t.kind == filterTokenOperator && t.value == "=":
+		return p.parseValue()
+	case t.kind == filterTokenOperator && t.value == "!=":
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not{Value: value}, nil
+	case t.keyword("IN"):
+		return p.parseValues()
+	case t.keyword("NOT"):
+		if in := p.next(); !in.keyword("IN") {
+			return nil, p.errorf(in, "expected IN")
+		}
+
+		values, err := p.parseValues()
+		if err != nil {
+			return nil, err
+		}
+
+		return Not{Value: values}, nil
+	default:
+		return nil, p.errorf(t, "expected =, !=, IN or NOT IN")
+	}
+}
+
+func (p *filterParser) parseValue() (string, error) {
+	t := p.next()
+	if t.kind != filterTokenWord && t.kind != filterTokenString {
+		return "", p.errorf(t, "expected value")
+	}
+
+	return t.value, nil
+}
+
+func (p *filterParser) parseValues() ([]string, error) {
+	if t := p.next(); t.kind != filterTokenOperator || t.value != "(" {
+		return nil, p.errorf(t, "expected (")
+	}
+
+	var values []string
+
+	for {
+		value, err := p.parseValue()
+		if err != nil {
+			return nil, err
+		}
+
+		values = append(values, value)
+		if len(values) > MaxFilterValues {
+			return nil, fmt.Errorf("%w: more than %d values", ErrTooManyFilterValues, MaxFilterValues)
+		}
+
+		t := p.next()
+		if t.kind == filterTokenOperator && t.value == ")" {
+			return values, nil
+		}
+
+		if t.kind != filterTokenOperator || t.value != "," {
+			return nil, p.errorf(t, "expected , or )")
+		}
+	}
+}
+
+// labelCondition returns the condition of the label key, keeping the negation outermost.
+func labelCondition(labelKey string, value any) any {
+	if not, ok := value.(Not); ok {
+		return Not{Value: map[string]any{labelKey: not.Value}}
+	}
+
+	return map[string]any{labelKey: value}
+}
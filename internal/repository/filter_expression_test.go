@@ -0,0 +1,115 @@
+package repository_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+func TestParseFilterExpression(t *testing.T) {
+	fields := repository.FilterFields{
+		"status": {Column: "status"},
+		"region": {Column: "tenants.region"},
+		"labels": {Column: "labels", Labels: true},
+	}
+
+	t.Run("should compile valid expressions", func(t *testing.T) {
+		tests := []struct {
+			name    string
+			expr    string
+			expKeys []repository.CompositeKey
+		}{
+			{
+				name: "conjunction",
+				expr: "status=ACTIVE AND region IN (eu01,eu02) AND labels.env!=prod",
+				expKeys: []repository.CompositeKey{{
+					"status":         "ACTIVE",
+					"tenants.region": []string{"eu01", "eu02"},
+					"labels":         repository.Not{Value: map[string]any{"env": "prod"}},
+				}},
+			},
+			{
+				name: "disjunction and keywords ignoring case",
+				expr: "status = ACTIVE or region not in ('eu 01') and status != \"BLOCKED\"",
+				expKeys: []repository.CompositeKey{
+					{"status": "ACTIVE"},
+					{
+						"tenants.region": repository.Not{Value: []string{"eu 01"}},
+						"status":         repository.Not{Value: "BLOCKED"},
+					},
+				},
+			},
+			{
+				name: "several comparisons of a field",
+				expr: "labels.env IN (dev,test) AND labels.team=a AND labels.ring!=0",
+				expKeys: []repository.CompositeKey{{
+					"labels": repository.All{
+						map[string]any{"env": []string{"dev", "test"}},
+						map[string]any{"team": "a"},
+						repository.Not{Value: map[string]any{"ring": "0"}},
+					},
+				}},
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// when
+				keys, err := repository.ParseFilterExpression(tt.expr, fields)
+
+				// then
+				require.NoError(t, err)
+				assert.Equal(t, tt.expKeys, keys)
+			})
+		}
+	})
+
+	t.Run("should return error for invalid expressions", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			expr   string
+			expErr error
+		}{
+			{name: "empty", expr: "", expErr: repository.ErrInvalidFilterExpression},
+			{name: "field not allowed", expr: "owner_id=x", expErr: repository.ErrFilterFieldNotAllowed},
+			{name: "label of field without labels", expr: "status.x=y", expErr: repository.ErrFilterFieldNotAllowed},
+			{name: "labels without key", expr: "labels=x", expErr: repository.ErrFilterFieldNotAllowed},
+			{name: "missing value", expr: "status=", expErr: repository.ErrInvalidFilterExpression},
+			{name: "missing operator", expr: "status ACTIVE", expErr: repository.ErrInvalidFilterExpression},
+			{name: "unterminated string", expr: "status='ACTIVE", expErr: repository.ErrInvalidFilterExpression},
+			{name: "unexpected character", expr: "status=ACTIVE; DROP TABLE tenants", expErr: repository.ErrInvalidFilterExpression},
+			{name: "unclosed list", expr: "region IN (eu01", expErr: repository.ErrInvalidFilterExpression},
+			{name: "dangling conjunction", expr: "status=ACTIVE AND", expErr: repository.ErrInvalidFilterExpression},
+			{name: "missing conjunction", expr: "status=ACTIVE region=eu01", expErr: repository.ErrInvalidFilterExpression},
+			{
+				name:   "too many values",
+				expr:   "region IN (" + strings.Repeat("r,", repository.MaxFilterValues) + "r)",
+				expErr: repository.ErrTooManyFilterValues,
+			},
+			{
+				name:   "too many comparisons",
+				expr:   strings.Repeat("status=a AND ", repository.MaxFilterExpressionComparisons) + "status=a",
+				expErr: repository.ErrInvalidFilterExpression,
+			},
+			{
+				name:   "too long",
+				expr:   "status=" + strings.Repeat("a", repository.MaxFilterExpressionLength),
+				expErr: repository.ErrInvalidFilterExpression,
+			},
+		}
+
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// when
+				_, err := repository.ParseFilterExpression(tt.expr, fields)
+
+				// then
+				assert.ErrorIs(t, err, tt.expErr)
+			})
+		}
+	})
+}
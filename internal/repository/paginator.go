@@ -4,6 +4,7 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"errors"
+	"maps"
 	"slices"
 	"time"
 )
@@ -19,6 +20,19 @@ const (
 	maxPaginationLimit     = 1000
 )
 
+// Keyset returns the columns the pages of the resource are ordered by, all descending:
+// the creation time followed by the sorted fields of the pagination key, which break ties
+// of resources created at the same time. The pagination key must be unique per resource,
+// and the table must have an index on the columns, see sql.VerifyPaginationIndexes.
+func Keyset(resource Resource) []QueryField {
+	return append([]QueryField{CreatedAtField}, paginationKeyFields(resource)...)
+}
+
+// paginationKeyFields returns the sorted fields of the pagination key of the resource.
+func paginationKeyFields(resource Resource) []QueryField {
+	return slices.Sorted(maps.Keys(resource.PaginationKey()))
+}
+
 // Paginator stores the composite key as a single token.
 type Paginator struct {
 	PageInfo    *PageInfo
@@ -1,23 +1,39 @@
 package repository
 
 import (
+	"errors"
 	"log/slog"
-	"maps"
-	"slices"
 )
 
+// MaxFilterValues is the maximum number of values a client filter can match a single field by.
+// It bounds the size of IN clauses to protect the query planner; internal queries chunk their values by it.
+const MaxFilterValues = 20
+
+var ErrTooManyFilterValues = errors.New("filter exceeds the maximum number of values")
+
 const (
-	IDField         QueryField = "id"
-	NameField       QueryField = "name"
-	RegionField     QueryField = "region"
-	TenantIDField   QueryField = "tenant_id"
-	ExternalIDField QueryField = "external_id"
-	SystemIDField   QueryField = "system_id"
-	OwnerIDField    QueryField = "owner_id"
-	OwnerTypeField  QueryField = "owner_type"
-	CreatedAtField  QueryField = "created_at"
-	TypeField       QueryField = "type"
-	LabelsField     QueryField = "labels"
+	IDField              QueryField = "id"
+	NameField            QueryField = "name"
+	RegionField          QueryField = "region"
+	TenantIDField        QueryField = "tenant_id"
+	ExternalIDField      QueryField = "external_id"
+	SystemIDField        QueryField = "system_id"
+	OwnerIDField         QueryField = "owner_id"
+	OwnerTypeField       QueryField = "owner_type"
+	CreatedAtField       QueryField = "created_at"
+	TypeField            QueryField = "type"
+	LabelsField          QueryField = "labels"
+	DateField            QueryField = "date"
+	ApprovalStatusField  QueryField = "approval_status"
+	StatusField          QueryField = "status"
+	L1KeyClaimField      QueryField = "has_l1_key_claim"
+	IsNotifiedField      QueryField = "is_notified"
+	BlockedUntilField    QueryField = "blocked_until"
+	DisplayNameField     QueryField = "display_name"
+	DescriptionField     QueryField = "description"
+	AuthIDField          QueryField = "auth_id"
+	KeyField             QueryField = "key"
+	StatusUpdatedAtField QueryField = "status_updated_at"
 
 	NotEmpty QueryFieldValue = "not_empty"
 	Empty    QueryFieldValue = "empty"
@@ -39,12 +55,36 @@ func (c CompositeKey) Where(q QueryField, v any) CompositeKey {
 	return c
 }
 
+// Range matches values between From and To, both inclusive.
+// A nil bound leaves the range open on that side.
+type Range struct {
+	From any
+	To   any
+}
+
+// Not matches the records not matching Value, including the records without value.
+// Value may be a single value, a slice of values or a map of labels.
+type Not struct {
+	Value any
+}
+
+// All matches the records matching every value, e.g. several conditions of the same field.
+type All []any
+
 type Join struct {
 	Resource Resource
 	OnColumn QueryField
 	Column   QueryField
 }
 
+// Missing matches the records without a record of Resource whose OnColumns equal the Columns of the record,
+// e.g. regional systems whose system does not exist.
+type Missing struct {
+	Resource  Resource
+	OnColumns []QueryField
+	Columns   []QueryField
+}
+
 type Query struct {
 	// the Resource type the query is for
 	Resource Resource
@@ -64,8 +104,18 @@ type Query struct {
 	// Joins are the resources to be joined with the main resource
 	Joins []Join
 
+	// Missing restricts the records to those without a matching record of the resources
+	Missing []Missing
+
 	// Preloads are the field names to be preloaded with the main resource
 	Preloads []FieldName
+
+	// Lock is the row-level lock on the selected records, see Lock.
+	Lock Lock
+
+	// Ascending orders the records by the fields ascending instead of by the keyset descending,
+	// e.g. changes by their sequence. The Paginator is not applied.
+	Ascending []QueryField
 }
 
 type QueryField = string
@@ -88,6 +138,30 @@ func (q *Query) Where(compositeKeys ...CompositeKey) *Query {
 	return q
 }
 
+// WhereMissing restricts the query to the records without a matching record of the missing resources.
+func (q *Query) WhereMissing(missing ...Missing) *Query {
+	q.Missing = append(q.Missing, missing...)
+	return q
+}
+
+// ForUpdate locks the selected records against concurrent updates and locks.
+func (q *Query) ForUpdate() *Query {
+	q.Lock = LockForUpdate
+	return q
+}
+
+// ForShare locks the selected records against concurrent updates.
+func (q *Query) ForShare() *Query {
+	q.Lock = LockForShare
+	return q
+}
+
+// OrderAscending orders the records by the fields ascending, see Query.Ascending.
+func (q *Query) OrderAscending(fields ...QueryField) *Query {
+	q.Ascending = append(q.Ascending, fields...)
+	return q
+}
+
 // SetLimit sets the limit value for the query.
 func (q *Query) SetLimit(limit int) *Query {
 	q.Limit = limit
@@ -104,7 +178,7 @@ func (q *Query) ApplyPagination(limit int32, token string) error {
 	q.Limit = queryLimit
 
 	q.Paginator = Paginator{
-		OrderFields: slices.Sorted(maps.Keys(q.Resource.PaginationKey())),
+		OrderFields: paginationKeyFields(q.Resource),
 	}
 
 	if token == "" {
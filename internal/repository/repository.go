@@ -2,20 +2,156 @@ package repository
 
 import (
 	"context"
+	"errors"
 )
 
+// ErrStatusTimeRegression is returned if the status of a StatusClocked resource is changed while its stored
+// status time is ahead of the database clock by more than the tolerated skew, so its status time would go back.
+var ErrStatusTimeRegression = errors.New("stored status time is ahead of the database clock")
+
 // TransactionFunc is func signature for ExecTransaction.
 type TransactionFunc func(context.Context, Repository) error
 
 // Repository defines the interface for Repository operations.
 type Repository interface {
 	Create(ctx context.Context, resource Resource) error
+	// CreateAll stores the resources of records, a pointer to a slice of a resource, with one insert.
+	CreateAll(ctx context.Context, records any) error
 	List(ctx context.Context, result any, query Query) error
 	Delete(ctx context.Context, resource Resource) (bool, error)
-	Find(ctx context.Context, resource Resource) (bool, error)
+	Find(ctx context.Context, resource Resource, lock ...Lock) (bool, error)
 	Patch(ctx context.Context, resource Resource) (bool, error)
 	PatchAll(ctx context.Context, resource Resource, result any, query Query) (int64, error)
+	// DeleteAll deletes all records matching the query and returns them in result, a pointer to a slice of the resource.
+	DeleteAll(ctx context.Context, result any, query Query) (int64, error)
+	// ClearAll sets the fields of all records matching the query to NULL and returns the records in result.
+	ClearAll(ctx context.Context, result any, query Query, fields ...QueryField) (int64, error)
+	// Count returns the number of records matching the query.
+	Count(ctx context.Context, query Query) (int64, error)
+	// Aggregate counts the records matching the query grouped by the fields into result, a pointer to a slice
+	// of structs with a field per grouped field and a Count field. Fields of joined resources are qualified.
+	Aggregate(ctx context.Context, result any, query Query, groupBy ...QueryField) error
 	Transaction(ctx context.Context, txFunc TransactionFunc) error
+	// AdvisoryLock takes an exclusive lock of the key which is held until the end of the transaction,
+	// e.g. to serialize the creation of records which do not exist yet and can therefore not be locked by row.
+	// Outside a transaction the lock is released at once.
+	AdvisoryLock(ctx context.Context, key string) error
+}
+
+// Lock is a row-level lock held on the selected records until the end of the transaction.
+// Within a Transaction records are selected with LockForUpdate, unless another lock is requested.
+type Lock string
+
+const (
+	// LockForUpdate blocks concurrent updates and locks of the selected records.
+	LockForUpdate Lock = "UPDATE"
+	// LockForShare blocks concurrent updates of the selected records, but allows other shared locks.
+	// It is used for records which are only checked, e.g. the status of a tenant while linking a system.
+	LockForShare Lock = "SHARE"
+	// LockForUpdateSkipLocked is LockForUpdate skipping the records locked by others instead of waiting,
+	// e.g. for checkpoints of batches which are run by a single instance at a time.
+	LockForUpdateSkipLocked Lock = "UPDATE SKIP LOCKED"
+)
+
+// Pool is the class of the connection pool serving the repository operations of a request,
+// so heavy requests, e.g. exports, can not exhaust the connections serving interactive requests.
+type Pool string
+
+const (
+	// PoolDefault serves operations without class, e.g. background jobs, and classes without dedicated pool.
+	PoolDefault Pool = "default"
+	// PoolRead serves read-only requests, e.g. Get and List calls.
+	PoolRead Pool = "read"
+	// PoolWrite serves requests changing resources, e.g. RegisterTenant.
+	PoolWrite Pool = "write"
+	// PoolAdmin serves long-running administrative requests, e.g. exports.
+	PoolAdmin Pool = "admin"
+)
+
+type poolKey struct{}
+
+// WithPool returns a copy of ctx whose repository operations are served by the pool of the class.
+func WithPool(ctx context.Context, pool Pool) context.Context {
+	return context.WithValue(ctx, poolKey{}, pool)
+}
+
+// PoolFromContext returns the pool class of the repository operations of ctx, PoolDefault if none is set.
+func PoolFromContext(ctx context.Context) Pool {
+	pool, ok := ctx.Value(poolKey{}).(Pool)
+	if !ok {
+		return PoolDefault
+	}
+
+	return pool
+}
+
+type callerKey struct{}
+
+// WithCaller returns a copy of ctx whose repository operations record the caller as the client
+// creating or modifying the resources, see Attributed.
+func WithCaller(ctx context.Context, caller string) context.Context {
+	return context.WithValue(ctx, callerKey{}, caller)
+}
+
+// CallerFromContext returns the caller of the repository operations of ctx, empty if none is set,
+// e.g. for background jobs.
+func CallerFromContext(ctx context.Context) string {
+	caller, _ := ctx.Value(callerKey{}).(string)
+	return caller
+}
+
+type clientDataKey struct{}
+
+// WithClientData returns a copy of ctx with the client data of the caller by header, which is propagated
+// to the outbound calls made on behalf of the caller.
+func WithClientData(ctx context.Context, clientData map[string]string) context.Context {
+	return context.WithValue(ctx, clientDataKey{}, clientData)
+}
+
+// ClientDataFromContext returns the client data of the caller of ctx by header, nil if none is set.
+func ClientDataFromContext(ctx context.Context) map[string]string {
+	clientData, _ := ctx.Value(clientDataKey{}).(map[string]string)
+	return clientData
+}
+
+type outsideEffectsKey struct{}
+
+// WithOutsideEffects returns a copy of ctx whose effects outside of the repository, recorded by RecordOutsideEffect,
+// set recorded to true. It is used by repositories retrying transactions, as such effects must not be repeated.
+func WithOutsideEffects(ctx context.Context, recorded *bool) context.Context {
+	return context.WithValue(ctx, outsideEffectsKey{}, recorded)
+}
+
+// RecordOutsideEffect records that an effect outside of the repository happened within the transaction of ctx,
+// e.g. an orbital job was prepared. Such a transaction is not run again after a serialization failure or a deadlock,
+// so the effect happens only once.
+func RecordOutsideEffect(ctx context.Context) {
+	if recorded, ok := ctx.Value(outsideEffectsKey{}).(*bool); ok {
+		*recorded = true
+	}
+}
+
+// PreCommitFunc is called within a transaction before it commits, an error rolls the transaction back.
+type PreCommitFunc func(ctx context.Context) error
+
+type preCommitKey struct{}
+
+// WithPreCommit returns a copy of ctx whose transactions call preCommit before they commit,
+// e.g. the pre-commit hooks of the operation of a request. A nil preCommit removes the call.
+func WithPreCommit(ctx context.Context, preCommit PreCommitFunc) context.Context {
+	return context.WithValue(ctx, preCommitKey{}, preCommit)
+}
+
+// PreCommitFromContext returns the function called before the transactions of ctx commit, nil if none is set.
+func PreCommitFromContext(ctx context.Context) PreCommitFunc {
+	preCommit, _ := ctx.Value(preCommitKey{}).(PreCommitFunc)
+	return preCommit
+}
+
+// Attributed is a Resource recording the clients creating and last modifying it.
+type Attributed interface {
+	SetCreatedBy(caller string)
+	SetLastModifiedBy(caller string)
 }
 
 // Resource defines the interface for Resource operations.
@@ -24,9 +160,30 @@ type Resource interface {
 	PaginationKey() map[QueryField]any
 }
 
+// ExternallyIdentified is a Resource known by an external ID, which is resolved by the external ID index.
+type ExternallyIdentified interface {
+	Resource
+	// ExternalIDColumn returns the column of the external ID of the resource.
+	ExternalIDColumn() QueryField
+}
+
+// StatusClocked is a Resource whose status time is taken from the database clock when its status changes,
+// so the status times written by all instances are in order whatever their clocks.
+type StatusClocked interface {
+	Resource
+	// StatusChanged returns true if the status of the resource was changed since it was read or created.
+	StatusChanged() bool
+	// StatusTimeColumn returns the column of the status time of the resource.
+	StatusTimeColumn() QueryField
+	// ClearStatusChanged is called once the status time of the changed status is stored.
+	ClearStatusChanged()
+}
+
 // UniqueConstraintError represents an error caused by a violation of a unique constraint in the database.
 type UniqueConstraintError struct {
-	Detail string
+	// Constraint is the name of the violated constraint or unique index.
+	Constraint string
+	Detail     string
 }
 
 // Error returns an error message describing the unique constraint violation.
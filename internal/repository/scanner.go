@@ -0,0 +1,192 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// ScanCheckpoint persists the position of a Scanner, so an interrupted scan resumes after its last scanned page,
+// e.g. after a restart.
+type ScanCheckpoint interface {
+	// Load returns the position of the last scanned page and the number of records scanned up to it,
+	// an empty position if the scan starts from the beginning.
+	Load(ctx context.Context) (string, int64, error)
+	// Save stores the position of the last scanned page and the number of records scanned up to it.
+	// The position is empty once the scan is completed, so the next scan starts from the beginning.
+	Save(ctx context.Context, position string, scanned int64) error
+}
+
+// Scanner reads all records of type T matching a query page by page in the order of their keyset, see Keyset,
+// so a scan of a whole table neither holds a transaction nor reads a record twice, and does not read the records
+// created during the scan. The records are read at most at the rate of the scanner.
+type Scanner[T any, PT interface {
+	*T
+	Resource
+}] struct {
+	query         func() *Query
+	createdAt     func(*T) time.Time
+	pageSize      int
+	rowsPerSecond int
+	checkpoint    ScanCheckpoint
+}
+
+// NewScanner creates and returns a new Scanner of the records of the queries created by query, whose
+// creation time is returned by createdAt. It reads pages of pageSize records, the default page size if zero,
+// and at most rowsPerSecond records per second, zero does not limit the rate.
+func NewScanner[T any, PT interface {
+	*T
+	Resource
+}](query func() *Query, createdAt func(*T) time.Time, pageSize, rowsPerSecond int) *Scanner[T, PT] {
+	return &Scanner[T, PT]{
+		query:         query,
+		createdAt:     createdAt,
+		pageSize:      pageSize,
+		rowsPerSecond: rowsPerSecond,
+	}
+}
+
+// WithCheckpoint returns the scanner persisting its position in checkpoint after each page.
+func (s *Scanner[T, PT]) WithCheckpoint(checkpoint ScanCheckpoint) *Scanner[T, PT] {
+	s.checkpoint = checkpoint
+	return s
+}
+
+// Page reads the page of records following the position, the first page if the position is empty.
+// It returns the position of the page, which is empty if it is the last page.
+func (s *Scanner[T, PT]) Page(ctx context.Context, repo Repository, position string) ([]T, string, error) {
+	query := s.query()
+
+	query.Limit = DefaultPaginationLimit
+	if s.pageSize > 0 {
+		query.Limit = min(maxPaginationLimit, s.pageSize)
+	}
+
+	query.Paginator = Paginator{OrderFields: paginationKeyFields(query.Resource)}
+	if position != "" {
+		pageInfo, err := DecodePageToken(position)
+		if err != nil {
+			return nil, "", err
+		}
+
+		query.Paginator.PageInfo = pageInfo
+	}
+
+	var page []T
+	if err := repo.List(ctx, &page, *query); err != nil {
+		return nil, "", err
+	}
+
+	if len(page) < query.Limit {
+		return page, "", nil
+	}
+
+	last := &page[len(page)-1]
+
+	next, err := PageInfo{
+		LastCreatedAt: s.createdAt(last),
+		LastKey:       PT(last).PaginationKey(),
+	}.Encode()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return page, next, nil
+}
+
+// Scan calls fn with each page of records until all records are read, fn fails or ctx is done,
+// and returns the number of scanned records. With a checkpoint the scan starts after the position of the
+// checkpoint, which is saved once fn processed a page, and the number includes the records scanned before.
+func (s *Scanner[T, PT]) Scan(ctx context.Context, repo Repository, fn func(ctx context.Context, page []T) error) (int64, error) {
+	position, scanned, err := s.load(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	throttle := NewThrottle(s.rowsPerSecond)
+	for {
+		page, next, err := s.Page(ctx, repo, position)
+		if err != nil {
+			return scanned, err
+		}
+
+		if len(page) > 0 {
+			if err := fn(ctx, page); err != nil {
+				return scanned, err
+			}
+		}
+
+		position = next
+		scanned += int64(len(page))
+
+		if s.checkpoint != nil {
+			if err := s.checkpoint.Save(ctx, position, scanned); err != nil {
+				return scanned, err
+			}
+		}
+
+		if position == "" {
+			return scanned, nil
+		}
+
+		if err := throttle.Wait(ctx, len(page)); err != nil {
+			return scanned, err
+		}
+	}
+}
+
+// load returns the position and the number of scanned records of the checkpoint, if any.
+func (s *Scanner[T, PT]) load(ctx context.Context) (string, int64, error) {
+	if s.checkpoint == nil {
+		return "", 0, nil
+	}
+
+	position, scanned, err := s.checkpoint.Load(ctx)
+	if err != nil || position == "" {
+		return "", 0, err
+	}
+
+	return position, scanned, nil
+}
+
+// Throttle paces reads to a maximum number of records per second, averaged since its creation,
+// so a pause of the reader is made up by the following reads.
+type Throttle struct {
+	rowsPerSecond int
+	start         time.Time
+	rows          int64
+}
+
+// NewThrottle creates and returns a new Throttle of rowsPerSecond, zero does not limit the rate.
+func NewThrottle(rowsPerSecond int) *Throttle {
+	return &Throttle{
+		rowsPerSecond: rowsPerSecond,
+		start:         time.Now(),
+	}
+}
+
+// Wait records that rows records were read and blocks until the rate of the reads is within the limit
+// or ctx is done, whose error it returns.
+func (t *Throttle) Wait(ctx context.Context, rows int) error {
+	if t.rowsPerSecond <= 0 {
+		return nil
+	}
+
+	t.rows += int64(rows)
+
+	due := t.start.Add(time.Duration(t.rows) * time.Second / time.Duration(t.rowsPerSecond))
+
+	wait := time.Until(due)
+	if wait <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
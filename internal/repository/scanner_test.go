@@ -0,0 +1,175 @@
+package repository_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// pagedRepository returns its pages of tenants one after another and records the listed queries.
+type pagedRepository struct {
+	repository.Repository
+
+	pages   [][]model.Tenant
+	queries []repository.Query
+}
+
+func (r *pagedRepository) List(_ context.Context, result any, query repository.Query) error {
+	r.queries = append(r.queries, query)
+
+	tenants, _ := result.(*[]model.Tenant)
+	if len(r.pages) > 0 {
+		*tenants, r.pages = r.pages[0], r.pages[1:]
+	}
+
+	return nil
+}
+
+// memoryCheckpoint keeps the saved positions of a scan.
+type memoryCheckpoint struct {
+	position string
+	scanned  int64
+	saved    []string
+}
+
+func (c *memoryCheckpoint) Load(context.Context) (string, int64, error) {
+	return c.position, c.scanned, nil
+}
+
+func (c *memoryCheckpoint) Save(_ context.Context, position string, scanned int64) error {
+	c.position, c.scanned = position, scanned
+	c.saved = append(c.saved, position)
+
+	return nil
+}
+
+func TestScanner(t *testing.T) {
+	createdAt := time.Date(2025, 6, 3, 12, 0, 0, 0, time.UTC)
+	tenant := func(id string) model.Tenant {
+		return model.Tenant{ID: id, CreatedAt: createdAt}
+	}
+
+	newScanner := func() *repository.Scanner[model.Tenant, *model.Tenant] {
+		return repository.NewScanner(func() *repository.Query {
+			return repository.QueryOf(model.TenantFields.Region.Eq("eu10"))
+		}, func(t *model.Tenant) time.Time { return t.CreatedAt }, 2, 0)
+	}
+
+	t.Run("should scan all pages in the order of the keyset", func(t *testing.T) {
+		// given
+		repo := &pagedRepository{pages: [][]model.Tenant{
+			{tenant("tenant-4"), tenant("tenant-3")},
+			{tenant("tenant-2"), tenant("tenant-1")},
+			{},
+		}}
+
+		var scanned []string
+
+		// when
+		count, err := newScanner().Scan(t.Context(), repo, func(_ context.Context, page []model.Tenant) error {
+			for _, tenant := range page {
+				scanned = append(scanned, tenant.ID)
+			}
+			return nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), count)
+		assert.Equal(t, []string{"tenant-4", "tenant-3", "tenant-2", "tenant-1"}, scanned)
+		require.Len(t, repo.queries, 3)
+		assert.Nil(t, repo.queries[0].Paginator.PageInfo)
+		assert.Equal(t, 2, repo.queries[0].Limit)
+		assert.Equal(t, repository.Keyset(&model.Tenant{})[1:], repo.queries[0].Paginator.OrderFields)
+		assert.Equal(t, &repository.PageInfo{
+			LastCreatedAt: createdAt,
+			LastKey:       repository.CompositeKey{repository.IDField: "tenant-3"},
+		}, repo.queries[1].Paginator.PageInfo)
+		assert.Equal(t, "tenant-1", repo.queries[2].Paginator.PageInfo.LastKey[repository.IDField])
+	})
+
+	t.Run("should resume after the position of the checkpoint", func(t *testing.T) {
+		// given
+		position, err := repository.PageInfo{
+			LastCreatedAt: createdAt,
+			LastKey:       repository.CompositeKey{repository.IDField: "tenant-3"},
+		}.Encode()
+		require.NoError(t, err)
+
+		checkpoint := &memoryCheckpoint{position: position, scanned: 2}
+		repo := &pagedRepository{pages: [][]model.Tenant{{tenant("tenant-2"), tenant("tenant-1")}, {}}}
+
+		// when
+		count, err := newScanner().WithCheckpoint(checkpoint).Scan(t.Context(), repo, func(context.Context, []model.Tenant) error {
+			return nil
+		})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, int64(4), count)
+		assert.Equal(t, "tenant-3", repo.queries[0].Paginator.PageInfo.LastKey[repository.IDField])
+		require.Len(t, checkpoint.saved, 2)
+		assert.NotEmpty(t, checkpoint.saved[0])
+		assert.Empty(t, checkpoint.saved[1])
+	})
+
+	t.Run("should keep the checkpoint of the failed page", func(t *testing.T) {
+		// given
+		checkpoint := &memoryCheckpoint{}
+		repo := &pagedRepository{pages: [][]model.Tenant{{tenant("tenant-2"), tenant("tenant-1")}}}
+
+		// when
+		_, err := newScanner().WithCheckpoint(checkpoint).Scan(t.Context(), repo, func(context.Context, []model.Tenant) error {
+			return assert.AnError
+		})
+
+		// then
+		assert.ErrorIs(t, err, assert.AnError)
+		assert.Empty(t, checkpoint.saved)
+	})
+}
+
+func TestThrottle(t *testing.T) {
+	t.Run("should not wait without rate", func(t *testing.T) {
+		// given
+		throttle := repository.NewThrottle(0)
+
+		// when
+		err := throttle.Wait(t.Context(), 1000000)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should wait until the rate is within the limit", func(t *testing.T) {
+		// given
+		throttle := repository.NewThrottle(100)
+		start := time.Now()
+
+		// when
+		err := throttle.Wait(t.Context(), 5)
+
+		// then
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	})
+
+	t.Run("should stop waiting once the context is done", func(t *testing.T) {
+		// given
+		throttle := repository.NewThrottle(1)
+		ctx, cancel := context.WithTimeout(t.Context(), 10*time.Millisecond)
+		defer cancel()
+
+		// when
+		err := throttle.Wait(ctx, 3600)
+
+		// then
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
@@ -0,0 +1,15 @@
+package sql
+
+import (
+	"context"
+)
+
+// advisoryLockNamespace is the first key of the advisory locks taken by AdvisoryLock.
+// Locks with two keys do not overlap with locks with one key, like the lock of the change feed.
+const advisoryLockNamespace int32 = 0x7265676c // "regl"
+
+// AdvisoryLock takes the transaction-level advisory lock of the key, hashed by the database,
+// and waits until it is released by other transactions.
+func (r ResourceRepository) AdvisoryLock(ctx context.Context, key string) error {
+	return r.conn(ctx).Exec("SELECT pg_advisory_xact_lock(?, hashtext(?))", advisoryLockNamespace, key).Error
+}
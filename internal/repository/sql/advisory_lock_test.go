@@ -0,0 +1,31 @@
+package sql_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+func TestAdvisoryLock(t *testing.T) {
+	t.Run("should take the lock within the transaction", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+
+		// when
+		err := repo.Transaction(t.Context(), func(ctx context.Context, r repository.Repository) error {
+			return r.AdvisoryLock(ctx, "systems/system/eu10")
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, pool.statements, 3)
+		assert.Equal(t, "BEGIN", pool.statements[0])
+		assert.Contains(t, pool.statements[1], "pg_advisory_xact_lock")
+		assert.Contains(t, pool.statements[1], "hashtext")
+		assert.Equal(t, "COMMIT", pool.statements[2])
+	})
+}
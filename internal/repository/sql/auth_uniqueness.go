@@ -0,0 +1,53 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+
+	"github.com/openkcm/registry/internal/model"
+)
+
+var ErrAuthUniquenessViolated = errors.New("existing auths violate the uniqueness per tenant and type")
+
+// authTenantTypeIndexStatement returns the statement creating the partial unique index on the tenant and type
+// of the auths which are not removed, so removed auths do not prevent applying a new auth of their type.
+func authTenantTypeIndexStatement() string {
+	return fmt.Sprintf("CREATE UNIQUE INDEX IF NOT EXISTS %s ON %s (tenant_id, type) WHERE status <> '%s'",
+		model.AuthTenantTypeIndex, (&model.Auth{}).TableName(), authgrpc.AuthStatus_AUTH_STATUS_REMOVED.String())
+}
+
+// EnforceAuthTenantTypeUniqueness creates the unique index allowing one auth per tenant and type which
+// is not removed if enforce is true, and drops it otherwise. If existing auths violate the uniqueness,
+// the index is not created and ErrAuthUniquenessViolated is returned with the number of violating groups.
+func EnforceAuthTenantTypeUniqueness(db *gorm.DB, enforce bool) error {
+	if !enforce {
+		if err := db.Exec("DROP INDEX IF EXISTS " + model.AuthTenantTypeIndex).Error; err != nil {
+			return fmt.Errorf("failed to drop the auth uniqueness index: %w", err)
+		}
+
+		return nil
+	}
+
+	var violations int64
+	err := db.Raw(`SELECT count(*) FROM (
+			SELECT tenant_id, type FROM auths WHERE status <> ? GROUP BY tenant_id, type HAVING count(*) > 1
+		) duplicates`, authgrpc.AuthStatus_AUTH_STATUS_REMOVED.String()).
+		Scan(&violations).Error
+	if err != nil {
+		return fmt.Errorf("failed to verify the auth uniqueness: %w", err)
+	}
+
+	if violations > 0 {
+		return fmt.Errorf("%w: %d combinations of tenant and type have several auths", ErrAuthUniquenessViolated, violations)
+	}
+
+	if err := db.Exec(authTenantTypeIndexStatement()).Error; err != nil {
+		return fmt.Errorf("failed to create the auth uniqueness index: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+package sql
+
+import (
+	"context"
+	"reflect"
+
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// changeFeedLockKey is the key of the advisory lock serializing the commits of the transactions with changes,
+// so the sequences of the changes are assigned in commit order.
+const changeFeedLockKey int64 = 0x72656766 // "regf"
+
+// changeFeed records the mutations of the resources of its tables as changes.
+type changeFeed struct {
+	tables map[string]struct{}
+}
+
+// EnableChangeFeed records the creates, patches and deletes of the resources by the repository as changes,
+// within the same transaction as the mutations. Mutations outside a transaction are run within one.
+// The changes of a transaction are inserted when the transaction function returns and the advisory lock
+// taken for them is held until the commit, so transactions with changes commit one after the other.
+// Mutations of many records, e.g. by PatchAll, are recorded per record returned by the mutation.
+func (r *ResourceRepository) EnableChangeFeed(resources ...repository.Resource) {
+	tables := make(map[string]struct{}, len(resources))
+	for _, resource := range resources {
+		tables[resource.TableName()] = struct{}{}
+	}
+
+	r.feed = &changeFeed{tables: tables}
+}
+
+// within returns the repository of the transaction, which collects the changes of the transaction,
+// indexes the labels and the external IDs of the resources mutated within it and guards their status times.
+func (r ResourceRepository) within(tx *gorm.DB) *ResourceRepository {
+	repo := NewRepository(tx)
+	repo.feed = r.feed
+	repo.labels = r.labels
+	repo.externalIDs = r.externalIDs
+	repo.clock = r.clock
+	repo.pending = &[]model.Change{}
+
+	return repo
+}
+
+// records returns true if the mutations of the resource are recorded as changes.
+func (r ResourceRepository) records(resource repository.Resource) bool {
+	if r.feed == nil {
+		return false
+	}
+
+	_, ok := r.feed.tables[resource.TableName()]
+	return ok
+}
+
+// outsideChangeTransaction returns true if the mutation of the resource is recorded, its labels or its external ID
+// are indexed or its status time is set, but the repository is not within a transaction collecting the changes.
+func (r ResourceRepository) outsideChangeTransaction(resource repository.Resource) bool {
+	return r.pending == nil &&
+		(r.records(resource) || r.indexes(resource) || r.resolves(resource) || statusChanged(resource))
+}
+
+// recordChange adds the change of the resource to the changes of the transaction.
+func (r ResourceRepository) recordChange(ctx context.Context, operation string, resource repository.Resource) {
+	if r.pending == nil || !r.records(resource) {
+		return
+	}
+
+	key := make(map[string]any)
+	for field, value := range resource.PaginationKey() {
+		key[field] = value
+	}
+
+	*r.pending = append(*r.pending, model.Change{
+		ResourceType: resource.TableName(),
+		ResourceKey:  key,
+		Operation:    operation,
+		Caller:       repository.CallerFromContext(ctx),
+	})
+}
+
+// recordChanges adds the changes of the resources of result, a pointer to a slice, to the changes of the transaction.
+func (r ResourceRepository) recordChanges(ctx context.Context, operation string, result any) {
+	for _, resource := range resourcesOf(result) {
+		r.recordChange(ctx, operation, resource)
+	}
+}
+
+// resourcesOf returns the resources of result, a pointer to a slice of a resource or of pointers to it.
+func resourcesOf(result any) []repository.Resource {
+	records := reflect.Indirect(reflect.ValueOf(result))
+	if records.Kind() != reflect.Slice {
+		return nil
+	}
+
+	resources := make([]repository.Resource, 0, records.Len())
+	for i := range records.Len() {
+		record := records.Index(i)
+		if record.Kind() != reflect.Pointer {
+			record = record.Addr()
+		}
+
+		if resource, ok := record.Interface().(repository.Resource); ok {
+			resources = append(resources, resource)
+		}
+	}
+
+	return resources
+}
+
+// flushChanges inserts the changes of the transaction. The advisory lock is released by the commit.
+func (r ResourceRepository) flushChanges(ctx context.Context) error {
+	if r.pending == nil || len(*r.pending) == 0 {
+		return nil
+	}
+
+	db := r.db.WithContext(ctx)
+	if err := db.Exec("SELECT pg_advisory_xact_lock(?)", changeFeedLockKey).Error; err != nil {
+		return err
+	}
+
+	return db.Create(r.pending).Error
+}
@@ -0,0 +1,153 @@
+package sql_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/repository"
+	sqlrepo "github.com/openkcm/registry/internal/repository/sql"
+)
+
+// recordingConnPool records the executed statements and supports transactions without a database.
+// The executed statements fail with execErr if it is set.
+type recordingConnPool struct {
+	statements []string
+	execErr    error
+}
+
+func (p *recordingConnPool) PrepareContext(context.Context, string) (*sql.Stmt, error) {
+	return nil, errors.ErrUnsupported
+}
+
+func (p *recordingConnPool) ExecContext(_ context.Context, query string, _ ...any) (sql.Result, error) {
+	p.statements = append(p.statements, query)
+	if p.execErr != nil {
+		return nil, p.execErr
+	}
+
+	return recordingResult{}, nil
+}
+
+func (p *recordingConnPool) QueryContext(context.Context, string, ...any) (*sql.Rows, error) {
+	return nil, errors.ErrUnsupported
+}
+
+func (p *recordingConnPool) QueryRowContext(context.Context, string, ...any) *sql.Row {
+	return nil
+}
+
+func (p *recordingConnPool) BeginTx(context.Context, *sql.TxOptions) (gorm.ConnPool, error) {
+	p.statements = append(p.statements, "BEGIN")
+	return &recordingTx{recordingConnPool: p}, nil
+}
+
+type recordingTx struct {
+	*recordingConnPool
+}
+
+func (tx *recordingTx) Commit() error {
+	tx.statements = append(tx.statements, "COMMIT")
+	return nil
+}
+
+func (tx *recordingTx) Rollback() error {
+	tx.statements = append(tx.statements, "ROLLBACK")
+	return nil
+}
+
+type recordingResult struct{}
+
+func (recordingResult) LastInsertId() (int64, error) { return 1, nil }
+func (recordingResult) RowsAffected() (int64, error) { return 1, nil }
+
+func newRecordingRepository(t *testing.T) (*sqlrepo.ResourceRepository, *recordingConnPool) {
+	t.Helper()
+	pool := &recordingConnPool{}
+	db, err := gorm.Open(noopDialector{}, &gorm.Config{ConnPool: pool, SkipDefaultTransaction: true})
+	require.NoError(t, err)
+
+	return sqlrepo.NewRepository(db), pool
+}
+
+func TestChangeFeed(t *testing.T) {
+	t.Run("should record a mutation outside a transaction within one", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+		repo.EnableChangeFeed(&testRecord{})
+
+		// when
+		err := repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, pool.statements, 5)
+		assert.Equal(t, "BEGIN", pool.statements[0])
+		assert.Contains(t, pool.statements[1], "INSERT INTO records")
+		assert.Contains(t, pool.statements[2], "pg_advisory_xact_lock")
+		assert.Contains(t, pool.statements[3], "INSERT INTO changes")
+		assert.Equal(t, "COMMIT", pool.statements[4])
+	})
+
+	t.Run("should record the changes of a transaction when it ends", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+		repo.EnableChangeFeed(&testRecord{})
+
+		// when
+		err := repo.Transaction(t.Context(), func(ctx context.Context, r repository.Repository) error {
+			if err := r.Create(ctx, &testRecord{ID: "a"}); err != nil {
+				return err
+			}
+			_, err := r.Delete(ctx, &testRecord{ID: "b"})
+			return err
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, pool.statements, 6)
+		assert.Contains(t, pool.statements[1], "INSERT INTO records")
+		assert.Contains(t, pool.statements[2], "DELETE FROM records")
+		assert.Contains(t, pool.statements[3], "pg_advisory_xact_lock")
+		assert.Contains(t, pool.statements[4], "INSERT INTO changes")
+		assert.Equal(t, "COMMIT", pool.statements[5])
+	})
+
+	t.Run("should not record the changes of a failed transaction", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+		repo.EnableChangeFeed(&testRecord{})
+		errFailed := errors.New("failed")
+
+		// when
+		err := repo.Transaction(t.Context(), func(ctx context.Context, r repository.Repository) error {
+			if err := r.Create(ctx, &testRecord{ID: "a"}); err != nil {
+				return err
+			}
+			return errFailed
+		})
+
+		// then
+		require.ErrorIs(t, err, errFailed)
+		assert.Equal(t, []string{"BEGIN", pool.statements[1], "ROLLBACK"}, pool.statements)
+	})
+
+	t.Run("should not record resources of other tables", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+		repo.EnableChangeFeed()
+
+		// when
+		err := repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, pool.statements, 1)
+		assert.Contains(t, pool.statements[0], "INSERT INTO records")
+	})
+}
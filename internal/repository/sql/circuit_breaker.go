@@ -0,0 +1,322 @@
+package sql
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+const (
+	circuitBeforeCallback = "registry:circuit_breaker_before"
+	circuitAfterCallback  = "registry:circuit_breaker_after"
+	circuitProbeKey       = "registry:circuit_probe"
+)
+
+// circuitRecordedKey is the context key of the flag set once a connection error of a guarded transaction is recorded.
+type circuitRecordedKey struct{}
+
+// ErrCircuitOpen is returned by the repository operations rejected while the circuit is open.
+var ErrCircuitOpen = errors.New("database circuit is open")
+
+// connectionErrCodes are the SQLSTATEs of errors caused by the server going away,
+// besides the class 08 connection exceptions, see https://www.postgresql.org/docs/14/errcodes-appendix.html
+var connectionErrCodes = map[string]struct{}{
+	"57P01": {}, // admin_shutdown
+	"57P02": {}, // crash_shutdown
+	"57P03": {}, // cannot_connect_now
+}
+
+// CircuitState is the state of a CircuitBreaker, its value is reported by the state gauge.
+type CircuitState int
+
+const (
+	// CircuitClosed lets all operations pass.
+	CircuitClosed CircuitState = iota
+	// CircuitHalfOpen lets a single probe operation pass, which decides whether the circuit closes or opens again.
+	CircuitHalfOpen
+	// CircuitOpen rejects all operations with ErrCircuitOpen.
+	CircuitOpen
+)
+
+func (s CircuitState) String() string {
+	switch s {
+	case CircuitClosed:
+		return "closed"
+	case CircuitHalfOpen:
+		return "half-open"
+	default:
+		return "open"
+	}
+}
+
+// CircuitBreaker fails the repository operations fast while the database is unreachable, e.g. during a failover,
+// instead of letting every operation wait for a connection and fail. The circuit opens after the configured
+// number of consecutive connection errors. Once the open duration elapsed, the circuit is half-open
+// and a single probe operation is let through, which closes the circuit if it reaches the database.
+// A disabled CircuitBreaker is always closed.
+type CircuitBreaker struct {
+	conf config.CircuitBreaker
+	now  func() time.Time
+
+	mu       sync.Mutex
+	open     bool
+	openedAt time.Time
+	failures int
+	probing  bool
+	// probe runs an operation through the breaker, set once the breaker is enabled for a repository.
+	probe func(ctx context.Context) error
+}
+
+// NewCircuitBreaker creates and returns a new instance of CircuitBreaker.
+func NewCircuitBreaker(conf config.CircuitBreaker) *CircuitBreaker {
+	return &CircuitBreaker{
+		conf: conf,
+		now:  time.Now,
+	}
+}
+
+// EnableCircuitBreaker guards the operations and transactions of the repository by the breaker.
+// The breaker is enabled for every DB of the repository, pools may share the DB.
+func (r *ResourceRepository) EnableCircuitBreaker(breaker *CircuitBreaker) error {
+	if !breaker.conf.Enabled {
+		return nil
+	}
+
+	dbs := []*gorm.DB{r.db}
+	for _, db := range r.pools {
+		dbs = append(dbs, db)
+	}
+
+	for _, db := range dbs {
+		if err := breaker.registerCallbacks(db); err != nil {
+			return err
+		}
+	}
+
+	breaker.mu.Lock()
+	breaker.probe = func(ctx context.Context) error {
+		return r.db.WithContext(ctx).Exec("SELECT 1").Error
+	}
+	breaker.mu.Unlock()
+
+	r.breaker = breaker
+
+	return nil
+}
+
+// State returns the current state of the circuit.
+func (b *CircuitBreaker) State() CircuitState {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.stateAt(b.now())
+}
+
+// RetryAfter returns the remaining duration the circuit is open and true if the circuit is not closed.
+// The duration is zero if the circuit is half-open.
+func (b *CircuitBreaker) RetryAfter() (time.Duration, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !b.open {
+		return 0, false
+	}
+
+	return max(b.conf.OpenDuration-b.now().Sub(b.openedAt), 0), true
+}
+
+// Check returns ErrCircuitOpen unless the circuit is closed, so the instance is not ready while it is open.
+// A half-open circuit is probed by the check, so the circuit closes once the database is reachable again
+// even if the instance receives no requests.
+func (b *CircuitBreaker) Check(ctx context.Context) error {
+	b.mu.Lock()
+	state := b.stateAt(b.now())
+	probe := b.probe
+	b.mu.Unlock()
+
+	if state == CircuitHalfOpen && probe != nil {
+		_ = probe(ctx)
+		state = b.State()
+	}
+
+	if state != CircuitClosed {
+		return ErrCircuitOpen
+	}
+
+	return nil
+}
+
+func (b *CircuitBreaker) stateAt(now time.Time) CircuitState {
+	switch {
+	case !b.open:
+		return CircuitClosed
+	case now.Sub(b.openedAt) >= b.conf.OpenDuration:
+		return CircuitHalfOpen
+	default:
+		return CircuitOpen
+	}
+}
+
+// allow returns ErrCircuitOpen if the operation is rejected, and true if it is the probe of the half-open circuit.
+func (b *CircuitBreaker) allow() (bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.stateAt(b.now()) {
+	case CircuitClosed:
+		return false, nil
+	case CircuitHalfOpen:
+		if b.probing {
+			return false, ErrCircuitOpen
+		}
+		b.probing = true
+		return true, nil
+	default:
+		return false, ErrCircuitOpen
+	}
+}
+
+// rejects reports whether the circuit is open and not yet probed, e.g. to fail a transaction before it begins.
+func (b *CircuitBreaker) rejects() bool {
+	return b.State() == CircuitOpen
+}
+
+// record records the result of an operation. The circuit opens once the threshold of consecutive connection errors
+// is reached and is closed or opened again by the result of the probe. A canceled or timed out probe leaves
+// the circuit half-open, so the next operation probes it. Results of operations which passed before the circuit
+// opened do not change the open circuit.
+func (b *CircuitBreaker) record(err error, probe bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if probe {
+		defer func() { b.probing = false }()
+	}
+
+	if errors.Is(err, ErrCircuitOpen) || probe && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		return
+	}
+
+	failed := isConnectionError(err)
+
+	switch {
+	case b.open && probe && failed:
+		b.openedAt = b.now()
+		slog.Warn("database circuit opened again after failed probe", slog.Any("error", err))
+	case b.open && probe:
+		b.open = false
+		b.failures = 0
+		slog.Info("database circuit closed")
+	case b.open:
+	case failed:
+		b.failures++
+		if b.failures >= b.conf.FailureThreshold {
+			b.open = true
+			b.openedAt = b.now()
+			slog.Warn("database circuit opened", slog.Int("failures", b.failures), slog.Any("error", err))
+		}
+	default:
+		b.failures = 0
+	}
+}
+
+// guard runs the transaction unless the circuit is open and records the connection errors of the transaction,
+// e.g. a failed begin, which are not seen by the callbacks of the statements. A connection error already
+// recorded by the callback of a statement of the transaction is not recorded again.
+func (b *CircuitBreaker) guard(ctx context.Context, tx func(ctx context.Context) error) error {
+	if b.rejects() {
+		return ErrCircuitOpen
+	}
+
+	recorded := new(atomic.Bool)
+	err := tx(context.WithValue(ctx, circuitRecordedKey{}, recorded))
+	if isConnectionError(err) && !recorded.Load() {
+		b.record(err, false)
+	}
+
+	return err
+}
+
+// registerCallbacks guards the statements of the DB by the breaker. The callbacks are registered once per DB.
+func (b *CircuitBreaker) registerCallbacks(db *gorm.DB) error {
+	callbacks := db.Callback()
+	if callbacks.Query().Get(circuitBeforeCallback) != nil {
+		return nil
+	}
+
+	return errors.Join(
+		callbacks.Create().Before("*").Register(circuitBeforeCallback, b.before),
+		callbacks.Create().After("*").Register(circuitAfterCallback, b.after),
+		callbacks.Query().Before("*").Register(circuitBeforeCallback, b.before),
+		callbacks.Query().After("*").Register(circuitAfterCallback, b.after),
+		callbacks.Update().Before("*").Register(circuitBeforeCallback, b.before),
+		callbacks.Update().After("*").Register(circuitAfterCallback, b.after),
+		callbacks.Delete().Before("*").Register(circuitBeforeCallback, b.before),
+		callbacks.Delete().After("*").Register(circuitAfterCallback, b.after),
+		callbacks.Row().Before("*").Register(circuitBeforeCallback, b.before),
+		callbacks.Row().After("*").Register(circuitAfterCallback, b.after),
+		callbacks.Raw().Before("*").Register(circuitBeforeCallback, b.before),
+		callbacks.Raw().After("*").Register(circuitAfterCallback, b.after),
+	)
+}
+
+// before fails the statement with ErrCircuitOpen if the breaker rejects it, so it is not executed.
+func (b *CircuitBreaker) before(db *gorm.DB) {
+	probe, err := b.allow()
+	if err != nil {
+		_ = db.AddError(err)
+		return
+	}
+
+	if probe {
+		db.InstanceSet(circuitProbeKey, true)
+	}
+}
+
+// after records the result of the statement and marks its connection error as recorded for the guarded transaction.
+func (b *CircuitBreaker) after(db *gorm.DB) {
+	_, probe := db.InstanceGet(circuitProbeKey)
+	b.record(db.Error, probe)
+
+	if db.Statement.Context == nil || !isConnectionError(db.Error) {
+		return
+	}
+	if recorded, ok := db.Statement.Context.Value(circuitRecordedKey{}).(*atomic.Bool); ok {
+		recorded.Store(true)
+	}
+}
+
+// isConnectionError reports whether the error is caused by an unreachable database.
+// Canceled and timed out operations are not, as the context of the operation ended them.
+func isConnectionError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var connectErr *pgconn.ConnectError
+	if errors.As(err, &connectErr) || errors.Is(err, driver.ErrBadConn) || errors.Is(err, io.ErrUnexpectedEOF) {
+		return true
+	}
+
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) {
+		_, ok := connectionErrCodes[pgError.Code]
+		return ok || strings.HasPrefix(pgError.Code, "08")
+	}
+
+	var netErr net.Error
+
+	return errors.As(err, &netErr)
+}
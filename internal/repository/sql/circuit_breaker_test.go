@@ -0,0 +1,222 @@
+package sql_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	sqlrepo "github.com/openkcm/registry/internal/repository/sql"
+)
+
+// newBreakerRepository returns a repository guarded by a breaker opening after two connection errors
+// for a minute, and the function advancing the clock of the breaker.
+func newBreakerRepository(t *testing.T) (*sqlrepo.ResourceRepository, *recordingConnPool, *sqlrepo.CircuitBreaker, func(time.Duration)) {
+	t.Helper()
+	repo, pool := newRecordingRepository(t)
+
+	now := time.Now()
+	breaker := sqlrepo.NewCircuitBreaker(config.CircuitBreaker{Enabled: true, FailureThreshold: 2, OpenDuration: time.Minute})
+	sqlrepo.SetCircuitBreakerClock(breaker, func() time.Time { return now })
+	require.NoError(t, repo.EnableCircuitBreaker(breaker))
+
+	return repo, pool, breaker, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	errConn := fmt.Errorf("query: %w", driver.ErrBadConn)
+
+	t.Run("should open after consecutive connection errors and reject operations", func(t *testing.T) {
+		// given
+		repo, pool, breaker, _ := newBreakerRepository(t)
+		pool.execErr = errConn
+
+		// when
+		for range 2 {
+			_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+		}
+		err := repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		require.ErrorIs(t, err, sqlrepo.ErrCircuitOpen)
+		assert.Len(t, pool.statements, 2, "the rejected operation must not be executed")
+		assert.Equal(t, sqlrepo.CircuitOpen, breaker.State())
+		delay, open := breaker.RetryAfter()
+		assert.True(t, open)
+		assert.Equal(t, time.Minute, delay)
+		assert.ErrorIs(t, breaker.Check(t.Context()), sqlrepo.ErrCircuitOpen)
+	})
+
+	t.Run("should not open on errors which are no connection errors", func(t *testing.T) {
+		// given
+		repo, pool, breaker, _ := newBreakerRepository(t)
+
+		// when
+		pool.execErr = errConn
+		_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+		pool.execErr = &pgconn.PgError{Code: "23505"}
+		_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+		pool.execErr = errConn
+		_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		assert.Equal(t, sqlrepo.CircuitClosed, breaker.State())
+		assert.NoError(t, breaker.Check(t.Context()))
+	})
+
+	t.Run("should reject transactions without beginning them while open", func(t *testing.T) {
+		// given
+		repo, pool, breaker, _ := newBreakerRepository(t)
+		pool.execErr = errConn
+		for range 2 {
+			_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+		}
+		require.Equal(t, sqlrepo.CircuitOpen, breaker.State())
+
+		// when
+		err := repo.Transaction(t.Context(), func(context.Context, repository.Repository) error {
+			return nil
+		})
+
+		// then
+		require.ErrorIs(t, err, sqlrepo.ErrCircuitOpen)
+		assert.NotContains(t, pool.statements, "BEGIN")
+	})
+
+	t.Run("should close once the probe of the half-open circuit succeeds", func(t *testing.T) {
+		// given
+		repo, pool, breaker, advance := newBreakerRepository(t)
+		pool.execErr = errConn
+		for range 2 {
+			_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+		}
+		advance(time.Minute)
+		require.Equal(t, sqlrepo.CircuitHalfOpen, breaker.State())
+		pool.execErr = nil
+
+		// when
+		err := breaker.Check(t.Context())
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "SELECT 1", pool.statements[len(pool.statements)-1])
+		assert.Equal(t, sqlrepo.CircuitClosed, breaker.State())
+		_, open := breaker.RetryAfter()
+		assert.False(t, open)
+	})
+
+	t.Run("should open again once the probe of the half-open circuit fails", func(t *testing.T) {
+		// given
+		repo, pool, breaker, advance := newBreakerRepository(t)
+		pool.execErr = errConn
+		for range 2 {
+			_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+		}
+		advance(time.Minute)
+
+		// when
+		err := repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		require.ErrorIs(t, err, errConn)
+		assert.Equal(t, sqlrepo.CircuitOpen, breaker.State())
+		delay, _ := breaker.RetryAfter()
+		assert.Equal(t, time.Minute, delay)
+	})
+
+	t.Run("should probe the half-open circuit again once the probe is canceled", func(t *testing.T) {
+		// given
+		repo, pool, breaker, advance := newBreakerRepository(t)
+		pool.execErr = errConn
+		for range 2 {
+			_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+		}
+		advance(time.Minute)
+		pool.execErr = fmt.Errorf("query: %w", context.Canceled)
+
+		// when
+		err := repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		require.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, sqlrepo.CircuitHalfOpen, breaker.State())
+
+		// when
+		pool.execErr = nil
+		err = repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, sqlrepo.CircuitClosed, breaker.State())
+	})
+
+	t.Run("should count a connection error of a transaction statement once", func(t *testing.T) {
+		// given
+		repo, pool, breaker, _ := newBreakerRepository(t)
+		pool.execErr = errConn
+
+		// when
+		err := repo.Transaction(t.Context(), func(ctx context.Context, r repository.Repository) error {
+			return r.Create(ctx, &testRecord{ID: "a"})
+		})
+
+		// then
+		require.ErrorIs(t, err, driver.ErrBadConn)
+		assert.Equal(t, sqlrepo.CircuitClosed, breaker.State())
+
+		// when
+		_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		assert.Equal(t, sqlrepo.CircuitOpen, breaker.State())
+	})
+
+	t.Run("should stay closed if disabled", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+		breaker := sqlrepo.NewCircuitBreaker(config.CircuitBreaker{})
+		require.NoError(t, repo.EnableCircuitBreaker(breaker))
+		pool.execErr = errConn
+
+		// when
+		for range 10 {
+			_ = repo.Create(t.Context(), &testRecord{ID: "a"})
+		}
+
+		// then
+		assert.Len(t, pool.statements, 10)
+		assert.Equal(t, sqlrepo.CircuitClosed, breaker.State())
+	})
+}
+
+func TestIsConnectionError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		exp  bool
+	}{
+		{name: "bad connection", err: fmt.Errorf("query: %w", driver.ErrBadConn), exp: true},
+		{name: "network error", err: &net.OpError{Op: "dial", Err: errors.New("connection refused")}, exp: true},
+		{name: "connection exception", err: &pgconn.PgError{Code: "08006"}, exp: true},
+		{name: "admin shutdown", err: &pgconn.PgError{Code: "57P01"}, exp: true},
+		{name: "unique violation", err: &pgconn.PgError{Code: "23505"}},
+		{name: "deadline exceeded", err: context.DeadlineExceeded},
+		{name: "other error", err: errors.New("record not found")},
+		{name: "no error"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.exp, sqlrepo.IsConnectionError(tt.err))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+var PaginationIndexStatement = paginationIndexStatement
+
+var ForeignKeyStatement = foreignKeyStatement
+
+var ForeignKeyViolationsStatement = foreignKeyViolationsStatement
+
+var ForeignKeyName = foreignKeyName
+
+var ApplyQuery = applyQuery
+
+var LabelIndexKey = labelIndexKey
+
+var LabelIndexID = labelIndexID
+
+var LabelIndexInsertStatement = labelIndexInsertStatement
+
+// RouteLabels returns the query with the label selectors matched by the label index of the repository.
+func RouteLabels(r *ResourceRepository, query repository.Query) repository.Query {
+	return r.routeLabels(query)
+}
+
+var ApplyAggregate = applyAggregate
+
+var TransactionOperation = transactionOperation
+
+// RunTransactionRetry runs tx with the retries of the configuration without sleeping between the attempts.
+func RunTransactionRetry(ctx context.Context, conf config.TransactionRetry, onRetry TransactionRetryHandler, tx func(ctx context.Context) error) error {
+	retry := &transactionRetry{
+		conf:    conf,
+		onRetry: onRetry,
+		sleep:   func(context.Context, time.Duration) error { return nil },
+	}
+
+	return retry.run(ctx, tx)
+}
+
+func TransactionRetryBackoff(conf config.TransactionRetry, attempt int) time.Duration {
+	return (&transactionRetry{conf: conf}).backoff(attempt)
+}
+
+var RecordRetryableError = recordRetryableError
+
+// SetCircuitBreakerClock sets the clock of the breaker.
+func SetCircuitBreakerClock(b *CircuitBreaker, now func() time.Time) {
+	b.now = now
+}
+
+var IsConnectionError = isConnectionError
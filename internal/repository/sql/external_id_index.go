@@ -0,0 +1,120 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// externalIDIndex indexes the external IDs of the resources of its tables.
+type externalIDIndex struct {
+	tables map[string]repository.ExternallyIdentified
+}
+
+// EnableExternalIDIndex indexes the external IDs of the resources in the external ID index table, within the same
+// transaction as the creates, patches and deletes of the resources by the repository, like the label index.
+// The resources are keyed in the index like in the label index. The index of existing resources is built by
+// RebuildExternalIDIndex.
+func (r *ResourceRepository) EnableExternalIDIndex(resources ...repository.ExternallyIdentified) {
+	tables := make(map[string]repository.ExternallyIdentified, len(resources))
+	for _, resource := range resources {
+		tables[resource.TableName()] = resource
+	}
+
+	r.externalIDs = &externalIDIndex{tables: tables}
+}
+
+// resolves returns true if the external ID of the resource is indexed.
+func (r ResourceRepository) resolves(resource repository.Resource) bool {
+	if r.externalIDs == nil {
+		return false
+	}
+
+	_, ok := r.externalIDs.tables[resource.TableName()]
+	return ok
+}
+
+// RebuildExternalIDIndex indexes the external IDs of the existing resources and removes the entries
+// of resources which no longer exist, e.g. those of resources deleted while the index was disabled.
+func (r ResourceRepository) RebuildExternalIDIndex(ctx context.Context) error {
+	if r.externalIDs == nil {
+		return nil
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(r.externalIDs.tables)) {
+		resource := r.externalIDs.tables[table]
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(externalIDIndexInsertStatement(resource, ""), table).Error; err != nil {
+				return err
+			}
+
+			return tx.Exec(externalIDIndexPruneStatement(resource), table).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to rebuild the external ID index of %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// indexExternalIDs replaces the entries of the resources, all of the same table, by their external IDs as stored.
+// Deleted resources are not stored anymore, so their entries are removed.
+func (r ResourceRepository) indexExternalIDs(ctx context.Context, resources ...repository.Resource) error {
+	if len(resources) == 0 || !r.resolves(resources[0]) {
+		return nil
+	}
+
+	resource := r.externalIDs.tables[resources[0].TableName()]
+	table := resource.TableName()
+	ids := make([]string, 0, len(resources))
+	for _, each := range resources {
+		ids = append(ids, labelIndexID(each))
+	}
+
+	db := r.conn(ctx)
+	for batch := range slices.Chunk(ids, maxLabelIndexBatch) {
+		err := db.Exec("DELETE FROM external_id_index WHERE resource_type = ? AND resource_id IN ?", table, batch).Error
+		if err != nil {
+			return fmt.Errorf("failed to index external IDs of %s: %w", table, err)
+		}
+
+		err = db.Exec(externalIDIndexInsertStatement(resource, labelIndexKey(resource)+" IN ?"), table, batch).Error
+		if err != nil {
+			return fmt.Errorf("failed to index external IDs of %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// externalIDIndexInsertStatement returns the statement indexing the external IDs of the records of the resource
+// matching the condition, or of all records without condition. Empty external IDs are skipped.
+// The first argument of the statement is the table.
+func externalIDIndexInsertStatement(resource repository.ExternallyIdentified, condition string) string {
+	table := resource.TableName()
+	column := table + "." + string(resource.ExternalIDColumn())
+
+	statement := fmt.Sprintf("INSERT INTO external_id_index (external_id, resource_type, resource_id) "+
+		"SELECT %s, ?, %s FROM %s WHERE %s <> ''", column, labelIndexKey(resource), table, column)
+	if condition != "" {
+		statement += " AND " + condition
+	}
+
+	return statement + " ON CONFLICT DO NOTHING"
+}
+
+// externalIDIndexPruneStatement returns the statement removing the entries of the resource whose
+// resource no longer exists or has another external ID. The argument of the statement is the table.
+func externalIDIndexPruneStatement(resource repository.ExternallyIdentified) string {
+	table := resource.TableName()
+
+	return fmt.Sprintf("DELETE FROM external_id_index WHERE resource_type = ? AND NOT EXISTS "+
+		"(SELECT 1 FROM %s WHERE %s = external_id_index.resource_id AND %s.%s = external_id_index.external_id)",
+		table, labelIndexKey(resource), table, resource.ExternalIDColumn())
+}
@@ -0,0 +1,130 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// OnDelete is the action of a foreign key once the referenced record is deleted.
+type OnDelete string
+
+const (
+	// OnDeleteRestrict rejects deleting referenced records.
+	OnDeleteRestrict OnDelete = "RESTRICT"
+	// OnDeleteSetNull sets the column of the referencing records to NULL.
+	OnDeleteSetNull OnDelete = "SET NULL"
+	// OnDeleteCascade deletes the referencing records.
+	OnDeleteCascade OnDelete = "CASCADE"
+)
+
+// confDelTypes are the codes of the actions in pg_constraint.confdeltype.
+var confDelTypes = map[OnDelete]string{
+	OnDeleteRestrict: "r",
+	OnDeleteSetNull:  "n",
+	OnDeleteCascade:  "c",
+}
+
+// ForeignKey references the ID of the References resource by the column of the resource.
+type ForeignKey struct {
+	Resource   repository.Resource
+	Column     string
+	References repository.Resource
+	OnDelete   OnDelete
+}
+
+// ForeignKeyViolation reports the records violating a foreign key, which is then not validated.
+type ForeignKeyViolation struct {
+	Name  string
+	Count int64
+}
+
+// ForeignKeys are the relationships enforced by the database:
+// a system can not be deleted while it has regional systems, a deleted system deletes its relationships,
+// a deleted tenant unlinks its systems and deletes its auths and service accounts, a deleted auth deletes its property blobs.
+var ForeignKeys = []ForeignKey{
+	{Resource: &model.RegionalSystem{}, Column: "system_id", References: &model.System{}, OnDelete: OnDeleteRestrict},
+	{Resource: &model.SystemRelationship{}, Column: "source_system_id", References: &model.System{}, OnDelete: OnDeleteCascade},
+	{Resource: &model.SystemRelationship{}, Column: "target_system_id", References: &model.System{}, OnDelete: OnDeleteCascade},
+	{Resource: &model.System{}, Column: "tenant_id", References: &model.Tenant{}, OnDelete: OnDeleteSetNull},
+	{Resource: &model.Auth{}, Column: "tenant_id", References: &model.Tenant{}, OnDelete: OnDeleteCascade},
+	{Resource: &model.ServiceAccount{}, Column: "tenant_id", References: &model.Tenant{}, OnDelete: OnDeleteCascade},
+	{Resource: &model.AuthPropertyBlob{}, Column: "auth_id", References: &model.Auth{}, OnDelete: OnDeleteCascade},
+}
+
+// foreignKeyName returns the name of the foreign key, as gorm names the constraints of associations.
+func foreignKeyName(key ForeignKey) string {
+	return fmt.Sprintf("fk_%s_%s", key.Resource.TableName(), strings.TrimSuffix(key.Column, "_id"))
+}
+
+// foreignKeyStatement returns the statement replacing the foreign key. The constraint is not validated,
+// so existing records violating it do not fail the migration, see VerifyForeignKeys.
+func foreignKeyStatement(key ForeignKey) string {
+	name := foreignKeyName(key)
+
+	return fmt.Sprintf("ALTER TABLE %s DROP CONSTRAINT IF EXISTS %s, "+
+		"ADD CONSTRAINT %s FOREIGN KEY (%s) REFERENCES %s (id) ON DELETE %s NOT VALID",
+		key.Resource.TableName(), name, name, key.Column, key.References.TableName(), key.OnDelete)
+}
+
+// foreignKeyViolationsStatement returns the statement counting the records referencing a missing record.
+func foreignKeyViolationsStatement(key ForeignKey) string {
+	return fmt.Sprintf("SELECT count(*) FROM %s r WHERE r.%s IS NOT NULL "+
+		"AND NOT EXISTS (SELECT 1 FROM %s p WHERE p.id = r.%s)",
+		key.Resource.TableName(), key.Column, key.References.TableName(), key.Column)
+}
+
+// CreateForeignKeys creates the foreign keys which are missing or whose ON DELETE action differs.
+func CreateForeignKeys(db *gorm.DB, keys ...ForeignKey) error {
+	for _, key := range keys {
+		var actions []string
+		err := db.Raw(`SELECT con.confdeltype::text
+			FROM pg_constraint con
+			JOIN pg_class c ON c.oid = con.conrelid
+			WHERE con.conname = ? AND c.relname = ?`, foreignKeyName(key), key.Resource.TableName()).
+			Scan(&actions).Error
+		if err != nil {
+			return fmt.Errorf("failed to read foreign key %s: %w", foreignKeyName(key), err)
+		}
+
+		if len(actions) == 1 && actions[0] == confDelTypes[key.OnDelete] {
+			continue
+		}
+
+		if err := db.Exec(foreignKeyStatement(key)).Error; err != nil {
+			return fmt.Errorf("failed to create foreign key %s: %w", foreignKeyName(key), err)
+		}
+	}
+
+	return nil
+}
+
+// VerifyForeignKeys validates the foreign keys which no existing record violates
+// and returns the violations of the others, e.g. auths of tenants deleted before the foreign keys existed.
+// The violating records are found and fixed by the integrity checks of the admin service.
+func VerifyForeignKeys(db *gorm.DB, keys ...ForeignKey) ([]ForeignKeyViolation, error) {
+	var violations []ForeignKeyViolation
+
+	for _, key := range keys {
+		var count int64
+		if err := db.Raw(foreignKeyViolationsStatement(key)).Scan(&count).Error; err != nil {
+			return nil, fmt.Errorf("failed to verify foreign key %s: %w", foreignKeyName(key), err)
+		}
+
+		if count > 0 {
+			violations = append(violations, ForeignKeyViolation{Name: foreignKeyName(key), Count: count})
+			continue
+		}
+
+		err := db.Exec(fmt.Sprintf("ALTER TABLE %s VALIDATE CONSTRAINT %s", key.Resource.TableName(), foreignKeyName(key))).Error
+		if err != nil {
+			return nil, fmt.Errorf("failed to validate foreign key %s: %w", foreignKeyName(key), err)
+		}
+	}
+
+	return violations, nil
+}
@@ -0,0 +1,36 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+	sqlrepo "github.com/openkcm/registry/internal/repository/sql"
+)
+
+func TestForeignKeyStatement(t *testing.T) {
+	// given
+	key := sqlrepo.ForeignKey{
+		Resource:   &model.Auth{},
+		Column:     "tenant_id",
+		References: &model.Tenant{},
+		OnDelete:   sqlrepo.OnDeleteCascade,
+	}
+
+	// when
+	statement := sqlrepo.ForeignKeyStatement(key)
+	violations := sqlrepo.ForeignKeyViolationsStatement(key)
+
+	// then
+	assert.Equal(t, "ALTER TABLE auths DROP CONSTRAINT IF EXISTS fk_auths_tenant, "+
+		"ADD CONSTRAINT fk_auths_tenant FOREIGN KEY (tenant_id) REFERENCES tenants (id) ON DELETE CASCADE NOT VALID", statement)
+	assert.Equal(t, "SELECT count(*) FROM auths r WHERE r.tenant_id IS NOT NULL "+
+		"AND NOT EXISTS (SELECT 1 FROM tenants p WHERE p.id = r.tenant_id)", violations)
+}
+
+func TestForeignKeys(t *testing.T) {
+	// then
+	assert.Equal(t, "fk_regional_systems_system", sqlrepo.ForeignKeyName(sqlrepo.ForeignKeys[0]),
+		"the foreign key must replace the one gorm creates for the association")
+}
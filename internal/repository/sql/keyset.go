@@ -0,0 +1,81 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+var ErrPaginationIndexMissing = errors.New("pagination index is missing or does not match the keyset")
+
+// PaginatedResources are the resources listed page by page, which require an index on their keyset,
+// including the resources scanned by repository.Scanner.
+var PaginatedResources = []repository.Resource{
+	&model.Tenant{},
+	&model.Auth{},
+	&model.RegionalSystem{},
+	&model.SystemGroup{},
+	&model.System{},
+	&model.SystemCredential{},
+}
+
+// paginationIndexName returns the name of the index on the keyset of the resource.
+func paginationIndexName(resource repository.Resource) string {
+	return resource.TableName() + "_keyset_idx"
+}
+
+// paginationIndexStatement returns the statement creating the index on the keyset of the resource,
+// so the pages are read in the order of the index instead of sorting the table.
+func paginationIndexStatement(resource repository.Resource) string {
+	keyset := repository.Keyset(resource)
+
+	columns := make([]string, len(keyset))
+	for i, column := range keyset {
+		columns[i] = column + " DESC"
+	}
+
+	return fmt.Sprintf("CREATE INDEX IF NOT EXISTS %s ON %s (%s)",
+		paginationIndexName(resource), resource.TableName(), strings.Join(columns, ", "))
+}
+
+// CreatePaginationIndexes creates the missing indexes on the keysets of the resources.
+func CreatePaginationIndexes(db *gorm.DB, resources ...repository.Resource) error {
+	for _, resource := range resources {
+		if err := db.Exec(paginationIndexStatement(resource)).Error; err != nil {
+			return fmt.Errorf("failed to create pagination index of %s: %w", resource.TableName(), err)
+		}
+	}
+
+	return nil
+}
+
+// VerifyPaginationIndexes returns ErrPaginationIndexMissing if the index on the keyset of a resource
+// is missing or its columns differ from the keyset, e.g. after the pagination key changed.
+func VerifyPaginationIndexes(db *gorm.DB, resources ...repository.Resource) error {
+	for _, resource := range resources {
+		var columns []string
+		err := db.Raw(`SELECT a.attname
+			FROM pg_index i
+			JOIN pg_class c ON c.oid = i.indexrelid
+			JOIN pg_attribute a ON a.attrelid = i.indrelid AND a.attnum = ANY(i.indkey)
+			WHERE c.relname = ?
+			ORDER BY array_position(i.indkey::int2[], a.attnum)`, paginationIndexName(resource)).
+			Scan(&columns).Error
+		if err != nil {
+			return fmt.Errorf("failed to read pagination index of %s: %w", resource.TableName(), err)
+		}
+
+		if !slices.Equal(columns, repository.Keyset(resource)) {
+			return fmt.Errorf("%w: %s has %v, expected %v", ErrPaginationIndexMissing,
+				resource.TableName(), columns, repository.Keyset(resource))
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// maxLabelIndexBatch is the maximum number of resources whose labels are indexed by one statement.
+const maxLabelIndexBatch = 1000
+
+// labelIndex indexes the labels of the resources of its tables.
+type labelIndex struct {
+	tables map[string]repository.Resource
+}
+
+// indexedLabels is the label selector of a query of an indexed resource, matched by the label index.
+type indexedLabels struct {
+	resource repository.Resource
+	labels   map[string]any
+}
+
+// EnableLabelIndex indexes the labels of the resources in the label index table, within the same transaction
+// as the creates, patches and deletes of the resources by the repository. Mutations outside a transaction
+// are run within one. Label selectors of queries of the resources are then matched by the index.
+// The index of existing resources is built by RebuildLabelIndex.
+func (r *ResourceRepository) EnableLabelIndex(resources ...repository.Resource) {
+	tables := make(map[string]repository.Resource, len(resources))
+	for _, resource := range resources {
+		tables[resource.TableName()] = resource
+	}
+
+	r.labels = &labelIndex{tables: tables}
+}
+
+// indexes returns true if the labels of the resource are indexed.
+func (r ResourceRepository) indexes(resource repository.Resource) bool {
+	if r.labels == nil {
+		return false
+	}
+
+	_, ok := r.labels.tables[resource.TableName()]
+	return ok
+}
+
+// RebuildLabelIndex indexes the labels of the existing resources and removes the entries of labels
+// which no longer exist, e.g. those of resources mutated while the index was disabled.
+func (r ResourceRepository) RebuildLabelIndex(ctx context.Context) error {
+	if r.labels == nil {
+		return nil
+	}
+
+	for _, table := range slices.Sorted(maps.Keys(r.labels.tables)) {
+		resource := r.labels.tables[table]
+		err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := tx.Exec(labelIndexInsertStatement(resource, ""), table).Error; err != nil {
+				return err
+			}
+
+			return tx.Exec(labelIndexPruneStatement(resource), table).Error
+		})
+		if err != nil {
+			return fmt.Errorf("failed to rebuild the label index of %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// indexLabels replaces the entries of the resources, all of the same table, by their labels as stored.
+// Deleted resources have no labels stored, so their entries are removed.
+func (r ResourceRepository) indexLabels(ctx context.Context, resources ...repository.Resource) error {
+	if len(resources) == 0 || !r.indexes(resources[0]) {
+		return nil
+	}
+
+	table := resources[0].TableName()
+	ids := make([]string, 0, len(resources))
+	for _, resource := range resources {
+		ids = append(ids, labelIndexID(resource))
+	}
+
+	db := r.conn(ctx)
+	for batch := range slices.Chunk(ids, maxLabelIndexBatch) {
+		err := db.Exec("DELETE FROM label_index WHERE resource_type = ? AND resource_id IN ?", table, batch).Error
+		if err != nil {
+			return fmt.Errorf("failed to index labels of %s: %w", table, err)
+		}
+
+		err = db.Exec(labelIndexInsertStatement(resources[0], labelIndexKey(resources[0])+" IN ?"), table, batch).Error
+		if err != nil {
+			return fmt.Errorf("failed to index labels of %s: %w", table, err)
+		}
+	}
+
+	return nil
+}
+
+// routeLabels returns the query with the label selectors matched by the label index, if the labels
+// of the queried resource are indexed. The composite keys of the query are copied, not modified.
+// Negated label selectors still evaluate the labels, as they match resources without the label as well.
+func (r ResourceRepository) routeLabels(query repository.Query) repository.Query {
+	if query.Resource == nil || !r.indexes(query.Resource) {
+		return query
+	}
+
+	compositeKeys := make([]repository.CompositeKey, len(query.CompositeKeys))
+	for i, compositeKey := range query.CompositeKeys {
+		compositeKeys[i] = maps.Clone(compositeKey)
+		if value, ok := compositeKey[repository.LabelsField]; ok {
+			compositeKeys[i][repository.LabelsField] = routeLabelsValue(query.Resource, value)
+		}
+	}
+
+	query.CompositeKeys = compositeKeys
+
+	return query
+}
+
+// routeLabelsValue returns the value of the labels field with its label selectors matched by the label index,
+// including those combined by All, e.g. by filter expressions with several label keys.
+func routeLabelsValue(resource repository.Resource, value any) any {
+	switch v := value.(type) {
+	case map[string]any:
+		return indexedLabels{resource: resource, labels: v}
+	case repository.All:
+		all := make(repository.All, len(v))
+		for i, each := range v {
+			all[i] = routeLabelsValue(resource, each)
+		}
+		return all
+	default:
+		return value
+	}
+}
+
+// handleIndexedLabels matches the resources having all labels of the selector by the label index.
+func handleIndexedLabels(tx *gorm.DB, selector indexedLabels) *gorm.DB {
+	key := labelIndexKey(selector.resource)
+	table := selector.resource.TableName()
+
+	for k, v := range selector.labels {
+		if isSlice(v) {
+			tx = tx.Where(key+" IN (SELECT resource_id FROM label_index WHERE resource_type = ? AND key = ? AND value IN ?)", table, k, v)
+			continue
+		}
+		tx = tx.Where(key+" IN (SELECT resource_id FROM label_index WHERE resource_type = ? AND key = ? AND value = ?)", table, k, v)
+	}
+
+	return tx
+}
+
+// labelIndexColumns returns the key columns of the resource in the order of their names.
+func labelIndexColumns(resource repository.Resource) []string {
+	return slices.Sorted(maps.Keys(resource.PaginationKey()))
+}
+
+// labelIndexKey returns the expression of the resource ID of the label index for the records of the resource.
+func labelIndexKey(resource repository.Resource) string {
+	columns := labelIndexColumns(resource)
+	for i, column := range columns {
+		columns[i] = resource.TableName() + "." + column
+	}
+
+	if len(columns) == 1 {
+		return columns[0] + "::text"
+	}
+
+	return "concat_ws('/', " + strings.Join(columns, ", ") + ")"
+}
+
+// labelIndexID returns the resource ID of the resource in the label index, matching labelIndexKey.
+func labelIndexID(resource repository.Resource) string {
+	key := resource.PaginationKey()
+
+	values := make([]string, 0, len(key))
+	for _, column := range labelIndexColumns(resource) {
+		values = append(values, fmt.Sprint(key[column]))
+	}
+
+	return strings.Join(values, "/")
+}
+
+// labelIndexInsertStatement returns the statement indexing the labels of the records of the resource
+// matching the condition, or of all records without condition. The labels of records without labels
+// are stored as JSON null, which is skipped. The first argument of the statement is the table.
+func labelIndexInsertStatement(resource repository.Resource, condition string) string {
+	table := resource.TableName()
+
+	statement := fmt.Sprintf("INSERT INTO label_index (resource_type, resource_id, key, value) "+
+		"SELECT ?, %s, l.key, l.value FROM %s, "+
+		"jsonb_each_text(CASE WHEN jsonb_typeof(%s.labels) = 'object' THEN %s.labels ELSE '{}' END) AS l",
+		labelIndexKey(resource), table, table, table)
+	if condition != "" {
+		statement += " WHERE " + condition
+	}
+
+	return statement + " ON CONFLICT (resource_type, resource_id, key) DO UPDATE SET value = EXCLUDED.value"
+}
+
+// labelIndexPruneStatement returns the statement removing the entries of the labels of the resource
+// which no longer exist. The argument of the statement is the table.
+func labelIndexPruneStatement(resource repository.Resource) string {
+	table := resource.TableName()
+
+	return fmt.Sprintf("DELETE FROM label_index WHERE resource_type = ? AND NOT EXISTS "+
+		"(SELECT 1 FROM %s WHERE %s = label_index.resource_id AND %s.labels ->> label_index.key = label_index.value)",
+		table, labelIndexKey(resource), table)
+}
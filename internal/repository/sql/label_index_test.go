@@ -0,0 +1,117 @@
+package sql_test
+
+import (
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	sqlrepo "github.com/openkcm/registry/internal/repository/sql"
+)
+
+func TestLabelIndexKey(t *testing.T) {
+	// given
+	systemID, err := uuid.NewV4()
+	require.NoError(t, err)
+
+	// then
+	assert.Equal(t, "tenants.id::text", sqlrepo.LabelIndexKey(&model.Tenant{}))
+	assert.Equal(t, "concat_ws('/', regional_systems.region, regional_systems.system_id)",
+		sqlrepo.LabelIndexKey(&model.RegionalSystem{}))
+	assert.Equal(t, "tenant-1", sqlrepo.LabelIndexID(&model.Tenant{ID: "tenant-1"}))
+	assert.Equal(t, "eu10/"+systemID.String(), sqlrepo.LabelIndexID(&model.RegionalSystem{SystemID: systemID, Region: "eu10"}))
+}
+
+func TestLabelIndexInsertStatement(t *testing.T) {
+	// when
+	statement := sqlrepo.LabelIndexInsertStatement(&model.Tenant{}, "tenants.id::text IN ?")
+
+	// then
+	assert.Equal(t, "INSERT INTO label_index (resource_type, resource_id, key, value) "+
+		"SELECT ?, tenants.id::text, l.key, l.value FROM tenants, "+
+		"jsonb_each_text(CASE WHEN jsonb_typeof(tenants.labels) = 'object' THEN tenants.labels ELSE '{}' END) AS l "+
+		"WHERE tenants.id::text IN ? "+
+		"ON CONFLICT (resource_type, resource_id, key) DO UPDATE SET value = EXCLUDED.value", statement)
+}
+
+func TestLabelIndex(t *testing.T) {
+	t.Run("should index the labels of a mutation outside a transaction within one", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+		repo.EnableLabelIndex(&testRecord{})
+
+		// when
+		err := repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, pool.statements, 5)
+		assert.Equal(t, "BEGIN", pool.statements[0])
+		assert.Contains(t, pool.statements[1], "INSERT INTO records")
+		assert.Contains(t, pool.statements[2], "DELETE FROM label_index")
+		assert.Contains(t, pool.statements[3], "INSERT INTO label_index")
+		assert.Equal(t, "COMMIT", pool.statements[4])
+	})
+
+	t.Run("should not index the labels of other resources", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+		repo.EnableLabelIndex()
+
+		// when
+		err := repo.Create(t.Context(), &testRecord{ID: "a"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, pool.statements, 1)
+		assert.Contains(t, pool.statements[0], "INSERT INTO records")
+	})
+
+	t.Run("should match label selectors by the label index", func(t *testing.T) {
+		// given
+		repo := sqlrepo.NewRepository(newTestDB(t))
+		repo.EnableLabelIndex(&testRecord{})
+		labels := map[string]any{"env": "prod"}
+		query := repository.NewQuery(&testRecord{}).Where(repository.NewCompositeKey().
+			Where(repository.LabelsField, repository.All{labels, map[string]any{"tier": []string{"gold", "silver"}}}).
+			Where(repository.StatusField, "ACTIVE"))
+
+		// when
+		result := newTestDB(t).ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.ApplyQuery(tx.Model(&[]testRecord{}), sqlrepo.RouteLabels(repo, *query))
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "records.id::text IN (SELECT resource_id FROM label_index "+
+			"WHERE resource_type = ? AND key = ? AND value = ?)")
+		assert.Contains(t, result, "records.id::text IN (SELECT resource_id FROM label_index "+
+			"WHERE resource_type = ? AND key = ? AND value IN (?,?))")
+		assert.NotContains(t, result, "labels ->>")
+		assert.Equal(t, map[string]any{"env": "prod"}, labels)
+	})
+
+	t.Run("should evaluate the label selectors of resources whose labels are not indexed", func(t *testing.T) {
+		// given
+		repo := sqlrepo.NewRepository(newTestDB(t))
+		repo.EnableLabelIndex(&model.Tenant{})
+		query := repository.NewQuery(&testRecord{}).Where(repository.NewCompositeKey().
+			Where(repository.LabelsField, map[string]any{"env": "prod"}))
+
+		// when
+		result := newTestDB(t).ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.ApplyQuery(tx.Model(&[]testRecord{}), sqlrepo.RouteLabels(repo, *query))
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "labels ->>")
+		assert.NotContains(t, result, "label_index")
+	})
+}
@@ -0,0 +1,100 @@
+package sql
+
+import (
+	"errors"
+	"fmt"
+
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Pools are the connection pools of the request classes, including the default pool.
+type Pools map[repository.Pool]*gorm.DB
+
+// OpenPools opens the enabled dedicated connection pools of the configuration.
+// The returned pools include db as the default pool, which serves the classes without dedicated pool.
+func OpenPools(db *gorm.DB, conf config.DB) (Pools, error) {
+	pools := Pools{repository.PoolDefault: db}
+
+	for pool, poolConf := range map[repository.Pool]config.DBPool{
+		repository.PoolRead:  conf.Pools.Read,
+		repository.PoolWrite: conf.Pools.Write,
+		repository.PoolAdmin: conf.Pools.Admin,
+	} {
+		if !poolConf.Enabled {
+			continue
+		}
+
+		poolDB, err := openPool(conf, pool, poolConf)
+		if err != nil {
+			return nil, errors.Join(fmt.Errorf("failed to open %s pool: %w", pool, err), pools.closeDedicated())
+		}
+
+		pools[pool] = poolDB
+	}
+
+	return pools, nil
+}
+
+// openPool opens a connection pool with the limits and the statement timeout of the pool.
+// The connections are named after the pool, so they can be told apart in pg_stat_activity.
+func openPool(conf config.DB, pool repository.Pool, poolConf config.DBPool) (*gorm.DB, error) {
+	dsn, err := GetDataSourceName(conf)
+	if err != nil {
+		return nil, err
+	}
+
+	dsn += fmt.Sprintf(" application_name=registry-%s statement_timeout=%d", pool, poolConf.StatementTimeout.Milliseconds())
+
+	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
+		Logger: logger.Default.LogMode(logger.LogLevel(conf.LogLevel)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB.SetMaxOpenConns(poolConf.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(poolConf.MaxIdleConns)
+	sqlDB.SetConnMaxIdleTime(poolConf.ConnMaxIdleTime)
+
+	return db, nil
+}
+
+// Close drains and closes all pools. It should be called once no requests are served anymore.
+func (p Pools) Close() error {
+	var errs []error
+
+	for pool, db := range p {
+		sqlDB, err := db.DB()
+		if err == nil {
+			err = sqlDB.Close()
+		}
+
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to close %s pool: %w", pool, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// closeDedicated closes the dedicated pools, leaving the default pool open.
+func (p Pools) closeDedicated() error {
+	dedicated := make(Pools, len(p))
+	for pool, db := range p {
+		if pool != repository.PoolDefault {
+			dedicated[pool] = db
+		}
+	}
+
+	return dedicated.Close()
+}
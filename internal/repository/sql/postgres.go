@@ -16,15 +16,11 @@ import (
 
 // StartDB starts DB connection and runs migrations.
 func StartDB(ctx context.Context, dbConf config.DB) (*gorm.DB, error) {
-	dbCon, err := startDBConnection(dbConf)
+	dbCon, err := OpenDB(ctx, dbConf)
 	if err != nil {
-		slog.Error("failed to initialize DB connection", slog.Any("error", err))
 		return nil, err
 	}
 
-	dbCon = dbCon.WithContext(ctx)
-	slog.Info("DB connection done")
-
 	if err = Migrate(dbCon); err != nil {
 		slog.Error("failed to run migrations", slog.Any("error", err))
 		return nil, err
@@ -35,20 +31,46 @@ func StartDB(ctx context.Context, dbConf config.DB) (*gorm.DB, error) {
 	return dbCon, nil
 }
 
-// startDBConnection initializes and returns a database connection using the provided configuration.
-func startDBConnection(conf config.DB) (*gorm.DB, error) {
+// OpenDB connects to the database without running the migrations, e.g. to a read replica.
+// ctx bounds the connection attempt, the returned connection does not keep it.
+func OpenDB(ctx context.Context, dbConf config.DB) (*gorm.DB, error) {
+	dbCon, err := startDBConnection(ctx, dbConf)
+	if err != nil {
+		slog.Error("failed to initialize DB connection", slog.Any("error", err))
+		return nil, err
+	}
+
+	slog.Info("DB connection done")
+
+	return dbCon, nil
+}
+
+// startDBConnection initializes and returns a database connection using the provided configuration,
+// which is pinged within ctx.
+func startDBConnection(ctx context.Context, conf config.DB) (*gorm.DB, error) {
 	dsn, err := GetDataSourceName(conf)
 	if err != nil {
 		return nil, err
 	}
 
 	db, err := gorm.Open(postgres.Open(dsn), &gorm.Config{
-		Logger: logger.Default.LogMode(logger.LogLevel(conf.LogLevel)),
+		Logger:               logger.Default.LogMode(logger.LogLevel(conf.LogLevel)),
+		DisableAutomaticPing: true,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+
 	return db, nil
 }
 
@@ -68,7 +90,43 @@ func GetDataSourceName(conf config.DB) (string, error) {
 	return dsn, nil
 }
 
-// Migrate runs DB migrations.
+// Migrate runs DB migrations, creates the foreign keys and verifies the indexes of the paginated resources.
+// Existing records violating a foreign key are reported, but do not fail the migration.
 func Migrate(db *gorm.DB) error {
-	return db.AutoMigrate(&model.System{}, &model.RegionalSystem{}, &model.Tenant{}, &model.Auth{})
+	err := db.AutoMigrate(&model.System{}, &model.RegionalSystem{}, &model.Tenant{}, &model.Auth{}, &model.SystemGroup{}, &model.InventorySnapshot{}, &model.Backfill{}, &model.SystemCredential{}, &model.SystemL2Key{}, &model.SystemLink{}, &model.JobDelay{}, &model.TenantUserGroup{}, &model.Change{}, &model.MaintenanceMode{}, &model.IdentityProvider{}, &model.NotificationPreferences{}, &model.JobOutcome{}, &model.LabelIndexEntry{}, &model.ExternalIDIndexEntry{}, &model.TenantStatusChange{}, &model.AuthPropertyBlob{}, &model.ScanCheckpoint{}, &model.ServiceAccount{}, &model.SystemRelationship{})
+	if err != nil {
+		return err
+	}
+
+	if err := CreateForeignKeys(db, ForeignKeys...); err != nil {
+		return err
+	}
+
+	violations, err := VerifyForeignKeys(db, ForeignKeys...)
+	if err != nil {
+		return err
+	}
+
+	for _, violation := range violations {
+		slog.Warn("existing records violate the foreign key, it is not validated",
+			slog.String("constraint", violation.Name), slog.Int64("count", violation.Count))
+	}
+
+	if err := CreatePaginationIndexes(db, PaginatedResources...); err != nil {
+		return err
+	}
+
+	return VerifyPaginationIndexes(db, PaginatedResources...)
+}
+
+// Ping returns the function pinging the database with a connection of the pool, e.g. as readiness check.
+func Ping(db *gorm.DB) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+
+		return sqlDB.PingContext(ctx)
+	}
 }
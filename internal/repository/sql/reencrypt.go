@@ -0,0 +1,83 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openkcm/registry/internal/encryption"
+)
+
+// EncryptedColumn identifies a column encrypted at rest and the primary key of its table.
+type EncryptedColumn struct {
+	Table  string
+	Column string
+	Key    string
+}
+
+// Reencrypt encrypts all values of the column which are in plaintext or encrypted with a previous key version
+// with the active key version, in transactions of batchSize rows.
+// Rows are locked with SKIP LOCKED, so it can run concurrently on several instances.
+// It returns the number of re-encrypted values.
+func Reencrypt(ctx context.Context, db *gorm.DB, cipher *encryption.Deterministic, col EncryptedColumn, batchSize int) (int64, error) {
+	var total int64
+
+	for {
+		n, err := reencryptBatch(ctx, db, cipher, col, batchSize)
+		if err != nil {
+			return total, err
+		}
+
+		total += n
+		if n < int64(batchSize) {
+			slog.Info("re-encryption done", slog.String("table", col.Table), slog.String("column", col.Column), slog.Int64("count", total))
+			return total, nil
+		}
+	}
+}
+
+func reencryptBatch(ctx context.Context, db *gorm.DB, cipher *encryption.Deterministic, col EncryptedColumn, batchSize int) (int64, error) {
+	var n int64
+
+	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var rows []struct {
+			RowKey   string
+			RowValue string
+		}
+
+		err := tx.Table(col.Table).
+			Select(fmt.Sprintf("%s AS row_key, %s AS row_value", col.Key, col.Column)).
+			Where(col.Column+" != ''").
+			Where(col.Column+" NOT LIKE ?", cipher.ActivePrefix()+"%").
+			Order(col.Key).
+			Limit(batchSize).
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked}).
+			Scan(&rows).Error
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			plaintext, err := cipher.Decrypt(row.RowValue)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt %s of %s %s: %w", col.Column, col.Table, row.RowKey, err)
+			}
+
+			err = tx.Table(col.Table).
+				Where(col.Key+" = ?", row.RowKey).
+				Update(col.Column, cipher.Encrypt(plaintext)).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		n = int64(len(rows))
+
+		return nil
+	})
+
+	return n, err
+}
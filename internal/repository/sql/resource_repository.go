@@ -12,6 +12,7 @@ import (
 	"gorm.io/gorm"
 	"gorm.io/gorm/clause"
 
+	"github.com/openkcm/registry/internal/model"
 	"github.com/openkcm/registry/internal/repository"
 )
 
@@ -23,7 +24,19 @@ var ErrUnknownTypeForJSONBField = errors.New("unknown type for jsonb field")
 
 // ResourceRepository represents the repository for managing Resource data.
 type ResourceRepository struct {
-	db *gorm.DB
+	db      *gorm.DB
+	pools   Pools
+	retry   *transactionRetry
+	metrics *transactionMetrics
+	breaker *CircuitBreaker
+	feed    *changeFeed
+	labels  *labelIndex
+	// externalIDs is the external ID index, nil if disabled.
+	externalIDs *externalIDIndex
+	// clock is the guard of the status times, nil if disabled.
+	clock *statusClock
+	// pending collects the changes of the transaction of the repository, nil outside a transaction.
+	pending *[]model.Change
 }
 
 // NewRepository creates and returns a new instance of ResourceRepository.
@@ -33,29 +46,97 @@ func NewRepository(db *gorm.DB) *ResourceRepository {
 	}
 }
 
+// NewPooledRepository creates and returns a new instance of ResourceRepository,
+// which serves the operations of a request by the pool of the request class.
+func NewPooledRepository(pools Pools) *ResourceRepository {
+	return &ResourceRepository{
+		db:    pools[repository.PoolDefault],
+		pools: pools,
+	}
+}
+
+// conn returns the connection of the pool of the request class, or the default connection
+// if the class has no dedicated pool.
+func (r ResourceRepository) conn(ctx context.Context) *gorm.DB {
+	if db, ok := r.pools[repository.PoolFromContext(ctx)]; ok {
+		return db.WithContext(ctx)
+	}
+
+	return r.db.WithContext(ctx)
+}
+
 // Create adds meta information and stores a Resource.
 func (r ResourceRepository) Create(ctx context.Context, resource repository.Resource) error {
-	result := r.db.WithContext(ctx).Create(resource)
+	if r.outsideChangeTransaction(resource) {
+		return r.Transaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+			return tx.Create(ctx, resource)
+		})
+	}
+
+	if caller, ok := attributedBy(ctx, resource); ok {
+		caller.SetCreatedBy(repository.CallerFromContext(ctx))
+		caller.SetLastModifiedBy(repository.CallerFromContext(ctx))
+	}
+
+	result := r.conn(ctx).Create(resource)
 	if result.Error != nil {
 		slog.Error("error creating resource", slog.Any("error", result.Error))
+		return createError(result.Error)
+	}
 
-		var pgError *pgconn.PgError
-		if errors.As(result.Error, &pgError) && pgError.Code == pqUniqueViolationErrCode {
-			return &repository.UniqueConstraintError{
-				Detail: pgError.Detail,
-			}
+	r.recordChange(ctx, model.ChangeOperationCreate, resource)
+
+	return r.indexResources(ctx, resource)
+}
+
+// CreateAll adds meta information and stores the resources of records with one insert.
+func (r ResourceRepository) CreateAll(ctx context.Context, records any) error {
+	resources := resourcesOf(records)
+	if len(resources) == 0 {
+		return nil
+	}
+
+	if r.outsideChangeTransaction(resources[0]) {
+		return r.Transaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+			return tx.CreateAll(ctx, records)
+		})
+	}
+
+	for _, resource := range resources {
+		if caller, ok := attributedBy(ctx, resource); ok {
+			caller.SetCreatedBy(repository.CallerFromContext(ctx))
+			caller.SetLastModifiedBy(repository.CallerFromContext(ctx))
 		}
+	}
 
-		return result.Error
+	result := r.conn(ctx).Create(records)
+	if result.Error != nil {
+		slog.Error("error creating resources", slog.Any("error", result.Error))
+		return createError(result.Error)
 	}
 
-	return nil
+	r.recordChanges(ctx, model.ChangeOperationCreate, records)
+
+	return r.indexResources(ctx, resources...)
+}
+
+// createError returns err as UniqueConstraintError if it is a unique violation.
+func createError(err error) error {
+	var pgError *pgconn.PgError
+	if errors.As(err, &pgError) && pgError.Code == pqUniqueViolationErrCode {
+		return &repository.UniqueConstraintError{
+			Constraint: pgError.ConstraintName,
+			Detail:     pgError.Detail,
+		}
+	}
+
+	return err
 }
 
 // List retrieves records from the database based on the provided query parameters and model.
 func (r ResourceRepository) List(ctx context.Context, result any, query repository.Query) error {
-	dbQuery := r.db.WithContext(ctx).Model(result)
-	dbQuery, err := applyQuery(dbQuery, query)
+	dbQuery := r.conn(ctx).Model(result)
+	dbQuery, err := applyQuery(dbQuery, r.routeLabels(query))
 	if err != nil {
 		slog.Error("error applying query for listing resources", slog.Any("error", err))
 		return err
@@ -76,18 +157,41 @@ func (r ResourceRepository) List(ctx context.Context, result any, query reposito
 // false if there was no record to delete,
 // and error if there was an error during the deletion.
 func (r ResourceRepository) Delete(ctx context.Context, resource repository.Resource) (bool, error) {
-	result := r.db.WithContext(ctx).Clauses(clause.Returning{}).Delete(resource)
+	if r.outsideChangeTransaction(resource) {
+		var deleted bool
+		err := r.Transaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+			var err error
+			deleted, err = tx.Delete(ctx, resource)
+			return err
+		})
+		return deleted, err
+	}
+
+	result := r.conn(ctx).Clauses(clause.Returning{}).Delete(resource)
 	if result.Error != nil {
 		slog.Error("error deleting resource", slog.Any("error", result.Error))
 		return false, result.Error
 	}
 
+	if result.RowsAffected > 0 {
+		r.recordChange(ctx, model.ChangeOperationDelete, resource)
+		if err := r.indexResources(ctx, resource); err != nil {
+			return false, err
+		}
+	}
+
 	return result.RowsAffected > 0, nil
 }
 
 // Find fill given Resource with data, if found. Given Resource is used as query data.
-func (r ResourceRepository) Find(ctx context.Context, resource repository.Resource) (bool, error) {
-	result := r.db.WithContext(ctx).Where(resource).Limit(1).Find(resource)
+// An optional lock overrides the default lock of a transaction.
+func (r ResourceRepository) Find(ctx context.Context, resource repository.Resource, lock ...repository.Lock) (bool, error) {
+	db := r.conn(ctx)
+	if len(lock) > 0 {
+		db = ApplyLock(db, lock[0])
+	}
+
+	result := db.Where(resource).Limit(1).Find(resource)
 	if result.Error != nil {
 		slog.Error("error finding a resource", slog.Any("error", result.Error))
 		return false, result.Error
@@ -101,12 +205,37 @@ func (r ResourceRepository) Find(ctx context.Context, resource repository.Resour
 // It returns true if a record was patched successfully,
 // and error if there was an error during the patch.
 func (r ResourceRepository) Patch(ctx context.Context, resource repository.Resource) (bool, error) {
-	db := r.db.WithContext(ctx).Clauses(clause.Returning{}).Updates(resource)
+	if r.outsideChangeTransaction(resource) {
+		var patched bool
+		err := r.Transaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+			var err error
+			patched, err = tx.Patch(ctx, resource)
+			return err
+		})
+		return patched, err
+	}
+
+	if caller, ok := attributedBy(ctx, resource); ok {
+		caller.SetLastModifiedBy(repository.CallerFromContext(ctx))
+	}
+
+	db := r.conn(ctx).Clauses(clause.Returning{}).Updates(resource)
 	if db.Error != nil {
 		slog.Error("error updating resource", slog.Any("error", db.Error))
 		return false, db.Error
 	}
 
+	if db.RowsAffected > 0 {
+		if err := r.stampStatusTime(ctx, resource); err != nil {
+			return false, err
+		}
+
+		r.recordChange(ctx, model.ChangeOperationUpdate, resource)
+		if err := r.indexResources(ctx, resource); err != nil {
+			return false, err
+		}
+	}
+
 	return db.RowsAffected > 0, nil
 }
 
@@ -114,8 +243,22 @@ func (r ResourceRepository) Patch(ctx context.Context, resource repository.Resou
 // It returns the number of affected rows
 // and error if there was an error during the patch operation.
 func (r ResourceRepository) PatchAll(ctx context.Context, resource repository.Resource, result any, query repository.Query) (int64, error) {
-	db := r.db.WithContext(ctx).Model(result).Clauses(clause.Returning{})
-	db, err := applyQuery(db, query)
+	if r.outsideChangeTransaction(resource) {
+		var patched int64
+		err := r.Transaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+			var err error
+			patched, err = tx.PatchAll(ctx, resource, result, query)
+			return err
+		})
+		return patched, err
+	}
+
+	if caller, ok := attributedBy(ctx, resource); ok {
+		caller.SetLastModifiedBy(repository.CallerFromContext(ctx))
+	}
+
+	db := r.conn(ctx).Model(result).Clauses(clause.Returning{})
+	db, err := applyQuery(db, r.routeLabels(query))
 	if err != nil {
 		slog.Error("error applying query for updating resources", slog.Any("error", err))
 		return 0, err
@@ -127,19 +270,215 @@ func (r ResourceRepository) PatchAll(ctx context.Context, resource repository.Re
 		return db.RowsAffected, db.Error
 	}
 
-	return db.RowsAffected, nil
+	r.recordChanges(ctx, model.ChangeOperationUpdate, result)
+
+	return db.RowsAffected, r.indexResources(ctx, resourcesOf(result)...)
+}
+
+// DeleteAll deletes all records matching the query and returns them in result.
+func (r ResourceRepository) DeleteAll(ctx context.Context, result any, query repository.Query) (int64, error) {
+	if r.outsideChangeTransaction(query.Resource) {
+		var deleted int64
+		err := r.Transaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+			var err error
+			deleted, err = tx.DeleteAll(ctx, result, query)
+			return err
+		})
+		return deleted, err
+	}
+
+	db, err := applyFilters(r.conn(ctx).Clauses(clause.Returning{}), r.routeLabels(query))
+	if err != nil {
+		slog.Error("error applying query for deleting resources", slog.Any("error", err))
+		return 0, err
+	}
+
+	db = db.Delete(result)
+	if db.Error != nil {
+		slog.Error("error deleting resources", slog.Any("error", db.Error))
+		return 0, db.Error
+	}
+
+	r.recordChanges(ctx, model.ChangeOperationDelete, result)
+
+	return db.RowsAffected, r.indexResources(ctx, resourcesOf(result)...)
+}
+
+// ClearAll sets the fields of all records matching the query to NULL and returns the records in result.
+func (r ResourceRepository) ClearAll(ctx context.Context, result any, query repository.Query, fields ...repository.QueryField) (int64, error) {
+	if r.outsideChangeTransaction(query.Resource) {
+		var cleared int64
+		err := r.Transaction(ctx, func(ctx context.Context, tx repository.Repository) error {
+			var err error
+			cleared, err = tx.ClearAll(ctx, result, query, fields...)
+			return err
+		})
+		return cleared, err
+	}
+
+	columns := make(map[string]any, len(fields)+1)
+	for _, field := range fields {
+		columns[field] = nil
+	}
+
+	if _, ok := attributedBy(ctx, query.Resource); ok {
+		columns["last_modified_by"] = repository.CallerFromContext(ctx)
+	}
+
+	db, err := applyFilters(r.conn(ctx).Model(result).Clauses(clause.Returning{}), r.routeLabels(query))
+	if err != nil {
+		slog.Error("error applying query for clearing resources", slog.Any("error", err))
+		return 0, err
+	}
+
+	db = db.Updates(columns)
+	if db.Error != nil {
+		slog.Error("error clearing resources", slog.Any("error", db.Error))
+		return 0, db.Error
+	}
+
+	r.recordChanges(ctx, model.ChangeOperationUpdate, result)
+
+	return db.RowsAffected, r.indexResources(ctx, resourcesOf(result)...)
+}
+
+// Count returns the number of records matching the query.
+func (r ResourceRepository) Count(ctx context.Context, query repository.Query) (int64, error) {
+	db, err := applyFilters(r.conn(ctx).Model(query.Resource), r.routeLabels(query))
+	if err != nil {
+		slog.Error("error applying query for counting resources", slog.Any("error", err))
+		return 0, err
+	}
+
+	var count int64
+	if err := db.Count(&count).Error; err != nil {
+		slog.Error("error counting resources", slog.Any("error", err))
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// Aggregate counts the records matching the query grouped by the fields into result.
+func (r ResourceRepository) Aggregate(ctx context.Context, result any, query repository.Query, groupBy ...repository.QueryField) error {
+	db, err := applyAggregate(r.conn(ctx).Model(query.Resource), r.routeLabels(query), groupBy)
+	if err != nil {
+		slog.Error("error applying query for aggregating resources", slog.Any("error", err))
+		return err
+	}
+
+	if err := db.Scan(result).Error; err != nil {
+		slog.Error("error aggregating resources", slog.Any("error", err))
+		return err
+	}
+
+	return nil
+}
+
+// applyAggregate selects the count of the records matching the query per group of the fields.
+func applyAggregate(db *gorm.DB, query repository.Query, groupBy []repository.QueryField) (*gorm.DB, error) {
+	db, err := applyFilters(db, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(groupBy) == 0 {
+		return db.Select("count(*) AS count"), nil
+	}
+
+	columns := strings.Join(groupBy, ", ")
+
+	return db.Select(columns + ", count(*) AS count").Group(columns), nil
+}
+
+// indexResources updates the label index and the external ID index by the mutated resources, all of the same table.
+func (r ResourceRepository) indexResources(ctx context.Context, resources ...repository.Resource) error {
+	if err := r.indexLabels(ctx, resources...); err != nil {
+		return err
+	}
+
+	return r.indexExternalIDs(ctx, resources...)
+}
+
+// attributedBy returns the resource as Attributed if it records its clients and ctx has a caller.
+// Without caller, e.g. for background jobs, the recorded clients are kept.
+func attributedBy(ctx context.Context, resource repository.Resource) (repository.Attributed, bool) {
+	if repository.CallerFromContext(ctx) == "" {
+		return nil, false
+	}
+
+	attributed, ok := resource.(repository.Attributed)
+	return attributed, ok
 }
 
 // Transaction executes txFunc inside a GORM transaction with SELECT FOR UPDATE locking.
-// Commits on nil return, rolls back on error.
+// Commits on nil return, rolls back on error. If transaction retries are enabled, txFunc is run again
+// after a serialization failure or a deadlock, unless it recorded an effect outside of the transaction,
+// e.g. a prepared job, by repository.RecordOutsideEffect. If the circuit breaker is enabled,
+// the transaction fails with ErrCircuitOpen without beginning while the circuit is open.
+// The pre-commit function of ctx, see repository.WithPreCommit, is called once txFunc succeeded,
+// but not for transactions nested within txFunc.
 func (r ResourceRepository) Transaction(ctx context.Context, txFunc repository.TransactionFunc) error {
-	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		return txFunc(ctx, NewRepository(tx.Clauses(clause.Locking{Strength: "UPDATE"})))
-	})
+	preCommit := repository.PreCommitFromContext(ctx)
+	if preCommit != nil {
+		ctx = repository.WithPreCommit(ctx, nil)
+	}
+
+	tx := func(ctx context.Context) error {
+		return r.conn(ctx).Transaction(func(tx *gorm.DB) error {
+			txRepo := r.within(ApplyLock(tx, repository.LockForUpdate))
+			if err := txFunc(ctx, txRepo); err != nil {
+				return err
+			}
+
+			if preCommit != nil {
+				if err := preCommit(ctx); err != nil {
+					return err
+				}
+			}
+
+			return txRepo.flushChanges(ctx)
+		})
+	}
+
+	run := tx
+	if r.retry != nil {
+		run = func(ctx context.Context) error {
+			return r.retry.run(ctx, tx)
+		}
+	}
+
+	if r.breaker != nil {
+		retried := run
+		run = func(ctx context.Context) error {
+			return r.breaker.guard(ctx, retried)
+		}
+	}
+
+	if r.metrics != nil {
+		return r.metrics.measure(ctx, run)
+	}
+
+	return run(ctx)
+}
+
+// ApplyLock sets the row-level lock of the selected records, replacing any previously set lock.
+func ApplyLock(db *gorm.DB, lock repository.Lock) *gorm.DB {
+	if lock == "" {
+		return db
+	}
+
+	if lock == repository.LockForUpdateSkipLocked {
+		return db.Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate, Options: clause.LockingOptionsSkipLocked})
+	}
+
+	return db.Clauses(clause.Locking{Strength: string(lock)})
 }
 
 // applyQuery applies the query to the database (including pagination and preloads).
 func applyQuery(db *gorm.DB, query repository.Query) (*gorm.DB, error) {
+	db = ApplyLock(db, query.Lock)
+
 	// Preloads are only relevant when fetching actual data, not counting
 	if len(query.Preloads) > 0 {
 		for _, preload := range query.Preloads {
@@ -157,10 +496,24 @@ func applyQuery(db *gorm.DB, query repository.Query) (*gorm.DB, error) {
 		query.Limit = repository.DefaultPaginationLimit
 	}
 
+	if len(query.Ascending) > 0 {
+		return handleAscending(query.Resource, query.Ascending, db).Limit(query.Limit), nil
+	}
+
 	return handlePagination(query.Resource, query.Paginator, db).Limit(query.Limit), nil
 }
 
-// applyFilters applies Joins and CompositeKeys (WHERE clauses) to the database.
+// handleAscending orders the records of the resource by the fields ascending.
+func handleAscending(resource repository.Resource, fields []repository.QueryField, db *gorm.DB) *gorm.DB {
+	orderBy := make([]string, len(fields))
+	for i, field := range fields {
+		orderBy[i] = resource.TableName() + "." + field + " ASC"
+	}
+
+	return db.Order(strings.Join(orderBy, ", "))
+}
+
+// applyFilters applies Joins, Missing and CompositeKeys (WHERE clauses) to the database.
 func applyFilters(db *gorm.DB, query repository.Query) (*gorm.DB, error) {
 	if len(query.Joins) > 0 {
 		for _, join := range query.Joins {
@@ -169,6 +522,10 @@ func applyFilters(db *gorm.DB, query repository.Query) (*gorm.DB, error) {
 		}
 	}
 
+	for _, missing := range query.Missing {
+		db = db.Where(notExists(query.Resource, missing))
+	}
+
 	if len(query.CompositeKeys) > 0 {
 		baseQuery := db.Session(&gorm.Session{NewDB: true})
 
@@ -191,6 +548,18 @@ func applyFilters(db *gorm.DB, query repository.Query) (*gorm.DB, error) {
 	return db, nil
 }
 
+// notExists returns the condition matching the records of the resource without a record of the missing resource.
+func notExists(resource repository.Resource, missing repository.Missing) string {
+	table := missing.Resource.TableName()
+
+	conditions := make([]string, 0, len(missing.OnColumns))
+	for i, onColumn := range missing.OnColumns {
+		conditions = append(conditions, fmt.Sprintf("%s.%s = %s.%s", table, onColumn, resource.TableName(), missing.Columns[i]))
+	}
+
+	return fmt.Sprintf("NOT EXISTS (SELECT 1 FROM %s WHERE %s)", table, strings.Join(conditions, " AND "))
+}
+
 // handleCompositeKey applies the composite key to the query.
 func handleCompositeKey(db *gorm.DB, compositeKey repository.CompositeKey) (*gorm.DB, error) {
 	tx := db.Session(&gorm.Session{NewDB: true})
@@ -208,6 +577,30 @@ func handleCompositeKey(db *gorm.DB, compositeKey repository.CompositeKey) (*gor
 
 // HandleQueryField applies the query field to the query.
 func HandleQueryField(tx *gorm.DB, field repository.QueryField, value any) (*gorm.DB, error) {
+	switch v := value.(type) {
+	case repository.Range:
+		if v.From != nil {
+			tx = tx.Where(field+" >= ?", v.From)
+		}
+		if v.To != nil {
+			tx = tx.Where(field+" <= ?", v.To)
+		}
+		return tx, nil
+	case repository.All:
+		for _, each := range v {
+			var err error
+			tx, err = HandleQueryField(tx, field, each)
+			if err != nil {
+				return nil, err
+			}
+		}
+		return tx, nil
+	case repository.Not:
+		return handleNotQueryField(tx, field, v.Value)
+	case indexedLabels:
+		return handleIndexedLabels(tx, v), nil
+	}
+
 	switch value {
 	case repository.NotEmpty:
 		tx = tx.Where(field+" IS NOT NULL").Where(field+" != ?", "")
@@ -223,6 +616,10 @@ func HandleQueryField(tx *gorm.DB, field repository.QueryField, value any) (*gor
 				return nil, fmt.Errorf("%w: %T", ErrUnknownTypeForJSONBField, value)
 			}
 			for k, v := range labels {
+				if isSlice(v) {
+					tx = tx.Where(field+" ->> ? IN ?", k, v)
+					continue
+				}
 				tx = tx.Where(field+" ->> ? = ?", k, v)
 			}
 		default:
@@ -232,17 +629,47 @@ func HandleQueryField(tx *gorm.DB, field repository.QueryField, value any) (*gor
 	return tx, nil
 }
 
-// handlePagination applies pagination to the query.
-func handlePagination(resource repository.Resource, paginator repository.Paginator, db *gorm.DB) *gorm.DB {
-	createdAtField := fmt.Sprintf("%s.%s", resource.TableName(), repository.CreatedAtField)
+// handleNotQueryField applies the negated query field to the query. Records without value match as well.
+func handleNotQueryField(tx *gorm.DB, field repository.QueryField, value any) (*gorm.DB, error) {
+	switch reflect.ValueOf(value).Kind() { //nolint:exhaustive
+	case reflect.Slice, reflect.Array:
+		tx = tx.Where("("+field+" IS NULL OR "+field+" NOT IN ?)", value)
+	case reflect.Map:
+		labels, ok := value.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("%w: %T", ErrUnknownTypeForJSONBField, value)
+		}
+		for k, v := range labels {
+			if isSlice(v) {
+				tx = tx.Where("("+field+" ->> ? IS NULL OR "+field+" ->> ? NOT IN ?)", k, k, v)
+				continue
+			}
+			tx = tx.Where(field+" ->> ? IS DISTINCT FROM ?", k, v)
+		}
+	default:
+		tx = tx.Where(field+" IS DISTINCT FROM ?", value)
+	}
+	return tx, nil
+}
 
+func isSlice(value any) bool {
+	kind := reflect.ValueOf(value).Kind()
+	return kind == reflect.Slice || kind == reflect.Array
+}
+
+// handlePagination orders the query by the keyset of the resource and, if a page token is given,
+// selects the resources after the last resource of the previous page.
+// The columns are qualified by the table, as joined tables may have columns with the same names.
+func handlePagination(resource repository.Resource, paginator repository.Paginator, db *gorm.DB) *gorm.DB {
 	orderedColumns := make([]string, 0, len(paginator.OrderFields)+1)
-	orderedColumns = append(orderedColumns, createdAtField)
+	orderedColumns = append(orderedColumns, repository.CreatedAtField)
 	orderedColumns = append(orderedColumns, paginator.OrderFields...)
 
+	columns := make([]string, len(orderedColumns))
 	orderBy := make([]string, len(orderedColumns))
 	for i, col := range orderedColumns {
-		orderBy[i] = col + " DESC"
+		columns[i] = resource.TableName() + "." + col
+		orderBy[i] = columns[i] + " DESC"
 	}
 	db = db.Order(strings.Join(orderBy, ", "))
 
@@ -252,21 +679,14 @@ func handlePagination(resource repository.Resource, paginator repository.Paginat
 
 	pageInfo := paginator.PageInfo
 
-	fields := make([]string, 0, len(orderedColumns))
 	args := make([]any, 0, len(orderedColumns))
-	placeholderSlice := make([]string, 0, len(orderedColumns))
-
-	fields = append(fields, createdAtField)
 	args = append(args, pageInfo.LastCreatedAt)
-	placeholderSlice = append(placeholderSlice, "?")
-
 	for _, field := range paginator.OrderFields {
-		fields = append(fields, field)
 		args = append(args, pageInfo.LastKey[field])
-		placeholderSlice = append(placeholderSlice, "?")
 	}
 
-	condition := fmt.Sprintf("(%s) < (%s)", strings.Join(fields, ", "), strings.Join(placeholderSlice, ", "))
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(columns)), ", ")
+	condition := fmt.Sprintf("(%s) < (%s)", strings.Join(columns, ", "), placeholders)
 
 	return db.Where(condition, args...)
 }
@@ -1,7 +1,9 @@
 package sql_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -33,6 +35,10 @@ type testRecord struct{ ID string }
 
 func (testRecord) TableName() string { return "records" }
 
+func (r testRecord) PaginationKey() map[repository.QueryField]any {
+	return map[repository.QueryField]any{repository.IDField: r.ID}
+}
+
 func newTestDB(t *testing.T) *gorm.DB {
 	t.Helper()
 	db, err := gorm.Open(noopDialector{}, &gorm.Config{})
@@ -116,6 +122,83 @@ func TestHandleQueryField(t *testing.T) {
 		assert.Contains(t, result, "labels ->>")
 	})
 
+	t.Run("negations also match records without value", func(t *testing.T) {
+		tests := []struct {
+			value  any
+			expSQL string
+		}{
+			{value: repository.Not{Value: "ACTIVE"}, expSQL: "status IS DISTINCT FROM "},
+			{value: repository.Not{Value: []string{"ACTIVE", "BLOCKED"}}, expSQL: "(status IS NULL OR status NOT IN "},
+			{value: repository.Not{Value: map[string]any{"env": "prod"}}, expSQL: "status ->> ? IS DISTINCT FROM "},
+		}
+
+		for _, tt := range tests {
+			// given
+			db := newTestDB(t)
+
+			// when
+			result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				tx, err := sqlrepo.HandleQueryField(tx, "status", tt.value)
+				require.NoError(t, err)
+				return tx.Find(&[]testRecord{})
+			})
+
+			// then
+			assert.Contains(t, result, tt.expSQL)
+		}
+	})
+
+	t.Run("All generates a clause per value", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.HandleQueryField(tx, "labels", repository.All{
+				map[string]any{"env": []string{"dev", "test"}},
+				repository.Not{Value: map[string]any{"team": "a"}},
+			})
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "labels ->> ? IN ")
+		assert.Contains(t, result, "labels ->> ? IS DISTINCT FROM ")
+	})
+
+	t.Run("range generates bound clauses", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.HandleQueryField(tx, "date", repository.Range{From: "2025-01-01", To: "2025-01-31"})
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "date >= ")
+		assert.Contains(t, result, "date <= ")
+	})
+
+	t.Run("range without upper bound is open", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.HandleQueryField(tx, "date", repository.Range{From: "2025-01-01"})
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "date >= ")
+		assert.NotContains(t, result, "date <= ")
+	})
+
 	t.Run("invalid map type returns error", func(t *testing.T) {
 		// given
 		db := newTestDB(t)
@@ -126,4 +209,322 @@ func TestHandleQueryField(t *testing.T) {
 		// then
 		assert.ErrorIs(t, err, sqlrepo.ErrUnknownTypeForJSONBField)
 	})
+
+	t.Run("empty slice matches no record", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.HandleQueryField(tx, "region", []string{})
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "region IN (NULL)")
+	})
+
+	t.Run("slice exceeding the maximum number of client filter values is not bounded", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+		values := make([]string, repository.MaxFilterValues+1)
+
+		// when
+		_, err := sqlrepo.HandleQueryField(db, "region", values)
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func TestApplyLock(t *testing.T) {
+	tests := []struct {
+		name   string
+		lock   repository.Lock
+		expSQL string
+	}{
+		{name: "for update", lock: repository.LockForUpdate, expSQL: "FOR UPDATE"},
+		{name: "for share", lock: repository.LockForShare, expSQL: "FOR SHARE"},
+		{name: "for update skip locked", lock: repository.LockForUpdateSkipLocked, expSQL: "FOR UPDATE SKIP LOCKED"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			db := newTestDB(t)
+
+			// when
+			result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+				return sqlrepo.ApplyLock(tx, tt.lock).Find(&[]testRecord{})
+			})
+
+			// then
+			assert.Contains(t, result, tt.expSQL)
+		})
+	}
+
+	t.Run("lock replaces previous lock", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx = sqlrepo.ApplyLock(tx, repository.LockForUpdate)
+			return sqlrepo.ApplyLock(tx, repository.LockForShare).Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "FOR SHARE")
+		assert.NotContains(t, result, "FOR UPDATE")
+	})
+
+	t.Run("empty lock does not lock", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			return sqlrepo.ApplyLock(tx, "").Find(&[]testRecord{})
+		})
+
+		// then
+		assert.NotContains(t, result, "FOR ")
+	})
+}
+
+func TestPooledRepository(t *testing.T) {
+	// given
+	var used []repository.Pool
+
+	newPool := func(pool repository.Pool) *gorm.DB {
+		db, err := gorm.Open(noopDialector{}, &gorm.Config{DryRun: true})
+		require.NoError(t, err)
+
+		err = db.Callback().Query().Before("gorm:query").Register("test:pool", func(*gorm.DB) {
+			used = append(used, pool)
+		})
+		require.NoError(t, err)
+
+		return db
+	}
+
+	repo := sqlrepo.NewPooledRepository(sqlrepo.Pools{
+		repository.PoolDefault: newPool(repository.PoolDefault),
+		repository.PoolAdmin:   newPool(repository.PoolAdmin),
+	})
+
+	// when
+	for _, ctx := range []context.Context{
+		t.Context(),
+		repository.WithPool(t.Context(), repository.PoolAdmin),
+		repository.WithPool(t.Context(), repository.PoolRead),
+	} {
+		_, err := repo.Find(ctx, &testRecord{ID: "id"})
+		require.NoError(t, err)
+	}
+
+	// then
+	assert.Equal(t, []repository.Pool{repository.PoolDefault, repository.PoolAdmin, repository.PoolDefault}, used)
+}
+
+type attributedRecord struct {
+	ID             string
+	Name           string
+	CreatedBy      string
+	LastModifiedBy string
+}
+
+func (attributedRecord) TableName() string { return "records" }
+
+func (r attributedRecord) PaginationKey() map[repository.QueryField]any {
+	return map[repository.QueryField]any{repository.IDField: r.ID}
+}
+
+func (r *attributedRecord) SetCreatedBy(caller string)      { r.CreatedBy = caller }
+func (r *attributedRecord) SetLastModifiedBy(caller string) { r.LastModifiedBy = caller }
+
+func TestResourceRepositoryCaller(t *testing.T) {
+	db, err := gorm.Open(noopDialector{}, &gorm.Config{DryRun: true})
+	require.NoError(t, err)
+	repo := sqlrepo.NewRepository(db)
+	ctx := repository.WithCaller(t.Context(), "tenant-manager")
+
+	t.Run("create records the caller as creator and last modifier", func(t *testing.T) {
+		// given
+		record := &attributedRecord{ID: "id"}
+
+		// when
+		err := repo.Create(ctx, record)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "tenant-manager", record.CreatedBy)
+		assert.Equal(t, "tenant-manager", record.LastModifiedBy)
+	})
+
+	t.Run("patch records the caller as last modifier", func(t *testing.T) {
+		// given
+		record := &attributedRecord{ID: "id", Name: "name", CreatedBy: "system-manager"}
+
+		// when
+		_, err := repo.Patch(ctx, record)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "system-manager", record.CreatedBy)
+		assert.Equal(t, "tenant-manager", record.LastModifiedBy)
+	})
+
+	t.Run("patch without caller keeps the last modifier", func(t *testing.T) {
+		// given
+		record := &attributedRecord{ID: "id", Name: "name", LastModifiedBy: "system-manager"}
+
+		// when
+		_, err := repo.Patch(t.Context(), record)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "system-manager", record.LastModifiedBy)
+	})
+}
+
+func TestPaginationIndexStatement(t *testing.T) {
+	// when
+	statement := sqlrepo.PaginationIndexStatement(&attributedRecord{})
+
+	// then
+	assert.Equal(t, "CREATE INDEX IF NOT EXISTS records_keyset_idx ON records (created_at DESC, id DESC)", statement)
+}
+
+func TestHandlePagination(t *testing.T) {
+	t.Run("orders by the qualified keyset", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+		query := repository.NewQuery(&testRecord{})
+		require.NoError(t, query.ApplyPagination(10, ""))
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.ApplyQuery(tx.Model(&[]testRecord{}), *query)
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "ORDER BY records.created_at DESC, records.id DESC")
+		assert.NotContains(t, result, "<")
+	})
+
+	t.Run("selects the resources after the last resource of the page token", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+		token, err := repository.PageInfo{
+			LastCreatedAt: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			LastKey:       testRecord{ID: "last"}.PaginationKey(),
+		}.Encode()
+		require.NoError(t, err)
+		query := repository.NewQuery(&testRecord{})
+		require.NoError(t, query.ApplyPagination(10, token))
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.ApplyQuery(tx.Model(&[]testRecord{}), *query)
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "(records.created_at, records.id) < (?, ?)")
+	})
+
+	t.Run("orders ascending by the fields instead of the keyset", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+		query := repository.NewQuery(&testRecord{}).OrderAscending(repository.IDField).SetLimit(1)
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.ApplyQuery(tx.Model(&[]testRecord{}), *query)
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "ORDER BY records.id ASC LIMIT ?")
+		assert.NotContains(t, result, "DESC")
+	})
+}
+
+func TestApplyAggregate(t *testing.T) {
+	type count struct {
+		Region string
+		Count  int64
+	}
+
+	t.Run("counts the records per group", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+		query := repository.NewQuery(&testRecord{}).Where(repository.NewCompositeKey().Where(repository.StatusField, "active"))
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.ApplyAggregate(tx.Model(&testRecord{}), *query, []repository.QueryField{repository.RegionField})
+			require.NoError(t, err)
+			return tx.Find(&[]count{})
+		})
+
+		// then
+		assert.Contains(t, result, "SELECT region, count(*) AS count FROM records")
+		assert.Contains(t, result, "status = ")
+		assert.Contains(t, result, "GROUP BY region")
+	})
+
+	t.Run("counts all records without groups", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+		query := repository.NewQuery(&testRecord{})
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.ApplyAggregate(tx.Model(&testRecord{}), *query, nil)
+			require.NoError(t, err)
+			return tx.Find(&[]count{})
+		})
+
+		// then
+		assert.Contains(t, result, "SELECT count(*) AS count FROM records")
+		assert.NotContains(t, result, "GROUP BY")
+	})
+}
+
+type testParent struct{ ID string }
+
+func (testParent) TableName() string { return "parents" }
+
+func (p testParent) PaginationKey() map[repository.QueryField]any {
+	return map[repository.QueryField]any{repository.IDField: p.ID}
+}
+
+func TestApplyMissing(t *testing.T) {
+	t.Run("matches the records without a matching record", func(t *testing.T) {
+		// given
+		db := newTestDB(t)
+		query := repository.NewQuery(&testRecord{})
+		query.Missing = []repository.Missing{{
+			Resource:  &testParent{},
+			OnColumns: []repository.QueryField{repository.IDField, repository.TypeField},
+			Columns:   []repository.QueryField{"parent_id", repository.TypeField},
+		}}
+
+		// when
+		result := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+			tx, err := sqlrepo.ApplyQuery(tx.Model(&testRecord{}), *query)
+			require.NoError(t, err)
+			return tx.Find(&[]testRecord{})
+		})
+
+		// then
+		assert.Contains(t, result, "NOT EXISTS (SELECT 1 FROM parents WHERE parents.id = records.parent_id AND parents.type = records.type)")
+	})
 }
@@ -0,0 +1,101 @@
+package sql
+
+import (
+	"context"
+	"regexp"
+	"time"
+
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+
+	slogctx "github.com/veqryn/slog-context"
+)
+
+const unknownMethod = "unknown"
+
+var (
+	stringLiteralPattern  = regexp.MustCompile(`'(?:[^']|'')*'`)
+	numericLiteralPattern = regexp.MustCompile(`\b\d+(?:\.\d+)?\b`)
+)
+
+// SlowOperationHandler is called for every repository operation exceeding the threshold.
+type SlowOperationHandler func(ctx context.Context, method string)
+
+// SlowOperationLogger wraps a GORM logger and additionally logs repository operations
+// exceeding the threshold together with the gRPC method that caused them.
+type SlowOperationLogger struct {
+	logger.Interface
+
+	threshold time.Duration
+	onSlow    SlowOperationHandler
+}
+
+var _ logger.Interface = &SlowOperationLogger{}
+
+// NewSlowOperationLogger creates and returns a new instance of SlowOperationLogger.
+func NewSlowOperationLogger(base logger.Interface, threshold time.Duration, onSlow SlowOperationHandler) *SlowOperationLogger {
+	return &SlowOperationLogger{
+		Interface: base,
+		threshold: threshold,
+		onSlow:    onSlow,
+	}
+}
+
+// EnableSlowOperationLog replaces the logger of the DB with a SlowOperationLogger.
+// A zero threshold leaves the DB unchanged.
+func EnableSlowOperationLog(db *gorm.DB, threshold time.Duration, onSlow SlowOperationHandler) {
+	if threshold <= 0 {
+		return
+	}
+
+	db.Logger = NewSlowOperationLogger(db.Logger, threshold, onSlow)
+}
+
+// LogMode sets the log level of the wrapped logger.
+func (l *SlowOperationLogger) LogMode(level logger.LogLevel) logger.Interface {
+	return NewSlowOperationLogger(l.Interface.LogMode(level), l.threshold, l.onSlow)
+}
+
+// Trace forwards the operation to the wrapped logger and logs it as slow if it exceeded the threshold.
+// Literal values are redacted from the logged SQL.
+func (l *SlowOperationLogger) Trace(ctx context.Context, begin time.Time, fc func() (sql string, rowsAffected int64), err error) {
+	l.Interface.Trace(ctx, begin, fc, err)
+
+	elapsed := time.Since(begin)
+	if elapsed < l.threshold {
+		return
+	}
+
+	// the calling method and request identifiers are expected to be part of the context logger
+	sql, rows := fc()
+	slogctx.Warn(ctx, "slow repository operation",
+		"sql", redactSQL(sql),
+		"rowsAffected", rows,
+		"duration", elapsed,
+		"error", err,
+	)
+
+	if l.onSlow != nil {
+		method, ok := grpc.Method(ctx)
+		if !ok {
+			method = unknownMethod
+		}
+		l.onSlow(ctx, method)
+	}
+}
+
+// ParamsFilter forwards to the wrapped logger if it filters parameters.
+func (l *SlowOperationLogger) ParamsFilter(ctx context.Context, sql string, params ...any) (string, []any) {
+	if filter, ok := l.Interface.(gorm.ParamsFilter); ok {
+		return filter.ParamsFilter(ctx, sql, params...)
+	}
+
+	return sql, params
+}
+
+// redactSQL replaces string and numeric literals in the SQL statement.
+func redactSQL(sql string) string {
+	sql = stringLiteralPattern.ReplaceAllString(sql, "'?'")
+	return numericLiteralPattern.ReplaceAllString(sql, "?")
+}
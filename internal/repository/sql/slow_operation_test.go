@@ -0,0 +1,60 @@
+package sql_test
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm/logger"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	sqlrepo "github.com/openkcm/registry/internal/repository/sql"
+)
+
+func TestSlowOperationLogger(t *testing.T) {
+	query := func() (string, int64) {
+		return "SELECT * FROM tenants WHERE id = 'tenant-1' AND region = 'eu01' LIMIT 101", 1
+	}
+
+	t.Run("should log and count operations exceeding the threshold", func(t *testing.T) {
+		// given
+		var buf bytes.Buffer
+		ctx := slogctx.NewCtx(context.Background(), slog.New(slog.NewJSONHandler(&buf, nil)))
+
+		var slowMethods []string
+		l := sqlrepo.NewSlowOperationLogger(logger.Discard, time.Second, func(_ context.Context, method string) {
+			slowMethods = append(slowMethods, method)
+		})
+
+		// when
+		l.Trace(ctx, time.Now().Add(-2*time.Second), query, nil)
+
+		// then
+		assert.Equal(t, []string{"unknown"}, slowMethods)
+		assert.Contains(t, buf.String(), "slow repository operation")
+		assert.Contains(t, buf.String(), "SELECT * FROM tenants WHERE id = '?' AND region = '?' LIMIT ?")
+		assert.NotContains(t, buf.String(), "tenant-1")
+	})
+
+	t.Run("should ignore operations below the threshold", func(t *testing.T) {
+		// given
+		var buf bytes.Buffer
+		ctx := slogctx.NewCtx(context.Background(), slog.New(slog.NewJSONHandler(&buf, nil)))
+
+		called := false
+		l := sqlrepo.NewSlowOperationLogger(logger.Discard, time.Minute, func(context.Context, string) {
+			called = true
+		})
+
+		// when
+		l.Trace(ctx, time.Now(), query, nil)
+
+		// then
+		assert.False(t, called)
+		assert.Empty(t, buf.String())
+	})
+}
@@ -0,0 +1,73 @@
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// statusClock guards the status times taken from the database clock.
+type statusClock struct {
+	maxSkew time.Duration
+}
+
+// EnableStatusClock rejects the status changes of StatusClocked resources with repository.ErrStatusTimeRegression
+// if their stored status time is ahead of the database clock by more than the maximum skew of the configuration.
+// The status times are taken from the database clock whether the guard is enabled or not.
+func (r *ResourceRepository) EnableStatusClock(cfg config.StatusClock) error {
+	if err := cfg.Validate(); err != nil {
+		return err
+	}
+
+	if cfg.Enabled {
+		r.clock = &statusClock{maxSkew: cfg.MaxSkew}
+	}
+
+	return nil
+}
+
+// statusChanged returns true if the resource is StatusClocked and its status changed.
+func statusChanged(resource repository.Resource) bool {
+	clocked, ok := resource.(repository.StatusClocked)
+	return ok && clocked.StatusChanged()
+}
+
+// stampStatusTime sets the status time of the patched resource, if its status changed, to the time of the
+// database clock, which is the start of the transaction. The status time is kept if it is ahead,
+// so it never goes back, unless it is ahead by more than the maximum skew of the guard.
+func (r ResourceRepository) stampStatusTime(ctx context.Context, resource repository.Resource) error {
+	clocked, ok := resource.(repository.StatusClocked)
+	if !ok || !clocked.StatusChanged() {
+		return nil
+	}
+
+	column := clocked.StatusTimeColumn()
+	db := r.conn(ctx).Model(resource).Clauses(clause.Returning{Columns: []clause.Column{{Name: column}}})
+	if r.clock != nil {
+		db = db.Where(fmt.Sprintf("(%s IS NULL OR %s <= now() + ?::interval)", column, column), r.clock.interval())
+	}
+
+	db = db.UpdateColumn(column, gorm.Expr(fmt.Sprintf("GREATEST(now(), %s)", column)))
+	if db.Error != nil {
+		return fmt.Errorf("failed to set the status time of %s: %w", resource.TableName(), db.Error)
+	}
+
+	if db.RowsAffected == 0 {
+		return repository.ErrStatusTimeRegression
+	}
+
+	clocked.ClearStatusChanged()
+
+	return nil
+}
+
+// interval returns the maximum skew as PostgreSQL interval.
+func (c *statusClock) interval() string {
+	return fmt.Sprintf("%d microseconds", c.maxSkew.Microseconds())
+}
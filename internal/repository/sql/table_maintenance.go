@@ -0,0 +1,132 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Estimate of the size of the btree indexes without bloat: each entry takes its key plus the tuple header
+// and the line pointer, and the pages are filled up to the default fill factor.
+const (
+	indexTupleOverhead = 16
+	indexFillFactor    = 0.9
+)
+
+var ErrUnsupportedTableOperation = errors.New("table operation is not supported")
+
+var _ repository.TableMaintainer = ResourceRepository{}
+
+// tableStatsQuery selects the rows and the sizes of the tables from the statistics collector.
+const tableStatsQuery = `
+SELECT s.relname AS table_name,
+	s.n_live_tup AS live_tuples,
+	s.n_dead_tup AS dead_tuples,
+	pg_table_size(s.relid) AS table_bytes,
+	pg_indexes_size(s.relid) AS index_bytes,
+	GREATEST(s.last_vacuum, s.last_autovacuum) AS last_vacuum
+FROM pg_stat_user_tables s
+WHERE s.schemaname = current_schema() AND s.relname IN ?`
+
+// indexBloatQuery estimates the bloat of the indexes of the tables by their size exceeding the size expected
+// for the live rows, with the width of the keys taken from the column statistics of the planner.
+const indexBloatQuery = `
+SELECT x.table_name, SUM(GREATEST(x.index_bytes - x.expected_bytes, 0))::bigint AS index_bloat_bytes
+FROM (
+	SELECT t.relname AS table_name,
+		pg_relation_size(i.oid) AS index_bytes,
+		GREATEST(i.reltuples, 0) * (? + COALESCE(SUM(st.avg_width), 0)) / ? + current_setting('block_size')::int AS expected_bytes
+	FROM pg_index ix
+	JOIN pg_class i ON i.oid = ix.indexrelid
+	JOIN pg_class t ON t.oid = ix.indrelid
+	JOIN pg_namespace n ON n.oid = t.relnamespace
+	LEFT JOIN pg_attribute a ON a.attrelid = t.oid AND a.attnum = ANY(ix.indkey)
+	LEFT JOIN pg_stats st ON st.schemaname = n.nspname AND st.tablename = t.relname AND st.attname = a.attname
+	WHERE n.nspname = current_schema() AND t.relname IN ?
+	GROUP BY t.relname, i.oid, i.reltuples
+) x
+GROUP BY x.table_name`
+
+// TableBloat returns the bloat of the tables of the current schema from the pg_stat_user_tables view.
+// The bloat of the indexes is estimated, it is accurate only once the tables are analyzed.
+func (r ResourceRepository) TableBloat(ctx context.Context, tables []string) ([]repository.TableBloat, error) {
+	if len(tables) == 0 {
+		return nil, nil
+	}
+
+	var stats []struct {
+		repository.TableBloat
+
+		TableName string
+	}
+	if err := r.db.WithContext(ctx).Raw(tableStatsQuery, tables).Scan(&stats).Error; err != nil {
+		return nil, fmt.Errorf("failed to select table statistics: %w", err)
+	}
+
+	var indexBloat []struct {
+		TableName       string
+		IndexBloatBytes int64
+	}
+	if err := r.db.WithContext(ctx).Raw(indexBloatQuery, indexTupleOverhead, indexFillFactor, tables).Scan(&indexBloat).Error; err != nil {
+		return nil, fmt.Errorf("failed to estimate index bloat: %w", err)
+	}
+
+	bloatByTable := make(map[string]int64, len(indexBloat))
+	for _, bloat := range indexBloat {
+		bloatByTable[bloat.TableName] = bloat.IndexBloatBytes
+	}
+
+	result := make([]repository.TableBloat, 0, len(stats))
+	for _, stat := range stats {
+		bloat := stat.TableBloat
+		bloat.Table = stat.TableName
+		bloat.IndexBloatBytes = min(bloatByTable[stat.TableName], bloat.IndexBytes)
+		result = append(result, bloat)
+	}
+
+	return result, nil
+}
+
+// MaintainTable runs the operation on the table while holding a session-level advisory lock of the table,
+// so a table is maintained by one instance at a time. Vacuums analyze the table as well and indexes are
+// rebuilt concurrently, so neither blocks reads or writes of the table. Neither can run within a transaction.
+func (r ResourceRepository) MaintainTable(ctx context.Context, table string, operation repository.TableOperation) (bool, error) {
+	var statement string
+	switch operation {
+	case repository.TableOperationVacuum:
+		statement = "VACUUM (ANALYZE) ?"
+	case repository.TableOperationReindex:
+		statement = "REINDEX TABLE CONCURRENTLY ?"
+	default:
+		return false, fmt.Errorf("%w: %s", ErrUnsupportedTableOperation, operation)
+	}
+
+	lockKey := "table-maintenance/" + table
+
+	var locked bool
+	err := r.db.WithContext(ctx).Connection(func(conn *gorm.DB) error {
+		if err := conn.Raw("SELECT pg_try_advisory_lock(?, hashtext(?))", advisoryLockNamespace, lockKey).Scan(&locked).Error; err != nil {
+			return err
+		}
+		if !locked {
+			return nil
+		}
+
+		defer func() {
+			// the lock is released even if the operation was canceled, as the connection returns to the pool
+			conn.WithContext(context.WithoutCancel(ctx)).Exec("SELECT pg_advisory_unlock(?, hashtext(?))", advisoryLockNamespace, lockKey)
+		}()
+
+		return conn.Exec(statement, clause.Table{Name: table}).Error
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to %s table %s: %w", operation, table, err)
+	}
+
+	return locked, nil
+}
@@ -0,0 +1,80 @@
+package sql
+
+import (
+	"context"
+	"path"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode"
+
+	"google.golang.org/grpc"
+)
+
+// TransactionHandler is called for every transaction of the repository with the operation it belongs to,
+// its duration including retries, and its error, which is nil if it was committed.
+type TransactionHandler func(ctx context.Context, operation string, elapsed time.Duration, err error)
+
+// transactionMetrics measures the transactions of the repository and counts the open ones.
+type transactionMetrics struct {
+	onDone TransactionHandler
+	open   atomic.Int64
+}
+
+// EnableTransactionMetrics calls onDone for every transaction of the repository once it ends
+// and counts the open transactions, see OpenTransactions. Transactions nested within a transaction
+// are measured as part of the outermost transaction.
+func (r *ResourceRepository) EnableTransactionMetrics(onDone TransactionHandler) {
+	r.metrics = &transactionMetrics{onDone: onDone}
+}
+
+// OpenTransactions returns the number of transactions in progress, which is zero unless metrics are enabled.
+func (r *ResourceRepository) OpenTransactions() int64 {
+	if r.metrics == nil {
+		return 0
+	}
+
+	return r.metrics.open.Load()
+}
+
+// measure runs the transaction and reports its duration to the handler.
+func (m *transactionMetrics) measure(ctx context.Context, tx func(ctx context.Context) error) error {
+	m.open.Add(1)
+	begin := time.Now()
+
+	err := tx(ctx)
+
+	m.open.Add(-1)
+	m.onDone(ctx, transactionOperation(ctx), time.Since(begin), err)
+
+	return err
+}
+
+// transactionOperation returns the operation of the transaction, which is the gRPC method causing it
+// in snake case, e.g. register_tenant for RegisterTenant.
+func transactionOperation(ctx context.Context) string {
+	method, ok := grpc.Method(ctx)
+	if !ok {
+		return unknownMethod
+	}
+
+	name := []rune(path.Base(method))
+
+	var sb strings.Builder
+	for i, r := range name {
+		// words start at an upper case letter following a lower case one, or preceding one in an acronym
+		// unless it is the plural of the acronym, e.g. list_systems_by_ids
+		if i > 0 && unicode.IsUpper(r) &&
+			(!unicode.IsUpper(name[i-1]) || (i+1 < len(name) && unicode.IsLower(name[i+1]) && !isAcronymPlural(name, i+1))) {
+			sb.WriteByte('_')
+		}
+		sb.WriteRune(unicode.ToLower(r))
+	}
+
+	return sb.String()
+}
+
+// isAcronymPlural reports whether the rune at i is the trailing s of an acronym, e.g. in ListSystemsByIDs.
+func isAcronymPlural(name []rune, i int) bool {
+	return name[i] == 's' && (i+1 == len(name) || unicode.IsUpper(name[i+1]))
+}
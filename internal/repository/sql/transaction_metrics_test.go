@@ -0,0 +1,82 @@
+package sql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/openkcm/registry/internal/repository"
+	sqlrepo "github.com/openkcm/registry/internal/repository/sql"
+)
+
+// methodStream is the transport stream of a call of the method.
+type methodStream struct {
+	grpc.ServerTransportStream
+
+	method string
+}
+
+func (s *methodStream) Method() string {
+	return s.method
+}
+
+func TestTransactionMetrics(t *testing.T) {
+	errTx := errors.New("transaction failed")
+
+	t.Run("should report the transaction once it ends", func(t *testing.T) {
+		// given
+		repo, pool := newRecordingRepository(t)
+
+		var operations []string
+		var errs []error
+		repo.EnableTransactionMetrics(func(_ context.Context, operation string, _ time.Duration, err error) {
+			operations = append(operations, operation)
+			errs = append(errs, err)
+		})
+
+		var open int64
+
+		// when
+		err := repo.Transaction(t.Context(), func(context.Context, repository.Repository) error {
+			open = repo.OpenTransactions()
+			return errTx
+		})
+
+		// then
+		require.ErrorIs(t, err, errTx)
+		assert.Equal(t, []string{"BEGIN", "ROLLBACK"}, pool.statements)
+		assert.Equal(t, int64(1), open)
+		assert.Equal(t, int64(0), repo.OpenTransactions())
+		assert.Equal(t, []string{"unknown"}, operations)
+		assert.Equal(t, []error{err}, errs)
+	})
+}
+
+func TestTransactionOperation(t *testing.T) {
+	tests := []struct {
+		method       string
+		expOperation string
+	}{
+		{method: "/kms.api.cmk.registry.tenant.v1.Service/RegisterTenant", expOperation: "register_tenant"},
+		{method: "/kms.api.cmk.registry.system.v1.Service/UpdateSystemL1KeyClaim", expOperation: "update_system_l1_key_claim"},
+		{method: "/kms.api.cmk.registry.admin.v1.Service/ListSystemsByIDs", expOperation: "list_systems_by_ids"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.method, func(t *testing.T) {
+			// given
+			ctx := grpc.NewContextWithServerTransportStream(t.Context(), &methodStream{method: tt.method})
+
+			// when
+			operation := sqlrepo.TransactionOperation(ctx)
+
+			// then
+			assert.Equal(t, tt.expOperation, operation)
+		})
+	}
+}
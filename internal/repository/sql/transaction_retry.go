@@ -0,0 +1,182 @@
+package sql
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"google.golang.org/grpc"
+	"gorm.io/gorm"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+const retryableErrorCallback = "registry:retryable_error"
+
+// retryableErrCodes are the SQLSTATEs of errors which succeed if the transaction is run again,
+// see https://www.postgresql.org/docs/14/errcodes-appendix.html
+var retryableErrCodes = map[string]struct{}{
+	"40001": {}, // serialization_failure
+	"40P01": {}, // deadlock_detected
+}
+
+// TransactionRetryHandler is called for every retry of a transaction caused by the given gRPC method.
+type TransactionRetryHandler func(ctx context.Context, method string)
+
+// transactionRetry retries transactions failing with a retryable error with a jittered exponential backoff.
+type transactionRetry struct {
+	conf    config.TransactionRetry
+	onRetry TransactionRetryHandler
+	sleep   func(ctx context.Context, d time.Duration) error
+}
+
+// transactionAttempt records whether a statement of an attempt of a transaction failed with a retryable error.
+// The error is recorded by a callback, as the transaction function may replace it, e.g. by a gRPC status.
+// outsideEffect records whether the attempt had an effect outside of the transaction, see repository.RecordOutsideEffect.
+type transactionAttempt struct {
+	retryable     bool
+	outsideEffect bool
+}
+
+type transactionAttemptKey struct{}
+
+// EnableTransactionRetry retries the transactions of the repository failing with a serialization failure
+// or a deadlock up to the configured attempts. Transactions nested within a transaction are not retried
+// on their own, the outermost transaction is retried as a whole.
+func (r *ResourceRepository) EnableTransactionRetry(conf config.TransactionRetry, onRetry TransactionRetryHandler) error {
+	if conf.MaxAttempts <= 1 {
+		return nil
+	}
+
+	dbs := []*gorm.DB{r.db}
+	for _, db := range r.pools {
+		dbs = append(dbs, db)
+	}
+
+	for _, db := range dbs {
+		if err := registerRetryableErrorCallbacks(db); err != nil {
+			return err
+		}
+	}
+
+	r.retry = &transactionRetry{
+		conf:    conf,
+		onRetry: onRetry,
+		sleep:   sleep,
+	}
+
+	return nil
+}
+
+// registerRetryableErrorCallbacks records retryable errors of the statements of the DB in the attempt
+// of the context. The callbacks are registered once per DB, pools may share the DB.
+func registerRetryableErrorCallbacks(db *gorm.DB) error {
+	callbacks := db.Callback()
+	if callbacks.Query().Get(retryableErrorCallback) != nil {
+		return nil
+	}
+
+	for _, processor := range []interface {
+		Register(name string, fn func(*gorm.DB)) error
+	}{
+		callbacks.Create(),
+		callbacks.Query(),
+		callbacks.Update(),
+		callbacks.Delete(),
+		callbacks.Row(),
+		callbacks.Raw(),
+	} {
+		if err := processor.Register(retryableErrorCallback, recordRetryableError); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func recordRetryableError(db *gorm.DB) {
+	if db.Error == nil || db.Statement.Context == nil {
+		return
+	}
+
+	attempt, ok := db.Statement.Context.Value(transactionAttemptKey{}).(*transactionAttempt)
+	if ok && isRetryableError(db.Error) {
+		attempt.retryable = true
+	}
+}
+
+// run runs the transaction until it succeeds, fails with an error which is not retryable,
+// or the attempts are exhausted. An attempt with an effect outside of the transaction, e.g. a prepared orbital job,
+// is not retried, as the effect is not rolled back and would happen again.
+func (t *transactionRetry) run(ctx context.Context, tx func(ctx context.Context) error) error {
+	for attempt := 1; ; attempt++ {
+		state := &transactionAttempt{}
+		attemptCtx := repository.WithOutsideEffects(context.WithValue(ctx, transactionAttemptKey{}, state), &state.outsideEffect)
+		err := tx(attemptCtx)
+		if err == nil || attempt >= t.conf.MaxAttempts || !(state.retryable || isRetryableError(err)) {
+			return err
+		}
+
+		if state.outsideEffect {
+			slogctx.Warn(ctx, "not retrying transaction with effects outside of it", "attempt", attempt, "error", err)
+			return err
+		}
+
+		backoff := t.backoff(attempt)
+		slogctx.Warn(ctx, "retrying transaction", "attempt", attempt, "backoff", backoff, "error", err)
+
+		if t.onRetry != nil {
+			method, ok := grpc.Method(ctx)
+			if !ok {
+				method = unknownMethod
+			}
+			t.onRetry(ctx, method)
+		}
+
+		if sleepErr := t.sleep(ctx, backoff); sleepErr != nil {
+			return err
+		}
+	}
+}
+
+// backoff returns the jittered backoff before the retry following the attempt.
+// The backoff doubles with each attempt up to the maximum, half of it is random.
+func (t *transactionRetry) backoff(attempt int) time.Duration {
+	backoff := t.conf.InitialBackoff << (attempt - 1)
+	if backoff > t.conf.MaxBackoff || backoff <= 0 {
+		backoff = t.conf.MaxBackoff
+	}
+
+	if backoff < 2 {
+		return backoff
+	}
+
+	return backoff/2 + rand.N(backoff/2)
+}
+
+func isRetryableError(err error) bool {
+	var pgError *pgconn.PgError
+	if !errors.As(err, &pgError) {
+		return false
+	}
+
+	_, ok := retryableErrCodes[pgError.Code]
+	return ok
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
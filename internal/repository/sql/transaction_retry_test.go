@@ -0,0 +1,120 @@
+package sql_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	sqlrepo "github.com/openkcm/registry/internal/repository/sql"
+)
+
+var errStatus = errors.New("failed to update system")
+
+func TestTransactionRetry(t *testing.T) {
+	conf := config.TransactionRetry{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: 10 * time.Millisecond}
+
+	t.Run("should retry a serialization failure until it succeeds", func(t *testing.T) {
+		// given
+		var retries []string
+		attempts := 0
+
+		// when
+		err := sqlrepo.RunTransactionRetry(t.Context(), conf, func(_ context.Context, method string) {
+			retries = append(retries, method)
+		}, func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return &pgconn.PgError{Code: "40001"}
+			}
+			return nil
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+		assert.Equal(t, []string{"unknown", "unknown"}, retries)
+	})
+
+	t.Run("should retry a deadlock replaced by the transaction function", func(t *testing.T) {
+		// given
+		attempts := 0
+
+		// when
+		err := sqlrepo.RunTransactionRetry(t.Context(), conf, nil, func(ctx context.Context) error {
+			attempts++
+			// the statement fails with a deadlock, the transaction function returns its own error
+			sqlrepo.RecordRetryableError(&gorm.DB{
+				Config:    &gorm.Config{},
+				Statement: &gorm.Statement{Context: ctx},
+				Error:     &pgconn.PgError{Code: "40P01"},
+			})
+			return errStatus
+		})
+
+		// then
+		assert.ErrorIs(t, err, errStatus)
+		assert.Equal(t, conf.MaxAttempts, attempts)
+	})
+
+	t.Run("should not retry a serialization failure after a job was prepared", func(t *testing.T) {
+		// given
+		attempts := 0
+		var jobs []string
+
+		// when
+		err := sqlrepo.RunTransactionRetry(t.Context(), conf, nil, func(ctx context.Context) error {
+			attempts++
+			// the job is prepared by orbital outside of the transaction, which fails afterwards
+			jobs = append(jobs, "job")
+			repository.RecordOutsideEffect(ctx)
+			return &pgconn.PgError{Code: "40001"}
+		})
+
+		// then
+		assert.Error(t, err)
+		assert.Equal(t, 1, attempts)
+		assert.Len(t, jobs, 1)
+	})
+
+	t.Run("should not retry other errors", func(t *testing.T) {
+		// given
+		attempts := 0
+
+		// when
+		err := sqlrepo.RunTransactionRetry(t.Context(), conf, nil, func(context.Context) error {
+			attempts++
+			return &pgconn.PgError{Code: "23505"}
+		})
+
+		// then
+		assert.Error(t, err)
+		assert.Equal(t, 1, attempts)
+	})
+}
+
+func TestTransactionRetryBackoff(t *testing.T) {
+	// given
+	conf := config.TransactionRetry{MaxAttempts: 10, InitialBackoff: 20 * time.Millisecond, MaxBackoff: 100 * time.Millisecond}
+
+	for attempt, expected := range map[int]time.Duration{
+		1: 20 * time.Millisecond,
+		2: 40 * time.Millisecond,
+		3: 80 * time.Millisecond,
+		4: 100 * time.Millisecond,
+		9: 100 * time.Millisecond,
+	} {
+		// when
+		backoff := sqlrepo.TransactionRetryBackoff(conf, attempt)
+
+		// then
+		assert.GreaterOrEqual(t, backoff, expected/2, "attempt %d", attempt)
+		assert.Less(t, backoff, expected, "attempt %d", attempt)
+	}
+}
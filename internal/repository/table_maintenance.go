@@ -0,0 +1,57 @@
+package repository
+
+import (
+	"context"
+	"time"
+)
+
+// TableOperation is a maintenance operation of a table.
+type TableOperation string
+
+const (
+	// TableOperationVacuum reclaims the space of the dead rows of a table and updates its planner statistics.
+	TableOperationVacuum TableOperation = "vacuum"
+	// TableOperationReindex rebuilds the indexes of a table without blocking writes to it.
+	TableOperationReindex TableOperation = "reindex"
+)
+
+// TableBloat is the bloat of a table and its indexes, as estimated from the statistics of the database.
+type TableBloat struct {
+	Table      string
+	LiveTuples int64
+	DeadTuples int64
+	TableBytes int64
+	IndexBytes int64
+	// IndexBloatBytes is the size of the indexes exceeding their size as estimated from the live rows.
+	IndexBloatBytes int64
+	// LastVacuum is the last vacuum of the table, manual or automatic, nil if it was never vacuumed.
+	LastVacuum *time.Time
+}
+
+// DeadTupleRatio returns the share of the dead rows of all rows of the table.
+func (b TableBloat) DeadTupleRatio() float64 {
+	total := b.LiveTuples + b.DeadTuples
+	if total == 0 {
+		return 0
+	}
+
+	return float64(b.DeadTuples) / float64(total)
+}
+
+// IndexBloatRatio returns the share of the size of the indexes of the table which is bloat.
+func (b TableBloat) IndexBloatRatio() float64 {
+	if b.IndexBytes == 0 {
+		return 0
+	}
+
+	return float64(b.IndexBloatBytes) / float64(b.IndexBytes)
+}
+
+// TableMaintainer estimates the bloat of tables and runs their maintenance operations.
+type TableMaintainer interface {
+	// TableBloat returns the bloat of the tables, tables which do not exist are skipped.
+	TableBloat(ctx context.Context, tables []string) ([]TableBloat, error)
+	// MaintainTable runs the operation on the table, unless another instance maintains the table already,
+	// then it returns false.
+	MaintainTable(ctx context.Context, table string, operation TableOperation) (bool, error)
+}
@@ -0,0 +1,1252 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	admingrpc "github.com/openkcm/registry/api/admin/v1"
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Admin implements the administrative procedure calls defined in api/admin/v1/admin.proto.
+// Only the configured callers are permitted, as identified by the CallerIdentity interceptor.
+type Admin struct {
+	admingrpc.UnimplementedServiceServer
+
+	callers  map[string]struct{}
+	services AdminServices
+}
+
+// AdminServices holds the services the administrative procedure calls delegate to.
+type AdminServices struct {
+	Integrity    *Integrity
+	Backfills    *Backfills
+	SystemLinks  *SystemLinkMetrics
+	Destroyer    *TenantDestroyer
+	Systems      *System
+	SystemGroups *SystemGroup
+	Inventory    *Inventory
+	Usage        *RegionUsages
+	Manifests    *Manifests
+	Exports      *TenantExports
+	Discovery    *SystemDiscovery
+	Tenants      *Tenant
+	Operations   *Operations
+	Maintenance  *MaintenanceMode
+	Jobs         *Orbital
+	ExternalIDs  *ExternalIDs
+	Imports      *TenantImports
+	Displays     *SystemDisplays
+	LabelBatches *SystemLabelBatches
+	LogControl   *LogControl
+	Config       *ConfigIntrospection
+}
+
+// NewAdmin creates and returns a new instance of Admin.
+func NewAdmin(cfg config.Admin, services AdminServices) *Admin {
+	callers := make(map[string]struct{}, len(cfg.Callers))
+	for _, caller := range cfg.Callers {
+		callers[caller] = struct{}{}
+	}
+
+	return &Admin{
+		callers:  callers,
+		services: services,
+	}
+}
+
+// VerifyIntegrity runs the integrity checks of the database and repairs the anomalies with a safe fix if requested.
+func (a *Admin) VerifyIntegrity(ctx context.Context, in *admingrpc.VerifyIntegrityRequest) (*admingrpc.VerifyIntegrityResponse, error) {
+	slogctx.Debug(ctx, "VerifyIntegrity called", "fix", in.GetFix())
+
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	findings, err := a.services.Integrity.Verify(ctx, in.GetFix())
+	if err != nil {
+		slogctx.Error(ctx, "failed to verify data integrity", "error", err)
+		return nil, ErrIntegrityVerify
+	}
+
+	resp := &admingrpc.VerifyIntegrityResponse{
+		Findings:   make([]*admingrpc.IntegrityFinding, 0, len(findings)),
+		Unresolved: Unresolved(findings),
+	}
+	for _, finding := range findings {
+		resp.Findings = append(resp.Findings, &admingrpc.IntegrityFinding{
+			Check:       finding.Check,
+			Severity:    string(finding.Severity),
+			Description: finding.Description,
+			Count:       finding.Count,
+			Keys:        finding.Keys,
+			Fixable:     finding.Fixable,
+			Fixed:       finding.Fixed,
+		})
+	}
+
+	return resp, nil
+}
+
+// ListBackfills returns the progress of all backfills which have been started.
+func (a *Admin) ListBackfills(ctx context.Context, _ *admingrpc.ListBackfillsRequest) (*admingrpc.ListBackfillsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	backfills, err := a.services.Backfills.ListBackfills(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.ListBackfillsResponse{
+		Backfills: make([]*admingrpc.Backfill, 0, len(backfills)),
+	}
+	for _, backfill := range backfills {
+		resp.Backfills = append(resp.Backfills, backfillToProto(backfill))
+	}
+
+	return resp, nil
+}
+
+// RecalculateSystemLinkMetrics recalculates the reported system counts by tenant link status.
+func (a *Admin) RecalculateSystemLinkMetrics(ctx context.Context, _ *admingrpc.RecalculateSystemLinkMetricsRequest) (*admingrpc.RecalculateSystemLinkMetricsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	recalculation, err := a.services.SystemLinks.RecalculateSystemLinkMetrics(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.RecalculateSystemLinkMetricsResponse{
+		Counts:         recalculation.Counts,
+		Drift:          recalculation.Drift,
+		RecalculatedAt: timestamppb.New(recalculation.RecalculatedAt),
+	}, nil
+}
+
+// DestroyTenant hard deletes the tenant with everything attached to it, if destroying tenants is enabled.
+func (a *Admin) DestroyTenant(ctx context.Context, in *admingrpc.DestroyTenantRequest) (*admingrpc.DestroyTenantResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	destroyed, err := a.services.Destroyer.DestroyTenant(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.DestroyTenantResponse{
+		Tenant: destroyedResourceToProto(*destroyed),
+	}, nil
+}
+
+// ApproveSystem accepts a regional system registered pending approval.
+func (a *Admin) ApproveSystem(ctx context.Context, in *admingrpc.ApproveSystemRequest) (*admingrpc.ApproveSystemResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.Systems.ApproveSystem(ctx, in.GetExternalId(), in.GetType(), in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.ApproveSystemResponse{Success: true}, nil
+}
+
+// RejectSystem removes a regional system registered pending approval.
+func (a *Admin) RejectSystem(ctx context.Context, in *admingrpc.RejectSystemRequest) (*admingrpc.RejectSystemResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.Systems.RejectSystem(ctx, in.GetExternalId(), in.GetType(), in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.RejectSystemResponse{Success: true}, nil
+}
+
+// CreateSystemGroup creates a group of systems within the namespace of an existing tenant.
+func (a *Admin) CreateSystemGroup(ctx context.Context, in *admingrpc.CreateSystemGroupRequest) (*admingrpc.CreateSystemGroupResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.SystemGroups.CreateSystemGroup(ctx, &model.SystemGroup{
+		Name:     in.GetName(),
+		TenantID: in.GetTenantId(),
+		Members:  systemIdentifiersFromProto(in.GetMembers()),
+		Labels:   in.GetLabels(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.CreateSystemGroupResponse{Success: true}, nil
+}
+
+// GetSystemGroup returns the group identified by its tenant and name.
+func (a *Admin) GetSystemGroup(ctx context.Context, in *admingrpc.GetSystemGroupRequest) (*admingrpc.GetSystemGroupResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	group, err := a.services.SystemGroups.GetSystemGroup(ctx, in.GetTenantId(), in.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.GetSystemGroupResponse{Group: systemGroupToProto(group)}, nil
+}
+
+// ListSystemGroups returns all groups of the tenant.
+func (a *Admin) ListSystemGroups(ctx context.Context, in *admingrpc.ListSystemGroupsRequest) (*admingrpc.ListSystemGroupsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	groups, err := a.services.SystemGroups.ListSystemGroups(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.ListSystemGroupsResponse{
+		Groups: make([]*admingrpc.SystemGroup, 0, len(groups)),
+	}
+	for i := range groups {
+		resp.Groups = append(resp.Groups, systemGroupToProto(&groups[i]))
+	}
+
+	return resp, nil
+}
+
+// UpdateSystemGroup replaces the members and labels of the group.
+func (a *Admin) UpdateSystemGroup(ctx context.Context, in *admingrpc.UpdateSystemGroupRequest) (*admingrpc.UpdateSystemGroupResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.SystemGroups.UpdateSystemGroup(ctx, in.GetTenantId(), in.GetName(), systemIdentifiersFromProto(in.GetMembers()), in.GetLabels())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.UpdateSystemGroupResponse{Success: true}, nil
+}
+
+// DeleteSystemGroup deletes the group, the member systems are not changed.
+func (a *Admin) DeleteSystemGroup(ctx context.Context, in *admingrpc.DeleteSystemGroupRequest) (*admingrpc.DeleteSystemGroupResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.SystemGroups.DeleteSystemGroup(ctx, in.GetTenantId(), in.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.DeleteSystemGroupResponse{Success: true}, nil
+}
+
+// LinkSystemGroup links all member systems of the group to the tenant of the group.
+func (a *Admin) LinkSystemGroup(ctx context.Context, in *admingrpc.LinkSystemGroupRequest) (*admingrpc.LinkSystemGroupResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.SystemGroups.LinkSystemGroup(ctx, in.GetTenantId(), in.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.LinkSystemGroupResponse{Success: true}, nil
+}
+
+// UnlinkSystemGroup unlinks all member systems of the group from the tenant of the group.
+func (a *Admin) UnlinkSystemGroup(ctx context.Context, in *admingrpc.UnlinkSystemGroupRequest) (*admingrpc.UnlinkSystemGroupResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.SystemGroups.UnlinkSystemGroup(ctx, in.GetTenantId(), in.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.UnlinkSystemGroupResponse{Success: true}, nil
+}
+
+// LinkSystemGroupChunked links the member systems of the group in chunks and streams the progress,
+// see SystemGroup.LinkSystemGroupChunked.
+func (a *Admin) LinkSystemGroupChunked(in *admingrpc.SystemGroupChunkRequest, stream grpc.ServerStreamingServer[admingrpc.SystemGroupChunkProgress]) error {
+	if err := a.authorize(stream.Context()); err != nil {
+		return err
+	}
+
+	return a.services.SystemGroups.LinkSystemGroupChunked(&systemGroupChunkServer{stream: stream}, systemGroupChunkRequestFromProto(in))
+}
+
+// UnlinkSystemGroupChunked unlinks the member systems of the group in chunks and streams the progress,
+// see SystemGroup.UnlinkSystemGroupChunked.
+func (a *Admin) UnlinkSystemGroupChunked(in *admingrpc.SystemGroupChunkRequest, stream grpc.ServerStreamingServer[admingrpc.SystemGroupChunkProgress]) error {
+	if err := a.authorize(stream.Context()); err != nil {
+		return err
+	}
+
+	return a.services.SystemGroups.UnlinkSystemGroupChunked(&systemGroupChunkServer{stream: stream}, systemGroupChunkRequestFromProto(in))
+}
+
+func systemGroupChunkRequestFromProto(in *admingrpc.SystemGroupChunkRequest) SystemGroupChunkRequest {
+	return SystemGroupChunkRequest{
+		TenantID:       in.GetTenantId(),
+		Name:           in.GetName(),
+		ChunkSize:      int(in.GetChunkSize()),
+		AbortOnFailure: in.GetAbortOnFailure(),
+	}
+}
+
+// systemGroupChunkServer adapts the gRPC streams of the chunked system group operations to SystemGroupChunkStream.
+type systemGroupChunkServer struct {
+	stream grpc.ServerStreamingServer[admingrpc.SystemGroupChunkProgress]
+}
+
+func (s *systemGroupChunkServer) Context() context.Context {
+	return s.stream.Context()
+}
+
+func (s *systemGroupChunkServer) Send(progress *SystemGroupChunkProgress) error {
+	resp := &admingrpc.SystemGroupChunkProgress{
+		Chunk:     int64(progress.Chunk),
+		Chunks:    int64(progress.Chunks),
+		Succeeded: int64(progress.Succeeded),
+		Failed:    int64(progress.Failed),
+		Failures:  make([]*admingrpc.SystemGroupMemberFailure, 0, len(progress.Failures)),
+		Reverted:  progress.Reverted,
+	}
+	for _, failure := range progress.Failures {
+		st := status.Convert(failure.Err)
+		resp.Failures = append(resp.Failures, &admingrpc.SystemGroupMemberFailure{
+			ExternalId: failure.Member.ExternalID,
+			Type:       failure.Member.Type,
+			Code:       st.Code().String(),
+			Message:    st.Message(),
+		})
+	}
+
+	return s.stream.Send(resp)
+}
+
+// SetSystemGroupLabels sets the labels on all regional systems of all member systems of the group.
+func (a *Admin) SetSystemGroupLabels(ctx context.Context, in *admingrpc.SetSystemGroupLabelsRequest) (*admingrpc.SetSystemGroupLabelsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.SystemGroups.SetSystemGroupLabels(ctx, in.GetTenantId(), in.GetName(), in.GetLabels())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.SetSystemGroupLabelsResponse{Success: true}, nil
+}
+
+// RemoveSystemGroupLabels removes the labels from all regional systems of all member systems of the group.
+func (a *Admin) RemoveSystemGroupLabels(ctx context.Context, in *admingrpc.RemoveSystemGroupLabelsRequest) (*admingrpc.RemoveSystemGroupLabelsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.SystemGroups.RemoveSystemGroupLabels(ctx, in.GetTenantId(), in.GetName(), in.GetLabelKeys())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.RemoveSystemGroupLabelsResponse{Success: true}, nil
+}
+
+// BatchSetSystemLabels sets the labels on all regional systems matching the filter, see SystemLabelBatches.
+func (a *Admin) BatchSetSystemLabels(ctx context.Context, in *admingrpc.BatchSetSystemLabelsRequest) (*admingrpc.BatchSetSystemLabelsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	summary, err := a.services.LabelBatches.BatchSetSystemLabels(ctx, systemLabelFilterFromProto(in.GetFilter()), in.GetLabels(), in.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.BatchSetSystemLabelsResponse{Summary: systemLabelBatchSummaryToProto(summary)}, nil
+}
+
+// BatchRemoveSystemLabels removes the labels from all regional systems matching the filter, see SystemLabelBatches.
+func (a *Admin) BatchRemoveSystemLabels(ctx context.Context, in *admingrpc.BatchRemoveSystemLabelsRequest) (*admingrpc.BatchRemoveSystemLabelsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	summary, err := a.services.LabelBatches.BatchRemoveSystemLabels(ctx, systemLabelFilterFromProto(in.GetFilter()), in.GetLabelKeys(), in.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.BatchRemoveSystemLabelsResponse{Summary: systemLabelBatchSummaryToProto(summary)}, nil
+}
+
+// GetInventorySnapshots returns the daily inventory snapshots of the date range.
+func (a *Admin) GetInventorySnapshots(ctx context.Context, in *admingrpc.GetInventorySnapshotsRequest) (*admingrpc.GetInventorySnapshotsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	snapshots, err := a.services.Inventory.GetInventorySnapshots(ctx, timeFromProto(in.GetFrom()), timeFromProto(in.GetTo()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.GetInventorySnapshotsResponse{
+		Snapshots: make([]*admingrpc.InventorySnapshot, 0, len(snapshots)),
+	}
+	for i := range snapshots {
+		resp.Snapshots = append(resp.Snapshots, inventorySnapshotToProto(&snapshots[i]))
+	}
+
+	return resp, nil
+}
+
+// GetRegionUsage returns the usage of a region with its configured soft limits.
+func (a *Admin) GetRegionUsage(ctx context.Context, in *admingrpc.GetRegionUsageRequest) (*admingrpc.GetRegionUsageResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	usage, err := a.services.Usage.GetRegionUsage(ctx, in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.GetRegionUsageResponse{
+		Region:          usage.Region,
+		Tenants:         inventoryCountsToProto(usage.Tenants),
+		Systems:         usage.Systems,
+		RegionalSystems: inventoryCountsToProto(usage.RegionalSystems),
+		L1KeyClaims:     usage.L1KeyClaims,
+		Auths:           usage.Auths,
+		SoftLimits: &admingrpc.RegionSoftLimits{
+			Tenants:         usage.SoftLimits.Tenants,
+			Systems:         usage.SoftLimits.Systems,
+			RegionalSystems: usage.SoftLimits.RegionalSystems,
+			L1KeyClaims:     usage.SoftLimits.L1KeyClaims,
+			Auths:           usage.SoftLimits.Auths,
+		},
+	}, nil
+}
+
+// ResolveExternalId returns the resources known by the external ID.
+func (a *Admin) ResolveExternalId(ctx context.Context, in *admingrpc.ResolveExternalIdRequest) (*admingrpc.ResolveExternalIdResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	resources, err := a.services.ExternalIDs.ResolveExternalID(ctx, in.GetExternalId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.ResolveExternalIdResponse{
+		Resources: make([]*admingrpc.ResolvedResource, 0, len(resources)),
+	}
+	for _, resource := range resources {
+		resp.Resources = append(resp.Resources, &admingrpc.ResolvedResource{
+			Kind: resource.Kind,
+			Id:   resource.ID,
+		})
+	}
+
+	return resp, nil
+}
+
+// ImportTenant creates the tenant migrated from a legacy registry without provisioning it, see TenantImports.ImportTenant.
+func (a *Admin) ImportTenant(ctx context.Context, in *admingrpc.ImportTenantRequest) (*admingrpc.ImportTenantResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	tenant := tenantFromAdminProto(in.GetTenant())
+	tenant.CreatedAt = timeFromProto(in.GetCreatedAt())
+
+	history := make([]model.TenantStatusChange, 0, len(in.GetStatusHistory()))
+	for _, change := range in.GetStatusHistory() {
+		history = append(history, model.TenantStatusChange{
+			Status:    model.TenantStatus(change.GetStatus()),
+			ChangedAt: timeFromProto(change.GetChangedAt()),
+		})
+	}
+
+	if err := a.services.Imports.ImportTenant(ctx, tenant, history, in.GetReason()); err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.ImportTenantResponse{Id: tenant.ID}, nil
+}
+
+// DescribeL1KeyClaim returns the claims of the L1 keys of the system in all its regions, see System.DescribeL1KeyClaim.
+func (a *Admin) DescribeL1KeyClaim(ctx context.Context, in *admingrpc.DescribeL1KeyClaimRequest) (*admingrpc.DescribeL1KeyClaimResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	description, err := a.services.Systems.DescribeL1KeyClaim(ctx, in.GetExternalId(), in.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.DescribeL1KeyClaimResponse{
+		ExternalId: description.ExternalID,
+		Type:       description.Type,
+		TenantId:   description.TenantID,
+		Claims:     make([]*admingrpc.L1KeyClaim, 0, len(description.Claims)),
+	}
+	for _, claim := range description.Claims {
+		pbClaim := &admingrpc.L1KeyClaim{
+			Region:    claim.Region,
+			Claimed:   claim.Claimed,
+			ClaimedBy: claim.ClaimedBy,
+			TenantId:  claim.TenantID,
+			Status:    claim.Status,
+		}
+		if claim.ClaimedAt != nil {
+			pbClaim.ClaimedAt = timestamppb.New(*claim.ClaimedAt)
+		}
+		resp.Claims = append(resp.Claims, pbClaim)
+	}
+
+	return resp, nil
+}
+
+// ApplyManifest reconciles the tenant and its resources with the manifest, or only plans it with dry run.
+func (a *Admin) ApplyManifest(ctx context.Context, in *admingrpc.ApplyManifestRequest) (*admingrpc.ApplyManifestResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	plan, err := a.services.Manifests.ApplyManifest(ctx, manifestFromProto(in.GetManifest()), in.GetDryRun())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.ApplyManifestResponse{
+		Steps:  make([]*admingrpc.PlanStep, 0, len(plan.Steps)),
+		DryRun: plan.DryRun,
+	}
+	for _, step := range plan.Steps {
+		resp.Steps = append(resp.Steps, &admingrpc.PlanStep{
+			Action: step.Action,
+			Target: step.Target,
+		})
+	}
+
+	return resp, nil
+}
+
+// ExportTenantData returns the JSON export of the tenant, or the location it was written to.
+func (a *Admin) ExportTenantData(ctx context.Context, in *admingrpc.ExportTenantDataRequest) (*admingrpc.ExportTenantDataResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	result, err := a.services.Exports.ExportTenantData(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.ExportTenantDataResponse{
+		Data:     result.Data,
+		Location: result.Location,
+	}, nil
+}
+
+// StreamTenantExport streams the export of the tenant in chunks, see TenantExports.StreamTenantExport.
+func (a *Admin) StreamTenantExport(in *admingrpc.StreamTenantExportRequest, stream grpc.ServerStreamingServer[admingrpc.TenantExportChunk]) error {
+	if err := a.authorize(stream.Context()); err != nil {
+		return err
+	}
+
+	return a.services.Exports.StreamTenantExport(&tenantExportServer{stream: stream}, TenantExportStreamRequest{
+		TenantID:    in.GetTenantId(),
+		Compression: in.GetCompression(),
+		ChunkSize:   in.GetChunkSize(),
+		Location:    in.GetLocation(),
+		Offset:      in.GetOffset(),
+	})
+}
+
+// tenantExportServer adapts the gRPC stream of StreamTenantExport to TenantExportStream.
+type tenantExportServer struct {
+	stream grpc.ServerStreamingServer[admingrpc.TenantExportChunk]
+}
+
+func (s *tenantExportServer) Context() context.Context {
+	return s.stream.Context()
+}
+
+func (s *tenantExportServer) Send(chunk *TenantExportChunk) error {
+	return s.stream.Send(&admingrpc.TenantExportChunk{
+		Location:    chunk.Location,
+		Offset:      chunk.Offset,
+		Data:        chunk.Data,
+		Compression: chunk.Compression,
+		Checksum:    chunk.Checksum,
+		Size:        chunk.Size,
+		Last:        chunk.Last,
+	})
+}
+
+// ListDiscoveredSystems returns the regional systems observed by regional operators awaiting confirmation.
+func (a *Admin) ListDiscoveredSystems(ctx context.Context, in *admingrpc.ListDiscoveredSystemsRequest) (*admingrpc.ListDiscoveredSystemsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	systems, err := a.services.Discovery.ListDiscoveredSystems(ctx, in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.ListDiscoveredSystemsResponse{
+		Systems: make([]*admingrpc.DiscoveredSystem, 0, len(systems)),
+	}
+	for _, system := range systems {
+		resp.Systems = append(resp.Systems, discoveredSystemToProto(system))
+	}
+
+	return resp, nil
+}
+
+// ConfirmDiscoveredSystem confirms a discovered regional system, so it can be used like a registered one.
+func (a *Admin) ConfirmDiscoveredSystem(ctx context.Context, in *admingrpc.ConfirmDiscoveredSystemRequest) (*admingrpc.ConfirmDiscoveredSystemResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.Discovery.ConfirmDiscoveredSystem(ctx, in.GetExternalId(), in.GetType(), in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.ConfirmDiscoveredSystemResponse{Success: true}, nil
+}
+
+// DismissDiscoveredSystem removes a discovered regional system, e.g. if it was observed by mistake.
+func (a *Admin) DismissDiscoveredSystem(ctx context.Context, in *admingrpc.DismissDiscoveredSystemRequest) (*admingrpc.DismissDiscoveredSystemResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	err := a.services.Discovery.DismissDiscoveredSystem(ctx, in.GetExternalId(), in.GetType(), in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.DismissDiscoveredSystemResponse{Success: true}, nil
+}
+
+// QueryTenants lists the tenants matching a filter expression, see Tenant.QueryTenants.
+func (a *Admin) QueryTenants(ctx context.Context, in *admingrpc.QueryTenantsRequest) (*admingrpc.QueryTenantsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	tenants, err := a.services.Tenants.QueryTenants(ctx, in.GetFilter(), in.GetLimit(), in.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.QueryTenantsResponse{
+		Tenants:       make([]*admingrpc.Tenant, 0, len(tenants.GetTenants())),
+		NextPageToken: tenants.GetNextPageToken(),
+	}
+	for _, tenant := range tenants.GetTenants() {
+		resp.Tenants = append(resp.Tenants, tenantToAdminProto(tenant))
+	}
+
+	return resp, nil
+}
+
+// QuerySystems lists the regional systems matching a filter expression, see System.QuerySystems.
+func (a *Admin) QuerySystems(ctx context.Context, in *admingrpc.QuerySystemsRequest) (*admingrpc.QuerySystemsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	systems, err := a.services.Systems.QuerySystems(ctx, in.GetFilter(), in.GetLimit(), in.GetPageToken())
+	if err != nil {
+		return nil, err
+	}
+
+	displays, err := a.services.Displays.Displays(ctx, systems.GetSystems())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.QuerySystemsResponse{
+		Systems:       systemsToAdminProto(systems.GetSystems(), displays),
+		NextPageToken: systems.GetNextPageToken(),
+	}, nil
+}
+
+// ListSystemsAsOf returns the systems which were linked to the tenant at the time, reconstructed from the link history.
+func (a *Admin) ListSystemsAsOf(ctx context.Context, in *admingrpc.ListSystemsAsOfRequest) (*admingrpc.ListSystemsAsOfResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	links, err := a.services.Systems.ListSystemsAsOf(ctx, in.GetTenantId(), timeFromProto(in.GetAsOf()))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.ListSystemsAsOfResponse{
+		Links: make([]*admingrpc.SystemLink, 0, len(links)),
+	}
+	for _, link := range links {
+		pbLink := &admingrpc.SystemLink{
+			ExternalId: link.ExternalID,
+			Type:       link.Type,
+			LinkedAt:   timestamppb.New(link.CreatedAt),
+		}
+		if link.UnlinkedAt != nil {
+			pbLink.UnlinkedAt = timestamppb.New(*link.UnlinkedAt)
+		}
+
+		resp.Links = append(resp.Links, pbLink)
+	}
+
+	return resp, nil
+}
+
+// ForceTenantStatus sets the status of the tenant without validating the transition, see Tenant.ForceTenantStatus.
+func (a *Admin) ForceTenantStatus(ctx context.Context, in *admingrpc.ForceTenantStatusRequest) (*admingrpc.ForceTenantStatusResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	previous, err := a.services.Tenants.ForceTenantStatus(ctx, in.GetId(), model.TenantStatus(in.GetStatus()), in.GetReason())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.ForceTenantStatusResponse{PreviousStatus: string(previous)}, nil
+}
+
+// ListUnfinishedJobs returns the orbital jobs which are not done, optionally only those of a job type, oldest first.
+func (a *Admin) ListUnfinishedJobs(ctx context.Context, in *admingrpc.ListUnfinishedJobsRequest) (*admingrpc.ListUnfinishedJobsResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	operations, err := a.services.Operations.ListUnfinishedOperations(ctx, in.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &admingrpc.ListUnfinishedJobsResponse{
+		Jobs: make([]*admingrpc.Job, 0, len(operations)),
+	}
+	for _, operation := range operations {
+		resp.Jobs = append(resp.Jobs, jobToAdminProto(operation))
+	}
+
+	return resp, nil
+}
+
+// GetMaintenanceMode returns whether the registry is in maintenance mode.
+func (a *Admin) GetMaintenanceMode(ctx context.Context, _ *admingrpc.GetMaintenanceModeRequest) (*admingrpc.GetMaintenanceModeResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	mode, enabled, err := a.services.Maintenance.GetMaintenanceMode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.GetMaintenanceModeResponse{MaintenanceMode: maintenanceModeToProto(mode, enabled)}, nil
+}
+
+// SetMaintenanceMode enables or disables the maintenance mode, in which changing requests of the other services are rejected.
+func (a *Admin) SetMaintenanceMode(ctx context.Context, in *admingrpc.SetMaintenanceModeRequest) (*admingrpc.SetMaintenanceModeResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.services.Maintenance.SetMaintenanceMode(ctx, in.GetEnabled(), in.GetReason()); err != nil {
+		return nil, err
+	}
+
+	mode, enabled, err := a.services.Maintenance.GetMaintenanceMode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.SetMaintenanceModeResponse{MaintenanceMode: maintenanceModeToProto(mode, enabled)}, nil
+}
+
+// ReplayJobOutcome runs the handling of the outcome of a finished orbital job again.
+func (a *Admin) ReplayJobOutcome(ctx context.Context, in *admingrpc.ReplayJobOutcomeRequest) (*admingrpc.ReplayJobOutcomeResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	outcome, err := a.services.Jobs.ReplayJobOutcome(ctx, in.GetJobId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.ReplayJobOutcomeResponse{Outcome: jobOutcomeToProto(outcome)}, nil
+}
+
+// authorize returns ErrAdminCallerNotPermitted unless the caller of the request is one of the admin callers.
+// GetLogControl returns the current log level and the sampled gRPC methods of the instance.
+func (a *Admin) GetLogControl(ctx context.Context, _ *admingrpc.GetLogControlRequest) (*admingrpc.GetLogControlResponse, error) {
+	if err := a.authorizeLogControl(ctx); err != nil {
+		return nil, err
+	}
+
+	return &admingrpc.GetLogControlResponse{LogControl: logControlToProto(a.services.LogControl.State())}, nil
+}
+
+// SetLogLevel changes the log level of the instance for a duration, after which the configured level is restored.
+func (a *Admin) SetLogLevel(ctx context.Context, in *admingrpc.SetLogLevelRequest) (*admingrpc.SetLogLevelResponse, error) {
+	slogctx.Debug(ctx, "SetLogLevel called", "level", in.GetLevel(), "duration", in.GetDuration().AsDuration())
+
+	if err := a.authorizeLogControl(ctx); err != nil {
+		return nil, err
+	}
+
+	level, err := ParseLogLevel(in.GetLevel())
+	if err != nil {
+		return nil, ErrorWithParams(ErrLogControlInvalid, "error", err)
+	}
+
+	if err := a.services.LogControl.SetLevel(ctx, level, in.GetDuration().AsDuration()); err != nil {
+		return nil, ErrorWithParams(ErrLogControlInvalid, "error", err)
+	}
+
+	return &admingrpc.SetLogLevelResponse{LogControl: logControlToProto(a.services.LogControl.State())}, nil
+}
+
+// SetLogSampling logs the debug messages of a share of the calls of a gRPC method of the instance for a duration.
+func (a *Admin) SetLogSampling(ctx context.Context, in *admingrpc.SetLogSamplingRequest) (*admingrpc.SetLogSamplingResponse, error) {
+	slogctx.Debug(ctx, "SetLogSampling called", "method", in.GetMethod(), "rate", in.GetRate(), "duration", in.GetDuration().AsDuration())
+
+	if err := a.authorizeLogControl(ctx); err != nil {
+		return nil, err
+	}
+
+	if err := a.services.LogControl.SetSampling(ctx, in.GetMethod(), in.GetRate(), in.GetDuration().AsDuration()); err != nil {
+		return nil, ErrorWithParams(ErrLogControlInvalid, "error", err)
+	}
+
+	return &admingrpc.SetLogSamplingResponse{LogControl: logControlToProto(a.services.LogControl.State())}, nil
+}
+
+// GetEffectiveConfig returns the effective redacted configuration of the instance with the source of each value
+// and the changes of the config files and environment since it was loaded.
+func (a *Admin) GetEffectiveConfig(ctx context.Context, _ *admingrpc.GetEffectiveConfigRequest) (*admingrpc.GetEffectiveConfigResponse, error) {
+	if err := a.authorize(ctx); err != nil {
+		return nil, err
+	}
+
+	if a.services.Config == nil {
+		return nil, ErrConfigIntrospectionDisabled
+	}
+
+	resp, err := effectiveConfigToProto(a.services.Config.EffectiveConfig(ctx))
+	if err != nil {
+		slogctx.Error(ctx, "failed to convert effective config", "error", err)
+		return nil, ErrConfigIntrospection
+	}
+
+	return resp, nil
+}
+
+// authorizeLogControl authorizes the caller and requires the log control to be enabled.
+func (a *Admin) authorizeLogControl(ctx context.Context) error {
+	if err := a.authorize(ctx); err != nil {
+		return err
+	}
+
+	if a.services.LogControl == nil || !a.services.LogControl.Enabled() {
+		return ErrLogControlDisabled
+	}
+
+	return nil
+}
+
+func (a *Admin) authorize(ctx context.Context) error {
+	caller := repository.CallerFromContext(ctx)
+	if _, ok := a.callers[caller]; !ok {
+		slogctx.Warn(ctx, "admin procedure call denied", "caller", caller)
+		return ErrorWithParams(ErrAdminCallerNotPermitted, "caller", caller)
+	}
+
+	return nil
+}
+
+func jobOutcomeToProto(outcome *model.JobOutcome) *admingrpc.JobOutcome {
+	pb := &admingrpc.JobOutcome{
+		JobId:          outcome.JobID,
+		ExternalId:     outcome.ExternalID,
+		Type:           outcome.Type,
+		Outcome:        outcome.Outcome,
+		ErrorMessage:   outcome.ErrorMessage,
+		ReplayCount:    int64(outcome.ReplayCount),
+		LastReplayedBy: outcome.LastReplayedBy,
+		CreatedAt:      timestamppb.New(outcome.CreatedAt),
+	}
+	if outcome.LastReplayedAt != nil {
+		pb.LastReplayedAt = timestamppb.New(*outcome.LastReplayedAt)
+	}
+
+	return pb
+}
+
+func backfillToProto(backfill model.Backfill) *admingrpc.Backfill {
+	pb := &admingrpc.Backfill{
+		Name:      backfill.Name,
+		Cursor:    backfill.Cursor,
+		Processed: backfill.Processed,
+		LastError: backfill.LastError,
+		UpdatedAt: timestamppb.New(backfill.UpdatedAt),
+		CreatedAt: timestamppb.New(backfill.CreatedAt),
+	}
+	if backfill.CompletedAt != nil {
+		pb.CompletedAt = timestamppb.New(*backfill.CompletedAt)
+	}
+
+	return pb
+}
+
+func systemGroupToProto(group *model.SystemGroup) *admingrpc.SystemGroup {
+	members := make([]*admingrpc.SystemIdentifier, 0, len(group.Members))
+	for _, member := range group.Members {
+		members = append(members, &admingrpc.SystemIdentifier{
+			ExternalId: member.ExternalID,
+			Type:       member.Type,
+		})
+	}
+
+	return &admingrpc.SystemGroup{
+		TenantId:  group.TenantID,
+		Name:      group.Name,
+		Members:   members,
+		Labels:    group.Labels,
+		UpdatedAt: timestamppb.New(group.UpdatedAt),
+		CreatedAt: timestamppb.New(group.CreatedAt),
+	}
+}
+
+func systemIdentifiersFromProto(identifiers []*admingrpc.SystemIdentifier) []model.SystemIdentifier {
+	members := make([]model.SystemIdentifier, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		members = append(members, model.SystemIdentifier{
+			ExternalID: identifier.GetExternalId(),
+			Type:       identifier.GetType(),
+		})
+	}
+
+	return members
+}
+
+func systemLabelFilterFromProto(filter *admingrpc.SystemLabelFilter) SystemLabelFilter {
+	return SystemLabelFilter{
+		TenantID: filter.GetTenantId(),
+		Region:   filter.GetRegion(),
+		Type:     filter.GetType(),
+		Labels:   filter.GetLabels(),
+	}
+}
+
+func systemLabelBatchSummaryToProto(summary *SystemLabelBatchSummary) *admingrpc.SystemLabelBatchSummary {
+	return &admingrpc.SystemLabelBatchSummary{
+		Matched:   summary.Matched,
+		Updated:   summary.Updated,
+		Unchanged: summary.Unchanged,
+		Skipped:   summary.Skipped,
+		DryRun:    summary.DryRun,
+	}
+}
+
+func inventorySnapshotToProto(snapshot *model.InventorySnapshot) *admingrpc.InventorySnapshot {
+	return &admingrpc.InventorySnapshot{
+		Date:          timestamppb.New(snapshot.Date),
+		Tenants:       inventoryCountsToProto(snapshot.Tenants),
+		Systems:       inventoryCountsToProto(snapshot.Systems),
+		L1KeyClaims:   inventoryCountsToProto(snapshot.L1KeyClaims),
+		TotalSystems:  snapshot.TotalSystems,
+		LinkedSystems: snapshot.LinkedSystems,
+		LinkRatio:     snapshot.LinkRatio(),
+	}
+}
+
+func inventoryCountsToProto(counts []model.InventoryCount) []*admingrpc.InventoryCount {
+	result := make([]*admingrpc.InventoryCount, 0, len(counts))
+	for _, count := range counts {
+		result = append(result, &admingrpc.InventoryCount{
+			Region: count.Region,
+			Status: count.Status,
+			Type:   count.Type,
+			Count:  count.Count,
+		})
+	}
+
+	return result
+}
+
+// timeFromProto returns the time of the timestamp, the zero time if it is not set.
+func timeFromProto(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+
+	return ts.AsTime()
+}
+
+func manifestFromProto(in *admingrpc.Manifest) *Manifest {
+	auths := make([]ManifestAuth, 0, len(in.GetAuths()))
+	for _, auth := range in.GetAuths() {
+		auths = append(auths, ManifestAuth{
+			ExternalID: auth.GetExternalId(),
+			Type:       auth.GetType(),
+			Properties: auth.GetProperties(),
+		})
+	}
+
+	tenant := in.GetTenant()
+
+	return &Manifest{
+		Tenant: ManifestTenant{
+			ID:        tenant.GetId(),
+			Name:      tenant.GetName(),
+			Region:    tenant.GetRegion(),
+			OwnerID:   tenant.GetOwnerId(),
+			OwnerType: tenant.GetOwnerType(),
+			Role:      tenant.GetRole(),
+		},
+		Labels:     in.GetLabels(),
+		UserGroups: in.GetUserGroups(),
+		Systems:    systemIdentifiersFromProto(in.GetSystems()),
+		Auths:      auths,
+	}
+}
+
+func discoveredSystemToProto(regionalSystem model.RegionalSystem) *admingrpc.DiscoveredSystem {
+	resp := &admingrpc.DiscoveredSystem{
+		Region:    regionalSystem.Region,
+		L2KeyId:   regionalSystem.L2KeyID,
+		Labels:    regionalSystem.Labels,
+		CreatedAt: timestamppb.New(regionalSystem.CreatedAt),
+	}
+
+	if regionalSystem.System != nil {
+		resp.ExternalId = regionalSystem.System.ExternalID
+		resp.Type = regionalSystem.System.Type
+	}
+
+	return resp
+}
+
+func tenantFromAdminProto(tenant *admingrpc.Tenant) *model.Tenant {
+	return &model.Tenant{
+		ID:         tenant.GetId(),
+		Name:       tenant.GetName(),
+		Region:     tenant.GetRegion(),
+		OwnerID:    tenant.GetOwnerId(),
+		OwnerType:  tenant.GetOwnerType(),
+		Status:     model.TenantStatus(tenant.GetStatus()),
+		Role:       tenant.GetRole(),
+		Labels:     tenant.GetLabels(),
+		UserGroups: tenant.GetUserGroups(),
+	}
+}
+
+func tenantToAdminProto(tenant *tenantgrpc.Tenant) *admingrpc.Tenant {
+	return &admingrpc.Tenant{
+		Id:              tenant.GetId(),
+		Name:            tenant.GetName(),
+		Region:          tenant.GetRegion(),
+		OwnerType:       tenant.GetOwnerType(),
+		OwnerId:         tenant.GetOwnerId(),
+		Status:          tenant.GetStatus().String(),
+		StatusUpdatedAt: tenant.GetStatusUpdatedAt(),
+		Role:            tenant.GetRole().String(),
+		Labels:          tenant.GetLabels(),
+		UserGroups:      tenant.GetUserGroups(),
+		UpdatedAt:       tenant.GetUpdatedAt(),
+		CreatedAt:       tenant.GetCreatedAt(),
+	}
+}
+
+func systemsToAdminProto(systems []*systemgrpc.System, displays map[string]SystemDisplay) []*admingrpc.System {
+	resp := make([]*admingrpc.System, 0, len(systems))
+	for _, system := range systems {
+		display := displays[systemKey(system.GetExternalId(), system.GetType())]
+		resp = append(resp, &admingrpc.System{
+			ExternalId:    system.GetExternalId(),
+			Type:          system.GetType(),
+			Region:        system.GetRegion(),
+			TenantId:      system.GetTenantId(),
+			L2KeyId:       system.GetL2KeyId(),
+			HasL1KeyClaim: system.GetHasL1KeyClaim(),
+			Status:        system.GetStatus().String(),
+			Labels:        system.GetLabels(),
+			UpdatedAt:     system.GetUpdatedAt(),
+			CreatedAt:     system.GetCreatedAt(),
+			DisplayName:   display.DisplayName,
+			Description:   display.Description,
+		})
+	}
+
+	return resp
+}
+
+func destroyedResourceToProto(destroyed DestroyedResource) *admingrpc.DestroyedResource {
+	resp := &admingrpc.DestroyedResource{
+		Resource:   destroyed.Resource,
+		Action:     destroyed.Action,
+		Keys:       destroyed.Keys,
+		Dependents: make([]*admingrpc.DestroyedResource, 0, len(destroyed.Dependents)),
+	}
+	for _, dependent := range destroyed.Dependents {
+		resp.Dependents = append(resp.Dependents, destroyedResourceToProto(dependent))
+	}
+
+	return resp
+}
+
+func jobToAdminProto(operation Operation) *admingrpc.Job {
+	job := &admingrpc.Job{
+		Id:           operation.ID,
+		ExternalId:   operation.ExternalID,
+		Type:         operation.Type,
+		Status:       operation.Status,
+		ErrorMessage: operation.ErrorMessage,
+		UpdatedAt:    timestamppb.New(operation.UpdatedAt),
+		CreatedAt:    timestamppb.New(operation.CreatedAt),
+	}
+	if operation.NotBefore != nil {
+		job.NotBefore = timestamppb.New(*operation.NotBefore)
+	}
+
+	return job
+}
+
+func logControlToProto(state LogControlState) *admingrpc.LogControl {
+	pb := &admingrpc.LogControl{
+		Level:     state.Level,
+		BaseLevel: state.BaseLevel,
+		Sampling:  make([]*admingrpc.LogSampling, 0, len(state.Sampling)),
+	}
+	if state.Until != nil {
+		pb.Until = timestamppb.New(*state.Until)
+	}
+
+	for _, sampling := range state.Sampling {
+		pb.Sampling = append(pb.Sampling, &admingrpc.LogSampling{
+			Method: sampling.Method,
+			Rate:   sampling.Rate,
+			Until:  timestamppb.New(sampling.Until),
+		})
+	}
+
+	return pb
+}
+
+func effectiveConfigToProto(effective EffectiveConfig) (*admingrpc.GetEffectiveConfigResponse, error) {
+	resp := &admingrpc.GetEffectiveConfigResponse{
+		LoadedAt:    timestamppb.New(effective.LoadedAt),
+		Values:      make([]*admingrpc.EffectiveConfigValue, 0, len(effective.Values)),
+		Changes:     make([]*admingrpc.EffectiveConfigChange, 0, len(effective.Changes)),
+		ReloadError: effective.ReloadError,
+	}
+
+	for _, value := range effective.Values {
+		pb := &admingrpc.EffectiveConfigValue{Path: value.Path, Source: value.Source}
+		if value.Value != nil {
+			v, err := configValueToProto(value.Value)
+			if err != nil {
+				return nil, err
+			}
+			pb.Value = v
+		}
+		resp.Values = append(resp.Values, pb)
+	}
+
+	for _, change := range effective.Changes {
+		pb := &admingrpc.EffectiveConfigChange{Path: change.Path}
+		if change.Previous != nil {
+			v, err := configValueToProto(change.Previous)
+			if err != nil {
+				return nil, err
+			}
+			pb.Previous = v
+		}
+		if change.Current != nil {
+			v, err := configValueToProto(change.Current)
+			if err != nil {
+				return nil, err
+			}
+			pb.Current = v
+		}
+		resp.Changes = append(resp.Changes, pb)
+	}
+
+	return resp, nil
+}
+
+// configValueToProto converts a value of the effective configuration by its JSON encoding,
+// e.g. durations are numbers of nanoseconds.
+func configValueToProto(value any) (*structpb.Value, error) {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &structpb.Value{}
+	if err := protojson.Unmarshal(data, pb); err != nil {
+		return nil, err
+	}
+
+	return pb, nil
+}
+
+func maintenanceModeToProto(mode *model.MaintenanceMode, enabled bool) *admingrpc.MaintenanceMode {
+	if !enabled {
+		return &admingrpc.MaintenanceMode{}
+	}
+
+	return &admingrpc.MaintenanceMode{
+		Enabled:   true,
+		Reason:    mode.Reason,
+		EnabledBy: mode.EnabledBy,
+		EnabledAt: timestamppb.New(mode.CreatedAt),
+	}
+}
@@ -0,0 +1,250 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	admingrpc "github.com/openkcm/registry/api/admin/v1"
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestAdminAuthorization(t *testing.T) {
+	subj := service.NewAdmin(config.Admin{Enabled: true, Callers: []string{"spiffe://example.org/operator"}},
+		service.AdminServices{Destroyer: service.NewTenantDestroyer(nil, nil, config.TenantDestroy{})})
+
+	t.Run("should deny unidentified callers", func(t *testing.T) {
+		// when
+		resp, err := subj.DestroyTenant(t.Context(), &admingrpc.DestroyTenantRequest{Id: "tenant"})
+
+		// then
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should deny callers which are not admins", func(t *testing.T) {
+		// given
+		ctx := repository.WithCaller(t.Context(), "spiffe://example.org/crypto")
+
+		// when
+		resp, err := subj.ListBackfills(ctx, &admingrpc.ListBackfillsRequest{})
+
+		// then
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.ErrorContains(t, err, "spiffe://example.org/crypto")
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should permit admin callers", func(t *testing.T) {
+		// given
+		ctx := repository.WithCaller(t.Context(), "spiffe://example.org/operator")
+
+		// when
+		_, err := subj.DestroyTenant(ctx, &admingrpc.DestroyTenantRequest{Id: "tenant"})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrTenantDestroyDisabled)
+	})
+}
+
+func TestAdminForceTenantStatus(t *testing.T) {
+	subj := service.NewAdmin(config.Admin{Enabled: true, Callers: []string{"spiffe://example.org/operator"}},
+		service.AdminServices{Tenants: &service.Tenant{}})
+	ctx := repository.WithCaller(t.Context(), "spiffe://example.org/operator")
+
+	t.Run("should reject unknown statuses", func(t *testing.T) {
+		// when
+		resp, err := subj.ForceTenantStatus(ctx, &admingrpc.ForceTenantStatusRequest{Id: "tenant", Status: "STATUS_UNKNOWN"})
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should reject the unspecified status", func(t *testing.T) {
+		// when
+		resp, err := subj.ForceTenantStatus(ctx, &admingrpc.ForceTenantStatusRequest{Id: "tenant", Status: "STATUS_UNSPECIFIED"})
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, resp)
+	})
+}
+
+func TestAdminResolveExternalId(t *testing.T) {
+	ctx := repository.WithCaller(t.Context(), "spiffe://example.org/operator")
+
+	t.Run("should reject resolving if the external ID index is disabled", func(t *testing.T) {
+		// given
+		subj := service.NewAdmin(config.Admin{Enabled: true, Callers: []string{"spiffe://example.org/operator"}},
+			service.AdminServices{ExternalIDs: service.NewExternalIDs(nil, config.ExternalIDIndex{})})
+
+		// when
+		resp, err := subj.ResolveExternalId(ctx, &admingrpc.ResolveExternalIdRequest{ExternalId: "external"})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrExternalIDIndexDisabled)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should reject an empty external ID", func(t *testing.T) {
+		// given
+		subj := service.NewAdmin(config.Admin{Enabled: true, Callers: []string{"spiffe://example.org/operator"}},
+			service.AdminServices{ExternalIDs: service.NewExternalIDs(nil, config.ExternalIDIndex{Enabled: true})})
+
+		// when
+		resp, err := subj.ResolveExternalId(ctx, &admingrpc.ResolveExternalIdRequest{})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrExternalIDIsEmpty)
+		assert.Nil(t, resp)
+	})
+}
+
+func TestAdminLogControl(t *testing.T) {
+	ctx := repository.WithCaller(t.Context(), "spiffe://example.org/operator")
+
+	newAdmin := func(t *testing.T, cfg config.LogControl) *service.Admin {
+		t.Helper()
+
+		logControl, err := service.NewLogControl(cfg, "info")
+		require.NoError(t, err)
+
+		return service.NewAdmin(config.Admin{Enabled: true, Callers: []string{"spiffe://example.org/operator"}},
+			service.AdminServices{LogControl: logControl})
+	}
+
+	t.Run("should deny callers which are not admins", func(t *testing.T) {
+		// given
+		subj := newAdmin(t, config.LogControl{Enabled: true, MaxDuration: time.Hour})
+
+		// when
+		resp, err := subj.SetLogLevel(t.Context(), &admingrpc.SetLogLevelRequest{Level: "debug"})
+
+		// then
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should reject changes if the log control is disabled", func(t *testing.T) {
+		// given
+		subj := newAdmin(t, config.LogControl{})
+
+		// when
+		resp, err := subj.SetLogLevel(ctx, &admingrpc.SetLogLevelRequest{Level: "debug"})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrLogControlDisabled)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should change the log level", func(t *testing.T) {
+		// given
+		subj := newAdmin(t, config.LogControl{Enabled: true, MaxDuration: time.Hour})
+
+		// when
+		resp, err := subj.SetLogLevel(ctx, &admingrpc.SetLogLevelRequest{Level: "debug", Duration: durationpb.New(time.Minute)})
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "DEBUG", resp.GetLogControl().GetLevel())
+		assert.Equal(t, "INFO", resp.GetLogControl().GetBaseLevel())
+		assert.NotNil(t, resp.GetLogControl().GetUntil())
+	})
+
+	t.Run("should reject unknown levels", func(t *testing.T) {
+		// given
+		subj := newAdmin(t, config.LogControl{Enabled: true, MaxDuration: time.Hour})
+
+		// when
+		resp, err := subj.SetLogLevel(ctx, &admingrpc.SetLogLevelRequest{Level: "verbose"})
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should sample a method", func(t *testing.T) {
+		// given
+		subj := newAdmin(t, config.LogControl{Enabled: true, MaxDuration: time.Hour})
+
+		// when
+		resp, err := subj.SetLogSampling(ctx, &admingrpc.SetLogSamplingRequest{Method: sampledMethod, Rate: 0.5})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, resp.GetLogControl().GetSampling(), 1)
+		assert.Equal(t, sampledMethod, resp.GetLogControl().GetSampling()[0].GetMethod())
+		assert.InDelta(t, 0.5, resp.GetLogControl().GetSampling()[0].GetRate(), 0.001)
+	})
+
+	t.Run("should reject invalid rates", func(t *testing.T) {
+		// given
+		subj := newAdmin(t, config.LogControl{Enabled: true, MaxDuration: time.Hour})
+
+		// when
+		resp, err := subj.SetLogSampling(ctx, &admingrpc.SetLogSamplingRequest{Method: sampledMethod, Rate: 2})
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Nil(t, resp)
+	})
+}
+
+func TestAdminGetEffectiveConfig(t *testing.T) {
+	ctx := repository.WithCaller(t.Context(), "spiffe://example.org/operator")
+
+	t.Run("should reject the call if the config introspection is disabled", func(t *testing.T) {
+		// given
+		subj := service.NewAdmin(config.Admin{Enabled: true, Callers: []string{"spiffe://example.org/operator"}}, service.AdminServices{})
+
+		// when
+		resp, err := subj.GetEffectiveConfig(ctx, &admingrpc.GetEffectiveConfigRequest{})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrConfigIntrospectionDisabled)
+		assert.Nil(t, resp)
+	})
+
+	t.Run("should return the values and changes", func(t *testing.T) {
+		// given
+		loaded := []config.EffectiveValue{
+			{Path: "database.host", Value: "db", Source: config.ValueSourceFile},
+			{Path: "labels.keys", Value: []string{"a", "b"}, Source: config.ValueSourceDefault},
+			{Path: "warmup.timeout", Value: time.Minute, Source: config.ValueSourceDefault},
+		}
+		reloaded := []config.EffectiveValue{
+			{Path: "database.host", Value: "other", Source: config.ValueSourceEnv},
+			{Path: "labels.keys", Value: []string{"a", "b"}, Source: config.ValueSourceDefault},
+			{Path: "warmup.timeout", Value: time.Minute, Source: config.ValueSourceDefault},
+			{Path: "warmup.hotTenants", Value: 10, Source: config.ValueSourceFile},
+		}
+		subj := service.NewAdmin(config.Admin{Enabled: true, Callers: []string{"spiffe://example.org/operator"}},
+			service.AdminServices{Config: service.NewConfigIntrospection(loaded, func() ([]config.EffectiveValue, error) {
+				return reloaded, nil
+			})})
+
+		// when
+		resp, err := subj.GetEffectiveConfig(ctx, &admingrpc.GetEffectiveConfigRequest{})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, resp.GetValues(), 3)
+		assert.Equal(t, "db", resp.GetValues()[0].GetValue().GetStringValue())
+		assert.Len(t, resp.GetValues()[1].GetValue().GetListValue().GetValues(), 2)
+		assert.InDelta(t, float64(time.Minute), resp.GetValues()[2].GetValue().GetNumberValue(), 0)
+		require.Len(t, resp.GetChanges(), 2)
+		assert.Equal(t, "database.host", resp.GetChanges()[0].GetPath())
+		assert.Equal(t, "other", resp.GetChanges()[0].GetCurrent().GetStringValue())
+		assert.Equal(t, "warmup.hotTenants", resp.GetChanges()[1].GetPath())
+		assert.Nil(t, resp.GetChanges()[1].GetPrevious())
+		assert.InDelta(t, 10, resp.GetChanges()[1].GetCurrent().GetNumberValue(), 0)
+	})
+}
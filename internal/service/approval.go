@@ -0,0 +1,132 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+var ErrApprovalWebhookStatus = errors.New("approval webhook returned unexpected status")
+
+type (
+	// ApprovalNotifier informs an external approval workflow about systems awaiting approval.
+	ApprovalNotifier interface {
+		NotifyApprovalRequired(ctx context.Context, req ApprovalRequest) error
+	}
+
+	// ApprovalRequest describes a regional system that awaits approval.
+	ApprovalRequest struct {
+		ExternalID string            `json:"externalId"`
+		Type       string            `json:"type"`
+		Region     string            `json:"region"`
+		TenantID   string            `json:"tenantId,omitempty"`
+		L2KeyID    string            `json:"l2KeyId"`
+		Labels     map[string]string `json:"labels,omitempty"`
+	}
+
+	// SystemApproval decides which system registrations require approval and notifies the approval workflow.
+	SystemApproval struct {
+		cfg      config.SystemApproval
+		notifier ApprovalNotifier
+	}
+
+	// WebhookApprovalNotifier posts approval requests as JSON to a configured HTTP endpoint.
+	WebhookApprovalNotifier struct {
+		client *http.Client
+		url    string
+	}
+)
+
+// NewSystemApproval creates and returns a new instance of SystemApproval.
+func NewSystemApproval(cfg config.SystemApproval, notifier ApprovalNotifier) *SystemApproval {
+	return &SystemApproval{
+		cfg:      cfg,
+		notifier: notifier,
+	}
+}
+
+// NewWebhookApprovalNotifier creates and returns a new instance of WebhookApprovalNotifier.
+func NewWebhookApprovalNotifier(cfg config.Webhook) *WebhookApprovalNotifier {
+	return &WebhookApprovalNotifier{
+		client: &http.Client{Timeout: cfg.Timeout},
+		url:    cfg.URL,
+	}
+}
+
+// NotifyApprovalRequired sends the approval request to the webhook.
+// Any non 2xx response is treated as an error.
+func (w *WebhookApprovalNotifier) NotifyApprovalRequired(ctx context.Context, req ApprovalRequest) error {
+	return postJSON(ctx, w.client, w.url, req, ErrApprovalWebhookStatus)
+}
+
+// postJSON posts the payload as JSON to the URL with the client data of the caller as headers.
+// A non 2xx response is returned as errStatus.
+func postJSON(ctx context.Context, client *http.Client, url string, payload any, errStatus error) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	for header, value := range repository.ClientDataFromContext(ctx) {
+		httpReq.Header.Set(header, value)
+	}
+
+	resp, err := client.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("%w: %d", errStatus, resp.StatusCode)
+	}
+
+	return nil
+}
+
+// requiresApproval returns true if registrations of the given system type must be approved.
+func (a *SystemApproval) requiresApproval(systemType string) bool {
+	if a == nil {
+		return false
+	}
+
+	return a.cfg.RequiresApproval(systemType)
+}
+
+// notify informs the approval workflow about the pending regional system.
+// Failures are logged only, the regional system stays pending and can still be approved or rejected.
+func (a *SystemApproval) notify(ctx context.Context, system *model.System, regionalSystem *model.RegionalSystem) {
+	if a == nil || a.notifier == nil {
+		return
+	}
+
+	req := ApprovalRequest{
+		ExternalID: system.ExternalID,
+		Type:       system.Type,
+		Region:     regionalSystem.Region,
+		L2KeyID:    regionalSystem.L2KeyID,
+		Labels:     regionalSystem.Labels,
+	}
+	if system.TenantID != nil {
+		req.TenantID = *system.TenantID
+	}
+
+	err := a.notifier.NotifyApprovalRequired(ctx, req)
+	if err != nil {
+		slogctx.Error(ctx, "failed to notify approval workflow", "error", err)
+	}
+}
@@ -0,0 +1,84 @@
+package service_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestWebhookApprovalNotifier(t *testing.T) {
+	req := service.ApprovalRequest{
+		ExternalID: "external-id",
+		Type:       "system",
+		Region:     "region-system",
+		TenantID:   "tenant-id",
+		L2KeyID:    "l2-key-id",
+	}
+
+	t.Run("should post the approval request as JSON", func(t *testing.T) {
+		// given
+		var received service.ApprovalRequest
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		t.Cleanup(srv.Close)
+
+		notifier := service.NewWebhookApprovalNotifier(config.Webhook{URL: srv.URL, Timeout: time.Second})
+
+		// when
+		err := notifier.NotifyApprovalRequired(context.Background(), req)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, req, received)
+	})
+
+	t.Run("should post the client data of the caller as headers", func(t *testing.T) {
+		// given
+		var header http.Header
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			header = r.Header
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		t.Cleanup(srv.Close)
+
+		notifier := service.NewWebhookApprovalNotifier(config.Webhook{URL: srv.URL, Timeout: time.Second})
+		ctx := repository.WithClientData(context.Background(), map[string]string{"x-client-data": "data"})
+
+		// when
+		err := notifier.NotifyApprovalRequired(ctx, req)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, "data", header.Get("x-client-data"))
+	})
+
+	t.Run("should return an error for non 2xx responses", func(t *testing.T) {
+		// given
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		t.Cleanup(srv.Close)
+
+		notifier := service.NewWebhookApprovalNotifier(config.Webhook{URL: srv.URL, Timeout: time.Second})
+
+		// when
+		err := notifier.NotifyApprovalRequired(context.Background(), req)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrApprovalWebhookStatus)
+	})
+}
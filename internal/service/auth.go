@@ -4,15 +4,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/openkcm/orbital"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
 
 	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
 	slogctx "github.com/veqryn/slog-context"
 
+	"github.com/openkcm/registry/internal/config"
 	"github.com/openkcm/registry/internal/model"
 	"github.com/openkcm/registry/internal/repository"
 	"github.com/openkcm/registry/internal/validation"
@@ -26,6 +28,7 @@ type Auth struct {
 	repo       repository.Repository
 	orbital    *Orbital
 	validation *validation.Validation
+	blobs      config.AuthPropertyBlobs
 }
 
 type (
@@ -40,6 +43,18 @@ type (
 	}
 )
 
+// authStatusPrefix is the prefix of the auth status names, which can be omitted in status filters.
+const authStatusPrefix = "AUTH_STATUS_"
+
+// TenantAuths are the auths of a tenant together with the tenant information needed to use them.
+type TenantAuths struct {
+	TenantID     string
+	TenantName   string
+	TenantRegion string
+	TenantStatus string
+	Auths        []*authgrpc.Auth
+}
+
 var AuthTransientStates = map[string]struct{}{
 	authgrpc.AuthStatus_AUTH_STATUS_APPLYING.String():   {},
 	authgrpc.AuthStatus_AUTH_STATUS_REMOVING.String():   {},
@@ -54,11 +69,13 @@ var AuthNonUpdatableState = map[string]struct{}{
 
 // NewAuth creates and return a new instance of Auth.
 // It also registers the job handlers to the Orbital instance.
-func NewAuth(repo repository.Repository, orbital *Orbital, validation *validation.Validation) *Auth {
+// Property values larger than the threshold of blobs are stored as AuthPropertyBlob.
+func NewAuth(repo repository.Repository, orbital *Orbital, validation *validation.Validation, blobs config.AuthPropertyBlobs) *Auth {
 	a := &Auth{
 		repo:       repo,
 		orbital:    orbital,
 		validation: validation,
+		blobs:      blobs,
 	}
 
 	for _, jobType := range []string{
@@ -83,6 +100,8 @@ func (a *Auth) ApplyAuth(ctx context.Context, req *authgrpc.ApplyAuthRequest) (*
 		Properties: req.Properties,
 		Status:     authgrpc.AuthStatus_AUTH_STATUS_APPLYING.String(),
 	}
+	auth.ExtractRequiredUserGroups()
+	auth.ExtractServiceAccount()
 
 	err := a.validateAuth(auth)
 	if err != nil {
@@ -90,25 +109,26 @@ func (a *Auth) ApplyAuth(ctx context.Context, req *authgrpc.ApplyAuthRequest) (*
 	}
 
 	err = a.repo.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
-		err := a.validateActiveTenant(ctx, r, auth.TenantID)
+		err := a.validateActiveTenant(ctx, r, auth)
 		if err != nil {
 			slogctx.Error(ctx, "tenant is invalid or not active", "error", err)
 			return err
 		}
 
-		err = r.Create(ctx, auth)
+		err = a.createAuth(ctx, r, auth)
+		if isAuthTypeConflict(err) {
+			return ErrAuthTypeConflict
+		}
+		if isUniqueConstraintError(err) {
+			slogctx.Info(ctx, AuthAlreadyExistsMsg)
+			return ErrAuthAlreadyExists
+		}
 		if err != nil {
 			slogctx.Error(ctx, "failed to create auth", "error", err)
-			var ucErr *repository.UniqueConstraintError
-			if errors.As(err, &ucErr) {
-				slogctx.Info(ctx, AuthAlreadyExistsMsg, "detail", ucErr.Detail)
-				return ErrAuthAlreadyExists
-			}
-
 			return status.Error(codes.Internal, "failed to create auth")
 		}
 
-		err = a.prepareJob(ctx, auth, authgrpc.AuthAction_AUTH_ACTION_APPLY_AUTH.String())
+		err = a.prepareJob(ctx, auth, authgrpc.AuthAction_AUTH_ACTION_APPLY_AUTH.String(), nil)
 		if err != nil {
 			slogctx.Error(ctx, "failed to prepare job", "error", err)
 			return err
@@ -117,6 +137,9 @@ func (a *Auth) ApplyAuth(ctx context.Context, req *authgrpc.ApplyAuthRequest) (*
 		return nil
 	})
 	err = mapError(err)
+	if errors.Is(err, ErrAuthTypeConflict) {
+		return nil, a.authTypeConflict(ctx, auth)
+	}
 	if err != nil && !errors.Is(err, ErrAuthAlreadyExists) {
 		return nil, err
 	}
@@ -128,15 +151,27 @@ func (a *Auth) ApplyAuth(ctx context.Context, req *authgrpc.ApplyAuthRequest) (*
 
 // GetAuth retrieves an auth by its external ID.
 func (a *Auth) GetAuth(ctx context.Context, req *authgrpc.GetAuthRequest) (*authgrpc.GetAuthResponse, error) {
-	ctx = slogctx.With(ctx, "externalId", req.ExternalId)
+	auth, err := a.GetAuthRecord(ctx, req.ExternalId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &authgrpc.GetAuthResponse{
+		Auth: auth.ToProto(),
+	}, nil
+}
+
+// GetAuthRecord returns the auth with the external ID, including the acknowledgments of its regions.
+func (a *Auth) GetAuthRecord(ctx context.Context, externalID string) (*model.Auth, error) {
+	ctx = slogctx.With(ctx, "externalId", externalID)
 	slogctx.Debug(ctx, "getting auth")
 
-	err := a.validation.Validate(model.AuthExternalIDValidationID, req.ExternalId)
+	err := a.validation.Validate(model.AuthExternalIDValidationID, externalID)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid external ID: %v", err)
+		return nil, ErrorWithValidationDetails(status.Errorf(codes.InvalidArgument, "invalid external ID: %v", err), err)
 	}
 
-	auth, err := getAuth(ctx, a.repo, req.ExternalId)
+	auth, err := getAuth(ctx, a.repo, externalID)
 	if errors.Is(err, ErrAuthNotFound) {
 		return nil, status.Error(codes.NotFound, "auth not found")
 	}
@@ -144,9 +179,7 @@ func (a *Auth) GetAuth(ctx context.Context, req *authgrpc.GetAuthRequest) (*auth
 		return nil, status.Error(codes.Internal, "failed to get auth")
 	}
 
-	return &authgrpc.GetAuthResponse{
-		Auth: auth.ToProto(),
-	}, nil
+	return auth, nil
 }
 
 func (a *Auth) ListAuths(ctx context.Context, in *authgrpc.ListAuthsRequest) (*authgrpc.ListAuthsResponse, error) {
@@ -155,7 +188,7 @@ func (a *Auth) ListAuths(ctx context.Context, in *authgrpc.ListAuthsRequest) (*a
 
 	err := a.validation.Validate(model.AuthTenantIDValidationID, in.TenantId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid tenant ID: %v", err)
+		return nil, ErrorWithValidationDetails(status.Errorf(codes.InvalidArgument, "invalid tenant ID: %v", err), err)
 	}
 
 	query := repository.NewQuery(&model.Auth{})
@@ -164,14 +197,15 @@ func (a *Auth) ListAuths(ctx context.Context, in *authgrpc.ListAuthsRequest) (*a
 		return nil, err
 	}
 
-	cond := repository.NewCompositeKey()
-	cond.Where(repository.TenantIDField, in.GetTenantId())
-	query.Where(cond)
+	query.Where(repository.Key(model.AuthFields.TenantID.Eq(in.GetTenantId())))
 
 	var auths []model.Auth
 	if err := a.repo.List(ctx, &auths, *query); err != nil {
 		return nil, err
 	}
+	if err := resolveAuthPropertyBlobs(ctx, a.repo, authPointers(auths)...); err != nil {
+		return nil, ErrAuthSelect
+	}
 	pbAuths := a.mapToGRPCResponse(auths)
 	if len(pbAuths) == 0 {
 		return nil, ErrAuthNotFound
@@ -199,6 +233,57 @@ func (a *Auth) ListAuths(ctx context.Context, in *authgrpc.ListAuthsRequest) (*a
 	}, nil
 }
 
+// GetTenantAuths returns the auths of the tenant, e.g. the OIDC auth currently applied.
+// The status and type filters are lists of comma separated values, empty filters match all auths.
+// Statuses may be given without the AUTH_STATUS_ prefix, e.g. APPLIED.
+// Unlike ListAuths, it fails with NotFound if the tenant does not exist and returns an empty list if no auth matches.
+func (a *Auth) GetTenantAuths(ctx context.Context, tenantID, statusFilter, typeFilter string) (*TenantAuths, error) {
+	ctx = slogctx.With(ctx, "tenantId", tenantID, "statusFilter", statusFilter, "typeFilter", typeFilter)
+	slogctx.Debug(ctx, "GetTenantAuths called")
+
+	err := a.validation.Validate(model.AuthTenantIDValidationID, tenantID)
+	if err != nil {
+		return nil, ErrorWithValidationDetails(status.Errorf(codes.InvalidArgument, "invalid tenant ID: %v", err), err)
+	}
+
+	cond := repository.Key(model.AuthFields.TenantID.Eq(tenantID))
+
+	err = whereAuthStatusFilter(cond, statusFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	err = whereFilter(cond, model.AuthFields.Type.Column(), typeFilter)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := getTenant(ctx, a.repo, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	query := repository.QueryOf[*model.Auth]().Where(cond)
+
+	var auths []model.Auth
+	if err := a.repo.List(ctx, &auths, *query); err != nil {
+		slogctx.Error(ctx, "failed to list auths of tenant", "error", err)
+		return nil, ErrAuthSelect
+	}
+
+	if err := resolveAuthPropertyBlobs(ctx, a.repo, authPointers(auths)...); err != nil {
+		return nil, ErrAuthSelect
+	}
+
+	return &TenantAuths{
+		TenantID:     tenant.ID,
+		TenantName:   tenant.Name,
+		TenantRegion: tenant.Region,
+		TenantStatus: string(tenant.Status),
+		Auths:        a.mapToGRPCResponse(auths),
+	}, nil
+}
+
 // RemoveAuth marks an auth for removal by its external ID and starts a job to remove it from the linked tenant.
 // If the auth does not exist or is not in APPLIED status, it returns an error.
 // If the linked tenant does not exist or is not active, it returns an error.
@@ -208,7 +293,7 @@ func (a *Auth) RemoveAuth(ctx context.Context, req *authgrpc.RemoveAuthRequest)
 
 	err := a.validation.Validate(model.AuthExternalIDValidationID, req.ExternalId)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, "invalid external ID: %v", err)
+		return nil, ErrorWithValidationDetails(status.Errorf(codes.InvalidArgument, "invalid external ID: %v", err), err)
 	}
 
 	err = a.repo.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
@@ -222,7 +307,12 @@ func (a *Auth) RemoveAuth(ctx context.Context, req *authgrpc.RemoveAuthRequest)
 			return ErrorWithParams(ErrAuthInvalidStatus, "status", auth.Status)
 		}
 
-		err = a.validateActiveTenant(ctx, r, auth.TenantID)
+		tenant, err := getTenant(ctx, r, auth.TenantID)
+		if err != nil {
+			slogctx.Error(ctx, "tenant is invalid or not active", "error", err)
+			return err
+		}
+		err = checkTenantActive(tenant)
 		if err != nil {
 			slogctx.Error(ctx, "tenant is invalid or not active", "error", err)
 			return err
@@ -238,7 +328,7 @@ func (a *Auth) RemoveAuth(ctx context.Context, req *authgrpc.RemoveAuthRequest)
 			return err
 		}
 
-		err = a.prepareJob(ctx, auth, authgrpc.AuthAction_AUTH_ACTION_REMOVE_AUTH.String())
+		err = a.prepareJob(ctx, auth, authgrpc.AuthAction_AUTH_ACTION_REMOVE_AUTH.String(), tenant)
 		if err != nil {
 			slogctx.Error(ctx, "failed to prepare job", "error", err)
 			return err
@@ -289,7 +379,11 @@ func (a *Auth) ResolveTasks(ctx context.Context, job orbital.Job,
 	targetsByRegion map[string]orbital.TargetManager) (
 	orbital.TaskResolverResult, error) {
 	auth := &authgrpc.Auth{}
-	err := proto.Unmarshal(job.Data, auth)
+	data, err := authJobPayload.decode(job.Data, auth)
+	if errors.Is(err, ErrJobPayloadTooNew) {
+		slogctx.Warn(ctx, "auth job payload is newer than supported, retrying", "error", err)
+		return nil, err
+	}
 	if err != nil {
 		slogctx.Error(ctx, "failed to decode auth proto", "error", err)
 		return orbital.CancelTaskResolver(fmt.Sprintf("failed to decode auth proto: %v", err)), nil
@@ -314,7 +408,7 @@ func (a *Auth) ResolveTasks(ctx context.Context, job orbital.Job,
 	return orbital.CompleteTaskResolver().WithTaskInfo(
 		[]orbital.TaskInfo{
 			{
-				Data:   job.Data,
+				Data:   data,
 				Type:   job.Type,
 				Target: tenant.Region,
 			},
@@ -335,10 +429,19 @@ func (a *Auth) HandleJobDone(ctx context.Context, job orbital.Job) error {
 		return nil
 	}
 
+	var acks map[string]model.AuthRegionAck
+	if job.Type == authgrpc.AuthAction_AUTH_ACTION_APPLY_AUTH.String() {
+		var err error
+		if acks, err = a.regionAcks(ctx, job); err != nil {
+			return err
+		}
+	}
+
 	err := patchAuth(ctx, a.repo,
 		job.ExternalID,
 		func(auth *model.Auth) {
 			auth.Status = status.String()
+			auth.RegionAcks = acks
 		},
 	)
 	if errors.Is(err, ErrAuthNotFound) {
@@ -358,12 +461,46 @@ func (a *Auth) HandleJobFailed(ctx context.Context, job orbital.Job) error {
 	return a.handleJobAborted(ctx, job)
 }
 
-func (a *Auth) validateActiveTenant(ctx context.Context, r repository.Repository, tenantID string) error {
-	tenant, err := getTenant(ctx, r, tenantID)
+// validateActiveTenant returns an error if the tenant of the auth is not active, lacks a user group the auth requires
+// or the service account of the auth is not active for the tenant.
+func (a *Auth) validateActiveTenant(ctx context.Context, r repository.Repository, auth *model.Auth) error {
+	tenant, err := getTenant(ctx, r, auth.TenantID)
 	if err != nil {
 		return err
 	}
-	return checkTenantActive(tenant)
+	if err := checkTenantActive(tenant); err != nil {
+		return err
+	}
+	if err := checkUserGroupsExist(tenant, auth.RequiredUserGroups); err != nil {
+		return err
+	}
+	return checkServiceAccountActive(ctx, r, tenant.ID, auth.ServiceAccountID)
+}
+
+// authTypeConflict returns ErrAuthTypeConflict identifying the auth of the tenant and type which is not removed.
+// The conflicting auth is looked up after the failed transaction, as the transaction is aborted by the conflict.
+func (a *Auth) authTypeConflict(ctx context.Context, auth *model.Auth) error {
+	var conflicting []model.Auth
+	err := a.repo.List(ctx, &conflicting, *repository.QueryOf(
+		model.AuthFields.TenantID.Eq(auth.TenantID),
+		model.AuthFields.Type.Eq(auth.Type),
+		model.AuthFields.Status.Ne(authgrpc.AuthStatus_AUTH_STATUS_REMOVED.String())).
+		SetLimit(1))
+	if err != nil || len(conflicting) == 0 {
+		slogctx.Warn(ctx, "failed to look up the conflicting auth", "error", err)
+		return ErrorWithParams(ErrAuthTypeConflict, "tenantId", auth.TenantID, "type", auth.Type)
+	}
+
+	slogctx.Info(ctx, "auth of the type already exists for the tenant", "conflictingExternalId", conflicting[0].ExternalID)
+
+	return ErrorWithParams(ErrAuthTypeConflict, "tenantId", auth.TenantID, "type", auth.Type,
+		"conflictingExternalId", conflicting[0].ExternalID, "conflictingStatus", conflicting[0].Status)
+}
+
+// isAuthTypeConflict returns true if err violates the uniqueness of the auths per tenant and type.
+func isAuthTypeConflict(err error) bool {
+	uniqueErr, ok := errors.AsType[*repository.UniqueConstraintError](err)
+	return ok && uniqueErr.Constraint == model.AuthTenantTypeIndex
 }
 
 func (a *Auth) validateAuth(auth *model.Auth) error {
@@ -374,23 +511,28 @@ func (a *Auth) validateAuth(auth *model.Auth) error {
 
 	err = a.validation.ValidateAll(valuesByID)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid auth: %v", err)
+		return ErrorWithValidationDetails(status.Errorf(codes.InvalidArgument, "invalid auth: %v", err), err)
 	}
 
-	return nil
+	return a.checkPropertySizes(auth.Properties)
 }
 
-func (a *Auth) prepareJob(ctx context.Context, auth *model.Auth, jobType string) error {
-	authData, err := proto.Marshal(auth.ToProto())
+// prepareJob starts the auth job. A job disrupting a tenant is restricted to its maintenance windows,
+// other jobs are passed a nil tenant and start immediately.
+func (a *Auth) prepareJob(ctx context.Context, auth *model.Auth, jobType string, disrupted *model.Tenant) error {
+	authData, err := authJobPayload.encode(auth.ToProto())
 	if err != nil {
 		return status.Error(codes.Internal, "failed to marshal auth proto")
 	}
 
-	err = a.orbital.PrepareJob(ctx,
-		authData,
-		auth.ExternalID,
-		jobType,
-	)
+	if disrupted != nil {
+		err = a.orbital.PrepareTenantJob(ctx, disrupted, authData, auth.ExternalID, jobType)
+	} else {
+		err = a.orbital.PrepareJob(ctx, authData, auth.ExternalID, jobType)
+	}
+	if errors.Is(err, ErrOutsideMaintenanceWindow) {
+		return err
+	}
 	if err != nil {
 		return status.Error(codes.Internal, "failed to start auth job")
 	}
@@ -410,11 +552,20 @@ func (a *Auth) handleJobAborted(ctx context.Context, job orbital.Job) error {
 		return nil
 	}
 
+	var acks map[string]model.AuthRegionAck
+	if job.Type == authgrpc.AuthAction_AUTH_ACTION_APPLY_AUTH.String() {
+		var err error
+		if acks, err = a.regionAcks(ctx, job); err != nil {
+			return err
+		}
+	}
+
 	err := patchAuth(ctx, a.repo,
 		job.ExternalID,
 		func(auth *model.Auth) {
 			auth.Status = status.String()
 			auth.ErrorMessage = job.ErrorMessage
+			auth.RegionAcks = acks
 		},
 	)
 	if errors.Is(err, ErrAuthNotFound) {
@@ -424,6 +575,70 @@ func (a *Auth) handleJobAborted(ctx context.Context, job orbital.Job) error {
 	return err
 }
 
+// regionAcks returns the outcomes of the tasks of the apply-auth job by target region,
+// which replace those of the previous apply-auth job.
+func (a *Auth) regionAcks(ctx context.Context, job orbital.Job) (map[string]model.AuthRegionAck, error) {
+	var tasks []model.Task
+
+	err := a.repo.List(ctx, &tasks, *repository.NewQuery(&model.Task{}).
+		Where(repository.NewCompositeKey().Where(jobIDField, job.ID.String())))
+	if err != nil {
+		slogctx.Error(ctx, "failed to list tasks of auth job", "error", err)
+		return nil, err
+	}
+
+	return regionAcksOf(tasks), nil
+}
+
+// regionAcksOf returns the outcomes of the tasks by target region. Tasks which are not done are pending.
+func regionAcksOf(tasks []model.Task) map[string]model.AuthRegionAck {
+	acks := make(map[string]model.AuthRegionAck, len(tasks))
+	for _, task := range tasks {
+		ack := model.AuthRegionAck{
+			Outcome: model.AuthRegionPending,
+			At:      time.Unix(0, task.UpdatedAt).UTC(),
+		}
+
+		switch orbital.TaskStatus(task.Status) {
+		case orbital.TaskStatusDone:
+			ack.Outcome = model.AuthRegionApplied
+		case orbital.TaskStatusFailed:
+			ack.Outcome = model.AuthRegionFailed
+			if task.ErrorMessage != nil {
+				ack.ErrorMessage = *task.ErrorMessage
+			}
+		}
+
+		acks[task.Target] = ack
+	}
+
+	return acks
+}
+
+// whereAuthStatusFilter adds the auth status filter to the condition.
+// The statuses must be auth status names, their AUTH_STATUS_ prefix is optional.
+func whereAuthStatusFilter(cond repository.CompositeKey, filter string) error {
+	values, err := filterValues(filter)
+	if err != nil {
+		return err
+	}
+
+	statuses := make([]string, 0, len(values))
+	for _, value := range values {
+		if !strings.HasPrefix(value, authStatusPrefix) {
+			value = authStatusPrefix + value
+		}
+
+		if err := (model.AuthStatusConstraint{}).Validate(value); err != nil {
+			return ErrorWithParams(ErrAuthStatusFilter, "status", value)
+		}
+
+		statuses = append(statuses, value)
+	}
+
+	return whereFilter(cond, model.AuthFields.Status.Column(), strings.Join(statuses, filterValueSeparator))
+}
+
 // mapToGRPCResponse maps model Auths to GRPC Tenants to be compatible for response.
 func (a *Auth) mapToGRPCResponse(auths []model.Auth) []*authgrpc.Auth {
 	pbAuths := make([]*authgrpc.Auth, 0, len(auths))
@@ -442,9 +657,8 @@ func (opts patchAuthOpts) apply(ctx context.Context, r repository.Repository, te
 		return nil
 	}
 	// get all auths for the tenantID
-	cond := repository.NewCompositeKey().Where(repository.TenantIDField, tenantID)
 	var auths []model.Auth
-	if err := r.List(ctx, &auths, *repository.NewQuery(&model.Auth{}).Where(cond)); err != nil {
+	if err := r.List(ctx, &auths, *repository.QueryOf(model.AuthFields.TenantID.Eq(tenantID))); err != nil {
 		return ErrAuthSelect
 	}
 
@@ -483,6 +697,10 @@ func getAuth(ctx context.Context, r repository.Repository, id string) (*model.Au
 		return nil, ErrAuthNotFound
 	}
 
+	if err := resolveAuthPropertyBlobs(ctx, r, auth); err != nil {
+		return nil, err
+	}
+
 	return auth, nil
 }
 
@@ -0,0 +1,213 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// defaultAuthPropertyChunkSize is the size in bytes of the chunks of streamed property values if not configured.
+const defaultAuthPropertyChunkSize = 64 << 10
+
+type (
+	// AuthPropertyStream is the server side of a GetAuthProperty call, a stream of the chunks of a property value.
+	// It is satisfied by a gRPC server stream of these messages.
+	AuthPropertyStream interface {
+		Context() context.Context
+		Send(*AuthPropertyChunk) error
+	}
+
+	// AuthPropertyChunk is a chunk of a streamed property value at the offset of the value.
+	// Every chunk carries the size and the digest of the whole value, so the client can verify it.
+	AuthPropertyChunk struct {
+		Offset int
+		Data   []byte
+		Size   int
+		// Digest is the lowercase hex SHA-256 of the value.
+		Digest string
+	}
+)
+
+// createAuth stores the auth with the property values larger than the threshold stored as blobs.
+// The auth keeps all its properties, e.g. for the payload of its job.
+func (a *Auth) createAuth(ctx context.Context, r repository.Repository, auth *model.Auth) error {
+	properties := auth.Properties
+
+	var blobs []model.AuthPropertyBlob
+	if a.blobs.Threshold > 0 {
+		inline := make(map[string]string, len(properties))
+		auth.BlobProperties = nil
+		for _, key := range slices.Sorted(maps.Keys(properties)) {
+			value := properties[key]
+			if len(value) <= a.blobs.Threshold {
+				inline[key] = value
+				continue
+			}
+
+			auth.BlobProperties = append(auth.BlobProperties, key)
+			blobs = append(blobs, *model.NewAuthPropertyBlob(auth.ExternalID, key, value))
+		}
+		auth.Properties = inline
+	}
+
+	err := r.Create(ctx, auth)
+	auth.Properties = properties
+	if err != nil || len(blobs) == 0 {
+		return err
+	}
+
+	return r.CreateAll(ctx, &blobs)
+}
+
+// checkPropertySizes returns ErrAuthPropertyTooLarge if a property value is stored as blob and exceeds the maximum size.
+func (a *Auth) checkPropertySizes(properties map[string]string) error {
+	if a.blobs.Threshold == 0 {
+		return nil
+	}
+
+	for key, value := range properties {
+		if len(value) > a.blobs.MaxSize {
+			return ErrorWithParams(ErrAuthPropertyTooLarge, "key", key, "size", len(value), "maxSize", a.blobs.MaxSize)
+		}
+	}
+
+	return nil
+}
+
+// StreamAuthProperty sends the value of the property of the auth with the external ID in chunks,
+// so large values, e.g. certificates or JWKS documents, are read without loading the whole auth in one message.
+// The value is sent as one empty chunk if it is empty.
+func (a *Auth) StreamAuthProperty(stream AuthPropertyStream, externalID, key string) error {
+	ctx := slogctx.With(stream.Context(), "externalId", externalID, "key", key)
+	slogctx.Debug(ctx, "StreamAuthProperty called")
+
+	if err := a.validation.Validate(model.AuthExternalIDValidationID, externalID); err != nil {
+		return validationFailed(err)
+	}
+
+	if key == "" {
+		return ErrorWithParams(ErrValidationFailed, "err", "property key must not be empty")
+	}
+
+	value, err := getAuthProperty(ctx, a.repo, externalID, key)
+	if err != nil {
+		return err
+	}
+
+	blob := model.NewAuthPropertyBlob(externalID, key, value)
+
+	chunkSize := a.blobs.ChunkSize
+	if chunkSize == 0 {
+		chunkSize = defaultAuthPropertyChunkSize
+	}
+
+	for offset := 0; ; offset += chunkSize {
+		end := min(offset+chunkSize, blob.Size)
+		err := stream.Send(&AuthPropertyChunk{
+			Offset: offset,
+			Data:   []byte(value[offset:end]),
+			Size:   blob.Size,
+			Digest: blob.Digest,
+		})
+		if err != nil {
+			return err
+		}
+
+		if end == blob.Size {
+			return nil
+		}
+	}
+}
+
+// getAuthProperty returns the value of the property of the auth, which is read from its blob if stored as blob.
+func getAuthProperty(ctx context.Context, r repository.Repository, externalID, key string) (string, error) {
+	auth := &model.Auth{ExternalID: externalID}
+	found, err := r.Find(ctx, auth)
+	if err != nil {
+		slogctx.Error(ctx, SelectAuthErrMsg, "error", err)
+		return "", ErrAuthSelect
+	}
+
+	if !found {
+		return "", ErrAuthNotFound
+	}
+
+	if !slices.Contains(auth.BlobProperties, key) {
+		value, ok := auth.ToProto().GetProperties()[key]
+		if !ok {
+			return "", ErrorWithParams(ErrAuthPropertyNotFound, "key", key)
+		}
+
+		return value, nil
+	}
+
+	blob := &model.AuthPropertyBlob{AuthID: externalID, Key: key}
+	found, err = r.Find(ctx, blob)
+	if err != nil {
+		slogctx.Error(ctx, "failed to select auth property blob", "error", err)
+		return "", ErrAuthSelect
+	}
+
+	if !found {
+		return "", ErrorWithParams(ErrAuthPropertyNotFound, "key", key)
+	}
+
+	return blob.Value, nil
+}
+
+// resolveAuthPropertyBlobs adds the property values stored as blobs to the properties of the auths,
+// so the auths are read as if all values were stored with them.
+func resolveAuthPropertyBlobs(ctx context.Context, r repository.Repository, auths ...*model.Auth) error {
+	byID := make(map[string]*model.Auth, len(auths))
+	keys := 0
+	for _, auth := range auths {
+		if len(auth.BlobProperties) > 0 {
+			byID[auth.ExternalID] = auth
+			keys += len(auth.BlobProperties)
+		}
+	}
+
+	if len(byID) == 0 {
+		return nil
+	}
+
+	query := repository.QueryOf(model.AuthPropertyBlobFields.AuthID.In(slices.Collect(maps.Keys(byID))...))
+	query.Limit = keys
+
+	var blobs []model.AuthPropertyBlob
+	if err := r.List(ctx, &blobs, *query); err != nil {
+		slogctx.Error(ctx, "failed to select auth property blobs", "error", err)
+		return fmt.Errorf("%w: %w", ErrAuthSelect, err)
+	}
+
+	for _, auth := range byID {
+		properties := make(map[string]string, len(auth.Properties)+len(auth.BlobProperties))
+		maps.Copy(properties, auth.Properties)
+		auth.Properties = properties
+	}
+
+	for _, blob := range blobs {
+		auth, ok := byID[blob.AuthID]
+		if ok && slices.Contains(auth.BlobProperties, blob.Key) {
+			auth.Properties[blob.Key] = blob.Value
+		}
+	}
+
+	return nil
+}
+
+// authPointers returns pointers to the auths, e.g. to resolve the property blobs of listed auths.
+func authPointers(auths []model.Auth) []*model.Auth {
+	pointers := make([]*model.Auth, 0, len(auths))
+	for i := range auths {
+		pointers = append(pointers, &auths[i])
+	}
+
+	return pointers
+}
@@ -0,0 +1,90 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openkcm/orbital"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestWhereAuthStatusFilter(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		expCond repository.CompositeKey
+		expCode codes.Code
+	}{
+		{
+			name:    "empty filter",
+			filter:  "",
+			expCond: repository.CompositeKey{},
+		},
+		{
+			name:    "status without prefix",
+			filter:  "APPLIED",
+			expCond: repository.CompositeKey{repository.StatusField: "AUTH_STATUS_APPLIED"},
+		},
+		{
+			name:   "statuses with and without prefix",
+			filter: "AUTH_STATUS_APPLIED, BLOCKED",
+			expCond: repository.CompositeKey{
+				repository.StatusField: []string{"AUTH_STATUS_APPLIED", "AUTH_STATUS_BLOCKED"},
+			},
+		},
+		{
+			name:    "unknown status",
+			filter:  "APPLIED,ENABLED",
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "unspecified status",
+			filter:  "UNSPECIFIED",
+			expCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			cond := repository.NewCompositeKey()
+
+			// when
+			err := service.WhereAuthStatusFilter(cond, tt.filter)
+
+			// then
+			if tt.expCode != codes.OK {
+				assert.Equal(t, tt.expCode, status.Code(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expCond, cond)
+		})
+	}
+}
+
+func TestRegionAcksOf(t *testing.T) {
+	// given
+	failure := "issuer unreachable"
+	tasks := []model.Task{
+		{Target: "eu10", Status: string(orbital.TaskStatusDone), UpdatedAt: 1_000},
+		{Target: "us10", Status: string(orbital.TaskStatusFailed), ErrorMessage: &failure, UpdatedAt: 2_000},
+		{Target: "ap10", Status: string(orbital.TaskStatusProcessing), UpdatedAt: 3_000},
+	}
+
+	// when
+	acks := service.RegionAcksOf(tasks)
+
+	// then
+	assert.Equal(t, map[string]model.AuthRegionAck{
+		"eu10": {Outcome: model.AuthRegionApplied, At: time.Unix(0, 1_000).UTC()},
+		"us10": {Outcome: model.AuthRegionFailed, ErrorMessage: failure, At: time.Unix(0, 2_000).UTC()},
+		"ap10": {Outcome: model.AuthRegionPending, At: time.Unix(0, 3_000).UTC()},
+	}, acks)
+}
@@ -0,0 +1,211 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// maxListedBackfills bounds the number of backfill checkpoints listed.
+const maxListedBackfills = 1000
+
+// BackfillJob processes all records of a table in batches ordered by their key.
+type BackfillJob struct {
+	// Name identifies the checkpoint of the backfill. A backfill with a new name starts from the beginning.
+	Name string
+	// Next returns the keys of at most limit records following the key after, in ascending order.
+	// The first batch is requested with an empty key.
+	Next func(ctx context.Context, tx repository.Repository, after string, limit int) ([]string, error)
+	// Apply mutates the records of the keys. It must be idempotent, as a batch is repeated if its transaction fails.
+	Apply func(ctx context.Context, tx repository.Repository, keys []string) error
+}
+
+// Backfills runs the registered backfills in rate-limited batches, which are paced by the batch interval
+// and the rows per second of the table scans.
+// The cursor of each backfill is checkpointed in the transaction of its batch, so backfills resume
+// after the last processed batch across restarts, and the checkpoint is locked while a batch runs,
+// so several instances do not process the same batch.
+type Backfills struct {
+	repo repository.Repository
+	cfg  config.Backfill
+	scan config.TableScan
+	jobs []BackfillJob
+}
+
+// NewBackfills creates and returns a new instance of Backfills.
+func NewBackfills(repo repository.Repository, cfg config.Backfill, scan config.TableScan) *Backfills {
+	return &Backfills{
+		repo: repo,
+		cfg:  cfg,
+		scan: scan,
+	}
+}
+
+// Register adds a backfill, which is run once started.
+func (b *Backfills) Register(job BackfillJob) {
+	b.jobs = append(b.jobs, job)
+}
+
+// Start runs the registered backfills one after another until they are completed or ctx is done.
+// It does nothing if backfills are disabled.
+func (b *Backfills) Start(ctx context.Context) {
+	if !b.cfg.Enabled || len(b.jobs) == 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(b.cfg.BatchInterval)
+		defer ticker.Stop()
+
+		// a batch reads at most the batch size of records
+		throttle := repository.NewThrottle(b.scan.RowsPerSecond)
+
+		for _, job := range b.jobs {
+			for {
+				completed, err := b.RunBatch(ctx, job)
+				if err != nil {
+					slogctx.Error(ctx, "failed to run backfill batch", "backfill", job.Name, "error", err)
+					b.recordError(ctx, job, err)
+				}
+
+				if completed {
+					break
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case <-ticker.C:
+				}
+
+				if err := throttle.Wait(ctx, b.cfg.BatchSize); err != nil {
+					return
+				}
+			}
+		}
+	}()
+}
+
+// lastErrorField is the error of the last failed batch, cleared once a batch succeeds.
+const lastErrorField repository.QueryField = "last_error"
+
+// RunBatch processes the next batch of the backfill and advances its checkpoint.
+// It returns true once the backfill is completed. A batch locked by another instance is skipped.
+func (b *Backfills) RunBatch(ctx context.Context, job BackfillJob) (bool, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, b.cfg.BatchTimeout)
+	defer cancel()
+
+	err := b.createCheckpoint(ctxTimeout, job)
+	if err != nil {
+		return false, err
+	}
+
+	completed := false
+
+	err = b.repo.Transaction(ctxTimeout, func(ctx context.Context, tx repository.Repository) error {
+		checkpoint := &model.Backfill{Name: job.Name}
+
+		found, err := tx.Find(ctx, checkpoint, repository.LockForUpdateSkipLocked)
+		if err != nil || !found {
+			return err
+		}
+
+		if checkpoint.IsCompleted() {
+			completed = true
+			return nil
+		}
+
+		keys, err := job.Next(ctx, tx, checkpoint.Cursor, b.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+
+		if len(keys) > 0 {
+			err = job.Apply(ctx, tx, keys)
+			if err != nil {
+				return err
+			}
+
+			checkpoint.Cursor = keys[len(keys)-1]
+			checkpoint.Processed += int64(len(keys))
+		}
+
+		if len(keys) < b.cfg.BatchSize {
+			now := time.Now()
+			checkpoint.CompletedAt = &now
+			completed = true
+
+			slogctx.Info(ctx, "backfill completed", "backfill", job.Name, "processed", checkpoint.Processed)
+		}
+
+		if _, err := tx.Patch(ctx, checkpoint); err != nil {
+			return err
+		}
+
+		if checkpoint.LastError == "" {
+			return nil
+		}
+
+		var cleared []model.Backfill
+		_, err = tx.ClearAll(ctx, &cleared, *repository.NewQuery(&model.Backfill{}).
+			Where(repository.NewCompositeKey().Where(repository.NameField, job.Name)), lastErrorField)
+
+		return err
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return completed, nil
+}
+
+// createCheckpoint creates the checkpoint of the backfill unless it exists. It is created outside
+// of the transaction of the batch, as a conflicting insert of another instance would abort it.
+func (b *Backfills) createCheckpoint(ctx context.Context, job BackfillJob) error {
+	found, err := b.repo.Find(ctx, &model.Backfill{Name: job.Name})
+	if err != nil || found {
+		return err
+	}
+
+	err = b.repo.Create(ctx, &model.Backfill{Name: job.Name})
+
+	var uniqueErr *repository.UniqueConstraintError
+	if errors.As(err, &uniqueErr) {
+		return nil
+	}
+
+	return err
+}
+
+// ListBackfills returns the checkpoints of all backfills which have been started, ordered by name.
+func (b *Backfills) ListBackfills(ctx context.Context) ([]model.Backfill, error) {
+	slogctx.Debug(ctx, "ListBackfills called")
+
+	var backfills []model.Backfill
+
+	err := b.repo.List(ctx, &backfills, *repository.NewQuery(&model.Backfill{}).SetLimit(maxListedBackfills))
+	if err != nil {
+		slogctx.Error(ctx, "failed to list backfills", "error", err)
+		return nil, ErrBackfillSelect
+	}
+
+	slices.SortFunc(backfills, func(a, b model.Backfill) int { return cmp.Compare(a.Name, b.Name) })
+
+	return backfills, nil
+}
+
+// recordError stores the error of the last failed batch in the checkpoint, so it shows in the progress.
+func (b *Backfills) recordError(ctx context.Context, job BackfillJob, batchErr error) {
+	_, err := b.repo.Patch(ctx, &model.Backfill{Name: job.Name, LastError: batchErr.Error()})
+	if err != nil {
+		slogctx.Error(ctx, "failed to record backfill error", "backfill", job.Name, "error", err)
+	}
+}
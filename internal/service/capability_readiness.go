@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"net/http"
+	"sync"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+// Capability is a capability of an instance whose readiness is served separately, see CapabilityReadiness.
+type Capability string
+
+const (
+	// CapabilityReads is the capability to serve the reading procedure calls.
+	CapabilityReads Capability = "reads"
+	// CapabilityWrites is the capability to serve the changing procedure calls.
+	CapabilityWrites Capability = "writes"
+	// CapabilityAsyncOps is the capability to start orbital jobs, e.g. to provision tenants.
+	CapabilityAsyncOps Capability = "async-ops"
+)
+
+// Capabilities are the capabilities of an instance, each requires the previous one.
+var Capabilities = []Capability{CapabilityReads, CapabilityWrites, CapabilityAsyncOps}
+
+var (
+	ErrCapabilityReadOnlyProfile   = errors.New("instance has the readOnly profile")
+	ErrCapabilityMaintenanceMode   = errors.New("registry is in maintenance mode")
+	ErrCapabilityOrbitalTargetDown = errors.New("orbital target is unreachable")
+)
+
+// capabilityRequires is the capability each capability requires: writes require reads and async ops require writes.
+var capabilityRequires = map[Capability]Capability{
+	CapabilityWrites:   CapabilityReads,
+	CapabilityAsyncOps: CapabilityWrites,
+}
+
+// CapabilityCheck is a named check of a capability, e.g. whether the database is reachable.
+type CapabilityCheck struct {
+	Name  string
+	Check func(ctx context.Context) error
+}
+
+// CapabilityReadiness checks the readiness of the instance per capability, so orchestration can route
+// the calls of a capability to the instances ready for it, e.g. reads to an instance whose AMQP brokers
+// are unreachable. A capability is ready if its checks and the checks of the capabilities it requires pass.
+type CapabilityReadiness struct {
+	cfg config.CapabilityReadiness
+
+	mu     sync.RWMutex
+	checks map[Capability][]CapabilityCheck
+}
+
+// CapabilityState is the readiness of a capability with the errors of its failed checks by check name.
+type CapabilityState struct {
+	Capability Capability        `json:"capability"`
+	Ready      bool              `json:"ready"`
+	Failures   map[string]string `json:"failures,omitempty"`
+}
+
+// NewCapabilityReadiness creates and returns a new instance of CapabilityReadiness.
+func NewCapabilityReadiness(cfg config.CapabilityReadiness) *CapabilityReadiness {
+	return &CapabilityReadiness{
+		cfg:    cfg,
+		checks: make(map[Capability][]CapabilityCheck),
+	}
+}
+
+// Add adds the checks to the capability. Checks may be added while the readiness is served,
+// e.g. once the resources they check are initialized.
+func (c *CapabilityReadiness) Add(capability Capability, checks ...CapabilityCheck) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.checks[capability] = append(c.checks[capability], checks...)
+}
+
+// Check returns the readiness of the capabilities. The checks of a capability run once, even if several
+// capabilities require it.
+func (c *CapabilityReadiness) Check(ctx context.Context, capabilities ...Capability) []CapabilityState {
+	ctx, cancel := context.WithTimeout(ctx, c.cfg.Timeout)
+	defer cancel()
+
+	failures := make(map[Capability]map[string]string, len(Capabilities))
+	states := make([]CapabilityState, 0, len(capabilities))
+	for _, capability := range capabilities {
+		failed := c.check(ctx, capability, failures)
+		states = append(states, CapabilityState{
+			Capability: capability,
+			Ready:      len(failed) == 0,
+			Failures:   failed,
+		})
+	}
+
+	return states
+}
+
+// check runs the checks of the capability and of the capabilities it requires, unless they already ran,
+// and returns the errors of the failed checks by check name.
+func (c *CapabilityReadiness) check(ctx context.Context, capability Capability, results map[Capability]map[string]string) map[string]string {
+	if failed, ok := results[capability]; ok {
+		return failed
+	}
+
+	failed := make(map[string]string)
+	if required, ok := capabilityRequires[capability]; ok {
+		maps.Copy(failed, c.check(ctx, required, results))
+	}
+
+	c.mu.RLock()
+	checks := c.checks[capability]
+	c.mu.RUnlock()
+
+	for _, check := range checks {
+		if err := check.Check(ctx); err != nil {
+			failed[check.Name] = err.Error()
+		}
+	}
+
+	results[capability] = failed
+
+	return failed
+}
+
+// Handler returns the handler serving the readiness of the capability as JSON,
+// with status 200 if the capability is ready and 503 otherwise.
+func (c *CapabilityReadiness) Handler(capability Capability) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", "GET")
+			http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+			return
+		}
+
+		state := c.Check(r.Context(), capability)[0]
+
+		code := http.StatusOK
+		if !state.Ready {
+			code = http.StatusServiceUnavailable
+		}
+
+		writeCapabilityStates(w, r, code, state)
+	})
+}
+
+// ServeHTTP serves the readiness of all capabilities as JSON, e.g. to label the instance by its ready capabilities.
+// The status is 200 even if capabilities are not ready.
+func (c *CapabilityReadiness) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	writeCapabilityStates(w, r, http.StatusOK, c.Check(r.Context(), Capabilities...))
+}
+
+func writeCapabilityStates(w http.ResponseWriter, r *http.Request, code int, states any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(states); err != nil {
+		slogctx.Error(r.Context(), "failed to write capability readiness", "error", err)
+	}
+}
+
+// ReadOnlyProfileCheck returns the check of the writes which fails for instances with the readOnly profile.
+func ReadOnlyProfileCheck(readOnly bool) CapabilityCheck {
+	return CapabilityCheck{
+		Name: "profile",
+		Check: func(_ context.Context) error {
+			if readOnly {
+				return ErrCapabilityReadOnlyProfile
+			}
+
+			return nil
+		},
+	}
+}
+
+// OrbitalTargetsCheck returns the check of the async ops which opens a connection to the AMQP broker
+// of every orbital target.
+func OrbitalTargetsCheck(targets []config.Target) CapabilityCheck {
+	return CapabilityCheck{
+		Name: "orbital-targets",
+		Check: func(ctx context.Context) error {
+			err := pingOrbitalTargets(ctx, targets)
+			if err != nil {
+				return errors.Join(ErrCapabilityOrbitalTargetDown, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+var errBrokerDown = errors.New("broker is down")
+
+func TestCapabilityReadiness(t *testing.T) {
+	// given
+	subj := service.NewCapabilityReadiness(config.CapabilityReadiness{Enabled: true, Timeout: time.Second})
+
+	var readsChecked int
+	subj.Add(service.CapabilityReads, service.CapabilityCheck{Name: "database", Check: func(_ context.Context) error {
+		readsChecked++
+		return nil
+	}})
+	subj.Add(service.CapabilityWrites, service.ReadOnlyProfileCheck(false))
+	subj.Add(service.CapabilityAsyncOps, service.CapabilityCheck{Name: "orbital-targets", Check: func(_ context.Context) error {
+		return errBrokerDown
+	}})
+
+	t.Run("should serve reads while the async ops are not ready", func(t *testing.T) {
+		// given
+		rec := httptest.NewRecorder()
+
+		// when
+		subj.Handler(service.CapabilityReads).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/probe/ready-reads", nil))
+
+		// then
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"capability":"reads","ready":true}`, rec.Body.String())
+	})
+
+	t.Run("should not be ready for async ops if a check fails", func(t *testing.T) {
+		// given
+		rec := httptest.NewRecorder()
+
+		// when
+		subj.Handler(service.CapabilityAsyncOps).ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/probe/ready-async-ops", nil))
+
+		// then
+		assert.Equal(t, http.StatusServiceUnavailable, rec.Code)
+		assert.JSONEq(t, `{"capability":"async-ops","ready":false,"failures":{"orbital-targets":"broker is down"}}`, rec.Body.String())
+	})
+
+	t.Run("should list the readiness of all capabilities and check each once", func(t *testing.T) {
+		// given
+		readsChecked = 0
+		rec := httptest.NewRecorder()
+
+		// when
+		subj.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/probe/capabilities", nil))
+
+		// then
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `[
+			{"capability":"reads","ready":true},
+			{"capability":"writes","ready":true},
+			{"capability":"async-ops","ready":false,"failures":{"orbital-targets":"broker is down"}}
+		]`, rec.Body.String())
+		assert.Equal(t, 1, readsChecked)
+	})
+
+	t.Run("should not be ready for writes or async ops with the readOnly profile", func(t *testing.T) {
+		// given
+		readOnly := service.NewCapabilityReadiness(config.CapabilityReadiness{Enabled: true, Timeout: time.Second})
+		readOnly.Add(service.CapabilityWrites, service.ReadOnlyProfileCheck(true))
+
+		// when
+		states := readOnly.Check(t.Context(), service.Capabilities...)
+
+		// then
+		require.Len(t, states, 3)
+		assert.True(t, states[0].Ready)
+		assert.False(t, states[1].Ready)
+		assert.False(t, states[2].Ready)
+		assert.Contains(t, states[2].Failures, "profile")
+	})
+
+	t.Run("should reject other methods", func(t *testing.T) {
+		// given
+		rec := httptest.NewRecorder()
+
+		// when
+		subj.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/probe/capabilities", nil))
+
+		// then
+		assert.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+	})
+}
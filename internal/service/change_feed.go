@@ -0,0 +1,156 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+const (
+	defaultChangesLimit = 100
+	maxChangesLimit     = 1000
+)
+
+// Query fields of the changes.
+const (
+	seqField          repository.QueryField = "seq"
+	resourceTypeField repository.QueryField = "resource_type"
+)
+
+// ChangeFeedResources are the resources whose mutations are recorded as changes.
+// Records of the registry's own bookkeeping, e.g. job delays and inventory snapshots, are not recorded.
+var ChangeFeedResources = []repository.Resource{
+	&model.Tenant{},
+	&model.TenantUserGroup{},
+	&model.System{},
+	&model.RegionalSystem{},
+	&model.SystemGroup{},
+	&model.SystemCredential{},
+	&model.SystemL2Key{},
+	&model.SystemRelationship{},
+	&model.Auth{},
+	&model.ServiceAccount{},
+}
+
+// ChangeFeed serves the changes of the resources in the order they are committed, so downstream indexers
+// ingest every change exactly once by checkpointing the sequence of the last change they ingested.
+type ChangeFeed struct {
+	repo repository.Repository
+	cfg  config.ChangeFeed
+}
+
+// NewChangeFeed creates and returns a new instance of ChangeFeed.
+func NewChangeFeed(repo repository.Repository, cfg config.ChangeFeed) *ChangeFeed {
+	return &ChangeFeed{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// ListChanges returns up to limit changes with a sequence greater than sinceSeq, ordered by their sequence.
+// Resource types restrict the changes to the tables of the resources, e.g. tenants; all if empty.
+// A sinceSeq of zero lists the changes from the oldest change kept. If the changes following sinceSeq
+// are pruned, ErrChangesPruned is returned, as the reader has missed them.
+func (f *ChangeFeed) ListChanges(ctx context.Context, sinceSeq int64, resourceTypes []string, limit int32) ([]model.Change, error) {
+	ctx = slogctx.With(ctx, "sinceSeq", sinceSeq, "resourceTypes", resourceTypes, "limit", limit)
+	slogctx.Debug(ctx, "ListChanges called")
+
+	if !f.cfg.Enabled {
+		return nil, ErrChangeFeedDisabled
+	}
+
+	if sinceSeq < 0 {
+		return nil, ErrorWithParams(ErrValidationFailed, "err", "sequence must not be negative")
+	}
+
+	for _, resourceType := range resourceTypes {
+		if !slices.ContainsFunc(ChangeFeedResources, func(r repository.Resource) bool { return r.TableName() == resourceType }) {
+			return nil, ErrorWithParams(ErrValidationFailed, "err", "resource type is not recorded", "resourceType", resourceType)
+		}
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	if sinceSeq > 0 {
+		var oldest []model.Change
+		err := f.repo.List(ctxTimeout, &oldest, *repository.NewQuery(&model.Change{}).OrderAscending(seqField).SetLimit(1))
+		if err != nil {
+			slogctx.Error(ctx, "failed to select oldest change", "error", err)
+			return nil, ErrChangeSelect
+		}
+
+		if len(oldest) > 0 && sinceSeq < oldest[0].Seq-1 {
+			return nil, ErrorWithParams(ErrChangesPruned, "sinceSeq", sinceSeq, "oldestSeq", oldest[0].Seq)
+		}
+	}
+
+	cond := repository.NewCompositeKey().Where(seqField, repository.Range{From: sinceSeq + 1})
+	if len(resourceTypes) > 0 {
+		cond.Where(resourceTypeField, slices.Compact(slices.Sorted(slices.Values(resourceTypes))))
+	}
+
+	changes := []model.Change{}
+	err := f.repo.List(ctxTimeout, &changes, *repository.NewQuery(&model.Change{}).
+		Where(cond).
+		OrderAscending(seqField).
+		SetLimit(changesLimit(limit)))
+	if err != nil {
+		slogctx.Error(ctx, "failed to list changes", "error", err)
+		return nil, ErrChangeSelect
+	}
+
+	return changes, nil
+}
+
+// Start prunes the changes older than the retention now and then every prune interval until ctx is done.
+func (f *ChangeFeed) Start(ctx context.Context) {
+	if !f.cfg.Enabled {
+		return
+	}
+
+	go func() {
+		f.prune(ctx)
+
+		ticker := time.NewTicker(f.cfg.PruneInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				f.prune(ctx)
+			}
+		}
+	}()
+}
+
+func (f *ChangeFeed) prune(ctx context.Context) {
+	var pruned []model.Change
+	count, err := f.repo.DeleteAll(ctx, &pruned, *repository.NewQuery(&model.Change{}).
+		Where(repository.NewCompositeKey().Where(repository.CreatedAtField, repository.Range{To: time.Now().Add(-f.cfg.Retention)})))
+	if err != nil {
+		slogctx.Error(ctx, "failed to prune changes", "error", err)
+		return
+	}
+
+	if count > 0 {
+		slogctx.Info(ctx, "pruned changes", "count", count, "retention", f.cfg.Retention)
+	}
+}
+
+// changesLimit returns the limit of listed changes, the default limit if none is given.
+func changesLimit(limit int32) int {
+	if limit <= 0 {
+		return defaultChangesLimit
+	}
+
+	return min(maxChangesLimit, int(limit))
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/model"
+)
+
+// ChangeFeedExtension implements the procedure calls on the change feed defined in api/extension/v1/extension.proto,
+// which are not defined by api-sdk yet.
+type ChangeFeedExtension struct {
+	extensiongrpc.UnimplementedChangeFeedServiceServer
+
+	feed *ChangeFeed
+}
+
+// NewChangeFeedExtension creates and returns a new instance of ChangeFeedExtension.
+func NewChangeFeedExtension(feed *ChangeFeed) *ChangeFeedExtension {
+	return &ChangeFeedExtension{
+		feed: feed,
+	}
+}
+
+// ListChanges returns the changes with a sequence greater than the given sequence, ordered by their sequence.
+func (c *ChangeFeedExtension) ListChanges(ctx context.Context, in *extensiongrpc.ListChangesRequest) (*extensiongrpc.ListChangesResponse, error) {
+	changes, err := c.feed.ListChanges(ctx, in.GetSinceSeq(), in.GetResourceTypes(), in.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.ListChangesResponse{
+		Changes: make([]*extensiongrpc.Change, 0, len(changes)),
+	}
+	for _, change := range changes {
+		resp.Changes = append(resp.Changes, changeToProto(change))
+	}
+
+	return resp, nil
+}
+
+func changeToProto(change model.Change) *extensiongrpc.Change {
+	key := make(map[string]string, len(change.ResourceKey))
+	for column, value := range change.ResourceKey {
+		key[column] = fmt.Sprint(value)
+	}
+
+	return &extensiongrpc.Change{
+		Seq:          change.Seq,
+		ResourceType: change.ResourceType,
+		ResourceKey:  key,
+		Operation:    change.Operation,
+		Caller:       change.Caller,
+		CreatedAt:    timestamppb.New(change.CreatedAt),
+	}
+}
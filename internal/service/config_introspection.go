@@ -0,0 +1,57 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+// ConfigIntrospection returns the effective configuration of the instance with the source of each value and
+// the changes of the config files and environment since it was loaded. The configuration applies at start,
+// so the changes are what the next restart applies. It is served by the admin service, see Admin.
+type ConfigIntrospection struct {
+	loaded   []config.EffectiveValue
+	loadedAt time.Time
+	reload   func() ([]config.EffectiveValue, error)
+}
+
+// EffectiveConfig is the effective configuration returned by the config introspection.
+type EffectiveConfig struct {
+	LoadedAt time.Time
+	Values   []config.EffectiveValue
+	Changes  []config.ConfigChange
+	// ReloadError is the error loading the config files and environment, without changes then.
+	ReloadError string
+}
+
+// NewConfigIntrospection creates and returns a new instance of ConfigIntrospection returning the loaded values,
+// which are compared to the values returned by reload on each call.
+func NewConfigIntrospection(loaded []config.EffectiveValue, reload func() ([]config.EffectiveValue, error)) *ConfigIntrospection {
+	return &ConfigIntrospection{
+		loaded:   loaded,
+		loadedAt: time.Now().UTC(),
+		reload:   reload,
+	}
+}
+
+// EffectiveConfig returns the loaded values and their changes by the current config files and environment.
+func (c *ConfigIntrospection) EffectiveConfig(ctx context.Context) EffectiveConfig {
+	effective := EffectiveConfig{
+		LoadedAt: c.loadedAt,
+		Values:   c.loaded,
+		Changes:  []config.ConfigChange{},
+	}
+
+	current, err := c.reload()
+	if err != nil {
+		slogctx.Warn(ctx, "failed to reload config for introspection", "error", err)
+		effective.ReloadError = err.Error()
+	} else {
+		effective.Changes = config.DiffEffectiveValues(c.loaded, current)
+	}
+
+	return effective
+}
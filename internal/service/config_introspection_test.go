@@ -0,0 +1,64 @@
+package service_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestConfigIntrospectionEffectiveConfig(t *testing.T) {
+	loaded := []config.EffectiveValue{
+		{Path: "database.host", Value: "db", Source: config.ValueSourceFile},
+		{Path: "database.port", Value: "5432", Source: config.ValueSourceDefault},
+	}
+
+	tests := []struct {
+		name           string
+		reloaded       []config.EffectiveValue
+		reloadErr      error
+		expChanges     []config.ConfigChange
+		expReloadError string
+	}{
+		{
+			name:       "unchanged",
+			reloaded:   loaded,
+			expChanges: []config.ConfigChange{},
+		},
+		{
+			name: "changed",
+			reloaded: []config.EffectiveValue{
+				{Path: "database.host", Value: "other", Source: config.ValueSourceEnv},
+				{Path: "database.port", Value: "5432", Source: config.ValueSourceDefault},
+			},
+			expChanges: []config.ConfigChange{{Path: "database.host", Previous: "db", Current: "other"}},
+		},
+		{
+			name:           "reload failed",
+			reloadErr:      errors.New("invalid yaml"),
+			expChanges:     []config.ConfigChange{},
+			expReloadError: "invalid yaml",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := service.NewConfigIntrospection(loaded, func() ([]config.EffectiveValue, error) {
+				return tt.reloaded, tt.reloadErr
+			})
+
+			// when
+			effective := subj.EffectiveConfig(t.Context())
+
+			// then
+			assert.Equal(t, loaded, effective.Values)
+			assert.Equal(t, tt.expChanges, effective.Changes)
+			assert.Equal(t, tt.expReloadError, effective.ReloadError)
+			assert.False(t, effective.LoadedAt.IsZero())
+		})
+	}
+}
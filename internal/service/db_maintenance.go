@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// DBMaintenance estimates the bloat of the configured tables every check interval and exposes it as gauges.
+// Within the maintenance windows it vacuums the tables whose share of dead rows reaches the vacuum threshold
+// and rebuilds the indexes of the tables whose share of index bloat reaches the reindex threshold.
+type DBMaintenance struct {
+	tables  repository.TableMaintainer
+	meters  *Meters
+	cfg     config.DBMaintenance
+	windows model.MaintenanceWindows
+	now     func() time.Time
+
+	mu    sync.RWMutex
+	bloat []repository.TableBloat
+}
+
+// NewDBMaintenance creates and returns a new instance of DBMaintenance.
+func NewDBMaintenance(tables repository.TableMaintainer, meters *Meters, cfg config.DBMaintenance) *DBMaintenance {
+	return &DBMaintenance{
+		tables:  tables,
+		meters:  meters,
+		cfg:     cfg,
+		windows: cfg.MaintenanceWindows(),
+		now:     time.Now,
+	}
+}
+
+// Start checks the tables now and then every check interval until ctx is done.
+func (m *DBMaintenance) Start(ctx context.Context) {
+	if !m.cfg.Enabled {
+		return
+	}
+
+	go func() {
+		m.check(ctx)
+
+		ticker := time.NewTicker(m.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.check(ctx)
+			}
+		}
+	}()
+}
+
+// RegisterMeters registers the gauges of the bloat of the tables as of the last check.
+func (m *DBMaintenance) RegisterMeters(ctx context.Context, registry *MeterRegistry) error {
+	gauges := []struct {
+		name, description string
+		value             func(repository.TableBloat) int64
+	}{
+		{"db.table.tuples.live", "Gauge of the estimated live rows of a table, partitioned by table",
+			func(b repository.TableBloat) int64 { return b.LiveTuples }},
+		{"db.table.tuples.dead", "Gauge of the estimated dead rows of a table, partitioned by table",
+			func(b repository.TableBloat) int64 { return b.DeadTuples }},
+		{"db.table.size", "Gauge of the size of a table in bytes without its indexes, partitioned by table",
+			func(b repository.TableBloat) int64 { return b.TableBytes }},
+		{"db.table.index.size", "Gauge of the size of the indexes of a table in bytes, partitioned by table",
+			func(b repository.TableBloat) int64 { return b.IndexBytes }},
+		{"db.table.index.bloat", "Gauge of the estimated bloat of the indexes of a table in bytes, partitioned by table",
+			func(b repository.TableBloat) int64 { return b.IndexBloatBytes }},
+	}
+
+	for _, gauge := range gauges {
+		err := registry.ObservableGauge(ctx, gauge.name, gauge.description,
+			func(_ context.Context, observer metric.Int64Observer) error {
+				m.mu.RLock()
+				defer m.mu.RUnlock()
+
+				for _, bloat := range m.bloat {
+					observer.Observe(gauge.value(bloat), metric.WithAttributes(attribute.String(AttrTable, bloat.Table)))
+				}
+
+				return nil
+			})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// check estimates the bloat of the tables and maintains the bloated tables if a maintenance window is open.
+func (m *DBMaintenance) check(ctx context.Context) {
+	bloat, err := m.tables.TableBloat(ctx, m.cfg.Tables)
+	if err != nil {
+		slogctx.Error(ctx, "failed to estimate table bloat", "error", err)
+		return
+	}
+
+	m.mu.Lock()
+	m.bloat = bloat
+	m.mu.Unlock()
+
+	for _, table := range bloat {
+		for _, operation := range m.dueOperations(table) {
+			// the window may close while the previous operations run
+			if !m.windowOpen() {
+				return
+			}
+
+			m.maintain(ctx, table, operation)
+		}
+	}
+}
+
+// windowOpen returns true if a maintenance window is open now.
+func (m *DBMaintenance) windowOpen() bool {
+	now := m.now()
+	next, ok := m.windows.NextOpen(now)
+	return ok && !next.After(now)
+}
+
+// dueOperations returns the enabled operations whose threshold the bloat of the table reaches, in order:
+// the vacuum runs first, as it reclaims the dead entries of the indexes as well.
+func (m *DBMaintenance) dueOperations(table repository.TableBloat) []repository.TableOperation {
+	var operations []repository.TableOperation
+	if m.cfg.Vacuum.Enabled && table.DeadTupleRatio() >= m.cfg.Vacuum.Threshold {
+		operations = append(operations, repository.TableOperationVacuum)
+	}
+
+	if m.cfg.Reindex.Enabled && table.IndexBloatRatio() >= m.cfg.Reindex.Threshold {
+		operations = append(operations, repository.TableOperationReindex)
+	}
+
+	return operations
+}
+
+// maintain runs the operation on the table and logs and counts its outcome.
+// Tables maintained by another instance are skipped.
+func (m *DBMaintenance) maintain(ctx context.Context, table repository.TableBloat, operation repository.TableOperation) {
+	ctx = slogctx.With(ctx, "table", table.Table, "operation", operation)
+	start := m.now()
+
+	ran, err := m.tables.MaintainTable(ctx, table.Table, operation)
+
+	outcome := "done"
+	switch {
+	case err != nil:
+		outcome = "failed"
+		slogctx.Error(ctx, "failed to maintain table", "error", err)
+	case !ran:
+		outcome = "skipped"
+		slogctx.Debug(ctx, "table is maintained by another instance")
+	default:
+		slogctx.Info(ctx, "table maintained",
+			"deadTupleRatio", table.DeadTupleRatio(),
+			"indexBloatRatio", table.IndexBloatRatio(),
+			"duration", m.now().Sub(start))
+	}
+
+	m.meters.handleTableMaintenance(ctx, table.Table, operation, outcome)
+}
@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/metric/noop"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+type tableMaintainerMock struct {
+	bloat      []repository.TableBloat
+	maintained []string
+	locked     bool
+}
+
+func (m *tableMaintainerMock) TableBloat(_ context.Context, _ []string) ([]repository.TableBloat, error) {
+	return m.bloat, nil
+}
+
+func (m *tableMaintainerMock) MaintainTable(_ context.Context, table string, operation repository.TableOperation) (bool, error) {
+	if m.locked {
+		return false, nil
+	}
+
+	m.maintained = append(m.maintained, table+":"+string(operation))
+	return true, nil
+}
+
+func TestDBMaintenance(t *testing.T) {
+	// Sunday, 2026-01-04 is within the window, Monday is not
+	sunday := time.Date(2026, 1, 4, 3, 0, 0, 0, time.UTC)
+	monday := sunday.AddDate(0, 0, 1)
+
+	cfg := config.DBMaintenance{
+		Enabled:       true,
+		CheckInterval: time.Minute,
+		Vacuum:        config.DBMaintenanceOperation{Enabled: true, Threshold: 0.2},
+		Reindex:       config.DBMaintenanceOperation{Enabled: true, Threshold: 0.5},
+		Windows:       []config.DBMaintenanceWindow{{Weekdays: []string{"Sunday"}, StartTime: "02:00", Duration: 4 * time.Hour}},
+	}
+	meters := service.NewMeters(service.NewMeterRegistry(&commoncfg.Application{Name: "test"}, noop.NewMeterProvider()))
+
+	bloat := []repository.TableBloat{
+		{Table: "jobs", LiveTuples: 70, DeadTuples: 30, IndexBytes: 100, IndexBloatBytes: 60},
+		{Table: "tasks", LiveTuples: 90, DeadTuples: 10, IndexBytes: 100, IndexBloatBytes: 60},
+		{Table: "changes", LiveTuples: 100, IndexBytes: 100, IndexBloatBytes: 10},
+	}
+
+	t.Run("should maintain the tables reaching the thresholds within a window", func(t *testing.T) {
+		tables := &tableMaintainerMock{bloat: bloat}
+		subj := service.NewDBMaintenance(tables, meters, cfg)
+
+		subj.CheckTables(t.Context(), sunday)
+
+		assert.Equal(t, []string{"jobs:vacuum", "jobs:reindex", "tasks:reindex"}, tables.maintained)
+	})
+
+	t.Run("should not maintain the tables outside the windows", func(t *testing.T) {
+		tables := &tableMaintainerMock{bloat: bloat}
+		subj := service.NewDBMaintenance(tables, meters, cfg)
+
+		subj.CheckTables(t.Context(), monday)
+
+		assert.Empty(t, tables.maintained)
+	})
+
+	t.Run("should not run disabled operations", func(t *testing.T) {
+		tables := &tableMaintainerMock{bloat: bloat}
+		monitoring := cfg
+		monitoring.Reindex.Enabled = false
+		subj := service.NewDBMaintenance(tables, meters, monitoring)
+
+		subj.CheckTables(t.Context(), sunday)
+
+		assert.Equal(t, []string{"jobs:vacuum"}, tables.maintained)
+	})
+
+	t.Run("should skip the tables maintained by another instance", func(t *testing.T) {
+		tables := &tableMaintainerMock{bloat: bloat, locked: true}
+		subj := service.NewDBMaintenance(tables, meters, cfg)
+
+		subj.CheckTables(t.Context(), sunday)
+
+		assert.Empty(t, tables.maintained)
+	})
+}
@@ -0,0 +1,311 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/openkcm/orbital"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// SystemObservedTaskType is the type of the messages on which regional operators report an observed system.
+const SystemObservedTaskType = "SYSTEM_OBSERVED"
+
+// discoveryReceiveBackoff is the pause after a failed receive, so a broken connection is not retried in a busy loop.
+const discoveryReceiveBackoff = time.Second
+
+var ErrUnexpectedDiscoveryMessage = errors.New("unexpected system discovery message type")
+
+type (
+	// SystemObservedEvent is a regional system observed by a regional operator.
+	// It is sent as JSON in the data of an orbital task request of type SystemObservedTaskType.
+	SystemObservedEvent struct {
+		ExternalID string            `json:"externalId"`
+		Type       string            `json:"type"`
+		Region     string            `json:"region"`
+		L2KeyID    string            `json:"l2KeyId"`
+		Labels     map[string]string `json:"labels,omitempty"`
+	}
+
+	// SystemObservedReceiver receives the messages of the regional operators.
+	SystemObservedReceiver interface {
+		ReceiveTaskRequest(ctx context.Context) (orbital.TaskRequest, error)
+	}
+
+	// SystemDiscovery registers the systems observed by regional operators, so the registry stays authoritative.
+	// Observed systems which are not registered yet are registered as discovered and await confirmation,
+	// observed systems which are already registered are ignored.
+	// The review procedure calls are served on the admin service, see Admin.
+	SystemDiscovery struct {
+		repo       repository.Repository
+		validation *validation.Validation
+		meters     *Meters
+		labels     *Labels
+	}
+)
+
+// NewSystemDiscovery creates and returns a new instance of SystemDiscovery.
+func NewSystemDiscovery(repo repository.Repository, meters *Meters, validation *validation.Validation, labels *Labels) *SystemDiscovery {
+	return &SystemDiscovery{
+		repo:       repo,
+		validation: validation,
+		meters:     meters,
+		labels:     labels,
+	}
+}
+
+// NewSystemObservedReceiver creates the AMQP client receiving the messages of the regional operators.
+func NewSystemObservedReceiver(ctx context.Context, cfg config.SystemDiscovery) (SystemObservedReceiver, error) {
+	return createAMQPClient(ctx, cfg.Connection)
+}
+
+// Listen handles the messages of the receiver until ctx is done.
+// Messages which can not be handled are logged and dropped, operators report observed systems repeatedly.
+func (d *SystemDiscovery) Listen(ctx context.Context, receiver SystemObservedReceiver) {
+	go func() {
+		for {
+			req, err := receiver.ReceiveTaskRequest(ctx)
+			if ctx.Err() != nil {
+				return
+			}
+			if err != nil {
+				slogctx.Error(ctx, "failed to receive system discovery message", "error", err)
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(discoveryReceiveBackoff):
+				}
+				continue
+			}
+
+			event, err := decodeSystemObserved(req)
+			if err != nil {
+				slogctx.Warn(ctx, "dropping system discovery message", "taskId", req.TaskID, "error", err)
+				continue
+			}
+
+			_, err = d.HandleSystemObserved(ctx, event)
+			if err != nil {
+				slogctx.Error(ctx, "failed to handle observed system", "externalId", event.ExternalID, "region", event.Region, "error", err)
+			}
+		}
+	}()
+}
+
+// HandleSystemObserved registers the observed regional system as discovered, if it is not registered yet.
+// It returns true if the regional system was registered.
+func (d *SystemDiscovery) HandleSystemObserved(ctx context.Context, event SystemObservedEvent) (bool, error) {
+	slogctx.Debug(ctx, "HandleSystemObserved called", "externalId", event.ExternalID, "type", event.Type, "region", event.Region)
+
+	if err := validateExternalIDAndType(d.validation, event.ExternalID, event.Type); err != nil {
+		return false, err
+	}
+
+	regionalSystem := &model.RegionalSystem{
+		Region:         event.Region,
+		Status:         typespb.Status_STATUS_AVAILABLE.String(),
+		L2KeyID:        event.L2KeyID,
+		Labels:         d.labels.systemDefaults(event.Labels),
+		ApprovalStatus: model.ApprovalStatusDiscovered,
+	}
+	if err := validateRegionalSystem(d.validation, regionalSystem); err != nil {
+		return false, err
+	}
+
+	registered := false
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := d.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		system, found, err := getSystem(ctx, r, event.ExternalID, event.Type)
+		if err != nil {
+			return ErrSystemSelect
+		}
+
+		if !found {
+			system, err = createSystem(ctx, d.validation, r, event.ExternalID, event.Type, "")
+			if err != nil {
+				return err
+			}
+		}
+
+		existing := &model.RegionalSystem{
+			SystemID: system.ID,
+			Region:   event.Region,
+		}
+
+		found, err = r.Find(ctx, existing)
+		if err != nil {
+			return ErrSystemSelect
+		}
+		if found {
+			return nil
+		}
+
+		regionalSystem.SystemID = system.ID
+
+		regionalSystem.Labels, err = d.labels.withInherited(ctx, r, system, regionalSystem.Labels)
+		if err != nil {
+			return err
+		}
+		err = r.Create(ctx, regionalSystem)
+		if err != nil {
+			return err
+		}
+
+		err = recordL2Key(ctx, r, regionalSystem, time.Now())
+		if err != nil {
+			return err
+		}
+
+		registered = true
+
+		return nil
+	})
+	if isUniqueConstraintError(err) {
+		// The system was registered concurrently, e.g. by a redelivered message.
+		return false, nil
+	}
+	if err != nil {
+		return false, mapError(err)
+	}
+
+	if registered {
+		slogctx.Info(ctx, "discovered system registered", "externalId", event.ExternalID, "type", event.Type, "region", event.Region)
+		d.meters.handleSystemRegistration(ctx, event.Region)
+	}
+
+	return registered, nil
+}
+
+// ListDiscoveredSystems returns the regional systems awaiting confirmation, of all regions if region is empty.
+func (d *SystemDiscovery) ListDiscoveredSystems(ctx context.Context, region string) ([]model.RegionalSystem, error) {
+	slogctx.Debug(ctx, "ListDiscoveredSystems called", "region", region)
+
+	conditions := []repository.Condition[*model.RegionalSystem]{
+		model.RegionalSystemFields.ApprovalStatus.Eq(model.ApprovalStatusDiscovered),
+	}
+	if region != "" {
+		conditions = append(conditions, model.RegionalSystemFields.Region.Eq(region))
+	}
+
+	query := repository.QueryOf(conditions...)
+	query.Populate(repository.System)
+
+	var systems []model.RegionalSystem
+	if err := d.repo.List(ctx, &systems, *query); err != nil {
+		return nil, ErrSystemSelect
+	}
+
+	return systems, nil
+}
+
+// ConfirmDiscoveredSystem confirms the discovered regional system, so it can be used like a registered one.
+func (d *SystemDiscovery) ConfirmDiscoveredSystem(ctx context.Context, externalID, systemType, region string) error {
+	slogctx.Debug(ctx, "ConfirmDiscoveredSystem called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := validateExternalIDAndType(d.validation, externalID, systemType); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := d.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		regionalSystem, err := getDiscoveredRegionalSystem(ctx, r, externalID, systemType, region)
+		if err != nil {
+			return err
+		}
+
+		isPatched, err := r.Patch(ctx, &model.RegionalSystem{
+			SystemID:       regionalSystem.SystemID,
+			Region:         regionalSystem.Region,
+			ApprovalStatus: model.ApprovalStatusApproved,
+		})
+		if err != nil || !isPatched {
+			return ErrSystemUpdate
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// DismissDiscoveredSystem removes the discovered regional system, e.g. if it was observed by mistake.
+// The parent system is removed as well if it has no other regional systems left.
+func (d *SystemDiscovery) DismissDiscoveredSystem(ctx context.Context, externalID, systemType, region string) error {
+	slogctx.Debug(ctx, "DismissDiscoveredSystem called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := validateExternalIDAndType(d.validation, externalID, systemType); err != nil {
+		return err
+	}
+
+	var deleted bool
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := d.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		regionalSystem, err := getDiscoveredRegionalSystem(ctx, r, externalID, systemType, region)
+		if err != nil {
+			return err
+		}
+
+		deleted, err = deleteRegionalSystem(ctx, r, regionalSystem)
+
+		return err
+	})
+
+	err = mapError(err)
+	if err != nil {
+		return err
+	}
+
+	if deleted {
+		d.meters.handleSystemDeletion(ctx, region)
+	}
+
+	return nil
+}
+
+// getDiscoveredRegionalSystem fetches the regional system and makes sure that it awaits confirmation.
+func getDiscoveredRegionalSystem(ctx context.Context, r repository.Repository, externalID, systemType, region string) (*model.RegionalSystem, error) {
+	regionalSystem, err := getRegionalSystem(ctx, r, externalID, systemType, region)
+	if err != nil {
+		return nil, err
+	}
+
+	if !regionalSystem.IsDiscovered() {
+		return nil, ErrSystemNotDiscovered
+	}
+
+	return regionalSystem, nil
+}
+
+// decodeSystemObserved decodes the observed system of the message.
+func decodeSystemObserved(req orbital.TaskRequest) (SystemObservedEvent, error) {
+	var event SystemObservedEvent
+
+	if req.Type != SystemObservedTaskType {
+		return event, fmt.Errorf("%w: %s", ErrUnexpectedDiscoveryMessage, req.Type)
+	}
+
+	err := json.Unmarshal(req.Data, &event)
+	if err != nil {
+		return event, fmt.Errorf("failed to decode observed system: %w", err)
+	}
+
+	return event, nil
+}
@@ -0,0 +1,56 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/openkcm/orbital"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestDecodeSystemObserved(t *testing.T) {
+	t.Run("should decode the observed system", func(t *testing.T) {
+		// given
+		req := orbital.TaskRequest{
+			Type: service.SystemObservedTaskType,
+			Data: []byte(`{"externalId":"external-id","type":"system","region":"region","l2KeyId":"key","labels":{"env":"prod"}}`),
+		}
+
+		// when
+		event, err := service.DecodeSystemObserved(req)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, service.SystemObservedEvent{
+			ExternalID: "external-id",
+			Type:       "system",
+			Region:     "region",
+			L2KeyID:    "key",
+			Labels:     map[string]string{"env": "prod"},
+		}, event)
+	})
+
+	t.Run("should return error for unexpected message types", func(t *testing.T) {
+		// given
+		req := orbital.TaskRequest{Type: "ACTION_PROVISION_TENANT", Data: []byte(`{}`)}
+
+		// when
+		_, err := service.DecodeSystemObserved(req)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrUnexpectedDiscoveryMessage)
+	})
+
+	t.Run("should return error for malformed data", func(t *testing.T) {
+		// given
+		req := orbital.TaskRequest{Type: service.SystemObservedTaskType, Data: []byte(`not json`)}
+
+		// when
+		_, err := service.DecodeSystemObserved(req)
+
+		// then
+		assert.Error(t, err)
+	})
+}
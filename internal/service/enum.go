@@ -0,0 +1,50 @@
+package service
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+)
+
+// EnumValues resolves enum values of requests to the names stored by the registry.
+// Values unknown to this server, e.g. sent by a newer client, are rejected
+// or stored as model.UnknownEnumValue if configured.
+type EnumValues struct {
+	acceptUnknown bool
+}
+
+// NewEnumValues creates and returns a new instance of EnumValues.
+func NewEnumValues(cfg config.Compatibility) *EnumValues {
+	return &EnumValues{
+		acceptUnknown: cfg.AcceptUnknownEnumValues,
+	}
+}
+
+// resolve returns the name of the enum value of the request field.
+// Unknown values are rejected with the list of supported values, unless they are accepted.
+func (e *EnumValues) resolve(ctx context.Context, field string, value protoreflect.Enum) (string, error) {
+	values := value.Descriptor().Values()
+	if v := values.ByNumber(value.Number()); v != nil {
+		return string(v.Name()), nil
+	}
+
+	if e != nil && e.acceptUnknown {
+		slogctx.Warn(ctx, "unknown enum value accepted", "field", field, "value", value.Number())
+		return model.UnknownEnumValue, nil
+	}
+
+	supported := make([]string, 0, values.Len())
+	for i := range values.Len() {
+		if v := values.Get(i); v.Number() != 0 {
+			supported = append(supported, string(v.Name()))
+		}
+	}
+
+	return "", ErrorWithParams(ErrUnknownEnumValue, "field", field, "value", value.Number(), "supported", strings.Join(supported, ","))
+}
@@ -0,0 +1,59 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestEnumValuesResolve(t *testing.T) {
+	unknownStatus := typespb.Status(999)
+
+	t.Run("should return the name of a known value", func(t *testing.T) {
+		// given
+		enums := service.NewEnumValues(config.Compatibility{})
+
+		// when
+		name, err := enums.Resolve(context.Background(), "status", typespb.Status_STATUS_AVAILABLE)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, typespb.Status_STATUS_AVAILABLE.String(), name)
+	})
+
+	t.Run("should reject an unknown value listing the supported values", func(t *testing.T) {
+		// given
+		enums := service.NewEnumValues(config.Compatibility{})
+
+		// when
+		name, err := enums.Resolve(context.Background(), "status", unknownStatus)
+
+		// then
+		assert.Empty(t, name)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.ErrorContains(t, err, "value=999")
+		assert.ErrorContains(t, err, typespb.Status_STATUS_AVAILABLE.String())
+		assert.NotContains(t, err.Error(), typespb.Status_STATUS_UNSPECIFIED.String())
+	})
+
+	t.Run("should accept an unknown value if configured", func(t *testing.T) {
+		// given
+		enums := service.NewEnumValues(config.Compatibility{AcceptUnknownEnumValues: true})
+
+		// when
+		name, err := enums.Resolve(context.Background(), "status", unknownStatus)
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, model.UnknownEnumValue, name)
+	})
+}
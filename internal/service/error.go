@@ -5,9 +5,16 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/protoadapt"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
 )
 
 const (
@@ -49,12 +56,58 @@ var (
 	ErrTenantUpdate                     = status.Error(codes.Internal, UpdateTenantErrMsg)
 	ErrTenantDelete                     = status.Error(codes.Internal, DeleteTenantErrMsg)
 	ErrTenantIDFormat                   = status.Error(codes.InvalidArgument, "tenant ID is not valid")
+	ErrTenantIDGeneration               = status.Error(codes.Internal, "failed to generate tenant ID")
 	ErrTenantNotFound                   = status.Error(codes.NotFound, TenantNotFoundMsg)
 	ErrTenantUnavailable                = status.Error(codes.FailedPrecondition, TenantUnavailableErrMsg)
 	ErrTenantEncoding                   = status.Error(codes.Internal, "failed to encode tenant data")
 	ErrTenantStatusTransitionNotAllowed = errors.New(TenantStatusTransitionNotAllowedMsg)
 	ErrInvalidTenantStatus              = errors.New(InvalidTenantStatusMsg)
+	ErrTenantJobOutdated                = errors.New("tenant is not in the status of the job anymore")
 	ErrTenantUserGroups                 = status.Error(codes.InvalidArgument, UserGroupsNilMsg)
+	ErrMissingTenantContacts            = status.Error(codes.InvalidArgument, "at least one tenant contact must be set")
+	ErrTenantExportWrite                = status.Error(codes.Internal, "failed to write tenant export")
+	ErrTenantExportRead                 = status.Error(codes.Internal, "failed to read tenant export")
+	ErrTenantExportNotFound             = status.Error(codes.NotFound, "tenant export not found")
+	ErrTenantExportLocation             = status.Error(codes.InvalidArgument, "location is not in the export directory")
+	ErrTenantExportResume               = status.Error(codes.InvalidArgument, "resuming an export requires its location")
+	ErrTenantExportOffset               = status.Error(codes.InvalidArgument, "offset is beyond the end of the export")
+	ErrExportCompressionUnsupported     = status.Error(codes.InvalidArgument, "export compression is not supported")
+	ErrTenantDestroyDisabled            = status.Error(codes.PermissionDenied, "destroying tenants is not enabled")
+	ErrTenantDestroy                    = status.Error(codes.Internal, "failed to destroy tenant")
+	ErrTenantStatusNotPermitted         = status.Error(codes.FailedPrecondition, "operation is not permitted for the status of the tenant")
+	ErrOutsideMaintenanceWindow         = status.Error(codes.FailedPrecondition, "operation is outside the maintenance windows of the tenant")
+	ErrMaintenanceWindowInvalid         = status.Error(codes.InvalidArgument, "maintenance window is not valid")
+	ErrJobDelayCreate                   = status.Error(codes.Internal, "failed to schedule job")
+	ErrTenantTemplateNotFound           = status.Error(codes.InvalidArgument, "tenant template is not configured")
+	ErrTenantTemplateAuth               = status.Error(codes.Internal, "failed to apply auth of tenant template")
+	ErrJobDelayDelete                   = status.Error(codes.Internal, "failed to release delayed job")
+	ErrTerminationNotPending            = status.Error(codes.FailedPrecondition, "termination of the tenant is not pending")
+	ErrTenantBlockDisabled              = status.Error(codes.FailedPrecondition, "blocking tenants for a period is not enabled")
+	ErrTenantBlockExpiryInvalid         = status.Error(codes.InvalidArgument, "block must either expire at a future time or after a positive duration")
+	ErrTenantBlockTooLong               = status.Error(codes.InvalidArgument, "block exceeds the maximum duration")
+	ErrTenantStatusTimeRegression       = status.Error(codes.FailedPrecondition, "status time of the tenant is ahead of the database clock")
+)
+
+var (
+	ErrChangeFeedDisabled = status.Error(codes.FailedPrecondition, "change feed is not enabled")
+	ErrChangeSelect       = status.Error(codes.Internal, "failed to select changes")
+	ErrChangesPruned      = status.Error(codes.OutOfRange, "changes following the sequence are pruned")
+)
+
+var (
+	ErrExternalIDIndexDisabled = status.Error(codes.FailedPrecondition, "external ID index is not enabled")
+	ErrExternalIDNotFound      = status.Error(codes.NotFound, "no resource with the external ID found")
+	ErrExternalIDSelect        = status.Error(codes.Internal, "failed to resolve the external ID")
+)
+
+var (
+	ErrTenantImportDisabled        = status.Error(codes.FailedPrecondition, "importing tenants is not enabled")
+	ErrImportedStatusInvalid       = status.Error(codes.InvalidArgument, "imported status is not a known tenant status")
+	ErrImportedCreatedAtInvalid    = status.Error(codes.InvalidArgument, "creation time of the imported tenant must be set and not in the future")
+	ErrImportedStatusHistoryOrder  = status.Error(codes.InvalidArgument, "status history of the imported tenant must be in chronological order between its creation and now")
+	ErrImportedStatusHistoryStatus = status.Error(codes.InvalidArgument, "status history of the imported tenant must end with its status")
+	ErrTenantImportInsert          = status.Error(codes.Internal, "failed to insert imported tenant")
+	ErrTenantStatusChangeInsert    = status.Error(codes.Internal, "failed to insert tenant status history")
 )
 
 var (
@@ -62,6 +115,7 @@ var (
 	ErrSystemUpdate                         = status.Error(codes.Internal, UpdateSystemErrMsg)
 	ErrSystemDelete                         = status.Error(codes.Internal, DeleteSystemErrMsg)
 	ErrExternalIDIsEmpty                    = status.Error(codes.InvalidArgument, "external ID cannot be empty")
+	ErrSystemTypeIsEmpty                    = status.Error(codes.InvalidArgument, "system type cannot be empty")
 	ErrRegionIsEmpty                        = status.Error(codes.InvalidArgument, "region cannot be empty")
 	ErrSystemNotFound                       = status.Error(codes.NotFound, SystemNotFoundMsg)
 	ErrSystemIsLinkedToTenant               = status.Error(codes.FailedPrecondition, "system is linked to the tenant")
@@ -74,6 +128,142 @@ var (
 	ErrRegisterSystemNotAllowedWithTenantID = status.Error(codes.InvalidArgument, "system cannot be registered because other system(s) with same external ID and type are already linked to a different tenant")
 	ErrSystemProtoConversion                = status.Error(codes.Internal, "failed to convert system to proto message struct")
 	ErrTooManyTypes                         = status.Error(codes.FailedPrecondition, "cannot determine type")
+	ErrSystemPendingApproval                = status.Error(codes.FailedPrecondition, "system is pending approval")
+	ErrSystemNotPendingApproval             = status.Error(codes.FailedPrecondition, "system is not pending approval")
+	ErrSystemDiscovered                     = status.Error(codes.FailedPrecondition, "system is discovered and awaits confirmation")
+	ErrSystemNotDiscovered                  = status.Error(codes.FailedPrecondition, "system is not awaiting confirmation")
+	ErrSystemRegistrationDisabled           = status.Error(codes.FailedPrecondition, "registering systems in batches is not enabled")
+	ErrSystemRegistrationBatch              = status.Error(codes.Internal, "could not register the batch of systems")
+	ErrSystemLookupDisabled                 = status.Error(codes.FailedPrecondition, "looking up systems in batches is not enabled")
+	ErrSystemLookupEmpty                    = status.Error(codes.InvalidArgument, "no systems to look up")
+	ErrSystemLookupTooLarge                 = status.Error(codes.InvalidArgument, "too many systems to look up at once")
+	ErrSystemStatusTransition               = status.Error(codes.FailedPrecondition, "system status transition is not allowed")
+	ErrRegionalSystemL2KeyConflict          = status.Error(codes.FailedPrecondition, "regional system is already registered with a different L2 key")
+	ErrL2KeyNotAllowed                      = status.Error(codes.InvalidArgument, "L2 key ID is not allowed in the region")
+	ErrSystemLabelFilterEmpty               = status.Error(codes.InvalidArgument, "filter of the systems requires a tenant, region, type or label")
+)
+
+var (
+	ErrSystemGroupSelect   = status.Error(codes.Internal, "could not select system group")
+	ErrSystemGroupCreate   = status.Error(codes.Internal, "could not create system group")
+	ErrSystemGroupUpdate   = status.Error(codes.Internal, "could not update system group")
+	ErrSystemGroupDelete   = status.Error(codes.Internal, "could not delete system group")
+	ErrSystemGroupNotFound = status.Error(codes.NotFound, "system group not found")
+	ErrSystemGroupEmpty    = status.Error(codes.FailedPrecondition, "system group has no members")
+)
+
+var (
+	ErrUserGroupSelect   = status.Error(codes.Internal, "could not select user group")
+	ErrUserGroupCreate   = status.Error(codes.Internal, "could not create user group")
+	ErrUserGroupUpdate   = status.Error(codes.Internal, "could not update user group")
+	ErrUserGroupDelete   = status.Error(codes.Internal, "could not delete user group")
+	ErrUserGroupNotFound = status.Error(codes.NotFound, "user group not found")
+	ErrUserGroupRequired = status.Error(codes.FailedPrecondition, "user group is required by an auth")
+)
+
+var (
+	ErrServiceAccountSelect   = status.Error(codes.Internal, "could not select service account")
+	ErrServiceAccountCreate   = status.Error(codes.Internal, "could not create service account")
+	ErrServiceAccountUpdate   = status.Error(codes.Internal, "could not update service account")
+	ErrServiceAccountDelete   = status.Error(codes.Internal, "could not delete service account")
+	ErrServiceAccountNotFound = status.Error(codes.NotFound, "service account not found")
+	ErrServiceAccountInUse    = status.Error(codes.FailedPrecondition, "service account is referenced by an auth")
+	ErrServiceAccountInactive = status.Error(codes.FailedPrecondition, "service account is not active")
+)
+
+var (
+	ErrIdentityProviderSelect   = status.Error(codes.Internal, "could not select identity provider")
+	ErrIdentityProviderUpdate   = status.Error(codes.Internal, "could not update identity provider")
+	ErrIdentityProviderDelete   = status.Error(codes.Internal, "could not delete identity provider")
+	ErrIdentityProviderJob      = status.Error(codes.Internal, "could not start identity provider job")
+	ErrIdentityProviderNotFound = status.Error(codes.NotFound, "identity provider not found")
+	ErrIdentityProviderInvalid  = status.Error(codes.InvalidArgument, "identity provider is invalid")
+	ErrIdentityProviderBusy     = status.Error(codes.FailedPrecondition, "identity provider is being applied or removed")
+)
+
+var (
+	ErrNotificationPreferencesSelect   = status.Error(codes.Internal, "could not select notification preferences")
+	ErrNotificationPreferencesUpdate   = status.Error(codes.Internal, "could not update notification preferences")
+	ErrNotificationPreferencesNotFound = status.Error(codes.NotFound, "notification preferences not found")
+	ErrNotificationPreferencesInvalid  = status.Error(codes.InvalidArgument, "notification preferences are invalid")
+)
+
+var (
+	ErrSystemCredentialSelect     = status.Error(codes.Internal, "could not select system credential")
+	ErrSystemCredentialCreate     = status.Error(codes.Internal, "could not create system credential")
+	ErrSystemCredentialUpdate     = status.Error(codes.Internal, "could not update system credential")
+	ErrSystemCredentialNotFound   = status.Error(codes.NotFound, "system credential not found")
+	ErrSystemCredentialRevoked    = status.Error(codes.FailedPrecondition, "system credential is already revoked")
+	ErrSystemCredentialThumbprint = status.Error(codes.InvalidArgument, "thumbprint must be the hex encoded SHA-256 of the certificate")
+	ErrSystemCredentialValidity   = status.Error(codes.InvalidArgument, "validity window of the credential is not valid")
+	ErrSystemCredentialExpired    = status.Error(codes.InvalidArgument, "credential is already expired")
+
+	ErrSystemL2KeySelect = status.Error(codes.Internal, "could not select system L2 key history")
+	ErrSystemL2KeyCreate = status.Error(codes.Internal, "could not create system L2 key history")
+	ErrSystemL2KeyUpdate = status.Error(codes.Internal, "could not update system L2 key history")
+
+	ErrSystemLinkSelect = status.Error(codes.Internal, "could not select system link history")
+	ErrSystemLinkCreate = status.Error(codes.Internal, "could not create system link history")
+	ErrSystemLinkUpdate = status.Error(codes.Internal, "could not update system link history")
+	ErrTenantIDIsEmpty  = status.Error(codes.InvalidArgument, "tenant ID cannot be empty")
+	ErrAsOfInvalid      = status.Error(codes.InvalidArgument, "as of time must be set and not in the future")
+
+	ErrSystemRelationshipSelect         = status.Error(codes.Internal, "could not select system relationship")
+	ErrSystemRelationshipCreate         = status.Error(codes.Internal, "could not create system relationship")
+	ErrSystemRelationshipDelete         = status.Error(codes.Internal, "could not delete system relationship")
+	ErrSystemRelationshipNotFound       = status.Error(codes.NotFound, "system relationship not found")
+	ErrSystemRelationshipTypeNotAllowed = status.Error(codes.InvalidArgument, "system relationship type is not allowed")
+	ErrSystemRelationshipSelf           = status.Error(codes.InvalidArgument, "system can not have a relationship with itself")
+	ErrSystemRelationshipCycle          = status.Error(codes.FailedPrecondition, "system relationship would form a cycle")
+	ErrSystemRelationshipExclusive      = status.Error(codes.FailedPrecondition, "system already has a relationship of the exclusive type")
+
+	ErrSystemClassificationInvalid = status.Error(codes.InvalidArgument, "system environment or criticality is not allowed")
+	ErrSystemNotLinkableToTenant   = status.Error(codes.FailedPrecondition, "system of the environment cannot be linked to a tenant of the role")
+
+	ErrSystemDisplayEmpty = status.Error(codes.InvalidArgument, "display name or description must be set")
+)
+
+var (
+	ErrManifestConflict        = status.Error(codes.FailedPrecondition, "manifest conflicts with the existing resources")
+	ErrManifestTenantNotActive = status.Error(codes.FailedPrecondition, "systems and auths can only be applied to an active tenant, apply the manifest again once the tenant is active")
+)
+
+var (
+	ErrSnapshotSelect    = status.Error(codes.Internal, "could not select inventory snapshots")
+	ErrSnapshotDateRange = status.Error(codes.InvalidArgument, "snapshot date range is not valid")
+	ErrRegionUsage       = status.Error(codes.Internal, "could not count the usage of the region")
+)
+
+var ErrBackfillSelect = status.Error(codes.Internal, "could not select backfills")
+
+var (
+	ErrAdminCallerNotPermitted = status.Error(codes.PermissionDenied, "caller is not permitted to call the admin service")
+	ErrIntegrityVerify         = status.Error(codes.Internal, "failed to verify the integrity of the database")
+	ErrMaintenanceModeSelect   = status.Error(codes.Internal, "could not select maintenance mode")
+	ErrMaintenanceModeUpdate   = status.Error(codes.Internal, "could not update maintenance mode")
+	ErrMaintenanceMode         = status.Error(codes.Unavailable, "registry is in maintenance mode, please try again later")
+	ErrReadOnlyProfile         = status.Error(codes.FailedPrecondition, "instance only serves read calls, please send changes to a full instance")
+	ErrForcedStatusInvalid     = status.Error(codes.InvalidArgument, "forced status is not a known tenant status")
+	ErrLogControlDisabled      = status.Error(codes.FailedPrecondition, "log control is not enabled")
+	ErrLogControlInvalid       = status.Error(codes.InvalidArgument, "log control change is not valid")
+)
+
+var (
+	ErrConfigIntrospectionDisabled = status.Error(codes.FailedPrecondition, "config introspection is not enabled")
+	ErrConfigIntrospection         = status.Error(codes.Internal, "failed to return the effective configuration")
+)
+
+var (
+	ErrOperationSelect    = status.Error(codes.Internal, "could not select operations")
+	ErrOperationNotFound  = status.Error(codes.NotFound, "operation not found")
+	ErrOperationIDInvalid = status.Error(codes.InvalidArgument, "operation ID must be a UUID")
+
+	ErrJobOutcomeSelect     = status.Error(codes.Internal, "could not select job outcome")
+	ErrJobOutcomeUpdate     = status.Error(codes.Internal, "could not update job outcome")
+	ErrJobOutcomeReplay     = status.Error(codes.Internal, "failed to replay job outcome")
+	ErrJobOutcomeNotFound   = status.Error(codes.NotFound, "job outcome not found")
+	ErrJobOutcomeSuperseded = status.Error(codes.FailedPrecondition, "job outcome is superseded by a later job")
+	ErrJobReplayRateLimited = status.Error(codes.ResourceExhausted, "job outcome replay is rate limited")
 )
 
 var (
@@ -82,18 +272,38 @@ var (
 	ErrAuthNotFound      = status.Error(codes.NotFound, AuthNotFoundErrMsg)
 	ErrAuthAlreadyExists = status.Error(codes.AlreadyExists, AuthAlreadyExistsMsg)
 	ErrAuthInvalidStatus = status.Error(codes.FailedPrecondition, AuthInvalidStatusMsg)
+	ErrAuthStatusFilter  = status.Error(codes.InvalidArgument, "auth status filter is not valid")
+	ErrAuthTypeConflict  = status.Error(codes.AlreadyExists, "auth of the type which is not removed already exists for the tenant")
+
+	ErrAuthPropertyTooLarge = status.Error(codes.InvalidArgument, "auth property value exceeds the maximum size")
+	ErrAuthPropertyNotFound = status.Error(codes.NotFound, "auth property not found")
 )
 
 var (
 	ErrTranCtxTimeout          = status.Error(codes.Aborted, "transaction was aborted due to timeout, please try again")
+	ErrDatabaseUnavailable     = status.Error(codes.Unavailable, "database is unavailable, please try again later")
+	ErrResourceTypeUnknown     = status.Error(codes.InvalidArgument, "resource type is not described")
 	ErrPanic                   = status.Error(codes.Internal, "an unexpected error occurred on the server, please try again")
 	ErrKeyClaimAlreadyActive   = status.Error(codes.FailedPrecondition, "key claim is already active")
 	ErrKeyClaimAlreadyInactive = status.Error(codes.FailedPrecondition, "key claim is already inactive")
 	ErrMissingLabelKeys        = status.Error(codes.InvalidArgument, MissingLabelKeysMsg)
 	ErrMissingLabels           = status.Error(codes.InvalidArgument, MissingLabelsMsg)
 	ErrEmptyLabelKeys          = status.Error(codes.InvalidArgument, EmptyLabelKeysMsg)
+	ErrTooManyLabels           = status.Error(codes.InvalidArgument, "too many labels")
+	ErrLabelValueTooLong       = status.Error(codes.InvalidArgument, "label value is too long")
 	ErrValidationConversion    = status.Error(codes.Internal, "validation conversion error")
 	ErrValidationFailed        = status.Error(codes.InvalidArgument, ValidationFailedMsg)
+	ErrLegacyRequest           = status.Error(codes.InvalidArgument, "deprecated request shape is no longer supported, please update the client")
+	ErrUnknownEnumValue        = status.Error(codes.InvalidArgument, "enum value is not supported by this server")
+	ErrTooManyFilterValues     = status.Errorf(codes.InvalidArgument, "a filter must not have more than %d values", repository.MaxFilterValues)
+	ErrFilterExpression        = status.Error(codes.InvalidArgument, "filter expression is not valid")
+	ErrConcurrencyLimit        = status.Error(codes.ResourceExhausted, "too many concurrent requests of the method, please try again later")
+	ErrTenantQueueFull         = status.Error(codes.ResourceExhausted, "too many queued requests of the tenant, please try again later")
+)
+
+var (
+	ErrHookRejected = status.Error(codes.FailedPrecondition, "operation rejected by hook")
+	ErrHookFailed   = status.Error(codes.Unavailable, "hook failed, please try again later")
 )
 
 // ErrorWithParams will return an error with new message,
@@ -134,6 +344,118 @@ func ErrorWithParams(err error, params ...any) error {
 	return status.Error(sts.Code(), sts.Message()+suffix)
 }
 
+// Resource types of the ResourceInfo details of AlreadyExists errors.
+const (
+	ResourceTypeTenant      = "tenant"
+	ResourceTypeSystem      = "system"
+	ResourceTypeSystemGroup = "system_group"
+	ResourceTypeUserGroup   = "user_group"
+	ResourceTypeAuth        = "auth"
+
+	ResourceTypeServiceAccount = "service_account"
+
+	ResourceTypeSystemCredential   = "system_credential"
+	ResourceTypeSystemRelationship = "system_relationship"
+)
+
+// ErrorAlreadyExists returns an AlreadyExists error for the conflicting resource.
+// The resource type and the identifier of the existing resource are returned as ResourceInfo details,
+// so clients do not need to parse the message.
+func ErrorAlreadyExists(resourceType, resourceName string) error {
+	msg := fmt.Sprintf("%s already exists (id=%s)", resourceType, resourceName)
+
+	sts, err := status.New(codes.AlreadyExists, msg).WithDetails(&errdetails.ResourceInfo{
+		ResourceType: resourceType,
+		ResourceName: resourceName,
+		Description:  msg,
+	})
+	if err != nil {
+		return status.Error(codes.AlreadyExists, msg)
+	}
+
+	return sts.Err()
+}
+
+// ErrorWithRetryDelay returns the error with the delay after which the call should be retried as RetryInfo details,
+// e.g. while the database is unavailable.
+func ErrorWithRetryDelay(err error, delay time.Duration) error {
+	sts, ok := status.FromError(err)
+	if !ok {
+		sts = status.New(codes.Unavailable, err.Error())
+	}
+
+	withDetails, detailsErr := sts.WithDetails(&errdetails.RetryInfo{RetryDelay: durationpb.New(delay)})
+	if detailsErr != nil {
+		return sts.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// ErrorWithFieldViolations returns the error with the violated fields as BadRequest details,
+// so clients can tell which fields to correct without parsing the message.
+// Violations are merged into the BadRequest details the error already has.
+func ErrorWithFieldViolations(err error, violations ...*errdetails.BadRequest_FieldViolation) error {
+	if len(violations) == 0 {
+		return err
+	}
+
+	sts, ok := status.FromError(err)
+	if !ok {
+		sts = status.New(codes.InvalidArgument, err.Error())
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	details := make([]protoadapt.MessageV1, 0, len(sts.Details())+1)
+	for _, detail := range sts.Details() {
+		if existing, ok := detail.(*errdetails.BadRequest); ok {
+			badRequest.FieldViolations = append(badRequest.FieldViolations, existing.GetFieldViolations()...)
+			continue
+		}
+
+		if msg, ok := detail.(protoadapt.MessageV1); ok {
+			details = append(details, msg)
+		}
+	}
+
+	badRequest.FieldViolations = append(badRequest.FieldViolations, violations...)
+	details = append(details, badRequest)
+
+	withDetails, detailsErr := status.New(sts.Code(), sts.Message()).WithDetails(details...)
+	if detailsErr != nil {
+		return sts.Err()
+	}
+
+	return withDetails.Err()
+}
+
+// ErrorWithValidationDetails returns err with the field of the validation error as BadRequest details.
+// The description of the violation lists the allowed values if the value is not one of them.
+// err is returned as is if validationErr is not the error of a validation ID.
+func ErrorWithValidationDetails(err, validationErr error) error {
+	fieldErr, ok := errors.AsType[*validation.FieldError](validationErr)
+	if !ok {
+		return err
+	}
+
+	return ErrorWithFieldViolations(err, &errdetails.BadRequest_FieldViolation{
+		Field:       string(fieldErr.ID),
+		Description: fieldErr.Err.Error(),
+	})
+}
+
+// validationFailed returns ErrValidationFailed with the message and the violated field of the validation error.
+func validationFailed(err error) error {
+	return ErrorWithValidationDetails(ErrorWithParams(ErrValidationFailed, "err", err.Error()), err)
+}
+
+// isUniqueConstraintError returns true if the error is caused by a unique constraint violation.
+// The violation detail of the database is not returned to clients, as it exposes the schema.
+func isUniqueConstraintError(err error) bool {
+	_, ok := errors.AsType[*repository.UniqueConstraintError](err)
+	return ok
+}
+
 // mapError maps an error to a corresponding error.
 // if err == context.DeadlineExceeded returns ErrTranCtxTimeout.
 // else return input error.
@@ -145,3 +467,32 @@ func mapError(err error) error {
 		return err
 	}
 }
+
+// Classes of the errors of procedure calls, see ErrorClass.
+const (
+	ErrorClassValidation   = "validation"
+	ErrorClassNotFound     = "not_found"
+	ErrorClassPrecondition = "precondition"
+	ErrorClassConflict     = "conflict"
+	ErrorClassInternal     = "internal"
+	ErrorClassOther        = "other"
+)
+
+// ErrorClass returns the class of the error of a procedure call by its status code after mapError,
+// so the errors can be counted with a bounded cardinality.
+func ErrorClass(err error) string {
+	switch status.Code(mapError(err)) {
+	case codes.InvalidArgument, codes.OutOfRange:
+		return ErrorClassValidation
+	case codes.NotFound:
+		return ErrorClassNotFound
+	case codes.FailedPrecondition:
+		return ErrorClassPrecondition
+	case codes.AlreadyExists, codes.Aborted:
+		return ErrorClassConflict
+	case codes.Internal, codes.Unknown, codes.DataLoss, codes.DeadlineExceeded, codes.Unavailable, codes.Unimplemented:
+		return ErrorClassInternal
+	default:
+		return ErrorClassOther
+	}
+}
@@ -4,11 +4,15 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
 )
 
 var errSomething = errors.New("error something")
@@ -48,6 +52,33 @@ func TestMapError(t *testing.T) {
 	}
 }
 
+func TestErrorClass(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expClass string
+	}{
+		{name: "invalid argument", err: service.ErrValidationFailed, expClass: service.ErrorClassValidation},
+		{name: "not found", err: service.ErrTenantNotFound, expClass: service.ErrorClassNotFound},
+		{name: "failed precondition", err: service.ErrTenantUnavailable, expClass: service.ErrorClassPrecondition},
+		{name: "already exists", err: status.Error(codes.AlreadyExists, "exists"), expClass: service.ErrorClassConflict},
+		{name: "mapped deadline", err: context.DeadlineExceeded, expClass: service.ErrorClassConflict},
+		{name: "internal", err: service.ErrTenantDelete, expClass: service.ErrorClassInternal},
+		{name: "plain error", err: errSomething, expClass: service.ErrorClassInternal},
+		{name: "permission denied", err: service.ErrTenantDestroyDisabled, expClass: service.ErrorClassOther},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			class := service.ErrorClass(tt.err)
+
+			// then
+			assert.Equal(t, tt.expClass, class)
+		})
+	}
+}
+
 func TestErrorWithParams(t *testing.T) {
 	// given
 	testCases := []struct {
@@ -122,3 +153,118 @@ func TestErrorWithParams(t *testing.T) {
 		})
 	}
 }
+
+func TestErrorAlreadyExists(t *testing.T) {
+	// when
+	err := service.ErrorAlreadyExists(service.ResourceTypeTenant, "tenant-id")
+
+	// then
+	sts, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.AlreadyExists, sts.Code())
+	assert.Contains(t, sts.Message(), "tenant-id")
+
+	details := sts.Details()
+	assert.Len(t, details, 1)
+
+	info, ok := details[0].(*errdetails.ResourceInfo)
+	assert.True(t, ok)
+	assert.Equal(t, service.ResourceTypeTenant, info.GetResourceType())
+	assert.Equal(t, "tenant-id", info.GetResourceName())
+}
+
+func TestErrorWithRetryDelay(t *testing.T) {
+	// when
+	err := service.ErrorWithRetryDelay(service.ErrDatabaseUnavailable, 3*time.Second)
+
+	// then
+	sts, ok := status.FromError(err)
+	assert.True(t, ok)
+	assert.Equal(t, codes.Unavailable, sts.Code())
+	assert.Len(t, sts.Details(), 1)
+
+	retryInfo, ok := sts.Details()[0].(*errdetails.RetryInfo)
+	assert.True(t, ok)
+	assert.Equal(t, 3*time.Second, retryInfo.GetRetryDelay().AsDuration())
+}
+
+func TestErrorWithFieldViolations(t *testing.T) {
+	t.Run("should return the violations as BadRequest details", func(t *testing.T) {
+		// when
+		err := service.ErrorWithFieldViolations(service.ErrValidationFailed,
+			&errdetails.BadRequest_FieldViolation{Field: "id", Description: "value is required"})
+
+		// then
+		sts, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, sts.Code())
+		assert.Len(t, sts.Details(), 1)
+
+		badRequest, ok := sts.Details()[0].(*errdetails.BadRequest)
+		assert.True(t, ok)
+		assert.Len(t, badRequest.GetFieldViolations(), 1)
+	})
+
+	t.Run("should merge the violations into existing BadRequest details", func(t *testing.T) {
+		// given
+		err := service.ErrorWithFieldViolations(service.ErrValidationFailed,
+			&errdetails.BadRequest_FieldViolation{Field: "id", Description: "value is required"})
+
+		// when
+		err = service.ErrorWithFieldViolations(err,
+			&errdetails.BadRequest_FieldViolation{Field: "region", Description: "value is required"})
+
+		// then
+		sts, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Len(t, sts.Details(), 1)
+
+		badRequest, ok := sts.Details()[0].(*errdetails.BadRequest)
+		assert.True(t, ok)
+		assert.Len(t, badRequest.GetFieldViolations(), 2)
+		assert.Equal(t, "id", badRequest.GetFieldViolations()[0].GetField())
+		assert.Equal(t, "region", badRequest.GetFieldViolations()[1].GetField())
+	})
+
+	t.Run("should return the error without violations", func(t *testing.T) {
+		// when
+		err := service.ErrorWithFieldViolations(service.ErrValidationFailed)
+
+		// then
+		assert.Equal(t, service.ErrValidationFailed, err)
+	})
+}
+
+func TestErrorWithValidationDetails(t *testing.T) {
+	t.Run("should return the field and the allowed values as BadRequest details", func(t *testing.T) {
+		// given
+		validationErr := &validation.FieldError{
+			ID:  "Tenant.Region",
+			Err: &validation.NotAllowedError{Value: "region3", Allowed: []string{"region1", "region2"}},
+		}
+
+		// when
+		err := service.ErrorWithValidationDetails(service.ErrValidationFailed, validationErr)
+
+		// then
+		sts, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, sts.Code())
+		assert.Len(t, sts.Details(), 1)
+
+		badRequest, ok := sts.Details()[0].(*errdetails.BadRequest)
+		assert.True(t, ok)
+		assert.Len(t, badRequest.GetFieldViolations(), 1)
+		assert.Equal(t, "Tenant.Region", badRequest.GetFieldViolations()[0].GetField())
+		assert.Equal(t, "value is not allowed: region3, allowed values are region1, region2",
+			badRequest.GetFieldViolations()[0].GetDescription())
+	})
+
+	t.Run("should return the error without validation ID as is", func(t *testing.T) {
+		// when
+		err := service.ErrorWithValidationDetails(service.ErrValidationFailed, errSomething)
+
+		// then
+		assert.Equal(t, service.ErrValidationFailed, err)
+	})
+}
@@ -1,5 +1,168 @@
 package service
 
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+)
+
 var (
-	MapError = mapError
+	MapError                 = mapError
+	FilterValues             = filterValues
+	SuggestPlacement         = suggestPlacement
+	DesiredWorkers           = desiredWorkers
+	Redact                   = redact
+	MergeSystems             = mergeRegionalSystems
+	MergeLabels              = mergeLabels
+	RemoveLabels             = removeLabels
+	MissingLabelKeys         = missingLabelKeys
+	NormalizeThumbprint      = normalizeThumbprint
+	ValidateSystemCredential = validateSystemCredential
+	SystemsToProto           = systemsToProto
+	SizeBucket               = sizeBucket
+	EncodeTenantJobPayload   = tenantJobPayload.encode
+	DecodeTenantJobPayload   = tenantJobPayload.decode
+	DecodeAuthJobPayload     = authJobPayload.decode
+	LinkDrift                = linkDrift
+	DecodeSystemObserved     = decodeSystemObserved
+	WhereAuthStatusFilter    = whereAuthStatusFilter
+	ValidateJobStatus        = validateJobStatus
+	RegionAcksOf             = regionAcksOf
+
+	ValidateIdentityProviderURLs  = validateIdentityProviderURLs
+	ClearedIdentityProviderFields = clearedIdentityProviderFields
+
+	ValidateNotificationPreferences = validateNotificationPreferences
+
+	ExcludeAnonymizedOwners = excludeAnonymizedOwners
+	AnonymizedTenantColumns = anonymizedTenantColumns
+
+	ImmutableFieldChanged = immutableFieldChanged
 )
+
+func (i *TenantIDs) Resolve(ctx context.Context, id string) (string, error) {
+	return i.resolve(ctx, id)
+}
+
+func (l *LegacyRequests) Handle(ctx context.Context, method, field string) error {
+	return l.handle(ctx, method, field)
+}
+
+func (l *LegacyRequests) EmptyList(ctx context.Context, method string, notFound error) error {
+	return l.emptyList(ctx, method, notFound)
+}
+
+func (e *EnumValues) Resolve(ctx context.Context, field string, value protoreflect.Enum) (string, error) {
+	return e.resolve(ctx, field, value)
+}
+
+func (l *Labels) CheckSet(current, labels map[string]string) error {
+	return l.checkSet(current, labels)
+}
+
+func (l *Labels) ValidateRemove(keys []string) error {
+	return l.validateRemove(keys)
+}
+
+func (l *Labels) SystemDefaults(labels map[string]string) map[string]string {
+	return l.systemDefaults(labels)
+}
+
+func (l *Labels) Inherit(ctx context.Context, previous, current, labels map[string]string) (map[string]string, bool) {
+	return l.inherit(ctx, previous, current, labels)
+}
+
+func BatchStream[T any](ctx context.Context, recv func() (T, error), size int, interval time.Duration, flush func([]T) error) error {
+	return batchStream(ctx, recv, size, interval, flush)
+}
+
+// ScheduleMaintenance returns when a job disrupting a tenant with the windows runs at now under the policy.
+func ScheduleMaintenance(ctx context.Context, policy string, now time.Time, windows model.MaintenanceWindows) (time.Time, error) {
+	s := newMaintenanceScheduler(policy)
+	s.now = func() time.Time { return now }
+	return s.schedule(ctx, windows)
+}
+
+// ScheduleMaintenanceAfter returns when a job disrupting a tenant with the windows, held back until notBefore, runs at now.
+func ScheduleMaintenanceAfter(ctx context.Context, policy string, now time.Time, windows model.MaintenanceWindows, notBefore time.Time) (time.Time, error) {
+	s := newMaintenanceScheduler(policy)
+	s.now = func() time.Time { return now }
+	return s.scheduleAfter(ctx, windows, notBefore)
+}
+
+func (t *TenantTemplates) Lookup(name string) error {
+	return t.lookup(name)
+}
+
+func (t *TenantTemplates) Expand(tenant *model.Tenant, name string) {
+	t.expand(tenant, name)
+}
+
+func NewTenantBlocksAt(cfg config.TenantBlock, now time.Time) *TenantBlocks {
+	b := NewTenantBlocks(nil, nil, cfg)
+	b.now = func() time.Time { return now }
+	return b
+}
+
+func (b *TenantBlocks) Expiry(until time.Time, duration time.Duration) (time.Time, error) {
+	return b.expiry(until, duration)
+}
+
+func NewTenantTerminationsAt(cfg config.TenantTermination, now time.Time) *TenantTerminations {
+	t := NewTenantTerminations(cfg)
+	t.now = func() time.Time { return now }
+	return t
+}
+
+func (t *TenantTerminations) EffectiveAt() time.Time {
+	return t.effectiveAt()
+}
+
+func (t *TenantTerminations) StatusAt(effectiveAt time.Time) model.TenantStatus {
+	return t.statusAt(effectiveAt)
+}
+
+func (t *TenantTerminations) ValidateCancel(tenant *model.Tenant) error {
+	return t.validateCancel(tenant)
+}
+
+func (s *SystemStatuses) ValidateTransition(from, to string) error {
+	return s.validateTransition(from, to)
+}
+
+func (s *SystemStatuses) Rollup(regionalSystems []model.RegionalSystem) string {
+	return s.rollup(regionalSystems)
+}
+
+func (o *Orbital) HandleOutcome(ctx context.Context, id uuid.UUID, outcome *model.JobOutcome) error {
+	return o.handleOutcome(ctx, id, outcome)
+}
+
+func (s *SystemIdentifiers) Validate(ctx context.Context, field string, identifiers []model.SystemIdentifier) error {
+	return s.validate(ctx, field, identifiers)
+}
+
+// CheckTables estimates the bloat of the tables and maintains the bloated tables at now.
+func (m *DBMaintenance) CheckTables(ctx context.Context, now time.Time) {
+	m.now = func() time.Time { return now }
+	m.check(ctx)
+}
+
+func NewTenantImportsAt(cfg config.TenantImport, now time.Time) *TenantImports {
+	i := NewTenantImports(nil, nil, cfg)
+	i.now = func() time.Time { return now }
+	return i
+}
+
+func (i *TenantImports) Validate(tenant *model.Tenant, history []model.TenantStatusChange) error {
+	return i.validate(tenant, history)
+}
+
+func L1KeyClaimError(system *model.System, regionalSystem *model.RegionalSystem) error {
+	return l1KeyClaimError(system, regionalSystem)
+}
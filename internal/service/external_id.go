@@ -0,0 +1,103 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strings"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// maxResolvedResources is the maximum number of resources an external ID is resolved to.
+const maxResolvedResources = 100
+
+// Kinds of the resources resolved by their external ID.
+const (
+	ResourceKindTenant = "tenant"
+	ResourceKindSystem = "system"
+	ResourceKindAuth   = "auth"
+)
+
+// ExternalIDIndexResources are the resources known by an external ID, whose external IDs are indexed
+// if the external ID index is enabled.
+var ExternalIDIndexResources = []repository.ExternallyIdentified{
+	&model.Tenant{},
+	&model.System{},
+	&model.Auth{},
+}
+
+// resourceKinds are the kinds of the indexed resources by their table.
+var resourceKinds = map[string]string{
+	(&model.Tenant{}).TableName(): ResourceKindTenant,
+	(&model.System{}).TableName(): ResourceKindSystem,
+	(&model.Auth{}).TableName():   ResourceKindAuth,
+}
+
+// ResolvedResource is a resource known by a resolved external ID.
+type ResolvedResource struct {
+	// Kind is the kind of the resource, e.g. system.
+	Kind string
+	// ID is the canonical ID of the resource, e.g. the ID of a system, which differs from its external ID.
+	ID string
+}
+
+// ExternalIDs resolves external IDs to the resources known by them, e.g. for support tooling starting
+// from whatever ID a customer provided. External IDs are not unique across kinds, a tenant and an auth
+// may share an external ID, and systems of different types may share an external ID as well.
+type ExternalIDs struct {
+	repo repository.Repository
+	cfg  config.ExternalIDIndex
+}
+
+// NewExternalIDs creates and returns a new instance of ExternalIDs.
+func NewExternalIDs(repo repository.Repository, cfg config.ExternalIDIndex) *ExternalIDs {
+	return &ExternalIDs{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// ResolveExternalID returns the resources known by the external ID, ordered by kind and ID.
+func (e *ExternalIDs) ResolveExternalID(ctx context.Context, externalID string) ([]ResolvedResource, error) {
+	slogctx.Debug(ctx, "ResolveExternalID called", "externalId", externalID)
+
+	if !e.cfg.Enabled {
+		return nil, ErrExternalIDIndexDisabled
+	}
+
+	if externalID == "" {
+		return nil, ErrExternalIDIsEmpty
+	}
+
+	var entries []model.ExternalIDIndexEntry
+	err := e.repo.List(ctx, &entries, *repository.NewQuery(&model.ExternalIDIndexEntry{}).
+		Where(repository.NewCompositeKey().Where(repository.ExternalIDField, externalID)).
+		SetLimit(maxResolvedResources))
+	if err != nil {
+		slogctx.Error(ctx, "failed to select external ID index entries", "error", err, "externalId", externalID)
+		return nil, ErrExternalIDSelect
+	}
+
+	if len(entries) == 0 {
+		return nil, ErrExternalIDNotFound
+	}
+
+	resources := make([]ResolvedResource, 0, len(entries))
+	for _, entry := range entries {
+		resources = append(resources, ResolvedResource{
+			Kind: resourceKinds[entry.ResourceType],
+			ID:   entry.ResourceID,
+		})
+	}
+
+	slices.SortFunc(resources, func(a, b ResolvedResource) int {
+		return cmp.Or(strings.Compare(a.Kind, b.Kind), strings.Compare(a.ID, b.ID))
+	})
+
+	return resources, nil
+}
@@ -0,0 +1,274 @@
+package service
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+type (
+	// Hook is custom business logic called at the phases of an operation with its request, e.g. a check
+	// that a CMDB entry exists before a system is registered. It rejects the operation by returning
+	// a HookRejection, any other error is a failure of the hook handled by its failure policy.
+	Hook interface {
+		CallHook(ctx context.Context, call HookCall) error
+	}
+
+	// HookCall is the call of a hook at a phase of an operation.
+	HookCall struct {
+		// Operation is the full gRPC method name of the operation.
+		Operation string
+		Phase     config.HookPhase
+		Request   proto.Message
+	}
+
+	// HookRejection rejects an operation with the reason of the hook, which is returned to the client.
+	HookRejection struct {
+		Reason string
+	}
+
+	// HookOptions define when a hook is called and how its failures are handled.
+	HookOptions struct {
+		Phases []config.HookPhase
+		// Timeout is the maximum duration of a call of the hook, zero for no timeout.
+		Timeout       time.Duration
+		FailurePolicy config.HookFailurePolicy
+	}
+
+	// Hooks holds the hooks of the operations and calls them in the order they were registered.
+	Hooks struct {
+		mu    sync.RWMutex
+		hooks map[string][]registeredHook
+	}
+
+	registeredHook struct {
+		name string
+		hook Hook
+		opts HookOptions
+	}
+
+	// GRPCHook calls an external hook implementing the HookService of the extension API.
+	GRPCHook struct {
+		client extensiongrpc.HookServiceClient
+	}
+)
+
+// NewHooks creates and returns a new instance of Hooks without hooks.
+func NewHooks() *Hooks {
+	return &Hooks{
+		hooks: make(map[string][]registeredHook),
+	}
+}
+
+// NewConfiguredHooks creates and returns a new instance of Hooks with the configured external hooks.
+// The connections to the hooks are established by their first calls.
+func NewConfiguredHooks(cfg config.Hooks) (*Hooks, error) {
+	hooks := NewHooks()
+	if !cfg.Enabled {
+		return hooks, nil
+	}
+
+	for _, callout := range cfg.Callouts {
+		conn, err := newHookClientConn(callout)
+		if err != nil {
+			return nil, fmt.Errorf("hook %s: %w", callout.Name, err)
+		}
+
+		hooks.Register(callout.Operation, callout.Name, NewGRPCHook(extensiongrpc.NewHookServiceClient(conn)), HookOptions{
+			Phases:        callout.Phases,
+			Timeout:       callout.Timeout,
+			FailurePolicy: callout.FailurePolicy,
+		})
+	}
+
+	return hooks, nil
+}
+
+// newHookClientConn creates the client connection to the gRPC server of the hook, authenticated by its auth.
+func newHookClientConn(callout config.HookCallout) (*grpc.ClientConn, error) {
+	var creds credentials.TransportCredentials
+
+	switch callout.Auth.Type {
+	case config.AuthTypeMTLS:
+		tlsConfig, err := mtlsClientConfig(callout.Auth.MTLS)
+		if err != nil {
+			return nil, err
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	case config.AuthTypeNone:
+		creds = insecure.NewCredentials()
+	default:
+		return nil, fmt.Errorf("%w: %s", config.ErrUnsupportedAuthType, callout.Auth.Type)
+	}
+
+	return grpc.NewClient(callout.Address, grpc.WithTransportCredentials(creds))
+}
+
+var errNoCACertificates = errors.New("CA file contains no certificates")
+
+// mtlsClientConfig returns the TLS configuration of a client authenticated by its certificate.
+func mtlsClientConfig(cfg *config.MTLS) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(cfg.CertFile, cfg.KeyFile)
+	if err != nil {
+		return nil, err
+	}
+
+	ca, err := os.ReadFile(cfg.CAFile)
+	if err != nil {
+		return nil, err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(ca) {
+		return nil, fmt.Errorf("%w: %s", errNoCACertificates, cfg.CAFile)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		RootCAs:      pool,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
+
+// NewGRPCHook creates and returns a new instance of GRPCHook.
+func NewGRPCHook(client extensiongrpc.HookServiceClient) *GRPCHook {
+	return &GRPCHook{
+		client: client,
+	}
+}
+
+func (r *HookRejection) Error() string {
+	return "rejected by hook: " + r.Reason
+}
+
+// Register adds the hook of the operation, identified by its full gRPC method name, e.g. by custom builds
+// of the registry. The name identifies the hook in logs and errors.
+func (h *Hooks) Register(operation, name string, hook Hook, opts HookOptions) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.hooks[operation] = append(h.hooks[operation], registeredHook{name: name, hook: hook, opts: opts})
+}
+
+// Hooked returns true if hooks are registered for the operation.
+func (h *Hooks) Hooked(operation string) bool {
+	if h == nil {
+		return false
+	}
+
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	return len(h.hooks[operation]) > 0
+}
+
+// Call calls the hooks of the operation at the phase. It returns ErrHookRejected if a hook rejects
+// the operation and ErrHookFailed if a hook with the fail policy fails. Failures and rejections
+// of postCommit hooks are logged only, as the operation is committed already.
+func (h *Hooks) Call(ctx context.Context, phase config.HookPhase, operation string, req proto.Message) error {
+	if h == nil {
+		return nil
+	}
+
+	h.mu.RLock()
+	hooks := h.hooks[operation]
+	h.mu.RUnlock()
+
+	call := HookCall{Operation: operation, Phase: phase, Request: req}
+	for _, hook := range hooks {
+		if !slices.Contains(hook.opts.Phases, phase) {
+			continue
+		}
+
+		err := hook.call(ctx, call)
+		if err == nil {
+			continue
+		}
+
+		logCtx := slogctx.With(ctx, "hook", hook.name, "operation", operation, "phase", phase)
+
+		if phase == config.HookPhasePostCommit {
+			slogctx.Error(logCtx, "hook failed after the operation was committed", "error", err)
+			continue
+		}
+
+		var rejection *HookRejection
+		if errors.As(err, &rejection) {
+			slogctx.Info(logCtx, "operation rejected by hook", "reason", rejection.Reason)
+			return ErrorWithParams(ErrHookRejected, "hook", hook.name, "reason", rejection.Reason)
+		}
+
+		if hook.opts.FailurePolicy == config.HookFailurePolicyIgnore {
+			slogctx.Warn(logCtx, "hook failed, continuing by its failure policy", "error", err)
+			continue
+		}
+
+		slogctx.Error(logCtx, "hook failed", "error", err)
+		return ErrorWithParams(ErrHookFailed, "hook", hook.name)
+	}
+
+	return nil
+}
+
+// call calls the hook within its timeout.
+func (r registeredHook) call(ctx context.Context, call HookCall) error {
+	if r.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, r.opts.Timeout)
+		defer cancel()
+	}
+
+	return r.hook.CallHook(ctx, call)
+}
+
+// CallHook invokes the external hook with the request of the operation and the client data of the caller as metadata.
+func (g *GRPCHook) CallHook(ctx context.Context, call HookCall) error {
+	request, err := anypb.New(call.Request)
+	if err != nil {
+		return err
+	}
+
+	resp, err := g.client.InvokeHook(withOutgoingClientData(ctx), &extensiongrpc.InvokeHookRequest{
+		Operation: call.Operation,
+		Phase:     string(call.Phase),
+		Request:   request,
+		Caller:    repository.CallerFromContext(ctx),
+	})
+	if err != nil {
+		return err
+	}
+
+	if !resp.GetAllowed() {
+		return &HookRejection{Reason: resp.GetReason()}
+	}
+
+	return nil
+}
+
+// withOutgoingClientData returns a copy of ctx whose outgoing metadata holds the client data of the caller.
+func withOutgoingClientData(ctx context.Context) context.Context {
+	for header, value := range repository.ClientDataFromContext(ctx) {
+		ctx = metadata.AppendToOutgoingContext(ctx, header, value)
+	}
+
+	return ctx
+}
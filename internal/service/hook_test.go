@@ -0,0 +1,226 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+const registerTenantMethod = "/kms.api.cmk.registry.tenant.v1.Service/RegisterTenant"
+
+var errHookUnavailable = errors.New("hook unavailable")
+
+type hookFunc func(ctx context.Context, call service.HookCall) error
+
+func (f hookFunc) CallHook(ctx context.Context, call service.HookCall) error {
+	return f(ctx, call)
+}
+
+func TestHooksCall(t *testing.T) {
+	allPhases := []config.HookPhase{config.HookPhasePreValidate, config.HookPhasePreCommit, config.HookPhasePostCommit}
+	rejecting := hookFunc(func(context.Context, service.HookCall) error {
+		return &service.HookRejection{Reason: "no CMDB entry"}
+	})
+	failing := hookFunc(func(context.Context, service.HookCall) error {
+		return errHookUnavailable
+	})
+
+	tests := []struct {
+		name    string
+		hook    service.Hook
+		opts    service.HookOptions
+		phase   config.HookPhase
+		expCode codes.Code
+	}{
+		{
+			name:    "rejected",
+			hook:    rejecting,
+			opts:    service.HookOptions{Phases: allPhases, FailurePolicy: config.HookFailurePolicyIgnore},
+			phase:   config.HookPhasePreValidate,
+			expCode: codes.FailedPrecondition,
+		},
+		{
+			name:    "failed with fail policy",
+			hook:    failing,
+			opts:    service.HookOptions{Phases: allPhases, FailurePolicy: config.HookFailurePolicyFail},
+			phase:   config.HookPhasePreCommit,
+			expCode: codes.Unavailable,
+		},
+		{
+			name:  "failed with ignore policy",
+			hook:  failing,
+			opts:  service.HookOptions{Phases: allPhases, FailurePolicy: config.HookFailurePolicyIgnore},
+			phase: config.HookPhasePreCommit,
+		},
+		{
+			name:  "rejected after commit",
+			hook:  rejecting,
+			opts:  service.HookOptions{Phases: allPhases, FailurePolicy: config.HookFailurePolicyFail},
+			phase: config.HookPhasePostCommit,
+		},
+		{
+			name:  "not called at other phases",
+			hook:  rejecting,
+			opts:  service.HookOptions{Phases: []config.HookPhase{config.HookPhasePostCommit}},
+			phase: config.HookPhasePreValidate,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := service.NewHooks()
+			subj.Register(registerTenantMethod, "cmdb", tt.hook, tt.opts)
+
+			// when
+			err := subj.Call(t.Context(), tt.phase, registerTenantMethod, &tenantgrpc.RegisterTenantRequest{})
+
+			// then
+			if tt.expCode != codes.OK {
+				assert.Equal(t, tt.expCode, status.Code(err))
+				assert.Contains(t, err.Error(), "hook=cmdb")
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+
+	t.Run("calls the hooks in order until one rejects", func(t *testing.T) {
+		// given
+		subj := service.NewHooks()
+		var called []string
+		for _, name := range []string{"first", "second", "third"} {
+			subj.Register(registerTenantMethod, name, hookFunc(func(_ context.Context, call service.HookCall) error {
+				called = append(called, name)
+				assert.Equal(t, registerTenantMethod, call.Operation)
+				assert.Equal(t, config.HookPhasePreValidate, call.Phase)
+				if name == "second" {
+					return &service.HookRejection{Reason: "denied"}
+				}
+				return nil
+			}), service.HookOptions{Phases: allPhases})
+		}
+
+		// when
+		err := subj.Call(t.Context(), config.HookPhasePreValidate, registerTenantMethod, &tenantgrpc.RegisterTenantRequest{})
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "reason=denied")
+		assert.Equal(t, []string{"first", "second"}, called)
+	})
+
+	t.Run("calls the hook within its timeout", func(t *testing.T) {
+		// given
+		subj := service.NewHooks()
+		subj.Register(registerTenantMethod, "slow", hookFunc(func(ctx context.Context, _ service.HookCall) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}), service.HookOptions{Phases: allPhases, Timeout: time.Millisecond, FailurePolicy: config.HookFailurePolicyFail})
+
+		// when
+		err := subj.Call(t.Context(), config.HookPhasePreValidate, registerTenantMethod, &tenantgrpc.RegisterTenantRequest{})
+
+		// then
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("other operations are not hooked", func(t *testing.T) {
+		// given
+		subj := service.NewHooks()
+		subj.Register(registerTenantMethod, "cmdb", rejecting, service.HookOptions{Phases: allPhases})
+
+		// then
+		assert.True(t, subj.Hooked(registerTenantMethod))
+		assert.False(t, subj.Hooked("/kms.api.cmk.registry.tenant.v1.Service/GetTenant"))
+		assert.NoError(t, subj.Call(t.Context(), config.HookPhasePreValidate, "/kms.api.cmk.registry.tenant.v1.Service/GetTenant", nil))
+	})
+}
+
+type hookServiceClient struct {
+	resp *extensiongrpc.InvokeHookResponse
+	err  error
+	req  *extensiongrpc.InvokeHookRequest
+	md   metadata.MD
+}
+
+func (c *hookServiceClient) InvokeHook(ctx context.Context, in *extensiongrpc.InvokeHookRequest, _ ...grpc.CallOption) (*extensiongrpc.InvokeHookResponse, error) {
+	c.req = in
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	return c.resp, c.err
+}
+
+func TestGRPCHookCallHook(t *testing.T) {
+	tests := []struct {
+		name         string
+		resp         *extensiongrpc.InvokeHookResponse
+		err          error
+		expRejection string
+		expErr       error
+	}{
+		{name: "allowed", resp: &extensiongrpc.InvokeHookResponse{Allowed: true}},
+		{name: "rejected", resp: &extensiongrpc.InvokeHookResponse{Reason: "no CMDB entry"}, expRejection: "no CMDB entry"},
+		{name: "failed", err: errHookUnavailable, expErr: errHookUnavailable},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			client := &hookServiceClient{resp: tt.resp, err: tt.err}
+			subj := service.NewGRPCHook(client)
+			req := &tenantgrpc.RegisterTenantRequest{Id: "tenant-1"}
+
+			// when
+			err := subj.CallHook(t.Context(), service.HookCall{Operation: registerTenantMethod, Phase: config.HookPhasePreCommit, Request: req})
+
+			// then
+			var rejection *service.HookRejection
+			switch {
+			case tt.expRejection != "":
+				require.ErrorAs(t, err, &rejection)
+				assert.Equal(t, tt.expRejection, rejection.Reason)
+			case tt.expErr != nil:
+				assert.ErrorIs(t, err, tt.expErr)
+			default:
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, registerTenantMethod, client.req.GetOperation())
+			assert.Equal(t, "preCommit", client.req.GetPhase())
+
+			var sent tenantgrpc.RegisterTenantRequest
+			require.NoError(t, client.req.GetRequest().UnmarshalTo(&sent))
+			assert.Equal(t, "tenant-1", sent.GetId())
+		})
+	}
+}
+
+func TestGRPCHookCallHookClientData(t *testing.T) {
+	// given
+	client := &hookServiceClient{resp: &extensiongrpc.InvokeHookResponse{Allowed: true}}
+	subj := service.NewGRPCHook(client)
+	ctx := repository.WithClientData(t.Context(), map[string]string{"x-client-data": "data", "x-client-data-signature": "signature"})
+
+	// when
+	err := subj.CallHook(ctx, service.HookCall{Operation: registerTenantMethod, Phase: config.HookPhasePreCommit, Request: &tenantgrpc.RegisterTenantRequest{}})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, []string{"data"}, client.md.Get("x-client-data"))
+	assert.Equal(t, []string{"signature"}, client.md.Get("x-client-data-signature"))
+}
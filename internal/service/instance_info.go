@@ -0,0 +1,30 @@
+package service
+
+import (
+	"encoding/json"
+	"net/http"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+// InstanceInfo is the build and status information of the instance, e.g. to tell read-only replicas from full instances.
+type InstanceInfo struct {
+	Version string         `json:"version"`
+	Profile config.Profile `json:"profile"`
+}
+
+// ServeHTTP serves the instance information as JSON.
+func (i InstanceInfo) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(i); err != nil {
+		slogctx.Error(r.Context(), "failed to write instance info", "error", err)
+	}
+}
@@ -0,0 +1,42 @@
+package service_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestInstanceInfoServeHTTP(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		expStatus int
+		expBody   string
+	}{
+		{name: "get", method: http.MethodGet, expStatus: http.StatusOK, expBody: `{"version":"1.2.3","profile":"readOnly"}`},
+		{name: "post", method: http.MethodPost, expStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := service.InstanceInfo{Version: "1.2.3", Profile: config.ProfileReadOnly}
+			req := httptest.NewRequest(tt.method, "/info", nil)
+			rec := httptest.NewRecorder()
+
+			// when
+			subj.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.expStatus, rec.Code)
+			if tt.expBody != "" {
+				assert.JSONEq(t, tt.expBody, rec.Body.String())
+			}
+		})
+	}
+}
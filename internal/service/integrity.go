@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/openkcm/orbital"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Severity is the severity of an integrity finding.
+type Severity string
+
+const (
+	// SeverityError marks records that break the data model, e.g. references to missing records.
+	SeverityError Severity = "error"
+	// SeverityWarning marks records that are unexpected, but do not break the data model.
+	SeverityWarning Severity = "warning"
+)
+
+// maxFindingKeys is the maximum number of keys reported per finding.
+const maxFindingKeys = 100
+
+// IntegrityFinding is the result of an integrity check which found anomalies.
+type IntegrityFinding struct {
+	Check       string   `json:"check"`
+	Severity    Severity `json:"severity"`
+	Description string   `json:"description"`
+	Count       int64    `json:"count"`
+	// Keys identify up to maxFindingKeys of the anomalous records.
+	Keys    []string `json:"keys"`
+	Fixable bool     `json:"fixable"`
+	Fixed   int64    `json:"fixed,omitempty"`
+}
+
+// integrityCheck finds anomalous records by their key.
+// Only checks whose fix is safe, i.e. does not lose information still needed, have a fix.
+type integrityCheck struct {
+	name        string
+	severity    Severity
+	description string
+	// query selects the anomalous records.
+	query func() *repository.Query
+	// scan counts the anomalous records and lists the sorted keys of up to maxFindingKeys of them.
+	scan func(ctx context.Context, repo repository.Repository, query func() *repository.Query, cfg config.TableScan) (int64, []string, error)
+	// fix repairs all anomalous records and returns their number, nil if the check has no safe fix.
+	fix func(ctx context.Context, repo repository.Repository, query repository.Query) (int64, error)
+}
+
+var terminalJobStatuses = []orbital.JobStatus{
+	orbital.JobStatusDone,
+	orbital.JobStatusFailed,
+	orbital.JobStatusResolveCanceled,
+	orbital.JobStatusConfirmCanceled,
+	orbital.JobStatusUserCanceled,
+}
+
+// integrityChecks find the anomalies the data model does not prevent. The references of regional systems,
+// systems and auths are enforced by foreign keys, so their checks find the records which predate them.
+var integrityChecks = []integrityCheck{
+	{
+		name:        "regional-system-without-system",
+		severity:    SeverityError,
+		description: "regional systems whose parent system does not exist, they can not be listed or changed",
+		query: func() *repository.Query {
+			return repository.QueryOf[*model.RegionalSystem]().
+				WhereMissing(repository.MissingOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID))
+		},
+		scan: scanKeys(func(s model.RegionalSystem) string { return s.SystemID.String() + "/" + s.Region }, func(s *model.RegionalSystem) time.Time { return s.CreatedAt }),
+		fix:  deleteAll[model.RegionalSystem],
+	},
+	{
+		name:        "system-credential-without-regional-system",
+		severity:    SeverityWarning,
+		description: "system credentials whose regional system does not exist, they can not be listed or revoked",
+		query: func() *repository.Query {
+			return repository.NewQuery(&model.SystemCredential{}).WhereMissing(repository.Missing{
+				Resource:  &model.RegionalSystem{},
+				OnColumns: []repository.QueryField{repository.SystemIDField, repository.RegionField},
+				Columns:   []repository.QueryField{repository.SystemIDField, repository.RegionField},
+			})
+		},
+		scan: scanKeys(func(c model.SystemCredential) string { return c.ID.String() }, func(c *model.SystemCredential) time.Time { return c.CreatedAt }),
+		fix:  deleteAll[model.SystemCredential],
+	},
+	{
+		name:        "system-with-missing-tenant",
+		severity:    SeverityError,
+		description: "systems linked to a tenant which does not exist",
+		query: func() *repository.Query {
+			return repository.QueryOf(model.SystemFields.TenantID.IsNotEmpty()).
+				WhereMissing(repository.MissingOn(model.SystemFields.TenantID, model.TenantFields.ID))
+		},
+		scan: scanKeys(systemRecordKey, systemCreatedAt),
+	},
+	{
+		name:        "system-with-empty-tenant",
+		severity:    SeverityWarning,
+		description: "systems with an empty instead of no tenant ID, they are treated as not linked",
+		query: func() *repository.Query {
+			return repository.QueryOf(model.SystemFields.TenantID.Eq(""))
+		},
+		scan: scanKeys(systemRecordKey, systemCreatedAt),
+		fix: func(ctx context.Context, repo repository.Repository, query repository.Query) (int64, error) {
+			var systems []model.System
+			return repo.ClearAll(ctx, &systems, query, model.SystemFields.TenantID.Column())
+		},
+	},
+	{
+		name:        "auth-with-missing-tenant",
+		severity:    SeverityError,
+		description: "auths of a tenant which does not exist",
+		query: func() *repository.Query {
+			return repository.QueryOf[*model.Auth]().
+				WhereMissing(repository.MissingOn(model.AuthFields.TenantID, model.TenantFields.ID))
+		},
+		scan: scanKeys(func(a model.Auth) string { return a.ExternalID }, func(a *model.Auth) time.Time { return a.CreatedAt }),
+	},
+	{
+		name:        "system-group-with-missing-tenant",
+		severity:    SeverityError,
+		description: "system groups of a tenant which does not exist",
+		query: func() *repository.Query {
+			return repository.NewQuery(&model.SystemGroup{}).WhereMissing(missingTenant(repository.TenantIDField))
+		},
+		scan: scanKeys(func(g model.SystemGroup) string { return g.TenantID + "/" + g.Name },
+			func(g *model.SystemGroup) time.Time { return g.CreatedAt }),
+	},
+	{
+		name:        "orphaned-orbital-job",
+		severity:    SeverityWarning,
+		description: "unfinished orbital jobs of a tenant or auth which does not exist",
+		query: func() *repository.Query {
+			return repository.NewQuery(&model.Job{}).
+				Where(repository.NewCompositeKey().Where(repository.StatusField, repository.Not{Value: terminalJobStatuses})).
+				WhereMissing(missingTenant(repository.ExternalIDField), repository.Missing{
+					Resource:  &model.Auth{},
+					OnColumns: []repository.QueryField{repository.IDField},
+					Columns:   []repository.QueryField{repository.ExternalIDField},
+				})
+		},
+		scan: countKeys(func(j model.Job) string { return j.ID }),
+	},
+}
+
+// missingTenant matches the records whose tenant referenced by the column does not exist.
+func missingTenant(column repository.QueryField) repository.Missing {
+	return repository.Missing{
+		Resource:  &model.Tenant{},
+		OnColumns: []repository.QueryField{repository.IDField},
+		Columns:   []repository.QueryField{column},
+	}
+}
+
+func systemRecordKey(s model.System) string {
+	return systemKey(s.ExternalID, s.Type)
+}
+
+func systemCreatedAt(s *model.System) time.Time {
+	return s.CreatedAt
+}
+
+// scanKeys returns a function scanning the records of type T page by page, see repository.Scanner,
+// which counts them and lists the sorted keys of the first maxFindingKeys of them.
+func scanKeys[T any, PT interface {
+	*T
+	repository.Resource
+}](key func(T) string, createdAt func(*T) time.Time) func(context.Context, repository.Repository, func() *repository.Query, config.TableScan) (int64, []string, error) {
+	return func(ctx context.Context, repo repository.Repository, query func() *repository.Query, cfg config.TableScan) (int64, []string, error) {
+		keys := make([]string, 0)
+
+		count, err := newTableScanner[T, PT](cfg, query, createdAt).Scan(ctx, repo, func(_ context.Context, page []T) error {
+			for _, record := range page[:min(len(page), maxFindingKeys-len(keys))] {
+				keys = append(keys, key(record))
+			}
+			return nil
+		})
+		if err != nil {
+			return 0, nil, err
+		}
+
+		slices.Sort(keys)
+
+		return count, keys, nil
+	}
+}
+
+// countKeys returns a function counting the records of type T and listing the sorted keys of up to maxFindingKeys
+// of them at once, for the records without keyset, e.g. the orbital jobs whose times are stored as Unix nanoseconds.
+func countKeys[T any](key func(T) string) func(context.Context, repository.Repository, func() *repository.Query, config.TableScan) (int64, []string, error) {
+	return func(ctx context.Context, repo repository.Repository, query func() *repository.Query, _ config.TableScan) (int64, []string, error) {
+		count, err := repo.Count(ctx, *query())
+		if err != nil || count == 0 {
+			return count, nil, err
+		}
+
+		var records []T
+		if err := repo.List(ctx, &records, *query().SetLimit(maxFindingKeys)); err != nil {
+			return 0, nil, err
+		}
+
+		keys := make([]string, 0, len(records))
+		for _, record := range records {
+			keys = append(keys, key(record))
+		}
+
+		slices.Sort(keys)
+
+		return count, keys, nil
+	}
+}
+
+// deleteAll deletes all records of type T matching the query.
+func deleteAll[T any](ctx context.Context, repo repository.Repository, query repository.Query) (int64, error) {
+	var deleted []T
+	return repo.DeleteAll(ctx, &deleted, query)
+}
+
+// Integrity scans the database for referential anomalies, e.g. records referencing missing records,
+// which can not be prevented by constraints as the references are optional or cross the orbital tables.
+type Integrity struct {
+	repo repository.Repository
+	scan config.TableScan
+}
+
+// NewIntegrity creates and returns a new instance of Integrity, which scans the tables paced by the
+// table scan configuration.
+func NewIntegrity(repo repository.Repository, scan config.TableScan) *Integrity {
+	return &Integrity{repo: repo, scan: scan}
+}
+
+// Verify runs all integrity checks and returns a finding for each check which found anomalies.
+// If fix is true, the anomalies of checks with a safe fix are repaired.
+func (i *Integrity) Verify(ctx context.Context, fix bool) ([]IntegrityFinding, error) {
+	findings := make([]IntegrityFinding, 0)
+
+	for _, check := range integrityChecks {
+		finding, err := i.verify(ctx, check, fix)
+		if err != nil {
+			return nil, fmt.Errorf("integrity check %s failed: %w", check.name, err)
+		}
+
+		if finding.Count > 0 {
+			findings = append(findings, finding)
+		}
+	}
+
+	return findings, nil
+}
+
+func (i *Integrity) verify(ctx context.Context, check integrityCheck, fix bool) (IntegrityFinding, error) {
+	finding := IntegrityFinding{
+		Check:       check.name,
+		Severity:    check.severity,
+		Description: check.description,
+		Fixable:     check.fix != nil,
+	}
+
+	var err error
+
+	finding.Count, finding.Keys, err = check.scan(ctx, i.repo, check.query, i.scan)
+	if err != nil {
+		return finding, err
+	}
+
+	if finding.Count == 0 {
+		return finding, nil
+	}
+
+	slogctx.Warn(ctx, "integrity check found anomalies", "check", check.name, "severity", check.severity, "count", finding.Count)
+
+	if !fix || !finding.Fixable {
+		return finding, nil
+	}
+
+	finding.Fixed, err = check.fix(ctx, i.repo, *check.query())
+	if err != nil {
+		return finding, err
+	}
+
+	slogctx.Info(ctx, "integrity check fixed anomalies", "check", check.name, "count", finding.Fixed)
+
+	return finding, nil
+}
+
+// Unresolved returns true if any finding of error severity has not been fixed.
+func Unresolved(findings []IntegrityFinding) bool {
+	for _, finding := range findings {
+		if finding.Severity == SeverityError && finding.Fixed < finding.Count {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,170 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// maxSnapshotDays bounds the date range of snapshots fetched at once.
+const maxSnapshotDays = 366
+
+// Inventory materializes daily aggregates of tenants and systems into snapshots,
+// so trend reporting does not need to scan the live tables.
+// The snapshots are served on the admin service, see Admin.
+type Inventory struct {
+	repo repository.Repository
+	cfg  config.InventorySnapshot
+}
+
+// NewInventory creates and returns a new instance of Inventory.
+func NewInventory(repo repository.Repository, cfg config.InventorySnapshot) *Inventory {
+	return &Inventory{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// Start takes a snapshot of the current day and refreshes it every configured interval until ctx is done.
+// It does nothing if the snapshot worker is disabled.
+func (i *Inventory) Start(ctx context.Context) {
+	if !i.cfg.Enabled {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(i.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			if err := i.TakeSnapshot(ctx, time.Now()); err != nil {
+				slogctx.Error(ctx, "failed to take inventory snapshot", "error", err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+// TakeSnapshot aggregates the current inventory and stores it as the snapshot of the given day.
+// An existing snapshot of the day is replaced.
+func (i *Inventory) TakeSnapshot(ctx context.Context, day time.Time) error {
+	snapshot, err := i.aggregate(ctx)
+	if err != nil {
+		return err
+	}
+	snapshot.Date = truncateToDay(day)
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	return i.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		_, err := r.Delete(ctx, &model.InventorySnapshot{Date: snapshot.Date})
+		if err != nil {
+			return err
+		}
+
+		return r.Create(ctx, snapshot)
+	})
+}
+
+// GetInventorySnapshots returns the snapshots between from and to, both inclusive, ordered by date.
+func (i *Inventory) GetInventorySnapshots(ctx context.Context, from, to time.Time) ([]model.InventorySnapshot, error) {
+	slogctx.Debug(ctx, "GetInventorySnapshots called", "from", from, "to", to)
+
+	from, to = truncateToDay(from), truncateToDay(to)
+	if err := validateSnapshotRange(from, to); err != nil {
+		return nil, err
+	}
+
+	query := repository.NewQuery(&model.InventorySnapshot{}).Where(
+		repository.NewCompositeKey().Where(repository.DateField, repository.Range{From: from, To: to}),
+	)
+
+	var snapshots []model.InventorySnapshot
+	if err := i.repo.List(ctx, &snapshots, *query); err != nil {
+		slogctx.Error(ctx, "failed to list inventory snapshots", "error", err)
+		return nil, ErrSnapshotSelect
+	}
+
+	slices.SortFunc(snapshots, func(a, b model.InventorySnapshot) int {
+		return a.Date.Compare(b.Date)
+	})
+
+	return snapshots, nil
+}
+
+// aggregate counts tenants, systems and L1 key claims of the live tables.
+func (i *Inventory) aggregate(ctx context.Context) (*model.InventorySnapshot, error) {
+	snapshot := &model.InventorySnapshot{}
+
+	err := i.repo.Aggregate(ctx, &snapshot.Tenants, *repository.QueryOf[*model.Tenant](),
+		model.TenantFields.Region.Column(), model.TenantFields.Status.Column())
+	if err != nil {
+		return nil, err
+	}
+
+	systemsQuery := repository.QueryOf[*model.RegionalSystem]()
+	systemsQuery.Joins = []repository.Join{repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)}
+
+	err = i.repo.Aggregate(ctx, &snapshot.Systems, *systemsQuery,
+		model.RegionalSystemFields.Region.Qualified(),
+		model.RegionalSystemFields.Status.Qualified(),
+		model.SystemFields.Type.Qualified())
+	if err != nil {
+		return nil, err
+	}
+
+	claimsQuery := repository.QueryOf(model.RegionalSystemFields.HasL1KeyClaim.Eq(true))
+
+	err = i.repo.Aggregate(ctx, &snapshot.L1KeyClaims, *claimsQuery, model.RegionalSystemFields.Region.Column())
+	if err != nil {
+		return nil, err
+	}
+
+	snapshot.TotalSystems, err = i.repo.Count(ctx, *repository.QueryOf[*model.System]())
+	if err != nil {
+		return nil, err
+	}
+
+	linkedQuery := repository.QueryOf(model.SystemFields.TenantID.IsNotEmpty())
+
+	snapshot.LinkedSystems, err = i.repo.Count(ctx, *linkedQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	return snapshot, nil
+}
+
+func validateSnapshotRange(from, to time.Time) error {
+	if from.IsZero() || to.IsZero() || to.Before(from) {
+		return ErrorWithParams(ErrSnapshotDateRange, "from", from.Format(time.DateOnly), "to", to.Format(time.DateOnly))
+	}
+
+	if to.Sub(from) >= maxSnapshotDays*24*time.Hour {
+		return ErrorWithParams(ErrSnapshotDateRange, "maxDays", maxSnapshotDays)
+	}
+
+	return nil
+}
+
+// truncateToDay returns the start of the UTC day of t.
+func truncateToDay(t time.Time) time.Time {
+	if t.IsZero() {
+		return t
+	}
+
+	y, m, d := t.UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,57 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestGetInventorySnapshotsDateRange(t *testing.T) {
+	day := time.Date(2025, time.March, 10, 15, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name string
+		from time.Time
+		to   time.Time
+	}{
+		{
+			name: "missing from",
+			to:   day,
+		},
+		{
+			name: "missing to",
+			from: day,
+		},
+		{
+			name: "to before from",
+			from: day,
+			to:   day.AddDate(0, 0, -1),
+		},
+		{
+			name: "range exceeds maximum",
+			from: day,
+			to:   day.AddDate(1, 1, 0),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			inventory := service.NewInventory(nil, config.InventorySnapshot{})
+
+			// when
+			snapshots, err := inventory.GetInventorySnapshots(context.Background(), tt.from, tt.to)
+
+			// then
+			assert.Nil(t, snapshots)
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/openkcm/orbital"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// lastReplayedAtField is the field of the job outcomes holding the time of their last replay.
+const lastReplayedAtField repository.QueryField = "last_replayed_at"
+
+// replayWindow is the window in which the replayed jobs are limited by JobReplay.MaxPerHour.
+const replayWindow = time.Hour
+
+// recordOutcome records the outcome of the finished job once it was handled, so the handling can be replayed.
+// The job event is not failed if the outcome can not be recorded, as it would be handled again.
+func (o *Orbital) recordOutcome(ctx context.Context, job orbital.Job, outcome string) {
+	err := o.repo.Create(ctx, &model.JobOutcome{
+		JobID:        job.ID.String(),
+		ExternalID:   job.ExternalID,
+		Type:         job.Type,
+		Outcome:      outcome,
+		ErrorMessage: job.ErrorMessage,
+		Data:         job.Data,
+	})
+	if err != nil && !isUniqueConstraintError(err) {
+		slogctx.Warn(ctx, "failed to record job outcome", "error", err, "jobId", job.ID.String())
+	}
+}
+
+// ReplayJobOutcome runs the handling of the outcome of the finished job again, e.g. to emit the change of a tenant
+// to ACTIVE again after a downstream consumer lost it. The handlers set the state resulting from the outcome,
+// so a replay is idempotent, and only the outcome of the latest job of a resource is replayed,
+// as the outcome of an earlier job would revert the changes of the later ones.
+// Replays are rate limited by JobReplay and audited with the replaying caller.
+func (o *Orbital) ReplayJobOutcome(ctx context.Context, jobID string) (*model.JobOutcome, error) {
+	ctx = slogctx.With(ctx, "jobId", jobID)
+	slogctx.Debug(ctx, "ReplayJobOutcome called")
+
+	id, err := uuid.Parse(jobID)
+	if err != nil {
+		return nil, ErrOperationIDInvalid
+	}
+
+	outcome, err := getJobOutcome(ctx, o.repo, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	// the jobs are read outside of the transaction, so the rows of orbital are not locked
+	if err := checkLatestJob(ctx, o.repo, outcome); err != nil {
+		return nil, err
+	}
+
+	err = o.repo.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
+		outcome, err = getJobOutcome(ctx, r, jobID)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now().UTC()
+		if err := o.checkReplayLimits(ctx, r, outcome, now); err != nil {
+			return err
+		}
+
+		outcome.ReplayCount++
+		outcome.LastReplayedAt = &now
+		if _, err := r.Patch(ctx, &model.JobOutcome{
+			JobID:          jobID,
+			ReplayCount:    outcome.ReplayCount,
+			LastReplayedAt: outcome.LastReplayedAt,
+		}); err != nil {
+			slogctx.Error(ctx, "failed to update job outcome", "error", err)
+			return ErrJobOutcomeUpdate
+		}
+
+		return nil
+	})
+	err = mapError(err)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := o.handleOutcome(ctx, id, outcome); err != nil {
+		slogctx.Error(ctx, "failed to replay job outcome", "error", err)
+		return nil, ErrJobOutcomeReplay
+	}
+
+	outcome.LastReplayedBy = repository.CallerFromContext(ctx)
+	slogctx.Info(ctx, "job outcome replayed", "caller", outcome.LastReplayedBy, "jobType", outcome.Type,
+		"externalId", outcome.ExternalID, "outcome", outcome.Outcome, "replayCount", outcome.ReplayCount)
+
+	return outcome, nil
+}
+
+// checkReplayLimits returns ErrJobReplayRateLimited if the outcome was replayed within the interval
+// or the maximum number of jobs was replayed within the last hour.
+func (o *Orbital) checkReplayLimits(ctx context.Context, r repository.Repository, outcome *model.JobOutcome, now time.Time) error {
+	if o.replay.Interval > 0 && outcome.LastReplayedAt != nil && now.Sub(*outcome.LastReplayedAt) < o.replay.Interval {
+		return ErrorWithParams(ErrJobReplayRateLimited, "lastReplayedAt", outcome.LastReplayedAt.Format(time.RFC3339))
+	}
+
+	if o.replay.MaxPerHour == 0 {
+		return nil
+	}
+
+	replayed, err := r.Count(ctx, *repository.NewQuery(&model.JobOutcome{}).Where(
+		repository.NewCompositeKey().Where(lastReplayedAtField, repository.Range{From: now.Add(-replayWindow)})))
+	if err != nil {
+		slogctx.Error(ctx, "failed to count replayed job outcomes", "error", err)
+		return ErrJobOutcomeSelect
+	}
+
+	if replayed >= int64(o.replay.MaxPerHour) {
+		return ErrorWithParams(ErrJobReplayRateLimited, "replayedJobs", replayed)
+	}
+
+	return nil
+}
+
+// handleOutcome calls the handler of the job type with the job of the outcome.
+func (o *Orbital) handleOutcome(ctx context.Context, id uuid.UUID, outcome *model.JobOutcome) error {
+	h, ok := o.getHandler(ctx, outcome.Type)
+	if !ok {
+		return ErrUnexpectedJobType
+	}
+
+	job := orbital.Job{
+		ID:           id,
+		ExternalID:   outcome.ExternalID,
+		Type:         outcome.Type,
+		Data:         outcome.Data,
+		ErrorMessage: outcome.ErrorMessage,
+	}
+
+	switch outcome.Outcome {
+	case model.JobOutcomeDone:
+		return h.HandleJobDone(ctx, job)
+	case model.JobOutcomeFailed:
+		return h.HandleJobFailed(ctx, job)
+	case model.JobOutcomeCanceled:
+		return h.HandleJobCanceled(ctx, job)
+	default:
+		return ErrUnexpectedJobType
+	}
+}
+
+// checkLatestJob returns ErrJobOutcomeSuperseded if a job of the same external ID was created after the job.
+func checkLatestJob(ctx context.Context, r repository.Repository, outcome *model.JobOutcome) error {
+	job := &model.Job{ID: outcome.JobID}
+
+	found, err := r.Find(ctx, job)
+	if err != nil {
+		slogctx.Error(ctx, "failed to select job", "error", err)
+		return ErrOperationSelect
+	}
+
+	if !found {
+		return ErrOperationNotFound
+	}
+
+	later, err := r.Count(ctx, *repository.NewQuery(&model.Job{}).Where(repository.NewCompositeKey().
+		Where(repository.ExternalIDField, outcome.ExternalID).
+		Where(repository.CreatedAtField, repository.Range{From: job.CreatedAt + 1})))
+	if err != nil {
+		slogctx.Error(ctx, "failed to count later jobs", "error", err)
+		return ErrOperationSelect
+	}
+
+	if later > 0 {
+		return ErrJobOutcomeSuperseded
+	}
+
+	return nil
+}
+
+func getJobOutcome(ctx context.Context, r repository.Repository, jobID string) (*model.JobOutcome, error) {
+	outcome := &model.JobOutcome{JobID: jobID}
+
+	found, err := r.Find(ctx, outcome)
+	if err != nil {
+		slogctx.Error(ctx, "failed to select job outcome", "error", err)
+		return nil, ErrJobOutcomeSelect
+	}
+
+	if !found {
+		return nil, ErrJobOutcomeNotFound
+	}
+
+	return outcome, nil
+}
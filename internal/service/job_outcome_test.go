@@ -0,0 +1,100 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/openkcm/orbital"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+// outcomeHandler records the outcomes it handles.
+type outcomeHandler struct {
+	handled []string
+	jobs    []orbital.Job
+}
+
+func (h *outcomeHandler) ConfirmJob(context.Context, orbital.Job) (orbital.JobConfirmerResult, error) {
+	return orbital.CompleteJobConfirmer(), nil
+}
+
+func (h *outcomeHandler) ResolveTasks(context.Context, orbital.Job, map[string]orbital.TargetManager) (orbital.TaskResolverResult, error) {
+	return orbital.CompleteTaskResolver(), nil
+}
+
+func (h *outcomeHandler) HandleJobDone(_ context.Context, job orbital.Job) error {
+	return h.handle(model.JobOutcomeDone, job)
+}
+
+func (h *outcomeHandler) HandleJobCanceled(_ context.Context, job orbital.Job) error {
+	return h.handle(model.JobOutcomeCanceled, job)
+}
+
+func (h *outcomeHandler) HandleJobFailed(_ context.Context, job orbital.Job) error {
+	return h.handle(model.JobOutcomeFailed, job)
+}
+
+func (h *outcomeHandler) handle(outcome string, job orbital.Job) error {
+	h.handled = append(h.handled, outcome)
+	h.jobs = append(h.jobs, job)
+
+	return nil
+}
+
+func TestHandleOutcome(t *testing.T) {
+	for _, outcome := range []string{model.JobOutcomeDone, model.JobOutcomeFailed, model.JobOutcomeCanceled} {
+		t.Run("should replay "+outcome+" with the handler of the job type", func(t *testing.T) {
+			// given
+			handler := &outcomeHandler{}
+			subj := &service.Orbital{}
+			subj.RegisterJobHandler("TEST_ACTION", handler)
+			id := uuid.New()
+
+			// when
+			err := subj.HandleOutcome(t.Context(), id, &model.JobOutcome{
+				JobID:        id.String(),
+				ExternalID:   "tenant-1",
+				Type:         "TEST_ACTION",
+				Outcome:      outcome,
+				ErrorMessage: "region unreachable",
+				Data:         []byte("payload"),
+			})
+
+			// then
+			require.NoError(t, err)
+			assert.Equal(t, []string{outcome}, handler.handled)
+			assert.Equal(t, orbital.Job{
+				ID:           id,
+				ExternalID:   "tenant-1",
+				Type:         "TEST_ACTION",
+				Data:         []byte("payload"),
+				ErrorMessage: "region unreachable",
+			}, handler.jobs[0])
+		})
+	}
+
+	t.Run("should fail for job types without handler", func(t *testing.T) {
+		// given
+		subj := &service.Orbital{}
+
+		// when
+		err := subj.HandleOutcome(t.Context(), uuid.New(), &model.JobOutcome{Type: "UNKNOWN_ACTION", Outcome: model.JobOutcomeDone})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrUnexpectedJobType)
+	})
+
+	t.Run("should reject invalid job IDs", func(t *testing.T) {
+		// when
+		outcome, err := (&service.Orbital{}).ReplayJobOutcome(t.Context(), "job")
+
+		// then
+		assert.ErrorIs(t, err, service.ErrOperationIDInvalid)
+		assert.Nil(t, outcome)
+	})
+}
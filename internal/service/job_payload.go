@@ -0,0 +1,118 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+
+	"google.golang.org/protobuf/proto"
+)
+
+// jobPayloadMarker starts an enveloped job payload, followed by the version and the payload of the version.
+// A marshalled proto message never starts with a zero byte, as zero is not a valid field number,
+// so the raw payloads of jobs prepared before the envelope are still recognized.
+const jobPayloadMarker byte = 0x00
+
+// Versions of the job payloads.
+const (
+	// jobPayloadVersionLegacy is the raw marshalled proto of jobs prepared before the envelope.
+	jobPayloadVersionLegacy byte = 0
+	// jobPayloadVersion1 is the enveloped marshalled proto of the api-sdk messages.
+	jobPayloadVersion1 byte = 1
+
+	currentJobPayloadVersion = jobPayloadVersion1
+)
+
+var (
+	ErrJobPayloadFormat = errors.New("job payload envelope is malformed")
+	// ErrJobPayloadTooNew is returned for payloads of a newer deployment, e.g. during a rollback.
+	// The job should be retried instead of canceled, so it is processed once the newer deployment is back.
+	ErrJobPayloadTooNew = errors.New("job payload version is newer than supported")
+)
+
+// jobPayloadUpgrade converts the payload of a version into the payload of the following version.
+type jobPayloadUpgrade func(payload []byte) ([]byte, error)
+
+// jobPayloadCodec versions the payloads of the jobs of one kind, so jobs prepared by the previous deployment
+// can still be processed after the payload changed.
+// When a payload changes incompatibly, increment currentJobPayloadVersion and add an upgrade
+// from the previous version to the codecs, which must be kept for at least one release.
+type jobPayloadCodec struct {
+	// upgrades maps a version to the upgrade of its payload to the following version.
+	upgrades map[byte]jobPayloadUpgrade
+}
+
+// unchangedPayload is the upgrade of a version whose payload is the same as the one of the following version.
+func unchangedPayload(payload []byte) ([]byte, error) {
+	return payload, nil
+}
+
+var (
+	tenantJobPayload = jobPayloadCodec{
+		upgrades: map[byte]jobPayloadUpgrade{
+			jobPayloadVersionLegacy: unchangedPayload,
+		},
+	}
+	authJobPayload = jobPayloadCodec{
+		upgrades: map[byte]jobPayloadUpgrade{
+			jobPayloadVersionLegacy: unchangedPayload,
+		},
+	}
+	// identityProviderJobPayload has no legacy payloads, its jobs were enveloped from the start.
+	identityProviderJobPayload = jobPayloadCodec{}
+)
+
+// encode marshals the message into an envelope of the current version.
+func (c jobPayloadCodec) encode(msg proto.Message) ([]byte, error) {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte{jobPayloadMarker, currentJobPayloadVersion}, payload...), nil
+}
+
+// decode upgrades the payload to the current version and unmarshals it into msg.
+// It returns the marshalled message of the current version, which is the data of the tasks sent to the operators,
+// as they do not know the envelope.
+func (c jobPayloadCodec) decode(data []byte, msg proto.Message) ([]byte, error) {
+	version, payload, err := openJobPayload(data)
+	if err != nil {
+		return nil, err
+	}
+
+	for ; version < currentJobPayloadVersion; version++ {
+		upgrade, ok := c.upgrades[version]
+		if !ok {
+			return nil, fmt.Errorf("%w: no upgrade of version %d", ErrJobPayloadFormat, version)
+		}
+
+		payload, err = upgrade(payload)
+		if err != nil {
+			return nil, fmt.Errorf("failed to upgrade job payload of version %d: %w", version, err)
+		}
+	}
+
+	err = proto.Unmarshal(payload, msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return payload, nil
+}
+
+// openJobPayload returns the version and the payload of the envelope.
+func openJobPayload(data []byte) (byte, []byte, error) {
+	if len(data) == 0 || data[0] != jobPayloadMarker {
+		return jobPayloadVersionLegacy, data, nil
+	}
+
+	if len(data) < 2 || data[1] == jobPayloadVersionLegacy {
+		return 0, nil, ErrJobPayloadFormat
+	}
+
+	if data[1] > currentJobPayloadVersion {
+		return 0, nil, fmt.Errorf("%w: %d", ErrJobPayloadTooNew, data[1])
+	}
+
+	return data[1], data[2:], nil
+}
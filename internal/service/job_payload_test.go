@@ -0,0 +1,108 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/openkcm/orbital"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestJobPayload(t *testing.T) {
+	tenant := &tenantgrpc.Tenant{
+		Id:     "tenant-id",
+		Name:   "tenant",
+		Region: "region",
+		Status: tenantgrpc.Status_STATUS_PROVISIONING,
+	}
+
+	raw, err := proto.Marshal(tenant)
+	require.NoError(t, err)
+
+	t.Run("should decode the current version", func(t *testing.T) {
+		// given
+		data, err := service.EncodeTenantJobPayload(tenant)
+		require.NoError(t, err)
+
+		// when
+		decoded := &tenantgrpc.Tenant{}
+		payload, err := service.DecodeTenantJobPayload(data, decoded)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(tenant, decoded))
+		assert.Equal(t, raw, payload)
+	})
+
+	t.Run("should replay legacy tenant payloads", func(t *testing.T) {
+		// when
+		decoded := &tenantgrpc.Tenant{}
+		payload, err := service.DecodeTenantJobPayload(raw, decoded)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(tenant, decoded))
+		assert.Equal(t, raw, payload)
+	})
+
+	t.Run("should replay legacy auth payloads", func(t *testing.T) {
+		// given
+		auth := &authgrpc.Auth{ExternalId: "external-id", TenantId: "tenant-id", Type: "oidc"}
+		rawAuth, err := proto.Marshal(auth)
+		require.NoError(t, err)
+
+		// when
+		decoded := &authgrpc.Auth{}
+		payload, err := service.DecodeAuthJobPayload(rawAuth, decoded)
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(auth, decoded))
+		assert.Equal(t, rawAuth, payload)
+	})
+
+	t.Run("should return error for newer versions", func(t *testing.T) {
+		// given
+		data := append([]byte{0x00, 0xff}, raw...)
+
+		// when
+		_, err := service.DecodeTenantJobPayload(data, &tenantgrpc.Tenant{})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrJobPayloadTooNew)
+	})
+
+	t.Run("should return error for malformed envelopes", func(t *testing.T) {
+		for _, data := range [][]byte{{0x00}, {0x00, 0x00}} {
+			// when
+			_, err := service.DecodeTenantJobPayload(data, &tenantgrpc.Tenant{})
+
+			// then
+			assert.ErrorIs(t, err, service.ErrJobPayloadFormat)
+		}
+	})
+}
+
+func TestTenantResolveTasksReplaysLegacyPayloads(t *testing.T) {
+	// given
+	raw, err := proto.Marshal(&tenantgrpc.Tenant{Id: "tenant-id", Region: "region"})
+	require.NoError(t, err)
+
+	job := orbital.Job{
+		Data: raw,
+		Type: tenantgrpc.ACTION_ACTION_PROVISION_TENANT.String(),
+	}
+
+	// when
+	result, err := (&service.Tenant{}).ResolveTasks(t.Context(), job, map[string]orbital.TargetManager{"region": {}})
+
+	// then
+	require.NoError(t, err)
+	assert.Equal(t, orbital.CompleteTaskResolver().Type(), result.Type())
+}
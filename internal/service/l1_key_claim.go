@@ -0,0 +1,99 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+)
+
+// L1KeyClaim is the state of the claim of the L1 key of a system in a region.
+type L1KeyClaim struct {
+	Region  string
+	Claimed bool
+	// ClaimedAt, ClaimedBy and TenantID describe the last claim of the key, which is kept when the claim is released.
+	// They are empty if the key was not claimed since the claims are recorded.
+	ClaimedAt *time.Time
+	ClaimedBy string
+	TenantID  string
+	// Status is the status of the regional system, e.g. STATUS_AVAILABLE.
+	Status string
+}
+
+// L1KeyClaimDescription aggregates the claims of the L1 keys of a system across its regions.
+type L1KeyClaimDescription struct {
+	ExternalID string
+	Type       string
+	// TenantID is the tenant the system is linked to, empty if it is not linked.
+	TenantID string
+	// Claims are the claims of the regions of the system, ordered by region.
+	Claims []L1KeyClaim
+}
+
+// DescribeL1KeyClaim returns the claims of the L1 keys of the system in all its regions, so operators can tell
+// which workflow holds the claim preventing the system from being unlinked.
+func (s *System) DescribeL1KeyClaim(ctx context.Context, externalID, systemType string) (*L1KeyClaimDescription, error) {
+	slogctx.Debug(ctx, "DescribeL1KeyClaim called", "externalId", externalID, "type", systemType)
+
+	if err := validateExternalIDAndType(s.validation, externalID, systemType); err != nil {
+		return nil, err
+	}
+
+	system, found, err := getSystem(ctx, s.repo, externalID, systemType)
+	if err != nil {
+		return nil, ErrSystemSelect
+	}
+
+	if !found {
+		return nil, ErrSystemNotFound
+	}
+
+	regionalSystems, err := getRegionalSystemsFromSystemID(ctx, s.repo, system.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	description := &L1KeyClaimDescription{
+		ExternalID: system.ExternalID,
+		Type:       system.Type,
+		Claims:     make([]L1KeyClaim, 0, len(regionalSystems)),
+	}
+	if system.IsLinkedToTenant() {
+		description.TenantID = *system.TenantID
+	}
+
+	for _, regionalSystem := range regionalSystems {
+		description.Claims = append(description.Claims, L1KeyClaim{
+			Region:    regionalSystem.Region,
+			Claimed:   regionalSystem.HasActiveL1KeyClaim(),
+			ClaimedAt: regionalSystem.L1KeyClaimedAt,
+			ClaimedBy: regionalSystem.L1KeyClaimedBy,
+			TenantID:  regionalSystem.L1KeyClaimTenantID,
+			Status:    regionalSystem.Status,
+		})
+	}
+
+	slices.SortFunc(description.Claims, func(a, b L1KeyClaim) int {
+		return cmp.Compare(a.Region, b.Region)
+	})
+
+	return description, nil
+}
+
+// l1KeyClaimError returns ErrSystemHasL1KeyClaim with the system and the region of the claim,
+// and with the time, the tenant and the client of the claim if they are recorded.
+func l1KeyClaimError(system *model.System, regionalSystem *model.RegionalSystem) error {
+	params := []any{"externalID", system.ExternalID, "type", system.Type, "region", regionalSystem.Region}
+	if regionalSystem.L1KeyClaimedAt != nil {
+		params = append(params,
+			"claimedAt", regionalSystem.L1KeyClaimedAt.Format(time.RFC3339),
+			"claimTenantID", regionalSystem.L1KeyClaimTenantID,
+			"claimedBy", regionalSystem.L1KeyClaimedBy)
+	}
+
+	return ErrorWithParams(ErrSystemHasL1KeyClaim, params...)
+}
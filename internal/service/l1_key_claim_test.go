@@ -0,0 +1,40 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestL1KeyClaimError(t *testing.T) {
+	system := &model.System{ExternalID: "external-id", Type: "system"}
+
+	t.Run("should describe the recorded claim", func(t *testing.T) {
+		// given
+		regionalSystem := &model.RegionalSystem{Region: "region-1"}
+		regionalSystem.RecordL1KeyClaim(time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC), "spiffe://example.org/crypto", "tenant-1")
+
+		// when
+		err := service.L1KeyClaimError(system, regionalSystem)
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "externalID=external-id type=system region=region-1 "+
+			"claimedAt=2026-01-10T12:00:00Z claimTenantID=tenant-1 claimedBy=spiffe://example.org/crypto")
+	})
+
+	t.Run("should omit a claim which is not recorded", func(t *testing.T) {
+		// when
+		err := service.L1KeyClaimError(system, &model.RegionalSystem{Region: "region-1"})
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+		assert.Contains(t, err.Error(), "(externalID=external-id type=system region=region-1)")
+	})
+}
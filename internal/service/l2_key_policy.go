@@ -0,0 +1,87 @@
+package service
+
+import (
+	"context"
+	"regexp"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+// L2KeyPolicy checks the L2 key ID of a regional system in its region when the regional system is registered
+// or its L2 key is updated, so obviously wrong key references are rejected at the API instead of failing
+// in the KMS data plane later. It returns ErrL2KeyNotAllowed if the L2 key ID is rejected.
+type L2KeyPolicy interface {
+	CheckL2Key(ctx context.Context, region, l2KeyID string) error
+}
+
+// RegionL2KeyPatterns is the L2KeyPolicy matching the L2 key IDs against the configured patterns of their region.
+type RegionL2KeyPatterns struct {
+	regions  map[string][]*regexp.Regexp
+	fallback []*regexp.Regexp
+}
+
+// NewRegionL2KeyPatterns creates and returns a new instance of RegionL2KeyPatterns.
+// If the policy is disabled, it has no patterns and allows every L2 key ID.
+func NewRegionL2KeyPatterns(cfg config.L2KeyPolicy) (*RegionL2KeyPatterns, error) {
+	if !cfg.Enabled {
+		return &RegionL2KeyPatterns{}, nil
+	}
+
+	regions := make(map[string][]*regexp.Regexp, len(cfg.Regions))
+	for region, patterns := range cfg.Regions {
+		compiled, err := compileL2KeyPatterns(patterns)
+		if err != nil {
+			return nil, err
+		}
+		regions[region] = compiled
+	}
+
+	fallback, err := compileL2KeyPatterns(cfg.Default)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RegionL2KeyPatterns{
+		regions:  regions,
+		fallback: fallback,
+	}, nil
+}
+
+// CheckL2Key implements L2KeyPolicy. The L2 key ID must match one pattern of the region,
+// or one default pattern if the region has none. Regions without any patterns are not checked.
+func (p *RegionL2KeyPatterns) CheckL2Key(ctx context.Context, region, l2KeyID string) error {
+	patterns, ok := p.regions[region]
+	if !ok {
+		patterns = p.fallback
+	}
+
+	if len(patterns) == 0 {
+		return nil
+	}
+
+	for _, pattern := range patterns {
+		if pattern.MatchString(l2KeyID) {
+			return nil
+		}
+	}
+
+	slogctx.Warn(ctx, "L2 key ID does not match the patterns of the region", "region", region, "l2KeyId", l2KeyID)
+
+	return ErrorWithParams(ErrL2KeyNotAllowed, "region", region, "l2KeyId", l2KeyID)
+}
+
+// compileL2KeyPatterns compiles the patterns anchored to match the whole L2 key ID.
+func compileL2KeyPatterns(patterns []string) ([]*regexp.Regexp, error) {
+	compiled := make([]*regexp.Regexp, 0, len(patterns))
+	for _, pattern := range patterns {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, err
+		}
+		compiled = append(compiled, re)
+	}
+
+	return compiled, nil
+}
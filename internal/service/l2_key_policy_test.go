@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestRegionL2KeyPatterns(t *testing.T) {
+	cfg := config.L2KeyPolicy{
+		Enabled: true,
+		Regions: map[string][]string{
+			"eu10": {"eu10-[a-z0-9]+", "legacy-eu-[0-9]+"},
+			"us10": {"us10-[a-z0-9]+"},
+		},
+		Default: []string{"key-[a-z0-9]+"},
+	}
+
+	tests := []struct {
+		name    string
+		cfg     config.L2KeyPolicy
+		region  string
+		l2KeyID string
+		expErr  bool
+	}{
+		{name: "key of the region", cfg: cfg, region: "eu10", l2KeyID: "eu10-abc123"},
+		{name: "key matching another pattern of the region", cfg: cfg, region: "eu10", l2KeyID: "legacy-eu-42"},
+		{name: "key of another region", cfg: cfg, region: "eu10", l2KeyID: "us10-abc123", expErr: true},
+		{name: "key matching a pattern partially", cfg: cfg, region: "eu10", l2KeyID: "x-eu10-abc123", expErr: true},
+		{name: "key of a region with default patterns", cfg: cfg, region: "ap10", l2KeyID: "key-abc"},
+		{name: "key violating the default patterns", cfg: cfg, region: "ap10", l2KeyID: "eu10-abc123", expErr: true},
+		{
+			name:    "region without patterns and without default patterns",
+			cfg:     config.L2KeyPolicy{Enabled: true, Regions: cfg.Regions},
+			region:  "ap10",
+			l2KeyID: "anything",
+		},
+		{name: "disabled", cfg: config.L2KeyPolicy{Regions: cfg.Regions}, region: "eu10", l2KeyID: "us10-abc123"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj, err := service.NewRegionL2KeyPatterns(tt.cfg)
+			require.NoError(t, err)
+
+			// when
+			err = subj.CheckL2Key(t.Context(), tt.region, tt.l2KeyID)
+
+			// then
+			if tt.expErr {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.ErrorContains(t, err, service.ErrL2KeyNotAllowed.Error())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,299 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// Operations of the label audit logs.
+const (
+	labelOperationSet    = "set"
+	labelOperationRemove = "remove"
+)
+
+// LabelIndexResources are the resources with labels, whose labels are indexed if the label index is enabled.
+var LabelIndexResources = []repository.Resource{
+	&model.Tenant{},
+	&model.RegionalSystem{},
+	&model.SystemGroup{},
+}
+
+// Labels implements the label operations shared by all resources with labels,
+// so validation, merge and remove semantics, size limits, default labels and audit logs are the same for all of them.
+// A resource passes the validation ID of its labels field.
+//
+// The configured tenant labels are inherited by the regional systems of the systems linked to the tenant.
+// A label of a regional system counts as inherited while its value equals the value of the tenant label,
+// so inherited labels follow the changes of the tenant labels and are removed with them or on unlink,
+// while labels set on the regional system itself are kept unless the conflict rule is overwrite.
+type Labels struct {
+	validation *validation.Validation
+	cfg        config.Labels
+}
+
+// NewLabels creates and returns a new instance of Labels.
+func NewLabels(validation *validation.Validation, cfg config.Labels) *Labels {
+	return &Labels{
+		validation: validation,
+		cfg:        cfg,
+	}
+}
+
+// validate validates the labels of a resource being created, which may be empty.
+func (l *Labels) validate(validationID validation.ID, labels map[string]string) error {
+	if len(labels) == 0 {
+		return nil
+	}
+
+	if err := l.checkLimits(labels); err != nil {
+		return err
+	}
+
+	if err := l.validation.Validate(validationID, labels); err != nil {
+		return validationFailed(err)
+	}
+
+	return nil
+}
+
+// validateSet validates the labels of a set request, which must not be empty.
+func (l *Labels) validateSet(validationID validation.ID, labels map[string]string) error {
+	if len(labels) == 0 {
+		return ErrMissingLabels
+	}
+
+	return l.validate(validationID, labels)
+}
+
+// validateRemove validates the keys of a remove request, which must not be empty.
+func (l *Labels) validateRemove(keys []string) error {
+	if len(keys) == 0 {
+		return ErrMissingLabelKeys
+	}
+
+	if slices.Contains(keys, "") {
+		return ErrEmptyLabelKeys
+	}
+
+	return nil
+}
+
+// checkSet returns an error if setting the labels exceeds the maximum number of labels of the resource.
+func (l *Labels) checkSet(current, labels map[string]string) error {
+	return l.checkLimits(mergeLabels(current, labels))
+}
+
+// mergeLabels returns the current labels with the given labels added, overwriting labels with the same keys.
+// The current labels are not modified.
+func mergeLabels(current, labels map[string]string) map[string]string {
+	merged := make(map[string]string, len(current)+len(labels))
+	maps.Copy(merged, current)
+	maps.Copy(merged, labels)
+
+	return merged
+}
+
+// removeLabels returns the current labels without the given keys. Keys which are not set are ignored.
+// The current labels are not modified.
+func removeLabels(current map[string]string, keys []string) map[string]string {
+	remaining := maps.Clone(current)
+	if remaining == nil {
+		remaining = make(map[string]string)
+	}
+
+	for _, k := range keys {
+		delete(remaining, k)
+	}
+
+	return remaining
+}
+
+// missingLabelKeys returns the given keys which are not set in the current labels.
+func missingLabelKeys(current map[string]string, keys []string) []string {
+	var missing []string
+	for _, k := range keys {
+		if _, ok := current[k]; !ok {
+			missing = append(missing, k)
+		}
+	}
+
+	return missing
+}
+
+// tenantDefaults returns the labels of a new tenant with the default tenant labels added.
+func (l *Labels) tenantDefaults(labels map[string]string) map[string]string {
+	return withDefaults(l.cfg.Defaults.Tenant, labels)
+}
+
+// systemDefaults returns the labels of a new regional system with the default system labels added.
+func (l *Labels) systemDefaults(labels map[string]string) map[string]string {
+	return withDefaults(l.cfg.Defaults.System, labels)
+}
+
+// withDefaults returns the labels with the defaults added. Labels take precedence over defaults with the same keys.
+func withDefaults(defaults, labels map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return labels
+	}
+
+	return mergeLabels(defaults, labels)
+}
+
+// inherits returns true if tenant labels are inherited by the regional systems of linked systems.
+func (l *Labels) inherits() bool {
+	return len(l.cfg.Inheritance.Keys) > 0
+}
+
+// withInherited returns the labels of a new regional system of the system with the labels inherited
+// from the tenant the system is linked to. The labels are kept if the inherited labels would exceed the label limits.
+func (l *Labels) withInherited(ctx context.Context, r repository.Repository, system *model.System, labels map[string]string) (map[string]string, error) {
+	if !l.inherits() || !system.IsLinkedToTenant() {
+		return labels, nil
+	}
+
+	tenant, err := getTenant(ctx, r, *system.TenantID, repository.LockForShare)
+	if err != nil {
+		return nil, err
+	}
+
+	return l.inheritedFrom(ctx, tenant, system, labels), nil
+}
+
+// inheritedFrom returns the labels of a new regional system of the system with the labels inherited from the tenant.
+func (l *Labels) inheritedFrom(ctx context.Context, tenant *model.Tenant, system *model.System, labels map[string]string) map[string]string {
+	inherited, changed := l.inherit(ctx, nil, tenant.Labels, labels)
+	if !changed {
+		return labels
+	}
+
+	if err := l.checkLimits(inherited); err != nil {
+		slogctx.Warn(ctx, "skipping label inheritance of new regional system", "systemId", system.ID, "error", err)
+		return labels
+	}
+
+	return inherited
+}
+
+// inheritTenantLabels propagates the change of the tenant labels from previous to current
+// to the regional systems of all systems linked to the tenant.
+func (l *Labels) inheritTenantLabels(ctx context.Context, r repository.Repository, tenantID string, previous, current map[string]string) error {
+	if !l.inherits() {
+		return nil
+	}
+
+	query := repository.QueryOf(model.SystemFields.TenantID.Eq(tenantID))
+
+	var systems []model.System
+	if err := r.List(ctx, &systems, *query); err != nil {
+		return ErrSystemSelect
+	}
+
+	for _, system := range systems {
+		if err := l.inheritSystemLabels(ctx, r, system.ID.String(), previous, current); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// inheritSystemLabels applies the change of the tenant labels from previous to current to the regional systems of the system.
+// Regional systems which would exceed the label limits keep their labels, so one system can not block the change of the tenant.
+func (l *Labels) inheritSystemLabels(ctx context.Context, r repository.Repository, systemID string, previous, current map[string]string) error {
+	if !l.inherits() {
+		return nil
+	}
+
+	regionalSystems, err := getRegionalSystemsFromSystemID(ctx, r, systemID)
+	if err != nil {
+		return err
+	}
+
+	for _, regionalSystem := range regionalSystems {
+		labels, changed := l.inherit(ctx, previous, current, regionalSystem.Labels)
+		if !changed {
+			continue
+		}
+
+		if err := l.checkLimits(labels); err != nil {
+			slogctx.Warn(ctx, "skipping label inheritance of regional system", "systemId", systemID, "region", regionalSystem.Region, "error", err)
+			continue
+		}
+
+		_, err := r.Patch(ctx, &model.RegionalSystem{
+			SystemID: regionalSystem.SystemID,
+			Region:   regionalSystem.Region,
+			Labels:   labels,
+		})
+		if err != nil {
+			return ErrSystemUpdate
+		}
+	}
+
+	return nil
+}
+
+// inherit returns the labels of a regional system with the change of the inherited tenant labels
+// from previous to current applied, and true if the labels changed. The labels are not modified.
+func (l *Labels) inherit(ctx context.Context, previous, current, labels map[string]string) (map[string]string, bool) {
+	result := maps.Clone(labels)
+	if result == nil {
+		result = make(map[string]string)
+	}
+
+	changed := false
+
+	for _, key := range l.cfg.Inheritance.Keys {
+		value, isSet := current[key]
+		previousValue, wasSet := previous[key]
+		own, has := result[key]
+		inherited := has && wasSet && own == previousValue
+
+		switch {
+		case isSet && has && own == value:
+		case isSet && (!has || inherited || l.cfg.Inheritance.Conflict == config.LabelConflictOverwrite):
+			result[key] = value
+			changed = true
+		case isSet:
+			slogctx.Debug(ctx, "keeping label of regional system over inherited tenant label", "labelKey", key)
+		case inherited:
+			delete(result, key)
+			changed = true
+		}
+	}
+
+	return result, changed
+}
+
+func (l *Labels) checkLimits(labels map[string]string) error {
+	if l.cfg.MaxLabels > 0 && len(labels) > l.cfg.MaxLabels {
+		return ErrorWithParams(ErrTooManyLabels, "count", len(labels), "max", l.cfg.MaxLabels)
+	}
+
+	if l.cfg.MaxValueLength > 0 {
+		for k, v := range labels {
+			if len(v) > l.cfg.MaxValueLength {
+				return ErrorWithParams(ErrLabelValueTooLong, "key", k, "max", l.cfg.MaxValueLength)
+			}
+		}
+	}
+
+	return nil
+}
+
+// audit logs the changed label keys of a resource. Values are not logged, as they may be sensitive.
+func (l *Labels) audit(ctx context.Context, resourceType, resourceName, operation string, keys []string) {
+	slogctx.Info(ctx, "labels changed",
+		"resourceType", resourceType,
+		"resourceName", resourceName,
+		"operation", operation,
+		"labelKeys", slices.Sorted(slices.Values(keys)))
+}
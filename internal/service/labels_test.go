@@ -0,0 +1,217 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestMergeLabels(t *testing.T) {
+	// given
+	current := map[string]string{"a": "1", "b": "2"}
+
+	// when
+	result := service.MergeLabels(current, map[string]string{"b": "3", "c": "4"})
+
+	// then
+	assert.Equal(t, map[string]string{"a": "1", "b": "3", "c": "4"}, result)
+	assert.Equal(t, map[string]string{"a": "1", "b": "2"}, current)
+}
+
+func TestRemoveLabels(t *testing.T) {
+	t.Run("should remove keys and ignore missing keys", func(t *testing.T) {
+		// given
+		current := map[string]string{"a": "1", "b": "2"}
+
+		// when
+		result := service.RemoveLabels(current, []string{"a", "missing"})
+
+		// then
+		assert.Equal(t, map[string]string{"b": "2"}, result)
+		assert.Equal(t, map[string]string{"a": "1", "b": "2"}, current)
+	})
+
+	t.Run("should return empty labels without labels", func(t *testing.T) {
+		// when
+		result := service.RemoveLabels(nil, []string{"a"})
+
+		// then
+		assert.NotNil(t, result)
+		assert.Empty(t, result)
+	})
+}
+
+func TestMissingLabelKeys(t *testing.T) {
+	// when
+	result := service.MissingLabelKeys(map[string]string{"a": "1"}, []string{"a", "b", "c"})
+
+	// then
+	assert.Equal(t, []string{"b", "c"}, result)
+}
+
+func TestLabelsCheckSet(t *testing.T) {
+	labels := service.NewLabels(nil, config.Labels{MaxLabels: 2, MaxValueLength: 3})
+
+	tests := []struct {
+		name    string
+		current map[string]string
+		set     map[string]string
+		expCode codes.Code
+	}{
+		{
+			name:    "within limits",
+			current: map[string]string{"a": "1"},
+			set:     map[string]string{"b": "2"},
+			expCode: codes.OK,
+		},
+		{
+			name:    "overwriting keeps the number of labels",
+			current: map[string]string{"a": "1", "b": "2"},
+			set:     map[string]string{"b": "3"},
+			expCode: codes.OK,
+		},
+		{
+			name:    "too many labels after merge",
+			current: map[string]string{"a": "1", "b": "2"},
+			set:     map[string]string{"c": "3"},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "value too long",
+			current: nil,
+			set:     map[string]string{"a": "1234"},
+			expCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := labels.CheckSet(tt.current, tt.set)
+
+			// then
+			assert.Equal(t, tt.expCode, status.Code(err))
+		})
+	}
+
+	t.Run("zero limits are disabled", func(t *testing.T) {
+		// given
+		unlimited := service.NewLabels(nil, config.Labels{})
+
+		// when
+		err := unlimited.CheckSet(map[string]string{"a": "1", "b": "2"}, map[string]string{"c": "a long value"})
+
+		// then
+		assert.NoError(t, err)
+	})
+}
+
+func TestLabelsValidateRemove(t *testing.T) {
+	labels := service.NewLabels(nil, config.Labels{})
+
+	assert.NoError(t, labels.ValidateRemove([]string{"a"}))
+	assert.ErrorIs(t, labels.ValidateRemove(nil), service.ErrMissingLabelKeys)
+	assert.ErrorIs(t, labels.ValidateRemove([]string{"a", ""}), service.ErrEmptyLabelKeys)
+}
+
+func TestLabelsSystemDefaults(t *testing.T) {
+	// given
+	labels := service.NewLabels(nil, config.Labels{
+		Defaults: config.LabelDefaults{System: map[string]string{"environment": "prod", "ring": "0"}},
+	})
+
+	// when
+	result := labels.SystemDefaults(map[string]string{"ring": "1", "team": "a"})
+
+	// then
+	assert.Equal(t, map[string]string{"environment": "prod", "ring": "1", "team": "a"}, result)
+	assert.Nil(t, service.NewLabels(nil, config.Labels{}).SystemDefaults(nil))
+}
+
+func TestLabelsInherit(t *testing.T) {
+	tests := []struct {
+		name       string
+		conflict   config.LabelConflict
+		previous   map[string]string
+		current    map[string]string
+		labels     map[string]string
+		expLabels  map[string]string
+		expChanged bool
+	}{
+		{
+			name:       "inherit on link",
+			current:    map[string]string{"ring": "0", "team": "a"},
+			labels:     map[string]string{"own": "1"},
+			expLabels:  map[string]string{"own": "1", "ring": "0"},
+			expChanged: true,
+		},
+		{
+			name:       "follow change of inherited label",
+			previous:   map[string]string{"ring": "0"},
+			current:    map[string]string{"ring": "1"},
+			labels:     map[string]string{"ring": "0"},
+			expLabels:  map[string]string{"ring": "1"},
+			expChanged: true,
+		},
+		{
+			name:       "keep own label on conflict",
+			previous:   map[string]string{"ring": "0"},
+			current:    map[string]string{"ring": "1"},
+			labels:     map[string]string{"ring": "2"},
+			expLabels:  map[string]string{"ring": "2"},
+			expChanged: false,
+		},
+		{
+			name:       "overwrite own label on conflict",
+			conflict:   config.LabelConflictOverwrite,
+			current:    map[string]string{"ring": "1"},
+			labels:     map[string]string{"ring": "2"},
+			expLabels:  map[string]string{"ring": "1"},
+			expChanged: true,
+		},
+		{
+			name:       "remove inherited label",
+			previous:   map[string]string{"ring": "0"},
+			labels:     map[string]string{"ring": "0", "own": "1"},
+			expLabels:  map[string]string{"own": "1"},
+			expChanged: true,
+		},
+		{
+			name:       "keep own label on remove",
+			conflict:   config.LabelConflictOverwrite,
+			previous:   map[string]string{"ring": "0"},
+			labels:     map[string]string{"ring": "2"},
+			expLabels:  map[string]string{"ring": "2"},
+			expChanged: false,
+		},
+		{
+			name:       "unchanged",
+			previous:   map[string]string{"ring": "0"},
+			current:    map[string]string{"ring": "0"},
+			labels:     map[string]string{"ring": "0"},
+			expLabels:  map[string]string{"ring": "0"},
+			expChanged: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			labels := service.NewLabels(nil, config.Labels{
+				Inheritance: config.LabelInheritance{Keys: []string{"ring"}, Conflict: tt.conflict},
+			})
+
+			// when
+			result, changed := labels.Inherit(t.Context(), tt.previous, tt.current, tt.labels)
+
+			// then
+			assert.Equal(t, tt.expLabels, result)
+			assert.Equal(t, tt.expChanged, changed)
+		})
+	}
+}
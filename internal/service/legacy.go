@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+	"strconv"
+
+	"google.golang.org/grpc/metadata"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+// Legacy request fields which are still accepted for one deprecation cycle.
+const (
+	legacyFieldEmptyType = "empty_type"
+	legacyFieldTenantID  = "deprecated_id"
+	// legacyFieldNotFound marks list requests without matching resources answered with NotFound.
+	legacyFieldNotFound = "not_found_on_empty_list"
+)
+
+// EmptyListMetadataKey is the gRPC metadata key a client sets to true to receive an empty page instead of NotFound
+// if no resources match a list request, or to false to receive NotFound regardless of the configuration.
+const EmptyListMetadataKey = "registry-empty-list"
+
+// LegacyRequests counts requests using a deprecated request shape
+// and rejects them once legacy requests are no longer accepted.
+type LegacyRequests struct {
+	accept     bool
+	emptyLists bool
+	meters     *Meters
+}
+
+// NewLegacyRequests creates and returns a new instance of LegacyRequests.
+func NewLegacyRequests(cfg config.Compatibility, meters *Meters) *LegacyRequests {
+	return &LegacyRequests{
+		accept:     cfg.AcceptLegacyRequests,
+		emptyLists: cfg.EmptyLists,
+		meters:     meters,
+	}
+}
+
+// handle records the usage of the legacy field by the method.
+// It returns an error if legacy requests are not accepted.
+func (l *LegacyRequests) handle(ctx context.Context, method, field string) error {
+	if l == nil {
+		return nil
+	}
+
+	if l.meters != nil {
+		l.meters.handleLegacyRequest(ctx, method, field)
+	}
+
+	if !l.accept {
+		return ErrorWithParams(ErrLegacyRequest, "method", method, "field", field)
+	}
+
+	slogctx.Debug(ctx, "legacy request shape used", "method", method, "field", field)
+
+	return nil
+}
+
+// emptyList returns the error of a list request of the method without matching resources, which is notFound
+// unless the client or the configuration asks for empty pages, the metadata of the client taking precedence.
+// The NotFound answers are recorded as legacy requests, so the clients still relying on them can be found.
+func (l *LegacyRequests) emptyList(ctx context.Context, method string, notFound error) error {
+	empty, requested := emptyListRequested(ctx)
+	if !requested {
+		empty = l != nil && l.emptyLists
+	}
+
+	if empty {
+		return nil
+	}
+
+	if l != nil && l.meters != nil {
+		l.meters.handleLegacyRequest(ctx, method, legacyFieldNotFound)
+	}
+
+	return notFound
+}
+
+// emptyListRequested returns whether the client asks for empty pages via the EmptyListMetadataKey metadata,
+// and false as second value if the client did not set the metadata.
+func emptyListRequested(ctx context.Context) (bool, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false, false
+	}
+
+	values := md.Get(EmptyListMetadataKey)
+	if len(values) == 0 {
+		return false, false
+	}
+
+	empty, err := strconv.ParseBool(values[0])
+	if err != nil {
+		return false, false
+	}
+
+	return empty, true
+}
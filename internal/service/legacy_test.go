@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestLegacyRequests(t *testing.T) {
+	t.Run("should accept legacy requests if enabled", func(t *testing.T) {
+		// given
+		legacy := service.NewLegacyRequests(config.Compatibility{AcceptLegacyRequests: true}, nil)
+
+		// when
+		err := legacy.Handle(context.Background(), "DeleteSystem", "empty_type")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject legacy requests if disabled", func(t *testing.T) {
+		// given
+		legacy := service.NewLegacyRequests(config.Compatibility{AcceptLegacyRequests: false}, nil)
+
+		// when
+		err := legacy.Handle(context.Background(), "DeleteSystem", "empty_type")
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.ErrorContains(t, err, "method=DeleteSystem")
+	})
+}
+
+func TestLegacyRequestsEmptyList(t *testing.T) {
+	tests := []struct {
+		name       string
+		emptyLists bool
+		metadata   string
+		expErr     error
+	}{
+		{name: "not found by default", expErr: service.ErrSystemNotFound},
+		{name: "empty list if configured", emptyLists: true},
+		{name: "empty list if requested", metadata: "true"},
+		{name: "not found if requested", emptyLists: true, metadata: "false", expErr: service.ErrSystemNotFound},
+		{name: "configuration if the metadata is invalid", emptyLists: true, metadata: "maybe"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			legacy := service.NewLegacyRequests(config.Compatibility{EmptyLists: tt.emptyLists}, nil)
+			ctx := context.Background()
+			if tt.metadata != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(service.EmptyListMetadataKey, tt.metadata))
+			}
+
+			// when
+			err := legacy.EmptyList(ctx, "ListSystems", service.ErrSystemNotFound)
+
+			// then
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+
+	t.Run("should answer with not found without legacy requests", func(t *testing.T) {
+		// given
+		var legacy *service.LegacyRequests
+
+		// when
+		err := legacy.EmptyList(context.Background(), "ListTenants", service.ErrTenantNotFound)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrTenantNotFound)
+	})
+}
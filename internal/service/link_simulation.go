@@ -0,0 +1,84 @@
+package service
+
+import (
+	"context"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+)
+
+// LinkOutcome is the predicted outcome of linking a system to or unlinking it from a tenant.
+// Err is the error the link or unlink would fail with, nil if it would succeed.
+type LinkOutcome struct {
+	model.SystemIdentifier
+
+	Err error
+}
+
+// Succeeds returns true if the link or unlink is predicted to succeed.
+func (o LinkOutcome) Succeeds() bool {
+	return o.Err == nil
+}
+
+// SimulateLink predicts the outcome of linking each of the systems to the tenant, by running the checks
+// of MapSystemToTenant without linking. Each system is simulated on its own, e.g. listing a system twice
+// predicts success for both. A system which does not exist yet is predicted to succeed, as it is created.
+func (m *Mapping) SimulateLink(ctx context.Context, tenantID string, systems []model.SystemIdentifier) ([]LinkOutcome, error) {
+	ctx = slogctx.With(ctx, "tenantId", tenantID, "systems", len(systems))
+	slogctx.Debug(ctx, "SimulateLink called")
+
+	return m.simulate(ctx, tenantID, systems, func(ctx context.Context, s model.SystemIdentifier) error {
+		_, _, err := isSystemTenantMapAllowed(ctx, m.repo, tenantID, s.ExternalID, s.Type)
+		return err
+	})
+}
+
+// SimulateUnlink predicts the outcome of unlinking each of the systems from the tenant, by running the checks
+// of UnmapSystemFromTenant without unlinking. Each system is simulated on its own.
+func (m *Mapping) SimulateUnlink(ctx context.Context, tenantID string, systems []model.SystemIdentifier) ([]LinkOutcome, error) {
+	ctx = slogctx.With(ctx, "tenantId", tenantID, "systems", len(systems))
+	slogctx.Debug(ctx, "SimulateUnlink called")
+
+	return m.simulate(ctx, tenantID, systems, func(ctx context.Context, s model.SystemIdentifier) error {
+		_, err := validateAndGetSystemForUnmap(ctx, m.repo, tenantID, s.ExternalID, s.Type)
+		return err
+	})
+}
+
+// simulate returns the outcome of the check of each system. The checks only read, they do not run within
+// a transaction, so the share locks they take are released right away and no link is blocked.
+func (m *Mapping) simulate(ctx context.Context, tenantID string, systems []model.SystemIdentifier,
+	check func(ctx context.Context, s model.SystemIdentifier) error,
+) ([]LinkOutcome, error) {
+	if tenantID == "" {
+		return nil, ErrNoTenantID
+	}
+
+	// invalid systems are predicted to fail validation, so all systems are validated
+	fieldErrs, _, err := m.identifiers.validateEach(ctx, systems, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	outcomes := make([]LinkOutcome, 0, len(systems))
+	for i, s := range systems {
+		outcome := LinkOutcome{SystemIdentifier: s}
+		if len(fieldErrs[i]) > 0 {
+			outcome.Err = identifierError(fieldErrs[i])
+		} else {
+			outcome.Err = check(ctxTimeout, s)
+		}
+
+		if err := ctxTimeout.Err(); err != nil {
+			return nil, mapError(err)
+		}
+
+		outcomes = append(outcomes, outcome)
+	}
+
+	return outcomes, nil
+}
@@ -0,0 +1,331 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"maps"
+	"math/rand/v2"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+// LevelTrace is the level of trace logs, matching the trace level of the logger configuration.
+const LevelTrace = slog.Level(-8)
+
+var (
+	ErrLogLevelInvalid          = errors.New("log level must be trace, debug, info, warn or error")
+	ErrLogSamplingRateInvalid   = errors.New("log sampling rate must be between 0 and 1")
+	ErrLogSamplingMethodMissing = errors.New("log sampling requires a gRPC method")
+	ErrLogControlDuration       = errors.New("log control duration must be positive and must not exceed the maximum duration")
+)
+
+// LogControl raises or lowers the log level at runtime and logs the debug messages of sampled calls
+// of gRPC methods, so production issues can be debugged without a restart.
+// Every change reverts after its duration, changes and reverts are audit logged whatever the level.
+// The changes are served by the admin service, the status server only serves the current state.
+type LogControl struct {
+	cfg  config.LogControl
+	base slog.Level
+
+	level    atomic.Pointer[logLevelOverride]
+	sampling atomic.Pointer[map[string]logSampling]
+
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+type logLevelOverride struct {
+	level slog.Level
+	until time.Time
+}
+
+type logSampling struct {
+	rate  float64
+	until time.Time
+}
+
+type logSampledKey struct{}
+
+// logAuditKey marks the context of the audit logs of the log control, which are logged at any level.
+type logAuditKey struct{}
+
+// logLevelTimer is the timer key of the level override, the timers of the sampling are keyed by method.
+const logLevelTimer = ""
+
+// NewLogControl creates and returns a new instance of LogControl, which logs at the configured level
+// unless the level is changed.
+func NewLogControl(cfg config.LogControl, level string) (*LogControl, error) {
+	base, err := ParseLogLevel(level)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &LogControl{
+		cfg:    cfg,
+		base:   base,
+		timers: make(map[string]*time.Timer),
+	}
+	c.sampling.Store(&map[string]logSampling{})
+
+	return c, nil
+}
+
+// ParseLogLevel parses the level of the logger configuration, an empty level is info.
+func ParseLogLevel(level string) (slog.Level, error) {
+	switch strings.ToLower(level) {
+	case "trace":
+		return LevelTrace, nil
+	case "debug":
+		return slog.LevelDebug, nil
+	case "info", "":
+		return slog.LevelInfo, nil
+	case "warn":
+		return slog.LevelWarn, nil
+	case "error":
+		return slog.LevelError, nil
+	default:
+		return 0, fmt.Errorf("%w: %s", ErrLogLevelInvalid, level)
+	}
+}
+
+// Handler wraps the handler, so it only handles records of the current level or of sampled calls.
+// The wrapped handler must handle all levels.
+func (c *LogControl) Handler(next slog.Handler) slog.Handler {
+	return &logControlHandler{Handler: next, control: c}
+}
+
+// Enabled reports whether the log level and sampling can be changed.
+func (c *LogControl) Enabled() bool {
+	return c.cfg.Enabled
+}
+
+// Level returns the current log level.
+func (c *LogControl) Level() slog.Level {
+	if override := c.level.Load(); override != nil {
+		return override.level
+	}
+
+	return c.base
+}
+
+// SetLevel changes the log level for the duration, after which the configured level is restored.
+// A zero duration is the maximum duration.
+func (c *LogControl) SetLevel(ctx context.Context, level slog.Level, d time.Duration) error {
+	d = c.duration(d)
+	if err := c.validateDuration(d); err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	until := time.Now().Add(d)
+	logControlAudit(ctx, "log level changed", "level", level, "until", until)
+	c.level.Store(&logLevelOverride{level: level, until: until})
+	c.schedule(logLevelTimer, d, func() {
+		logControlAudit(context.Background(), "log level reverted", "level", c.base)
+		c.level.Store(nil)
+	})
+
+	return nil
+}
+
+// SetSampling logs the debug messages of the share of calls of the method given by the rate for the duration.
+// A zero rate stops the sampling of the method, a zero duration is the maximum duration.
+func (c *LogControl) SetSampling(ctx context.Context, method string, rate float64, d time.Duration) error {
+	if method == "" {
+		return ErrLogSamplingMethodMissing
+	}
+
+	if rate < 0 || rate > 1 {
+		return fmt.Errorf("%w: %v", ErrLogSamplingRateInvalid, rate)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if rate == 0 {
+		c.stopSampling(method)
+		logControlAudit(ctx, "log sampling stopped", "method", method)
+		return nil
+	}
+
+	d = c.duration(d)
+	if err := c.validateDuration(d); err != nil {
+		return err
+	}
+
+	until := time.Now().Add(d)
+	sampling := maps.Clone(*c.sampling.Load())
+	sampling[method] = logSampling{rate: rate, until: until}
+	c.sampling.Store(&sampling)
+	c.schedule(method, d, func() {
+		c.stopSampling(method)
+		logControlAudit(context.Background(), "log sampling reverted", "method", method)
+	})
+
+	logControlAudit(ctx, "log sampling changed", "method", method, "rate", rate, "until", until)
+
+	return nil
+}
+
+// Sample returns the context of a call of the method, which is marked as sampled by the rate of the method.
+func (c *LogControl) Sample(ctx context.Context, method string) context.Context {
+	sampling, ok := (*c.sampling.Load())[method]
+	if !ok || rand.Float64() >= sampling.rate {
+		return ctx
+	}
+
+	return context.WithValue(ctx, logSampledKey{}, true)
+}
+
+// ServeHTTP serves the current level and sampling as JSON, see State.
+func (c *LogControl) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.Header().Set("Allow", "GET")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(c.State()); err != nil {
+		slogctx.Error(r.Context(), "failed to write log control state", "error", err)
+	}
+}
+
+// LogControlState is the current level and sampling of the log control.
+type LogControlState struct {
+	Level     string `json:"level"`
+	BaseLevel string `json:"baseLevel"`
+	// Until is when the level is restored, nil if the level is not changed.
+	Until    *time.Time         `json:"until,omitempty"`
+	Sampling []LogSamplingState `json:"sampling"`
+}
+
+// LogSamplingState is the sampling of a gRPC method.
+type LogSamplingState struct {
+	Method string    `json:"method"`
+	Rate   float64   `json:"rate"`
+	Until  time.Time `json:"until"`
+}
+
+// State returns the current level and the sampling ordered by method.
+func (c *LogControl) State() LogControlState {
+	state := LogControlState{
+		Level:     c.Level().String(),
+		BaseLevel: c.base.String(),
+		Sampling:  []LogSamplingState{},
+	}
+
+	if override := c.level.Load(); override != nil {
+		state.Until = &override.until
+	}
+
+	sampling := *c.sampling.Load()
+	for _, method := range slices.Sorted(maps.Keys(sampling)) {
+		state.Sampling = append(state.Sampling, LogSamplingState{
+			Method: method,
+			Rate:   sampling[method].rate,
+			Until:  sampling[method].until,
+		})
+	}
+
+	return state
+}
+
+// duration returns the duration of a change, the maximum duration if zero.
+func (c *LogControl) duration(d time.Duration) time.Duration {
+	if d == 0 {
+		return c.cfg.MaxDuration
+	}
+
+	return d
+}
+
+func (c *LogControl) validateDuration(d time.Duration) error {
+	if d <= 0 || d > c.cfg.MaxDuration {
+		return fmt.Errorf("%w: %v, maximum %v", ErrLogControlDuration, d, c.cfg.MaxDuration)
+	}
+
+	return nil
+}
+
+// schedule reverts the change of the key after the duration, unless the key is changed again before.
+// The caller must hold the lock.
+func (c *LogControl) schedule(key string, d time.Duration, revert func()) {
+	if timer, ok := c.timers[key]; ok {
+		timer.Stop()
+	}
+
+	var timer *time.Timer
+	timer = time.AfterFunc(d, func() {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+
+		if c.timers[key] != timer {
+			return
+		}
+
+		delete(c.timers, key)
+		revert()
+	})
+	c.timers[key] = timer
+}
+
+// stopSampling stops the sampling of the method. The caller must hold the lock.
+func (c *LogControl) stopSampling(method string) {
+	if timer, ok := c.timers[method]; ok {
+		timer.Stop()
+		delete(c.timers, method)
+	}
+
+	sampling := maps.Clone(*c.sampling.Load())
+	delete(sampling, method)
+	c.sampling.Store(&sampling)
+}
+
+// logControlAudit logs a change of the log control whatever the current level.
+func logControlAudit(ctx context.Context, msg string, args ...any) {
+	slogctx.Warn(context.WithValue(ctx, logAuditKey{}, true), msg, args...)
+}
+
+// logControlHandler handles the records of the current level of the log control,
+// the debug records of sampled calls and the audit records of the log control.
+type logControlHandler struct {
+	slog.Handler
+
+	control *LogControl
+}
+
+func (h *logControlHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	if level >= h.control.Level() {
+		return true
+	}
+
+	if audited, _ := ctx.Value(logAuditKey{}).(bool); audited {
+		return true
+	}
+
+	sampled, _ := ctx.Value(logSampledKey{}).(bool)
+
+	return sampled && level >= slog.LevelDebug
+}
+
+func (h *logControlHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &logControlHandler{Handler: h.Handler.WithAttrs(attrs), control: h.control}
+}
+
+func (h *logControlHandler) WithGroup(name string) slog.Handler {
+	return &logControlHandler{Handler: h.Handler.WithGroup(name), control: h.control}
+}
@@ -0,0 +1,169 @@
+package service_test
+
+import (
+	"bytes"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+const sampledMethod = "/kms.api.cmk.registry.tenant.v1.Service/GetTenant"
+
+func TestLogControlLevel(t *testing.T) {
+	// given
+	subj, err := service.NewLogControl(config.LogControl{Enabled: true, MaxDuration: time.Minute}, "info")
+	require.NoError(t, err)
+	handler := subj.Handler(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: service.LevelTrace}))
+
+	// when
+	err = subj.SetLevel(t.Context(), slog.LevelDebug, 50*time.Millisecond)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, handler.Enabled(t.Context(), slog.LevelDebug))
+	assert.Eventually(t, func() bool {
+		return !handler.Enabled(t.Context(), slog.LevelDebug)
+	}, time.Second, 10*time.Millisecond, "the level must be reverted")
+	assert.Equal(t, slog.LevelInfo, subj.Level())
+}
+
+func TestLogControlSampling(t *testing.T) {
+	// given
+	subj, err := service.NewLogControl(config.LogControl{Enabled: true, MaxDuration: time.Minute}, "info")
+	require.NoError(t, err)
+	handler := subj.Handler(slog.NewTextHandler(io.Discard, &slog.HandlerOptions{Level: service.LevelTrace}))
+
+	// when
+	err = subj.SetSampling(t.Context(), sampledMethod, 1, time.Minute)
+
+	// then
+	require.NoError(t, err)
+	assert.True(t, handler.Enabled(subj.Sample(t.Context(), sampledMethod), slog.LevelDebug))
+	assert.False(t, handler.Enabled(subj.Sample(t.Context(), sampledMethod), service.LevelTrace))
+	assert.False(t, handler.Enabled(subj.Sample(t.Context(), "/other"), slog.LevelDebug))
+
+	// when
+	err = subj.SetSampling(t.Context(), sampledMethod, 0, 0)
+
+	// then
+	require.NoError(t, err)
+	assert.False(t, handler.Enabled(subj.Sample(t.Context(), sampledMethod), slog.LevelDebug))
+}
+
+func TestLogControlServeHTTP(t *testing.T) {
+	tests := []struct {
+		name      string
+		method    string
+		expStatus int
+		expBody   string
+	}{
+		{name: "get", method: http.MethodGet, expStatus: http.StatusOK, expBody: `"level":"DEBUG"`},
+		{name: "post", method: http.MethodPost, expStatus: http.StatusMethodNotAllowed},
+		{name: "delete", method: http.MethodDelete, expStatus: http.StatusMethodNotAllowed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj, err := service.NewLogControl(config.LogControl{Enabled: true, MaxDuration: time.Hour}, "info")
+			require.NoError(t, err)
+			require.NoError(t, subj.SetLevel(t.Context(), slog.LevelDebug, 0))
+
+			req := httptest.NewRequest(tt.method, "/probe/log-level", strings.NewReader("level=error"))
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			rec := httptest.NewRecorder()
+
+			// when
+			subj.ServeHTTP(rec, req)
+
+			// then
+			assert.Equal(t, tt.expStatus, rec.Code, rec.Body.String())
+			assert.Contains(t, rec.Body.String(), tt.expBody)
+			assert.Equal(t, slog.LevelDebug, subj.Level())
+		})
+	}
+}
+
+func TestLogControlSetLevel(t *testing.T) {
+	tests := []struct {
+		name     string
+		duration time.Duration
+		expErr   error
+	}{
+		{name: "duration", duration: 10 * time.Minute},
+		{name: "maximum duration by default"},
+		{name: "duration exceeding maximum", duration: 2 * time.Hour, expErr: service.ErrLogControlDuration},
+		{name: "negative duration", duration: -time.Minute, expErr: service.ErrLogControlDuration},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj, err := service.NewLogControl(config.LogControl{Enabled: true, MaxDuration: time.Hour}, "info")
+			require.NoError(t, err)
+
+			// when
+			err = subj.SetLevel(t.Context(), slog.LevelDebug, tt.duration)
+
+			// then
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				assert.Equal(t, slog.LevelInfo, subj.Level())
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, slog.LevelDebug, subj.Level())
+				assert.NotNil(t, subj.State().Until)
+			}
+		})
+	}
+}
+
+func TestLogControlAudit(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+	subj, err := service.NewLogControl(config.LogControl{Enabled: true, MaxDuration: time.Hour}, "info")
+	require.NoError(t, err)
+
+	defaultLogger := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+	slog.SetDefault(slog.New(subj.Handler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: service.LevelTrace}))))
+
+	// when
+	err = subj.SetLevel(t.Context(), slog.LevelDebug, time.Minute)
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "log level changed")
+}
+
+func TestLogControlAuditBelowLevel(t *testing.T) {
+	// given
+	var buf bytes.Buffer
+	subj, err := service.NewLogControl(config.LogControl{Enabled: true, MaxDuration: time.Hour}, "info")
+	require.NoError(t, err)
+
+	defaultLogger := slog.Default()
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+	slog.SetDefault(slog.New(subj.Handler(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: service.LevelTrace}))))
+
+	// when
+	err = subj.SetLevel(t.Context(), slog.LevelError, 50*time.Millisecond)
+
+	// then
+	require.NoError(t, err)
+	assert.Contains(t, buf.String(), "log level changed")
+	require.Eventually(t, func() bool {
+		return subj.Level() == slog.LevelInfo
+	}, time.Second, 10*time.Millisecond, "the level must be reverted")
+	assert.Contains(t, buf.String(), "log level reverted")
+}
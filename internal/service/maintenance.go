@@ -0,0 +1,126 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openkcm/orbital"
+	"google.golang.org/grpc/metadata"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// ForceMetadataKey is the gRPC metadata key a client sets to true to run a disruptive operation
+// outside the maintenance windows of the tenant.
+const ForceMetadataKey = "registry-force"
+
+// maintenanceScheduler decides when a job disrupting a tenant runs with respect to its maintenance windows.
+type maintenanceScheduler struct {
+	policy string
+	now    func() time.Time
+}
+
+func newMaintenanceScheduler(policy string) maintenanceScheduler {
+	return maintenanceScheduler{
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// schedule returns the time the job may run at, which is the zero time if it may run immediately.
+// Outside the windows, the job is scheduled to the next window, or rejected by the reject policy
+// or if no window is upcoming. Forced jobs always run immediately.
+func (s maintenanceScheduler) schedule(ctx context.Context, windows model.MaintenanceWindows) (time.Time, error) {
+	if len(windows) == 0 || isForced(ctx) {
+		return time.Time{}, nil
+	}
+
+	now := s.now()
+	next, ok := windows.NextOpen(now)
+	if ok && !next.After(now) {
+		return time.Time{}, nil
+	}
+
+	if !ok || s.policy == config.MaintenancePolicyReject {
+		return time.Time{}, ErrOutsideMaintenanceWindow
+	}
+
+	return next, nil
+}
+
+// scheduleAfter returns the time the job may run at like schedule, but not before notBefore.
+// A job held back until notBefore is scheduled to the first window open at or after notBefore,
+// regardless of the policy, as it does not run at the time of the request anyway.
+// Forced jobs run at notBefore.
+func (s maintenanceScheduler) scheduleAfter(ctx context.Context, windows model.MaintenanceWindows, notBefore time.Time) (time.Time, error) {
+	if !notBefore.After(s.now()) {
+		return s.schedule(ctx, windows)
+	}
+
+	if len(windows) == 0 || isForced(ctx) {
+		return notBefore, nil
+	}
+
+	next, ok := windows.NextOpen(notBefore)
+	if !ok {
+		return time.Time{}, ErrOutsideMaintenanceWindow
+	}
+
+	return next, nil
+}
+
+// hold returns true if the job is delayed and must not be confirmed yet.
+// Expired delays are removed, so the job is confirmed.
+func (s maintenanceScheduler) hold(ctx context.Context, r repository.Repository, job orbital.Job) (bool, error) {
+	delay := &model.JobDelay{JobID: job.ID.String()}
+	found, err := r.Find(ctx, delay)
+	if err != nil {
+		return false, err
+	}
+	if !found {
+		return false, nil
+	}
+
+	if s.now().Before(delay.NotBefore) {
+		slogctx.Debug(ctx, "job is delayed", "notBefore", delay.NotBefore)
+		return true, nil
+	}
+
+	return false, deleteJobDelay(ctx, r, delay.JobID)
+}
+
+// createJobDelay delays the confirmation of the job until notBefore.
+func createJobDelay(ctx context.Context, r repository.Repository, job orbital.Job, notBefore time.Time) error {
+	return r.Create(ctx, &model.JobDelay{
+		JobID:      job.ID.String(),
+		ExternalID: job.ExternalID,
+		JobType:    job.Type,
+		NotBefore:  notBefore,
+	})
+}
+
+func deleteJobDelay(ctx context.Context, r repository.Repository, jobID string) error {
+	_, err := r.Delete(ctx, &model.JobDelay{JobID: jobID})
+	return err
+}
+
+// isForced returns true if the client forces the operation via the ForceMetadataKey metadata.
+func isForced(ctx context.Context) bool {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+
+	values := md.Get(ForceMetadataKey)
+	if len(values) == 0 {
+		return false
+	}
+
+	forced, err := strconv.ParseBool(values[0])
+	return err == nil && forced
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// MaintenanceMode lets admins put the registry into maintenance mode, e.g. during a database migration,
+// in which the MaintenanceMode interceptor rejects the changing requests of all services but the admin service.
+// The mode is stored in the database, so it applies to all replicas once they have refreshed it.
+type MaintenanceMode struct {
+	repo    repository.Repository
+	cfg     config.Admin
+	enabled atomic.Bool
+}
+
+// NewMaintenanceMode creates and returns a new instance of MaintenanceMode.
+func NewMaintenanceMode(repo repository.Repository, cfg config.Admin) *MaintenanceMode {
+	return &MaintenanceMode{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// Start reads the maintenance mode now and then every refresh interval until ctx is done.
+func (m *MaintenanceMode) Start(ctx context.Context) {
+	go func() {
+		m.refresh(ctx)
+
+		if m.cfg.MaintenanceRefreshInterval <= 0 {
+			return
+		}
+
+		ticker := time.NewTicker(m.cfg.MaintenanceRefreshInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				m.refresh(ctx)
+			}
+		}
+	}()
+}
+
+// Enabled reports whether the registry is in maintenance mode, as of the last refresh.
+func (m *MaintenanceMode) Enabled() bool {
+	return m.enabled.Load()
+}
+
+// Check returns ErrCapabilityMaintenanceMode while the registry is in maintenance mode, as of the last refresh,
+// it is meant as readiness check of the writes.
+func (m *MaintenanceMode) Check(_ context.Context) error {
+	if m.Enabled() {
+		return ErrCapabilityMaintenanceMode
+	}
+
+	return nil
+}
+
+// GetMaintenanceMode returns the maintenance mode, enabled is false if it is disabled.
+func (m *MaintenanceMode) GetMaintenanceMode(ctx context.Context) (*model.MaintenanceMode, bool, error) {
+	mode := &model.MaintenanceMode{Name: model.MaintenanceModeName}
+
+	enabled, err := m.repo.Find(ctx, mode)
+	if err != nil {
+		slogctx.Error(ctx, "failed to select maintenance mode", "error", err)
+		return nil, false, ErrMaintenanceModeSelect
+	}
+
+	m.enabled.Store(enabled)
+
+	return mode, enabled, nil
+}
+
+// SetMaintenanceMode enables the maintenance mode for the reason, or disables it.
+// It applies to this replica at once and to the other replicas with their next refresh.
+func (m *MaintenanceMode) SetMaintenanceMode(ctx context.Context, enabled bool, reason string) error {
+	slogctx.Warn(ctx, "SetMaintenanceMode called", "enabled", enabled, "reason", reason)
+
+	err := m.repo.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
+		mode := &model.MaintenanceMode{Name: model.MaintenanceModeName}
+
+		if !enabled {
+			_, err := r.Delete(ctx, mode)
+			return err
+		}
+
+		found, err := r.Find(ctx, mode)
+		if err != nil {
+			return err
+		}
+
+		mode.Reason = reason
+		mode.EnabledBy = repository.CallerFromContext(ctx)
+
+		if found {
+			_, err = r.Patch(ctx, mode)
+			return err
+		}
+
+		return r.Create(ctx, mode)
+	})
+	if err != nil {
+		slogctx.Error(ctx, "failed to update maintenance mode", "error", err)
+		return ErrMaintenanceModeUpdate
+	}
+
+	m.enabled.Store(enabled)
+
+	return nil
+}
+
+// refresh reads the maintenance mode from the database, keeping the last known mode if it fails.
+func (m *MaintenanceMode) refresh(ctx context.Context) {
+	if _, _, err := m.GetMaintenanceMode(ctx); err != nil {
+		slogctx.Warn(ctx, "failed to refresh maintenance mode", "error", err)
+	}
+}
@@ -0,0 +1,158 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestScheduleMaintenance(t *testing.T) {
+	// Saturday, 10 January 2026
+	saturday := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	windows := model.MaintenanceWindows{{Weekdays: []string{"Saturday"}, StartTime: "02:00", Duration: "4h"}}
+	ended := saturday.Add(-time.Hour)
+	endedStart := ended.Add(-time.Hour)
+	endedWindows := model.MaintenanceWindows{{Start: &endedStart, End: &ended}}
+
+	tests := []struct {
+		name         string
+		policy       string
+		windows      model.MaintenanceWindows
+		now          time.Time
+		forced       bool
+		expNotBefore time.Time
+		expErr       error
+	}{
+		{
+			name:   "no windows",
+			policy: config.MaintenancePolicySchedule,
+			now:    saturday,
+		},
+		{
+			name:    "inside window",
+			policy:  config.MaintenancePolicyReject,
+			windows: windows,
+			now:     saturday.Add(3 * time.Hour),
+		},
+		{
+			name:         "outside window is scheduled",
+			policy:       config.MaintenancePolicySchedule,
+			windows:      windows,
+			now:          saturday,
+			expNotBefore: saturday.Add(2 * time.Hour),
+		},
+		{
+			name:    "outside window is rejected",
+			policy:  config.MaintenancePolicyReject,
+			windows: windows,
+			now:     saturday,
+			expErr:  service.ErrOutsideMaintenanceWindow,
+		},
+		{
+			name:    "forced outside window",
+			policy:  config.MaintenancePolicyReject,
+			windows: windows,
+			now:     saturday,
+			forced:  true,
+		},
+		{
+			name:    "no upcoming window",
+			policy:  config.MaintenancePolicySchedule,
+			windows: endedWindows,
+			now:     saturday,
+			expErr:  service.ErrOutsideMaintenanceWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctx := t.Context()
+			if tt.forced {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(service.ForceMetadataKey, "true"))
+			}
+
+			// when
+			notBefore, err := service.ScheduleMaintenance(ctx, tt.policy, tt.now, tt.windows)
+
+			// then
+			assert.ErrorIs(t, err, tt.expErr)
+			assert.Equal(t, tt.expNotBefore, notBefore)
+		})
+	}
+}
+
+func TestScheduleMaintenanceAfter(t *testing.T) {
+	// Saturday, 10 January 2026
+	saturday := time.Date(2026, 1, 10, 0, 0, 0, 0, time.UTC)
+	windows := model.MaintenanceWindows{{Weekdays: []string{"Saturday"}, StartTime: "02:00", Duration: "4h"}}
+
+	tests := []struct {
+		name         string
+		policy       string
+		windows      model.MaintenanceWindows
+		notBefore    time.Time
+		forced       bool
+		expNotBefore time.Time
+		expErr       error
+	}{
+		{
+			name:         "no windows",
+			policy:       config.MaintenancePolicySchedule,
+			notBefore:    saturday.Add(time.Hour),
+			expNotBefore: saturday.Add(time.Hour),
+		},
+		{
+			name:         "inside window",
+			policy:       config.MaintenancePolicyReject,
+			windows:      windows,
+			notBefore:    saturday.Add(3 * time.Hour),
+			expNotBefore: saturday.Add(3 * time.Hour),
+		},
+		{
+			name:         "outside window is scheduled regardless of the policy",
+			policy:       config.MaintenancePolicyReject,
+			windows:      windows,
+			notBefore:    saturday.Add(7 * time.Hour),
+			expNotBefore: saturday.Add(7*24*time.Hour + 2*time.Hour),
+		},
+		{
+			name:         "forced outside window",
+			policy:       config.MaintenancePolicySchedule,
+			windows:      windows,
+			notBefore:    saturday.Add(7 * time.Hour),
+			forced:       true,
+			expNotBefore: saturday.Add(7 * time.Hour),
+		},
+		{
+			name:      "past is scheduled like now",
+			policy:    config.MaintenancePolicyReject,
+			windows:   windows,
+			notBefore: saturday.Add(-time.Hour),
+			expErr:    service.ErrOutsideMaintenanceWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ctx := t.Context()
+			if tt.forced {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(service.ForceMetadataKey, "true"))
+			}
+
+			// when
+			notBefore, err := service.ScheduleMaintenanceAfter(ctx, tt.policy, saturday, tt.windows, tt.notBefore)
+
+			// then
+			assert.ErrorIs(t, err, tt.expErr)
+			assert.Equal(t, tt.expNotBefore, notBefore)
+		})
+	}
+}
@@ -0,0 +1,438 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// maxManifestSystems bounds the number of systems linked to a tenant by a manifest.
+const maxManifestSystems = 1000
+
+// Actions of the steps of an ApplyPlan.
+const (
+	PlanActionCreateTenant     = "CREATE_TENANT"
+	PlanActionUpdateLabels     = "UPDATE_LABELS"
+	PlanActionUpdateUserGroups = "UPDATE_USER_GROUPS"
+	PlanActionUnlinkSystem     = "UNLINK_SYSTEM"
+	PlanActionLinkSystem       = "LINK_SYSTEM"
+	PlanActionApplyAuth        = "APPLY_AUTH"
+)
+
+// Manifest describes the desired state of a tenant and the resources belonging to it.
+type Manifest struct {
+	Tenant     ManifestTenant
+	Labels     map[string]string
+	UserGroups []string
+	// Systems are all systems linked to the tenant. Linked systems not listed are unlinked.
+	Systems []model.SystemIdentifier
+	// Auths are applied to the tenant if they do not exist yet. Auths not listed are kept.
+	Auths []ManifestAuth
+}
+
+// ManifestTenant holds the fields of the tenant which can not be changed once it is registered.
+type ManifestTenant struct {
+	ID        string
+	Name      string
+	Region    string
+	OwnerID   string
+	OwnerType string
+	Role      string
+}
+
+// ManifestAuth describes an auth of the tenant.
+type ManifestAuth struct {
+	ExternalID string
+	Type       string
+	Properties map[string]string
+}
+
+// ApplyPlan lists the steps needed to reach the state described by a manifest, in order of execution.
+type ApplyPlan struct {
+	Steps  []PlanStep
+	DryRun bool
+}
+
+// PlanStep is a single operation of an ApplyPlan on the resource identified by Target.
+type PlanStep struct {
+	Action string
+	Target string
+
+	run func(ctx context.Context, r repository.Repository) error
+}
+
+// Manifests reconciles the registry with declarative manifests.
+// The procedure call is served on the admin service, see Admin.
+type Manifests struct {
+	repo        repository.Repository
+	tenant      *Tenant
+	auth        *Auth
+	identifiers *SystemIdentifiers
+}
+
+// NewManifests creates and returns a new instance of Manifests.
+func NewManifests(repo repository.Repository, tenant *Tenant, auth *Auth, identifiers *SystemIdentifiers) *Manifests {
+	return &Manifests{
+		repo:        repo,
+		tenant:      tenant,
+		auth:        auth,
+		identifiers: identifiers,
+	}
+}
+
+// ApplyManifest diffs the manifest against the current state and performs the minimal set of operations
+// to reach it within one transaction. With dryRun the plan is returned without performing it.
+//
+// Systems and auths can only be applied to an active tenant, so a manifest of a new tenant
+// only registers the tenant and has to be applied again once the tenant is provisioned.
+func (m *Manifests) ApplyManifest(ctx context.Context, manifest *Manifest, dryRun bool) (*ApplyPlan, error) {
+	ctx = slogctx.With(ctx, "tenantId", manifest.Tenant.ID, "dryRun", dryRun)
+	slogctx.Debug(ctx, "ApplyManifest called")
+
+	desired, err := m.validateManifest(ctx, manifest)
+	if err != nil {
+		slogctx.Warn(ctx, "validation failed for ApplyManifest request", "error", err)
+		return nil, err
+	}
+
+	plan := &ApplyPlan{DryRun: dryRun}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err = m.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		steps, err := m.plan(ctx, r, manifest, desired)
+		if err != nil {
+			return err
+		}
+
+		plan.Steps = steps
+		if dryRun {
+			return nil
+		}
+
+		for _, step := range steps {
+			if err := step.run(ctx, r); err != nil {
+				slogctx.Warn(ctx, "failed to apply manifest step", "action", step.Action, "target", step.Target, "error", err)
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	err = mapError(err)
+	if err != nil {
+		return nil, err
+	}
+
+	if !dryRun && slices.ContainsFunc(plan.Steps, func(s PlanStep) bool { return s.Action == PlanActionCreateTenant }) {
+		m.tenant.meters.handleTenantRegistration(ctx, desired.Region)
+	}
+
+	return plan, nil
+}
+
+// plan diffs the manifest against the current state.
+func (m *Manifests) plan(ctx context.Context, r repository.Repository, manifest *Manifest, desired *model.Tenant) ([]PlanStep, error) {
+	existing := &model.Tenant{ID: desired.ID}
+	found, err := r.Find(ctx, existing)
+	if err != nil {
+		return nil, ErrTenantSelect
+	}
+
+	if !found {
+		if len(manifest.Systems) > 0 || len(manifest.Auths) > 0 {
+			return nil, ErrorWithParams(ErrManifestTenantNotActive, "tenantID", desired.ID)
+		}
+
+		return []PlanStep{m.createTenantStep(desired)}, nil
+	}
+
+	if field, ok := immutableFieldChanged(existing, desired); ok {
+		return nil, ErrorWithParams(ErrManifestConflict, "tenantID", desired.ID, "field", field)
+	}
+
+	var steps []PlanStep
+	if !maps.Equal(existing.Labels, desired.Labels) {
+		steps = append(steps, patchTenantStep(PlanActionUpdateLabels, desired.ID, func(t *model.Tenant) {
+			t.Labels = desired.Labels
+		}))
+	}
+
+	if !slices.Equal(existing.UserGroups, desired.UserGroups) {
+		step := patchTenantStep(PlanActionUpdateUserGroups, desired.ID, func(t *model.Tenant) {
+			t.UserGroups = desired.UserGroups
+		})
+		patch := step.run
+		step.run = func(ctx context.Context, r repository.Repository) error {
+			if err := patch(ctx, r); err != nil {
+				return err
+			}
+			return syncTenantUserGroups(ctx, r, desired.ID, desired.UserGroups)
+		}
+		steps = append(steps, step)
+	}
+
+	systemSteps, err := planSystems(ctx, r, m.tenant, desired.ID, manifest.Systems)
+	if err != nil {
+		return nil, err
+	}
+
+	authSteps, err := m.planAuths(ctx, r, desired.ID, manifest.Auths)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(systemSteps) > 0 || len(authSteps) > 0 {
+		if err := checkTenantActive(existing); err != nil {
+			return nil, ErrorWithParams(ErrManifestTenantNotActive, "tenantID", desired.ID)
+		}
+	}
+
+	steps = append(steps, systemSteps...)
+	steps = append(steps, authSteps...)
+
+	return steps, nil
+}
+
+func (m *Manifests) createTenantStep(tenant *model.Tenant) PlanStep {
+	return PlanStep{
+		Action: PlanActionCreateTenant,
+		Target: tenant.ID,
+		run: func(ctx context.Context, r repository.Repository) error {
+			err := r.Create(ctx, tenant)
+			if isUniqueConstraintError(err) {
+				return ErrorAlreadyExists(ResourceTypeTenant, tenant.ID)
+			}
+			if err != nil {
+				return ErrTenantUpdate
+			}
+
+			if err := syncTenantUserGroups(ctx, r, tenant.ID, tenant.UserGroups); err != nil {
+				return err
+			}
+
+			data, err := tenantJobPayload.encode(tenant.ToProto())
+			if err != nil {
+				slogctx.Error(ctx, "failed to encode tenant data", "error", err)
+				return ErrTenantEncoding
+			}
+
+			return m.tenant.orbital.PrepareJob(ctx, data, tenant.ID, tenantgrpc.ACTION_ACTION_PROVISION_TENANT.String())
+		},
+	}
+}
+
+func patchTenantStep(action, tenantID string, update tenantUpdateFunc) PlanStep {
+	return PlanStep{
+		Action: action,
+		Target: tenantID,
+		run: func(ctx context.Context, r repository.Repository) error {
+			tenant := &model.Tenant{ID: tenantID}
+			update(tenant)
+
+			patched, err := r.Patch(ctx, tenant)
+			if err != nil || !patched {
+				return ErrTenantUpdate
+			}
+
+			return nil
+		},
+	}
+}
+
+// planSystems unlinks the systems linked to the tenant but not listed, and links the listed systems not linked yet.
+func planSystems(ctx context.Context, r repository.Repository, t *Tenant, tenantID string, desired []model.SystemIdentifier) ([]PlanStep, error) {
+	query := repository.QueryOf(model.SystemFields.TenantID.Eq(tenantID)).
+		SetLimit(maxManifestSystems + 1)
+
+	var linked []model.System
+	if err := r.List(ctx, &linked, *query); err != nil {
+		return nil, ErrSystemSelect
+	}
+
+	linkedIDs := make([]model.SystemIdentifier, 0, len(linked))
+	for _, s := range linked {
+		linkedIDs = append(linkedIDs, model.SystemIdentifier{ExternalID: s.ExternalID, Type: s.Type})
+	}
+
+	var steps []PlanStep
+	for _, s := range linkedIDs {
+		if slices.Contains(desired, s) {
+			continue
+		}
+
+		steps = append(steps, PlanStep{
+			Action: PlanActionUnlinkSystem,
+			Target: s.Type + "/" + s.ExternalID,
+			run: func(ctx context.Context, r repository.Repository) error {
+				return unmapSystemFromTenant(ctx, t.labels, r, tenantID, s.ExternalID, s.Type)
+			},
+		})
+	}
+
+	for _, s := range desired {
+		if slices.Contains(linkedIDs, s) {
+			continue
+		}
+
+		steps = append(steps, PlanStep{
+			Action: PlanActionLinkSystem,
+			Target: s.Type + "/" + s.ExternalID,
+			run: func(ctx context.Context, r repository.Repository) error {
+				return mapSystemToTenant(ctx, t.validation, t.labels, r, tenantID, s.ExternalID, s.Type)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+// planAuths applies the listed auths which do not exist yet.
+// An existing auth must belong to the tenant and match the manifest.
+func (m *Manifests) planAuths(ctx context.Context, r repository.Repository, tenantID string, desired []ManifestAuth) ([]PlanStep, error) {
+	var steps []PlanStep
+	for _, a := range desired {
+		auth := &model.Auth{ExternalID: a.ExternalID}
+		found, err := r.Find(ctx, auth)
+		if err != nil {
+			return nil, ErrAuthSelect
+		}
+
+		if found {
+			if err := resolveAuthPropertyBlobs(ctx, r, auth); err != nil {
+				return nil, ErrAuthSelect
+			}
+			if auth.TenantID != tenantID || auth.Type != a.Type || !maps.Equal(auth.ToProto().Properties, a.Properties) {
+				return nil, ErrorWithParams(ErrManifestConflict, "authExternalID", a.ExternalID)
+			}
+			continue
+		}
+
+		newAuth := &model.Auth{
+			ExternalID: a.ExternalID,
+			TenantID:   tenantID,
+			Type:       a.Type,
+			Properties: a.Properties,
+			Status:     authgrpc.AuthStatus_AUTH_STATUS_APPLYING.String(),
+		}
+		newAuth.ExtractRequiredUserGroups()
+		newAuth.ExtractServiceAccount()
+
+		steps = append(steps, PlanStep{
+			Action: PlanActionApplyAuth,
+			Target: a.ExternalID,
+			run: func(ctx context.Context, r repository.Repository) error {
+				// the user groups are updated by a preceding step
+				tenant, err := getTenant(ctx, r, tenantID)
+				if err != nil {
+					return err
+				}
+				if err := checkUserGroupsExist(tenant, newAuth.RequiredUserGroups); err != nil {
+					return err
+				}
+				if err := checkServiceAccountActive(ctx, r, tenantID, newAuth.ServiceAccountID); err != nil {
+					return err
+				}
+
+				err = m.auth.createAuth(ctx, r, newAuth)
+				if isUniqueConstraintError(err) {
+					return ErrorAlreadyExists(ResourceTypeAuth, newAuth.ExternalID)
+				}
+				if err != nil {
+					return ErrAuthUpdate
+				}
+
+				return m.auth.prepareJob(ctx, newAuth, authgrpc.AuthAction_AUTH_ACTION_APPLY_AUTH.String(), nil)
+			},
+		})
+	}
+
+	return steps, nil
+}
+
+// validateManifest validates the manifest and returns the tenant it describes.
+func (m *Manifests) validateManifest(ctx context.Context, manifest *Manifest) (*model.Tenant, error) {
+	labels := manifest.Labels
+	if labels == nil {
+		labels = map[string]string{}
+	}
+
+	userGroups := manifest.UserGroups
+	if userGroups == nil {
+		userGroups = []string{}
+	}
+
+	tenant := &model.Tenant{
+		ID:         m.tenant.ids.Normalize(manifest.Tenant.ID),
+		Name:       manifest.Tenant.Name,
+		Region:     manifest.Tenant.Region,
+		OwnerID:    manifest.Tenant.OwnerID,
+		OwnerType:  manifest.Tenant.OwnerType,
+		Role:       manifest.Tenant.Role,
+		Status:     model.TenantStatus(tenantgrpc.Status_STATUS_PROVISIONING.String()),
+		Labels:     labels,
+		UserGroups: userGroups,
+	}
+
+	if err := m.tenant.validateTenant(tenant); err != nil {
+		return nil, err
+	}
+
+	if err := m.tenant.labels.checkLimits(tenant.Labels); err != nil {
+		return nil, err
+	}
+
+	if len(manifest.Systems) > maxManifestSystems {
+		return nil, ErrorWithParams(ErrValidationFailed, "err", "too many systems", "max", maxManifestSystems)
+	}
+
+	if len(uniqueMembers(manifest.Systems)) != len(manifest.Systems) {
+		return nil, ErrorWithParams(ErrValidationFailed, "err", "duplicate systems")
+	}
+
+	if err := m.identifiers.validate(ctx, "systems", manifest.Systems); err != nil {
+		return nil, err
+	}
+
+	for _, a := range manifest.Auths {
+		err := m.auth.validateAuth(&model.Auth{
+			ExternalID: a.ExternalID,
+			TenantID:   tenant.ID,
+			Type:       a.Type,
+			Properties: a.Properties,
+			Status:     authgrpc.AuthStatus_AUTH_STATUS_APPLYING.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return tenant, nil
+}
+
+// immutableFieldChanged returns the first field of the existing tenant which differs from the desired tenant.
+func immutableFieldChanged(existing, desired *model.Tenant) (string, bool) {
+	switch {
+	case existing.Name != desired.Name:
+		return "name", true
+	case existing.Region != desired.Region:
+		return "region", true
+	case existing.OwnerID != desired.OwnerID:
+		return "ownerId", true
+	case existing.OwnerType != desired.OwnerType:
+		return "ownerType", true
+	case existing.Role != desired.Role:
+		return "role", true
+	default:
+		return "", false
+	}
+}
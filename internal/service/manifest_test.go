@@ -0,0 +1,67 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestImmutableFieldChanged(t *testing.T) {
+	existing := model.Tenant{
+		ID:        "tenant-id",
+		Name:      "name",
+		Region:    "region",
+		OwnerID:   "owner-id",
+		OwnerType: "owner-type",
+		Role:      "ROLE_LIVE",
+		Labels:    map[string]string{"key": "value"},
+	}
+
+	tests := []struct {
+		name     string
+		mutate   func(t *model.Tenant)
+		expField string
+	}{
+		{
+			name:   "no change",
+			mutate: func(*model.Tenant) {},
+		},
+		{
+			name:   "mutable field changed",
+			mutate: func(t *model.Tenant) { t.Labels = nil },
+		},
+		{
+			name:     "name changed",
+			mutate:   func(t *model.Tenant) { t.Name = "other" },
+			expField: "name",
+		},
+		{
+			name:     "region changed",
+			mutate:   func(t *model.Tenant) { t.Region = "other" },
+			expField: "region",
+		},
+		{
+			name:     "role changed",
+			mutate:   func(t *model.Tenant) { t.Role = "ROLE_TEST" },
+			expField: "role",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			desired := existing
+			tt.mutate(&desired)
+
+			// when
+			field, changed := service.ImmutableFieldChanged(&existing, &desired)
+
+			// then
+			assert.Equal(t, tt.expField, field)
+			assert.Equal(t, tt.expField != "", changed)
+		})
+	}
+}
@@ -16,17 +16,21 @@ import (
 type Mapping struct {
 	mappinggrpc.UnimplementedServiceServer
 
-	repo       repository.Repository
-	meters     *Meters
-	validation *validation.Validation
+	repo        repository.Repository
+	meters      *Meters
+	validation  *validation.Validation
+	labels      *Labels
+	identifiers *SystemIdentifiers
 }
 
 // NewMapping creates and returns a new instance of Mapping.
-func NewMapping(repo repository.Repository, meters *Meters, validation *validation.Validation) *Mapping {
+func NewMapping(repo repository.Repository, meters *Meters, validation *validation.Validation, labels *Labels, identifiers *SystemIdentifiers) *Mapping {
 	return &Mapping{
-		repo:       repo,
-		meters:     meters,
-		validation: validation,
+		repo:        repo,
+		meters:      meters,
+		validation:  validation,
+		labels:      labels,
+		identifiers: identifiers,
 	}
 }
 
@@ -40,28 +44,11 @@ func (m *Mapping) UnmapSystemFromTenant(ctx context.Context, in *mappinggrpc.Unm
 		return nil, err
 	}
 
-	emptyTenantID := ""
-
 	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
 	defer cancel()
 
 	err := m.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
-		system, err := validateAndGetSystemForUnmap(ctx, r, in)
-		if err != nil {
-			return err
-		}
-
-		system.TenantID = &emptyTenantID
-		ok, err := r.Patch(ctx, system)
-		if err != nil {
-			return ErrSystemUpdate
-		}
-
-		if !ok {
-			return ErrorWithParams(ErrSystemNotFound, "externalID", in.GetExternalId(), "type", in.GetType())
-		}
-
-		return nil
+		return unmapSystemFromTenant(ctx, m.labels, r, in.GetTenantId(), in.GetExternalId(), in.GetType())
 	})
 
 	err = mapError(err)
@@ -76,8 +63,6 @@ func (m *Mapping) UnmapSystemFromTenant(ctx context.Context, in *mappinggrpc.Unm
 // MapSystemToTenant links Systems to the Tenant.
 func (m *Mapping) MapSystemToTenant(ctx context.Context, in *mappinggrpc.MapSystemToTenantRequest) (*mappinggrpc.MapSystemToTenantResponse, error) {
 	ctx = slogctx.With(ctx, "tenantId", in.GetTenantId(), "externalId", in.GetExternalId(), "type", in.GetType())
-
-	tenantID := in.GetTenantId()
 	slogctx.Debug(ctx, "MapSystemToTenant called")
 
 	if err := m.validateMapRequest(in); err != nil {
@@ -89,23 +74,7 @@ func (m *Mapping) MapSystemToTenant(ctx context.Context, in *mappinggrpc.MapSyst
 	defer cancel()
 
 	err := m.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
-		system, found, err := isSystemTenantMapAllowed(ctx, r, in)
-		if err != nil {
-			return err
-		}
-
-		if !found {
-			_, err = createSystem(ctx, m.validation, r, in.GetExternalId(), in.GetType(), tenantID)
-			return err
-		}
-
-		system.TenantID = &tenantID
-		_, err = r.Patch(ctx, system)
-		if err != nil {
-			return ErrSystemUpdate
-		}
-
-		return nil
+		return mapSystemToTenant(ctx, m.validation, m.labels, r, in.GetTenantId(), in.GetExternalId(), in.GetType())
 	})
 
 	err = mapError(err)
@@ -148,12 +117,89 @@ func (m *Mapping) Get(ctx context.Context, in *mappinggrpc.GetRequest) (*mapping
 	}, nil
 }
 
-// validateAndGetSystemForUnmap fetched and returns the system it also validates
-// iIt checks if the tenantID matches and if the tenant is active and it checks for the regional systems validity.
-func validateAndGetSystemForUnmap(ctx context.Context, r repository.Repository, in *mappinggrpc.UnmapSystemFromTenantRequest) (*model.System, error) {
-	tenantID := in.GetTenantId()
+// mapSystemToTenant links the System to the Tenant, the System is created if it does not exist yet.
+// The regional systems of the System inherit the labels of the Tenant.
+// Here repository r is passed as a variable so that it can be called within a transaction.
+func mapSystemToTenant(ctx context.Context, v *validation.Validation, l *Labels, r repository.Repository, tenantID, externalID, systemType string) error {
+	system, found, err := isSystemTenantMapAllowed(ctx, r, tenantID, externalID, systemType)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		_, err = createSystem(ctx, v, r, externalID, systemType, tenantID)
+		return err
+	}
+
+	system.TenantID = &tenantID
+	_, err = r.Patch(ctx, system)
+	if err != nil {
+		return ErrSystemUpdate
+	}
+
+	err = recordSystemLink(ctx, r, system, tenantID)
+	if err != nil {
+		return err
+	}
+
+	return inheritLinkedTenantLabels(ctx, l, r, system, tenantID, true)
+}
+
+// unmapSystemFromTenant unlinks the System from the Tenant.
+// The labels inherited from the Tenant are removed from the regional systems of the System.
+// Here repository r is passed as a variable so that it can be called within a transaction.
+func unmapSystemFromTenant(ctx context.Context, l *Labels, r repository.Repository, tenantID, externalID, systemType string) error {
+	system, err := validateAndGetSystemForUnmap(ctx, r, tenantID, externalID, systemType)
+	if err != nil {
+		return err
+	}
 
-	system, found, err := getSystem(ctx, r, in.GetExternalId(), in.GetType())
+	// the tenant ID is cleared to NULL, as the foreign key on the tenants rejects an empty tenant ID
+	var unlinked []model.System
+	count, err := r.ClearAll(ctx, &unlinked, *repository.QueryOf(model.SystemFields.ID.Eq(system.ID)),
+		model.SystemFields.TenantID.Column())
+	if err != nil {
+		return ErrSystemUpdate
+	}
+
+	if count == 0 {
+		return ErrorWithParams(ErrSystemNotFound, "externalID", externalID, "type", systemType)
+	}
+
+	system.TenantID = nil
+
+	err = recordSystemUnlink(ctx, r, system)
+	if err != nil {
+		return err
+	}
+
+	return inheritLinkedTenantLabels(ctx, l, r, system, tenantID, false)
+}
+
+// inheritLinkedTenantLabels adds the inherited labels of the tenant to the regional systems of the linked system,
+// or removes them from the regional systems of the unlinked system.
+func inheritLinkedTenantLabels(ctx context.Context, l *Labels, r repository.Repository, system *model.System, tenantID string, linked bool) error {
+	if !l.inherits() {
+		return nil
+	}
+
+	tenant, err := getTenant(ctx, r, tenantID, repository.LockForShare)
+	if err != nil {
+		return err
+	}
+
+	if linked {
+		return l.inheritSystemLabels(ctx, r, system.ID.String(), nil, tenant.Labels)
+	}
+
+	return l.inheritSystemLabels(ctx, r, system.ID.String(), tenant.Labels, nil)
+}
+
+// validateAndGetSystemForUnmap fetches and returns the system it also validates.
+// It checks if the tenantID matches and if the tenant is active and it checks for the regional systems validity.
+// It only reads, so it is also used to simulate unlinks.
+func validateAndGetSystemForUnmap(ctx context.Context, r repository.Repository, tenantID, externalID, systemType string) (*model.System, error) {
+	system, found, err := getSystem(ctx, r, externalID, systemType)
 	if err != nil {
 		return nil, ErrSystemSelect
 	}
@@ -169,7 +215,8 @@ func validateAndGetSystemForUnmap(ctx context.Context, r repository.Repository,
 		return nil, ErrorWithParams(ErrSystemIsNotLinkedToTenant, "externalID", system.ExternalID, "type", system.Type)
 	}
 
-	tenant, err := getTenant(ctx, r, *system.TenantID)
+	// The tenant is only checked, so concurrent unlinks from the same tenant do not block each other.
+	tenant, err := getTenant(ctx, r, *system.TenantID, repository.LockForShare)
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +227,6 @@ func validateAndGetSystemForUnmap(ctx context.Context, r repository.Repository,
 	}
 
 	if err := validateRegionalSystemsForUnmap(ctx, r, system); err != nil {
-		slogctx.Warn(ctx, "validation failed for UnmapSystemFromTenant request", "error", err)
 		return nil, err
 	}
 
@@ -199,7 +245,7 @@ func validateRegionalSystemsForUnmap(ctx context.Context, r repository.Repositor
 		}
 
 		if s.HasActiveL1KeyClaim() {
-			return ErrorWithParams(ErrSystemHasL1KeyClaim, "externalID", system.ExternalID, "type", system.Type, "region", s.Region)
+			return l1KeyClaimError(system, &s)
 		}
 	}
 
@@ -208,8 +254,12 @@ func validateRegionalSystemsForUnmap(ctx context.Context, r repository.Repositor
 
 // isSystemTenantMapAllowed checks whether all conditions are met to map the Tenant.
 // It returns nil if the provided Tenant exist, the System is found and no linked, and HasL1KeyClaim is false.
-func isSystemTenantMapAllowed(ctx context.Context, r repository.Repository, in *mappinggrpc.MapSystemToTenantRequest) (*model.System, bool, error) {
-	tenant, err := getTenant(ctx, r, in.GetTenantId())
+// It only reads, so it is also used to simulate links.
+func isSystemTenantMapAllowed(ctx context.Context, r repository.Repository, tenantID, externalID, systemType string) (*model.System, bool, error) {
+	// The tenant is only checked, so concurrent links to the same tenant do not block each other.
+	// The system and its regional systems are locked for update, so that a concurrent
+	// UpdateSystemL1KeyClaim can not interleave between the checks and the patch.
+	tenant, err := getTenant(ctx, r, tenantID, repository.LockForShare)
 	if err != nil {
 		return nil, false, err
 	}
@@ -219,7 +269,7 @@ func isSystemTenantMapAllowed(ctx context.Context, r repository.Repository, in *
 		return nil, false, err
 	}
 
-	system, found, err := getSystem(ctx, r, in.GetExternalId(), in.GetType())
+	system, found, err := getSystem(ctx, r, externalID, systemType)
 	if err != nil {
 		return nil, false, err
 	}
@@ -233,6 +283,11 @@ func isSystemTenantMapAllowed(ctx context.Context, r repository.Repository, in *
 		return system, found, ErrorWithParams(ErrSystemIsLinkedToTenant, "externalID", system.ExternalID, "type", system.Type)
 	}
 
+	if !system.CanLinkToTenantRole(tenant.Role) {
+		return system, found, ErrorWithParams(ErrSystemNotLinkableToTenant, "externalID", system.ExternalID, "type", system.Type,
+			"environment", system.Environment, "role", tenant.Role)
+	}
+
 	if err := validateRegionalSystemsForLink(ctx, r, system); err != nil {
 		return system, found, err
 	}
@@ -248,23 +303,19 @@ func validateRegionalSystemsForLink(ctx context.Context, r repository.Repository
 	}
 
 	for _, s := range regionalSystems {
-		err := checkRegionalSystemAvailable(&s)
-		if err != nil {
-			slogctx.Warn(ctx, "validation failed for MapSystemToTenant request", "error", err)
+		if err := checkRegionalSystemAvailable(&s); err != nil {
 			return err
 		}
 
 		if s.HasL1KeyClaim != nil && *s.HasL1KeyClaim {
-			err = ErrorWithParams(ErrSystemHasL1KeyClaim, "externalID", system.ExternalID, "type", system.Type, "region", s.Region)
-			slogctx.Warn(ctx, "validation failed for MapSystemToTenant request", "error", err)
-			return err
+			return l1KeyClaimError(system, &s)
 		}
 	}
 
 	return nil
 }
 
-// validateAndGetSystems validates the input slice of SystemId and returns a slice of model.System having only unique systems.
+// validateUnmapRequest validates the tenant ID, external ID and type of the request.
 func (m *Mapping) validateUnmapRequest(in *mappinggrpc.UnmapSystemFromTenantRequest) error {
 	if in == nil || len(in.GetTenantId()) == 0 {
 		return ErrNoTenantID
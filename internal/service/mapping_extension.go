@@ -0,0 +1,77 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/status"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/model"
+)
+
+// MappingExtension implements the procedure calls on the links of systems defined in api/extension/v1/extension.proto,
+// which are not defined by api-sdk yet.
+type MappingExtension struct {
+	extensiongrpc.UnimplementedMappingServiceServer
+
+	mapping *Mapping
+}
+
+// NewMappingExtension creates and returns a new instance of MappingExtension.
+func NewMappingExtension(mapping *Mapping) *MappingExtension {
+	return &MappingExtension{
+		mapping: mapping,
+	}
+}
+
+// SimulateLink predicts the outcome of linking each of the systems to the tenant without linking.
+func (m *MappingExtension) SimulateLink(ctx context.Context, in *extensiongrpc.SimulateLinkRequest) (*extensiongrpc.SimulateLinkResponse, error) {
+	outcomes, err := m.mapping.SimulateLink(ctx, in.GetTenantId(), systemIdentifiersFromExtensionProto(in.GetSystems()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.SimulateLinkResponse{Outcomes: linkOutcomesToProto(outcomes)}, nil
+}
+
+// SimulateUnlink predicts the outcome of unlinking each of the systems from the tenant without unlinking.
+func (m *MappingExtension) SimulateUnlink(ctx context.Context, in *extensiongrpc.SimulateUnlinkRequest) (*extensiongrpc.SimulateUnlinkResponse, error) {
+	outcomes, err := m.mapping.SimulateUnlink(ctx, in.GetTenantId(), systemIdentifiersFromExtensionProto(in.GetSystems()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.SimulateUnlinkResponse{Outcomes: linkOutcomesToProto(outcomes)}, nil
+}
+
+func systemIdentifiersFromExtensionProto(systems []*extensiongrpc.SystemIdentifier) []model.SystemIdentifier {
+	identifiers := make([]model.SystemIdentifier, 0, len(systems))
+	for _, system := range systems {
+		identifiers = append(identifiers, model.SystemIdentifier{
+			ExternalID: system.GetExternalId(),
+			Type:       system.GetType(),
+		})
+	}
+
+	return identifiers
+}
+
+func linkOutcomesToProto(outcomes []LinkOutcome) []*extensiongrpc.LinkOutcome {
+	resp := make([]*extensiongrpc.LinkOutcome, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		pbOutcome := &extensiongrpc.LinkOutcome{
+			ExternalId: outcome.ExternalID,
+			Type:       outcome.Type,
+			Succeeds:   outcome.Succeeds(),
+		}
+		if !outcome.Succeeds() {
+			st := status.Convert(outcome.Err)
+			pbOutcome.Code = st.Code().String()
+			pbOutcome.Message = st.Message()
+		}
+
+		resp = append(resp, pbOutcome)
+	}
+
+	return resp
+}
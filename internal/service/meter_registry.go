@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/openkcm/common-sdk/pkg/otlp"
+	"github.com/samber/oops"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var ErrGaugeRegistered = errors.New("gauge is already registered")
+
+// MeterRegistry creates the instruments of the registry on first use and caches them by name,
+// so subsystems can request their instruments without them being set up upfront.
+// It is safe for concurrent use.
+type MeterRegistry struct {
+	application *commoncfg.Application
+	provider    metric.MeterProvider
+
+	once  sync.Once
+	meter metric.Meter
+
+	mu         sync.Mutex
+	counters   map[string]metric.Int64Counter
+	histograms map[string]metric.Float64Histogram
+	gauges     map[string]struct{}
+}
+
+// NewMeterRegistry creates and returns a new instance of MeterRegistry creating its instruments by the provider.
+func NewMeterRegistry(cfgApp *commoncfg.Application, provider metric.MeterProvider) *MeterRegistry {
+	return &MeterRegistry{
+		application: cfgApp,
+		provider:    provider,
+		counters:    make(map[string]metric.Int64Counter),
+		histograms:  make(map[string]metric.Float64Histogram),
+		gauges:      make(map[string]struct{}),
+	}
+}
+
+// Meter returns the meter of the application, which is created on first use.
+func (r *MeterRegistry) Meter() metric.Meter {
+	r.once.Do(func() {
+		r.meter = r.provider.Meter(
+			r.application.Name,
+			metric.WithInstrumentationVersion(otel.Version()),
+			metric.WithInstrumentationAttributes(otlp.CreateAttributesFrom(*r.application)...),
+		)
+	})
+
+	return r.meter
+}
+
+// Application returns the application the instruments are created for.
+func (r *MeterRegistry) Application() *commoncfg.Application {
+	return r.application
+}
+
+// Counter returns the counter with the given name, creating it on first use.
+func (r *MeterRegistry) Counter(ctx context.Context, name, description string) (metric.Int64Counter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if ctr, ok := r.counters[name]; ok {
+		return ctr, nil
+	}
+
+	ctr, err := createCounter(ctx, r.Meter(), name, description)
+	if err != nil {
+		return nil, err
+	}
+
+	r.counters[name] = ctr
+
+	return ctr, nil
+}
+
+// Histogram returns the histogram of durations in seconds with the given name, creating it on first use.
+func (r *MeterRegistry) Histogram(ctx context.Context, name, description string) (metric.Float64Histogram, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if hist, ok := r.histograms[name]; ok {
+		return hist, nil
+	}
+
+	hist, err := createHistogram(ctx, r.Meter(), name, description)
+	if err != nil {
+		return nil, err
+	}
+
+	r.histograms[name] = hist
+
+	return hist, nil
+}
+
+// ObservableGauge registers the gauge with the given name, which is observed by the callback.
+// A gauge can only be registered once, as a second callback would report its values twice.
+func (r *MeterRegistry) ObservableGauge(ctx context.Context, name, description string, callback metric.Int64Callback) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.gauges[name]; ok {
+		return oops.In(ErrDomainMetrics).
+			WithContext(ctx).
+			Wrapf(ErrGaugeRegistered, "creating %s meter", name)
+	}
+
+	if err := createObservableGauge(ctx, r.Meter(), name, description, callback); err != nil {
+		return err
+	}
+
+	r.gauges[name] = struct{}{}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/openkcm/common-sdk/pkg/commoncfg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestMeterRegistry(t *testing.T) {
+	newRegistry := func() (*service.MeterRegistry, *sdkmetric.ManualReader) {
+		reader := sdkmetric.NewManualReader()
+		provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+		return service.NewMeterRegistry(&commoncfg.Application{Name: "test"}, provider), reader
+	}
+
+	t.Run("should create a counter once when requested concurrently", func(t *testing.T) {
+		// given
+		subj, reader := newRegistry()
+		ctx := t.Context()
+
+		// when
+		var wg sync.WaitGroup
+		for range 10 {
+			wg.Go(func() {
+				ctr, err := subj.Counter(ctx, "test.counter", "Counter of tests")
+				assert.NoError(t, err)
+				ctr.Add(ctx, 1)
+			})
+		}
+		wg.Wait()
+
+		// then
+		var rm metricdata.ResourceMetrics
+		require.NoError(t, reader.Collect(ctx, &rm))
+		require.Len(t, rm.ScopeMetrics, 1)
+		require.Len(t, rm.ScopeMetrics[0].Metrics, 1)
+
+		sum, ok := rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Sum[int64])
+		require.True(t, ok)
+		require.Len(t, sum.DataPoints, 1)
+		assert.Equal(t, int64(10), sum.DataPoints[0].Value)
+	})
+
+	t.Run("should return the same histogram by name", func(t *testing.T) {
+		// given
+		subj, _ := newRegistry()
+
+		// when
+		first, err := subj.Histogram(t.Context(), "test.duration", "Histogram of tests")
+		require.NoError(t, err)
+		second, err := subj.Histogram(t.Context(), "test.duration", "Histogram of tests")
+		require.NoError(t, err)
+
+		// then
+		assert.Same(t, first, second)
+	})
+
+	t.Run("should register a gauge only once", func(t *testing.T) {
+		// given
+		subj, _ := newRegistry()
+		callback := func(_ context.Context, observer metric.Int64Observer) error {
+			observer.Observe(1)
+			return nil
+		}
+
+		// when
+		err := subj.ObservableGauge(t.Context(), "test.gauge", "Gauge of tests", callback)
+		require.NoError(t, err)
+		err = subj.ObservableGauge(t.Context(), "test.gauge", "Gauge of tests", callback)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrGaugeRegistered)
+	})
+}
@@ -2,71 +2,87 @@ package service
 
 import (
 	"context"
+	"database/sql"
+	"time"
 
-	"github.com/openkcm/common-sdk/pkg/commoncfg"
 	"github.com/openkcm/common-sdk/pkg/otlp"
 	"github.com/samber/oops"
-	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 	"gorm.io/gorm"
 
+	slogctx "github.com/veqryn/slog-context"
+
 	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
 )
 
 const (
 	AttrRegion       = "region"
 	AttrTenantLinked = "tenant_linked"
 	AttrStatus       = "status"
+	AttrRPCMethod    = "rpc_method"
+	AttrField        = "field"
+	AttrExpiry       = "expiry"
+	AttrSizeBucket   = "size_bucket"
+	AttrPool         = "pool"
+	AttrConnState    = "state"
+	AttrCaller       = "caller"
+	AttrOperation    = "operation"
+	AttrOutcome      = "outcome"
+	AttrErrorClass   = "error_class"
+	AttrTenantID     = "tenant_id"
+	AttrTable        = "table"
 	ErrDomainMetrics = "metrics"
 )
 
-func InitMeters(ctx context.Context, cfgApp *commoncfg.Application, db *gorm.DB) (*Meters, error) {
-	meter := otel.Meter(
-		cfgApp.Name,
-		metric.WithInstrumentationVersion(otel.Version()),
-		metric.WithInstrumentationAttributes(otlp.CreateAttributesFrom(*cfgApp)...),
-	)
+// unknownCaller is the caller attribute of requests without caller identity.
+const unknownCaller = "unknown"
 
-	var err error
+// instrument is the name and description of a counter or histogram of Meters.
+type instrument struct {
+	name        string
+	description string
+}
 
-	systemRegistrationCtr, err := createCounter(ctx, meter, "systems.registered", "Counter of system registrations, partitioned by region")
-	if err != nil {
-		return nil, err
-	}
+var (
+	systemRegistrationCtr = instrument{"systems.registered", "Counter of system registrations, partitioned by region"}
+	systemDeletionCtr     = instrument{"systems.deleted", "Counter of system deletions, partitioned by region"}
+	tenantRegistrationCtr = instrument{"tenants.registered", "Counter of tenant registrations, partitioned by region"}
+	slowOperationCtr      = instrument{"repository.slow_operations", "Counter of slow repository operations, partitioned by gRPC method"}
+	transactionRetryCtr   = instrument{"repository.transaction_retries", "Counter of retried transactions, partitioned by gRPC method"}
+	legacyRequestCtr      = instrument{"requests.legacy", "Counter of requests using a deprecated request shape, partitioned by method, field and caller"}
+	deprecatedFieldCtr    = instrument{"requests.deprecated_fields", "Counter of requests setting a field marked as deprecated in the protobufs, partitioned by method, field and caller"}
+	listSystemsDuration   = instrument{"systems.list.duration",
+		"Histogram of the ListSystems latency in seconds, partitioned by the bucket of the number of listed systems"}
+	transactionDuration = instrument{"repository.transaction.duration",
+		"Histogram of the duration of transactions in seconds including retries, partitioned by operation and outcome"}
+	tableMaintenanceCtr = instrument{"db.maintenance.operations",
+		"Counter of the maintenance operations of tables, partitioned by table, operation and outcome"}
+)
 
-	systemDeletionCtr, err := createCounter(ctx, meter, "systems.deleted", "Counter of system deletions, partitioned by region")
-	if err != nil {
-		return nil, err
+// NewMeters creates and returns a new instance of Meters, whose instruments are created by the registry on first use.
+func NewMeters(registry *MeterRegistry) *Meters {
+	return &Meters{
+		registry: registry,
 	}
+}
 
-	err = createObservableGauge(ctx, meter, "systems.count", "Gauge of systems, partitioned by region and tenant link status",
+// RegisterDBMeters registers the gauges measured by querying the database on every collection.
+func RegisterDBMeters(ctx context.Context, registry *MeterRegistry, repo repository.Repository) error {
+	err := registry.ObservableGauge(ctx, "tenants.count", "Gauge of tenants, partitioned by status and region",
 		func(ctx context.Context, observer metric.Int64Observer) error {
-			return measureSystems(ctx, observer, db)
+			return measureTenants(ctx, observer, repo)
 		})
 	if err != nil {
-		return nil, err
-	}
-
-	tenantRegistrationCtr, err := createCounter(ctx, meter, "tenants.registered", "Counter of tenant registrations, partitioned by region")
-	if err != nil {
-		return nil, err
+		return err
 	}
 
-	err = createObservableGauge(ctx, meter, "tenants.count", "Gauge of tenants, partitioned by status and region",
+	return registry.ObservableGauge(ctx, "system_credentials.expiring",
+		"Gauge of active system credentials expiring within 30 days, partitioned by region and expiry window",
 		func(ctx context.Context, observer metric.Int64Observer) error {
-			return measureTenants(ctx, observer, db)
+			return measureExpiringCredentials(ctx, observer, repo)
 		})
-	if err != nil {
-		return nil, err
-	}
-
-	return &Meters{
-		application:           cfgApp,
-		systemRegistrationCtr: systemRegistrationCtr,
-		tenantRegistrationCtr: tenantRegistrationCtr,
-		systemDeletionCtr:     systemDeletionCtr,
-	}, nil
 }
 
 func createCounter(ctx context.Context, meter metric.Meter, name string, description string) (metric.Int64Counter, error) {
@@ -83,6 +99,85 @@ func createCounter(ctx context.Context, meter metric.Meter, name string, descrip
 	return ctr, nil
 }
 
+func createHistogram(ctx context.Context, meter metric.Meter, name string, description string) (metric.Float64Histogram, error) {
+	hist, err := meter.Float64Histogram(
+		name,
+		metric.WithDescription(description),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, oops.In(ErrDomainMetrics).
+			WithContext(ctx).
+			Wrapf(err, "creating %s meter", name)
+	}
+
+	return hist, nil
+}
+
+// RegisterPoolMeters registers the gauges of the database connection pools, partitioned by pool.
+func RegisterPoolMeters(ctx context.Context, registry *MeterRegistry, pools map[repository.Pool]*gorm.DB) error {
+	err := registry.ObservableGauge(ctx, "db.pool.connections", "Gauge of database connections, partitioned by pool and state",
+		func(_ context.Context, observer metric.Int64Observer) error {
+			return measurePools(pools, func(pool repository.Pool, stats sql.DBStats) {
+				observer.Observe(int64(stats.InUse), metric.WithAttributes(attribute.String(AttrPool, string(pool)), attribute.String(AttrConnState, "in_use")))
+				observer.Observe(int64(stats.Idle), metric.WithAttributes(attribute.String(AttrPool, string(pool)), attribute.String(AttrConnState, "idle")))
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	err = registry.ObservableGauge(ctx, "db.pool.connections.max", "Gauge of the maximum database connections, partitioned by pool",
+		func(_ context.Context, observer metric.Int64Observer) error {
+			return measurePools(pools, func(pool repository.Pool, stats sql.DBStats) {
+				observer.Observe(int64(stats.MaxOpenConnections), metric.WithAttributes(attribute.String(AttrPool, string(pool))))
+			})
+		})
+	if err != nil {
+		return err
+	}
+
+	return registry.ObservableGauge(ctx, "db.pool.wait.count", "Gauge of the total waits for a database connection, partitioned by pool",
+		func(_ context.Context, observer metric.Int64Observer) error {
+			return measurePools(pools, func(pool repository.Pool, stats sql.DBStats) {
+				observer.Observe(stats.WaitCount, metric.WithAttributes(attribute.String(AttrPool, string(pool))))
+			})
+		})
+}
+
+// RegisterTransactionMeters registers the gauge of the open transactions, as counted by open.
+func RegisterTransactionMeters(ctx context.Context, registry *MeterRegistry, open func() int64) error {
+	return registry.ObservableGauge(ctx, "repository.transactions.open", "Gauge of the transactions in progress",
+		func(_ context.Context, observer metric.Int64Observer) error {
+			observer.Observe(open())
+			return nil
+		})
+}
+
+// RegisterCircuitBreakerMeters registers the gauge of the state of the database circuit breaker, as returned by state:
+// 0 if the circuit is closed, 1 if it is half-open and 2 if it is open.
+func RegisterCircuitBreakerMeters(ctx context.Context, registry *MeterRegistry, state func() int64) error {
+	return registry.ObservableGauge(ctx, "repository.circuit_breaker.state", "Gauge of the state of the database circuit breaker",
+		func(_ context.Context, observer metric.Int64Observer) error {
+			observer.Observe(state())
+			return nil
+		})
+}
+
+// measurePools passes the statistics of every pool to observe.
+func measurePools(pools map[repository.Pool]*gorm.DB, observe func(repository.Pool, sql.DBStats)) error {
+	for pool, db := range pools {
+		sqlDB, err := db.DB()
+		if err != nil {
+			return err
+		}
+
+		observe(pool, sqlDB.Stats())
+	}
+
+	return nil
+}
+
 func createObservableGauge(ctx context.Context, meter metric.Meter, name string, description string, callback metric.Int64Callback) error {
 	_, err := meter.Int64ObservableGauge(
 		name,
@@ -98,18 +193,14 @@ func createObservableGauge(ctx context.Context, meter metric.Meter, name string,
 	return nil
 }
 
-func measureTenants(ctx context.Context, observer metric.Int64Observer, db *gorm.DB) error {
+func measureTenants(ctx context.Context, observer metric.Int64Observer, repo repository.Repository) error {
 	var tenantStatus []struct {
 		Status string
 		Region string
 		Count  int64
 	}
 
-	err := db.WithContext(ctx).
-		Model(&model.Tenant{}).
-		Select("status, region, count(*) as count").
-		Group("status, region").
-		Scan(&tenantStatus).Error
+	err := repo.Aggregate(ctx, &tenantStatus, *repository.NewQuery(&model.Tenant{}), repository.StatusField, repository.RegionField)
 	if err != nil {
 		return err
 	}
@@ -123,51 +214,232 @@ func measureTenants(ctx context.Context, observer metric.Int64Observer, db *gorm
 	return nil
 }
 
-func measureSystems(ctx context.Context, observer metric.Int64Observer, db *gorm.DB) error {
-	var systemLinkStatus []struct {
-		Linked string
-		Count  int64
-	}
+// notAfterField is the end of the validity window of a system credential.
+const notAfterField repository.QueryField = "not_after"
 
-	err := db.WithContext(ctx).
-		Model(&model.System{}).
-		Select("count(*) as count, case when tenant_id IS NULL OR tenant_id = '' then 'false' else 'true' end as linked").
-		Group("case when tenant_id IS NULL OR tenant_id = '' then 'false' else 'true' end").
-		Scan(&systemLinkStatus).Error
-	if err != nil {
-		return err
+// measureExpiringCredentials observes the active credentials which are expired or expire within 7 or 30 days.
+func measureExpiringCredentials(ctx context.Context, observer metric.Int64Observer, repo repository.Repository) error {
+	now := time.Now()
+	windows := []struct {
+		expiry   string
+		notAfter repository.Range
+	}{
+		{expiry: "expired", notAfter: repository.Range{To: now}},
+		{expiry: "7d", notAfter: repository.Range{From: now.Add(time.Microsecond), To: now.AddDate(0, 0, 7)}},
+		{expiry: "30d", notAfter: repository.Range{From: now.AddDate(0, 0, 7).Add(time.Microsecond), To: now.AddDate(0, 0, 30)}},
 	}
 
-	for _, status := range systemLinkStatus {
-		observer.Observe(status.Count, metric.WithAttributes(
-			attribute.String(AttrTenantLinked, status.Linked)))
+	for _, window := range windows {
+		var credentialExpiry []struct {
+			Region string
+			Count  int64
+		}
+
+		query := repository.NewQuery(&model.SystemCredential{}).Where(repository.NewCompositeKey().
+			Where(repository.StatusField, model.SystemCredentialStatusActive).
+			Where(notAfterField, window.notAfter))
+
+		err := repo.Aggregate(ctx, &credentialExpiry, *query, repository.RegionField)
+		if err != nil {
+			return err
+		}
+
+		for _, credential := range credentialExpiry {
+			observer.Observe(credential.Count, metric.WithAttributes(
+				attribute.String(AttrRegion, credential.Region),
+				attribute.String(AttrExpiry, window.expiry)))
+		}
 	}
 
 	return nil
 }
 
+// Meters records the counters and histograms of the services.
 type Meters struct {
-	application           *commoncfg.Application
-	systemRegistrationCtr metric.Int64Counter
-	tenantRegistrationCtr metric.Int64Counter
-	systemDeletionCtr     metric.Int64Counter
+	registry *MeterRegistry
 }
 
 func (m *Meters) handleSystemRegistration(ctx context.Context, region string) {
-	m.handleCtrInc(ctx, m.systemRegistrationCtr, region)
+	m.handleCtrInc(ctx, systemRegistrationCtr, region)
 }
 
 func (m *Meters) handleSystemDeletion(ctx context.Context, region string) {
-	m.handleCtrInc(ctx, m.systemDeletionCtr, region)
+	m.handleCtrInc(ctx, systemDeletionCtr, region)
 }
 
 func (m *Meters) handleTenantRegistration(ctx context.Context, region string) {
-	m.handleCtrInc(ctx, m.tenantRegistrationCtr, region)
+	m.handleCtrInc(ctx, tenantRegistrationCtr, region)
+}
+
+// HandleSlowOperation counts a slow repository operation caused by the given gRPC method.
+func (m *Meters) HandleSlowOperation(ctx context.Context, method string) {
+	ctr, ok := m.counter(ctx, slowOperationCtr)
+	if !ok {
+		return
+	}
+
+	attrs := metric.WithAttributes(
+		otlp.CreateAttributesFrom(*m.registry.Application(),
+			attribute.String(AttrRPCMethod, method),
+		)...,
+	)
+
+	ctr.Add(ctx, 1, attrs)
 }
 
-func (m *Meters) handleCtrInc(ctx context.Context, ctr metric.Int64Counter, region string) {
+// HandleTransactionRetry counts a retry of a transaction caused by the given gRPC method.
+func (m *Meters) HandleTransactionRetry(ctx context.Context, method string) {
+	ctr, ok := m.counter(ctx, transactionRetryCtr)
+	if !ok {
+		return
+	}
+
+	attrs := metric.WithAttributes(
+		otlp.CreateAttributesFrom(*m.registry.Application(),
+			attribute.String(AttrRPCMethod, method),
+		)...,
+	)
+
+	ctr.Add(ctx, 1, attrs)
+}
+
+func (m *Meters) handleLegacyRequest(ctx context.Context, method, field string) {
+	ctr, ok := m.counter(ctx, legacyRequestCtr)
+	if !ok {
+		return
+	}
+
+	attrs := metric.WithAttributes(
+		otlp.CreateAttributesFrom(*m.registry.Application(),
+			attribute.String(AttrRPCMethod, method),
+			attribute.String(AttrField, field),
+			attribute.String(AttrCaller, callerAttr(ctx)),
+		)...,
+	)
+
+	ctr.Add(ctx, 1, attrs)
+}
+
+// HandleDeprecatedField counts a request of the method setting the deprecated field, e.g. system.external_id.
+func (m *Meters) HandleDeprecatedField(ctx context.Context, method, field string) {
+	ctr, ok := m.counter(ctx, deprecatedFieldCtr)
+	if !ok {
+		return
+	}
+
+	attrs := metric.WithAttributes(
+		otlp.CreateAttributesFrom(*m.registry.Application(),
+			attribute.String(AttrRPCMethod, method),
+			attribute.String(AttrField, field),
+			attribute.String(AttrCaller, callerAttr(ctx)),
+		)...,
+	)
+
+	ctr.Add(ctx, 1, attrs)
+}
+
+// HandleTransaction records the duration of a transaction of the operation, e.g. register_tenant,
+// which was committed if err is nil and rolled back otherwise.
+func (m *Meters) HandleTransaction(ctx context.Context, operation string, elapsed time.Duration, err error) {
+	hist, histErr := m.registry.Histogram(ctx, transactionDuration.name, transactionDuration.description)
+	if histErr != nil {
+		slogctx.Warn(ctx, "failed to create meter", "meter", transactionDuration.name, "error", histErr)
+		return
+	}
+
+	outcome := "committed"
+	if err != nil {
+		outcome = "rolled_back"
+	}
+
+	attrs := metric.WithAttributes(
+		otlp.CreateAttributesFrom(*m.registry.Application(),
+			attribute.String(AttrOperation, operation),
+			attribute.String(AttrOutcome, outcome),
+		)...,
+	)
+
+	hist.Record(ctx, elapsed.Seconds(), attrs)
+}
+
+// handleTableMaintenance counts a maintenance operation of the table with its outcome: done, skipped or failed.
+func (m *Meters) handleTableMaintenance(ctx context.Context, table string, operation repository.TableOperation, outcome string) {
+	ctr, ok := m.counter(ctx, tableMaintenanceCtr)
+	if !ok {
+		return
+	}
+
+	attrs := metric.WithAttributes(
+		otlp.CreateAttributesFrom(*m.registry.Application(),
+			attribute.String(AttrTable, table),
+			attribute.String(AttrOperation, string(operation)),
+			attribute.String(AttrOutcome, outcome),
+		)...,
+	)
+
+	ctr.Add(ctx, 1, attrs)
+}
+
+func (m *Meters) handleListSystems(ctx context.Context, size int, elapsed time.Duration) {
+	hist, err := m.registry.Histogram(ctx, listSystemsDuration.name, listSystemsDuration.description)
+	if err != nil {
+		slogctx.Warn(ctx, "failed to create meter", "meter", listSystemsDuration.name, "error", err)
+		return
+	}
+
+	attrs := metric.WithAttributes(
+		otlp.CreateAttributesFrom(*m.registry.Application(),
+			attribute.String(AttrSizeBucket, sizeBucket(size)),
+		)...,
+	)
+
+	hist.Record(ctx, elapsed.Seconds(), attrs)
+}
+
+// counter returns the counter of the instrument. The counter is not recorded if it can not be created.
+func (m *Meters) counter(ctx context.Context, i instrument) (metric.Int64Counter, bool) {
+	ctr, err := m.registry.Counter(ctx, i.name, i.description)
+	if err != nil {
+		slogctx.Warn(ctx, "failed to create meter", "meter", i.name, "error", err)
+		return nil, false
+	}
+
+	return ctr, true
+}
+
+// callerAttr returns the identity of the calling client, see repository.CallerFromContext.
+func callerAttr(ctx context.Context) string {
+	if caller := repository.CallerFromContext(ctx); caller != "" {
+		return caller
+	}
+
+	return unknownCaller
+}
+
+// sizeBucket returns the bucket of a list size, so the cardinality of the size attribute is bounded.
+func sizeBucket(size int) string {
+	switch {
+	case size == 0:
+		return "0"
+	case size <= 10:
+		return "1-10"
+	case size <= 100:
+		return "11-100"
+	case size <= 1000:
+		return "101-1000"
+	default:
+		return "1000+"
+	}
+}
+
+func (m *Meters) handleCtrInc(ctx context.Context, i instrument, region string) {
+	ctr, ok := m.counter(ctx, i)
+	if !ok {
+		return
+	}
+
 	attrs := metric.WithAttributes(
-		otlp.CreateAttributesFrom(*m.application,
+		otlp.CreateAttributesFrom(*m.registry.Application(),
 			attribute.String(AttrRegion, region),
 		)...,
 	)
@@ -0,0 +1,250 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/openkcm/orbital"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+const (
+	// operationPollInterval is the interval in which WaitOperation checks the status of the operation.
+	operationPollInterval = 500 * time.Millisecond
+	// maxOperationWait is the longest time WaitOperation waits for an operation to be done.
+	maxOperationWait = time.Minute
+)
+
+// jobIDField is the field of the job delays holding the ID of the delayed job.
+const jobIDField repository.QueryField = "job_id"
+
+// Operation is the state of an asynchronous procedure call, e.g. blocking a tenant.
+// It is backed by the orbital job doing the work, so the ID of the operation is the ID of the job.
+type Operation struct {
+	ID           string     `json:"id"`
+	ExternalID   string     `json:"externalId"`
+	Type         string     `json:"type"`
+	Status       string     `json:"status"`
+	Done         bool       `json:"done"`
+	ErrorMessage string     `json:"errorMessage,omitempty"`
+	NotBefore    *time.Time `json:"notBefore,omitempty"` // set by ListUnfinishedOperations if the job is delayed
+	UpdatedAt    time.Time  `json:"updatedAt"`
+	CreatedAt    time.Time  `json:"createdAt"`
+}
+
+// Succeeded reports whether the operation is done without error.
+func (o *Operation) Succeeded() bool {
+	return o.Status == string(orbital.JobStatusDone)
+}
+
+// Operations lets clients look up and wait for the operations started by asynchronous procedure calls,
+// following the google.longrunning pattern.
+// The procedure calls are served on the extension operation service, see OperationExtension.
+type Operations struct {
+	repo repository.Repository
+}
+
+// NewOperations creates and returns a new instance of Operations.
+func NewOperations(repo repository.Repository) *Operations {
+	return &Operations{
+		repo: repo,
+	}
+}
+
+// GetOperation returns the latest state of the operation.
+func (o *Operations) GetOperation(ctx context.Context, id string) (*Operation, error) {
+	ctx = slogctx.With(ctx, "operationId", id)
+	slogctx.Debug(ctx, "GetOperation called")
+
+	jobID, err := uuid.FromString(id)
+	if err != nil {
+		return nil, ErrOperationIDInvalid
+	}
+
+	operations, err := o.list(ctx, repository.NewCompositeKey().Where(repository.IDField, jobID.String()))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(operations) == 0 {
+		return nil, ErrOperationNotFound
+	}
+
+	return &operations[0], nil
+}
+
+// ListOperations returns the operations of the resource with the given external ID,
+// e.g. the ID of a tenant, newest first.
+func (o *Operations) ListOperations(ctx context.Context, externalID string) ([]Operation, error) {
+	ctx = slogctx.With(ctx, "externalId", externalID)
+	slogctx.Debug(ctx, "ListOperations called")
+
+	if externalID == "" {
+		return nil, ErrExternalIDIsEmpty
+	}
+
+	operations, err := o.list(ctx, repository.NewCompositeKey().Where(repository.ExternalIDField, externalID))
+	if err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(operations)
+
+	return operations, nil
+}
+
+// WaitOperation waits until the operation is done, the timeout elapses or the context is done,
+// and returns the latest state of the operation. It does not return an error if the operation is not done in time,
+// so clients check Done. The timeout is capped at one minute, a timeout of zero waits the full minute.
+func (o *Operations) WaitOperation(ctx context.Context, id string, timeout time.Duration) (*Operation, error) {
+	if timeout <= 0 || timeout > maxOperationWait {
+		timeout = maxOperationWait
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(operationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		// The state is read with the parent context, so it can still be returned once the wait is over.
+		operation, err := o.GetOperation(ctx, id)
+		if err != nil || operation.Done {
+			return operation, err
+		}
+
+		select {
+		case <-waitCtx.Done():
+			return operation, nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// ListUnfinishedOperations returns the operations which are not done, optionally only those of the job type,
+// oldest first, with the time until which the delayed ones are held back, e.g. to find stuck jobs.
+func (o *Operations) ListUnfinishedOperations(ctx context.Context, jobType string) ([]Operation, error) {
+	slogctx.Debug(ctx, "ListUnfinishedOperations called", "type", jobType)
+
+	cond := repository.NewCompositeKey().Where(repository.StatusField, repository.Not{Value: terminalJobStatuses})
+	if jobType != "" {
+		cond.Where(repository.TypeField, jobType)
+	}
+
+	operations, err := o.list(ctx, cond)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]string, 0, len(operations))
+	for _, operation := range operations {
+		ids = append(ids, operation.ID)
+	}
+
+	notBefore := make(map[string]time.Time, len(ids))
+
+	for chunk := range slices.Chunk(ids, repository.MaxFilterValues) {
+		var delays []model.JobDelay
+
+		err := o.repo.List(ctx, &delays, *repository.NewQuery(&model.JobDelay{}).
+			Where(repository.NewCompositeKey().Where(jobIDField, chunk)))
+		if err != nil {
+			slogctx.Error(ctx, "failed to list job delays", "error", err)
+			return nil, ErrOperationSelect
+		}
+
+		for _, delay := range delays {
+			notBefore[delay.JobID] = delay.NotBefore
+		}
+	}
+
+	for i := range operations {
+		if t, ok := notBefore[operations[i].ID]; ok {
+			operations[i].NotBefore = &t
+		}
+	}
+
+	return operations, nil
+}
+
+// list returns the operations matching the condition, oldest first.
+func (o *Operations) list(ctx context.Context, cond repository.CompositeKey) ([]Operation, error) {
+	jobs, err := listJobs(ctx, o.repo, cond)
+	if err != nil {
+		slogctx.Error(ctx, "failed to list jobs", "error", err)
+		return nil, ErrOperationSelect
+	}
+
+	operations := make([]Operation, 0, len(jobs))
+	for _, job := range jobs {
+		operations = append(operations, operationOf(job))
+	}
+
+	return operations, nil
+}
+
+// operationOf returns the operation backed by the job.
+func operationOf(job model.Job) Operation {
+	operation := Operation{
+		ID:         job.ID,
+		ExternalID: job.ExternalID,
+		Type:       job.Type,
+		Status:     job.Status,
+		Done:       slices.Contains(orbital.TerminalStatuses(), orbital.JobStatus(job.Status)),
+		UpdatedAt:  time.Unix(0, job.UpdatedAt).UTC(),
+		CreatedAt:  time.Unix(0, job.CreatedAt).UTC(),
+	}
+	if job.ErrorMessage != nil {
+		operation.ErrorMessage = *job.ErrorMessage
+	}
+
+	return operation
+}
+
+type operationIDsKey struct{}
+
+// operationIDs collects the IDs of the operations started by a call.
+type operationIDs struct {
+	mu   sync.Mutex
+	list []string
+}
+
+// WithOperationIDs returns a context collecting the IDs of the operations started by the call.
+func WithOperationIDs(ctx context.Context) context.Context {
+	return context.WithValue(ctx, operationIDsKey{}, &operationIDs{})
+}
+
+// OperationIDsFromContext returns the IDs of the operations collected in the context.
+func OperationIDsFromContext(ctx context.Context) []string {
+	ids, ok := ctx.Value(operationIDsKey{}).(*operationIDs)
+	if !ok {
+		return nil
+	}
+
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+
+	return append([]string(nil), ids.list...)
+}
+
+// AddOperationID adds the ID of an operation started by the call.
+// It does nothing if the context does not collect operation IDs.
+func AddOperationID(ctx context.Context, id string) {
+	ids, ok := ctx.Value(operationIDsKey{}).(*operationIDs)
+	if !ok {
+		return
+	}
+
+	ids.mu.Lock()
+	defer ids.mu.Unlock()
+
+	ids.list = append(ids.list, id)
+}
@@ -0,0 +1,74 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+)
+
+// OperationExtension implements the procedure calls on operations defined in api/extension/v1/extension.proto,
+// which are not defined by api-sdk yet.
+type OperationExtension struct {
+	extensiongrpc.UnimplementedOperationServiceServer
+
+	operations *Operations
+}
+
+// NewOperationExtension creates and returns a new instance of OperationExtension.
+func NewOperationExtension(operations *Operations) *OperationExtension {
+	return &OperationExtension{
+		operations: operations,
+	}
+}
+
+// GetOperation returns the latest state of the operation.
+func (o *OperationExtension) GetOperation(ctx context.Context, in *extensiongrpc.GetOperationRequest) (*extensiongrpc.GetOperationResponse, error) {
+	operation, err := o.operations.GetOperation(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.GetOperationResponse{Operation: operationToProto(operation)}, nil
+}
+
+// ListOperations returns the operations of a resource, e.g. of a tenant, newest first.
+func (o *OperationExtension) ListOperations(ctx context.Context, in *extensiongrpc.ListOperationsRequest) (*extensiongrpc.ListOperationsResponse, error) {
+	operations, err := o.operations.ListOperations(ctx, in.GetExternalId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.ListOperationsResponse{
+		Operations: make([]*extensiongrpc.Operation, 0, len(operations)),
+	}
+	for i := range operations {
+		resp.Operations = append(resp.Operations, operationToProto(&operations[i]))
+	}
+
+	return resp, nil
+}
+
+// WaitOperation waits until the operation is done or the timeout elapses and returns the latest state of the operation.
+func (o *OperationExtension) WaitOperation(ctx context.Context, in *extensiongrpc.WaitOperationRequest) (*extensiongrpc.WaitOperationResponse, error) {
+	operation, err := o.operations.WaitOperation(ctx, in.GetId(), in.GetTimeout().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.WaitOperationResponse{Operation: operationToProto(operation)}, nil
+}
+
+func operationToProto(operation *Operation) *extensiongrpc.Operation {
+	return &extensiongrpc.Operation{
+		Id:           operation.ID,
+		ExternalId:   operation.ExternalID,
+		Type:         operation.Type,
+		Status:       operation.Status,
+		Done:         operation.Done,
+		ErrorMessage: operation.ErrorMessage,
+		UpdatedAt:    timestamppb.New(operation.UpdatedAt),
+		CreatedAt:    timestamppb.New(operation.CreatedAt),
+	}
+}
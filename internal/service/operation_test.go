@@ -0,0 +1,62 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestOperationInvalidID(t *testing.T) {
+	subj := service.NewOperations(nil)
+
+	t.Run("should return error for GetOperation", func(t *testing.T) {
+		// when
+		operation, err := subj.GetOperation(t.Context(), "not-a-uuid")
+
+		// then
+		assert.ErrorIs(t, err, service.ErrOperationIDInvalid)
+		assert.Nil(t, operation)
+	})
+
+	t.Run("should return error for WaitOperation", func(t *testing.T) {
+		// when
+		operation, err := subj.WaitOperation(t.Context(), "not-a-uuid", 0)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrOperationIDInvalid)
+		assert.Nil(t, operation)
+	})
+
+	t.Run("should return error for ListOperations without external ID", func(t *testing.T) {
+		// when
+		operations, err := subj.ListOperations(t.Context(), "")
+
+		// then
+		assert.ErrorIs(t, err, service.ErrExternalIDIsEmpty)
+		assert.Nil(t, operations)
+	})
+}
+
+func TestOperationIDsFromContext(t *testing.T) {
+	t.Run("should collect the operation IDs of the call", func(t *testing.T) {
+		// given
+		ctx := service.WithOperationIDs(t.Context())
+
+		// when
+		service.AddOperationID(ctx, "operation-1")
+		service.AddOperationID(ctx, "operation-2")
+
+		// then
+		assert.Equal(t, []string{"operation-1", "operation-2"}, service.OperationIDsFromContext(ctx))
+	})
+
+	t.Run("should ignore operation IDs if the context does not collect them", func(t *testing.T) {
+		// when
+		service.AddOperationID(t.Context(), "operation-1")
+
+		// then
+		assert.Nil(t, service.OperationIDsFromContext(t.Context()))
+	})
+}
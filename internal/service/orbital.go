@@ -5,8 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
+	"slices"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/openkcm/orbital"
 	"github.com/openkcm/orbital/client/amqp"
 	"github.com/openkcm/orbital/codec"
@@ -16,8 +20,13 @@ import (
 	slogctx "github.com/veqryn/slog-context"
 
 	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
 )
 
+// maxListedJobs bounds the number of orbital jobs listed at once, e.g. the history of a tenant.
+const maxListedJobs = 1000
+
 var (
 	ErrWrongConnectionType = errors.New("wrong initiator type")
 	ErrUnexpectedJobType   = errors.New("unexpected job type")
@@ -29,6 +38,10 @@ type (
 		manager  *orbital.Manager
 		targets  map[string]orbital.TargetManager
 		registry handlerRegistry
+		workers  *WorkerScaler
+		repo     repository.Repository
+		delays   maintenanceScheduler
+		replay   config.JobReplay
 	}
 
 	// handlerRegistry maintains a mapping of job types to their respective handlers.
@@ -49,7 +62,9 @@ type (
 
 // NewOrbital initializes the Orbital manager with the provided database and target configurations.
 // It sets up the AMQP clients for each target and starts the manager.
-func NewOrbital(ctx context.Context, db *gorm.DB, cfg config.Orbital) (*Orbital, error) {
+// The orbital tables are read and the job delays are stored through the repository,
+// while orbital writes its tables through its own store.
+func NewOrbital(ctx context.Context, db *gorm.DB, repo repository.Repository, cfg config.Orbital) (*Orbital, error) {
 	slogctx.Info(ctx, "Initializing Orbital Manager")
 
 	sqlDB, err := db.DB()
@@ -69,6 +84,10 @@ func NewOrbital(ctx context.Context, db *gorm.DB, cfg config.Orbital) (*Orbital,
 	}
 	o := &Orbital{
 		targets: targets,
+		workers: newWorkerScaler(repo, cfg.Workers),
+		repo:    repo,
+		delays:  newMaintenanceScheduler(cfg.MaintenancePolicy),
+		replay:  cfg.Replay,
 	}
 
 	manager, err := orbital.NewManager(orbRepo,
@@ -83,7 +102,7 @@ func NewOrbital(ctx context.Context, db *gorm.DB, cfg config.Orbital) (*Orbital,
 		return nil, fmt.Errorf("orbital manager initialization failed: %w", err)
 	}
 
-	configureOrbital(ctx, cfg, manager)
+	configureOrbital(ctx, cfg, manager, o.workers)
 
 	o.manager = manager
 	return o, nil
@@ -98,6 +117,16 @@ func (o *Orbital) Start(ctx context.Context) error {
 	return nil
 }
 
+// Regions returns the sorted regions with a configured target.
+func (o *Orbital) Regions() []string {
+	return slices.Sorted(maps.Keys(o.targets))
+}
+
+// Workers returns the scaler sizing the orbital worker pools.
+func (o *Orbital) Workers() *WorkerScaler {
+	return o.workers
+}
+
 // RegisterJobHandler registers a JobHandler for a specific job type.
 func (o *Orbital) RegisterJobHandler(jobType string, handler JobHandler) {
 	o.registry.mu.Lock()
@@ -112,16 +141,114 @@ func (o *Orbital) RegisterJobHandler(jobType string, handler JobHandler) {
 
 // PrepareJob creates a new job with the given data, external ID, and job type.
 func (o *Orbital) PrepareJob(ctx context.Context, data []byte, externalID, jobType string) error {
+	_, err := o.prepareJob(ctx, data, externalID, jobType)
+	return err
+}
+
+func (o *Orbital) prepareJob(ctx context.Context, data []byte, externalID, jobType string) (orbital.Job, error) {
 	ctx = slogctx.With(ctx, slog.String("job type", jobType), slog.String("external ID", externalID))
 
 	job := orbital.NewJob(jobType, data).WithExternalID(externalID)
 	job, err := o.manager.PrepareJob(ctx, job)
 	if err != nil {
 		slogctx.Error(ctx, "failed to prepare job", "error", err)
-		return err
+		return orbital.Job{}, err
 	}
 
+	// the job is stored by orbital outside of the transaction, which must not run again and prepare another job
+	repository.RecordOutsideEffect(ctx)
+
 	slogctx.Debug(ctx, "Job prepared", "jobId", job.ID)
+	AddOperationID(ctx, job.ID.String())
+	return job, nil
+}
+
+// CancelJob cancels the unfinished job with the ID, so it is neither confirmed nor processed further.
+func (o *Orbital) CancelJob(ctx context.Context, jobID string) error {
+	id, err := uuid.Parse(jobID)
+	if err != nil {
+		return err
+	}
+
+	err = o.manager.CancelJob(ctx, id)
+	if err != nil {
+		slogctx.Error(ctx, "failed to cancel job", "error", err, "jobId", jobID)
+		return err
+	}
+
+	repository.RecordOutsideEffect(ctx)
+
+	return nil
+}
+
+// PrepareDelayedJob creates a new job like PrepareJob, which is not confirmed before notBefore.
+// The delay is keyed by the ID of the job, so it is stored right after the job is prepared,
+// and the job is canceled if the delay can not be stored.
+// Orbital confirms the job not before ConfirmJobAfter, which must cover storing the delay.
+func (o *Orbital) PrepareDelayedJob(ctx context.Context, data []byte, externalID, jobType string, notBefore time.Time) error {
+	job, err := o.prepareJob(ctx, data, externalID, jobType)
+	if err != nil {
+		return err
+	}
+
+	err = createJobDelay(ctx, o.repo, job, notBefore)
+	if err != nil {
+		slogctx.Error(ctx, "failed to store job delay", "error", err, "jobId", job.ID)
+		if cancelErr := o.CancelJob(ctx, job.ID.String()); cancelErr != nil {
+			slogctx.Error(ctx, "failed to cancel job without delay", "error", cancelErr, "jobId", job.ID)
+		}
+		return ErrJobDelayCreate
+	}
+
+	slogctx.Info(ctx, "job scheduled to maintenance window", "jobType", jobType, "externalID", externalID, "notBefore", notBefore)
+	return nil
+}
+
+// PrepareTenantJob creates a new job disrupting the tenant with respect to its maintenance windows.
+// Inside a window or if forced, the job is prepared immediately. Outside, it is delayed to the next window,
+// or ErrOutsideMaintenanceWindow is returned if the maintenance policy rejects it.
+func (o *Orbital) PrepareTenantJob(ctx context.Context, tenant *model.Tenant, data []byte, externalID, jobType string) error {
+	return o.PrepareTenantJobAfter(ctx, tenant, data, externalID, jobType, time.Time{})
+}
+
+// PrepareTenantJobAfter creates a new job like PrepareTenantJob, which is not confirmed before notBefore.
+// A notBefore in the future delays the job to the first maintenance window of the tenant open at or after it.
+func (o *Orbital) PrepareTenantJobAfter(ctx context.Context, tenant *model.Tenant, data []byte, externalID, jobType string, notBefore time.Time) error {
+	notBefore, err := o.delays.scheduleAfter(ctx, tenant.MaintenanceWindows, notBefore)
+	if err != nil {
+		return err
+	}
+
+	if notBefore.IsZero() {
+		return o.PrepareJob(ctx, data, externalID, jobType)
+	}
+
+	return o.PrepareDelayedJob(ctx, data, externalID, jobType, notBefore)
+}
+
+// listJobs returns the orbital jobs matching the condition, oldest first, at most maxListedJobs.
+func listJobs(ctx context.Context, r repository.Repository, cond repository.CompositeKey) ([]model.Job, error) {
+	query := repository.NewQuery(&model.Job{}).Where(cond).SetLimit(maxListedJobs)
+
+	var jobs []model.Job
+	if err := r.List(ctx, &jobs, *query); err != nil {
+		return nil, err
+	}
+
+	slices.Reverse(jobs)
+
+	return jobs, nil
+}
+
+// ReleaseJobDelay removes the delay of the job with the ID, so it is confirmed
+// by the next run of the confirm worker.
+func (o *Orbital) ReleaseJobDelay(ctx context.Context, jobID string) error {
+	err := deleteJobDelay(ctx, o.repo, jobID)
+	if err != nil {
+		slogctx.Error(ctx, "failed to remove job delay", "error", err, "jobId", jobID)
+		return ErrJobDelayDelete
+	}
+
 	return nil
 }
 
@@ -130,7 +257,7 @@ func createTargets(ctx context.Context, cfgTargets []config.Target) (map[string]
 	for _, cfgTarget := range cfgTargets {
 		slogctx.Info(ctx, "creating orbital target", slog.String("Region", cfgTarget.Region))
 
-		client, err := createAMQPClient(ctx, cfgTarget)
+		client, err := createAMQPClient(ctx, cfgTarget.Connection)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create AMQP client for %s: %w", cfgTarget.Region, err)
 		}
@@ -143,31 +270,31 @@ func createTargets(ctx context.Context, cfgTargets []config.Target) (map[string]
 	return targets, nil
 }
 
-func createAMQPClient(ctx context.Context, cfgTarget config.Target) (*amqp.Client, error) {
-	if cfgTarget.Connection.Type != config.ConnectionTypeAMQP {
-		return nil, fmt.Errorf("%w: %s", ErrWrongConnectionType, cfgTarget.Connection.Type)
+func createAMQPClient(ctx context.Context, cfgConnection *config.Connection) (*amqp.Client, error) {
+	if cfgConnection.Type != config.ConnectionTypeAMQP {
+		return nil, fmt.Errorf("%w: %s", ErrWrongConnectionType, cfgConnection.Type)
 	}
 
 	connInfo := amqp.ConnectionInfo{
-		URL:    cfgTarget.Connection.AMQP.URL,
-		Target: cfgTarget.Connection.AMQP.Target,
-		Source: cfgTarget.Connection.AMQP.Source,
+		URL:    cfgConnection.AMQP.URL,
+		Target: cfgConnection.AMQP.Target,
+		Source: cfgConnection.AMQP.Source,
 	}
 
 	var option amqp.ClientOption
 
-	switch cfgTarget.Connection.Auth.Type {
+	switch cfgConnection.Auth.Type {
 	case config.AuthTypeMTLS:
 		option = amqp.WithExternalMTLS(
-			cfgTarget.Connection.Auth.MTLS.CertFile,
-			cfgTarget.Connection.Auth.MTLS.KeyFile,
-			cfgTarget.Connection.Auth.MTLS.CAFile,
+			cfgConnection.Auth.MTLS.CertFile,
+			cfgConnection.Auth.MTLS.KeyFile,
+			cfgConnection.Auth.MTLS.CAFile,
 			"",
 		)
 	case config.AuthTypeNone:
 		option = amqp.WithNoAuth()
 	default:
-		return nil, fmt.Errorf("%w: %s", config.ErrUnsupportedAuthType, cfgTarget.Connection.Auth.Type)
+		return nil, fmt.Errorf("%w: %s", config.ErrUnsupportedAuthType, cfgConnection.Auth.Type)
 	}
 
 	client, err := amqp.NewClient(ctx, &codec.Proto{}, connInfo, option)
@@ -179,36 +306,36 @@ func createAMQPClient(ctx context.Context, cfgTarget config.Target) (*amqp.Clien
 		slog.String("url", connInfo.URL),
 		slog.String("target", connInfo.Target),
 		slog.String("source", connInfo.Source),
-		slog.String("authType", string(cfgTarget.Connection.Auth.Type)),
+		slog.String("authType", string(cfgConnection.Auth.Type)),
 	)
 
 	return client, nil
 }
 
-func configureOrbital(ctx context.Context, cfg config.Orbital, manager *orbital.Manager) {
+func configureOrbital(ctx context.Context, cfg config.Orbital, manager *orbital.Manager, workers *WorkerScaler) {
 	manager.Config.ConfirmJobAfter = cfg.ConfirmJobAfter
 	manager.Config.TaskLimitNum = cfg.TaskLimitNum
 	manager.Config.MaxPendingReconciles = cfg.MaxPendingReconciles
 	manager.Config.BackoffBaseIntervalSec = cfg.BackoffBaseIntervalSec
 	manager.Config.BackoffMaxIntervalSec = cfg.BackoffMaxIntervalSec
-	configureOrbitalWorkers(ctx, cfg, manager)
+	configureOrbitalWorkers(ctx, cfg, manager, workers)
 	slogctx.Info(ctx, "effective orbital configuration", "config", manager.Config)
 }
 
-func configureOrbitalWorkers(ctx context.Context, cfg config.Orbital, manager *orbital.Manager) {
-	configureOrbitalWorker(ctx, cfg.GetWorker(config.WorkerNameCreateTask), &manager.Config.CreateTasksWorkerConfig)
-	configureOrbitalWorker(ctx, cfg.GetWorker(config.WorkerNameConfirmJob), &manager.Config.ConfirmJobWorkerConfig)
-	configureOrbitalWorker(ctx, cfg.GetWorker(config.WorkerNameReconcile), &manager.Config.ReconcileWorkerConfig)
-	configureOrbitalWorker(ctx, cfg.GetWorker(config.WorkerNameNotifyEvent), &manager.Config.NotifyWorkerConfig)
+func configureOrbitalWorkers(ctx context.Context, cfg config.Orbital, manager *orbital.Manager, workers *WorkerScaler) {
+	configureOrbitalWorker(ctx, cfg.GetWorker(config.WorkerNameCreateTask), &manager.Config.CreateTasksWorkerConfig, workers)
+	configureOrbitalWorker(ctx, cfg.GetWorker(config.WorkerNameConfirmJob), &manager.Config.ConfirmJobWorkerConfig, workers)
+	configureOrbitalWorker(ctx, cfg.GetWorker(config.WorkerNameReconcile), &manager.Config.ReconcileWorkerConfig, workers)
+	configureOrbitalWorker(ctx, cfg.GetWorker(config.WorkerNameNotifyEvent), &manager.Config.NotifyWorkerConfig, workers)
 }
 
-func configureOrbitalWorker(ctx context.Context, cfg *config.Worker, worker *orbital.WorkerConfig) {
+func configureOrbitalWorker(ctx context.Context, cfg *config.Worker, worker *orbital.WorkerConfig, workers *WorkerScaler) {
 	if cfg == nil {
 		return
 	}
 
-	if cfg.NoOfWorkers > 0 {
-		worker.NoOfWorkers = cfg.NoOfWorkers
+	if noOfWorkers := workers.scale(ctx, *cfg); noOfWorkers > 0 {
+		worker.NoOfWorkers = noOfWorkers
 	}
 
 	if cfg.ExecInterval > 0 {
@@ -232,6 +359,14 @@ func (o *Orbital) confirmJob() orbital.JobConfirmFunc {
 				ErrUnexpectedJobType, job.Type)), nil
 		}
 
+		hold, err := o.delays.hold(ctx, o.repo, job)
+		if err != nil {
+			return nil, err
+		}
+		if hold {
+			return orbital.ContinueJobConfirmer(), nil
+		}
+
 		return h.ConfirmJob(ctx, job)
 	}
 }
@@ -258,7 +393,13 @@ func (o *Orbital) handleJobDone() orbital.JobTerminatedEventFunc {
 			return nil
 		}
 
-		return h.HandleJobDone(ctx, job)
+		if err := h.HandleJobDone(ctx, job); err != nil {
+			return err
+		}
+
+		o.recordOutcome(ctx, job, model.JobOutcomeDone)
+
+		return nil
 	}
 }
 
@@ -271,7 +412,13 @@ func (o *Orbital) handleJobFailed() orbital.JobTerminatedEventFunc {
 			return nil
 		}
 
-		return h.HandleJobFailed(ctx, job)
+		if err := h.HandleJobFailed(ctx, job); err != nil {
+			return err
+		}
+
+		o.recordOutcome(ctx, job, model.JobOutcomeFailed)
+
+		return nil
 	}
 }
 
@@ -284,7 +431,13 @@ func (o *Orbital) handleJobCanceled() orbital.JobTerminatedEventFunc {
 			return nil
 		}
 
-		return h.HandleJobCanceled(ctx, job)
+		if err := h.HandleJobCanceled(ctx, job); err != nil {
+			return err
+		}
+
+		o.recordOutcome(ctx, job, model.JobOutcomeCanceled)
+
+		return nil
 	}
 }
 
@@ -0,0 +1,134 @@
+package service
+
+import (
+	"context"
+
+	"github.com/openkcm/orbital"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+const AttrWorker = "worker"
+
+// workerBacklogs selects the pending items of each orbital worker in the orbital tables.
+var workerBacklogs = map[string]func() *repository.Query{
+	config.WorkerNameConfirmJob: func() *repository.Query {
+		return repository.NewQuery(&model.Job{}).Where(repository.NewCompositeKey().
+			Where(repository.StatusField, []orbital.JobStatus{orbital.JobStatusCreated, orbital.JobStatusConfirming}))
+	},
+	config.WorkerNameCreateTask: func() *repository.Query {
+		return repository.NewQuery(&model.Job{}).Where(repository.NewCompositeKey().
+			Where(repository.StatusField, []orbital.JobStatus{orbital.JobStatusConfirmed, orbital.JobStatusResolving}))
+	},
+	config.WorkerNameReconcile: func() *repository.Query {
+		return repository.NewQuery(&model.Task{}).Where(repository.NewCompositeKey().
+			Where(repository.StatusField, []orbital.TaskStatus{orbital.TaskStatusCreated, orbital.TaskStatusProcessing}))
+	},
+	config.WorkerNameNotifyEvent: func() *repository.Query {
+		return repository.NewQuery(&model.JobEvent{}).Where(repository.NewCompositeKey().
+			Where(repository.IsNotifiedField, false))
+	},
+}
+
+// WorkerScaler sizes the orbital worker pools between their configured minimum and maximum
+// based on the backlog of each worker.
+// The orbital manager fixes the pool sizes once started, so pools are sized at startup
+// and the desired sizes are exposed as metrics to detect when a restart would pay off.
+type WorkerScaler struct {
+	repo    repository.Repository
+	workers []config.Worker
+	active  map[string]int
+}
+
+func newWorkerScaler(repo repository.Repository, workers []config.Worker) *WorkerScaler {
+	return &WorkerScaler{
+		repo:    repo,
+		workers: workers,
+		active:  make(map[string]int, len(workers)),
+	}
+}
+
+// scale returns the number of workers to start the worker with.
+// If the backlog can not be counted, the configured number of workers is used.
+func (s *WorkerScaler) scale(ctx context.Context, worker config.Worker) int {
+	workers := worker.NoOfWorkers
+	if worker.Scaled() {
+		backlog, err := s.backlog(ctx, worker.Name)
+		if err != nil {
+			slogctx.Error(ctx, "failed to count orbital worker backlog", "name", worker.Name, "error", err)
+		} else {
+			workers = desiredWorkers(worker, backlog)
+		}
+	}
+
+	s.active[worker.Name] = workers
+
+	return workers
+}
+
+// RegisterMeters registers gauges of the backlog, the active and the desired number of workers.
+func (s *WorkerScaler) RegisterMeters(ctx context.Context, registry *MeterRegistry) error {
+	err := registry.ObservableGauge(ctx, "orbital.workers.backlog", "Gauge of pending items of orbital workers, partitioned by worker",
+		func(ctx context.Context, observer metric.Int64Observer) error {
+			return s.measure(ctx, observer, func(_ config.Worker, backlog int64) int64 { return backlog })
+		})
+	if err != nil {
+		return err
+	}
+
+	err = registry.ObservableGauge(ctx, "orbital.workers.active", "Gauge of running orbital workers, partitioned by worker",
+		func(_ context.Context, observer metric.Int64Observer) error {
+			for name, workers := range s.active {
+				observer.Observe(int64(workers), metric.WithAttributes(attribute.String(AttrWorker, name)))
+			}
+
+			return nil
+		})
+	if err != nil {
+		return err
+	}
+
+	return registry.ObservableGauge(ctx, "orbital.workers.desired", "Gauge of orbital workers desired for the current backlog, partitioned by worker",
+		func(ctx context.Context, observer metric.Int64Observer) error {
+			return s.measure(ctx, observer, func(w config.Worker, backlog int64) int64 { return int64(desiredWorkers(w, backlog)) })
+		})
+}
+
+func (s *WorkerScaler) measure(ctx context.Context, observer metric.Int64Observer, value func(config.Worker, int64) int64) error {
+	for _, worker := range s.workers {
+		backlog, err := s.backlog(ctx, worker.Name)
+		if err != nil {
+			return err
+		}
+
+		observer.Observe(value(worker, backlog), metric.WithAttributes(attribute.String(AttrWorker, worker.Name)))
+	}
+
+	return nil
+}
+
+func (s *WorkerScaler) backlog(ctx context.Context, name string) (int64, error) {
+	query, ok := workerBacklogs[name]
+	if !ok {
+		return 0, nil
+	}
+
+	return s.repo.Count(ctx, *query())
+}
+
+// desiredWorkers returns one worker per BacklogPerWorker pending items, bounded by the configured minimum and maximum.
+func desiredWorkers(worker config.Worker, backlog int64) int {
+	if !worker.Scaled() {
+		return worker.NoOfWorkers
+	}
+
+	desired := (backlog + worker.BacklogPerWorker - 1) / worker.BacklogPerWorker
+
+	return int(max(int64(worker.NoOfWorkers), min(desired, int64(worker.MaxWorkers))))
+}
@@ -0,0 +1,57 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestDesiredWorkers(t *testing.T) {
+	scaled := config.Worker{
+		Name:             config.WorkerNameReconcile,
+		NoOfWorkers:      2,
+		MaxWorkers:       10,
+		BacklogPerWorker: 100,
+	}
+
+	tests := []struct {
+		name       string
+		worker     config.Worker
+		backlog    int64
+		expWorkers int
+	}{
+		{
+			name:       "not scaled",
+			worker:     config.Worker{Name: config.WorkerNameReconcile, NoOfWorkers: 3},
+			backlog:    10000,
+			expWorkers: 3,
+		},
+		{
+			name:       "empty backlog",
+			worker:     scaled,
+			backlog:    0,
+			expWorkers: 2,
+		},
+		{
+			name:       "backlog within bounds",
+			worker:     scaled,
+			backlog:    401,
+			expWorkers: 5,
+		},
+		{
+			name:       "backlog above max",
+			worker:     scaled,
+			backlog:    5000,
+			expWorkers: 10,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expWorkers, service.DesiredWorkers(tt.worker, tt.backlog))
+		})
+	}
+}
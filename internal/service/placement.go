@@ -0,0 +1,150 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Reasons why a tenant should not be placed in the requested region.
+const (
+	PlacementReasonNoTarget     = "NO_TARGET"
+	PlacementReasonAtCapacity   = "AT_CAPACITY"
+	PlacementReasonNearCapacity = "NEAR_CAPACITY"
+)
+
+// PlacementHint is the result of a tenant placement check for a region.
+type PlacementHint struct {
+	Region string
+	// Accepted is true if the tenant can be placed in the region as requested.
+	Accepted bool
+	// Reason explains why the region is not accepted, it is empty otherwise.
+	Reason string
+	// Alternatives are accepted regions, ordered by their free capacity.
+	Alternatives []string
+}
+
+// TenantPlacement checks if a region can take another tenant and suggests alternative regions.
+// Only regions with an orbital target can be provisioned, so other regions are never accepted.
+// The procedure call is served on the extension tenant service, see TenantExtension.
+type TenantPlacement struct {
+	repo    repository.Repository
+	regions []string
+	cfg     config.TenantPlacement
+}
+
+// NewTenantPlacement creates and returns a new instance of TenantPlacement for the regions with an orbital target.
+func NewTenantPlacement(repo repository.Repository, regions []string, cfg config.TenantPlacement) *TenantPlacement {
+	return &TenantPlacement{
+		repo:    repo,
+		regions: regions,
+		cfg:     cfg,
+	}
+}
+
+// SuggestTenantPlacement returns whether a tenant can be placed in the region,
+// and if not the alternative regions to place it in.
+func (p *TenantPlacement) SuggestTenantPlacement(ctx context.Context, region string) (*PlacementHint, error) {
+	slogctx.Debug(ctx, "SuggestTenantPlacement called", "region", region)
+
+	if region == "" {
+		return nil, ErrorWithParams(ErrValidationFailed, "err", "region must not be empty")
+	}
+
+	var counts []struct {
+		Region string
+		Count  int64
+	}
+
+	query := repository.QueryOf(model.TenantFields.Status.Ne(model.TenantStatus(tenantgrpc.Status_STATUS_TERMINATED.String())))
+
+	err := p.repo.Aggregate(ctx, &counts, *query, model.TenantFields.Region.Column())
+	if err != nil {
+		slogctx.Error(ctx, "failed to count tenants by region", "error", err)
+		return nil, ErrTenantSelect
+	}
+
+	tenants := make(map[string]int64, len(counts))
+	for _, c := range counts {
+		tenants[c.Region] = c.Count
+	}
+
+	return suggestPlacement(region, tenants, p.regions, p.cfg), nil
+}
+
+// suggestPlacement checks the region against the tenant counts and capacities of all regions.
+func suggestPlacement(region string, tenants map[string]int64, regions []string, cfg config.TenantPlacement) *PlacementHint {
+	hint := &PlacementHint{
+		Region: region,
+		Reason: placementReason(region, tenants[region], regions, cfg),
+	}
+
+	hint.Accepted = hint.Reason == ""
+	if hint.Accepted {
+		return hint
+	}
+
+	for _, r := range regions {
+		if r != region && placementReason(r, tenants[r], regions, cfg) == "" {
+			hint.Alternatives = append(hint.Alternatives, r)
+		}
+	}
+
+	// Unbounded regions have the most free capacity.
+	slices.SortStableFunc(hint.Alternatives, func(a, b string) int {
+		return compareFreeCapacity(b, a, tenants, cfg)
+	})
+
+	return hint
+}
+
+func placementReason(region string, tenants int64, regions []string, cfg config.TenantPlacement) string {
+	if !slices.Contains(regions, region) {
+		return PlacementReasonNoTarget
+	}
+
+	capacity, ok := cfg.Capacity[region]
+	if !ok {
+		return ""
+	}
+
+	if tenants >= capacity {
+		return PlacementReasonAtCapacity
+	}
+
+	if float64(tenants) >= float64(capacity)*cfg.NearCapacityRatio {
+		return PlacementReasonNearCapacity
+	}
+
+	return ""
+}
+
+func compareFreeCapacity(a, b string, tenants map[string]int64, cfg config.TenantPlacement) int {
+	capA, boundedA := cfg.Capacity[a]
+	capB, boundedB := cfg.Capacity[b]
+
+	switch {
+	case !boundedA && !boundedB:
+		return 0
+	case !boundedA:
+		return 1
+	case !boundedB:
+		return -1
+	}
+
+	freeA, freeB := capA-tenants[a], capB-tenants[b]
+	switch {
+	case freeA < freeB:
+		return -1
+	case freeA > freeB:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,78 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestSuggestPlacement(t *testing.T) {
+	regions := []string{"region-1", "region-2", "region-3", "region-4"}
+	cfg := config.TenantPlacement{
+		Capacity: map[string]int64{
+			"region-1": 100,
+			"region-2": 100,
+			"region-3": 100,
+		},
+		NearCapacityRatio: 0.9,
+	}
+	tenants := map[string]int64{
+		"region-1": 100,
+		"region-2": 95,
+		"region-3": 50,
+		"region-4": 1000,
+	}
+
+	tests := []struct {
+		name            string
+		region          string
+		expAccepted     bool
+		expReason       string
+		expAlternatives []string
+	}{
+		{
+			name:        "region below capacity",
+			region:      "region-3",
+			expAccepted: true,
+		},
+		{
+			name:        "unbounded region",
+			region:      "region-4",
+			expAccepted: true,
+		},
+		{
+			name:            "region at capacity",
+			region:          "region-1",
+			expReason:       service.PlacementReasonAtCapacity,
+			expAlternatives: []string{"region-4", "region-3"},
+		},
+		{
+			name:            "region near capacity",
+			region:          "region-2",
+			expReason:       service.PlacementReasonNearCapacity,
+			expAlternatives: []string{"region-4", "region-3"},
+		},
+		{
+			name:            "region without target",
+			region:          "region-5",
+			expReason:       service.PlacementReasonNoTarget,
+			expAlternatives: []string{"region-4", "region-3"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			hint := service.SuggestPlacement(tt.region, tenants, regions, cfg)
+
+			// then
+			assert.Equal(t, tt.region, hint.Region)
+			assert.Equal(t, tt.expAccepted, hint.Accepted)
+			assert.Equal(t, tt.expReason, hint.Reason)
+			assert.Equal(t, tt.expAlternatives, hint.Alternatives)
+		})
+	}
+}
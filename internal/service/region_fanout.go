@@ -0,0 +1,183 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"strings"
+	"sync"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// maxRegionFanOut is the maximum number of shards queried concurrently by a single request.
+const maxRegionFanOut = 4
+
+// Reason and domain of the ErrorInfo details of shards which failed during a fan-out.
+const (
+	RegionUnavailableReason = "REGION_UNAVAILABLE"
+	RegionErrorDomain       = "registry.openkcm.io"
+)
+
+// RegionShard is a database storing the regional systems of a set of regions.
+type RegionShard struct {
+	// Name identifies the shard in logs and error details.
+	Name string
+	// Regions are the regions stored by the shard. Nil if the shard stores all regions.
+	Regions []string
+	Repo    repository.Repository
+}
+
+// stores returns true if the shard stores any of the regions.
+func (s RegionShard) stores(regions []string) bool {
+	if s.Regions == nil || len(regions) == 0 {
+		return true
+	}
+
+	return slices.ContainsFunc(regions, func(region string) bool {
+		return slices.Contains(s.Regions, region)
+	})
+}
+
+// RegionRouter routes queries of regional systems to the shards storing their regions.
+type RegionRouter interface {
+	// Route returns the shards storing any of the regions, or all shards if no region is given.
+	Route(regions []string) []RegionShard
+}
+
+// singleDatabase routes all regions to the one database the registry runs on today.
+type singleDatabase struct {
+	shard RegionShard
+}
+
+// NewSingleDatabaseRouter creates a RegionRouter storing all regions in the database of the repository.
+func NewSingleDatabaseRouter(repo repository.Repository) RegionRouter {
+	return singleDatabase{
+		shard: RegionShard{Name: "default", Repo: repo},
+	}
+}
+
+// Route implements RegionRouter.
+func (d singleDatabase) Route(regions []string) []RegionShard {
+	if !d.shard.stores(regions) {
+		return nil
+	}
+
+	return []RegionShard{d.shard}
+}
+
+// shardError is the error of a shard during a fan-out.
+type shardError struct {
+	shard RegionShard
+	err   error
+}
+
+// fanOut calls list on every shard, with at most maxRegionFanOut concurrent calls,
+// and returns the results of the successful shards and the errors of the failed ones.
+func fanOut[T any](ctx context.Context, shards []RegionShard, list func(context.Context, repository.Repository) ([]T, error)) ([][]T, []shardError) {
+	if len(shards) == 1 {
+		result, err := list(ctx, shards[0].Repo)
+		if err != nil {
+			return nil, []shardError{{shard: shards[0], err: err}}
+		}
+
+		return [][]T{result}, nil
+	}
+
+	results := make([][]T, len(shards))
+	errs := make([]error, len(shards))
+	sem := make(chan struct{}, maxRegionFanOut)
+
+	var wg sync.WaitGroup
+	for i, shard := range shards {
+		wg.Go(func() {
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			results[i], errs[i] = list(ctx, shard.Repo)
+		})
+	}
+	wg.Wait()
+
+	var failures []shardError
+	for i, err := range errs {
+		if err != nil {
+			failures = append(failures, shardError{shard: shards[i], err: err})
+		}
+	}
+
+	return results, failures
+}
+
+// mergeRegionalSystems merges the pages of the shards into one page of at most limit systems.
+// The pages of all shards are selected with the same page token and in the same order,
+// so the merged page continues where the previous merged page ended.
+func mergeRegionalSystems(pages [][]model.RegionalSystem, limit int) []model.RegionalSystem {
+	merged := slices.Concat(pages...)
+	slices.SortStableFunc(merged, compareRegionalSystems)
+
+	if limit > 0 && len(merged) > limit {
+		merged = merged[:limit]
+	}
+
+	return merged
+}
+
+// compareRegionalSystems orders regional systems like the paginated queries:
+// descending by creation time, region and system ID.
+func compareRegionalSystems(a, b model.RegionalSystem) int {
+	if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+		return c
+	}
+
+	if c := strings.Compare(b.Region, a.Region); c != 0 {
+		return c
+	}
+
+	return bytes.Compare(b.SystemID.Bytes(), a.SystemID.Bytes())
+}
+
+// errorShardsUnavailable returns an Unavailable error with an ErrorInfo detail for each failed shard,
+// naming the shard and the regions it stores.
+// A page is never returned without the systems of a failed shard, as the page token of the merged page
+// would skip the systems of the failed shard in the following pages.
+func errorShardsUnavailable(ctx context.Context, failures []shardError) error {
+	sts := status.New(codes.Unavailable, "systems of some regions are unavailable, please try again")
+
+	for _, failure := range failures {
+		slogctx.Error(ctx, "failed to list systems of region shard", "shard", failure.shard.Name, "regions", failure.shard.Regions, "error", failure.err)
+
+		regions := "*"
+		if failure.shard.Regions != nil {
+			regions = strings.Join(failure.shard.Regions, filterValueSeparator)
+		}
+
+		withDetails, err := sts.WithDetails(&errdetails.ErrorInfo{
+			Reason: RegionUnavailableReason,
+			Domain: RegionErrorDomain,
+			Metadata: map[string]string{
+				"shard":   failure.shard.Name,
+				"regions": regions,
+				"code":    status.Code(mapError(failure.err)).String(),
+			},
+		})
+		if err != nil {
+			continue
+		}
+		sts = withDetails
+	}
+
+	return sts.Err()
+}
@@ -0,0 +1,65 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestMergeSystems(t *testing.T) {
+	now := time.Now()
+	id1 := uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000001"))
+	id2 := uuid.Must(uuid.FromString("00000000-0000-0000-0000-000000000002"))
+
+	newest := model.RegionalSystem{SystemID: id1, Region: "region-a", CreatedAt: now}
+	sameTimeRegionB := model.RegionalSystem{SystemID: id1, Region: "region-b", CreatedAt: now.Add(-time.Minute)}
+	sameTimeRegionA2 := model.RegionalSystem{SystemID: id2, Region: "region-a", CreatedAt: now.Add(-time.Minute)}
+	sameTimeRegionA1 := model.RegionalSystem{SystemID: id1, Region: "region-a", CreatedAt: now.Add(-time.Minute)}
+	oldest := model.RegionalSystem{SystemID: id2, Region: "region-b", CreatedAt: now.Add(-time.Hour)}
+
+	shardA := []model.RegionalSystem{newest, sameTimeRegionA2, sameTimeRegionA1}
+	shardB := []model.RegionalSystem{sameTimeRegionB, oldest}
+
+	t.Run("should order pages like the paginated query", func(t *testing.T) {
+		// when
+		result := service.MergeSystems([][]model.RegionalSystem{shardB, shardA}, 10)
+
+		// then
+		assert.Equal(t, []model.RegionalSystem{newest, sameTimeRegionB, sameTimeRegionA2, sameTimeRegionA1, oldest}, result)
+	})
+
+	t.Run("should cut the merged page at the limit", func(t *testing.T) {
+		// when
+		result := service.MergeSystems([][]model.RegionalSystem{shardA, shardB}, 2)
+
+		// then
+		assert.Equal(t, []model.RegionalSystem{newest, sameTimeRegionB}, result)
+	})
+
+	t.Run("should return an empty page without systems", func(t *testing.T) {
+		// when
+		result := service.MergeSystems([][]model.RegionalSystem{nil, {}}, 2)
+
+		// then
+		assert.Empty(t, result)
+	})
+}
+
+func TestSingleDatabaseRouter(t *testing.T) {
+	// given
+	router := service.NewSingleDatabaseRouter(nil)
+
+	// when
+	all := router.Route(nil)
+	filtered := router.Route([]string{"region-a", "region-b"})
+
+	// then
+	assert.Len(t, all, 1)
+	assert.Nil(t, all[0].Regions)
+	assert.Equal(t, all, filtered)
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// RegionUsage is the usage of a region with its configured soft limits.
+type RegionUsage struct {
+	Region string
+	// Tenants are the tenants of the region by status.
+	Tenants []model.InventoryCount
+	// Systems are the systems linked to the tenants of the region.
+	Systems int64
+	// RegionalSystems are the regional systems of the region by status.
+	RegionalSystems []model.InventoryCount
+	L1KeyClaims     int64
+	// Auths are the auths of the tenants of the region.
+	Auths      int64
+	SoftLimits config.RegionSoftLimits
+}
+
+// RegionUsages reports the usage of regions for capacity planning, counted on the live tables.
+// The usage is served on the admin service, see Admin.
+type RegionUsages struct {
+	repo      repository.Repository
+	cfg       config.RegionUsage
+	placement config.TenantPlacement
+}
+
+// NewRegionUsages creates and returns a new instance of RegionUsages.
+// The tenant capacities of the placement are reported as the soft limits of regions without a tenant limit.
+func NewRegionUsages(repo repository.Repository, cfg config.RegionUsage, placement config.TenantPlacement) *RegionUsages {
+	return &RegionUsages{
+		repo:      repo,
+		cfg:       cfg,
+		placement: placement,
+	}
+}
+
+// GetRegionUsage returns the number of tenants by status, systems, regional systems by status,
+// active L1 key claims and auths of the region along with its soft limits.
+func (u *RegionUsages) GetRegionUsage(ctx context.Context, region string) (*RegionUsage, error) {
+	slogctx.Debug(ctx, "GetRegionUsage called", "region", region)
+
+	if region == "" {
+		return nil, ErrRegionIsEmpty
+	}
+
+	usage, err := u.count(ctx, region)
+	if err != nil {
+		slogctx.Error(ctx, "failed to count the usage of the region", "region", region, "error", err)
+		return nil, ErrRegionUsage
+	}
+
+	usage.SoftLimits = u.cfg.SoftLimits[region]
+	if usage.SoftLimits.Tenants == 0 {
+		usage.SoftLimits.Tenants = u.placement.Capacity[region]
+	}
+
+	return usage, nil
+}
+
+func (u *RegionUsages) count(ctx context.Context, region string) (*RegionUsage, error) {
+	usage := &RegionUsage{Region: region}
+
+	err := u.repo.Aggregate(ctx, &usage.Tenants, *repository.QueryOf(model.TenantFields.Region.Eq(region)),
+		model.TenantFields.Status.Column())
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.repo.Aggregate(ctx, &usage.RegionalSystems, *repository.QueryOf(model.RegionalSystemFields.Region.Eq(region)),
+		model.RegionalSystemFields.Status.Column())
+	if err != nil {
+		return nil, err
+	}
+
+	claimsQuery := repository.QueryOf(
+		model.RegionalSystemFields.Region.Eq(region),
+		model.RegionalSystemFields.HasL1KeyClaim.Eq(true))
+
+	usage.L1KeyClaims, err = u.repo.Count(ctx, *claimsQuery)
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Systems, err = u.repo.Count(ctx, *ofTenantsInRegion(&model.System{}, region))
+	if err != nil {
+		return nil, err
+	}
+
+	usage.Auths, err = u.repo.Count(ctx, *ofTenantsInRegion(&model.Auth{}, region))
+	if err != nil {
+		return nil, err
+	}
+
+	return usage, nil
+}
+
+// ofTenantsInRegion returns the query of the resources whose tenant is in the region.
+func ofTenantsInRegion(resource repository.Resource, region string) *repository.Query {
+	query := repository.NewQuery(resource).Where(repository.NewCompositeKey().
+		Where(model.TenantFields.Region.Qualified(), region))
+	query.Joins = []repository.Join{{Resource: &model.Tenant{}, OnColumn: model.TenantFields.ID.Column(), Column: repository.TenantIDField}}
+
+	return query
+}
@@ -0,0 +1,22 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestGetRegionUsageWithoutRegion(t *testing.T) {
+	// given
+	subj := service.NewRegionUsages(nil, config.RegionUsage{}, config.TenantPlacement{})
+
+	// when
+	usage, err := subj.GetRegionUsage(t.Context(), "")
+
+	// then
+	assert.Nil(t, usage)
+	assert.ErrorIs(t, err, service.ErrRegionIsEmpty)
+}
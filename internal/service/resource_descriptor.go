@@ -0,0 +1,305 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"slices"
+	"strings"
+	"time"
+
+	"gorm.io/gorm/schema"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// Types of the described fields.
+const (
+	FieldTypeString     = "STRING"
+	FieldTypeBool       = "BOOL"
+	FieldTypeInteger    = "INTEGER"
+	FieldTypeBytes      = "BYTES"
+	FieldTypeUUID       = "UUID"
+	FieldTypeTimestamp  = "TIMESTAMP"
+	FieldTypeStringList = "STRING_LIST"
+	FieldTypeStringMap  = "STRING_MAP"
+	FieldTypeBoolMap    = "BOOL_MAP"
+	FieldTypeObject     = "OBJECT"
+	FieldTypeObjectList = "OBJECT_LIST"
+	FieldTypeObjectMap  = "OBJECT_MAP"
+)
+
+// Mutabilities of the described fields.
+const (
+	// FieldImmutable fields identify the resource and do not change once it is created.
+	FieldImmutable = "IMMUTABLE"
+	// FieldMutable fields are set by the clients and validated.
+	FieldMutable = "MUTABLE"
+	// FieldOutputOnly fields are set by the registry.
+	FieldOutputOnly = "OUTPUT_ONLY"
+)
+
+// Visibilities of the described fields.
+const (
+	FieldPublic = "PUBLIC"
+	// FieldRedacted fields are labels whose internal labels are hidden from callers which are not privileged,
+	// see config.LabelVisibility.
+	FieldRedacted = "REDACTED"
+)
+
+const (
+	// labelsFieldName is the name of the label fields of the models.
+	labelsFieldName = "Labels"
+	// uuidTypeName is the name of the UUID types stored as uuid.
+	uuidTypeName = "UUID"
+)
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// describedResource is a resource described by ResourceDescriptors, its type is the root of its validation IDs.
+type describedResource struct {
+	resourceType string
+	model        repository.Resource
+}
+
+var describedResources = []describedResource{
+	{resourceType: "Tenant", model: &model.Tenant{}},
+	{resourceType: "System", model: &model.System{}},
+	{resourceType: "RegionalSystem", model: &model.RegionalSystem{}},
+	{resourceType: "Auth", model: &model.Auth{}},
+}
+
+// ResourceDescriptor describes a resource as stored by this deployment.
+type ResourceDescriptor struct {
+	ResourceType string
+	Table        string
+	Fields       []FieldDescriptor
+}
+
+// FieldDescriptor describes a stored field of a resource, see the proto of the same name.
+type FieldDescriptor struct {
+	Name           string
+	ValidationID   validation.ID
+	Type           string
+	Optional       bool
+	Mutability     string
+	Visibility     string
+	HiddenPrefixes []string
+	Encrypted      bool
+	Validators     []ValidatorDescriptor
+	Fields         []FieldDescriptor
+}
+
+// ValidatorDescriptor is a constraint in effect for the validation ID.
+type ValidatorDescriptor struct {
+	ValidationID validation.ID
+	Constraint   validation.Constraint
+}
+
+// ResourceDescriptors describes the fields of the resources as stored by this deployment, with the validators
+// in effect and the visibility of their labels, so clients do not hardcode assumptions which drift from the config.
+// The fields are described by reflection on the models, the validators by the configured validations.
+type ResourceDescriptors struct {
+	validation *validation.Validation
+	visibility config.LabelVisibility
+}
+
+// NewResourceDescriptors creates and returns a new instance of ResourceDescriptors.
+func NewResourceDescriptors(validation *validation.Validation, visibility config.LabelVisibility) *ResourceDescriptors {
+	return &ResourceDescriptors{
+		validation: validation,
+		visibility: visibility,
+	}
+}
+
+// SchemaVersion returns the version of the configured validations the descriptors reflect.
+func (d *ResourceDescriptors) SchemaVersion() string {
+	return d.validation.SchemaVersion()
+}
+
+// Describe returns the descriptors of the resource types, or of all resources if no type is given.
+// It fails with ErrResourceTypeUnknown for types which are not described.
+func (d *ResourceDescriptors) Describe(ctx context.Context, resourceTypes []string) ([]ResourceDescriptor, error) {
+	slogctx.Debug(ctx, "Describe resources called", "resourceTypes", resourceTypes)
+
+	for _, resourceType := range resourceTypes {
+		if !slices.ContainsFunc(describedResources, func(r describedResource) bool {
+			return r.resourceType == resourceType
+		}) {
+			return nil, ErrorWithParams(ErrResourceTypeUnknown, "resourceType", resourceType)
+		}
+	}
+
+	descriptors := make([]ResourceDescriptor, 0, len(describedResources))
+	for _, resource := range describedResources {
+		if len(resourceTypes) > 0 && !slices.Contains(resourceTypes, resource.resourceType) {
+			continue
+		}
+
+		descriptors = append(descriptors, ResourceDescriptor{
+			ResourceType: resource.resourceType,
+			Table:        resource.model.TableName(),
+			Fields:       d.describeStruct(reflect.TypeOf(resource.model).Elem(), validation.ID(resource.resourceType), ""),
+		})
+	}
+
+	return descriptors, nil
+}
+
+// describeStruct describes the fields of the model, or of a nested struct stored as JSON if mutability is set,
+// which is then the mutability of its fields. Associations are not described, as they are stored on their own.
+func (d *ResourceDescriptors) describeStruct(t reflect.Type, parentID validation.ID, mutability string) []FieldDescriptor {
+	fields := make([]FieldDescriptor, 0, t.NumField())
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() || isAssociation(field) {
+			continue
+		}
+
+		fields = append(fields, d.describeField(field, parentID, mutability))
+	}
+
+	return fields
+}
+
+func (d *ResourceDescriptors) describeField(field reflect.StructField, parentID validation.ID, mutability string) FieldDescriptor {
+	descriptor := FieldDescriptor{
+		Visibility: FieldPublic,
+	}
+
+	if tag, ok := field.Tag.Lookup(validation.TagName); ok {
+		descriptor.ValidationID = parentID + "." + validation.ID(tag)
+	}
+
+	settings := schema.ParseTagSetting(field.Tag.Get("gorm"), ";")
+	if mutability == "" {
+		descriptor.Name = settings["COLUMN"]
+		if descriptor.Name == "" {
+			descriptor.Name = schema.NamingStrategy{}.ColumnName("", field.Name)
+		}
+		descriptor.Mutability = fieldMutability(settings, descriptor.ValidationID)
+		descriptor.Encrypted = settings["SERIALIZER"] == model.EncryptedSerializerName
+	} else {
+		descriptor.Name, _, _ = strings.Cut(field.Tag.Get("json"), ",")
+		if descriptor.Name == "" {
+			descriptor.Name = field.Name
+		}
+		descriptor.Mutability = mutability
+	}
+
+	fieldType := field.Type
+	if fieldType.Kind() == reflect.Pointer {
+		descriptor.Optional = true
+		fieldType = fieldType.Elem()
+	}
+	descriptor.Type = fieldTypeOf(fieldType)
+
+	switch descriptor.Type {
+	case FieldTypeObject:
+		descriptor.Fields = d.describeStruct(fieldType, descriptor.ValidationID, descriptor.Mutability)
+	case FieldTypeObjectList, FieldTypeObjectMap:
+		descriptor.Fields = d.describeStruct(fieldType.Elem(), descriptor.ValidationID, descriptor.Mutability)
+	}
+
+	if field.Name == labelsFieldName && descriptor.Type == FieldTypeStringMap && len(d.visibility.InternalPrefixes) > 0 {
+		descriptor.Visibility = FieldRedacted
+		descriptor.HiddenPrefixes = d.visibility.InternalPrefixes
+	}
+
+	if descriptor.ValidationID != "" {
+		descriptor.Validators = d.describeValidators(descriptor.ValidationID, descriptor.Fields != nil)
+	}
+
+	return descriptor
+}
+
+// describeValidators returns the validators in effect for the validation ID and, unless the nested fields
+// are described on their own, for the IDs nested below it, e.g. single labels. The validators are ordered by their ID.
+func (d *ResourceDescriptors) describeValidators(id validation.ID, nestedFields bool) []ValidatorDescriptor {
+	described := d.validation.Describe(id)
+
+	ids := make([]validation.ID, 0, len(described))
+	for describedID := range described {
+		if describedID == id || !nestedFields {
+			ids = append(ids, describedID)
+		}
+	}
+	slices.Sort(ids)
+
+	var validators []ValidatorDescriptor
+	for _, describedID := range ids {
+		for _, constraint := range described[describedID] {
+			validators = append(validators, ValidatorDescriptor{ValidationID: describedID, Constraint: constraint})
+		}
+	}
+
+	return validators
+}
+
+// fieldMutability returns the mutability of a column: the keys identify the resource, the validated fields
+// are set by clients and the other fields by the registry.
+func fieldMutability(settings map[string]string, validationID validation.ID) string {
+	_, primaryKey := settings["PRIMARYKEY"]
+	_, uniqueIndex := settings["UNIQUEINDEX"]
+
+	switch {
+	case primaryKey || uniqueIndex:
+		return FieldImmutable
+	case validationID != "":
+		return FieldMutable
+	default:
+		return FieldOutputOnly
+	}
+}
+
+// isAssociation returns true if the field references another model, e.g. the System of a RegionalSystem.
+func isAssociation(field reflect.StructField) bool {
+	settings := schema.ParseTagSetting(field.Tag.Get("gorm"), ";")
+	_, foreignKey := settings["FOREIGNKEY"]
+
+	return foreignKey || field.Tag.Get("gorm") == "-"
+}
+
+//nolint:cyclop
+func fieldTypeOf(t reflect.Type) string {
+	switch {
+	case t == timeType:
+		return FieldTypeTimestamp
+	case t.Name() == uuidTypeName && t.Kind() == reflect.Array:
+		return FieldTypeUUID
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return FieldTypeString
+	case reflect.Bool:
+		return FieldTypeBool
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return FieldTypeInteger
+	case reflect.Slice:
+		switch t.Elem().Kind() {
+		case reflect.Uint8:
+			return FieldTypeBytes
+		case reflect.Struct:
+			return FieldTypeObjectList
+		default:
+			return FieldTypeStringList
+		}
+	case reflect.Map:
+		switch t.Elem().Kind() {
+		case reflect.Bool:
+			return FieldTypeBoolMap
+		case reflect.Struct:
+			return FieldTypeObjectMap
+		default:
+			return FieldTypeStringMap
+		}
+	default:
+		return FieldTypeObject
+	}
+}
@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestResourceDescriptors(t *testing.T) {
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.Tenant{}, &model.Auth{}, &model.RegionalSystem{}, &model.System{}},
+		Fields: []validation.ConfigField{
+			{
+				ID:          "Tenant.Region",
+				Constraints: []validation.Constraint{{Type: validation.ConstraintTypeList, Spec: &validation.ConstraintSpec{AllowList: []string{"eu10"}}}},
+			},
+			{
+				ID:              "Tenant.Labels.team",
+				SkipIfNotExists: true,
+				Constraints:     []validation.Constraint{{Type: validation.ConstraintTypeNonEmpty}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	subj := service.NewResourceDescriptors(v, config.LabelVisibility{InternalPrefixes: []string{"routing."}})
+
+	fieldByName := func(fields []service.FieldDescriptor, name string) service.FieldDescriptor {
+		for _, field := range fields {
+			if field.Name == name {
+				return field
+			}
+		}
+		t.Fatalf("field %s is not described", name)
+		return service.FieldDescriptor{}
+	}
+
+	t.Run("should describe the fields of the tenant with the validators in effect", func(t *testing.T) {
+		// when
+		resources, err := subj.Describe(t.Context(), []string{"Tenant"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		tenant := resources[0]
+		assert.Equal(t, "tenants", tenant.Table)
+
+		id := fieldByName(tenant.Fields, "id")
+		assert.Equal(t, service.FieldTypeString, id.Type)
+		assert.Equal(t, service.FieldImmutable, id.Mutability)
+
+		region := fieldByName(tenant.Fields, "region")
+		assert.Equal(t, service.FieldMutable, region.Mutability)
+		assert.Equal(t, []service.ValidatorDescriptor{
+			{ValidationID: "Tenant.Region", Constraint: validation.Constraint{
+				Type: validation.ConstraintTypeList, Spec: &validation.ConstraintSpec{AllowList: []string{"eu10"}},
+			}},
+			{ValidationID: "Tenant.Region", Constraint: validation.Constraint{Type: validation.ConstraintTypeNonEmpty}},
+		}, region.Validators)
+
+		labels := fieldByName(tenant.Fields, "labels")
+		assert.Equal(t, service.FieldTypeStringMap, labels.Type)
+		assert.Equal(t, service.FieldRedacted, labels.Visibility)
+		assert.Equal(t, []string{"routing."}, labels.HiddenPrefixes)
+		assert.Contains(t, labels.Validators, service.ValidatorDescriptor{
+			ValidationID: "Tenant.Labels.team", Constraint: validation.Constraint{Type: validation.ConstraintTypeNonEmpty},
+		})
+
+		assert.True(t, fieldByName(tenant.Fields, "owner_id").Encrypted)
+		assert.Equal(t, service.FieldOutputOnly, fieldByName(tenant.Fields, "status").Mutability)
+		assert.Equal(t, service.FieldTypeTimestamp, fieldByName(tenant.Fields, "created_at").Type)
+
+		contacts := fieldByName(tenant.Fields, "contacts")
+		assert.Equal(t, service.FieldTypeObject, contacts.Type)
+		securityContact := fieldByName(contacts.Fields, "securityContact")
+		assert.Equal(t, validation.ID("Tenant.Contacts.SecurityContact"), securityContact.ValidationID)
+		assert.Equal(t, []service.ValidatorDescriptor{
+			{ValidationID: "Tenant.Contacts.SecurityContact", Constraint: validation.Constraint{Type: validation.ConstraintTypeEmail}},
+		}, securityContact.Validators)
+	})
+
+	t.Run("should describe the enum values of custom validators and skip associations", func(t *testing.T) {
+		// when
+		resources, err := subj.Describe(t.Context(), []string{"RegionalSystem"})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+		systemStatus := fieldByName(resources[0].Fields, "status")
+		require.Len(t, systemStatus.Validators, 1)
+		assert.Equal(t, validation.ConstraintTypeList, systemStatus.Validators[0].Constraint.Type)
+		assert.Contains(t, systemStatus.Validators[0].Constraint.Spec.AllowList, model.UnknownEnumValue)
+		assert.Equal(t, service.FieldTypeUUID, fieldByName(resources[0].Fields, "system_id").Type)
+		for _, field := range resources[0].Fields {
+			assert.NotEqual(t, "system", field.Name)
+		}
+	})
+
+	t.Run("should describe all resources without resource types", func(t *testing.T) {
+		// when
+		resources, err := subj.Describe(t.Context(), nil)
+
+		// then
+		require.NoError(t, err)
+		assert.Len(t, resources, 4)
+	})
+
+	t.Run("should fail for unknown resource types", func(t *testing.T) {
+		// when
+		resources, err := subj.Describe(t.Context(), []string{"Unknown"})
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Contains(t, err.Error(), "resourceType=Unknown")
+		assert.Nil(t, resources)
+	})
+}
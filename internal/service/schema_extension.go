@@ -0,0 +1,102 @@
+package service
+
+import (
+	"context"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// SchemaExtension implements the procedure calls on the schema defined in api/extension/v1/extension.proto,
+// which are not defined by api-sdk yet.
+type SchemaExtension struct {
+	extensiongrpc.UnimplementedSchemaServiceServer
+
+	descriptors *ResourceDescriptors
+}
+
+// NewSchemaExtension creates and returns a new instance of SchemaExtension.
+func NewSchemaExtension(descriptors *ResourceDescriptors) *SchemaExtension {
+	return &SchemaExtension{
+		descriptors: descriptors,
+	}
+}
+
+// GetResourceDescriptors returns the descriptors of the fields of the resources with the validators in effect.
+func (s *SchemaExtension) GetResourceDescriptors(ctx context.Context, in *extensiongrpc.GetResourceDescriptorsRequest) (*extensiongrpc.GetResourceDescriptorsResponse, error) {
+	resources, err := s.descriptors.Describe(ctx, in.GetResourceTypes())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.GetResourceDescriptorsResponse{
+		Resources:               make([]*extensiongrpc.ResourceDescriptor, 0, len(resources)),
+		ValidationSchemaVersion: s.descriptors.SchemaVersion(),
+	}
+	for _, resource := range resources {
+		resp.Resources = append(resp.Resources, &extensiongrpc.ResourceDescriptor{
+			ResourceType: resource.ResourceType,
+			Table:        resource.Table,
+			Fields:       fieldDescriptorsToProto(resource.Fields),
+		})
+	}
+
+	return resp, nil
+}
+
+func fieldDescriptorsToProto(fields []FieldDescriptor) []*extensiongrpc.FieldDescriptor {
+	if len(fields) == 0 {
+		return nil
+	}
+
+	res := make([]*extensiongrpc.FieldDescriptor, 0, len(fields))
+	for _, field := range fields {
+		validators := make([]*extensiongrpc.ValidatorDescriptor, 0, len(field.Validators))
+		for _, validator := range field.Validators {
+			validators = append(validators, constraintToProto(validator.ValidationID, validator.Constraint))
+		}
+
+		res = append(res, &extensiongrpc.FieldDescriptor{
+			Name:           field.Name,
+			ValidationId:   string(field.ValidationID),
+			Type:           field.Type,
+			Optional:       field.Optional,
+			Mutability:     field.Mutability,
+			Visibility:     field.Visibility,
+			HiddenPrefixes: field.HiddenPrefixes,
+			Encrypted:      field.Encrypted,
+			Validators:     validators,
+			Fields:         fieldDescriptorsToProto(field.Fields),
+		})
+	}
+
+	return res
+}
+
+func constraintToProto(id validation.ID, constraint validation.Constraint) *extensiongrpc.ValidatorDescriptor {
+	descriptor := &extensiongrpc.ValidatorDescriptor{
+		ValidationId: string(id),
+		Type:         constraint.Type,
+	}
+	if constraint.Spec == nil {
+		return descriptor
+	}
+
+	descriptor.AllowList = constraint.Spec.AllowList
+	descriptor.Pattern = constraint.Spec.Pattern
+	descriptor.MaxLength = int64(constraint.Spec.MaxLength)
+	for _, key := range constraint.Spec.Keys {
+		keyValidators := make([]*extensiongrpc.ValidatorDescriptor, 0, len(key.Constraints))
+		for _, keyConstraint := range key.Constraints {
+			keyValidators = append(keyValidators, constraintToProto("", keyConstraint))
+		}
+
+		descriptor.Keys = append(descriptor.Keys, &extensiongrpc.MapKeyDescriptor{
+			Name:       key.Name,
+			Required:   key.Required,
+			Validators: keyValidators,
+		})
+	}
+
+	return descriptor
+}
@@ -2,6 +2,8 @@ package service
 
 import (
 	"context"
+	"slices"
+	"strings"
 	"time"
 
 	"github.com/openkcm/registry/internal/model"
@@ -9,7 +11,12 @@ import (
 	"github.com/openkcm/registry/internal/validation"
 )
 
-const defaultTranTimeout = time.Second * 10
+const (
+	defaultTranTimeout = time.Second * 10
+
+	// filterValueSeparator separates multiple values of a list filter.
+	filterValueSeparator = ","
+)
 
 // assertTenantExist checks if a tenant exists in the database by tenant_id.
 // It returns an error if the tenant does not exist.
@@ -58,8 +65,16 @@ func getRegionalSystemsFromSystemID(ctx context.Context, r repository.Repository
 	return regionalSystems, nil
 }
 
-// checkRegionalSystemAvailable returns nil if System has status Available.
+// checkRegionalSystemAvailable returns nil if System has status Available and is not pending approval.
 func checkRegionalSystemAvailable(regionalSystem *model.RegionalSystem) error {
+	if regionalSystem.IsPendingApproval() {
+		return ErrSystemPendingApproval
+	}
+
+	if regionalSystem.IsDiscovered() {
+		return ErrSystemDiscovered
+	}
+
 	if !regionalSystem.IsAvailable() {
 		return ErrSystemUnavailable
 	}
@@ -73,7 +88,7 @@ func validateExternalIDAndType(v *validation.Validation, externalID, systemType
 		model.SystemTypeValidationID:       systemType,
 	})
 	if err != nil {
-		return ErrorWithParams(ErrValidationFailed, "err", err.Error())
+		return validationFailed(err)
 	}
 
 	return nil
@@ -88,7 +103,7 @@ func validateRegionalSystem(v *validation.Validation, regionalSystem *model.Regi
 
 	err = v.ValidateAll(values)
 	if err != nil {
-		return ErrorWithParams(ErrValidationFailed, "err", err.Error())
+		return validationFailed(err)
 	}
 
 	return nil
@@ -103,7 +118,7 @@ func validateSystem(v *validation.Validation, system *model.System) error {
 
 	err = v.ValidateAll(values)
 	if err != nil {
-		return ErrorWithParams(ErrValidationFailed, "err", err.Error())
+		return validationFailed(err)
 	}
 
 	return nil
@@ -132,5 +147,60 @@ func createSystem(ctx context.Context, v *validation.Validation, repo repository
 		return nil, err
 	}
 
+	if tenantID != "" {
+		if err := recordSystemLink(ctx, repo, system, tenantID); err != nil {
+			return nil, err
+		}
+	}
+
 	return system, nil
 }
+
+// whereFilterExpression adds the condition of the filter expression of an admin query to the query.
+func whereFilterExpression(query *repository.Query, filter string, fields repository.FilterFields) error {
+	keys, err := repository.ParseFilterExpression(filter, fields)
+	if err != nil {
+		return ErrorWithParams(ErrFilterExpression, "err", err.Error())
+	}
+
+	query.Where(keys...)
+
+	return nil
+}
+
+// filterValues splits a list filter into its values. Multiple values are separated by a comma
+// and are matched with IN semantics. Empty values are dropped and duplicates are removed.
+func filterValues(filter string) ([]string, error) {
+	values := make([]string, 0, 1)
+	for v := range strings.SplitSeq(filter, filterValueSeparator) {
+		v = strings.TrimSpace(v)
+		if v != "" && !slices.Contains(values, v) {
+			values = append(values, v)
+		}
+	}
+
+	if len(values) > repository.MaxFilterValues {
+		return nil, ErrTooManyFilterValues
+	}
+
+	return values, nil
+}
+
+// whereFilter adds the list filter to the condition. A single value results in an equality check,
+// multiple values in an IN clause.
+func whereFilter(cond repository.CompositeKey, field repository.QueryField, filter string) error {
+	values, err := filterValues(filter)
+	if err != nil {
+		return err
+	}
+
+	switch len(values) {
+	case 0:
+	case 1:
+		cond.Where(field, values[0])
+	default:
+		cond.Where(field, values)
+	}
+
+	return nil
+}
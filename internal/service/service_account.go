@@ -0,0 +1,259 @@
+package service
+
+import (
+	"context"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// ServiceAccount manages the service accounts of tenants, which the regional components of KMS authenticate as.
+// An auth references the service account it is applied for, see model.AuthServiceAccountProperty,
+// and a referenced service account can not be deleted.
+type ServiceAccount struct {
+	repo       repository.Repository
+	validation *validation.Validation
+}
+
+// NewServiceAccount creates and returns a new instance of ServiceAccount.
+func NewServiceAccount(repo repository.Repository, validation *validation.Validation) *ServiceAccount {
+	return &ServiceAccount{
+		repo:       repo,
+		validation: validation,
+	}
+}
+
+// CreateServiceAccount adds the service account to an existing tenant. It is active unless another status is given.
+func (s *ServiceAccount) CreateServiceAccount(ctx context.Context, account *model.ServiceAccount) error {
+	slogctx.Debug(ctx, "CreateServiceAccount called", "tenantId", account.TenantID, "serviceAccountId", account.ID)
+
+	if account.Status == "" {
+		account.Status = model.ServiceAccountStatusActive
+	}
+
+	if err := s.validateServiceAccount(account); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		if _, err := getTenant(ctx, r, account.TenantID); err != nil {
+			return err
+		}
+
+		err := r.Create(ctx, account)
+		if isUniqueConstraintError(err) {
+			return ErrorAlreadyExists(ResourceTypeServiceAccount, account.ID)
+		}
+		if err != nil {
+			return ErrServiceAccountCreate
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// GetServiceAccount returns the service account identified by its tenant and ID.
+func (s *ServiceAccount) GetServiceAccount(ctx context.Context, tenantID, id string) (*model.ServiceAccount, error) {
+	slogctx.Debug(ctx, "GetServiceAccount called", "tenantId", tenantID, "serviceAccountId", id)
+
+	if err := s.validateServiceAccountKey(tenantID, id); err != nil {
+		return nil, err
+	}
+
+	return getServiceAccount(ctx, s.repo, tenantID, id)
+}
+
+// ListServiceAccounts returns all service accounts of the tenant.
+func (s *ServiceAccount) ListServiceAccounts(ctx context.Context, tenantID string) ([]model.ServiceAccount, error) {
+	slogctx.Debug(ctx, "ListServiceAccounts called", "tenantId", tenantID)
+
+	if tenantID == "" {
+		return nil, ErrNoTenantID
+	}
+
+	var accounts []model.ServiceAccount
+	if err := s.repo.List(ctx, &accounts, *repository.QueryOf(model.ServiceAccountFields.TenantID.Eq(tenantID))); err != nil {
+		return nil, ErrServiceAccountSelect
+	}
+
+	return accounts, nil
+}
+
+// UpdateServiceAccount updates the purpose and status of the service account. Empty values keep the current values.
+func (s *ServiceAccount) UpdateServiceAccount(ctx context.Context, tenantID, id, purpose, status string) error {
+	slogctx.Debug(ctx, "UpdateServiceAccount called", "tenantId", tenantID, "serviceAccountId", id, "status", status)
+
+	update := &model.ServiceAccount{
+		ID:       id,
+		TenantID: tenantID,
+		Purpose:  purpose,
+		Status:   status,
+	}
+
+	values := map[validation.ID]any{
+		model.ServiceAccountTenantIDValidationID: tenantID,
+		model.ServiceAccountIDValidationID:       id,
+		model.ServiceAccountPurposeValidationID:  purpose,
+	}
+	if status != "" {
+		values[model.ServiceAccountStatusValidationID] = status
+	}
+
+	if err := s.validation.ValidateAll(values); err != nil {
+		return validationFailed(err)
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		if _, err := getServiceAccount(ctx, r, tenantID, id); err != nil {
+			return err
+		}
+
+		isPatched, err := r.Patch(ctx, update)
+		if err != nil || !isPatched {
+			return ErrServiceAccountUpdate
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// DeleteServiceAccount deletes the service account. It fails if an auth of the tenant, which is not removed,
+// references the service account.
+func (s *ServiceAccount) DeleteServiceAccount(ctx context.Context, tenantID, id string) error {
+	slogctx.Debug(ctx, "DeleteServiceAccount called", "tenantId", tenantID, "serviceAccountId", id)
+
+	if err := s.validateServiceAccountKey(tenantID, id); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		account, err := getServiceAccount(ctx, r, tenantID, id)
+		if err != nil {
+			return err
+		}
+
+		var auths []model.Auth
+		err = r.List(ctx, &auths, *repository.QueryOf(
+			model.AuthFields.TenantID.Eq(tenantID),
+			model.AuthFields.ServiceAccountID.Eq(id),
+			model.AuthFields.Status.Ne(authgrpc.AuthStatus_AUTH_STATUS_REMOVED.String())).
+			SetLimit(1))
+		if err != nil {
+			return ErrAuthSelect
+		}
+
+		if len(auths) > 0 {
+			return ErrorWithParams(ErrServiceAccountInUse, "serviceAccountId", id, "authExternalID", auths[0].ExternalID)
+		}
+
+		if _, err := r.Delete(ctx, account); err != nil {
+			return ErrServiceAccountDelete
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// CheckServiceAccount returns whether the service account exists, belongs to the tenant and is active,
+// and its status. A service account which does not exist or belongs to another tenant is inactive without status,
+// so the existence of the service accounts of other tenants is not revealed.
+func (s *ServiceAccount) CheckServiceAccount(ctx context.Context, tenantID, id string) (bool, string, error) {
+	slogctx.Debug(ctx, "CheckServiceAccount called", "tenantId", tenantID, "serviceAccountId", id)
+
+	if err := s.validateServiceAccountKey(tenantID, id); err != nil {
+		return false, "", err
+	}
+
+	account := &model.ServiceAccount{ID: id}
+
+	found, err := s.repo.Find(ctx, account)
+	if err != nil {
+		return false, "", ErrServiceAccountSelect
+	}
+
+	if !found || account.TenantID != tenantID {
+		return false, "", nil
+	}
+
+	return account.IsActiveFor(tenantID), account.Status, nil
+}
+
+func (s *ServiceAccount) validateServiceAccount(account *model.ServiceAccount) error {
+	values, err := validation.GetValues(account)
+	if err != nil {
+		return ErrValidationConversion
+	}
+
+	if err := s.validation.ValidateAll(values); err != nil {
+		return validationFailed(err)
+	}
+
+	return nil
+}
+
+func (s *ServiceAccount) validateServiceAccountKey(tenantID, id string) error {
+	err := s.validation.ValidateAll(map[validation.ID]any{
+		model.ServiceAccountTenantIDValidationID: tenantID,
+		model.ServiceAccountIDValidationID:       id,
+	})
+	if err != nil {
+		return validationFailed(err)
+	}
+
+	return nil
+}
+
+// checkServiceAccountActive returns ErrServiceAccountInactive unless the service account with the ID, if any,
+// belongs to the tenant and is active. An auth without service account passes.
+func checkServiceAccountActive(ctx context.Context, r repository.Repository, tenantID, id string) error {
+	if id == "" {
+		return nil
+	}
+
+	account, err := getServiceAccount(ctx, r, tenantID, id)
+	if err != nil {
+		return err
+	}
+
+	if !account.IsActiveFor(tenantID) {
+		return ErrorWithParams(ErrServiceAccountInactive, "serviceAccountId", id, "status", account.Status)
+	}
+
+	return nil
+}
+
+// getServiceAccount fetches the service account by its ID. A service account of another tenant is not found.
+func getServiceAccount(ctx context.Context, r repository.Repository, tenantID, id string) (*model.ServiceAccount, error) {
+	account := &model.ServiceAccount{ID: id}
+
+	found, err := r.Find(ctx, account)
+	if err != nil {
+		return nil, ErrServiceAccountSelect
+	}
+
+	if !found || account.TenantID != tenantID {
+		return nil, ErrorWithParams(ErrServiceAccountNotFound, "tenantID", tenantID, "serviceAccountId", id)
+	}
+
+	return account, nil
+}
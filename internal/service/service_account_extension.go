@@ -0,0 +1,108 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/model"
+)
+
+// ServiceAccountExtension implements the procedure calls on service accounts defined in api/extension/v1/extension.proto,
+// which are not defined by api-sdk yet.
+type ServiceAccountExtension struct {
+	extensiongrpc.UnimplementedServiceAccountServiceServer
+
+	accounts *ServiceAccount
+}
+
+// NewServiceAccountExtension creates and returns a new instance of ServiceAccountExtension.
+func NewServiceAccountExtension(accounts *ServiceAccount) *ServiceAccountExtension {
+	return &ServiceAccountExtension{
+		accounts: accounts,
+	}
+}
+
+// CreateServiceAccount adds the service account to an existing tenant, it is ACTIVE unless another status is given.
+func (e *ServiceAccountExtension) CreateServiceAccount(ctx context.Context, in *extensiongrpc.CreateServiceAccountRequest) (*extensiongrpc.CreateServiceAccountResponse, error) {
+	err := e.accounts.CreateServiceAccount(ctx, &model.ServiceAccount{
+		ID:       in.GetId(),
+		TenantID: in.GetTenantId(),
+		Purpose:  in.GetPurpose(),
+		Status:   in.GetStatus(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.CreateServiceAccountResponse{Success: true}, nil
+}
+
+// GetServiceAccount returns the service account identified by its tenant and ID.
+func (e *ServiceAccountExtension) GetServiceAccount(ctx context.Context, in *extensiongrpc.GetServiceAccountRequest) (*extensiongrpc.GetServiceAccountResponse, error) {
+	account, err := e.accounts.GetServiceAccount(ctx, in.GetTenantId(), in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.GetServiceAccountResponse{ServiceAccount: serviceAccountToProto(account)}, nil
+}
+
+// ListServiceAccounts returns the service accounts of the tenant.
+func (e *ServiceAccountExtension) ListServiceAccounts(ctx context.Context, in *extensiongrpc.ListServiceAccountsRequest) (*extensiongrpc.ListServiceAccountsResponse, error) {
+	accounts, err := e.accounts.ListServiceAccounts(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.ListServiceAccountsResponse{
+		ServiceAccounts: make([]*extensiongrpc.ServiceAccount, 0, len(accounts)),
+	}
+	for _, account := range accounts {
+		resp.ServiceAccounts = append(resp.ServiceAccounts, serviceAccountToProto(&account))
+	}
+
+	return resp, nil
+}
+
+// UpdateServiceAccount updates the purpose and status of the service account. Empty values keep the current values.
+func (e *ServiceAccountExtension) UpdateServiceAccount(ctx context.Context, in *extensiongrpc.UpdateServiceAccountRequest) (*extensiongrpc.UpdateServiceAccountResponse, error) {
+	err := e.accounts.UpdateServiceAccount(ctx, in.GetTenantId(), in.GetId(), in.GetPurpose(), in.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.UpdateServiceAccountResponse{Success: true}, nil
+}
+
+// DeleteServiceAccount deletes the service account, unless it is referenced by an auth of the tenant.
+func (e *ServiceAccountExtension) DeleteServiceAccount(ctx context.Context, in *extensiongrpc.DeleteServiceAccountRequest) (*extensiongrpc.DeleteServiceAccountResponse, error) {
+	err := e.accounts.DeleteServiceAccount(ctx, in.GetTenantId(), in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.DeleteServiceAccountResponse{Success: true}, nil
+}
+
+// CheckServiceAccount returns whether the service account exists, belongs to the tenant and is ACTIVE.
+func (e *ServiceAccountExtension) CheckServiceAccount(ctx context.Context, in *extensiongrpc.CheckServiceAccountRequest) (*extensiongrpc.CheckServiceAccountResponse, error) {
+	active, status, err := e.accounts.CheckServiceAccount(ctx, in.GetTenantId(), in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.CheckServiceAccountResponse{Active: active, Status: status}, nil
+}
+
+func serviceAccountToProto(account *model.ServiceAccount) *extensiongrpc.ServiceAccount {
+	return &extensiongrpc.ServiceAccount{
+		Id:        account.ID,
+		TenantId:  account.TenantID,
+		Purpose:   account.Purpose,
+		Status:    account.Status,
+		UpdatedAt: timestamppb.New(account.UpdatedAt),
+		CreatedAt: timestamppb.New(account.CreatedAt),
+	}
+}
@@ -0,0 +1,106 @@
+package service_test
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestFilterValues(t *testing.T) {
+	tests := []struct {
+		name      string
+		filter    string
+		expValues []string
+		expErr    error
+	}{
+		{
+			name:      "empty filter",
+			filter:    "",
+			expValues: []string{},
+		},
+		{
+			name:      "single value",
+			filter:    "eu01",
+			expValues: []string{"eu01"},
+		},
+		{
+			name:      "multiple values are trimmed and deduplicated",
+			filter:    "eu01, eu02,,eu01 ",
+			expValues: []string{"eu01", "eu02"},
+		},
+		{
+			name:   "too many values",
+			filter: manyFilterValues(repository.MaxFilterValues + 1),
+			expErr: service.ErrTooManyFilterValues,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			values, err := service.FilterValues(tt.filter)
+
+			// then
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expValues, values)
+		})
+	}
+}
+
+func manyFilterValues(n int) string {
+	values := make([]string, n)
+	for i := range values {
+		values[i] = "value-" + strconv.Itoa(i)
+	}
+
+	return strings.Join(values, ",")
+}
+
+func TestQueryInvalidFilterExpression(t *testing.T) {
+	for _, filter := range []string{"", "owner_id=x", "status=ACTIVE OR", "labels.env IN ()"} {
+		t.Run(filter, func(t *testing.T) {
+			// when
+			_, tenantErr := (&service.Tenant{}).QueryTenants(t.Context(), filter, 0, "")
+			_, systemErr := (&service.System{}).QuerySystems(t.Context(), filter, 0, "")
+
+			// then
+			assert.Equal(t, codes.InvalidArgument, status.Code(tenantErr))
+			assert.Equal(t, codes.InvalidArgument, status.Code(systemErr))
+		})
+	}
+}
+
+func TestListSystemsAsOfInvalidRequest(t *testing.T) {
+	tests := []struct {
+		name     string
+		tenantID string
+		asOf     time.Time
+		expErr   error
+	}{
+		{name: "empty tenant ID", tenantID: "", asOf: time.Now().Add(-time.Hour), expErr: service.ErrTenantIDIsEmpty},
+		{name: "zero time", tenantID: "tenant", asOf: time.Time{}, expErr: service.ErrAsOfInvalid},
+		{name: "future time", tenantID: "tenant", asOf: time.Now().Add(time.Hour), expErr: service.ErrAsOfInvalid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := (&service.System{}).ListSystemsAsOf(t.Context(), tt.tenantID, tt.asOf)
+
+			// then
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+var ErrStartupBudgetExhausted = errors.New("startup budget exhausted")
+
+// StartupStep is a dependency of a starting instance, e.g. the database connection.
+// The context of an attempt is done once the attempt returned, so Run must not keep it, e.g. in a created client.
+type StartupStep struct {
+	Name    string
+	Timeout time.Duration
+	Run     func(ctx context.Context) error
+}
+
+// Startup starts the dependencies of an instance one after another, so each dependency is only started once
+// the dependencies it requires are available. A failing step is retried with a jittered backoff doubling with
+// each attempt, so a briefly unavailable dependency, e.g. during a cold start of the cluster, does not crash
+// the instance. The steps share the budget of the startup.
+type Startup struct {
+	cfg      config.Startup
+	deadline time.Time
+}
+
+// NewStartup creates and returns a new instance of Startup, whose budget starts now.
+func NewStartup(cfg config.Startup) *Startup {
+	return &Startup{
+		cfg:      cfg,
+		deadline: time.Now().Add(cfg.Budget),
+	}
+}
+
+// Run runs the step until it succeeds, each attempt within the timeout of the step. It returns the error of the
+// last attempt wrapped by ErrStartupBudgetExhausted once a retry would exceed the budget, and the error of ctx
+// once it is done.
+func (s *Startup) Run(ctx context.Context, step StartupStep) error {
+	start := time.Now()
+
+	for attempt := 1; ; attempt++ {
+		slogctx.Info(ctx, "starting dependency", "dependency", step.Name, "attempt", attempt)
+
+		err := s.attempt(ctx, step)
+		if err == nil {
+			slogctx.Info(ctx, "dependency started", "dependency", step.Name, "attempt", attempt, "duration", time.Since(start))
+			return nil
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		backoff := s.backoff(attempt)
+		if time.Now().Add(backoff).After(s.deadline) {
+			return fmt.Errorf("%w: %s failed after %d attempts: %w", ErrStartupBudgetExhausted, step.Name, attempt, err)
+		}
+
+		slogctx.Warn(ctx, "dependency failed to start, retrying",
+			"dependency", step.Name, "attempt", attempt, "backoff", backoff, "error", err)
+
+		if err := sleep(ctx, backoff); err != nil {
+			return err
+		}
+	}
+}
+
+// attempt runs the step once within its timeout, if any.
+func (s *Startup) attempt(ctx context.Context, step StartupStep) error {
+	if step.Timeout <= 0 {
+		return step.Run(ctx)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, step.Timeout)
+	defer cancel()
+
+	return step.Run(ctx)
+}
+
+// backoff returns the jittered backoff before the retry following the attempt.
+// The backoff doubles with each attempt up to the maximum, half of it is random.
+func (s *Startup) backoff(attempt int) time.Duration {
+	backoff := s.cfg.InitialBackoff << (attempt - 1)
+	if backoff > s.cfg.MaxBackoff || backoff <= 0 {
+		backoff = s.cfg.MaxBackoff
+	}
+
+	if backoff < 2 {
+		return backoff
+	}
+
+	return backoff/2 + rand.N(backoff/2)
+}
+
+// sleep blocks for the duration or until ctx is done, whose error it returns.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,85 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestStartup(t *testing.T) {
+	retries := config.Startup{Budget: time.Second, InitialBackoff: time.Millisecond, MaxBackoff: 4 * time.Millisecond}
+
+	t.Run("should retry the step until it succeeds", func(t *testing.T) {
+		// given
+		subj := service.NewStartup(retries)
+		attempts := 0
+
+		// when
+		err := subj.Run(t.Context(), service.StartupStep{Name: "database", Run: func(context.Context) error {
+			attempts++
+			if attempts < 3 {
+				return assert.AnError
+			}
+			return nil
+		}})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 3, attempts)
+	})
+
+	t.Run("should fail once the budget is exhausted", func(t *testing.T) {
+		// given
+		subj := service.NewStartup(config.Startup{Budget: 20 * time.Millisecond, InitialBackoff: 5 * time.Millisecond, MaxBackoff: 5 * time.Millisecond})
+
+		// when
+		err := subj.Run(t.Context(), service.StartupStep{Name: "database", Run: func(context.Context) error {
+			return assert.AnError
+		}})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrStartupBudgetExhausted)
+		assert.ErrorIs(t, err, assert.AnError)
+	})
+
+	t.Run("should not retry without budget", func(t *testing.T) {
+		// given
+		subj := service.NewStartup(config.Startup{})
+		attempts := 0
+
+		// when
+		err := subj.Run(t.Context(), service.StartupStep{Name: "database", Run: func(context.Context) error {
+			attempts++
+			return assert.AnError
+		}})
+
+		// then
+		assert.ErrorIs(t, err, service.ErrStartupBudgetExhausted)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("should bound each attempt by the timeout of the step", func(t *testing.T) {
+		// given
+		subj := service.NewStartup(retries)
+		attempts := 0
+
+		// when
+		err := subj.Run(t.Context(), service.StartupStep{Name: "orbital", Timeout: time.Millisecond, Run: func(ctx context.Context) error {
+			attempts++
+			if attempts == 1 {
+				<-ctx.Done()
+				return ctx.Err()
+			}
+			return nil
+		}})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+	})
+}
@@ -3,21 +3,36 @@ package service
 import (
 	"context"
 	"errors"
-	"fmt"
 	"log/slog"
 	"maps"
 	"slices"
+	"time"
 
 	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
 	slogctx "github.com/veqryn/slog-context"
-	grpccodes "google.golang.org/grpc/codes"
-	grpcstatus "google.golang.org/grpc/status"
 
 	"github.com/openkcm/registry/internal/model"
 	"github.com/openkcm/registry/internal/repository"
 	"github.com/openkcm/registry/internal/validation"
 )
 
+// systemFilterFields are the fields of the filter expressions of QuerySystems.
+var systemFilterFields = repository.FilterFields{
+	"external_id":      {Column: model.SystemFields.ExternalID.Qualified()},
+	"type":             {Column: model.SystemFields.Type.Qualified()},
+	"tenant_id":        {Column: model.SystemFields.TenantID.Qualified()},
+	"region":           {Column: model.RegionalSystemFields.Region.Qualified()},
+	"status":           {Column: model.RegionalSystemFields.Status.Qualified()},
+	"approval_status":  {Column: model.RegionalSystemFields.ApprovalStatus.Qualified()},
+	"l2_key_id":        {Column: model.RegionalSystemFields.L2KeyID.Qualified()},
+	"environment":      {Column: model.SystemFields.Environment.Qualified()},
+	"criticality":      {Column: model.SystemFields.Criticality.Qualified()},
+	"labels":           {Column: "regional_systems.labels", Labels: true},
+	"created_by":       {Column: model.SystemFields.CreatedBy.Qualified()},
+	"last_modified_by": {Column: model.RegionalSystemFields.LastModifiedBy.Qualified()},
+	"display_name":     {Column: model.SystemFields.DisplayName.Qualified()},
+}
+
 // System implements the procedure calls defined as protobufs.
 // See https://github.com/openkcm/api-sdk/blob/main/proto/kms/api/cmk/registry/system/v1/system.proto.
 type System struct {
@@ -26,14 +41,29 @@ type System struct {
 	repo       repository.Repository
 	meters     *Meters
 	validation *validation.Validation
+	approval   *SystemApproval
+	legacy     *LegacyRequests
+	enums      *EnumValues
+	labels     *Labels
+	regions    RegionRouter
+	statuses   *SystemStatuses
+	l2Keys     L2KeyPolicy
 }
 
 // NewSystem creates and return a new instance of System.
-func NewSystem(repo repository.Repository, meters *Meters, validation *validation.Validation) *System {
+// The L2 key IDs of the regional systems are checked by the L2 key policy unless it is nil.
+func NewSystem(repo repository.Repository, meters *Meters, validation *validation.Validation, approval *SystemApproval, legacy *LegacyRequests, enums *EnumValues, labels *Labels, statuses *SystemStatuses, l2Keys L2KeyPolicy) *System {
 	return &System{
 		repo:       repo,
 		meters:     meters,
 		validation: validation,
+		approval:   approval,
+		legacy:     legacy,
+		enums:      enums,
+		labels:     labels,
+		regions:    NewSingleDatabaseRouter(repo),
+		statuses:   statuses,
+		l2Keys:     l2Keys,
 	}
 }
 
@@ -43,15 +73,8 @@ func NewSystem(repo repository.Repository, meters *Meters, validation *validatio
 func (s *System) RegisterSystem(ctx context.Context, in *systemgrpc.RegisterSystemRequest) (*systemgrpc.RegisterSystemResponse, error) {
 	slogctx.Debug(ctx, "RegisterSystem called", "externalId", in.GetExternalId(), "region", in.GetRegion(), "tenantId", in.GetTenantId(), "systemType", in.GetType(), "status", in.GetStatus().String())
 
-	regionalSystem := &model.RegionalSystem{
-		L2KeyID:       in.GetL2KeyId(),
-		HasL1KeyClaim: &in.HasL1KeyClaim,
-		Status:        in.GetStatus().String(),
-		Region:        in.GetRegion(),
-		Labels:        in.GetLabels(),
-	}
-
-	if err := validateRegionalSystem(s.validation, regionalSystem); err != nil {
+	regionalSystem, err := s.newRegionalSystem(ctx, in)
+	if err != nil {
 		slogctx.Warn(ctx, "validation failed for RegisterSystem request", "error", err)
 		return nil, err
 	}
@@ -61,8 +84,20 @@ func (s *System) RegisterSystem(ctx context.Context, in *systemgrpc.RegisterSyst
 	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
 	defer cancel()
 
+	var system *model.System
+	var reused []Warning
 	if err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
-		system, found, err := getSystem(ctx, r, in.GetExternalId(), in.GetType())
+		var found bool
+		var err error
+
+		// Concurrent registrations of the same system are serialized, as the system may not exist yet,
+		// so the first one to commit registers the regional system and the others see it.
+		if err := r.AdvisoryLock(ctx, registrationLockKey(in.GetExternalId(), in.GetType())); err != nil {
+			slogctx.Error(ctx, "failed to lock system registration", "error", err)
+			return ErrSystemSelect
+		}
+
+		system, found, err = getSystem(ctx, r, in.GetExternalId(), in.GetType())
 		if err != nil {
 			return ErrSystemSelect
 		}
@@ -76,14 +111,32 @@ func (s *System) RegisterSystem(ctx context.Context, in *systemgrpc.RegisterSyst
 			if err != nil {
 				return err
 			}
+		} else {
+			if err := checkRegisteredRegionalSystem(ctx, r, system, regionalSystem, in); err != nil {
+				return err
+			}
+
+			reused, err = reusedSystemWarnings(ctx, r, system, tenantID, regionalSystem.Labels)
+			if err != nil {
+				return err
+			}
 		}
 
 		regionalSystem.SystemID = system.ID
 
-		return r.Create(ctx, regionalSystem)
+		regionalSystem.Labels, err = s.labels.withInherited(ctx, r, system, regionalSystem.Labels)
+		if err != nil {
+			return err
+		}
+
+		if err := r.Create(ctx, regionalSystem); err != nil {
+			return err
+		}
+
+		return recordL2Key(ctx, r, regionalSystem, time.Now())
 	}); err != nil {
-		if _, ok := errors.AsType[*repository.UniqueConstraintError](err); ok {
-			return nil, grpcstatus.Error(grpccodes.AlreadyExists, "system already exists")
+		if isUniqueConstraintError(err) {
+			return nil, ErrorAlreadyExists(ResourceTypeSystem, in.GetType()+"/"+in.GetExternalId()+"/"+in.GetRegion())
 		}
 
 		return nil, err
@@ -91,18 +144,134 @@ func (s *System) RegisterSystem(ctx context.Context, in *systemgrpc.RegisterSyst
 
 	s.meters.handleSystemRegistration(ctx, regionalSystem.Region)
 
+	for _, w := range reused {
+		AddWarning(ctx, w.Code, w.Message)
+	}
+
+	if regionalSystem.IsPendingApproval() {
+		s.approval.notify(ctx, system, regionalSystem)
+	}
+
 	return &systemgrpc.RegisterSystemResponse{
 		Success: true,
 	}, nil
 }
 
+// registrationLockKey returns the key of the advisory lock serializing the registrations of the system.
+func registrationLockKey(externalID, systemType string) string {
+	return "system-registration/" + systemKey(externalID, systemType)
+}
+
+// checkRegisteredRegionalSystem returns an error if the regional system of the request is already registered:
+// ErrRegionalSystemL2KeyConflict if it is registered with a different L2 key, otherwise an AlreadyExists error.
+func checkRegisteredRegionalSystem(ctx context.Context, r repository.Repository, system *model.System, regionalSystem *model.RegionalSystem, in *systemgrpc.RegisterSystemRequest) error {
+	registered := &model.RegionalSystem{SystemID: system.ID, Region: regionalSystem.Region}
+
+	found, err := r.Find(ctx, registered)
+	if err != nil {
+		return ErrSystemSelect
+	}
+
+	if !found {
+		return nil
+	}
+
+	if registered.L2KeyID != regionalSystem.L2KeyID {
+		slogctx.Warn(ctx, "regional system is already registered with a different L2 key",
+			"externalId", in.GetExternalId(), "region", in.GetRegion(), "systemType", in.GetType())
+		return ErrorWithParams(ErrRegionalSystemL2KeyConflict, "region", in.GetRegion())
+	}
+
+	return ErrorAlreadyExists(ResourceTypeSystem, regionalSystemKey(in))
+}
+
+// newRegionalSystem validates the registration request and returns the regional system it registers.
+func (s *System) newRegionalSystem(ctx context.Context, in *systemgrpc.RegisterSystemRequest) (*model.RegionalSystem, error) {
+	status, err := s.enums.resolve(ctx, "status", in.GetStatus())
+	if err != nil {
+		return nil, err
+	}
+
+	hasL1KeyClaim := in.GetHasL1KeyClaim()
+	regionalSystem := &model.RegionalSystem{
+		L2KeyID:       in.GetL2KeyId(),
+		HasL1KeyClaim: &hasL1KeyClaim,
+		Status:        status,
+		Region:        in.GetRegion(),
+		Labels:        s.labels.systemDefaults(in.GetLabels()),
+	}
+
+	if err := validateRegionalSystem(s.validation, regionalSystem); err != nil {
+		return nil, err
+	}
+
+	if err := s.checkL2Key(ctx, regionalSystem.Region, regionalSystem.L2KeyID); err != nil {
+		return nil, err
+	}
+
+	if err := s.labels.checkLimits(regionalSystem.Labels); err != nil {
+		return nil, err
+	}
+
+	if s.approval.requiresApproval(in.GetType()) {
+		regionalSystem.ApprovalStatus = model.ApprovalStatusPending
+	}
+
+	if hasL1KeyClaim {
+		regionalSystem.RecordL1KeyClaim(time.Now(), repository.CallerFromContext(ctx), in.GetTenantId())
+	}
+
+	return regionalSystem, nil
+}
+
+// reusedSystemWarnings returns the warnings of registering a regional system of an existing system:
+// the tenant ID of the request is ignored if the system is not linked, and the other regional systems
+// may have a different label set.
+func reusedSystemWarnings(ctx context.Context, r repository.Repository, system *model.System, tenantID string, labels map[string]string) ([]Warning, error) {
+	var reused []Warning
+
+	if tenantID != "" && !system.IsLinkedToTenant() {
+		reused = append(reused, Warning{
+			Code:    WarningTenantIDIgnored,
+			Message: "the system is already registered without tenant, link it by MapSystemToTenant",
+		})
+	}
+
+	regionalSystems, err := getRegionalSystemsFromSystemID(ctx, r, system.ID.String())
+	if err != nil {
+		return nil, err
+	}
+
+	for _, rs := range regionalSystems {
+		if !maps.Equal(rs.Labels, labels) {
+			reused = append(reused, Warning{
+				Code:    WarningSystemLabelsDiffer,
+				Message: "the system is already registered in region " + rs.Region + " with a different label set",
+			})
+
+			break
+		}
+	}
+
+	return reused, nil
+}
+
 // ListSystems retrieves a list of Systems based on optional query parameters such as tenant_id. region and external_id
 // To retrieve sSystems one of tenant_id or a combination of region and external_id must be provided.
+// Region and type accept multiple comma separated values, matching any of them.
+// The systems are listed from every region shard storing the requested regions and merged into one page,
+// if any shard fails the call fails with the failed shards as details.
 //
 //nolint:cyclop
 func (s *System) ListSystems(ctx context.Context, in *systemgrpc.ListSystemsRequest) (*systemgrpc.ListSystemsResponse, error) {
 	slogctx.Debug(ctx, "ListSystems called", "externalId", in.GetExternalId(), "region", in.GetRegion(), "tenantId", in.GetTenantId())
 
+	start := time.Now()
+	listed := 0
+	defer func() {
+		s.meters.handleListSystems(ctx, listed, time.Since(start))
+	}()
+
 	if in.GetExternalId() == "" && in.GetTenantId() == "" {
 		return nil, ErrSystemListNotAllowed
 	}
@@ -116,56 +285,93 @@ func (s *System) ListSystems(ctx context.Context, in *systemgrpc.ListSystemsRequ
 
 	cond := repository.NewCompositeKey()
 
-	system := &model.System{}
-	regionalSystem := &model.RegionalSystem{}
-
-	query.Joins = []repository.Join{
-		{
-			Resource: system,
-			OnColumn: repository.IDField,
-			Column:   repository.SystemIDField,
-		},
-	}
+	query.Joins = []repository.Join{repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)}
 
 	if in.GetExternalId() != "" {
-		fieldAfterJoin := fmt.Sprintf("%s.%s", system.TableName(), repository.ExternalIDField)
-		cond.Where(fieldAfterJoin, in.GetExternalId())
+		cond.Where(model.SystemFields.ExternalID.Qualified(), in.GetExternalId())
 	}
 
 	if in.GetTenantId() != "" {
-		fieldAfterJoin := fmt.Sprintf("%s.%s", system.TableName(), repository.TenantIDField)
-		cond.Where(fieldAfterJoin, in.GetTenantId())
+		cond.Where(model.SystemFields.TenantID.Qualified(), in.GetTenantId())
 	}
 
-	if in.GetRegion() != "" {
-		fieldAfterJoin := fmt.Sprintf("%s.%s", regionalSystem.TableName(), repository.RegionField)
-		cond.Where(fieldAfterJoin, in.GetRegion())
+	regions, err := filterValues(in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	err = whereFilter(cond, model.RegionalSystemFields.Region.Qualified(), in.GetRegion())
+	if err != nil {
+		return nil, err
 	}
 
-	if in.GetType() != "" {
-		fieldAfterJoin := fmt.Sprintf("%s.%s", system.TableName(), repository.TypeField)
-		cond.Where(fieldAfterJoin, in.GetType())
+	err = whereFilter(cond, model.SystemFields.Type.Qualified(), in.GetType())
+	if err != nil {
+		return nil, err
 	}
 
 	query.Where(cond)
-	query.Populate(repository.System)
 
-	var systems []model.RegionalSystem
-	if err := s.repo.List(ctx, &systems, *query); err != nil {
+	resp, err := s.listSystemsPage(ctx, "ListSystems", query, regions)
+	if err != nil {
 		return nil, err
 	}
 
-	pbSystems := make([]*systemgrpc.System, 0, len(systems))
-	for _, system := range systems {
-		systemProto, err := system.ToProto()
-		if err != nil {
-			return nil, ErrSystemProtoConversion
-		}
-		pbSystems = append(pbSystems, systemProto)
+	listed = len(resp.GetSystems())
+
+	return resp, nil
+}
+
+// QuerySystems lists the regional systems matching the filter expression, e.g.
+// status=STATUS_AVAILABLE AND region IN (eu01,eu02) AND labels.env!=prod, see repository.ParseFilterExpression.
+// It is intended for administrators and served on the admin service, see Admin.
+func (s *System) QuerySystems(ctx context.Context, filter string, limit int32, pageToken string) (*systemgrpc.ListSystemsResponse, error) {
+	slogctx.Debug(ctx, "QuerySystems called", "filter", filter)
+
+	query := repository.NewQuery(&model.RegionalSystem{})
+
+	err := query.ApplyPagination(limit, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	query.Joins = []repository.Join{repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)}
+
+	err = whereFilterExpression(query, filter, systemFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.listSystemsPage(ctx, "QuerySystems", query, nil)
+}
+
+// listSystemsPage lists a page of the regional systems of the query from the shards storing the regions.
+// Without regions the systems of all shards are listed and merged into one page.
+// A page without systems fails with ErrSystemNotFound unless empty lists are requested, see LegacyRequests.
+func (s *System) listSystemsPage(ctx context.Context, method string, query *repository.Query, regions []string) (*systemgrpc.ListSystemsResponse, error) {
+	query.Populate(repository.System)
+
+	pages, failures := fanOut(ctx, s.regions.Route(regions), func(ctx context.Context, r repository.Repository) ([]model.RegionalSystem, error) {
+		var systems []model.RegionalSystem
+		err := r.List(ctx, &systems, *query)
+
+		return systems, err
+	})
+	if len(failures) > 0 {
+		return nil, errorShardsUnavailable(ctx, failures)
+	}
+
+	systems := mergeRegionalSystems(pages, query.Limit)
+
+	pbSystems, err := systemsToProto(systems)
+	if err != nil {
+		return nil, err
 	}
 
 	if len(pbSystems) == 0 {
-		return nil, ErrSystemNotFound
+		if err := s.legacy.emptyList(ctx, method, ErrSystemNotFound); err != nil {
+			return nil, err
+		}
 	}
 
 	if len(systems) < query.Limit {
@@ -201,6 +407,10 @@ func (s *System) DeleteSystem(ctx context.Context, in *systemgrpc.DeleteSystemRe
 		return nil, err
 	}
 
+	if err := s.handleEmptyType(ctx, "DeleteSystem", in.GetType()); err != nil {
+		return nil, err
+	}
+
 	var systemFound bool
 	var region string
 
@@ -220,44 +430,98 @@ func (s *System) DeleteSystem(ctx context.Context, in *systemgrpc.DeleteSystemRe
 			return err
 		}
 
-		if systemFound, err = r.Delete(ctx, regionalSystem); err != nil {
-			return ErrSystemDelete
-		}
-
 		region = regionalSystem.Region
+		systemFound, err = deleteRegionalSystem(ctx, r, regionalSystem)
+
+		return err
+	})
+
+	err = mapError(err)
+	if err != nil {
+		return nil, err
+	}
+
+	if systemFound {
+		s.meters.handleSystemDeletion(ctx, region)
+	}
+
+	return &systemgrpc.DeleteSystemResponse{Success: true}, nil
+}
+
+// ApproveSystem accepts a regional system that was registered pending approval,
+// making it available for all further operations.
+func (s *System) ApproveSystem(ctx context.Context, externalID, systemType, region string) error {
+	slogctx.Debug(ctx, "ApproveSystem called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := s.validateExternalIDTypeAndRegion(externalID, systemType, region); err != nil {
+		slogctx.Warn(ctx, "validation failed for ApproveSystem request", "error", err)
+		return err
+	}
 
-		query := repository.NewQuery(&model.RegionalSystem{})
-		cond := repository.NewCompositeKey()
-		cond.Where(repository.SystemIDField, regionalSystem.SystemID.String())
-		query.Where(cond)
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
 
-		var regionalSystems []model.RegionalSystem
-		if err = r.List(ctx, &regionalSystems, *query); err != nil {
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		regionalSystem, err := getPendingRegionalSystem(ctx, r, externalID, systemType, region)
+		if err != nil {
 			return err
 		}
 
-		if len(regionalSystems) > 0 {
-			return nil
+		isPatched, err := r.Patch(ctx, &model.RegionalSystem{
+			SystemID:       regionalSystem.SystemID,
+			Region:         regionalSystem.Region,
+			ApprovalStatus: model.ApprovalStatusApproved,
+		})
+		if err != nil {
+			return ErrSystemUpdate
+		}
+
+		if !isPatched {
+			return ErrSystemNotFound
 		}
 
-		system := &model.System{
-			ID: regionalSystem.SystemID,
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// RejectSystem removes a regional system that was registered pending approval.
+// The parent system is removed as well if it has no other regional systems left.
+func (s *System) RejectSystem(ctx context.Context, externalID, systemType, region string) error {
+	slogctx.Debug(ctx, "RejectSystem called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := s.validateExternalIDTypeAndRegion(externalID, systemType, region); err != nil {
+		slogctx.Warn(ctx, "validation failed for RejectSystem request", "error", err)
+		return err
+	}
+
+	var deleted bool
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		regionalSystem, err := getPendingRegionalSystem(ctx, r, externalID, systemType, region)
+		if err != nil {
+			return err
 		}
-		_, err = r.Delete(ctx, system)
+
+		deleted, err = deleteRegionalSystem(ctx, r, regionalSystem)
 
 		return err
 	})
 
 	err = mapError(err)
 	if err != nil {
-		return nil, err
+		return err
 	}
 
-	if systemFound {
+	if deleted {
 		s.meters.handleSystemDeletion(ctx, region)
 	}
 
-	return &systemgrpc.DeleteSystemResponse{Success: true}, nil
+	return nil
 }
 
 // UpdateSystemL1KeyClaim updates the l1_key_claim parameter of the System identified by its system_id.
@@ -269,12 +533,18 @@ func (s *System) UpdateSystemL1KeyClaim(ctx context.Context, in *systemgrpc.Upda
 		return nil, err
 	}
 
+	if err := s.handleEmptyType(ctx, "UpdateSystemL1KeyClaim", in.GetType()); err != nil {
+		return nil, err
+	}
+
 	desiredClaim := in.GetL1KeyClaim()
 
 	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
 	defer cancel()
 
 	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		// The system and the regional system are selected for update by the transaction,
+		// so a concurrent link or unlink of the system can not interleave with the checks.
 		regionalSystem, err := getRegionalSystem(ctx, r, in.GetExternalId(), in.GetType(), in.GetRegion())
 		if err != nil {
 			return err
@@ -284,11 +554,16 @@ func (s *System) UpdateSystemL1KeyClaim(ctx context.Context, in *systemgrpc.Upda
 			return err
 		}
 
-		isPatched, err := r.Patch(ctx, &model.RegionalSystem{
+		patch := &model.RegionalSystem{
 			SystemID:      regionalSystem.SystemID,
 			Region:        regionalSystem.Region,
 			HasL1KeyClaim: &desiredClaim,
-		})
+		}
+		if desiredClaim {
+			patch.RecordL1KeyClaim(time.Now(), repository.CallerFromContext(ctx), in.GetTenantId())
+		}
+
+		isPatched, err := r.Patch(ctx, patch)
 		if err != nil || !isPatched {
 			return ErrSystemUpdate
 		}
@@ -305,7 +580,7 @@ func (s *System) UpdateSystemL1KeyClaim(ctx context.Context, in *systemgrpc.Upda
 }
 
 // UpdateSystemStatus updates the status of the System identified by its ID.
-// The status can be one of a predefined set of values.
+// The status can be one of a predefined set of values, reachable from the current status by the configured transitions.
 // If the update is successful, a success message will be returned, otherwise an error will be returned.
 func (s *System) UpdateSystemStatus(ctx context.Context, in *systemgrpc.UpdateSystemStatusRequest) (*systemgrpc.UpdateSystemStatusResponse, error) {
 	slogctx.Debug(ctx, "UpdateSystemStatus called", "externalId", in.GetExternalId(), "type", in.GetType(), "region", in.GetRegion(), "status", in.GetStatus())
@@ -313,8 +588,16 @@ func (s *System) UpdateSystemStatus(ctx context.Context, in *systemgrpc.UpdateSy
 		slogctx.Warn(ctx, "validation failed for UpdateSystemStatus request", "error", err)
 		return nil, err
 	}
-	if err := s.validation.Validate(model.SystemStatusValidationID, in.GetStatus().String()); err != nil {
-		err = ErrorWithParams(ErrValidationFailed, "err", err.Error())
+	if err := s.handleEmptyType(ctx, "UpdateSystemStatus", in.GetType()); err != nil {
+		return nil, err
+	}
+	status, err := s.enums.resolve(ctx, "status", in.GetStatus())
+	if err != nil {
+		slogctx.Warn(ctx, "validation failed for UpdateSystemStatus request", "error", err)
+		return nil, err
+	}
+	if err := s.validation.Validate(model.SystemStatusValidationID, status); err != nil {
+		err = validationFailed(err)
 		slogctx.Warn(ctx, "validation failed for UpdateSystemStatus request", "error", err)
 		return nil, err
 	}
@@ -322,16 +605,20 @@ func (s *System) UpdateSystemStatus(ctx context.Context, in *systemgrpc.UpdateSy
 	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
 	defer cancel()
 
-	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+	err = s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
 		regionalSystem, err := getRegionalSystem(ctx, r, in.GetExternalId(), in.GetType(), in.GetRegion())
 		if err != nil {
 			return err
 		}
 
+		if err := s.statuses.validateTransition(regionalSystem.Status, status); err != nil {
+			return err
+		}
+
 		isPatched, err := r.Patch(ctx, &model.RegionalSystem{
 			SystemID: regionalSystem.SystemID,
 			Region:   in.GetRegion(),
-			Status:   in.GetStatus().String(),
+			Status:   status,
 		})
 		if err != nil {
 			return ErrSystemUpdate
@@ -358,7 +645,7 @@ func (s *System) UpdateSystemStatus(ctx context.Context, in *systemgrpc.UpdateSy
 func (s *System) SetSystemLabels(ctx context.Context, in *systemgrpc.SetSystemLabelsRequest) (*systemgrpc.SetSystemLabelsResponse, error) {
 	slogctx.Debug(ctx, "SetSystemLabels called", "externalId", in.GetExternalId(), "type", in.GetType(), "region", in.GetRegion())
 
-	if err := s.validateSetSystemLabelsRequest(in); err != nil {
+	if err := s.validateSetSystemLabelsRequest(ctx, in); err != nil {
 		slogctx.Warn(ctx, "validation failed for SetSystemLabels request", "error", err)
 		return nil, err
 	}
@@ -376,18 +663,16 @@ func (s *System) SetSystemLabels(ctx context.Context, in *systemgrpc.SetSystemLa
 			return err
 		}
 
+		if err := s.labels.checkSet(regionalSystem.Labels, in.GetLabels()); err != nil {
+			return err
+		}
+
 		systemToPatch := &model.RegionalSystem{
 			SystemID: regionalSystem.SystemID,
 			Region:   in.GetRegion(),
-			Labels:   regionalSystem.Labels,
-		}
-
-		if systemToPatch.Labels == nil {
-			systemToPatch.Labels = make(map[string]string)
+			Labels:   mergeLabels(regionalSystem.Labels, in.GetLabels()),
 		}
 
-		maps.Copy(systemToPatch.Labels, in.GetLabels())
-
 		isPatched, err := r.Patch(ctx, systemToPatch)
 		if err != nil {
 			return ErrSystemUpdate
@@ -405,18 +690,20 @@ func (s *System) SetSystemLabels(ctx context.Context, in *systemgrpc.SetSystemLa
 		return nil, err
 	}
 
+	s.labels.audit(ctx, ResourceTypeSystem, in.GetType()+"/"+in.GetExternalId()+"/"+in.GetRegion(), labelOperationSet, slices.Collect(maps.Keys(in.GetLabels())))
+
 	return &systemgrpc.SetSystemLabelsResponse{
 		Success: true,
 	}, nil
 }
 
 // RemoveSystemLabels removes the specified labels from the System identified by its external ID and region.
-// If one or more label keys are not found, they will be ignored.
+// If one or more label keys are not found, they will be ignored and reported as warning.
 // If the update is successful, a success message will be returned, otherwise an error will be returned.
 func (s *System) RemoveSystemLabels(ctx context.Context, in *systemgrpc.RemoveSystemLabelsRequest) (*systemgrpc.RemoveSystemLabelsResponse, error) {
 	slogctx.Debug(ctx, "RemoveSystemLabels called", "externalId", in.GetExternalId(), "type", in.GetType(), "region", in.GetRegion())
 
-	if err := s.validateRemoveSystemLabelsRequest(in); err != nil {
+	if err := s.validateRemoveSystemLabelsRequest(ctx, in); err != nil {
 		slogctx.Warn(ctx, "validation failed for RemoveSystemLabels request", "error", err)
 		return nil, err
 	}
@@ -424,6 +711,7 @@ func (s *System) RemoveSystemLabels(ctx context.Context, in *systemgrpc.RemoveSy
 	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
 	defer cancel()
 
+	var missing []string
 	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
 		regionalSystem, err := getRegionalSystem(ctx, r, in.GetExternalId(), in.GetType(), in.GetRegion())
 		if err != nil {
@@ -434,14 +722,12 @@ func (s *System) RemoveSystemLabels(ctx context.Context, in *systemgrpc.RemoveSy
 			return err
 		}
 
+		missing = missingLabelKeys(regionalSystem.Labels, in.GetLabelKeys())
+
 		systemToPatch := &model.RegionalSystem{
 			SystemID: regionalSystem.SystemID,
 			Region:   in.GetRegion(),
-			Labels:   regionalSystem.Labels,
-		}
-
-		for _, k := range in.GetLabelKeys() {
-			delete(systemToPatch.Labels, k)
+			Labels:   removeLabels(regionalSystem.Labels, in.GetLabelKeys()),
 		}
 
 		isPatched, err := r.Patch(ctx, systemToPatch)
@@ -461,11 +747,24 @@ func (s *System) RemoveSystemLabels(ctx context.Context, in *systemgrpc.RemoveSy
 		return nil, err
 	}
 
+	s.labels.audit(ctx, ResourceTypeSystem, in.GetType()+"/"+in.GetExternalId()+"/"+in.GetRegion(), labelOperationRemove, in.GetLabelKeys())
+	addLabelKeysNotFoundWarning(ctx, missing)
+
 	return &systemgrpc.RemoveSystemLabelsResponse{
 		Success: true,
 	}, nil
 }
 
+// handleEmptyType handles requests without system type, the request shape before system types were introduced.
+// Such requests resolve the system by its external ID only.
+func (s *System) handleEmptyType(ctx context.Context, method, systemType string) error {
+	if systemType != "" {
+		return nil
+	}
+
+	return s.legacy.handle(ctx, method, legacyFieldEmptyType)
+}
+
 // validateExternalIDTypeAndRegion validates the externalID, type and region against the validator.
 func (s *System) validateExternalIDTypeAndRegion(exteralID, systemType, region string) error {
 	if systemType != "" {
@@ -478,7 +777,7 @@ func (s *System) validateExternalIDTypeAndRegion(exteralID, systemType, region s
 		model.SystemExternalIDValidationID:     exteralID,
 		model.RegionalSystemRegionValidationID: region,
 	}); err != nil {
-		return ErrorWithParams(ErrValidationFailed, "err", err.Error())
+		return validationFailed(err)
 	}
 
 	return nil
@@ -486,40 +785,30 @@ func (s *System) validateExternalIDTypeAndRegion(exteralID, systemType, region s
 
 // validateSetSystemLabelsRequest validates the SetSystemLabelsRequest.
 // If the request is valid, it returns nil, otherwise it returns an error.
-func (s *System) validateSetSystemLabelsRequest(in *systemgrpc.SetSystemLabelsRequest) error {
+func (s *System) validateSetSystemLabelsRequest(ctx context.Context, in *systemgrpc.SetSystemLabelsRequest) error {
 	if err := s.validateExternalIDTypeAndRegion(in.GetExternalId(), in.GetType(), in.GetRegion()); err != nil {
 		return err
 	}
 
-	if len(in.GetLabels()) == 0 {
-		return ErrMissingLabels
-	}
-
-	labels := in.GetLabels()
-	err := s.validation.Validate(model.RegionalSystemLabelsValidationID, labels)
-	if err != nil {
+	if err := s.handleEmptyType(ctx, "SetSystemLabels", in.GetType()); err != nil {
 		return err
 	}
 
-	return nil
+	return s.labels.validateSet(model.RegionalSystemLabelsValidationID, in.GetLabels())
 }
 
 // validateRemoveSystemLabelsRequest validates the RemoveSystemLabelsRequest.
 // If the request is valid, it returns nil, otherwise it returns an error.
-func (s *System) validateRemoveSystemLabelsRequest(in *systemgrpc.RemoveSystemLabelsRequest) error {
+func (s *System) validateRemoveSystemLabelsRequest(ctx context.Context, in *systemgrpc.RemoveSystemLabelsRequest) error {
 	if err := s.validateExternalIDTypeAndRegion(in.GetExternalId(), in.GetType(), in.GetRegion()); err != nil {
 		return err
 	}
 
-	if len(in.GetLabelKeys()) == 0 {
-		return ErrMissingLabelKeys
-	}
-
-	if slices.Contains(in.GetLabelKeys(), "") {
-		return ErrEmptyLabelKeys
+	if err := s.handleEmptyType(ctx, "RemoveSystemLabels", in.GetType()); err != nil {
+		return err
 	}
 
-	return nil
+	return s.labels.validateRemove(in.GetLabelKeys())
 }
 
 // validateDeleteSystem makes sure that the System is allowed to be deleted.
@@ -560,6 +849,50 @@ func (s *System) isUpdateKeyClaimAllowed(regionalSystem *model.RegionalSystem, d
 	return nil
 }
 
+// deleteRegionalSystem deletes the regional system with its credentials, its L2 key history and its parent system if no other regional systems are left.
+// It returns true if the regional system was deleted.
+func deleteRegionalSystem(ctx context.Context, r repository.Repository, regionalSystem *model.RegionalSystem) (bool, error) {
+	deleted, err := r.Delete(ctx, regionalSystem)
+	if err != nil {
+		return false, ErrSystemDelete
+	}
+
+	err = deleteSystemCredentials(ctx, r, regionalSystem)
+	if err != nil {
+		return deleted, err
+	}
+
+	err = deleteSystemL2Keys(ctx, r, regionalSystem)
+	if err != nil {
+		return deleted, err
+	}
+
+	// the foreign key of the regional systems restricts deleting a system which still has regional systems,
+	// so it is only deleted if none are left
+	var systems []model.System
+	_, err = r.DeleteAll(ctx, &systems, *repository.QueryOf(model.SystemFields.ID.Eq(regionalSystem.SystemID)).
+		WhereMissing(repository.MissingOn(model.SystemFields.ID, model.RegionalSystemFields.SystemID)))
+	if err != nil {
+		return deleted, ErrSystemDelete
+	}
+
+	return deleted, nil
+}
+
+// getPendingRegionalSystem fetches the regional system and makes sure that it is pending approval.
+func getPendingRegionalSystem(ctx context.Context, r repository.Repository, externalID, systemType, region string) (*model.RegionalSystem, error) {
+	regionalSystem, err := getRegionalSystem(ctx, r, externalID, systemType, region)
+	if err != nil {
+		return nil, err
+	}
+
+	if !regionalSystem.IsPendingApproval() {
+		return nil, ErrSystemNotPendingApproval
+	}
+
+	return regionalSystem, nil
+}
+
 // getRegionalSystem fetches the regional system from the db based on the externalID, type and region.
 func getRegionalSystem(ctx context.Context, repo repository.Repository, external_id, systemType, region string) (*model.RegionalSystem, error) {
 	var regionalSystem *model.RegionalSystem
@@ -610,8 +943,7 @@ func getRegionalSystemWithType(ctx context.Context, repo repository.Repository,
 // getRegionalSystemWithoutType fetches the regionalSystem if there are multiple systems returned then it returns an error.
 func getRegionalSystemWithoutType(ctx context.Context, repo repository.Repository, externalID, region string) (*model.RegionalSystem, error) {
 	var systems []model.System
-	query := repository.NewQuery(&model.System{})
-	query.Where(repository.NewCompositeKey().Where(repository.ExternalIDField, externalID))
+	query := repository.QueryOf(model.SystemFields.ExternalID.Eq(externalID))
 
 	if err := repo.List(ctx, &systems, *query); err != nil {
 		return nil, err
@@ -0,0 +1,104 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// SystemClassifications sets the environment and criticality of systems, which are used for policy decisions,
+// e.g. systems of the prod environment can not be linked to test tenants.
+// The procedure call is served on the extension system service, see SystemExtension.
+type SystemClassifications struct {
+	repo repository.Repository
+	cfg  config.SystemClassification
+}
+
+// NewSystemClassifications creates and returns a new instance of SystemClassifications.
+func NewSystemClassifications(repo repository.Repository, cfg config.SystemClassification) *SystemClassifications {
+	return &SystemClassifications{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// ClassifySystem sets the environment and criticality of the system. They must be one of the configured values,
+// empty values keep the current classification. A linked system can only be classified as prod
+// if the tenant it is linked to is not a test tenant.
+func (c *SystemClassifications) ClassifySystem(ctx context.Context, externalID, systemType, environment, criticality string) error {
+	ctx = slogctx.With(ctx, "externalId", externalID, "type", systemType, "environment", environment, "criticality", criticality)
+	slogctx.Debug(ctx, "ClassifySystem called")
+
+	err := c.validateClassification(externalID, systemType, environment, criticality)
+	if err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err = c.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		system, found, err := getSystem(ctx, r, externalID, systemType)
+		if err != nil {
+			return ErrSystemSelect
+		}
+
+		if !found {
+			return ErrorWithParams(ErrSystemNotFound, "externalID", externalID, "type", systemType)
+		}
+
+		system.Environment = environment
+		system.Criticality = criticality
+
+		if environment != "" && system.IsLinkedToTenant() {
+			tenant, err := getTenant(ctx, r, *system.TenantID, repository.LockForShare)
+			if err != nil {
+				return err
+			}
+
+			if !system.CanLinkToTenantRole(tenant.Role) {
+				return ErrorWithParams(ErrSystemNotLinkableToTenant, "externalID", externalID, "type", systemType,
+					"environment", environment, "role", tenant.Role)
+			}
+		}
+
+		_, err = r.Patch(ctx, system)
+		if err != nil {
+			return ErrSystemUpdate
+		}
+
+		return nil
+	})
+
+	err = mapError(err)
+	if err != nil {
+		slogctx.Error(ctx, "failed to classify system", "error", err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *SystemClassifications) validateClassification(externalID, systemType, environment, criticality string) error {
+	if externalID == "" {
+		return ErrExternalIDIsEmpty
+	}
+
+	if systemType == "" {
+		return ErrSystemTypeIsEmpty
+	}
+
+	if environment != "" && !slices.Contains(c.cfg.Environments, environment) {
+		return ErrorWithParams(ErrSystemClassificationInvalid, "environment", environment)
+	}
+
+	if criticality != "" && !slices.Contains(c.cfg.Criticalities, criticality) {
+		return ErrorWithParams(ErrSystemClassificationInvalid, "criticality", criticality)
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestClassifySystemInvalidRequest(t *testing.T) {
+	subj := service.NewSystemClassifications(nil, config.SystemClassification{
+		Environments:  []string{"prod", "nonprod"},
+		Criticalities: []string{"low", "medium", "high"},
+	})
+
+	tests := []struct {
+		name        string
+		externalID  string
+		systemType  string
+		environment string
+		criticality string
+	}{
+		{name: "empty external ID", externalID: "", systemType: "system", environment: "prod"},
+		{name: "empty type", externalID: "ext", systemType: "", environment: "prod"},
+		{name: "environment not allowed", externalID: "ext", systemType: "system", environment: "staging"},
+		{name: "criticality not allowed", externalID: "ext", systemType: "system", criticality: "critical"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := subj.ClassifySystem(t.Context(), tt.externalID, tt.systemType, tt.environment, tt.criticality)
+
+			// then
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
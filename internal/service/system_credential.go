@@ -0,0 +1,218 @@
+package service
+
+import (
+	"context"
+	"regexp"
+	"strings"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// thumbprintPattern matches the lowercase hex encoded SHA-256 of a certificate.
+var thumbprintPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// SystemCredentials manages the certificates regional systems authenticate with to KMS.
+// Certificates are registered by their thumbprint, the key material is never stored.
+// The procedure calls are served on the extension system service, see SystemExtension.
+type SystemCredentials struct {
+	repo repository.Repository
+}
+
+// NewSystemCredentials creates and returns a new instance of SystemCredentials.
+func NewSystemCredentials(repo repository.Repository) *SystemCredentials {
+	return &SystemCredentials{
+		repo: repo,
+	}
+}
+
+// AddSystemCredential registers the credential for the regional system identified by its external ID, type and region.
+// The thumbprint may be given in upper case and with colon separators, it is stored normalized.
+func (s *SystemCredentials) AddSystemCredential(ctx context.Context, externalID, systemType, region string, credential *model.SystemCredential) error {
+	slogctx.Debug(ctx, "AddSystemCredential called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := validateRegionalSystemKey(externalID, region); err != nil {
+		return err
+	}
+
+	credential.Thumbprint = normalizeThumbprint(credential.Thumbprint)
+	if err := validateSystemCredential(credential, time.Now()); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		regionalSystem, err := getRegionalSystem(ctx, r, externalID, systemType, region)
+		if err != nil {
+			return err
+		}
+
+		if err := checkRegionalSystemAvailable(regionalSystem); err != nil {
+			return err
+		}
+
+		credential.SystemID = regionalSystem.SystemID
+		credential.Region = regionalSystem.Region
+		credential.Status = model.SystemCredentialStatusActive
+		credential.RevokedAt = nil
+
+		err = r.Create(ctx, credential)
+		if isUniqueConstraintError(err) {
+			return ErrorAlreadyExists(ResourceTypeSystemCredential, externalID+"/"+region+"/"+credential.Thumbprint)
+		}
+		if err != nil {
+			return ErrSystemCredentialCreate
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// ListSystemCredentials returns all credentials of the regional system, including revoked and expired ones.
+func (s *SystemCredentials) ListSystemCredentials(ctx context.Context, externalID, systemType, region string) ([]model.SystemCredential, error) {
+	slogctx.Debug(ctx, "ListSystemCredentials called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := validateRegionalSystemKey(externalID, region); err != nil {
+		return nil, err
+	}
+
+	regionalSystem, err := getRegionalSystem(ctx, s.repo, externalID, systemType, region)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	query := repository.NewQuery(&model.SystemCredential{}).Where(
+		repository.NewCompositeKey().
+			Where(repository.SystemIDField, regionalSystem.SystemID).
+			Where(repository.RegionField, regionalSystem.Region),
+	)
+
+	var credentials []model.SystemCredential
+	if err := s.repo.List(ctx, &credentials, *query); err != nil {
+		return nil, ErrSystemCredentialSelect
+	}
+
+	return credentials, nil
+}
+
+// RevokeSystemCredential revokes the credential of the regional system with the given thumbprint.
+// A revoked credential is kept, so the same certificate can not be registered again.
+func (s *SystemCredentials) RevokeSystemCredential(ctx context.Context, externalID, systemType, region, thumbprint string) error {
+	slogctx.Debug(ctx, "RevokeSystemCredential called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := validateRegionalSystemKey(externalID, region); err != nil {
+		return err
+	}
+
+	thumbprint = normalizeThumbprint(thumbprint)
+	if !thumbprintPattern.MatchString(thumbprint) {
+		return ErrSystemCredentialThumbprint
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		regionalSystem, err := getRegionalSystem(ctx, r, externalID, systemType, region)
+		if err != nil {
+			return err
+		}
+
+		credential := &model.SystemCredential{
+			SystemID:   regionalSystem.SystemID,
+			Region:     regionalSystem.Region,
+			Thumbprint: thumbprint,
+		}
+
+		found, err := r.Find(ctx, credential)
+		if err != nil {
+			return ErrSystemCredentialSelect
+		}
+		if !found {
+			return ErrorWithParams(ErrSystemCredentialNotFound, "thumbprint", thumbprint)
+		}
+
+		if credential.Status == model.SystemCredentialStatusRevoked {
+			return ErrSystemCredentialRevoked
+		}
+
+		now := time.Now()
+		isPatched, err := r.Patch(ctx, &model.SystemCredential{
+			ID:        credential.ID,
+			Status:    model.SystemCredentialStatusRevoked,
+			RevokedAt: &now,
+		})
+		if err != nil || !isPatched {
+			return ErrSystemCredentialUpdate
+		}
+
+		slogctx.Info(ctx, "system credential revoked", "externalId", externalID, "region", region, "thumbprint", thumbprint)
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// deleteSystemCredentials deletes all credentials of the regional system.
+func deleteSystemCredentials(ctx context.Context, r repository.Repository, regionalSystem *model.RegionalSystem) error {
+	query := repository.NewQuery(&model.SystemCredential{}).Where(
+		repository.NewCompositeKey().
+			Where(repository.SystemIDField, regionalSystem.SystemID).
+			Where(repository.RegionField, regionalSystem.Region),
+	)
+
+	var credentials []model.SystemCredential
+	if err := r.List(ctx, &credentials, *query); err != nil {
+		return ErrSystemCredentialSelect
+	}
+
+	for _, credential := range credentials {
+		if _, err := r.Delete(ctx, &credential); err != nil {
+			return ErrSystemDelete
+		}
+	}
+
+	return nil
+}
+
+// normalizeThumbprint converts a thumbprint to lower case and removes colon separators.
+func normalizeThumbprint(thumbprint string) string {
+	return strings.ToLower(strings.ReplaceAll(thumbprint, ":", ""))
+}
+
+// validateSystemCredential validates the thumbprint and validity window of a credential being added at now.
+func validateSystemCredential(credential *model.SystemCredential, now time.Time) error {
+	if !thumbprintPattern.MatchString(credential.Thumbprint) {
+		return ErrSystemCredentialThumbprint
+	}
+
+	if credential.NotBefore.IsZero() || credential.NotAfter.IsZero() || !credential.NotAfter.After(credential.NotBefore) {
+		return ErrSystemCredentialValidity
+	}
+
+	if !credential.NotAfter.After(now) {
+		return ErrSystemCredentialExpired
+	}
+
+	return nil
+}
+
+func validateRegionalSystemKey(externalID, region string) error {
+	if externalID == "" {
+		return ErrExternalIDIsEmpty
+	}
+
+	if region == "" {
+		return ErrRegionIsEmpty
+	}
+
+	return nil
+}
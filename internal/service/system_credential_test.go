@@ -0,0 +1,104 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+const validThumbprint = "9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08"
+
+func TestNormalizeThumbprint(t *testing.T) {
+	// given
+	thumbprint := "9F:86:D0:81:88:4C:7D:65:9A:2F:EA:A0:C5:5A:D0:15:A3:BF:4F:1B:2B:0B:82:2C:D1:5D:6C:15:B0:F0:0A:08"
+
+	// when
+	result := service.NormalizeThumbprint(thumbprint)
+
+	// then
+	assert.Equal(t, validThumbprint, result)
+}
+
+func TestValidateSystemCredential(t *testing.T) {
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		credential model.SystemCredential
+		expErr     error
+	}{
+		{
+			name: "valid credential",
+			credential: model.SystemCredential{
+				Thumbprint: validThumbprint,
+				NotBefore:  now.Add(-time.Hour),
+				NotAfter:   now.Add(time.Hour),
+			},
+		},
+		{
+			name: "credential not valid yet",
+			credential: model.SystemCredential{
+				Thumbprint: validThumbprint,
+				NotBefore:  now.Add(time.Hour),
+				NotAfter:   now.Add(2 * time.Hour),
+			},
+		},
+		{
+			name: "thumbprint too short",
+			credential: model.SystemCredential{
+				Thumbprint: validThumbprint[:40],
+				NotBefore:  now.Add(-time.Hour),
+				NotAfter:   now.Add(time.Hour),
+			},
+			expErr: service.ErrSystemCredentialThumbprint,
+		},
+		{
+			name: "thumbprint not hex",
+			credential: model.SystemCredential{
+				Thumbprint: "z" + validThumbprint[1:],
+				NotBefore:  now.Add(-time.Hour),
+				NotAfter:   now.Add(time.Hour),
+			},
+			expErr: service.ErrSystemCredentialThumbprint,
+		},
+		{
+			name: "missing validity window",
+			credential: model.SystemCredential{
+				Thumbprint: validThumbprint,
+			},
+			expErr: service.ErrSystemCredentialValidity,
+		},
+		{
+			name: "not after before not before",
+			credential: model.SystemCredential{
+				Thumbprint: validThumbprint,
+				NotBefore:  now.Add(time.Hour),
+				NotAfter:   now.Add(-time.Hour),
+			},
+			expErr: service.ErrSystemCredentialValidity,
+		},
+		{
+			name: "expired credential",
+			credential: model.SystemCredential{
+				Thumbprint: validThumbprint,
+				NotBefore:  now.Add(-2 * time.Hour),
+				NotAfter:   now.Add(-time.Hour),
+			},
+			expErr: service.ErrSystemCredentialExpired,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := service.ValidateSystemCredential(&tt.credential, now)
+
+			// then
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// SystemDisplays sets the display name and description of systems, which describe them to humans
+// instead of their external IDs, and looks them up for the systems of list responses.
+// The procedure call is served on the extension system service, see SystemExtension.
+type SystemDisplays struct {
+	repo       repository.Repository
+	validation *validation.Validation
+}
+
+// SystemDisplay is the display name and description of a system.
+type SystemDisplay struct {
+	DisplayName string
+	Description string
+}
+
+// NewSystemDisplays creates and returns a new instance of SystemDisplays.
+func NewSystemDisplays(repo repository.Repository, validation *validation.Validation) *SystemDisplays {
+	return &SystemDisplays{
+		repo:       repo,
+		validation: validation,
+	}
+}
+
+// UpdateSystem sets the display name and description of the system which are not nil and returns the system.
+// Empty values clear them. At least one of them must be set.
+func (d *SystemDisplays) UpdateSystem(ctx context.Context, externalID, systemType string, displayName, description *string) (*model.System, error) {
+	ctx = slogctx.With(ctx, "externalId", externalID, "type", systemType)
+	slogctx.Debug(ctx, "UpdateSystem called")
+
+	err := d.validateDisplay(externalID, systemType, displayName, description)
+	if err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	var system *model.System
+	err = d.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		var found bool
+		system, found, err = getSystem(ctx, r, externalID, systemType)
+		if err != nil {
+			return ErrSystemSelect
+		}
+
+		if !found {
+			return ErrorWithParams(ErrSystemNotFound, "externalID", externalID, "type", systemType)
+		}
+
+		// patches skip empty values, so the cleared fields are set to NULL separately
+		var cleared []repository.QueryField
+		if displayName != nil {
+			system.DisplayName = *displayName
+			if *displayName == "" {
+				cleared = append(cleared, model.SystemFields.DisplayName.Column())
+			}
+		}
+		if description != nil {
+			system.Description = *description
+			if *description == "" {
+				cleared = append(cleared, model.SystemFields.Description.Column())
+			}
+		}
+
+		_, err = r.Patch(ctx, system)
+		if err != nil {
+			return ErrSystemUpdate
+		}
+
+		if len(cleared) == 0 {
+			return nil
+		}
+
+		var systems []model.System
+		_, err = r.ClearAll(ctx, &systems, *repository.QueryOf(model.SystemFields.ID.Eq(system.ID)), cleared...)
+		if err != nil {
+			return ErrSystemUpdate
+		}
+
+		return nil
+	})
+
+	err = mapError(err)
+	if err != nil {
+		slogctx.Error(ctx, "failed to update system", "error", err)
+		return nil, err
+	}
+
+	return system, nil
+}
+
+// Displays returns the display names and descriptions of the systems of the regional systems by systemKey.
+// Systems without display name and description are omitted.
+func (d *SystemDisplays) Displays(ctx context.Context, systems []*systemgrpc.System) (map[string]SystemDisplay, error) {
+	seen := make(map[string]struct{}, len(systems))
+	keys := make([]repository.CompositeKey, 0, len(systems))
+	for _, system := range systems {
+		key := systemKey(system.GetExternalId(), system.GetType())
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		keys = append(keys, repository.Key(
+			model.SystemFields.ExternalID.Eq(system.GetExternalId()),
+			model.SystemFields.Type.Eq(system.GetType())))
+	}
+
+	displays := make(map[string]SystemDisplay)
+	if len(keys) == 0 {
+		return displays, nil
+	}
+
+	var found []model.System
+	err := d.repo.List(ctx, &found, *repository.NewQuery(&model.System{}).Where(keys...).SetLimit(len(keys)))
+	if err != nil {
+		slogctx.Error(ctx, "failed to select system displays", "error", err)
+		return nil, ErrSystemSelect
+	}
+
+	for _, system := range found {
+		if system.DisplayName == "" && system.Description == "" {
+			continue
+		}
+
+		displays[systemKey(system.ExternalID, system.Type)] = SystemDisplay{
+			DisplayName: system.DisplayName,
+			Description: system.Description,
+		}
+	}
+
+	return displays, nil
+}
+
+func (d *SystemDisplays) validateDisplay(externalID, systemType string, displayName, description *string) error {
+	if externalID == "" {
+		return ErrExternalIDIsEmpty
+	}
+
+	if systemType == "" {
+		return ErrSystemTypeIsEmpty
+	}
+
+	if displayName == nil && description == nil {
+		return ErrSystemDisplayEmpty
+	}
+
+	values := make(map[validation.ID]any, 2)
+	if displayName != nil {
+		values[model.SystemDisplayNameValidationID] = *displayName
+	}
+	if description != nil {
+		values[model.SystemDescriptionValidationID] = *description
+	}
+
+	if err := d.validation.ValidateAll(values); err != nil {
+		return validationFailed(err)
+	}
+
+	return nil
+}
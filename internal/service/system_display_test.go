@@ -0,0 +1,54 @@
+package service_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestUpdateSystemInvalidRequest(t *testing.T) {
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}},
+	})
+	require.NoError(t, err)
+
+	subj := service.NewSystemDisplays(nil, v)
+
+	name := func(s string) *string { return &s }
+
+	tests := []struct {
+		name        string
+		externalID  string
+		systemType  string
+		displayName *string
+		description *string
+	}{
+		{name: "empty external ID", externalID: "", systemType: "system", displayName: name("Payroll")},
+		{name: "empty type", externalID: "ext", systemType: "", displayName: name("Payroll")},
+		{name: "nothing to update", externalID: "ext", systemType: "system"},
+		{name: "display name too long", externalID: "ext", systemType: "system",
+			displayName: name(strings.Repeat("a", model.MaxSystemDisplayNameLength+1))},
+		{name: "display name with line break", externalID: "ext", systemType: "system", displayName: name("Payroll\nEU")},
+		{name: "description too long", externalID: "ext", systemType: "system",
+			description: name(strings.Repeat("a", model.MaxSystemDescriptionLength+1))},
+		{name: "description with control character", externalID: "ext", systemType: "system", description: name("Payroll\x00")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := subj.UpdateSystem(t.Context(), tt.externalID, tt.systemType, tt.displayName, tt.description)
+
+			// then
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
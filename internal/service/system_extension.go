@@ -0,0 +1,385 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/model"
+)
+
+// SystemExtension implements the procedure calls on systems defined in api/extension/v1/extension.proto,
+// which are not defined by api-sdk yet.
+type SystemExtension struct {
+	extensiongrpc.UnimplementedSystemServiceServer
+
+	services SystemExtensionServices
+}
+
+// SystemExtensionServices holds the services the procedure calls of SystemExtension delegate to.
+type SystemExtensionServices struct {
+	Credentials     *SystemCredentials
+	Systems         *System
+	Classifications *SystemClassifications
+	Registrations   *SystemRegistrations
+	Lookups         *SystemLookups
+	Displays        *SystemDisplays
+	Relationships   *SystemRelationships
+}
+
+// NewSystemExtension creates and returns a new instance of SystemExtension.
+func NewSystemExtension(services SystemExtensionServices) *SystemExtension {
+	return &SystemExtension{
+		services: services,
+	}
+}
+
+// AddSystemCredential registers the certificate thumbprint a regional system authenticates with.
+func (s *SystemExtension) AddSystemCredential(ctx context.Context, in *extensiongrpc.AddSystemCredentialRequest) (*extensiongrpc.AddSystemCredentialResponse, error) {
+	credential := &model.SystemCredential{
+		Thumbprint: in.GetThumbprint(),
+		NotBefore:  timeFromProto(in.GetNotBefore()),
+		NotAfter:   timeFromProto(in.GetNotAfter()),
+	}
+
+	err := s.services.Credentials.AddSystemCredential(ctx, in.GetExternalId(), in.GetType(), in.GetRegion(), credential)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.AddSystemCredentialResponse{
+		Credential: systemCredentialToProto(credential),
+	}, nil
+}
+
+// ListSystemCredentials returns all credentials of a regional system, including revoked and expired ones.
+func (s *SystemExtension) ListSystemCredentials(ctx context.Context, in *extensiongrpc.ListSystemCredentialsRequest) (*extensiongrpc.ListSystemCredentialsResponse, error) {
+	credentials, err := s.services.Credentials.ListSystemCredentials(ctx, in.GetExternalId(), in.GetType(), in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.ListSystemCredentialsResponse{
+		Credentials: make([]*extensiongrpc.SystemCredential, 0, len(credentials)),
+	}
+	for i := range credentials {
+		resp.Credentials = append(resp.Credentials, systemCredentialToProto(&credentials[i]))
+	}
+
+	return resp, nil
+}
+
+// RevokeSystemCredential revokes the credential of a regional system with the thumbprint.
+func (s *SystemExtension) RevokeSystemCredential(ctx context.Context, in *extensiongrpc.RevokeSystemCredentialRequest) (*extensiongrpc.RevokeSystemCredentialResponse, error) {
+	err := s.services.Credentials.RevokeSystemCredential(ctx, in.GetExternalId(), in.GetType(), in.GetRegion(), in.GetThumbprint())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.RevokeSystemCredentialResponse{Success: true}, nil
+}
+
+// UpdateSystemL2Key rotates the L2 key of a regional system, the previous key is retired in its L2 key history.
+func (s *SystemExtension) UpdateSystemL2Key(ctx context.Context, in *extensiongrpc.UpdateSystemL2KeyRequest) (*extensiongrpc.UpdateSystemL2KeyResponse, error) {
+	err := s.services.Systems.UpdateSystemL2Key(ctx, in.GetExternalId(), in.GetType(), in.GetRegion(), in.GetL2KeyId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.UpdateSystemL2KeyResponse{Success: true}, nil
+}
+
+// GetSystemKeyHistory returns the L2 keys a regional system was assigned, the current key first.
+func (s *SystemExtension) GetSystemKeyHistory(ctx context.Context, in *extensiongrpc.GetSystemKeyHistoryRequest) (*extensiongrpc.GetSystemKeyHistoryResponse, error) {
+	keys, err := s.services.Systems.GetSystemKeyHistory(ctx, in.GetExternalId(), in.GetType(), in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.GetSystemKeyHistoryResponse{
+		Keys: make([]*extensiongrpc.SystemL2Key, 0, len(keys)),
+	}
+	for _, key := range keys {
+		pbKey := &extensiongrpc.SystemL2Key{
+			L2KeyId:    key.L2KeyID,
+			AssignedAt: timestamppb.New(key.CreatedAt),
+		}
+		if key.RetiredAt != nil {
+			pbKey.RetiredAt = timestamppb.New(*key.RetiredAt)
+		}
+
+		resp.Keys = append(resp.Keys, pbKey)
+	}
+
+	return resp, nil
+}
+
+// ClassifySystem sets the environment and criticality of a system, which are used for policy decisions.
+func (s *SystemExtension) ClassifySystem(ctx context.Context, in *extensiongrpc.ClassifySystemRequest) (*extensiongrpc.ClassifySystemResponse, error) {
+	err := s.services.Classifications.ClassifySystem(ctx, in.GetExternalId(), in.GetType(), in.GetEnvironment(), in.GetCriticality())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.ClassifySystemResponse{Success: true}, nil
+}
+
+// ListSystems lists the regional systems like ListSystems of api-sdk, each with the rollup status of its system.
+func (s *SystemExtension) ListSystems(ctx context.Context, in *extensiongrpc.ListSystemsRequest) (*extensiongrpc.ListSystemsResponse, error) {
+	resp, rollups, err := s.services.Systems.ListSystemsWithRollups(ctx, &systemgrpc.ListSystemsRequest{
+		ExternalId: in.GetExternalId(),
+		Region:     in.GetRegion(),
+		TenantId:   in.GetTenantId(),
+		Type:       in.GetType(),
+		Limit:      in.GetLimit(),
+		PageToken:  in.GetPageToken(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	displays, err := s.services.Displays.Displays(ctx, resp.GetSystems())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.ListSystemsResponse{
+		Systems:       regionalSystemsToExtensionProto(resp.GetSystems(), rollups, displays),
+		NextPageToken: resp.GetNextPageToken(),
+	}, nil
+}
+
+// GetSystem returns the regional systems of a system, its rollup status and its first-degree relationships.
+func (s *SystemExtension) GetSystem(ctx context.Context, in *extensiongrpc.GetSystemRequest) (*extensiongrpc.GetSystemResponse, error) {
+	systems, rollup, err := s.services.Systems.GetSystem(ctx, in.GetExternalId(), in.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	displays, err := s.services.Displays.Displays(ctx, systems)
+	if err != nil {
+		return nil, err
+	}
+
+	relationships, err := s.services.Relationships.ListSystemRelationships(ctx, model.SystemIdentifier{
+		ExternalID: in.GetExternalId(),
+		Type:       in.GetType(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rollups := map[string]string{systemKey(in.GetExternalId(), in.GetType()): rollup}
+
+	return &extensiongrpc.GetSystemResponse{
+		RollupStatus:    rollup,
+		RegionalSystems: regionalSystemsToExtensionProto(systems, rollups, displays),
+		Relationships:   systemRelationshipsToProto(relationships),
+	}, nil
+}
+
+// BatchGetSystems returns the regional systems of the systems identified by their external ID and type
+// and the identifiers of the systems without regional systems, of the region if given.
+func (s *SystemExtension) BatchGetSystems(ctx context.Context, in *extensiongrpc.BatchGetSystemsRequest) (*extensiongrpc.BatchGetSystemsResponse, error) {
+	batch, err := s.services.Lookups.BatchGetSystems(ctx, systemIdentifiersFromExtensionProto(in.GetSystems()), in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	displays, err := s.services.Displays.Displays(ctx, batch.Systems)
+	if err != nil {
+		return nil, err
+	}
+
+	missing := make([]*extensiongrpc.SystemIdentifier, 0, len(batch.Missing))
+	for _, identifier := range batch.Missing {
+		missing = append(missing, &extensiongrpc.SystemIdentifier{
+			ExternalId: identifier.ExternalID,
+			Type:       identifier.Type,
+		})
+	}
+
+	return &extensiongrpc.BatchGetSystemsResponse{
+		Systems: regionalSystemsToExtensionProto(batch.Systems, batch.Rollups, displays),
+		Missing: missing,
+	}, nil
+}
+
+// UpdateSystem sets the display name and description of a system, unset fields keep their value.
+func (s *SystemExtension) UpdateSystem(ctx context.Context, in *extensiongrpc.UpdateSystemRequest) (*extensiongrpc.UpdateSystemResponse, error) {
+	system, err := s.services.Displays.UpdateSystem(ctx, in.GetExternalId(), in.GetType(), in.DisplayName, in.Description)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.UpdateSystemResponse{
+		ExternalId:  system.ExternalID,
+		Type:        system.Type,
+		DisplayName: system.DisplayName,
+		Description: system.Description,
+	}, nil
+}
+
+// CreateSystemRelationship creates the relationship of the type from the source system to the target system.
+func (s *SystemExtension) CreateSystemRelationship(ctx context.Context, in *extensiongrpc.CreateSystemRelationshipRequest) (*extensiongrpc.CreateSystemRelationshipResponse, error) {
+	relationship, err := s.services.Relationships.CreateSystemRelationship(ctx,
+		systemIdentifierFromExtensionProto(in.GetSource()), systemIdentifierFromExtensionProto(in.GetTarget()), in.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.CreateSystemRelationshipResponse{
+		Relationship: systemRelationshipToProto(relationship),
+	}, nil
+}
+
+// ListSystemRelationships returns the relationships the system is the source or the target of.
+func (s *SystemExtension) ListSystemRelationships(ctx context.Context, in *extensiongrpc.ListSystemRelationshipsRequest) (*extensiongrpc.ListSystemRelationshipsResponse, error) {
+	relationships, err := s.services.Relationships.ListSystemRelationships(ctx, systemIdentifierFromExtensionProto(in.GetSystem()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.ListSystemRelationshipsResponse{
+		Relationships: systemRelationshipsToProto(relationships),
+	}, nil
+}
+
+// DeleteSystemRelationship deletes the relationship of the type from the source system to the target system.
+func (s *SystemExtension) DeleteSystemRelationship(ctx context.Context, in *extensiongrpc.DeleteSystemRelationshipRequest) (*extensiongrpc.DeleteSystemRelationshipResponse, error) {
+	err := s.services.Relationships.DeleteSystemRelationship(ctx,
+		systemIdentifierFromExtensionProto(in.GetSource()), systemIdentifierFromExtensionProto(in.GetTarget()), in.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.DeleteSystemRelationshipResponse{Success: true}, nil
+}
+
+// RegisterSystems registers the regional systems of the requests of the stream in batches with the semantics
+// of RegisterSystem and answers each batch with its result.
+func (s *SystemExtension) RegisterSystems(stream extensiongrpc.SystemService_RegisterSystemsServer) error {
+	return s.services.Registrations.RegisterSystems(&registerSystemsServer{stream: stream})
+}
+
+// registerSystemsServer adapts the gRPC stream of RegisterSystems to RegisterSystemsStream.
+type registerSystemsServer struct {
+	stream extensiongrpc.SystemService_RegisterSystemsServer
+}
+
+func (s *registerSystemsServer) Context() context.Context {
+	return s.stream.Context()
+}
+
+func (s *registerSystemsServer) Recv() (*systemgrpc.RegisterSystemRequest, error) {
+	in, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	return &systemgrpc.RegisterSystemRequest{
+		ExternalId:    in.GetExternalId(),
+		L2KeyId:       in.GetL2KeyId(),
+		HasL1KeyClaim: in.GetHasL1KeyClaim(),
+		Region:        in.GetRegion(),
+		TenantId:      in.GetTenantId(),
+		Type:          in.GetType(),
+		Status:        typespb.Status(typespb.Status_value[in.GetStatus()]),
+		Labels:        in.GetLabels(),
+	}, nil
+}
+
+func (s *registerSystemsServer) Send(result *RegisterSystemsBatchResult) error {
+	resp := &extensiongrpc.RegisterSystemsResponse{
+		Batch:      int64(result.Batch),
+		Received:   int64(result.Received),
+		Registered: int64(result.Registered),
+		Failures:   make([]*extensiongrpc.RegisterSystemsFailure, 0, len(result.Failures)),
+	}
+	for _, failure := range result.Failures {
+		st := status.Convert(failure.Err)
+		resp.Failures = append(resp.Failures, &extensiongrpc.RegisterSystemsFailure{
+			Index:      int64(failure.Index),
+			ExternalId: failure.ExternalID,
+			Type:       failure.Type,
+			Region:     failure.Region,
+			Code:       st.Code().String(),
+			Message:    st.Message(),
+		})
+	}
+
+	return s.stream.Send(resp)
+}
+
+func systemIdentifierFromExtensionProto(identifier *extensiongrpc.SystemIdentifier) model.SystemIdentifier {
+	return model.SystemIdentifier{
+		ExternalID: identifier.GetExternalId(),
+		Type:       identifier.GetType(),
+	}
+}
+
+func systemRelationshipsToProto(relationships []SystemRelationship) []*extensiongrpc.SystemRelationship {
+	resp := make([]*extensiongrpc.SystemRelationship, 0, len(relationships))
+	for i := range relationships {
+		resp = append(resp, systemRelationshipToProto(&relationships[i]))
+	}
+
+	return resp
+}
+
+func systemRelationshipToProto(relationship *SystemRelationship) *extensiongrpc.SystemRelationship {
+	return &extensiongrpc.SystemRelationship{
+		Source:    &extensiongrpc.SystemIdentifier{ExternalId: relationship.Source.ExternalID, Type: relationship.Source.Type},
+		Target:    &extensiongrpc.SystemIdentifier{ExternalId: relationship.Target.ExternalID, Type: relationship.Target.Type},
+		Type:      relationship.Type,
+		CreatedAt: timestamppb.New(relationship.CreatedAt),
+	}
+}
+
+func systemCredentialToProto(credential *model.SystemCredential) *extensiongrpc.SystemCredential {
+	resp := &extensiongrpc.SystemCredential{
+		Id:         credential.ID.String(),
+		Thumbprint: credential.Thumbprint,
+		NotBefore:  timestamppb.New(credential.NotBefore),
+		NotAfter:   timestamppb.New(credential.NotAfter),
+		Status:     credential.Status,
+		CreatedAt:  timestamppb.New(credential.CreatedAt),
+	}
+
+	if credential.RevokedAt != nil {
+		resp.RevokedAt = timestamppb.New(*credential.RevokedAt)
+	}
+
+	return resp
+}
+
+// regionalSystemsToExtensionProto converts the regional systems with the rollup statuses and the displays
+// of their systems by systemKey.
+func regionalSystemsToExtensionProto(systems []*systemgrpc.System, rollups map[string]string, displays map[string]SystemDisplay) []*extensiongrpc.RegionalSystem {
+	resp := make([]*extensiongrpc.RegionalSystem, 0, len(systems))
+	for _, system := range systems {
+		key := systemKey(system.GetExternalId(), system.GetType())
+		resp = append(resp, &extensiongrpc.RegionalSystem{
+			ExternalId:    system.GetExternalId(),
+			TenantId:      system.GetTenantId(),
+			L2KeyId:       system.GetL2KeyId(),
+			HasL1KeyClaim: system.GetHasL1KeyClaim(),
+			Region:        system.GetRegion(),
+			Status:        system.GetStatus().String(),
+			Type:          system.GetType(),
+			Labels:        system.GetLabels(),
+			UpdatedAt:     system.GetUpdatedAt(),
+			CreatedAt:     system.GetCreatedAt(),
+			RollupStatus:  rollups[key],
+			DisplayName:   displays[key].DisplayName,
+			Description:   displays[key].Description,
+		})
+	}
+
+	return resp
+}
@@ -0,0 +1,373 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// SystemGroup manages named groups of systems within the namespace of a tenant.
+// Link, unlink and label operations on a group are expanded to all member systems within one transaction.
+// The procedure calls are served on the admin service, see Admin.
+type SystemGroup struct {
+	repo        repository.Repository
+	validation  *validation.Validation
+	labels      *Labels
+	identifiers *SystemIdentifiers
+}
+
+// NewSystemGroup creates and returns a new instance of SystemGroup.
+func NewSystemGroup(repo repository.Repository, validation *validation.Validation, labels *Labels, identifiers *SystemIdentifiers) *SystemGroup {
+	return &SystemGroup{
+		repo:        repo,
+		validation:  validation,
+		labels:      labels,
+		identifiers: identifiers,
+	}
+}
+
+// CreateSystemGroup creates a new group for an existing tenant.
+// The member systems do not need to exist yet.
+func (g *SystemGroup) CreateSystemGroup(ctx context.Context, group *model.SystemGroup) error {
+	slogctx.Debug(ctx, "CreateSystemGroup called", "tenantId", group.TenantID, "name", group.Name)
+
+	// the members are validated before duplicates are removed, so the violations refer to the members of the request
+	if err := g.identifiers.validate(ctx, "members", group.Members); err != nil {
+		return err
+	}
+
+	group.Members = uniqueMembers(group.Members)
+	if err := g.validateSystemGroup(group); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := g.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		err := assertTenantExist(ctx, r, group.TenantID)
+		if err != nil {
+			return err
+		}
+
+		err = r.Create(ctx, group)
+		if isUniqueConstraintError(err) {
+			return ErrorAlreadyExists(ResourceTypeSystemGroup, group.TenantID+"/"+group.Name)
+		}
+		if err != nil {
+			return ErrSystemGroupCreate
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// GetSystemGroup returns the group identified by its tenant and name.
+func (g *SystemGroup) GetSystemGroup(ctx context.Context, tenantID, name string) (*model.SystemGroup, error) {
+	slogctx.Debug(ctx, "GetSystemGroup called", "tenantId", tenantID, "name", name)
+
+	if err := g.validateGroupKey(tenantID, name); err != nil {
+		return nil, err
+	}
+
+	return getSystemGroup(ctx, g.repo, tenantID, name)
+}
+
+// ListSystemGroups returns all groups of the tenant.
+func (g *SystemGroup) ListSystemGroups(ctx context.Context, tenantID string) ([]model.SystemGroup, error) {
+	slogctx.Debug(ctx, "ListSystemGroups called", "tenantId", tenantID)
+
+	if tenantID == "" {
+		return nil, ErrNoTenantID
+	}
+
+	query := repository.NewQuery(&model.SystemGroup{}).Where(
+		repository.NewCompositeKey().Where(repository.TenantIDField, tenantID),
+	)
+
+	var groups []model.SystemGroup
+	if err := g.repo.List(ctx, &groups, *query); err != nil {
+		return nil, ErrSystemGroupSelect
+	}
+
+	return groups, nil
+}
+
+// UpdateSystemGroup replaces the members and labels of the group.
+func (g *SystemGroup) UpdateSystemGroup(ctx context.Context, tenantID, name string, members []model.SystemIdentifier, labels map[string]string) error {
+	slogctx.Debug(ctx, "UpdateSystemGroup called", "tenantId", tenantID, "name", name)
+
+	if err := g.identifiers.validate(ctx, "members", members); err != nil {
+		return err
+	}
+
+	update := &model.SystemGroup{
+		Name:     name,
+		TenantID: tenantID,
+		Members:  uniqueMembers(members),
+		Labels:   labels,
+	}
+	if err := g.validateSystemGroup(update); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := g.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		group, err := getSystemGroup(ctx, r, tenantID, name)
+		if err != nil {
+			return err
+		}
+
+		update.ID = group.ID
+		if update.Members == nil {
+			update.Members = []model.SystemIdentifier{}
+		}
+		if update.Labels == nil {
+			update.Labels = map[string]string{}
+		}
+
+		isPatched, err := r.Patch(ctx, update)
+		if err != nil || !isPatched {
+			return ErrSystemGroupUpdate
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// DeleteSystemGroup deletes the group. The member systems are not changed.
+func (g *SystemGroup) DeleteSystemGroup(ctx context.Context, tenantID, name string) error {
+	slogctx.Debug(ctx, "DeleteSystemGroup called", "tenantId", tenantID, "name", name)
+
+	if err := g.validateGroupKey(tenantID, name); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := g.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		group, err := getSystemGroup(ctx, r, tenantID, name)
+		if err != nil {
+			return err
+		}
+
+		_, err = r.Delete(ctx, group)
+		if err != nil {
+			return ErrSystemGroupDelete
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// LinkSystemGroup links all member systems of the group to the tenant of the group.
+// Either all systems are linked or none.
+func (g *SystemGroup) LinkSystemGroup(ctx context.Context, tenantID, name string) error {
+	slogctx.Debug(ctx, "LinkSystemGroup called", "tenantId", tenantID, "name", name)
+
+	return g.forEachMember(ctx, tenantID, name, func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error {
+		return mapSystemToTenant(ctx, g.validation, g.labels, r, tenantID, member.ExternalID, member.Type)
+	})
+}
+
+// UnlinkSystemGroup unlinks all member systems of the group from the tenant of the group.
+// Either all systems are unlinked or none.
+func (g *SystemGroup) UnlinkSystemGroup(ctx context.Context, tenantID, name string) error {
+	slogctx.Debug(ctx, "UnlinkSystemGroup called", "tenantId", tenantID, "name", name)
+
+	return g.forEachMember(ctx, tenantID, name, func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error {
+		return unmapSystemFromTenant(ctx, g.labels, r, tenantID, member.ExternalID, member.Type)
+	})
+}
+
+// SetSystemGroupLabels sets the labels on all regional systems of all member systems of the group.
+// Existing labels with the same keys will be overwritten.
+func (g *SystemGroup) SetSystemGroupLabels(ctx context.Context, tenantID, name string, labels map[string]string) error {
+	slogctx.Debug(ctx, "SetSystemGroupLabels called", "tenantId", tenantID, "name", name)
+
+	if err := g.labels.validateSet(model.RegionalSystemLabelsValidationID, labels); err != nil {
+		return err
+	}
+
+	err := g.forEachMember(ctx, tenantID, name, func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error {
+		return patchMemberLabels(ctx, r, member, func(current map[string]string) (map[string]string, error) {
+			return mergeLabels(current, labels), g.labels.checkSet(current, labels)
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	g.labels.audit(ctx, ResourceTypeSystemGroup, tenantID+"/"+name, labelOperationSet, slices.Collect(maps.Keys(labels)))
+
+	return nil
+}
+
+// RemoveSystemGroupLabels removes the labels from all regional systems of all member systems of the group.
+// If one or more label keys are not found, they will be ignored.
+func (g *SystemGroup) RemoveSystemGroupLabels(ctx context.Context, tenantID, name string, labelKeys []string) error {
+	slogctx.Debug(ctx, "RemoveSystemGroupLabels called", "tenantId", tenantID, "name", name)
+
+	if err := g.labels.validateRemove(labelKeys); err != nil {
+		return err
+	}
+
+	err := g.forEachMember(ctx, tenantID, name, func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error {
+		return patchMemberLabels(ctx, r, member, func(current map[string]string) (map[string]string, error) {
+			return removeLabels(current, labelKeys), nil
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	g.labels.audit(ctx, ResourceTypeSystemGroup, tenantID+"/"+name, labelOperationRemove, labelKeys)
+
+	return nil
+}
+
+// forEachMember runs fn for every member system of the group within one transaction.
+func (g *SystemGroup) forEachMember(ctx context.Context, tenantID, name string,
+	fn func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error,
+) error {
+	if err := g.validateGroupKey(tenantID, name); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := g.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		group, err := getSystemGroup(ctx, r, tenantID, name)
+		if err != nil {
+			return err
+		}
+
+		if len(group.Members) == 0 {
+			return ErrSystemGroupEmpty
+		}
+
+		for _, member := range group.Members {
+			err = fn(ctx, r, member)
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// patchMemberLabels applies the update to the labels of all regional systems of the member system.
+func patchMemberLabels(ctx context.Context, r repository.Repository, member model.SystemIdentifier, update func(labels map[string]string) (map[string]string, error)) error {
+	system, found, err := getSystem(ctx, r, member.ExternalID, member.Type)
+	if err != nil {
+		return ErrSystemSelect
+	}
+	if !found {
+		return ErrorWithParams(ErrSystemNotFound, "externalID", member.ExternalID, "type", member.Type)
+	}
+
+	regionalSystems, err := getRegionalSystemsFromSystemID(ctx, r, system.ID.String())
+	if err != nil {
+		return err
+	}
+
+	for _, rs := range regionalSystems {
+		if err := checkRegionalSystemAvailable(&rs); err != nil {
+			return err
+		}
+
+		labels, err := update(rs.Labels)
+		if err != nil {
+			return err
+		}
+
+		systemToPatch := &model.RegionalSystem{
+			SystemID: rs.SystemID,
+			Region:   rs.Region,
+			Labels:   labels,
+		}
+
+		isPatched, err := r.Patch(ctx, systemToPatch)
+		if err != nil || !isPatched {
+			return ErrSystemUpdate
+		}
+	}
+
+	return nil
+}
+
+// getSystemGroup fetches the group by its tenant and name.
+func getSystemGroup(ctx context.Context, r repository.Repository, tenantID, name string) (*model.SystemGroup, error) {
+	group := &model.SystemGroup{
+		TenantID: tenantID,
+		Name:     name,
+	}
+
+	found, err := r.Find(ctx, group)
+	if err != nil {
+		return nil, ErrSystemGroupSelect
+	}
+
+	if !found {
+		return nil, ErrorWithParams(ErrSystemGroupNotFound, "tenantID", tenantID, "name", name)
+	}
+
+	return group, nil
+}
+
+// uniqueMembers removes duplicate members while keeping their order.
+func uniqueMembers(members []model.SystemIdentifier) []model.SystemIdentifier {
+	unique := make([]model.SystemIdentifier, 0, len(members))
+	for _, m := range members {
+		if !slices.Contains(unique, m) {
+			unique = append(unique, m)
+		}
+	}
+
+	return unique
+}
+
+func (g *SystemGroup) validateGroupKey(tenantID, name string) error {
+	err := g.validation.ValidateAll(map[validation.ID]any{
+		model.SystemGroupTenantIDValidationID: tenantID,
+		model.SystemGroupNameValidationID:     name,
+	})
+	if err != nil {
+		return validationFailed(err)
+	}
+
+	return nil
+}
+
+func (g *SystemGroup) validateSystemGroup(group *model.SystemGroup) error {
+	values, err := validation.GetValues(group)
+	if err != nil {
+		return ErrValidationConversion
+	}
+
+	err = g.validation.ValidateAll(values)
+	if err != nil {
+		return validationFailed(err)
+	}
+
+	return g.labels.checkLimits(group.Labels)
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc/status"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Sizes of the chunks of chunked links and unlinks of system groups.
+const (
+	defaultSystemGroupChunkSize = 100
+	maxSystemGroupChunkSize     = 1000
+)
+
+type (
+	// SystemGroupChunkStream is the server side of a chunked link or unlink of a system group.
+	// It is satisfied by a gRPC server stream of progress messages.
+	SystemGroupChunkStream interface {
+		Context() context.Context
+		Send(*SystemGroupChunkProgress) error
+	}
+
+	// SystemGroupChunkRequest selects the group to link or unlink in chunks and the size of the chunks.
+	// With AbortOnFailure the first failed chunk stops the operation and the chunks processed before are reverted.
+	SystemGroupChunkRequest struct {
+		TenantID       string
+		Name           string
+		ChunkSize      int
+		AbortOnFailure bool
+	}
+
+	// SystemGroupChunkProgress is the progress of a chunked link or unlink after a chunk, with the numbers
+	// of the members processed so far and the failures of the chunk.
+	SystemGroupChunkProgress struct {
+		Chunk     int
+		Chunks    int
+		Succeeded int
+		Failed    int
+		Failures  []SystemGroupMemberFailure
+		// Reverted is true if the chunks processed before were reverted after the chunk failed.
+		Reverted bool
+	}
+
+	// SystemGroupMemberFailure is a member of a failed chunk with the error of the chunk.
+	SystemGroupMemberFailure struct {
+		Member model.SystemIdentifier
+		Err    error
+	}
+
+	// memberOperation links or unlinks a member within the transaction of its chunk.
+	memberOperation func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error
+)
+
+// LinkSystemGroupChunked links the member systems of the group to the tenant of the group in chunks,
+// each within its own transaction, and sends the progress after each chunk, so clients can show the progress
+// of large groups. If the client cancels the call, the members linked by it are unlinked again.
+func (g *SystemGroup) LinkSystemGroupChunked(stream SystemGroupChunkStream, req SystemGroupChunkRequest) error {
+	link := func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error {
+		return mapSystemToTenant(ctx, g.validation, g.labels, r, req.TenantID, member.ExternalID, member.Type)
+	}
+	unlink := func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error {
+		return unmapSystemFromTenant(ctx, g.labels, r, req.TenantID, member.ExternalID, member.Type)
+	}
+
+	return g.forEachMemberChunk(stream, req, link, unlink)
+}
+
+// UnlinkSystemGroupChunked unlinks the member systems of the group from the tenant of the group in chunks,
+// like LinkSystemGroupChunked. If the client cancels the call, the members unlinked by it are linked again.
+func (g *SystemGroup) UnlinkSystemGroupChunked(stream SystemGroupChunkStream, req SystemGroupChunkRequest) error {
+	unlink := func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error {
+		return unmapSystemFromTenant(ctx, g.labels, r, req.TenantID, member.ExternalID, member.Type)
+	}
+	link := func(ctx context.Context, r repository.Repository, member model.SystemIdentifier) error {
+		return mapSystemToTenant(ctx, g.validation, g.labels, r, req.TenantID, member.ExternalID, member.Type)
+	}
+
+	return g.forEachMemberChunk(stream, req, unlink, link)
+}
+
+// forEachMemberChunk runs the operation for the members of the group as of the start, one transaction per chunk.
+// A failed chunk is rolled back and reported with its members as failures, the following chunks are processed
+// unless the request aborts on failures. The chunks processed before are reverted by undo if the call is canceled
+// or aborted.
+func (g *SystemGroup) forEachMemberChunk(stream SystemGroupChunkStream, req SystemGroupChunkRequest, do, undo memberOperation) error {
+	ctx := slogctx.With(stream.Context(), "tenantId", req.TenantID, "name", req.Name)
+	slogctx.Debug(ctx, "chunked system group operation called", "chunkSize", req.ChunkSize)
+
+	if err := g.validateGroupKey(req.TenantID, req.Name); err != nil {
+		return err
+	}
+
+	group, err := getSystemGroup(ctx, g.repo, req.TenantID, req.Name)
+	if err != nil {
+		return err
+	}
+
+	if len(group.Members) == 0 {
+		return ErrSystemGroupEmpty
+	}
+
+	chunkSize := defaultSystemGroupChunkSize
+	if req.ChunkSize > 0 {
+		chunkSize = min(req.ChunkSize, maxSystemGroupChunkSize)
+	}
+
+	chunks := slices.Collect(slices.Chunk(group.Members, chunkSize))
+	progress := &SystemGroupChunkProgress{Chunks: len(chunks)}
+
+	var processed [][]model.SystemIdentifier
+	for i, chunk := range chunks {
+		if ctx.Err() != nil {
+			g.revertChunks(ctx, processed, undo)
+			return status.FromContextError(ctx.Err()).Err()
+		}
+
+		progress.Chunk = i + 1
+		progress.Failures = nil
+
+		err := g.runChunk(ctx, chunk, do)
+		if err != nil {
+			progress.Failed += len(chunk)
+			for _, member := range chunk {
+				progress.Failures = append(progress.Failures, SystemGroupMemberFailure{Member: member, Err: err})
+			}
+		} else {
+			progress.Succeeded += len(chunk)
+			processed = append(processed, chunk)
+		}
+
+		abort := err != nil && req.AbortOnFailure
+		if abort {
+			g.revertChunks(ctx, processed, undo)
+			progress.Reverted = true
+		}
+
+		if err := stream.Send(progress); err != nil {
+			if !abort {
+				g.revertChunks(ctx, processed, undo)
+			}
+			return err
+		}
+
+		if abort {
+			return nil
+		}
+	}
+
+	return nil
+}
+
+// runChunk runs the operation for the members of the chunk within one transaction, either all or none.
+func (g *SystemGroup) runChunk(ctx context.Context, chunk []model.SystemIdentifier, operation memberOperation) error {
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := g.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		for _, member := range chunk {
+			if err := operation(ctx, r, member); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// revertChunks reverts the processed chunks in reverse order, even if the call is canceled.
+// Chunks which cannot be reverted, e.g. as their members were changed meanwhile, are logged.
+func (g *SystemGroup) revertChunks(ctx context.Context, processed [][]model.SystemIdentifier, undo memberOperation) {
+	ctx = context.WithoutCancel(ctx)
+
+	for _, chunk := range slices.Backward(processed) {
+		err := g.runChunk(ctx, chunk, undo)
+		if err != nil {
+			slogctx.Error(ctx, "failed to revert chunk of system group operation", "members", len(chunk), "code", status.Code(err), "error", err)
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// identifierFields are the fields of the SystemIdentifier messages by the validation IDs of their values.
+var identifierFields = map[validation.ID]string{
+	model.SystemExternalIDValidationID: "external_id",
+	model.SystemTypeValidationID:       "type",
+}
+
+// SystemIdentifiers validates the system identifiers of batch requests, e.g. the members of a system group.
+// All identifiers are validated, so clients can correct every invalid identifier at once.
+type SystemIdentifiers struct {
+	validation *validation.Validation
+	cfg        config.BatchValidation
+}
+
+// NewSystemIdentifiers creates and returns a new instance of SystemIdentifiers.
+func NewSystemIdentifiers(validation *validation.Validation, cfg config.BatchValidation) *SystemIdentifiers {
+	return &SystemIdentifiers{
+		validation: validation,
+		cfg:        cfg,
+	}
+}
+
+// validate returns ErrValidationFailed with a BadRequest violation per invalid field of the identifiers,
+// named by the field of the identifiers in the request and their index, e.g. systems[3].external_id.
+func (s *SystemIdentifiers) validate(ctx context.Context, field string, identifiers []model.SystemIdentifier) error {
+	fieldErrs, complete, err := s.validateEach(ctx, identifiers, s.cfg.MaxViolations)
+	if err != nil {
+		return err
+	}
+
+	var violations []*errdetails.BadRequest_FieldViolation
+	for i, errs := range fieldErrs {
+		for _, fieldErr := range errs {
+			violations = append(violations, &errdetails.BadRequest_FieldViolation{
+				Field:       fmt.Sprintf("%s[%d].%s", field, i, identifierFields[fieldErr.ID]),
+				Description: fieldErr.Err.Error(),
+			})
+		}
+	}
+
+	if len(violations) == 0 {
+		return nil
+	}
+
+	// the goroutines may find a few violations more than the maximum before they stop
+	if s.cfg.MaxViolations > 0 && len(violations) > s.cfg.MaxViolations {
+		violations = violations[:s.cfg.MaxViolations]
+	}
+
+	params := []any{"err", "invalid systems", "violations", len(violations)}
+	if !complete {
+		params = append(params, "maxViolations", s.cfg.MaxViolations)
+	}
+
+	return ErrorWithFieldViolations(ErrorWithParams(ErrValidationFailed, params...), violations...)
+}
+
+// validateEach validates the external ID and type of each identifier and returns their errors by the index
+// of the identifier. Requests with more than MaxIdentifiers identifiers are rejected before validation.
+// The identifiers are split into chunks validated by at most Parallelism goroutines, which stop once
+// maxViolations violations are found, then complete is false. Zero maxViolations validates all identifiers.
+func (s *SystemIdentifiers) validateEach(ctx context.Context, identifiers []model.SystemIdentifier, maxViolations int) ([][]*validation.FieldError, bool, error) {
+	if s.cfg.MaxIdentifiers > 0 && len(identifiers) > s.cfg.MaxIdentifiers {
+		return nil, false, ErrorWithParams(ErrValidationFailed, "err", "too many systems", "max", s.cfg.MaxIdentifiers)
+	}
+
+	fieldErrs := make([][]*validation.FieldError, len(identifiers))
+	var found atomic.Int64
+
+	validateChunk := func(start, end int) {
+		for i := start; i < end; i++ {
+			if ctx.Err() != nil || enough(found.Load(), maxViolations) {
+				return
+			}
+
+			fieldErrs[i] = s.validateIdentifier(identifiers[i])
+			found.Add(int64(len(fieldErrs[i])))
+		}
+	}
+
+	workers := min(s.cfg.Parallelism, len(identifiers))
+	if workers <= 1 {
+		validateChunk(0, len(identifiers))
+	} else {
+		chunkSize := (len(identifiers) + workers - 1) / workers
+
+		var wg sync.WaitGroup
+		for i := range workers {
+			start := i * chunkSize
+			end := min(start+chunkSize, len(identifiers))
+
+			wg.Go(func() {
+				validateChunk(start, end)
+			})
+		}
+		wg.Wait()
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, false, mapError(err)
+	}
+
+	return fieldErrs, !enough(found.Load(), maxViolations), nil
+}
+
+// enough returns true if the number of violations found reached the maximum, if any.
+func enough(found int64, maxViolations int) bool {
+	return maxViolations > 0 && found >= int64(maxViolations)
+}
+
+// identifierError returns ErrValidationFailed with the errors of the invalid fields of an identifier.
+func identifierError(fieldErrs []*validation.FieldError) error {
+	errs := make([]error, 0, len(fieldErrs))
+	for _, fieldErr := range fieldErrs {
+		errs = append(errs, fieldErr)
+	}
+
+	return validationFailed(errors.Join(errs...))
+}
+
+// validateIdentifier returns the errors of the invalid fields of the identifier.
+func (s *SystemIdentifiers) validateIdentifier(identifier model.SystemIdentifier) []*validation.FieldError {
+	var fieldErrs []*validation.FieldError
+	for _, field := range []struct {
+		id    validation.ID
+		value string
+	}{
+		{id: model.SystemExternalIDValidationID, value: identifier.ExternalID},
+		{id: model.SystemTypeValidationID, value: identifier.Type},
+	} {
+		if fieldErr, ok := errors.AsType[*validation.FieldError](s.validation.Validate(field.id, field.value)); ok {
+			fieldErrs = append(fieldErrs, fieldErr)
+		}
+	}
+
+	return fieldErrs
+}
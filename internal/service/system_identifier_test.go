@@ -0,0 +1,110 @@
+package service_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+func TestSystemIdentifiersValidate(t *testing.T) {
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{&model.System{}},
+		Fields: []validation.ConfigField{
+			{
+				ID:          model.SystemTypeValidationID,
+				Constraints: []validation.Constraint{{Type: validation.ConstraintTypeList, Spec: &validation.ConstraintSpec{AllowList: []string{"system"}}}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	identifiers := func(n int, valid func(i int) bool) []model.SystemIdentifier {
+		result := make([]model.SystemIdentifier, 0, n)
+		for i := range n {
+			if valid(i) {
+				result = append(result, model.SystemIdentifier{ExternalID: fmt.Sprintf("ext-%d", i), Type: "system"})
+			} else {
+				result = append(result, model.SystemIdentifier{Type: "unknown"})
+			}
+		}
+		return result
+	}
+
+	violatedFields := func(t *testing.T, err error) []string {
+		t.Helper()
+		sts, ok := status.FromError(err)
+		require.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, sts.Code())
+
+		var fields []string
+		for _, detail := range sts.Details() {
+			if badRequest, ok := detail.(*errdetails.BadRequest); ok {
+				for _, violation := range badRequest.GetFieldViolations() {
+					fields = append(fields, violation.GetField())
+				}
+			}
+		}
+		return fields
+	}
+
+	t.Run("should accept valid identifiers", func(t *testing.T) {
+		subj := service.NewSystemIdentifiers(v, config.BatchValidation{Parallelism: 4})
+
+		err := subj.Validate(t.Context(), "members", identifiers(10, func(int) bool { return true }))
+
+		assert.NoError(t, err)
+	})
+
+	t.Run("should return a violation per invalid field with the index of the identifier", func(t *testing.T) {
+		for _, parallelism := range []int{0, 1, 4} {
+			t.Run(fmt.Sprintf("parallelism %d", parallelism), func(t *testing.T) {
+				subj := service.NewSystemIdentifiers(v, config.BatchValidation{Parallelism: parallelism})
+
+				err := subj.Validate(t.Context(), "members", identifiers(10, func(i int) bool { return i != 1 && i != 7 }))
+
+				assert.ElementsMatch(t, []string{
+					"members[1].external_id", "members[1].type",
+					"members[7].external_id", "members[7].type",
+				}, violatedFields(t, err))
+			})
+		}
+	})
+
+	t.Run("should reject more identifiers than the maximum before validation", func(t *testing.T) {
+		subj := service.NewSystemIdentifiers(v, config.BatchValidation{MaxIdentifiers: 5})
+
+		err := subj.Validate(t.Context(), "members", identifiers(6, func(int) bool { return true }))
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, violatedFields(t, err))
+	})
+
+	t.Run("should stop after the maximum number of violations", func(t *testing.T) {
+		subj := service.NewSystemIdentifiers(v, config.BatchValidation{MaxViolations: 3, Parallelism: 4})
+
+		err := subj.Validate(t.Context(), "systems", identifiers(100, func(int) bool { return false }))
+
+		assert.Len(t, violatedFields(t, err), 3)
+	})
+
+	t.Run("should return the context error if the context is done", func(t *testing.T) {
+		subj := service.NewSystemIdentifiers(v, config.BatchValidation{Parallelism: 4})
+		ctx, cancel := context.WithCancel(t.Context())
+		cancel()
+
+		err := subj.Validate(ctx, "members", identifiers(10, func(int) bool { return false }))
+
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
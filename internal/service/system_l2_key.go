@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// UpdateSystemL2Key rotates the L2 key of the regional system identified by its external ID, type and region.
+// The previous key is retired in the L2 key history of the regional system, instead of being overwritten.
+// The procedure call is served on the extension system service, see SystemExtension.
+func (s *System) UpdateSystemL2Key(ctx context.Context, externalID, systemType, region, l2KeyID string) error {
+	slogctx.Debug(ctx, "UpdateSystemL2Key called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := s.validateExternalIDTypeAndRegion(externalID, systemType, region); err != nil {
+		return err
+	}
+
+	if err := s.validation.ValidateAll(map[validation.ID]any{
+		model.RegionalSystemL2KeyIDValidationID: l2KeyID,
+	}); err != nil {
+		return validationFailed(err)
+	}
+
+	if err := s.checkL2Key(ctx, region, l2KeyID); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		regionalSystem, err := getRegionalSystem(ctx, r, externalID, systemType, region)
+		if err != nil {
+			return err
+		}
+
+		if err := checkRegionalSystemAvailable(regionalSystem); err != nil {
+			return err
+		}
+
+		if regionalSystem.L2KeyID == l2KeyID {
+			return nil
+		}
+
+		history, err := listSystemL2Keys(ctx, r, regionalSystem)
+		if err != nil {
+			return err
+		}
+
+		// Regional systems registered before the history was recorded get their
+		// registered key as first entry, so the rotation is not lost.
+		if len(history) == 0 {
+			err = recordL2Key(ctx, r, regionalSystem, regionalSystem.CreatedAt)
+			if err != nil {
+				return err
+			}
+
+			history, err = listSystemL2Keys(ctx, r, regionalSystem)
+			if err != nil {
+				return err
+			}
+		}
+
+		now := time.Now()
+		for _, key := range history {
+			if !key.IsCurrent() {
+				continue
+			}
+
+			isPatched, err := r.Patch(ctx, &model.SystemL2Key{ID: key.ID, RetiredAt: &now})
+			if err != nil || !isPatched {
+				return ErrSystemL2KeyUpdate
+			}
+		}
+
+		isPatched, err := r.Patch(ctx, &model.RegionalSystem{
+			SystemID: regionalSystem.SystemID,
+			Region:   regionalSystem.Region,
+			L2KeyID:  l2KeyID,
+		})
+		if err != nil || !isPatched {
+			return ErrSystemUpdate
+		}
+
+		regionalSystem.L2KeyID = l2KeyID
+
+		err = recordL2Key(ctx, r, regionalSystem, now)
+		if err != nil {
+			return err
+		}
+
+		slogctx.Info(ctx, "system L2 key rotated", "externalId", externalID, "region", region)
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// GetSystemKeyHistory returns the L2 keys the regional system was assigned, the current key first.
+// The procedure call is served on the extension system service, see SystemExtension.
+func (s *System) GetSystemKeyHistory(ctx context.Context, externalID, systemType, region string) ([]model.SystemL2Key, error) {
+	slogctx.Debug(ctx, "GetSystemKeyHistory called", "externalId", externalID, "type", systemType, "region", region)
+
+	if err := s.validateExternalIDTypeAndRegion(externalID, systemType, region); err != nil {
+		return nil, err
+	}
+
+	regionalSystem, err := getRegionalSystem(ctx, s.repo, externalID, systemType, region)
+	if err != nil {
+		return nil, mapError(err)
+	}
+
+	return listSystemL2Keys(ctx, s.repo, regionalSystem)
+}
+
+// checkL2Key checks the L2 key ID of a regional system of the region by the L2 key policy, if any.
+func (s *System) checkL2Key(ctx context.Context, region, l2KeyID string) error {
+	if s.l2Keys == nil {
+		return nil
+	}
+
+	return s.l2Keys.CheckL2Key(ctx, region, l2KeyID)
+}
+
+// recordL2Key records the current L2 key of the regional system as assigned at the given time.
+func recordL2Key(ctx context.Context, r repository.Repository, regionalSystem *model.RegionalSystem, assignedAt time.Time) error {
+	err := r.Create(ctx, &model.SystemL2Key{
+		SystemID:  regionalSystem.SystemID,
+		Region:    regionalSystem.Region,
+		L2KeyID:   regionalSystem.L2KeyID,
+		CreatedAt: assignedAt,
+	})
+	if err != nil {
+		return ErrSystemL2KeyCreate
+	}
+
+	return nil
+}
+
+// listSystemL2Keys lists the L2 key history of the regional system, newest first.
+func listSystemL2Keys(ctx context.Context, r repository.Repository, regionalSystem *model.RegionalSystem) ([]model.SystemL2Key, error) {
+	query := repository.NewQuery(&model.SystemL2Key{}).Where(
+		repository.NewCompositeKey().
+			Where(repository.SystemIDField, regionalSystem.SystemID).
+			Where(repository.RegionField, regionalSystem.Region),
+	)
+
+	var keys []model.SystemL2Key
+	if err := r.List(ctx, &keys, *query); err != nil {
+		return nil, ErrSystemL2KeySelect
+	}
+
+	return keys, nil
+}
+
+// deleteSystemL2Keys deletes the L2 key history of the regional system.
+func deleteSystemL2Keys(ctx context.Context, r repository.Repository, regionalSystem *model.RegionalSystem) error {
+	keys, err := listSystemL2Keys(ctx, r, regionalSystem)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if _, err := r.Delete(ctx, &key); err != nil {
+			return ErrSystemDelete
+		}
+	}
+
+	return nil
+}
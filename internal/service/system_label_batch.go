@@ -0,0 +1,280 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"google.golang.org/grpc/status"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// SystemLabelBatches sets and removes labels on all regional systems matching a filter, e.g. the label of a
+// patch wave on the systems of a tenant in a region. The matching systems are scanned page by page at the pace
+// of the table scans and each page is updated within its own transaction, so a batch over many systems neither
+// holds a long transaction nor loads the database. The procedure calls are served on the admin service, see Admin.
+type SystemLabelBatches struct {
+	repo   repository.Repository
+	labels *Labels
+	scan   config.TableScan
+}
+
+// SystemLabelFilter matches the regional systems by all its set fields.
+type SystemLabelFilter struct {
+	TenantID string
+	Region   string
+	Type     string
+	Labels   map[string]string
+}
+
+// SystemLabelBatchSummary counts the regional systems of a batch label operation.
+// Matched is the sum of the other numbers.
+type SystemLabelBatchSummary struct {
+	Matched   int64
+	Updated   int64
+	Unchanged int64
+	Skipped   int64
+	DryRun    bool
+}
+
+// NewSystemLabelBatches creates and returns a new instance of SystemLabelBatches.
+func NewSystemLabelBatches(repo repository.Repository, labels *Labels, scan config.TableScan) *SystemLabelBatches {
+	return &SystemLabelBatches{
+		repo:   repo,
+		labels: labels,
+		scan:   scan,
+	}
+}
+
+// BatchSetSystemLabels sets the labels on all regional systems matching the filter.
+// Existing labels with the same keys will be overwritten. The regional systems which are not available
+// or would exceed the label limits are skipped. A dry run counts the regional systems without updating them.
+func (b *SystemLabelBatches) BatchSetSystemLabels(ctx context.Context, filter SystemLabelFilter, labels map[string]string, dryRun bool) (*SystemLabelBatchSummary, error) {
+	slogctx.Debug(ctx, "BatchSetSystemLabels called", "tenantId", filter.TenantID, "region", filter.Region, "type", filter.Type, "dryRun", dryRun)
+
+	if err := validateSystemLabelFilter(filter); err != nil {
+		return nil, err
+	}
+
+	if err := b.labels.validateSet(model.RegionalSystemLabelsValidationID, labels); err != nil {
+		return nil, err
+	}
+
+	return b.run(ctx, filter, dryRun, labelOperationSet, slices.Collect(maps.Keys(labels)), func(current map[string]string) (map[string]string, error) {
+		return mergeLabels(current, labels), b.labels.checkSet(current, labels)
+	})
+}
+
+// BatchRemoveSystemLabels removes the labels from all regional systems matching the filter.
+// The regional systems which are not available are skipped, those without the labels are unchanged.
+// A dry run counts the regional systems without updating them.
+func (b *SystemLabelBatches) BatchRemoveSystemLabels(ctx context.Context, filter SystemLabelFilter, labelKeys []string, dryRun bool) (*SystemLabelBatchSummary, error) {
+	slogctx.Debug(ctx, "BatchRemoveSystemLabels called", "tenantId", filter.TenantID, "region", filter.Region, "type", filter.Type, "dryRun", dryRun)
+
+	if err := validateSystemLabelFilter(filter); err != nil {
+		return nil, err
+	}
+
+	if err := b.labels.validateRemove(labelKeys); err != nil {
+		return nil, err
+	}
+
+	return b.run(ctx, filter, dryRun, labelOperationRemove, labelKeys, func(current map[string]string) (map[string]string, error) {
+		return removeLabels(current, labelKeys), nil
+	})
+}
+
+// run applies the update to the labels of the regional systems matching the filter, one page of them per
+// transaction. The pages updated before a failed page stay updated.
+func (b *SystemLabelBatches) run(ctx context.Context, filter SystemLabelFilter, dryRun bool, operation string, keys []string,
+	update func(labels map[string]string) (map[string]string, error),
+) (*SystemLabelBatchSummary, error) {
+	summary := &SystemLabelBatchSummary{DryRun: dryRun}
+
+	scanner := newTableScanner[model.RegionalSystem](b.scan,
+		func() *repository.Query { return systemLabelFilterQuery(filter) },
+		func(rs *model.RegionalSystem) time.Time { return rs.CreatedAt })
+
+	_, err := scanner.Scan(ctx, b.repo, func(ctx context.Context, page []model.RegionalSystem) error {
+		updated, err := b.updatePage(ctx, filter, page, dryRun, summary, update)
+		if err != nil {
+			return err
+		}
+
+		if dryRun {
+			return nil
+		}
+
+		for _, rs := range updated {
+			b.labels.audit(ctx, ResourceTypeSystem, rs.System.Type+"/"+rs.System.ExternalID+"/"+rs.Region, operation, keys)
+		}
+
+		return nil
+	})
+	if err != nil {
+		slogctx.Error(ctx, "failed to run batch label operation", "operation", operation, "updated", summary.Updated, "error", err)
+
+		if _, ok := status.FromError(err); !ok {
+			return nil, ErrSystemSelect
+		}
+
+		return nil, mapError(err)
+	}
+
+	slogctx.Info(ctx, "batch label operation completed", "operation", operation, "dryRun", dryRun,
+		"matched", summary.Matched, "updated", summary.Updated, "unchanged", summary.Unchanged, "skipped", summary.Skipped)
+
+	return summary, nil
+}
+
+// updatePage applies the update to the labels of the regional systems of the page which still match the filter
+// within a transaction, adds them to the summary and returns the updated regional systems.
+func (b *SystemLabelBatches) updatePage(ctx context.Context, filter SystemLabelFilter, page []model.RegionalSystem, dryRun bool,
+	summary *SystemLabelBatchSummary, update func(labels map[string]string) (map[string]string, error),
+) ([]model.RegionalSystem, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	var counted SystemLabelBatchSummary
+	var updated []model.RegionalSystem
+
+	err := b.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		// a retried transaction counts the page again
+		counted = SystemLabelBatchSummary{}
+		updated = nil
+
+		systems, err := lockSystemLabelPage(ctx, r, filter, page)
+		if err != nil {
+			return err
+		}
+
+		for _, rs := range systems {
+			counted.Matched++
+
+			if err := checkRegionalSystemAvailable(&rs); err != nil {
+				counted.Skipped++
+				continue
+			}
+
+			labels, err := update(rs.Labels)
+			if err != nil {
+				counted.Skipped++
+				continue
+			}
+
+			if maps.Equal(labels, rs.Labels) {
+				counted.Unchanged++
+				continue
+			}
+
+			counted.Updated++
+			updated = append(updated, rs)
+
+			if dryRun {
+				continue
+			}
+
+			isPatched, err := r.Patch(ctx, &model.RegionalSystem{
+				SystemID: rs.SystemID,
+				Region:   rs.Region,
+				Labels:   labels,
+			})
+			if err != nil || !isPatched {
+				return ErrSystemUpdate
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	summary.Matched += counted.Matched
+	summary.Updated += counted.Updated
+	summary.Unchanged += counted.Unchanged
+	summary.Skipped += counted.Skipped
+
+	return updated, nil
+}
+
+// lockSystemLabelPage returns the regional systems of the page which still match the filter, locked by the transaction
+// of r and with their system. The regional systems changed since the page was read are matched by their current labels.
+func lockSystemLabelPage(ctx context.Context, r repository.Repository, filter SystemLabelFilter, page []model.RegionalSystem) ([]model.RegionalSystem, error) {
+	type pageKey struct {
+		systemID uuid.UUID
+		region   string
+	}
+
+	keys := make(map[pageKey]struct{}, len(page))
+	ids := make([]uuid.UUID, 0, len(page))
+	for _, rs := range page {
+		keys[pageKey{systemID: rs.SystemID, region: rs.Region}] = struct{}{}
+		ids = append(ids, rs.SystemID)
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+	ids = slices.Compact(ids)
+
+	locked := make([]model.RegionalSystem, 0, len(page))
+	for chunk := range slices.Chunk(ids, repository.MaxFilterValues) {
+		query := systemLabelFilterQuery(filter)
+		query.CompositeKeys[0].Where(model.RegionalSystemFields.SystemID.Qualified(), chunk)
+		query.Populate(repository.System)
+
+		var systems []model.RegionalSystem
+		if err := r.List(ctx, &systems, *query); err != nil {
+			return nil, ErrSystemSelect
+		}
+
+		for _, rs := range systems {
+			if _, ok := keys[pageKey{systemID: rs.SystemID, region: rs.Region}]; ok {
+				locked = append(locked, rs)
+			}
+		}
+	}
+
+	return locked, nil
+}
+
+// systemLabelFilterQuery returns the query of the regional systems matching the filter, joined with their systems.
+func systemLabelFilterQuery(filter SystemLabelFilter) *repository.Query {
+	key := repository.NewCompositeKey()
+	if filter.TenantID != "" {
+		key.Where(model.SystemFields.TenantID.Qualified(), filter.TenantID)
+	}
+	if filter.Region != "" {
+		key.Where(model.RegionalSystemFields.Region.Qualified(), filter.Region)
+	}
+	if filter.Type != "" {
+		key.Where(model.SystemFields.Type.Qualified(), filter.Type)
+	}
+	if len(filter.Labels) > 0 {
+		labels := make(map[string]any, len(filter.Labels))
+		for k, v := range filter.Labels {
+			labels[k] = v
+		}
+		key.Where("regional_systems.labels", labels)
+	}
+
+	query := repository.NewQuery(&model.RegionalSystem{}).Where(key)
+	query.Joins = []repository.Join{repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)}
+
+	return query
+}
+
+// validateSystemLabelFilter requires at least one field of the filter, so a batch does not update all systems by mistake.
+func validateSystemLabelFilter(filter SystemLabelFilter) error {
+	if filter.TenantID == "" && filter.Region == "" && filter.Type == "" && len(filter.Labels) == 0 {
+		return ErrSystemLabelFilterEmpty
+	}
+
+	return nil
+}
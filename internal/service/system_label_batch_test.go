@@ -0,0 +1,42 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestSystemLabelBatchesValidation(t *testing.T) {
+	subj := service.NewSystemLabelBatches(nil, service.NewLabels(nil, config.Labels{}), config.TableScan{})
+	filter := service.SystemLabelFilter{TenantID: "tenant", Region: "eu01"}
+
+	t.Run("should reject an empty filter", func(t *testing.T) {
+		// when
+		summary, err := subj.BatchSetSystemLabels(t.Context(), service.SystemLabelFilter{}, map[string]string{"patch-wave": "3"}, false)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrSystemLabelFilterEmpty)
+		assert.Nil(t, summary)
+	})
+
+	t.Run("should reject setting no labels", func(t *testing.T) {
+		// when
+		summary, err := subj.BatchSetSystemLabels(t.Context(), filter, nil, true)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrMissingLabels)
+		assert.Nil(t, summary)
+	})
+
+	t.Run("should reject removing an empty label key", func(t *testing.T) {
+		// when
+		summary, err := subj.BatchRemoveSystemLabels(t.Context(), filter, []string{"patch-wave", ""}, false)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrEmptyLabelKeys)
+		assert.Nil(t, summary)
+	})
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// ListSystemsAsOf returns the systems which were linked to the tenant at the given time, reconstructed
+// from the link history. Links made before the history was recorded are not known.
+// Only the links are historized, the state of tenants and regional systems is always the current one.
+// It is intended for administrators and served on the admin service, see Admin.
+func (s *System) ListSystemsAsOf(ctx context.Context, tenantID string, asOf time.Time) ([]model.SystemLink, error) {
+	slogctx.Debug(ctx, "ListSystemsAsOf called", "tenantId", tenantID, "asOf", asOf)
+
+	if tenantID == "" {
+		return nil, ErrTenantIDIsEmpty
+	}
+
+	if asOf.IsZero() || asOf.After(time.Now()) {
+		return nil, ErrAsOfInvalid
+	}
+
+	// compliance reads are served by the admin pool, so they can not exhaust the connections of interactive requests
+	ctx = repository.WithPool(ctx, repository.PoolAdmin)
+
+	query := repository.NewQuery(&model.SystemLink{}).Where(
+		repository.NewCompositeKey().
+			Where(repository.TenantIDField, tenantID).
+			Where(repository.CreatedAtField, repository.Range{To: asOf}),
+	)
+
+	var links []model.SystemLink
+	if err := s.repo.List(ctx, &links, *query); err != nil {
+		return nil, ErrSystemLinkSelect
+	}
+
+	linked := make([]model.SystemLink, 0, len(links))
+	for _, link := range links {
+		if link.IsLinkedAt(asOf) {
+			linked = append(linked, link)
+		}
+	}
+
+	return linked, nil
+}
+
+// recordSystemLink records that the system was linked to the tenant now.
+func recordSystemLink(ctx context.Context, r repository.Repository, system *model.System, tenantID string) error {
+	err := r.Create(ctx, &model.SystemLink{
+		SystemID:   system.ID,
+		ExternalID: system.ExternalID,
+		Type:       system.Type,
+		TenantID:   tenantID,
+	})
+	if err != nil {
+		return ErrSystemLinkCreate
+	}
+
+	return nil
+}
+
+// recordSystemUnlink records that the current link of the system ended now.
+func recordSystemUnlink(ctx context.Context, r repository.Repository, system *model.System) error {
+	query := repository.NewQuery(&model.SystemLink{}).Where(
+		repository.NewCompositeKey().Where(repository.SystemIDField, system.ID),
+	)
+
+	var links []model.SystemLink
+	if err := r.List(ctx, &links, *query); err != nil {
+		return ErrSystemLinkSelect
+	}
+
+	now := time.Now()
+	for _, link := range links {
+		if link.UnlinkedAt != nil {
+			continue
+		}
+
+		isPatched, err := r.Patch(ctx, &model.SystemLink{ID: link.ID, UnlinkedAt: &now})
+		if err != nil || !isPatched {
+			return ErrSystemLinkUpdate
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,185 @@
+package service
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// SystemLinkRecalculation is the result of a recalculation of the system counts by tenant link status.
+type SystemLinkRecalculation struct {
+	// Counts are the recalculated counts by link status, "true" or "false".
+	Counts map[string]int64
+	// Drift is the recalculated minus the last reported count by link status.
+	Drift          map[string]int64
+	RecalculatedAt time.Time
+}
+
+// SystemLinkMetrics reports the number of systems partitioned by tenant link status.
+// The counts reported by the last scrape are recalculated from the database at start, every interval and on demand,
+// e.g. after a crash, and reset to the recalculated counts. The difference between the recalculated
+// and the reported counts is reported as drift, so divergence can be alerted on.
+type SystemLinkMetrics struct {
+	repo repository.Repository
+	cfg  config.SystemLinkMetrics
+
+	mu       sync.RWMutex
+	reported map[string]int64
+	drift    map[string]int64
+}
+
+// NewSystemLinkMetrics creates and returns a new instance of SystemLinkMetrics.
+func NewSystemLinkMetrics(repo repository.Repository, cfg config.SystemLinkMetrics) *SystemLinkMetrics {
+	return &SystemLinkMetrics{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// Start recalculates the counts now and then every interval until ctx is done.
+func (m *SystemLinkMetrics) Start(ctx context.Context) {
+	go func() {
+		_, err := m.RecalculateSystemLinkMetrics(ctx)
+		if err != nil {
+			slogctx.Error(ctx, "failed to recalculate system link metrics", "error", err)
+		}
+
+		if m.cfg.RecalculationInterval <= 0 {
+			return
+		}
+
+		ticker := time.NewTicker(m.cfg.RecalculationInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				_, err := m.RecalculateSystemLinkMetrics(ctx)
+				if err != nil {
+					slogctx.Error(ctx, "failed to recalculate system link metrics", "error", err)
+				}
+			}
+		}
+	}()
+}
+
+// RecalculateSystemLinkMetrics queries the actual counts of linked and unlinked systems,
+// records their difference to the reported counts as drift and resets the reported counts to them.
+func (m *SystemLinkMetrics) RecalculateSystemLinkMetrics(ctx context.Context) (SystemLinkRecalculation, error) {
+	slogctx.Debug(ctx, "RecalculateSystemLinkMetrics called")
+
+	counts, err := countSystemLinks(ctx, m.repo)
+	if err != nil {
+		slogctx.Error(ctx, "failed to count systems by link status", "error", err)
+		return SystemLinkRecalculation{}, ErrSystemSelect
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.drift = linkDrift(m.reported, counts)
+	m.reported = counts
+
+	for linked, drift := range m.drift {
+		if drift != 0 {
+			slogctx.Warn(ctx, "system link metrics drifted", "tenantLinked", linked, "drift", drift)
+		}
+	}
+
+	return SystemLinkRecalculation{
+		Counts:         counts,
+		Drift:          m.drift,
+		RecalculatedAt: time.Now(),
+	}, nil
+}
+
+// RegisterMeters registers the gauges of the system counts and their drift.
+func (m *SystemLinkMetrics) RegisterMeters(ctx context.Context, registry *MeterRegistry) error {
+	err := registry.ObservableGauge(ctx, "systems.count", "Gauge of systems, partitioned by tenant link status",
+		m.observeCounts)
+	if err != nil {
+		return err
+	}
+
+	return registry.ObservableGauge(ctx, "systems.count.drift",
+		"Gauge of the recalculated minus the last reported systems, partitioned by tenant link status",
+		m.observeDrift)
+}
+
+// observeCounts observes the actual counts and records them as reported.
+func (m *SystemLinkMetrics) observeCounts(ctx context.Context, observer metric.Int64Observer) error {
+	counts, err := countSystemLinks(ctx, m.repo)
+	if err != nil {
+		return err
+	}
+
+	m.mu.Lock()
+	m.reported = counts
+	m.mu.Unlock()
+
+	for linked, count := range counts {
+		observer.Observe(count, metric.WithAttributes(attribute.String(AttrTenantLinked, linked)))
+	}
+
+	return nil
+}
+
+// observeDrift observes the drift of the last recalculation.
+func (m *SystemLinkMetrics) observeDrift(_ context.Context, observer metric.Int64Observer) error {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	for linked, drift := range m.drift {
+		observer.Observe(drift, metric.WithAttributes(attribute.String(AttrTenantLinked, linked)))
+	}
+
+	return nil
+}
+
+// linkDrift returns the current minus the previous count of every link status.
+// There is no drift if no counts have been reported yet.
+func linkDrift(previous, current map[string]int64) map[string]int64 {
+	drift := make(map[string]int64)
+	if previous == nil {
+		return drift
+	}
+
+	for linked := range previous {
+		drift[linked] = current[linked] - previous[linked]
+	}
+
+	for linked := range current {
+		drift[linked] = current[linked] - previous[linked]
+	}
+
+	return drift
+}
+
+// countSystemLinks counts the systems by tenant link status.
+func countSystemLinks(ctx context.Context, repo repository.Repository) (map[string]int64, error) {
+	counts := make(map[string]int64, 2)
+
+	for linked, tenantID := range map[string]repository.QueryFieldValue{"true": repository.NotEmpty, "false": repository.Empty} {
+		query := repository.NewQuery(&model.System{}).Where(repository.NewCompositeKey().Where(model.SystemFields.TenantID.Column(), tenantID))
+
+		count, err := repo.Count(ctx, *query)
+		if err != nil {
+			return nil, err
+		}
+
+		counts[linked] = count
+	}
+
+	return counts, nil
+}
@@ -0,0 +1,52 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestLinkDrift(t *testing.T) {
+	tests := []struct {
+		name     string
+		previous map[string]int64
+		current  map[string]int64
+		exp      map[string]int64
+	}{
+		{
+			name:    "no drift without reported counts",
+			current: map[string]int64{"true": 3, "false": 1},
+			exp:     map[string]int64{},
+		},
+		{
+			name:     "no drift for equal counts",
+			previous: map[string]int64{"true": 3, "false": 1},
+			current:  map[string]int64{"true": 3, "false": 1},
+			exp:      map[string]int64{"true": 0, "false": 0},
+		},
+		{
+			name:     "drift for diverged counts",
+			previous: map[string]int64{"true": 3, "false": 1},
+			current:  map[string]int64{"true": 1, "false": 4},
+			exp:      map[string]int64{"true": -2, "false": 3},
+		},
+		{
+			name:     "drift for missing link status",
+			previous: map[string]int64{"true": 3},
+			current:  map[string]int64{"false": 2},
+			exp:      map[string]int64{"true": -3, "false": 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			result := service.LinkDrift(tt.previous, tt.current)
+
+			// then
+			assert.Equal(t, tt.exp, result)
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package service
+
+import (
+	"context"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// SystemLookups looks up systems by their identifiers in batches, e.g. the systems a key service resolves at once.
+type SystemLookups struct {
+	systems *System
+	cfg     config.SystemLookup
+}
+
+// SystemBatch is the result of a lookup of systems by their identifiers.
+type SystemBatch struct {
+	// Systems are the regional systems found.
+	Systems []*systemgrpc.System
+	// Rollups are the rollup statuses of the systems found by systemKey, nil if the lookup is restricted to a region.
+	Rollups map[string]string
+	// Missing are the identifiers without regional systems, in the order they were requested.
+	Missing []model.SystemIdentifier
+}
+
+// NewSystemLookups creates and returns a new instance of SystemLookups.
+func NewSystemLookups(systems *System, cfg config.SystemLookup) *SystemLookups {
+	return &SystemLookups{
+		systems: systems,
+		cfg:     cfg,
+	}
+}
+
+// BatchGetSystems returns the regional systems of the systems identified by their external ID and type,
+// restricted to the region if not empty, and the identifiers without regional systems.
+// The regional systems are selected with one query per region shard matching any of the identifiers.
+// Without region all regional systems of the systems are selected, so their rollup statuses are returned as well.
+func (l *SystemLookups) BatchGetSystems(ctx context.Context, identifiers []model.SystemIdentifier, region string) (*SystemBatch, error) {
+	slogctx.Debug(ctx, "BatchGetSystems called", "systems", len(identifiers), "region", region)
+
+	if !l.cfg.Enabled {
+		return nil, ErrSystemLookupDisabled
+	}
+	if len(identifiers) == 0 {
+		return nil, ErrSystemLookupEmpty
+	}
+	if len(identifiers) > l.cfg.MaxBatchSize {
+		return nil, ErrorWithParams(ErrSystemLookupTooLarge, "maxBatchSize", l.cfg.MaxBatchSize)
+	}
+
+	query, err := lookupQuery(identifiers, region)
+	if err != nil {
+		return nil, err
+	}
+
+	var regions []string
+	if region != "" {
+		regions = []string{region}
+	}
+
+	pages, failures := fanOut(ctx, l.systems.regions.Route(regions), func(ctx context.Context, r repository.Repository) ([]model.RegionalSystem, error) {
+		var systems []model.RegionalSystem
+		err := r.List(ctx, &systems, *query)
+
+		return systems, err
+	})
+	if len(failures) > 0 {
+		return nil, errorShardsUnavailable(ctx, failures)
+	}
+
+	for _, page := range pages {
+		if len(page) > maxRolledUpSystems {
+			slogctx.Warn(ctx, "lookup of systems exceeds the maximum number of regional systems", "limit", maxRolledUpSystems)
+			return nil, ErrorWithParams(ErrSystemLookupTooLarge, "maxRegionalSystems", maxRolledUpSystems)
+		}
+	}
+
+	return l.batch(identifiers, region, mergeRegionalSystems(pages, 0))
+}
+
+// lookupQuery returns the query of the regional systems of the identifiers, of the region if not empty.
+// The identifiers are matched by one composite key each, so a batch is selected by a single query.
+func lookupQuery(identifiers []model.SystemIdentifier, region string) (*repository.Query, error) {
+	seen := make(map[string]struct{}, len(identifiers))
+	keys := make([]repository.CompositeKey, 0, len(identifiers))
+	for _, identifier := range identifiers {
+		if identifier.ExternalID == "" {
+			return nil, ErrExternalIDIsEmpty
+		}
+		if identifier.Type == "" {
+			return nil, ErrSystemTypeIsEmpty
+		}
+
+		key := systemKey(identifier.ExternalID, identifier.Type)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		cond := repository.NewCompositeKey().
+			Where(model.SystemFields.ExternalID.Qualified(), identifier.ExternalID).
+			Where(model.SystemFields.Type.Qualified(), identifier.Type)
+		if region != "" {
+			cond.Where(model.RegionalSystemFields.Region.Qualified(), region)
+		}
+		keys = append(keys, cond)
+	}
+
+	// One more regional system than the bound of the rollups is selected to detect lookups exceeding it.
+	query := repository.QueryOf[*model.RegionalSystem]().Where(keys...).SetLimit(maxRolledUpSystems + 1)
+	query.Joins = []repository.Join{repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)}
+	query.Populate(repository.System)
+
+	return query, nil
+}
+
+// batch returns the batch of the regional systems found for the identifiers.
+func (l *SystemLookups) batch(identifiers []model.SystemIdentifier, region string, found []model.RegionalSystem) (*SystemBatch, error) {
+	bySystem := make(map[string][]model.RegionalSystem, len(identifiers))
+	for _, regionalSystem := range found {
+		key := systemKey(regionalSystem.System.ExternalID, regionalSystem.System.Type)
+		bySystem[key] = append(bySystem[key], regionalSystem)
+	}
+
+	systems, err := systemsToProto(found)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := &SystemBatch{
+		Systems: systems,
+	}
+
+	if region == "" {
+		batch.Rollups = make(map[string]string, len(bySystem))
+		for key, regionalSystems := range bySystem {
+			batch.Rollups[key] = l.systems.statuses.rollup(regionalSystems)
+		}
+	}
+
+	missing := make(map[string]struct{}, len(identifiers))
+	for _, identifier := range identifiers {
+		key := systemKey(identifier.ExternalID, identifier.Type)
+		if _, ok := bySystem[key]; ok {
+			continue
+		}
+		if _, ok := missing[key]; ok {
+			continue
+		}
+
+		missing[key] = struct{}{}
+		batch.Missing = append(batch.Missing, identifier)
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,76 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestBatchGetSystemsRejectsRequests(t *testing.T) {
+	enabled := config.SystemLookup{Enabled: true, MaxBatchSize: 2}
+	identifier := model.SystemIdentifier{ExternalID: "ext-1", Type: "system"}
+
+	tests := []struct {
+		name        string
+		cfg         config.SystemLookup
+		identifiers []model.SystemIdentifier
+		expCode     codes.Code
+		expMsg      string
+	}{
+		{
+			name:        "disabled lookup",
+			cfg:         config.SystemLookup{},
+			identifiers: []model.SystemIdentifier{identifier},
+			expCode:     codes.FailedPrecondition,
+			expMsg:      "not enabled",
+		},
+		{
+			name:    "no identifiers",
+			cfg:     enabled,
+			expCode: codes.InvalidArgument,
+			expMsg:  "no systems",
+		},
+		{
+			name:        "batch exceeding the maximum",
+			cfg:         enabled,
+			identifiers: []model.SystemIdentifier{identifier, identifier, identifier},
+			expCode:     codes.InvalidArgument,
+			expMsg:      "maxBatchSize=2",
+		},
+		{
+			name:        "identifier without external ID",
+			cfg:         enabled,
+			identifiers: []model.SystemIdentifier{{Type: "system"}},
+			expCode:     codes.InvalidArgument,
+			expMsg:      "external ID",
+		},
+		{
+			name:        "identifier without type",
+			cfg:         enabled,
+			identifiers: []model.SystemIdentifier{{ExternalID: "ext-1"}},
+			expCode:     codes.InvalidArgument,
+			expMsg:      "system type",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			subj := service.NewSystemLookups(nil, tt.cfg)
+
+			// when
+			batch, err := subj.BatchGetSystems(t.Context(), tt.identifiers, "")
+
+			// then
+			assert.Nil(t, batch)
+			assert.Equal(t, tt.expCode, status.Code(err))
+			assert.Contains(t, err.Error(), tt.expMsg)
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package service
+
+import (
+	"sync"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+
+	"github.com/openkcm/registry/internal/model"
+)
+
+const (
+	// maxProtoWorkers is the maximum number of goroutines converting the systems of a single request.
+	maxProtoWorkers = 8
+	// minSystemsPerProtoWorker is the minimum number of systems converted by a goroutine,
+	// smaller lists are converted sequentially as the goroutines would cost more than they save.
+	minSystemsPerProtoWorker = 64
+)
+
+// systemsToProto converts the regional systems to their gRPC representation, keeping their order.
+// Large lists are split into chunks converted by at most maxProtoWorkers goroutines.
+func systemsToProto(systems []model.RegionalSystem) ([]*systemgrpc.System, error) {
+	pbSystems := make([]*systemgrpc.System, len(systems))
+
+	workers := min(maxProtoWorkers, len(systems)/minSystemsPerProtoWorker)
+	if workers <= 1 {
+		return pbSystems, convertSystems(systems, pbSystems)
+	}
+
+	chunkSize := (len(systems) + workers - 1) / workers
+	errs := make([]error, workers)
+
+	var wg sync.WaitGroup
+	for i := range workers {
+		start := i * chunkSize
+		end := min(start+chunkSize, len(systems))
+
+		wg.Go(func() {
+			errs[i] = convertSystems(systems[start:end], pbSystems[start:end])
+		})
+	}
+	wg.Wait()
+
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return pbSystems, nil
+}
+
+// convertSystems converts the systems into the slots of pbSystems with the same index.
+func convertSystems(systems []model.RegionalSystem, pbSystems []*systemgrpc.System) error {
+	for i := range systems {
+		pbSystem, err := systems[i].ToProto()
+		if err != nil {
+			return ErrSystemProtoConversion
+		}
+		pbSystems[i] = pbSystem
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package service_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func newRegionalSystems(n int) []model.RegionalSystem {
+	tenantID := "tenant-id"
+	systems := make([]model.RegionalSystem, n)
+
+	for i := range systems {
+		systems[i] = model.RegionalSystem{
+			SystemID:  uuid.Must(uuid.NewV4()),
+			Region:    "region",
+			Status:    "STATUS_AVAILABLE",
+			L2KeyID:   "key-" + strconv.Itoa(i),
+			Labels:    map[string]string{"index": strconv.Itoa(i)},
+			CreatedAt: time.Now(),
+			UpdatedAt: time.Now(),
+			System: &model.System{
+				ExternalID: "external-" + strconv.Itoa(i),
+				Type:       "system",
+				TenantID:   &tenantID,
+			},
+		}
+	}
+
+	return systems
+}
+
+func TestSystemsToProto(t *testing.T) {
+	for _, n := range []int{0, 1, 63, 64, 1000} {
+		t.Run(fmt.Sprintf("should keep the order of %d systems", n), func(t *testing.T) {
+			// given
+			systems := newRegionalSystems(n)
+
+			// when
+			result, err := service.SystemsToProto(systems)
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, result, n)
+			for i, pbSystem := range result {
+				assert.Equal(t, systems[i].System.ExternalID, pbSystem.GetExternalId())
+			}
+		})
+	}
+
+	t.Run("should return error if a system is not loaded", func(t *testing.T) {
+		// given
+		systems := newRegionalSystems(1000)
+		systems[700].System = nil
+
+		// when
+		result, err := service.SystemsToProto(systems)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrSystemProtoConversion)
+		assert.Nil(t, result)
+	})
+}
+
+func TestSizeBucket(t *testing.T) {
+	tests := map[int]string{
+		0:    "0",
+		1:    "1-10",
+		10:   "1-10",
+		11:   "11-100",
+		1000: "101-1000",
+		1001: "1000+",
+	}
+
+	for size, exp := range tests {
+		assert.Equal(t, exp, service.SizeBucket(size), "size %d", size)
+	}
+}
+
+func BenchmarkSystemsToProto(b *testing.B) {
+	for _, n := range []int{10, 100, 1000, 10000} {
+		systems := newRegionalSystems(n)
+
+		b.Run(strconv.Itoa(n), func(b *testing.B) {
+			for b.Loop() {
+				_, err := service.SystemsToProto(systems)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"io"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+type (
+	// RegisterSystemsStream is the server side of a RegisterSystems call, a stream of registration requests
+	// answered by the result of each batch. It is satisfied by a gRPC bidirectional stream of these messages.
+	RegisterSystemsStream interface {
+		Context() context.Context
+		Recv() (*systemgrpc.RegisterSystemRequest, error)
+		Send(*RegisterSystemsBatchResult) error
+	}
+
+	// RegisterSystemsBatchResult summarizes the registration of a batch of requests.
+	RegisterSystemsBatchResult struct {
+		// Batch is the sequence number of the batch, starting at 1.
+		Batch      int
+		Received   int
+		Registered int
+		Failures   []RegisterSystemsFailure
+	}
+
+	// RegisterSystemsFailure is a request of a batch which was not registered.
+	RegisterSystemsFailure struct {
+		// Index is the position of the request in the stream, starting at 0.
+		Index      int
+		ExternalID string
+		Type       string
+		Region     string
+		Err        error
+	}
+)
+
+// SystemRegistrations registers regional systems in batches, e.g. the tens of thousands of systems of a region bring-up.
+// The systems, regional systems and their history of a batch are stored with one multi-row insert per table,
+// instead of the round trips of one RegisterSystem call per regional system.
+type SystemRegistrations struct {
+	repo    repository.Repository
+	systems *System
+	cfg     config.SystemRegistration
+}
+
+// maxListedRegistrations bounds the stored systems and regional systems listed per chunk of a batch.
+const maxListedRegistrations = 1000
+
+// pendingRegistration is a valid request of a batch, err is set if it can not be registered.
+type pendingRegistration struct {
+	index          int
+	req            *systemgrpc.RegisterSystemRequest
+	system         *model.System
+	regionalSystem *model.RegionalSystem
+	err            error
+}
+
+// NewSystemRegistrations creates and returns a new instance of SystemRegistrations.
+// The requests are validated like the RegisterSystem requests of systems.
+func NewSystemRegistrations(repo repository.Repository, systems *System, cfg config.SystemRegistration) *SystemRegistrations {
+	return &SystemRegistrations{
+		repo:    repo,
+		systems: systems,
+		cfg:     cfg,
+	}
+}
+
+// RegisterSystems registers the regional systems of the requests received on the stream with the semantics of RegisterSystem.
+// The requests are registered in batches once a batch is full, the flush interval elapsed or the client closed the stream,
+// and the result of each batch is sent on the stream. Invalid requests are reported as failures of their batch,
+// the other requests of a batch are registered in one transaction, so they fail together if it fails and can be sent again.
+func (s *SystemRegistrations) RegisterSystems(stream RegisterSystemsStream) error {
+	ctx := stream.Context()
+	slogctx.Debug(ctx, "RegisterSystems called")
+
+	if !s.cfg.Enabled {
+		return ErrSystemRegistrationDisabled
+	}
+
+	batch, received := 0, 0
+
+	return batchStream(ctx, stream.Recv, s.cfg.BatchSize, s.cfg.FlushInterval, func(reqs []*systemgrpc.RegisterSystemRequest) error {
+		batch++
+		result := s.registerBatch(ctx, received, reqs)
+		result.Batch = batch
+		received += len(reqs)
+
+		slogctx.Debug(ctx, "registered batch of systems", "batch", batch, "registered", result.Registered, "failed", len(result.Failures))
+
+		return stream.Send(result)
+	})
+}
+
+// registerBatch registers the requests of a batch, offset is the index of the first request in the stream.
+func (s *SystemRegistrations) registerBatch(ctx context.Context, offset int, reqs []*systemgrpc.RegisterSystemRequest) *RegisterSystemsBatchResult {
+	result := &RegisterSystemsBatchResult{Received: len(reqs)}
+	fail := func(index int, req *systemgrpc.RegisterSystemRequest, err error) {
+		result.Failures = append(result.Failures, RegisterSystemsFailure{
+			Index:      index,
+			ExternalID: req.GetExternalId(),
+			Type:       req.GetType(),
+			Region:     req.GetRegion(),
+			Err:        err,
+		})
+	}
+
+	pending := make([]*pendingRegistration, 0, len(reqs))
+	seen := make(map[string]struct{}, len(reqs))
+	for i, req := range reqs {
+		regionalSystem, err := s.systems.newRegionalSystem(ctx, req)
+		if err != nil {
+			fail(offset+i, req, err)
+			continue
+		}
+
+		key := regionalSystemKey(req)
+		if _, ok := seen[key]; ok {
+			fail(offset+i, req, ErrorAlreadyExists(ResourceTypeSystem, key))
+			continue
+		}
+		seen[key] = struct{}{}
+
+		pending = append(pending, &pendingRegistration{index: offset + i, req: req, regionalSystem: regionalSystem})
+	}
+
+	if len(pending) == 0 {
+		return result
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		return s.insert(ctx, r, pending)
+	})
+	if err != nil {
+		slogctx.Error(ctx, "failed to register batch of systems", "error", err)
+	}
+
+	for _, p := range pending {
+		switch {
+		case p.err != nil:
+			fail(p.index, p.req, p.err)
+		case err != nil:
+			fail(p.index, p.req, ErrSystemRegistrationBatch)
+		default:
+			result.Registered++
+
+			s.systems.meters.handleSystemRegistration(ctx, p.regionalSystem.Region)
+
+			if p.regionalSystem.IsPendingApproval() {
+				s.systems.approval.notify(ctx, p.system, p.regionalSystem)
+			}
+		}
+	}
+
+	return result
+}
+
+// insert stores the systems, links, regional systems and L2 keys of the pending registrations with one insert per table.
+// Registrations which conflict with the stored systems are marked as failed and skipped.
+//
+//nolint:cyclop
+func (s *SystemRegistrations) insert(ctx context.Context, r repository.Repository, pending []*pendingRegistration) error {
+	systems, regionalSystems, err := listRegisteredSystems(ctx, r, pending)
+	if err != nil {
+		return err
+	}
+
+	tenants, err := listRegistrationTenants(ctx, r, pending, systems)
+	if err != nil {
+		return err
+	}
+
+	var newSystems []*model.System
+	var links []*model.SystemLink
+	for _, p := range pending {
+		tenantID := p.req.GetTenantId()
+
+		system, found := systems[systemKey(p.req.GetExternalId(), p.req.GetType())]
+		switch {
+		case found && system.TenantID != nil && tenantID != "" && tenantID != *system.TenantID:
+			p.err = ErrRegisterSystemNotAllowedWithTenantID
+			continue
+		case found:
+			if _, ok := regionalSystems[system.ID.String()+"/"+p.req.GetRegion()]; ok {
+				p.err = ErrorAlreadyExists(ResourceTypeSystem, regionalSystemKey(p.req))
+				continue
+			}
+		default:
+			system = model.NewSystem(p.req.GetExternalId(), p.req.GetType())
+			if err := validateSystem(s.systems.validation, system); err != nil {
+				p.err = err
+				continue
+			}
+
+			if tenantID != "" {
+				if _, ok := tenants[tenantID]; !ok {
+					p.err = ErrTenantNotFound
+					continue
+				}
+
+				system.LinkTenant(tenantID)
+				links = append(links, &model.SystemLink{ExternalID: system.ExternalID, Type: system.Type, TenantID: tenantID})
+			}
+
+			systems[systemKey(system.ExternalID, system.Type)] = system
+			newSystems = append(newSystems, system)
+		}
+
+		p.system = system
+	}
+
+	if err := r.CreateAll(ctx, &newSystems); err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		link.SystemID = systems[systemKey(link.ExternalID, link.Type)].ID
+	}
+
+	if err := r.CreateAll(ctx, &links); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	var toCreate []*model.RegionalSystem
+	var l2Keys []*model.SystemL2Key
+	for _, p := range pending {
+		if p.err != nil {
+			continue
+		}
+
+		p.regionalSystem.SystemID = p.system.ID
+
+		if tenant, ok := tenants[tenantIDOf(p.system)]; ok && s.systems.labels.inherits() {
+			p.regionalSystem.Labels = s.systems.labels.inheritedFrom(ctx, tenant, p.system, p.regionalSystem.Labels)
+		}
+
+		toCreate = append(toCreate, p.regionalSystem)
+		l2Keys = append(l2Keys, &model.SystemL2Key{
+			SystemID:  p.system.ID,
+			Region:    p.regionalSystem.Region,
+			L2KeyID:   p.regionalSystem.L2KeyID,
+			CreatedAt: now,
+		})
+	}
+
+	if err := r.CreateAll(ctx, &toCreate); err != nil {
+		return err
+	}
+
+	return r.CreateAll(ctx, &l2Keys)
+}
+
+// listRegisteredSystems returns the stored systems of the pending registrations by their external ID and type,
+// and the keys of their regional systems, the system ID and region.
+func listRegisteredSystems(ctx context.Context, r repository.Repository, pending []*pendingRegistration) (map[string]*model.System, map[string]struct{}, error) {
+	externalIDs := make([]string, 0, len(pending))
+	for _, p := range pending {
+		externalIDs = append(externalIDs, p.req.GetExternalId())
+	}
+
+	byKey := make(map[string]*model.System)
+	ids := make([]uuid.UUID, 0)
+	for chunk := range slices.Chunk(externalIDs, repository.MaxFilterValues) {
+		var systems []*model.System
+		if err := r.List(ctx, &systems, *repository.QueryOf(model.SystemFields.ExternalID.In(chunk...)).
+			SetLimit(maxListedRegistrations)); err != nil {
+			return nil, nil, err
+		}
+
+		for _, system := range systems {
+			byKey[systemKey(system.ExternalID, system.Type)] = system
+			ids = append(ids, system.ID)
+		}
+	}
+
+	regionalSystems := make(map[string]struct{})
+	for chunk := range slices.Chunk(ids, repository.MaxFilterValues) {
+		var stored []model.RegionalSystem
+		if err := r.List(ctx, &stored, *repository.QueryOf(model.RegionalSystemFields.SystemID.In(chunk...)).
+			SetLimit(maxListedRegistrations)); err != nil {
+			return nil, nil, err
+		}
+
+		for _, regionalSystem := range stored {
+			regionalSystems[regionalSystem.SystemID.String()+"/"+regionalSystem.Region] = struct{}{}
+		}
+	}
+
+	return byKey, regionalSystems, nil
+}
+
+// listRegistrationTenants returns the tenants linked by the pending registrations or to the stored systems by their ID.
+// The tenants are locked for share, so they can not be changed while their systems are registered.
+func listRegistrationTenants(ctx context.Context, r repository.Repository, pending []*pendingRegistration, systems map[string]*model.System) (map[string]*model.Tenant, error) {
+	ids := make(map[string]struct{})
+	for _, p := range pending {
+		if p.req.GetTenantId() != "" {
+			ids[p.req.GetTenantId()] = struct{}{}
+		}
+	}
+	for _, system := range systems {
+		if system.IsLinkedToTenant() {
+			ids[*system.TenantID] = struct{}{}
+		}
+	}
+
+	tenants := make(map[string]*model.Tenant, len(ids))
+	for chunk := range slices.Chunk(slices.Sorted(maps.Keys(ids)), repository.MaxFilterValues) {
+		var found []*model.Tenant
+		if err := r.List(ctx, &found, *repository.QueryOf(model.TenantFields.ID.In(chunk...)).
+			ForShare()); err != nil {
+			return nil, err
+		}
+
+		for _, tenant := range found {
+			tenants[tenant.ID] = tenant
+		}
+	}
+
+	return tenants, nil
+}
+
+// batchStream passes the messages received by recv to flush in batches of at most size messages.
+// A batch is flushed once it is full, once interval elapsed since the last flush, and when recv returns io.EOF.
+// It stops at the first error of recv or flush.
+func batchStream[T any](ctx context.Context, recv func() (T, error), size int, interval time.Duration, flush func([]T) error) error {
+	type received struct {
+		msg T
+		err error
+	}
+
+	msgs := make(chan received)
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		for {
+			msg, err := recv()
+
+			select {
+			case msgs <- received{msg: msg, err: err}:
+			case <-done:
+				return
+			}
+
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	batch := make([]T, 0, size)
+	flushBatch := func() error {
+		ticker.Reset(interval)
+		if len(batch) == 0 {
+			return nil
+		}
+
+		full := batch
+		batch = make([]T, 0, size)
+
+		return flush(full)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+			if err := flushBatch(); err != nil {
+				return err
+			}
+		case r := <-msgs:
+			if errors.Is(r.err, io.EOF) {
+				return flushBatch()
+			}
+			if r.err != nil {
+				return r.err
+			}
+
+			batch = append(batch, r.msg)
+			if len(batch) < size {
+				continue
+			}
+
+			if err := flushBatch(); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func systemKey(externalID, systemType string) string {
+	return externalID + "/" + systemType
+}
+
+// regionalSystemKey returns the key of the regional system of the request, as reported if it already exists.
+func regionalSystemKey(req *systemgrpc.RegisterSystemRequest) string {
+	return req.GetType() + "/" + req.GetExternalId() + "/" + req.GetRegion()
+}
+
+func tenantIDOf(system *model.System) string {
+	if !system.IsLinkedToTenant() {
+		return ""
+	}
+
+	return *system.TenantID
+}
@@ -0,0 +1,128 @@
+package service_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+type registerSystemsStream struct {
+	ctx context.Context
+}
+
+func (s *registerSystemsStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *registerSystemsStream) Recv() (*systemgrpc.RegisterSystemRequest, error) {
+	return nil, io.EOF
+}
+
+func (s *registerSystemsStream) Send(*service.RegisterSystemsBatchResult) error {
+	return nil
+}
+
+func TestRegisterSystemsDisabled(t *testing.T) {
+	// given
+	subj := service.NewSystemRegistrations(nil, nil, config.SystemRegistration{})
+
+	// when
+	err := subj.RegisterSystems(&registerSystemsStream{ctx: t.Context()})
+
+	// then
+	assert.ErrorIs(t, err, service.ErrSystemRegistrationDisabled)
+}
+
+func TestBatchStream(t *testing.T) {
+	// recvAll returns the messages one after another, followed by err.
+	recvAll := func(err error, msgs ...int) func() (int, error) {
+		return func() (int, error) {
+			if len(msgs) == 0 {
+				return 0, err
+			}
+
+			msg := msgs[0]
+			msgs = msgs[1:]
+
+			return msg, nil
+		}
+	}
+
+	t.Run("should flush full batches and the rest at the end of the stream", func(t *testing.T) {
+		// given
+		var batches [][]int
+
+		// when
+		err := service.BatchStream(t.Context(), recvAll(io.EOF, 1, 2, 3, 4, 5), 2, time.Hour, func(batch []int) error {
+			batches = append(batches, batch)
+			return nil
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int{{1, 2}, {3, 4}, {5}}, batches)
+	})
+
+	t.Run("should flush a partial batch once the interval elapsed", func(t *testing.T) {
+		// given
+		release := make(chan struct{})
+		sent := false
+		recv := func() (int, error) {
+			if !sent {
+				sent = true
+				return 1, nil
+			}
+
+			<-release
+			return 0, io.EOF
+		}
+
+		var batches [][]int
+
+		// when
+		err := service.BatchStream(t.Context(), recv, 10, time.Millisecond, func(batch []int) error {
+			batches = append(batches, batch)
+			close(release)
+			return nil
+		})
+
+		// then
+		assert.NoError(t, err)
+		assert.Equal(t, [][]int{{1}}, batches)
+	})
+
+	t.Run("should return the error of the stream", func(t *testing.T) {
+		// given
+		expErr := errors.New("stream closed")
+
+		// when
+		err := service.BatchStream(t.Context(), recvAll(expErr, 1), 2, time.Hour, func([]int) error {
+			return nil
+		})
+
+		// then
+		assert.ErrorIs(t, err, expErr)
+	})
+
+	t.Run("should return the error of a flush", func(t *testing.T) {
+		// given
+		expErr := errors.New("send failed")
+
+		// when
+		err := service.BatchStream(t.Context(), recvAll(io.EOF, 1, 2), 2, time.Hour, func([]int) error {
+			return expErr
+		})
+
+		// then
+		assert.ErrorIs(t, err, expErr)
+	})
+}
@@ -0,0 +1,345 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid/v5"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// SystemRelationships manages the typed relationships between systems, e.g. an application system which is
+// part of a landscape system or depends on another system. The relationships of a type do not form a cycle,
+// and a system is the source of at most one relationship of an exclusive type.
+// The procedure calls are served on the extension system service, see SystemExtension.
+type SystemRelationships struct {
+	repo repository.Repository
+	cfg  config.SystemRelationships
+}
+
+// SystemRelationship is a relationship from the source system to the target system.
+type SystemRelationship struct {
+	Source    model.SystemIdentifier
+	Target    model.SystemIdentifier
+	Type      string
+	CreatedAt time.Time
+}
+
+// NewSystemRelationships creates and returns a new instance of SystemRelationships.
+func NewSystemRelationships(repo repository.Repository, cfg config.SystemRelationships) *SystemRelationships {
+	return &SystemRelationships{
+		repo: repo,
+		cfg:  cfg,
+	}
+}
+
+// CreateSystemRelationship creates the relationship of the type from the source system to the target system.
+// It fails if the type is not allowed, the relationship would form a cycle of relationships of the type,
+// or the source system already has a relationship of the type and the type is exclusive.
+func (s *SystemRelationships) CreateSystemRelationship(ctx context.Context, source, target model.SystemIdentifier, relationshipType string) (*SystemRelationship, error) {
+	ctx = slogctx.With(ctx, "source", systemKey(source.ExternalID, source.Type),
+		"target", systemKey(target.ExternalID, target.Type), "relationshipType", relationshipType)
+	slogctx.Debug(ctx, "CreateSystemRelationship called")
+
+	if err := s.validateRelationship(source, target, relationshipType); err != nil {
+		return nil, err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	var relationship *SystemRelationship
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		// relationships of a type are created one at a time, so concurrent creations can not form a cycle together
+		if err := r.AdvisoryLock(ctx, relationshipLockKey(relationshipType)); err != nil {
+			return ErrSystemRelationshipSelect
+		}
+
+		sourceSystem, err := getRelatedSystem(ctx, r, source)
+		if err != nil {
+			return err
+		}
+
+		targetSystem, err := getRelatedSystem(ctx, r, target)
+		if err != nil {
+			return err
+		}
+
+		if err := s.checkExclusive(ctx, r, sourceSystem, relationshipType); err != nil {
+			return err
+		}
+
+		if err := checkRelationshipCycle(ctx, r, sourceSystem.ID, targetSystem.ID, relationshipType); err != nil {
+			return err
+		}
+
+		created := &model.SystemRelationship{
+			SourceSystemID: sourceSystem.ID,
+			TargetSystemID: targetSystem.ID,
+			Type:           relationshipType,
+		}
+
+		err = r.Create(ctx, created)
+		if isUniqueConstraintError(err) {
+			return ErrorAlreadyExists(ResourceTypeSystemRelationship, relationshipKey(source, target, relationshipType))
+		}
+		if err != nil {
+			return ErrSystemRelationshipCreate
+		}
+
+		relationship = &SystemRelationship{
+			Source:    source,
+			Target:    target,
+			Type:      relationshipType,
+			CreatedAt: created.CreatedAt,
+		}
+
+		return nil
+	})
+
+	err = mapError(err)
+	if err != nil {
+		slogctx.Error(ctx, "failed to create system relationship", "error", err)
+		return nil, err
+	}
+
+	return relationship, nil
+}
+
+// ListSystemRelationships returns the first-degree relationships of the system, those it is the source of
+// and those it is the target of, ordered by their creation.
+func (s *SystemRelationships) ListSystemRelationships(ctx context.Context, system model.SystemIdentifier) ([]SystemRelationship, error) {
+	slogctx.Debug(ctx, "ListSystemRelationships called", "externalId", system.ExternalID, "type", system.Type)
+
+	if err := validateSystemIdentifier(system); err != nil {
+		return nil, err
+	}
+
+	found, err := getRelatedSystem(ctx, s.repo, system)
+	if err != nil {
+		return nil, err
+	}
+
+	var relationships []model.SystemRelationship
+	err = s.repo.List(ctx, &relationships, *repository.NewQuery(&model.SystemRelationship{}).
+		Where(
+			repository.Key(model.SystemRelationshipFields.SourceSystemID.Eq(found.ID)),
+			repository.Key(model.SystemRelationshipFields.TargetSystemID.Eq(found.ID))).
+		OrderAscending(model.SystemRelationshipFields.CreatedAt.Column()))
+	if err != nil {
+		return nil, ErrSystemRelationshipSelect
+	}
+
+	return s.resolve(ctx, relationships)
+}
+
+// DeleteSystemRelationship deletes the relationship of the type from the source system to the target system.
+func (s *SystemRelationships) DeleteSystemRelationship(ctx context.Context, source, target model.SystemIdentifier, relationshipType string) error {
+	ctx = slogctx.With(ctx, "source", systemKey(source.ExternalID, source.Type),
+		"target", systemKey(target.ExternalID, target.Type), "relationshipType", relationshipType)
+	slogctx.Debug(ctx, "DeleteSystemRelationship called")
+
+	if err := validateSystemIdentifiers(source, target); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := s.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		sourceSystem, err := getRelatedSystem(ctx, r, source)
+		if err != nil {
+			return err
+		}
+
+		targetSystem, err := getRelatedSystem(ctx, r, target)
+		if err != nil {
+			return err
+		}
+
+		var deleted []model.SystemRelationship
+		count, err := r.DeleteAll(ctx, &deleted, *repository.QueryOf(
+			model.SystemRelationshipFields.SourceSystemID.Eq(sourceSystem.ID),
+			model.SystemRelationshipFields.TargetSystemID.Eq(targetSystem.ID),
+			model.SystemRelationshipFields.Type.Eq(relationshipType)))
+		if err != nil {
+			return ErrSystemRelationshipDelete
+		}
+
+		if count == 0 {
+			return ErrorWithParams(ErrSystemRelationshipNotFound, "type", relationshipType)
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+func (s *SystemRelationships) validateRelationship(source, target model.SystemIdentifier, relationshipType string) error {
+	if err := validateSystemIdentifiers(source, target); err != nil {
+		return err
+	}
+
+	if !slices.Contains(s.cfg.Types, relationshipType) {
+		return ErrorWithParams(ErrSystemRelationshipTypeNotAllowed, "type", relationshipType)
+	}
+
+	if source == target {
+		return ErrSystemRelationshipSelf
+	}
+
+	return nil
+}
+
+// checkExclusive returns ErrSystemRelationshipExclusive if the type is exclusive
+// and the source system already has a relationship of the type.
+func (s *SystemRelationships) checkExclusive(ctx context.Context, r repository.Repository, source *model.System, relationshipType string) error {
+	if !slices.Contains(s.cfg.ExclusiveTypes, relationshipType) {
+		return nil
+	}
+
+	var existing []model.SystemRelationship
+	err := r.List(ctx, &existing, *repository.QueryOf(
+		model.SystemRelationshipFields.SourceSystemID.Eq(source.ID),
+		model.SystemRelationshipFields.Type.Eq(relationshipType)).
+		ForShare().
+		SetLimit(1))
+	if err != nil {
+		return ErrSystemRelationshipSelect
+	}
+
+	if len(existing) > 0 {
+		return ErrorWithParams(ErrSystemRelationshipExclusive, "type", relationshipType)
+	}
+
+	return nil
+}
+
+// resolve returns the relationships with the identifiers of their systems.
+func (s *SystemRelationships) resolve(ctx context.Context, relationships []model.SystemRelationship) ([]SystemRelationship, error) {
+	if len(relationships) == 0 {
+		return []SystemRelationship{}, nil
+	}
+
+	ids := make([]uuid.UUID, 0, 2*len(relationships))
+	for _, relationship := range relationships {
+		ids = append(ids, relationship.SourceSystemID, relationship.TargetSystemID)
+	}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+	ids = slices.Compact(ids)
+
+	identifiers := make(map[uuid.UUID]model.SystemIdentifier, len(ids))
+	for chunk := range slices.Chunk(ids, repository.MaxFilterValues) {
+		var systems []model.System
+		if err := s.repo.List(ctx, &systems, *repository.QueryOf(model.SystemFields.ID.In(chunk...))); err != nil {
+			return nil, ErrSystemSelect
+		}
+
+		for _, system := range systems {
+			identifiers[system.ID] = model.SystemIdentifier{ExternalID: system.ExternalID, Type: system.Type}
+		}
+	}
+
+	resolved := make([]SystemRelationship, 0, len(relationships))
+	for _, relationship := range relationships {
+		resolved = append(resolved, SystemRelationship{
+			Source:    identifiers[relationship.SourceSystemID],
+			Target:    identifiers[relationship.TargetSystemID],
+			Type:      relationship.Type,
+			CreatedAt: relationship.CreatedAt,
+		})
+	}
+
+	return resolved, nil
+}
+
+// checkRelationshipCycle returns ErrSystemRelationshipCycle if the source system is reachable from the target
+// system by the relationships of the type, so a relationship from the source to the target would close a cycle.
+// The relationships are followed breadth-first, one query per level and chunk of systems.
+func checkRelationshipCycle(ctx context.Context, r repository.Repository, sourceID, targetID uuid.UUID, relationshipType string) error {
+	visited := map[uuid.UUID]struct{}{targetID: {}}
+	frontier := []uuid.UUID{targetID}
+
+	for len(frontier) > 0 {
+		var next []uuid.UUID
+		for chunk := range slices.Chunk(frontier, repository.MaxFilterValues) {
+			var relationships []model.SystemRelationship
+			err := r.List(ctx, &relationships, *repository.QueryOf(
+				model.SystemRelationshipFields.SourceSystemID.In(chunk...),
+				model.SystemRelationshipFields.Type.Eq(relationshipType)).
+				ForShare())
+			if err != nil {
+				return ErrSystemRelationshipSelect
+			}
+
+			for _, relationship := range relationships {
+				if relationship.TargetSystemID == sourceID {
+					return ErrorWithParams(ErrSystemRelationshipCycle, "type", relationshipType)
+				}
+
+				if _, ok := visited[relationship.TargetSystemID]; ok {
+					continue
+				}
+
+				visited[relationship.TargetSystemID] = struct{}{}
+				next = append(next, relationship.TargetSystemID)
+			}
+		}
+
+		frontier = next
+	}
+
+	return nil
+}
+
+// getRelatedSystem returns the system of the identifier or ErrSystemNotFound.
+func getRelatedSystem(ctx context.Context, r repository.Repository, identifier model.SystemIdentifier) (*model.System, error) {
+	system, found, err := getSystem(ctx, r, identifier.ExternalID, identifier.Type)
+	if err != nil {
+		return nil, ErrSystemSelect
+	}
+
+	if !found {
+		return nil, ErrorWithParams(ErrSystemNotFound, "externalID", identifier.ExternalID, "type", identifier.Type)
+	}
+
+	return system, nil
+}
+
+func validateSystemIdentifiers(identifiers ...model.SystemIdentifier) error {
+	for _, identifier := range identifiers {
+		if err := validateSystemIdentifier(identifier); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func validateSystemIdentifier(identifier model.SystemIdentifier) error {
+	if identifier.ExternalID == "" {
+		return ErrExternalIDIsEmpty
+	}
+
+	if identifier.Type == "" {
+		return ErrSystemTypeIsEmpty
+	}
+
+	return nil
+}
+
+func relationshipLockKey(relationshipType string) string {
+	return "system-relationship/" + relationshipType
+}
+
+func relationshipKey(source, target model.SystemIdentifier, relationshipType string) string {
+	return systemKey(source.ExternalID, source.Type) + " " + relationshipType + " " + systemKey(target.ExternalID, target.Type)
+}
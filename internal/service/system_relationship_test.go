@@ -0,0 +1,45 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestCreateSystemRelationshipInvalidRequest(t *testing.T) {
+	subj := service.NewSystemRelationships(nil, config.SystemRelationships{
+		Types:          []string{"partOf", "dependsOn"},
+		ExclusiveTypes: []string{"partOf"},
+	})
+
+	application := model.SystemIdentifier{ExternalID: "application", Type: "system"}
+	landscape := model.SystemIdentifier{ExternalID: "landscape", Type: "system"}
+
+	tests := []struct {
+		name             string
+		source           model.SystemIdentifier
+		target           model.SystemIdentifier
+		relationshipType string
+	}{
+		{name: "empty source external ID", source: model.SystemIdentifier{Type: "system"}, target: landscape, relationshipType: "partOf"},
+		{name: "empty target type", source: application, target: model.SystemIdentifier{ExternalID: "landscape"}, relationshipType: "partOf"},
+		{name: "type not allowed", source: application, target: landscape, relationshipType: "owns"},
+		{name: "relationship with itself", source: application, target: application, relationshipType: "dependsOn"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			_, err := subj.CreateSystemRelationship(t.Context(), tt.source, tt.target, tt.relationshipType)
+
+			// then
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
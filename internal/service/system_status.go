@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	systemgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/system/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// maxRolledUpSystems bounds the number of regional systems listed at once to roll up the statuses of systems.
+const maxRolledUpSystems = 1000
+
+// SystemStatuses validates the status transitions of regional systems against the configured transitions
+// and rolls up the statuses of the regional systems of a system.
+type SystemStatuses struct {
+	transitions map[string][]string
+	// rank holds the index of the statuses in the rollup order, the least available status first.
+	rank map[string]int
+}
+
+// NewSystemStatuses creates and returns a new instance of SystemStatuses.
+func NewSystemStatuses(cfg config.SystemStatus) *SystemStatuses {
+	rank := make(map[string]int, len(cfg.RollupOrder))
+	for i, status := range cfg.RollupOrder {
+		rank[status] = i
+	}
+
+	return &SystemStatuses{
+		transitions: cfg.Transitions,
+		rank:        rank,
+	}
+}
+
+// validateTransition returns ErrSystemStatusTransition if a regional system may not change from one status to the other.
+// Keeping the status and leaving a status without configured transitions are always allowed.
+func (s *SystemStatuses) validateTransition(from, to string) error {
+	allowed, ok := s.transitions[from]
+	if from == to || !ok || slices.Contains(allowed, to) {
+		return nil
+	}
+
+	return ErrorWithParams(ErrSystemStatusTransition, "from", from, "to", to)
+}
+
+// rollup returns the least available status of the regional systems, empty without regional systems.
+// Statuses not ranked by the rollup order are less available than the ranked ones and are compared by name,
+// so the rollup does not depend on the order of the regional systems.
+func (s *SystemStatuses) rollup(regionalSystems []model.RegionalSystem) string {
+	var rollup string
+	for i, regionalSystem := range regionalSystems {
+		if i == 0 || s.lessAvailable(regionalSystem.Status, rollup) {
+			rollup = regionalSystem.Status
+		}
+	}
+
+	return rollup
+}
+
+func (s *SystemStatuses) lessAvailable(status, other string) bool {
+	rank, ranked := s.rank[status]
+	otherRank, otherRanked := s.rank[other]
+
+	switch {
+	case ranked && otherRanked:
+		return rank < otherRank
+	case ranked != otherRanked:
+		return !ranked
+	default:
+		return status < other
+	}
+}
+
+// ListSystemsWithRollups lists the regional systems like ListSystems with the rollup statuses of their systems
+// by external ID and type, see systemKey.
+func (s *System) ListSystemsWithRollups(ctx context.Context, in *systemgrpc.ListSystemsRequest) (*systemgrpc.ListSystemsResponse, map[string]string, error) {
+	resp, err := s.ListSystems(ctx, in)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	rollups, err := s.statusRollups(ctx, resp.GetSystems())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp, rollups, nil
+}
+
+// GetSystem returns the regional systems of the System identified by its external ID and type
+// and its rollup status, which is the least available status of its regional systems.
+func (s *System) GetSystem(ctx context.Context, externalID, systemType string) ([]*systemgrpc.System, string, error) {
+	slogctx.Debug(ctx, "GetSystem called", "externalId", externalID, "type", systemType)
+
+	if externalID == "" {
+		return nil, "", ErrExternalIDIsEmpty
+	}
+	if systemType == "" {
+		return nil, "", ErrSystemTypeIsEmpty
+	}
+
+	resp, rollups, err := s.ListSystemsWithRollups(ctx, &systemgrpc.ListSystemsRequest{
+		ExternalId: externalID,
+		Type:       systemType,
+		Limit:      maxRolledUpSystems,
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	// the list is empty instead of failing if the client asks for empty lists
+	if len(resp.GetSystems()) == 0 {
+		return nil, "", ErrSystemNotFound
+	}
+
+	return resp.GetSystems(), rollups[systemKey(externalID, systemType)], nil
+}
+
+// statusRollups returns the rollup statuses of the systems of the regional systems by systemKey.
+// The rollups take all regional systems of the systems into account, from every region shard.
+func (s *System) statusRollups(ctx context.Context, systems []*systemgrpc.System) (map[string]string, error) {
+	wanted := make(map[string]struct{}, len(systems))
+	externalIDs := make([]string, 0, len(systems))
+	for _, system := range systems {
+		wanted[systemKey(system.GetExternalId(), system.GetType())] = struct{}{}
+		externalIDs = append(externalIDs, system.GetExternalId())
+	}
+
+	slices.Sort(externalIDs)
+	externalIDs = slices.Compact(externalIDs)
+
+	pages, failures := fanOut(ctx, s.regions.Route(nil), func(ctx context.Context, r repository.Repository) ([]model.RegionalSystem, error) {
+		var regionalSystems []model.RegionalSystem
+		for chunk := range slices.Chunk(externalIDs, repository.MaxFilterValues) {
+			query := repository.NewQuery(&model.RegionalSystem{}).
+				Where(repository.NewCompositeKey().Where(model.SystemFields.ExternalID.Qualified(), chunk)).
+				SetLimit(maxRolledUpSystems)
+			query.Joins = []repository.Join{repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)}
+			query.Populate(repository.System)
+
+			var chunkSystems []model.RegionalSystem
+			if err := r.List(ctx, &chunkSystems, *query); err != nil {
+				return nil, err
+			}
+
+			regionalSystems = append(regionalSystems, chunkSystems...)
+		}
+
+		return regionalSystems, nil
+	})
+	if len(failures) > 0 {
+		return nil, errorShardsUnavailable(ctx, failures)
+	}
+
+	bySystem := make(map[string][]model.RegionalSystem, len(wanted))
+	for _, page := range pages {
+		for _, regionalSystem := range page {
+			key := systemKey(regionalSystem.System.ExternalID, regionalSystem.System.Type)
+			if _, ok := wanted[key]; ok {
+				bySystem[key] = append(bySystem[key], regionalSystem)
+			}
+		}
+	}
+
+	rollups := make(map[string]string, len(bySystem))
+	for key, regionalSystems := range bySystem {
+		rollups[key] = s.statuses.rollup(regionalSystems)
+	}
+
+	return rollups, nil
+}
@@ -0,0 +1,78 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	typespb "github.com/openkcm/api-sdk/proto/kms/api/cmk/types/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestSystemStatusesValidateTransition(t *testing.T) {
+	available := typespb.Status_STATUS_AVAILABLE.String()
+	processing := typespb.Status_STATUS_PROCESSING.String()
+
+	subj := service.NewSystemStatuses(config.SystemStatus{Transitions: map[string][]string{
+		processing: {available},
+	}})
+
+	tests := []struct {
+		name    string
+		from    string
+		to      string
+		allowed bool
+	}{
+		{name: "configured transition", from: processing, to: available, allowed: true},
+		{name: "same status", from: processing, to: processing, allowed: true},
+		{name: "status without transitions", from: available, to: processing, allowed: true},
+		{name: "status without status", from: "", to: processing, allowed: true},
+		{name: "transition not configured", from: processing, to: model.UnknownEnumValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := subj.ValidateTransition(tt.from, tt.to)
+			if tt.allowed {
+				assert.NoError(t, err)
+			} else {
+				assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestSystemStatusesRollup(t *testing.T) {
+	available := typespb.Status_STATUS_AVAILABLE.String()
+	processing := typespb.Status_STATUS_PROCESSING.String()
+
+	subj := service.NewSystemStatuses(config.SystemStatus{RollupOrder: []string{processing, available}})
+
+	tests := []struct {
+		name     string
+		statuses []string
+		exp      string
+	}{
+		{name: "no regional systems"},
+		{name: "all available", statuses: []string{available, available}, exp: available},
+		{name: "least available ranked status", statuses: []string{available, processing, available}, exp: processing},
+		{name: "status not ranked", statuses: []string{processing, model.UnknownEnumValue, available}, exp: model.UnknownEnumValue},
+		{name: "statuses not ranked by name", statuses: []string{"STATUS_B", "STATUS_A"}, exp: "STATUS_A"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regionalSystems := make([]model.RegionalSystem, 0, len(tt.statuses))
+			for _, s := range tt.statuses {
+				regionalSystems = append(regionalSystems, model.RegionalSystem{Status: s})
+			}
+
+			assert.Equal(t, tt.exp, subj.Rollup(regionalSystems))
+		})
+	}
+}
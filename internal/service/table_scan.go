@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// newTableScanner creates and returns a repository.Scanner of the records of the queries created by query,
+// which reads the pages and paces the reads as configured.
+func newTableScanner[T any, PT interface {
+	*T
+	repository.Resource
+}](cfg config.TableScan, query func() *repository.Query, createdAt func(*T) time.Time) *repository.Scanner[T, PT] {
+	return repository.NewScanner[T, PT](query, createdAt, cfg.PageSize, cfg.RowsPerSecond)
+}
+
+// scanCheckpoint persists the position of the table scan of the name as model.ScanCheckpoint.
+// The checkpoint is deleted once the scan is completed.
+type scanCheckpoint struct {
+	repo repository.Repository
+	name string
+}
+
+func (c scanCheckpoint) Load(ctx context.Context) (string, int64, error) {
+	checkpoint := &model.ScanCheckpoint{Name: c.name}
+
+	found, err := c.repo.Find(ctx, checkpoint)
+	if err != nil || !found {
+		return "", 0, err
+	}
+
+	return checkpoint.Position, checkpoint.Scanned, nil
+}
+
+func (c scanCheckpoint) Save(ctx context.Context, position string, scanned int64) error {
+	if position == "" {
+		_, err := c.repo.Delete(ctx, &model.ScanCheckpoint{Name: c.name})
+		return err
+	}
+
+	checkpoint := &model.ScanCheckpoint{Name: c.name, Position: position, Scanned: scanned}
+
+	found, err := c.repo.Patch(ctx, checkpoint)
+	if err != nil || found {
+		return err
+	}
+
+	err = c.repo.Create(ctx, checkpoint)
+
+	// another instance created the checkpoint of the same scan meanwhile
+	var uniqueErr *repository.UniqueConstraintError
+	if errors.As(err, &uniqueErr) {
+		_, err = c.repo.Patch(ctx, checkpoint)
+	}
+
+	return err
+}
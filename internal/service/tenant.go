@@ -11,7 +11,6 @@ import (
 	"github.com/openkcm/orbital"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
-	"google.golang.org/protobuf/proto"
 
 	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
 	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
@@ -22,6 +21,20 @@ import (
 	"github.com/openkcm/registry/internal/validation"
 )
 
+// tenantFilterFields are the fields of the filter expressions of QueryTenants.
+// The owner ID is not allowed, as it may be stored encrypted.
+var tenantFilterFields = repository.FilterFields{
+	"id":               {Column: model.TenantFields.ID.Column()},
+	"name":             {Column: model.TenantFields.Name.Column()},
+	"region":           {Column: model.TenantFields.Region.Column()},
+	"status":           {Column: model.TenantFields.Status.Column()},
+	"role":             {Column: model.TenantFields.Role.Column()},
+	"owner_type":       {Column: model.TenantFields.OwnerType.Column()},
+	"labels":           {Column: repository.LabelsField, Labels: true},
+	"created_by":       {Column: "created_by"},
+	"last_modified_by": {Column: "last_modified_by"},
+}
+
 // Tenant implements the procedure calls defined as protobufs.
 // See https://github.com/openkcm/api-sdk/blob/main/proto/kms/api/cmk/registry/tenant/v1/tenant.proto.
 type Tenant struct {
@@ -31,12 +44,22 @@ type Tenant struct {
 	orbital    *Orbital
 	meters     *Meters
 	validation *validation.Validation
+	ids        *TenantIDs
+	legacy     *LegacyRequests
+	enums      *EnumValues
+	labels     *Labels
+	templates  *TenantTemplates
+	// terminations delay the terminate jobs by the grace period, see CancelTermination.
+	terminations *TenantTerminations
+	// notifications notify the tenants of the lifecycle events they opted into once the jobs are done or aborted.
+	notifications *TenantNotifications
 }
 
 type (
-	tenantUpdateFunc   func(tenant *model.Tenant)
-	tenantValidateFunc func(tenant *model.Tenant) error
-	orbitalJobFunc     func(ctx context.Context, tenant *model.Tenant) error
+	tenantUpdateFunc    func(tenant *model.Tenant)
+	tenantValidateFunc  func(tenant *model.Tenant) error
+	orbitalJobFunc      func(ctx context.Context, tenant *model.Tenant) error
+	tenantPropagateFunc func(ctx context.Context, r repository.Repository, tenant *model.Tenant) error
 
 	patchTenantOpts struct {
 		id            string
@@ -44,16 +67,25 @@ type (
 		validateFunc  tenantValidateFunc
 		patchAuthOpts patchAuthOpts
 		jobFunc       orbitalJobFunc
+		// propagateFunc propagates the patch of the tenant to its related resources within the transaction.
+		propagateFunc tenantPropagateFunc
 	}
 )
 
 // NewTenant creates and returns a new instance of Tenant.
-func NewTenant(repo repository.Repository, orbital *Orbital, meters *Meters, validation *validation.Validation) *Tenant {
+func NewTenant(repo repository.Repository, orbital *Orbital, meters *Meters, validation *validation.Validation, ids *TenantIDs, legacy *LegacyRequests, enums *EnumValues, labels *Labels, templates *TenantTemplates, terminations *TenantTerminations, notifications *TenantNotifications) *Tenant {
 	t := &Tenant{
-		repo:       repo,
-		orbital:    orbital,
-		meters:     meters,
-		validation: validation,
+		repo:          repo,
+		orbital:       orbital,
+		meters:        meters,
+		validation:    validation,
+		ids:           ids,
+		legacy:        legacy,
+		enums:         enums,
+		labels:        labels,
+		templates:     templates,
+		terminations:  terminations,
+		notifications: notifications,
 	}
 
 	// Register tenant service as job handler for tenant-related actions
@@ -72,32 +104,69 @@ func NewTenant(repo repository.Repository, orbital *Orbital, meters *Meters, val
 // RegisterTenant handles the creation of a new Tenant. The response contains the created Tenant's ID.
 func (t *Tenant) RegisterTenant(ctx context.Context, in *tenantgrpc.RegisterTenantRequest) (*tenantgrpc.RegisterTenantResponse, error) {
 	slogctx.Debug(ctx, "RegisterTenant called", "tenantId", in.GetId(), "tenantName", in.GetName(), "tenantRegion", in.GetRegion())
+
+	return t.registerTenant(ctx, in, "")
+}
+
+// RegisterTenantFromTemplate registers a new Tenant like RegisterTenant with the configured onboarding template.
+// The template is expanded before the tenant is validated, labels and the role of the request take precedence
+// over those of the template. The auth of the template is applied once the tenant is provisioned.
+func (t *Tenant) RegisterTenantFromTemplate(ctx context.Context, in *tenantgrpc.RegisterTenantRequest, template string) (*tenantgrpc.RegisterTenantResponse, error) {
+	slogctx.Debug(ctx, "RegisterTenantFromTemplate called", "tenantId", in.GetId(), "tenantName", in.GetName(), "tenantRegion", in.GetRegion(), "template", template)
+
+	if err := t.templates.lookup(template); err != nil {
+		return nil, err
+	}
+
+	return t.registerTenant(ctx, in, template)
+}
+
+// registerTenant registers the tenant of the request, expanding the template unless it is empty.
+func (t *Tenant) registerTenant(ctx context.Context, in *tenantgrpc.RegisterTenantRequest, template string) (*tenantgrpc.RegisterTenantResponse, error) {
+	id, err := t.ids.resolve(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := t.enums.resolve(ctx, "role", in.GetRole())
+	if err != nil {
+		return nil, err
+	}
+
 	tenant := &model.Tenant{
-		Name:            in.GetName(),
-		ID:              in.GetId(),
-		Region:          in.GetRegion(),
-		OwnerID:         in.GetOwnerId(),
-		OwnerType:       in.GetOwnerType(),
-		Status:          model.TenantStatus(tenantgrpc.Status_STATUS_PROVISIONING.String()),
-		StatusUpdatedAt: time.Now(),
-		Role:            in.GetRole().String(),
-		Labels:          in.GetLabels(),
+		Name:      in.GetName(),
+		ID:        id,
+		Region:    in.GetRegion(),
+		OwnerID:   in.GetOwnerId(),
+		OwnerType: in.GetOwnerType(),
+		Status:    model.TenantStatus(tenantgrpc.Status_STATUS_PROVISIONING.String()),
+		Role:      role,
+		Labels:    in.GetLabels(),
 	}
 
+	if template != "" {
+		t.templates.expand(tenant, template)
+	}
+	tenant.Labels = t.labels.tenantDefaults(tenant.Labels)
+
 	if err := t.validateTenant(tenant); err != nil {
 		return nil, err
 	}
 
+	if err := t.labels.checkLimits(tenant.Labels); err != nil {
+		return nil, err
+	}
+
 	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
 	defer cancel()
 
-	err := t.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+	err = t.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
 		err := createOrPatchTenant(ctx, r, tenant)
 		if err != nil {
 			return err
 		}
 
-		data, err := proto.Marshal(tenant.ToProto())
+		data, err := tenantJobPayload.encode(tenant.ToProto())
 		if err != nil {
 			slogctx.Error(ctx, "failed to encode tenant data", "error", err)
 			return ErrTenantEncoding
@@ -125,11 +194,12 @@ func (t *Tenant) RegisterTenant(ctx context.Context, in *tenantgrpc.RegisterTena
 
 // ListTenants retrieves a list of Tenants based on optional query parameters such as name, region,
 // owner_id, and owner_type.
+// Region and owner_type accept multiple comma separated values, matching any of them.
 // Retrieves all Tenants if all query parameters are empty.
 func (t *Tenant) ListTenants(ctx context.Context, in *tenantgrpc.ListTenantsRequest) (*tenantgrpc.ListTenantsResponse, error) {
 	slogctx.Debug(ctx, "ListTenants called", "name", in.GetName(), "region", in.GetRegion(), "ownerId", in.GetOwnerId(), "ownerType", in.GetOwnerType())
 
-	query, err := t.buildListTenantsQuery(in)
+	query, err := t.buildListTenantsQuery(ctx, in)
 	if err != nil {
 		return nil, err
 	}
@@ -141,7 +211,9 @@ func (t *Tenant) ListTenants(ctx context.Context, in *tenantgrpc.ListTenantsRequ
 
 	pbTenants := t.mapTenantsToGRPCResponse(tenants)
 	if len(pbTenants) == 0 {
-		return nil, ErrTenantNotFound
+		if err := t.legacy.emptyList(ctx, "ListTenants", ErrTenantNotFound); err != nil {
+			return nil, err
+		}
 	}
 
 	if len(tenants) < query.Limit {
@@ -166,10 +238,62 @@ func (t *Tenant) ListTenants(ctx context.Context, in *tenantgrpc.ListTenantsRequ
 	}, nil
 }
 
-// BlockTenant updates the status of a Tenant to BLOCKED.
+// QueryTenants lists the tenants matching the filter expression, e.g.
+// status=STATUS_ACTIVE AND region IN (eu01,eu02) AND labels.env!=prod, see repository.ParseFilterExpression.
+// It is intended for administrators and served on the admin service, see Admin.
+func (t *Tenant) QueryTenants(ctx context.Context, filter string, limit int32, pageToken string) (*tenantgrpc.ListTenantsResponse, error) {
+	slogctx.Debug(ctx, "QueryTenants called", "filter", filter)
+
+	query := repository.NewQuery(&model.Tenant{})
+
+	err := query.ApplyPagination(limit, pageToken)
+	if err != nil {
+		return nil, err
+	}
+
+	err = whereFilterExpression(query, filter, tenantFilterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	excludeAnonymizedOwners(query.CompositeKeys)
+
+	var tenants []model.Tenant
+	if err := t.repo.List(ctx, &tenants, *query); err != nil {
+		return nil, ErrTenantSelect
+	}
+
+	pbTenants := t.mapTenantsToGRPCResponse(tenants)
+	if len(pbTenants) == 0 {
+		if err := t.legacy.emptyList(ctx, "QueryTenants", ErrTenantNotFound); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(tenants) < query.Limit {
+		return &tenantgrpc.ListTenantsResponse{
+			Tenants: pbTenants,
+		}, nil
+	}
+
+	lastItem := tenants[len(tenants)-1]
+
+	nextPageToken, err := repository.PageInfo{
+		LastKey:       lastItem.PaginationKey(),
+		LastCreatedAt: lastItem.CreatedAt,
+	}.Encode()
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenantgrpc.ListTenantsResponse{
+		Tenants:       pbTenants,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+// BlockTenant updates the status of a Tenant to BLOCKED without expiry.
 // If the update is successful, a success message will be returned, otherwise an error will be returned.
-//
-//nolint:dupl
 func (t *Tenant) BlockTenant(ctx context.Context, in *tenantgrpc.BlockTenantRequest) (*tenantgrpc.BlockTenantResponse, error) {
 	slogctx.Debug(ctx, "BlockTenant called", "tenantId", in.GetId())
 
@@ -178,30 +302,46 @@ func (t *Tenant) BlockTenant(ctx context.Context, in *tenantgrpc.BlockTenantRequ
 		return nil, err
 	}
 
-	err = t.patchTenant(ctx, patchTenantOpts{
-		id: in.GetId(),
+	err = t.blockTenant(ctx, t.ids.Normalize(in.GetId()), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &tenantgrpc.BlockTenantResponse{Success: true}, nil
+}
+
+// blockTenant updates the status of the Tenant to BLOCKING and starts the block job.
+// The tenant is blocked until the block expires at until, or without expiry if until is nil, see TenantBlocks.
+func (t *Tenant) blockTenant(ctx context.Context, id string, until *time.Time) error {
+	return t.patchTenant(ctx, patchTenantOpts{
+		id: id,
 		updateFunc: func(tenant *model.Tenant) {
 			tenant.SetStatus(model.TenantStatus(tenantgrpc.Status_STATUS_BLOCKING.String()))
+			if until != nil {
+				tenant.BlockedUntil = until
+			}
 		},
 		validateFunc:  validateTransition(tenantgrpc.Status_STATUS_BLOCKING),
 		patchAuthOpts: newPatchAuthOptsWith(authgrpc.AuthStatus_AUTH_STATUS_BLOCKING),
+		propagateFunc: func(ctx context.Context, r repository.Repository, tenant *model.Tenant) error {
+			if until != nil {
+				return nil
+			}
+
+			return clearBlockExpiry(ctx, r, tenant)
+		},
 		jobFunc: func(ctx context.Context, tenant *model.Tenant) error {
-			data, err := proto.Marshal(tenant.ToProto())
+			data, err := tenantJobPayload.encode(tenant.ToProto())
 			if err != nil {
 				slogctx.Error(ctx, "failed to encode tenant data", "error", err)
 				return ErrTenantEncoding
 			}
-			return t.orbital.PrepareJob(ctx, data, tenant.ID, tenantgrpc.ACTION_ACTION_BLOCK_TENANT.String())
+			return t.orbital.PrepareTenantJob(ctx, tenant, data, tenant.ID, tenantgrpc.ACTION_ACTION_BLOCK_TENANT.String())
 		},
 	})
-	if err != nil {
-		return nil, err
-	}
-
-	return &tenantgrpc.BlockTenantResponse{Success: true}, nil
 }
 
-// UnblockTenant updates the status of a Tenant to ACTIVE.
+// UnblockTenant updates the status of a Tenant to ACTIVE, which ends the period of a tenant blocked for a period.
 // If the update is successful, a success message will be returned, otherwise an error will be returned.
 //
 //nolint:dupl
@@ -214,14 +354,15 @@ func (t *Tenant) UnblockTenant(ctx context.Context, in *tenantgrpc.UnblockTenant
 	}
 
 	err = t.patchTenant(ctx, patchTenantOpts{
-		id: in.GetId(),
+		id: t.ids.Normalize(in.GetId()),
 		updateFunc: func(tenant *model.Tenant) {
 			tenant.SetStatus(model.TenantStatus(tenantgrpc.Status_STATUS_UNBLOCKING.String()))
 		},
 		validateFunc:  validateTransition(tenantgrpc.Status_STATUS_UNBLOCKING),
 		patchAuthOpts: newPatchAuthOptsWith(authgrpc.AuthStatus_AUTH_STATUS_UNBLOCKING),
+		propagateFunc: clearBlockExpiry,
 		jobFunc: func(ctx context.Context, tenant *model.Tenant) error {
-			data, err := proto.Marshal(tenant.ToProto())
+			data, err := tenantJobPayload.encode(tenant.ToProto())
 			if err != nil {
 				slogctx.Error(ctx, "failed to encode tenant data", "error", err)
 				return ErrTenantEncoding
@@ -238,6 +379,8 @@ func (t *Tenant) UnblockTenant(ctx context.Context, in *tenantgrpc.UnblockTenant
 
 // TerminateTenant updates the status of a Tenant to TERMINATED.
 // If the update is successful, a success message will be returned, otherwise an error will be returned.
+// With a grace period, the terminate job is delayed until the termination takes effect,
+// until then it can be canceled by CancelTermination.
 func (t *Tenant) TerminateTenant(ctx context.Context, in *tenantgrpc.TerminateTenantRequest) (*tenantgrpc.TerminateTenantResponse, error) {
 	slogctx.Debug(ctx, "TerminateTenant called", "tenantId", in.GetId())
 
@@ -246,23 +389,24 @@ func (t *Tenant) TerminateTenant(ctx context.Context, in *tenantgrpc.TerminateTe
 		return nil, err
 	}
 
-	if err := assertNoSystemLinks(ctx, t.repo, in.GetId()); err != nil {
+	if err := assertNoSystemLinks(ctx, t.repo, t.ids.Normalize(in.GetId())); err != nil {
 		return nil, err
 	}
 
 	err = t.patchTenant(ctx, patchTenantOpts{
-		id: in.GetId(),
+		id: t.ids.Normalize(in.GetId()),
 		updateFunc: func(tenant *model.Tenant) {
-			tenant.SetStatus(model.TenantStatus(tenantgrpc.Status_STATUS_TERMINATING.String()))
+			tenant.TerminationEffectiveAt = t.terminations.effectiveAt()
+			tenant.SetStatus(t.terminations.statusAt(tenant.TerminationEffectiveAt))
 		},
 		validateFunc: validateTransition(tenantgrpc.Status_STATUS_TERMINATING),
 		jobFunc: func(ctx context.Context, tenant *model.Tenant) error {
-			data, err := proto.Marshal(tenant.ToProto())
+			data, err := tenantJobPayload.encode(tenant.ToProto())
 			if err != nil {
 				slogctx.Error(ctx, "failed to encode tenant data", "error", err)
 				return ErrTenantEncoding
 			}
-			return t.orbital.PrepareJob(ctx, data, tenant.ID, tenantgrpc.ACTION_ACTION_TERMINATE_TENANT.String())
+			return t.orbital.PrepareTenantJobAfter(ctx, tenant, data, tenant.ID, tenantgrpc.ACTION_ACTION_TERMINATE_TENANT.String(), tenant.TerminationEffectiveAt)
 		},
 		patchAuthOpts: newPatchAuthOptsWith(authgrpc.AuthStatus_AUTH_STATUS_REMOVING),
 	})
@@ -273,6 +417,48 @@ func (t *Tenant) TerminateTenant(ctx context.Context, in *tenantgrpc.TerminateTe
 	return &tenantgrpc.TerminateTenantResponse{Success: true}, nil
 }
 
+// ForceTenantStatus sets the status of the tenant without validating the transition and without starting orbital jobs,
+// e.g. to repair a tenant stuck in a transient status after a failed job. The auths of the tenant are not changed.
+// It returns the status of the tenant before it was forced.
+func (t *Tenant) ForceTenantStatus(ctx context.Context, id string, forced model.TenantStatus, reason string) (model.TenantStatus, error) {
+	slogctx.Debug(ctx, "ForceTenantStatus called", "tenantId", id, "status", forced)
+
+	if err := t.validateIDNonEmpty(id); err != nil {
+		return "", err
+	}
+
+	if !isKnownTenantStatus(forced) {
+		return "", ErrorWithParams(ErrForcedStatusInvalid, "status", forced)
+	}
+
+	var previous model.TenantStatus
+
+	err := t.patchTenant(ctx, patchTenantOpts{
+		id: t.ids.Normalize(id),
+		updateFunc: func(tenant *model.Tenant) {
+			previous = tenant.Status
+			tenant.SetStatus(forced)
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	slogctx.Warn(ctx, "tenant status forced", "tenantId", id, "previousStatus", previous, "status", forced, "reason", reason)
+
+	return previous, nil
+}
+
+// isKnownTenantStatus returns true if the status is a tenant status other than the unspecified one.
+func isKnownTenantStatus(s model.TenantStatus) bool {
+	if s == model.TenantStatusPendingTermination {
+		return true
+	}
+
+	_, known := tenantgrpc.Status_value[string(s)]
+	return known && s != model.TenantStatus(tenantgrpc.Status_STATUS_UNSPECIFIED.String())
+}
+
 // SetTenantLabels sets the labels for the Tenant identified by its ID.
 // Existing labels with the same keys will be overwritten.
 // If the update is successful, a success message will be returned, otherwise an error will be returned.
@@ -283,27 +469,39 @@ func (t *Tenant) SetTenantLabels(ctx context.Context, in *tenantgrpc.SetTenantLa
 		return nil, err
 	}
 
+	id := t.ids.Normalize(in.GetId())
+
+	var previous map[string]string
 	err := t.patchTenant(ctx, patchTenantOpts{
-		id: in.GetId(),
+		id: id,
 		updateFunc: func(tenant *model.Tenant) {
-			if tenant.Labels == nil {
-				tenant.Labels = make(map[string]string)
+			previous = tenant.Labels
+			tenant.Labels = mergeLabels(tenant.Labels, in.GetLabels())
+		},
+		validateFunc: func(tenant *model.Tenant) error {
+			if err := checkTenantActive(tenant); err != nil {
+				return err
 			}
-			maps.Copy(tenant.Labels, in.GetLabels())
+
+			return t.labels.checkSet(tenant.Labels, in.GetLabels())
+		},
+		propagateFunc: func(ctx context.Context, r repository.Repository, tenant *model.Tenant) error {
+			return t.labels.inheritTenantLabels(ctx, r, tenant.ID, previous, tenant.Labels)
 		},
-		validateFunc: checkTenantActive,
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	t.labels.audit(ctx, ResourceTypeTenant, id, labelOperationSet, slices.Collect(maps.Keys(in.GetLabels())))
+
 	return &tenantgrpc.SetTenantLabelsResponse{
 		Success: true,
 	}, nil
 }
 
 // RemoveTenantLabels removes the specified labels from the Tenant identified by its external ID and region.
-// If one or more label keys are not found, they will be ignored.
+// If one or more label keys are not found, they will be ignored and reported as warning.
 // If the update is successful, a success message will be returned, otherwise an error will be returned.
 func (t *Tenant) RemoveTenantLabels(ctx context.Context, in *tenantgrpc.RemoveTenantLabelsRequest) (*tenantgrpc.RemoveTenantLabelsResponse, error) {
 	slogctx.Debug(ctx, "RemoveTenantLabels called", "tenantId", in.GetId())
@@ -312,22 +510,27 @@ func (t *Tenant) RemoveTenantLabels(ctx context.Context, in *tenantgrpc.RemoveTe
 		return nil, err
 	}
 
+	id := t.ids.Normalize(in.GetId())
+
+	var previous map[string]string
 	err := t.patchTenant(ctx, patchTenantOpts{
-		id: in.GetId(),
+		id: id,
 		updateFunc: func(tenant *model.Tenant) {
-			if tenant.Labels == nil {
-				return
-			}
-			for _, k := range in.GetLabelKeys() {
-				delete(tenant.Labels, k)
-			}
+			previous = tenant.Labels
+			tenant.Labels = removeLabels(tenant.Labels, in.GetLabelKeys())
 		},
 		validateFunc: checkTenantActive,
+		propagateFunc: func(ctx context.Context, r repository.Repository, tenant *model.Tenant) error {
+			return t.labels.inheritTenantLabels(ctx, r, tenant.ID, previous, tenant.Labels)
+		},
 	})
 	if err != nil {
 		return nil, err
 	}
 
+	t.labels.audit(ctx, ResourceTypeTenant, id, labelOperationRemove, in.GetLabelKeys())
+	addLabelKeysNotFoundWarning(ctx, missingLabelKeys(previous, in.GetLabelKeys()))
+
 	return &tenantgrpc.RemoveTenantLabelsResponse{
 		Success: true,
 	}, nil
@@ -343,11 +546,13 @@ func (t *Tenant) GetTenant(ctx context.Context, in *tenantgrpc.GetTenantRequest)
 		return nil, err
 	}
 
-	tenant, err := getTenant(ctx, t.repo, in.GetId())
+	tenant, err := getTenant(ctx, t.repo, t.ids.Normalize(in.GetId()))
 	if err != nil {
 		return nil, err
 	}
 
+	setBlockedUntilHeader(ctx, tenant)
+
 	return &tenantgrpc.GetTenantResponse{
 		Tenant: tenant.ToProto(),
 	}, nil
@@ -368,6 +573,10 @@ func (t *Tenant) ConfirmJob(ctx context.Context, job orbital.Job) (orbital.JobCo
 	case tenantgrpc.ACTION_ACTION_PROVISION_TENANT.String():
 		return orbital.CompleteJobConfirmer(), nil
 	case tenantgrpc.ACTION_ACTION_BLOCK_TENANT.String(), tenantgrpc.ACTION_ACTION_UNBLOCK_TENANT.String(), tenantgrpc.ACTION_ACTION_TERMINATE_TENANT.String():
+		if tenant.IsPendingTermination() && job.Type == tenantgrpc.ACTION_ACTION_TERMINATE_TENANT.String() {
+			return t.confirmTermination(ctx, tenant.ID)
+		}
+
 		status, err := jobTypeToStatus(job.Type)
 		if err != nil { //nolint:nilerr // if we return an error here, the job will be retried indefinitely
 			return orbital.CancelJobConfirmer(fmt.Sprintf("%s: %s",
@@ -390,7 +599,11 @@ func (t *Tenant) ConfirmJob(ctx context.Context, job orbital.Job) (orbital.JobCo
 func (t *Tenant) ResolveTasks(ctx context.Context, job orbital.Job, targetsByRegion map[string]orbital.TargetManager) (orbital.TaskResolverResult, error) {
 	tenant := &tenantgrpc.Tenant{}
 
-	err := proto.Unmarshal(job.Data, tenant)
+	data, err := tenantJobPayload.decode(job.Data, tenant)
+	if errors.Is(err, ErrJobPayloadTooNew) {
+		slogctx.Warn(ctx, "tenant job payload is newer than supported, retrying", "error", err)
+		return nil, err
+	}
 	if err != nil {
 		msg := "failed to unmarshal tenant data"
 		slogctx.Error(ctx, msg, "error", err)
@@ -409,7 +622,7 @@ func (t *Tenant) ResolveTasks(ctx context.Context, job orbital.Job, targetsByReg
 	return orbital.CompleteTaskResolver().WithTaskInfo(
 		[]orbital.TaskInfo{
 			{
-				Data:   job.Data,
+				Data:   data,
 				Type:   job.Type,
 				Target: tenant.GetRegion(),
 			},
@@ -427,32 +640,46 @@ func (t *Tenant) HandleJobCanceled(ctx context.Context, job orbital.Job) error {
 	return t.handleJobAborted(ctx, job)
 }
 
-// HandleJobDone applies the changes to the tenant based on the job type when the job is done.
+// HandleJobDone applies the changes to the tenant based on the job type when the job is done
+// and notifies the tenant of the lifecycle event.
 //
 //nolint:dupl
 func (t *Tenant) HandleJobDone(ctx context.Context, job orbital.Job) error {
 	var tenantUpdateFn tenantUpdateFunc
 	var authUpdateFn authUpdateFunc
+	var event model.NotificationEvent
 	switch job.Type {
 	case tenantgrpc.ACTION_ACTION_PROVISION_TENANT.String():
 		tenantUpdateFn = newTenantUpdateFn(tenantgrpc.Status_STATUS_ACTIVE)
+		event = model.NotificationEventProvisioned
 	case tenantgrpc.ACTION_ACTION_UNBLOCK_TENANT.String():
 		tenantUpdateFn = newTenantUpdateFn(tenantgrpc.Status_STATUS_ACTIVE)
 		authUpdateFn = newAuthUpdateFn(authgrpc.AuthStatus_AUTH_STATUS_APPLIED)
+		event = model.NotificationEventUnblocked
 	case tenantgrpc.ACTION_ACTION_BLOCK_TENANT.String():
 		tenantUpdateFn = newTenantUpdateFn(tenantgrpc.Status_STATUS_BLOCKED)
 		authUpdateFn = newAuthUpdateFn(authgrpc.AuthStatus_AUTH_STATUS_BLOCKED)
+		event = model.NotificationEventBlocked
 	case tenantgrpc.ACTION_ACTION_TERMINATE_TENANT.String():
 		tenantUpdateFn = newTenantUpdateFn(tenantgrpc.Status_STATUS_TERMINATED)
 		authUpdateFn = newAuthUpdateFn(authgrpc.AuthStatus_AUTH_STATUS_REMOVED)
+		event = model.NotificationEventTerminated
 	default:
 		slogctx.Error(ctx, "unexpected job type in handleJobDone")
 		return nil
 	}
 
-	return t.patchTenant(ctx, patchTenantOpts{
-		id:         job.ExternalID,
-		updateFunc: tenantUpdateFn,
+	var propagateFn tenantPropagateFunc
+	if job.Type == tenantgrpc.ACTION_ACTION_PROVISION_TENANT.String() {
+		propagateFn = func(ctx context.Context, r repository.Repository, tenant *model.Tenant) error {
+			return t.templates.applyAuth(ctx, r, t.orbital, tenant)
+		}
+	}
+
+	err := t.patchTenant(ctx, patchTenantOpts{
+		id:            job.ExternalID,
+		updateFunc:    tenantUpdateFn,
+		propagateFunc: propagateFn,
 		patchAuthOpts: patchAuthOpts{
 			skipUpdateFn: func(auth *model.Auth) bool {
 				_, ok := AuthNonUpdatableState[auth.Status]
@@ -461,6 +688,13 @@ func (t *Tenant) HandleJobDone(ctx context.Context, job orbital.Job) error {
 			updateFn: authUpdateFn,
 		},
 	})
+	if err != nil {
+		return err
+	}
+
+	t.notifications.notify(ctx, job.ExternalID, event, "")
+
+	return nil
 }
 
 func (t *Tenant) SetTenantUserGroups(ctx context.Context, in *tenantgrpc.SetTenantUserGroupsRequest) (*tenantgrpc.SetTenantUserGroupsResponse, error) {
@@ -483,10 +717,13 @@ func (t *Tenant) SetTenantUserGroups(ctx context.Context, in *tenantgrpc.SetTena
 	}
 
 	err = t.patchTenant(ctx, patchTenantOpts{
-		id: in.GetId(),
+		id: t.ids.Normalize(in.GetId()),
 		updateFunc: func(tenant *model.Tenant) {
 			tenant.UserGroups = in.GetUserGroups()
 		},
+		propagateFunc: func(ctx context.Context, r repository.Repository, tenant *model.Tenant) error {
+			return syncTenantUserGroups(ctx, r, tenant.ID, tenant.UserGroups)
+		},
 	})
 	if err != nil {
 		return nil, err
@@ -495,6 +732,109 @@ func (t *Tenant) SetTenantUserGroups(ctx context.Context, in *tenantgrpc.SetTena
 	return &tenantgrpc.SetTenantUserGroupsResponse{Success: true}, nil
 }
 
+// SetTenantContacts replaces the contact and escalation information of the Tenant identified by its ID.
+// The contacts are validated as part of the tenant, at least one contact must be set.
+// It is served by TenantExtension, as the tenant proto of api-sdk does not define contacts.
+func (t *Tenant) SetTenantContacts(ctx context.Context, id string, contacts model.TenantContacts) error {
+	slogctx.Debug(ctx, "SetTenantContacts called", "tenantId", id)
+
+	err := t.validateIDNonEmpty(id)
+	if err != nil {
+		return err
+	}
+
+	if !contacts.HasContacts() {
+		return ErrMissingTenantContacts
+	}
+
+	return t.patchTenant(ctx, patchTenantOpts{
+		id: t.ids.Normalize(id),
+		updateFunc: func(tenant *model.Tenant) {
+			tenant.Contacts = contacts
+		},
+	})
+}
+
+// GetTenantContacts returns the contact and escalation information of the Tenant identified by its ID.
+func (t *Tenant) GetTenantContacts(ctx context.Context, id string) (model.TenantContacts, error) {
+	slogctx.Debug(ctx, "GetTenantContacts called", "tenantId", id)
+
+	err := t.validateIDNonEmpty(id)
+	if err != nil {
+		return model.TenantContacts{}, err
+	}
+
+	tenant, err := getTenant(ctx, t.repo, t.ids.Normalize(id))
+	if err != nil {
+		return model.TenantContacts{}, err
+	}
+
+	return tenant.Contacts, nil
+}
+
+// GetTenantFeatureFlags returns the feature flags of the Tenant identified by its ID,
+// which are set by the template the tenant is registered with.
+func (t *Tenant) GetTenantFeatureFlags(ctx context.Context, id string) (map[string]bool, error) {
+	slogctx.Debug(ctx, "GetTenantFeatureFlags called", "tenantId", id)
+
+	err := t.validateIDNonEmpty(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := getTenant(ctx, t.repo, t.ids.Normalize(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return tenant.FeatureFlags, nil
+}
+
+// SetTenantMaintenanceWindows replaces the maintenance windows of the Tenant identified by its ID.
+// Blocking and terminating the tenant and removing its auths are restricted to these windows.
+// Empty windows remove the restriction.
+func (t *Tenant) SetTenantMaintenanceWindows(ctx context.Context, id string, windows model.MaintenanceWindows) error {
+	slogctx.Debug(ctx, "SetTenantMaintenanceWindows called", "tenantId", id)
+
+	err := t.validateIDNonEmpty(id)
+	if err != nil {
+		return err
+	}
+
+	if err := windows.Validate(); err != nil {
+		return ErrorWithParams(ErrMaintenanceWindowInvalid, "err", err.Error())
+	}
+
+	return t.patchTenant(ctx, patchTenantOpts{
+		id: t.ids.Normalize(id),
+		updateFunc: func(tenant *model.Tenant) {
+			tenant.MaintenanceWindows = windows
+		},
+	})
+}
+
+// GetTenantMaintenanceWindows returns the maintenance windows of the Tenant identified by its ID.
+func (t *Tenant) GetTenantMaintenanceWindows(ctx context.Context, id string) (model.MaintenanceWindows, error) {
+	slogctx.Debug(ctx, "GetTenantMaintenanceWindows called", "tenantId", id)
+
+	err := t.validateIDNonEmpty(id)
+	if err != nil {
+		return nil, err
+	}
+
+	tenant, err := getTenant(ctx, t.repo, t.ids.Normalize(id))
+	if err != nil {
+		return nil, err
+	}
+
+	return tenant.MaintenanceWindows, nil
+}
+
+// handleJobAborted sets the tenant and its auths to the error status of the job type
+// and notifies the tenant of the failed operation.
+// A tenant which is not in the status of the job anymore is left unchanged,
+// e.g. the tenant of a canceled termination, which is BLOCKED or even ACTIVE again.
+//
 //nolint:dupl
 func (t *Tenant) handleJobAborted(ctx context.Context, job orbital.Job) error {
 	var tenantUpdateFn tenantUpdateFunc
@@ -516,9 +856,11 @@ func (t *Tenant) handleJobAborted(ctx context.Context, job orbital.Job) error {
 		slogctx.Error(ctx, "unexpected job type in handleJobAborted")
 		return nil
 	}
-	return t.patchTenant(ctx, patchTenantOpts{
-		id:         job.ExternalID,
-		updateFunc: tenantUpdateFn,
+
+	err := t.patchTenant(ctx, patchTenantOpts{
+		id:           job.ExternalID,
+		updateFunc:   tenantUpdateFn,
+		validateFunc: validateJobStatus(job.Type),
 		patchAuthOpts: patchAuthOpts{
 			skipUpdateFn: func(auth *model.Auth) bool {
 				_, ok := AuthNonUpdatableState[auth.Status]
@@ -527,6 +869,17 @@ func (t *Tenant) handleJobAborted(ctx context.Context, job orbital.Job) error {
 			updateFn: authUpdateFn,
 		},
 	})
+	if errors.Is(err, ErrTenantJobOutdated) {
+		slogctx.Debug(ctx, "aborted job of tenant is outdated", "tenantId", job.ExternalID, "jobType", job.Type)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	t.notifications.notify(ctx, job.ExternalID, model.NotificationEventOperationFailed, job.ErrorMessage)
+
+	return nil
 }
 
 // validateSetTenantLabelsRequest validates the SetTenantLabelsRequest.
@@ -537,17 +890,7 @@ func (t *Tenant) validateSetTenantLabelsRequest(in *tenantgrpc.SetTenantLabelsRe
 		return err
 	}
 
-	if len(in.GetLabels()) == 0 {
-		return ErrMissingLabels
-	}
-
-	labels := in.GetLabels()
-	err = t.validation.Validate(model.TenantLabelsValidationID, labels)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return t.labels.validateSet(model.TenantLabelsValidationID, in.GetLabels())
 }
 
 // validateRemoveTenantLabelsRequest validates the RemoveTenantLabelsRequest.
@@ -558,15 +901,7 @@ func (t *Tenant) validateRemoveTenantLabelsRequest(in *tenantgrpc.RemoveTenantLa
 		return err
 	}
 
-	if len(in.GetLabelKeys()) == 0 {
-		return ErrMissingLabelKeys
-	}
-
-	if slices.Contains(in.GetLabelKeys(), "") {
-		return ErrEmptyLabelKeys
-	}
-
-	return nil
+	return t.labels.validateRemove(in.GetLabelKeys())
 }
 
 // createOrPatchTenant creates a new Tenant
@@ -581,11 +916,19 @@ func createOrPatchTenant(ctx context.Context, r repository.Repository, tenant *m
 	}
 
 	if !found {
-		return r.Create(ctx, tenant)
+		err = r.Create(ctx, tenant)
+		if isUniqueConstraintError(err) {
+			return ErrorAlreadyExists(ResourceTypeTenant, tenant.ID)
+		}
+		if err != nil {
+			return err
+		}
+
+		return syncTenantUserGroups(ctx, r, tenant.ID, tenant.UserGroups)
 	}
 
 	if existingTenant.Status != model.TenantStatus(tenantgrpc.Status_STATUS_PROVISIONING_ERROR.String()) {
-		return status.Error(codes.AlreadyExists, "tenant is already provisioned")
+		return ErrorAlreadyExists(ResourceTypeTenant, tenant.ID)
 	}
 
 	patched, err := r.Patch(ctx, tenant)
@@ -597,7 +940,7 @@ func createOrPatchTenant(ctx context.Context, r repository.Repository, tenant *m
 		return ErrTenantNotFound
 	}
 
-	return nil
+	return syncTenantUserGroups(ctx, r, tenant.ID, tenant.UserGroups)
 }
 
 // patchTenant retrieves the Tenant by its ID, applies the update function to it,
@@ -635,6 +978,9 @@ func (t *Tenant) patchTenant(ctx context.Context, opts patchTenantOpts) error {
 			}
 
 			isPatched, err := r.Patch(ctx, tenant)
+			if errors.Is(err, repository.ErrStatusTimeRegression) {
+				return ErrorWithParams(ErrTenantStatusTimeRegression, "statusUpdatedAt", tenant.StatusUpdatedAt.Format(time.RFC3339Nano))
+			}
 			if err != nil {
 				return ErrTenantUpdate
 			}
@@ -644,8 +990,18 @@ func (t *Tenant) patchTenant(ctx context.Context, opts patchTenantOpts) error {
 			}
 		}
 
+		if opts.propagateFunc != nil {
+			err = opts.propagateFunc(ctx, r, tenant)
+			if err != nil {
+				return err
+			}
+		}
+
 		if opts.jobFunc != nil {
 			err = opts.jobFunc(ctx, tenant)
+			if errors.Is(err, ErrOutsideMaintenanceWindow) {
+				return err
+			}
 			if err != nil {
 				return status.Errorf(codes.Internal, "failed to start orbital job: %v", err)
 			}
@@ -658,12 +1014,12 @@ func (t *Tenant) patchTenant(ctx context.Context, opts patchTenantOpts) error {
 }
 
 // getTenant queries the Tenant by its ID.
-func getTenant(ctx context.Context, r repository.Repository, id string) (*model.Tenant, error) {
+func getTenant(ctx context.Context, r repository.Repository, id string, lock ...repository.Lock) (*model.Tenant, error) {
 	tenant := &model.Tenant{
 		ID: id,
 	}
 
-	found, err := r.Find(ctx, tenant)
+	found, err := r.Find(ctx, tenant, lock...)
 	if err != nil {
 		return nil, ErrTenantSelect
 	}
@@ -675,7 +1031,7 @@ func getTenant(ctx context.Context, r repository.Repository, id string) (*model.
 	return tenant, nil
 }
 
-func (t *Tenant) buildListTenantsQuery(in *tenantgrpc.ListTenantsRequest) (*repository.Query, error) {
+func (t *Tenant) buildListTenantsQuery(ctx context.Context, in *tenantgrpc.ListTenantsRequest) (*repository.Query, error) {
 	query := repository.NewQuery(&model.Tenant{})
 
 	err := query.ApplyPagination(in.GetLimit(), in.GetPageToken())
@@ -684,25 +1040,45 @@ func (t *Tenant) buildListTenantsQuery(in *tenantgrpc.ListTenantsRequest) (*repo
 	}
 
 	cond := repository.NewCompositeKey()
+	//nolint:staticcheck // the deprecated ID filter is still accepted for legacy clients
+	if id := in.GetId(); id != "" {
+		err = t.legacy.handle(ctx, "ListTenants", legacyFieldTenantID)
+		if err != nil {
+			return nil, err
+		}
+
+		cond.Where(model.TenantFields.ID.Column(), t.ids.Normalize(id))
+	}
+
 	if in.GetName() != "" {
-		cond.Where(repository.NameField, in.GetName())
+		cond.Where(model.TenantFields.Name.Column(), in.GetName())
 	}
 
-	if in.GetRegion() != "" {
-		cond.Where(repository.RegionField, in.GetRegion())
+	err = whereFilter(cond, model.TenantFields.Region.Column(), in.GetRegion())
+	if err != nil {
+		return nil, err
 	}
 
 	if in.GetOwnerId() != "" {
-		cond.Where(repository.OwnerIDField, in.GetOwnerId())
+		// Owner IDs may be encrypted with any key version, or not yet be encrypted at all.
+		cond.Where(model.TenantFields.OwnerID.Column(), model.EncryptedFilterValues(in.GetOwnerId()))
+	}
+
+	ownerTypes, err := filterValues(in.GetOwnerType())
+	if err != nil {
+		return nil, err
 	}
 
-	if in.GetOwnerType() != "" {
-		err = t.validation.Validate(model.TenantOwnerTypeValidationID, in.GetOwnerType())
+	for _, ownerType := range ownerTypes {
+		err = t.validation.Validate(model.TenantOwnerTypeValidationID, ownerType)
 		if err != nil {
 			return nil, err
 		}
+	}
 
-		cond.Where(repository.OwnerTypeField, in.GetOwnerType())
+	err = whereFilter(cond, model.TenantFields.OwnerType.Column(), in.GetOwnerType())
+	if err != nil {
+		return nil, err
 	}
 
 	err = addLabelsCondition(&cond, t.validation, in.GetLabels())
@@ -710,6 +1086,8 @@ func (t *Tenant) buildListTenantsQuery(in *tenantgrpc.ListTenantsRequest) (*repo
 		return nil, err
 	}
 
+	excludeAnonymizedOwners([]repository.CompositeKey{cond})
+
 	return query.Where(cond), nil
 }
 
@@ -761,9 +1139,7 @@ func addLabelsCondition(cond *repository.CompositeKey, validation *validation.Va
 // Here repository r is passed as a variable to address the scenarios where we will
 // create a new repository from the existing repository for e.g. in the case of transaction.
 func assertNoSystemLinks(ctx context.Context, r repository.Repository, tenantID string) error {
-	query := repository.NewQuery(&model.System{}).Where(
-		repository.NewCompositeKey().Where(repository.TenantIDField, tenantID),
-	)
+	query := repository.QueryOf(model.SystemFields.TenantID.Eq(tenantID))
 
 	var systems []model.System
 
@@ -791,6 +1167,23 @@ func validateTransition(targetStatus tenantgrpc.Status) tenantValidateFunc {
 	}
 }
 
+// validateJobStatus returns ErrTenantJobOutdated if the tenant is not in the status of the job type,
+// e.g. TERMINATING for a terminate job.
+func validateJobStatus(jobType string) tenantValidateFunc {
+	return func(tenant *model.Tenant) error {
+		status, err := jobTypeToStatus(jobType)
+		if err != nil {
+			return err
+		}
+
+		if tenant.Status != model.TenantStatus(status.String()) {
+			return ErrTenantJobOutdated
+		}
+
+		return nil
+	}
+}
+
 // checkTenantActive returns nil if Tenant has status Available.
 func checkTenantActive(tenant *model.Tenant) error {
 	if tenant.Status.IsActive() {
@@ -844,7 +1237,7 @@ func (t *Tenant) validateTenantBase(tenant *model.Tenant, validateId bool) error
 
 	err = t.validation.ValidateAll(valuesByID)
 	if err != nil {
-		return status.Errorf(codes.InvalidArgument, "invalid tenant: %v", err)
+		return ErrorWithValidationDetails(status.Errorf(codes.InvalidArgument, "invalid tenant: %v", err), err)
 	}
 
 	return nil
@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// anonymizationScan is the name of the checkpoint of the scan of the tenants whose retention elapsed.
+const anonymizationScan = "tenant-anonymization"
+
+// Query fields of the anonymization of tenants.
+const (
+	anonymizedField repository.QueryField = "anonymized"
+	contactsField   repository.QueryField = "contacts"
+)
+
+// anonymizedColumns are the columns of the personal fields of tenants by their configured name.
+var anonymizedColumns = map[string]repository.QueryField{
+	config.AnonymizedFieldOwnerID:  repository.OwnerIDField,
+	config.AnonymizedFieldContacts: contactsField,
+}
+
+// TenantAnonymization anonymizes the personal data of terminated tenants once the retention period after their
+// termination elapsed: it clears the configured fields and removes the configured labels. The tenants are kept
+// and marked as anonymized, so they are no longer matched by their owner.
+type TenantAnonymization struct {
+	repo repository.Repository
+	cfg  config.TenantAnonymization
+	scan config.TableScan
+	now  func() time.Time
+}
+
+// NewTenantAnonymization creates and returns a new instance of TenantAnonymization, which scans the tenants
+// paced by the table scan configuration.
+func NewTenantAnonymization(repo repository.Repository, cfg config.TenantAnonymization, scan config.TableScan) *TenantAnonymization {
+	return &TenantAnonymization{
+		repo: repo,
+		cfg:  cfg,
+		scan: scan,
+		now:  time.Now,
+	}
+}
+
+// Start anonymizes the tenants whose retention elapsed every check interval, until the context is done.
+func (a *TenantAnonymization) Start(ctx context.Context) {
+	if !a.cfg.Enabled {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(a.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				a.anonymizeExpired(ctx)
+			}
+		}
+	}()
+}
+
+// anonymizeExpired anonymizes the TERMINATED tenants which are terminated for longer than the retention.
+// The tenants are scanned page by page, and an interrupted scan resumes after its last scanned page.
+// If several replicas anonymize a tenant at once, the tenant is anonymized the same way by each of them.
+func (a *TenantAnonymization) anonymizeExpired(ctx context.Context) {
+	terminatedBefore := a.now().Add(-a.cfg.Retention)
+
+	scanner := newTableScanner(a.scan, func() *repository.Query {
+		return repository.QueryOf(
+			model.TenantFields.Status.Eq(model.TenantStatus(tenantgrpc.Status_STATUS_TERMINATED.String())),
+			model.TenantFields.StatusUpdatedAt.AtMost(terminatedBefore),
+			model.TenantFields.Anonymized.Eq(false))
+	}, func(t *model.Tenant) time.Time { return t.CreatedAt }).
+		WithCheckpoint(scanCheckpoint{repo: a.repo, name: anonymizationScan})
+
+	_, err := scanner.Scan(ctx, a.repo, func(ctx context.Context, tenants []model.Tenant) error {
+		for _, tenant := range tenants {
+			labelKeys, err := a.anonymize(ctx, &tenant)
+			if err != nil {
+				slogctx.Error(ctx, "failed to anonymize tenant", "error", err, "tenantId", tenant.ID)
+				continue
+			}
+
+			// the anonymization is audit logged with the names of the anonymized fields, not their values
+			slogctx.Info(ctx, "tenant anonymized as its retention elapsed",
+				"tenantId", tenant.ID,
+				"terminatedAt", tenant.StatusUpdatedAt,
+				"fields", a.cfg.Fields,
+				"labelKeys", labelKeys)
+		}
+
+		return nil
+	})
+	if err != nil {
+		slogctx.Error(ctx, "failed to scan tenants whose retention elapsed", "error", err)
+	}
+}
+
+// anonymize clears the personal fields of the tenant, removes its personal labels and marks it as anonymized.
+// It returns the keys of the removed labels.
+func (a *TenantAnonymization) anonymize(ctx context.Context, tenant *model.Tenant) ([]string, error) {
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	patch := &model.Tenant{ID: tenant.ID, Anonymized: true}
+
+	var labelKeys []string
+	for _, key := range a.cfg.LabelKeys {
+		if _, ok := tenant.Labels[key]; ok {
+			labelKeys = append(labelKeys, key)
+		}
+	}
+	if len(labelKeys) > 0 {
+		patch.Labels = removeLabels(tenant.Labels, labelKeys)
+	}
+
+	err := a.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		found, err := r.Patch(ctx, patch)
+		if err != nil {
+			return err
+		}
+		if !found {
+			return ErrTenantNotFound
+		}
+
+		columns := anonymizedTenantColumns(a.cfg.Fields)
+		if len(columns) == 0 {
+			return nil
+		}
+
+		var cleared []model.Tenant
+		_, err = r.ClearAll(ctx, &cleared, *repository.QueryOf(model.TenantFields.ID.Eq(tenant.ID)), columns...)
+		return err
+	})
+
+	return slices.Sorted(slices.Values(labelKeys)), err
+}
+
+// anonymizedTenantColumns returns the columns of the configured personal fields.
+func anonymizedTenantColumns(fields []string) []repository.QueryField {
+	columns := make([]repository.QueryField, 0, len(fields))
+	for _, field := range fields {
+		if column, ok := anonymizedColumns[field]; ok && !slices.Contains(columns, column) {
+			columns = append(columns, column)
+		}
+	}
+
+	return columns
+}
+
+// excludeAnonymizedOwners restricts the conditions filtering tenants by their owner to tenants which are
+// not anonymized, as the owner of an anonymized tenant must not be traceable, even by its owner type.
+func excludeAnonymizedOwners(keys []repository.CompositeKey) {
+	for _, key := range keys {
+		_, byOwnerID := key[repository.OwnerIDField]
+		_, byOwnerType := key[repository.OwnerTypeField]
+		if byOwnerID || byOwnerType {
+			key.Where(anonymizedField, false)
+		}
+	}
+}
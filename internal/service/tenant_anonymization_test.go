@@ -0,0 +1,32 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestExcludeAnonymizedOwners(t *testing.T) {
+	// given
+	byOwnerID := repository.NewCompositeKey().Where(repository.OwnerIDField, []string{"owner"})
+	byOwnerType := repository.NewCompositeKey().Where(repository.OwnerTypeField, repository.Not{Value: "customer"})
+	byRegion := repository.NewCompositeKey().Where(repository.RegionField, "eu01")
+
+	// when
+	service.ExcludeAnonymizedOwners([]repository.CompositeKey{byOwnerID, byOwnerType, byRegion})
+
+	// then
+	assert.Equal(t, false, byOwnerID["anonymized"])
+	assert.Equal(t, false, byOwnerType["anonymized"])
+	assert.NotContains(t, byRegion, repository.QueryField("anonymized"), "filters not by owner must match anonymized tenants")
+}
+
+func TestAnonymizedTenantColumns(t *testing.T) {
+	assert.Equal(t, []repository.QueryField{repository.OwnerIDField, "contacts"},
+		service.AnonymizedTenantColumns([]string{config.AnonymizedFieldOwnerID, config.AnonymizedFieldContacts, config.AnonymizedFieldOwnerID}))
+	assert.Empty(t, service.AnonymizedTenantColumns(nil))
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// BlockedUntilHeaderKey is the header key of GetTenant with the expiry of the block of a tenant blocked
+// for a period, as RFC 3339 timestamp, as the tenant of api-sdk does not define it.
+const BlockedUntilHeaderKey = "registry-blocked-until"
+
+// maxUnblockedTenants is the maximum number of tenants unblocked per check, the others are unblocked by the next checks.
+const maxUnblockedTenants = 100
+
+// TenantBlocks blocks tenants for a period, e.g. for a security investigation,
+// and unblocks them once their blocks expire.
+type TenantBlocks struct {
+	repo    repository.Repository
+	tenants *Tenant
+	cfg     config.TenantBlock
+	now     func() time.Time
+}
+
+// NewTenantBlocks creates and returns a new instance of TenantBlocks.
+func NewTenantBlocks(repo repository.Repository, tenants *Tenant, cfg config.TenantBlock) *TenantBlocks {
+	return &TenantBlocks{
+		repo:    repo,
+		tenants: tenants,
+		cfg:     cfg,
+		now:     time.Now,
+	}
+}
+
+// BlockTenantUntil blocks the tenant like BlockTenant until the block expires at until,
+// or after duration if until is zero. It returns when the block expires.
+func (b *TenantBlocks) BlockTenantUntil(ctx context.Context, id string, until time.Time, duration time.Duration) (time.Time, error) {
+	slogctx.Debug(ctx, "BlockTenantUntil called", "tenantId", id, "until", until, "duration", duration)
+
+	if !b.cfg.Enabled {
+		return time.Time{}, ErrTenantBlockDisabled
+	}
+
+	err := b.tenants.validateIDNonEmpty(id)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	expiry, err := b.expiry(until, duration)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	err = b.tenants.blockTenant(ctx, b.tenants.ids.Normalize(id), &expiry)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return expiry, nil
+}
+
+// expiry returns when a block requested now expires, at until or after duration.
+func (b *TenantBlocks) expiry(until time.Time, duration time.Duration) (time.Time, error) {
+	if until.IsZero() == (duration == 0) {
+		return time.Time{}, ErrTenantBlockExpiryInvalid
+	}
+
+	now := b.now()
+	if until.IsZero() {
+		until = now.Add(duration)
+	}
+
+	if !until.After(now) {
+		return time.Time{}, ErrTenantBlockExpiryInvalid
+	}
+
+	if b.cfg.MaxDuration > 0 && until.Sub(now) > b.cfg.MaxDuration {
+		return time.Time{}, ErrorWithParams(ErrTenantBlockTooLong, "maxDuration", b.cfg.MaxDuration.String())
+	}
+
+	return until.UTC(), nil
+}
+
+// GetTenantBlock returns the tenant with the expiry of its block.
+func (b *TenantBlocks) GetTenantBlock(ctx context.Context, id string) (*model.Tenant, error) {
+	slogctx.Debug(ctx, "GetTenantBlock called", "tenantId", id)
+
+	err := b.tenants.validateIDNonEmpty(id)
+	if err != nil {
+		return nil, err
+	}
+
+	return getTenant(ctx, b.repo, b.tenants.ids.Normalize(id))
+}
+
+// Start unblocks the tenants whose blocks expired every check interval, until the context is done.
+func (b *TenantBlocks) Start(ctx context.Context) {
+	if !b.cfg.Enabled {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(b.cfg.CheckInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				b.unblockExpired(ctx)
+			}
+		}
+	}()
+}
+
+// unblockExpired starts the unblock jobs of the BLOCKED tenants whose blocks expired.
+// Tenants still BLOCKING are unblocked by a later check, once their block jobs are done.
+// If several replicas unblock a tenant at once, the transition rejects all but the first.
+func (b *TenantBlocks) unblockExpired(ctx context.Context) {
+	var tenants []model.Tenant
+	err := b.repo.List(ctx, &tenants, *repository.QueryOf(
+		model.TenantFields.Status.Eq(model.TenantStatus(tenantgrpc.Status_STATUS_BLOCKED.String())),
+		model.TenantFields.BlockedUntil.AtMost(b.now())).
+		SetLimit(maxUnblockedTenants))
+	if err != nil {
+		slogctx.Error(ctx, "failed to list tenants whose blocks expired", "error", err)
+		return
+	}
+
+	for _, tenant := range tenants {
+		_, err := b.tenants.UnblockTenant(ctx, &tenantgrpc.UnblockTenantRequest{Id: tenant.ID})
+		if err != nil {
+			slogctx.Warn(ctx, "failed to unblock tenant whose block expired", "error", err, "tenantId", tenant.ID)
+			continue
+		}
+
+		slogctx.Info(ctx, "tenant unblocked as its block expired", "tenantId", tenant.ID, "blockedUntil", tenant.BlockedUntil)
+	}
+}
+
+// clearBlockExpiry ends the period of a tenant blocked for a period, so it is not unblocked once the period expires.
+// The column is cleared explicitly, as patching the tenant skips unset fields.
+func clearBlockExpiry(ctx context.Context, r repository.Repository, tenant *model.Tenant) error {
+	if tenant.BlockedUntil == nil {
+		return nil
+	}
+
+	var cleared []model.Tenant
+	_, err := r.ClearAll(ctx, &cleared, *repository.QueryOf(model.TenantFields.ID.Eq(tenant.ID)),
+		model.TenantFields.BlockedUntil.Column())
+	if err != nil {
+		return ErrTenantUpdate
+	}
+
+	tenant.BlockedUntil = nil
+
+	return nil
+}
+
+// setBlockedUntilHeader sets the header with the expiry of the block of the tenant, if it is blocked for a period.
+func setBlockedUntilHeader(ctx context.Context, tenant *model.Tenant) {
+	if tenant.BlockedUntil == nil {
+		return
+	}
+
+	header := metadata.Pairs(BlockedUntilHeaderKey, tenant.BlockedUntil.UTC().Format(time.RFC3339))
+	if err := grpc.SetHeader(ctx, header); err != nil {
+		slogctx.Debug(ctx, "failed to set blocked until header", "error", err)
+	}
+}
@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestTenantBlockExpiry(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	subj := service.NewTenantBlocksAt(config.TenantBlock{Enabled: true, MaxDuration: 24 * time.Hour, CheckInterval: time.Minute}, now)
+
+	t.Run("should expire at the requested time", func(t *testing.T) {
+		// when
+		expiry, err := subj.Expiry(now.Add(time.Hour), 0)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(time.Hour), expiry)
+	})
+
+	t.Run("should expire after the requested duration", func(t *testing.T) {
+		// when
+		expiry, err := subj.Expiry(time.Time{}, 2*time.Hour)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(2*time.Hour), expiry)
+	})
+
+	tests := []struct {
+		name     string
+		until    time.Time
+		duration time.Duration
+		expErr   error
+	}{
+		{name: "no expiry", expErr: service.ErrTenantBlockExpiryInvalid},
+		{name: "both expiries", until: now.Add(time.Hour), duration: time.Hour, expErr: service.ErrTenantBlockExpiryInvalid},
+		{name: "past expiry", until: now.Add(-time.Hour), expErr: service.ErrTenantBlockExpiryInvalid},
+		{name: "negative duration", duration: -time.Hour, expErr: service.ErrTenantBlockExpiryInvalid},
+		{name: "longer than the maximum duration", duration: 25 * time.Hour, expErr: service.ErrTenantBlockTooLong},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			// when
+			_, err := subj.Expiry(tt.until, tt.duration)
+
+			// then
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+			assert.Contains(t, err.Error(), status.Convert(tt.expErr).Message())
+		})
+	}
+
+	t.Run("should not restrict the duration without maximum", func(t *testing.T) {
+		// given
+		unrestricted := service.NewTenantBlocksAt(config.TenantBlock{Enabled: true, CheckInterval: time.Minute}, now)
+
+		// when
+		expiry, err := unrestricted.Expiry(time.Time{}, 90*24*time.Hour)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, now.Add(90*24*time.Hour), expiry)
+	})
+}
@@ -0,0 +1,278 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/gofrs/uuid/v5"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Actions performed on the resources of a destroyed tenant.
+const (
+	DestroyActionDeleted  = "deleted"
+	DestroyActionUnlinked = "unlinked"
+	DestroyActionReleased = "released"
+	DestroyActionCanceled = "canceled"
+)
+
+// maxDestroyedAuths bounds the number of auths of a destroyed tenant whose orbital jobs are canceled.
+const maxDestroyedAuths = 1000
+
+// DestroyedResource is a node of the dependency graph of a destroyed tenant: the records of a resource
+// and what was done to them, with the resources depending on them as dependents.
+type DestroyedResource struct {
+	Resource   string              `json:"resource"`
+	Action     string              `json:"action"`
+	Keys       []string            `json:"keys"`
+	Dependents []DestroyedResource `json:"dependents,omitempty"`
+}
+
+// TenantDestroyer hard deletes tenants with everything attached to them, to wipe ephemeral test environments.
+// The records are removed through the repository, so the changes are recorded in the change feed,
+// and the unfinished orbital jobs are canceled through orbital.
+type TenantDestroyer struct {
+	repo    repository.Repository
+	orbital *Orbital
+	cfg     config.TenantDestroy
+}
+
+// NewTenantDestroyer creates and returns a new instance of TenantDestroyer.
+func NewTenantDestroyer(repo repository.Repository, orbital *Orbital, cfg config.TenantDestroy) *TenantDestroyer {
+	return &TenantDestroyer{
+		repo:    repo,
+		orbital: orbital,
+		cfg:     cfg,
+	}
+}
+
+// DestroyTenant deletes the tenant, its auths, identity provider, system groups, user groups and link history,
+// unlinks its systems and releases their L1 key claims within one transaction. The unfinished orbital jobs of the tenant,
+// including those of its identity provider, and of its auths are canceled first.
+// The systems themselves are kept, as they may be registered again by a test.
+// It returns the dependency graph of the destroyed tenant, rooted at the tenant.
+// It fails unless destroying tenants is enabled.
+func (d *TenantDestroyer) DestroyTenant(ctx context.Context, id string) (*DestroyedResource, error) {
+	slogctx.Debug(ctx, "DestroyTenant called", "tenantId", id)
+
+	if !d.cfg.Enabled {
+		return nil, ErrTenantDestroyDisabled
+	}
+
+	if id == "" {
+		return nil, ErrorWithParams(ErrValidationFailed, "err", "tenant ID must not be empty")
+	}
+
+	destroyed, err := d.destroyTenant(ctx, id)
+	if errors.Is(err, ErrTenantNotFound) {
+		return nil, ErrTenantNotFound
+	}
+	if err != nil {
+		slogctx.Error(ctx, "failed to destroy tenant", "tenantId", id, "error", err)
+		return nil, ErrTenantDestroy
+	}
+
+	slogctx.Warn(ctx, "tenant destroyed", "tenantId", id, "destroyed", destroyed)
+
+	return destroyed, nil
+}
+
+func (d *TenantDestroyer) destroyTenant(ctx context.Context, id string) (*DestroyedResource, error) {
+	found, err := d.repo.Find(ctx, &model.Tenant{ID: id})
+	if err != nil {
+		return nil, err
+	}
+
+	if !found {
+		return nil, ErrTenantNotFound
+	}
+
+	tenantJobs, err := d.cancelJobs(ctx, []string{id})
+	if err != nil {
+		return nil, err
+	}
+
+	var auths []model.Auth
+	if err := d.repo.List(ctx, &auths, *repository.QueryOf(model.AuthFields.TenantID.Eq(id)).
+		SetLimit(maxDestroyedAuths)); err != nil {
+		return nil, err
+	}
+
+	authIDs := make([]string, 0, len(auths))
+	for _, auth := range auths {
+		authIDs = append(authIDs, auth.ExternalID)
+	}
+
+	authJobs, err := d.cancelJobs(ctx, authIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	root := &DestroyedResource{Resource: "tenants", Action: DestroyActionDeleted, Keys: []string{id}}
+
+	err = d.repo.Transaction(ctx, func(ctx context.Context, r repository.Repository) error {
+		root.Dependents = []DestroyedResource{tenantJobs}
+
+		authsNode, err := deleteTenantRecords(ctx, r, id, func(a model.Auth) string { return a.ExternalID })
+		if err != nil {
+			return err
+		}
+
+		authsNode.Dependents = []DestroyedResource{authJobs}
+		root.Dependents = append(root.Dependents, authsNode)
+
+		providersNode, err := deleteTenantRecords(ctx, r, id, func(p model.IdentityProvider) string { return p.Issuer })
+		if err != nil {
+			return err
+		}
+
+		preferencesNode, err := deleteTenantRecords(ctx, r, id, func(p model.NotificationPreferences) string { return p.TenantID })
+		if err != nil {
+			return err
+		}
+
+		groupsNode, err := deleteTenantRecords(ctx, r, id, func(g model.SystemGroup) string { return g.Name })
+		if err != nil {
+			return err
+		}
+
+		linksNode, err := deleteTenantRecords(ctx, r, id, func(l model.SystemLink) string { return l.ExternalID + "/" + l.Type })
+		if err != nil {
+			return err
+		}
+
+		userGroupsNode, err := deleteTenantRecords(ctx, r, id, func(g model.TenantUserGroup) string { return g.Name })
+		if err != nil {
+			return err
+		}
+
+		statusChangesNode, err := deleteTenantRecords(ctx, r, id, func(c model.TenantStatusChange) string { return c.ID.String() })
+		if err != nil {
+			return err
+		}
+
+		serviceAccountsNode, err := deleteTenantRecords(ctx, r, id, func(s model.ServiceAccount) string { return s.ID })
+		if err != nil {
+			return err
+		}
+
+		systemsNode, err := unlinkTenantSystems(ctx, r, id)
+		if err != nil {
+			return err
+		}
+
+		root.Dependents = append(root.Dependents, providersNode, preferencesNode, groupsNode, linksNode, userGroupsNode, statusChangesNode, serviceAccountsNode, systemsNode)
+
+		deleted, err := r.Delete(ctx, &model.Tenant{ID: id})
+		if err != nil {
+			return err
+		}
+
+		if !deleted {
+			return ErrTenantNotFound
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+// cancelJobs cancels the unfinished orbital jobs of the external IDs, the IDs of tenants or auths.
+func (d *TenantDestroyer) cancelJobs(ctx context.Context, externalIDs []string) (DestroyedResource, error) {
+	node := DestroyedResource{Resource: "jobs", Action: DestroyActionCanceled, Keys: []string{}}
+
+	for chunk := range slices.Chunk(externalIDs, repository.MaxFilterValues) {
+		jobs, err := listJobs(ctx, d.repo, repository.NewCompositeKey().
+			Where(repository.ExternalIDField, chunk).
+			Where(repository.StatusField, repository.Not{Value: terminalJobStatuses}))
+		if err != nil {
+			return node, err
+		}
+
+		for _, job := range jobs {
+			if err := d.orbital.CancelJob(ctx, job.ID); err != nil {
+				return node, err
+			}
+
+			node.Keys = append(node.Keys, job.ID)
+		}
+	}
+
+	return node, nil
+}
+
+// deleteTenantRecords deletes the records of type T of the tenant and returns them as node keyed by key.
+func deleteTenantRecords[T any, PT interface {
+	*T
+	repository.Resource
+}](ctx context.Context, r repository.Repository, tenantID string, key func(T) string) (DestroyedResource, error) {
+	var deleted []T
+
+	_, err := r.DeleteAll(ctx, &deleted, *repository.NewQuery(PT(new(T))).
+		Where(repository.NewCompositeKey().Where(repository.TenantIDField, tenantID)))
+	if err != nil {
+		return DestroyedResource{}, err
+	}
+
+	node := DestroyedResource{
+		Resource: PT(new(T)).TableName(),
+		Action:   DestroyActionDeleted,
+		Keys:     make([]string, 0, len(deleted)),
+	}
+	for _, record := range deleted {
+		node.Keys = append(node.Keys, key(record))
+	}
+
+	return node, nil
+}
+
+// unlinkTenantSystems unlinks the systems of the tenant and releases the L1 key claims of their regional systems.
+func unlinkTenantSystems(ctx context.Context, r repository.Repository, tenantID string) (DestroyedResource, error) {
+	var systems []model.System
+
+	_, err := r.ClearAll(ctx, &systems, *repository.QueryOf(model.SystemFields.TenantID.Eq(tenantID)),
+		model.SystemFields.TenantID.Column())
+	if err != nil {
+		return DestroyedResource{}, err
+	}
+
+	node := DestroyedResource{Resource: "systems", Action: DestroyActionUnlinked, Keys: make([]string, 0, len(systems))}
+	claims := DestroyedResource{Resource: "regional_systems", Action: DestroyActionReleased, Keys: []string{}}
+
+	systemIDs := make([]uuid.UUID, 0, len(systems))
+	for _, system := range systems {
+		node.Keys = append(node.Keys, system.ExternalID+"/"+system.Type)
+		systemIDs = append(systemIDs, system.ID)
+	}
+
+	hasL1KeyClaim := false
+
+	for chunk := range slices.Chunk(systemIDs, repository.MaxFilterValues) {
+		var released []model.RegionalSystem
+
+		_, err := r.PatchAll(ctx, &model.RegionalSystem{HasL1KeyClaim: &hasL1KeyClaim}, &released,
+			*repository.QueryOf(
+				model.RegionalSystemFields.SystemID.In(chunk...),
+				model.RegionalSystemFields.HasL1KeyClaim.Eq(true)))
+		if err != nil {
+			return DestroyedResource{}, err
+		}
+
+		for _, regionalSystem := range released {
+			claims.Keys = append(claims.Keys, regionalSystem.SystemID.String()+"/"+regionalSystem.Region)
+		}
+	}
+
+	node.Dependents = []DestroyedResource{claims}
+
+	return node, nil
+}
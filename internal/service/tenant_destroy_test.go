@@ -0,0 +1,37 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestDestroyTenantInvalidRequest(t *testing.T) {
+	t.Run("should return error if destroying tenants is disabled", func(t *testing.T) {
+		// given
+		subj := service.NewTenantDestroyer(nil, nil, config.TenantDestroy{})
+
+		// when
+		destroyed, err := subj.DestroyTenant(t.Context(), "tenant")
+
+		// then
+		assert.ErrorIs(t, err, service.ErrTenantDestroyDisabled)
+		assert.Nil(t, destroyed)
+	})
+
+	t.Run("should return error for empty tenant ID", func(t *testing.T) {
+		// given
+		subj := service.NewTenantDestroyer(nil, nil, config.TenantDestroy{Enabled: true})
+
+		// when
+		_, err := subj.DestroyTenant(t.Context(), "")
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
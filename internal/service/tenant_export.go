@@ -0,0 +1,427 @@
+package service
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// RedactedValue replaces the values of redacted labels and properties in tenant exports.
+const RedactedValue = "REDACTED"
+
+type (
+	// TenantExport is everything the registry stores about a tenant.
+	// The registry does not keep audit events, so the history consists of the orbital jobs of the tenant and its auths.
+	TenantExport struct {
+		ExportedAt   time.Time             `json:"exportedAt"`
+		Tenant       ExportedTenant        `json:"tenant"`
+		Systems      []ExportedSystem      `json:"systems"`
+		SystemGroups []ExportedSystemGroup `json:"systemGroups"`
+		Auths        []ExportedAuth        `json:"auths"`
+		History      []ExportedJob         `json:"history"`
+	}
+
+	ExportedTenant struct {
+		ID              string               `json:"id"`
+		Name            string               `json:"name"`
+		Region          string               `json:"region"`
+		OwnerID         string               `json:"ownerId"`
+		OwnerType       string               `json:"ownerType"`
+		Status          string               `json:"status"`
+		StatusUpdatedAt time.Time            `json:"statusUpdatedAt"`
+		Role            string               `json:"role"`
+		Labels          map[string]string    `json:"labels,omitempty"`
+		UserGroups      []string             `json:"userGroups,omitempty"`
+		Contacts        model.TenantContacts `json:"contacts"`
+		CreatedBy       string               `json:"createdBy,omitempty"`
+		LastModifiedBy  string               `json:"lastModifiedBy,omitempty"`
+		UpdatedAt       time.Time            `json:"updatedAt"`
+		CreatedAt       time.Time            `json:"createdAt"`
+	}
+
+	ExportedSystem struct {
+		ID             string                   `json:"id"`
+		ExternalID     string                   `json:"externalId"`
+		Type           string                   `json:"type"`
+		Regions        []ExportedRegionalSystem `json:"regions"`
+		CreatedBy      string                   `json:"createdBy,omitempty"`
+		LastModifiedBy string                   `json:"lastModifiedBy,omitempty"`
+		UpdatedAt      time.Time                `json:"updatedAt"`
+		CreatedAt      time.Time                `json:"createdAt"`
+	}
+
+	ExportedRegionalSystem struct {
+		Region         string            `json:"region"`
+		Status         string            `json:"status"`
+		L2KeyID        string            `json:"l2KeyId"`
+		HasL1KeyClaim  bool              `json:"hasL1KeyClaim"`
+		ApprovalStatus string            `json:"approvalStatus,omitempty"`
+		Labels         map[string]string `json:"labels,omitempty"`
+		CreatedBy      string            `json:"createdBy,omitempty"`
+		LastModifiedBy string            `json:"lastModifiedBy,omitempty"`
+		UpdatedAt      time.Time         `json:"updatedAt"`
+		CreatedAt      time.Time         `json:"createdAt"`
+	}
+
+	ExportedSystemGroup struct {
+		ID        string                   `json:"id"`
+		Name      string                   `json:"name"`
+		Members   []model.SystemIdentifier `json:"members"`
+		Labels    map[string]string        `json:"labels,omitempty"`
+		UpdatedAt time.Time                `json:"updatedAt"`
+		CreatedAt time.Time                `json:"createdAt"`
+	}
+
+	ExportedAuth struct {
+		ExternalID     string            `json:"externalId"`
+		Type           string            `json:"type"`
+		Status         string            `json:"status"`
+		ErrorMessage   string            `json:"errorMessage,omitempty"`
+		Properties     map[string]string `json:"properties,omitempty"`
+		CreatedBy      string            `json:"createdBy,omitempty"`
+		LastModifiedBy string            `json:"lastModifiedBy,omitempty"`
+		UpdatedAt      time.Time         `json:"updatedAt"`
+		CreatedAt      time.Time         `json:"createdAt"`
+	}
+
+	// ExportedJob is an orbital job executed for the tenant or one of its auths.
+	ExportedJob struct {
+		ID           string    `json:"id"`
+		ExternalID   string    `json:"externalId"`
+		Type         string    `json:"type"`
+		Status       string    `json:"status"`
+		ErrorMessage string    `json:"errorMessage,omitempty"`
+		UpdatedAt    time.Time `json:"updatedAt"`
+		CreatedAt    time.Time `json:"createdAt"`
+	}
+
+	// TenantExportResult holds the JSON document of an export,
+	// or the location it was written to if an export directory is configured.
+	TenantExportResult struct {
+		Data     []byte
+		Location string
+	}
+)
+
+// TenantExports compiles everything the registry stores about a tenant, e.g. for data subject requests.
+// The procedure call is served on the admin service, see Admin.
+type TenantExports struct {
+	repo repository.Repository
+	cfg  config.TenantExport
+	scan config.TableScan
+	now  func() time.Time
+}
+
+// NewTenantExports creates and returns a new instance of TenantExports, which reads the records
+// of the tenants paced by the table scan configuration.
+func NewTenantExports(repo repository.Repository, cfg config.TenantExport, scan config.TableScan) *TenantExports {
+	return &TenantExports{
+		repo: repo,
+		cfg:  cfg,
+		scan: scan,
+		now:  time.Now,
+	}
+}
+
+// ExportTenantData returns the JSON export of the tenant, or writes it to the configured directory.
+// Values of labels and auth properties with keys matching a redaction pattern are replaced by RedactedValue.
+func (e *TenantExports) ExportTenantData(ctx context.Context, id string) (*TenantExportResult, error) {
+	slogctx.Debug(ctx, "ExportTenantData called", "tenantId", id)
+
+	if id == "" {
+		return nil, ErrorWithParams(ErrValidationFailed, "err", "tenant ID must not be empty")
+	}
+
+	// exports are served by the admin pool, so they can not exhaust the connections of interactive requests
+	ctx = repository.WithPool(ctx, repository.PoolAdmin)
+
+	export, err := e.compile(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		slogctx.Error(ctx, "failed to encode tenant export", "error", err)
+		return nil, ErrTenantEncoding
+	}
+
+	if e.cfg.Directory == "" {
+		return &TenantExportResult{Data: data}, nil
+	}
+
+	location := filepath.Join(e.cfg.Directory, fmt.Sprintf("%s-%s.json", id, export.ExportedAt.Format("20060102T150405Z")))
+	if err := os.WriteFile(location, data, 0o600); err != nil {
+		slogctx.Error(ctx, "failed to write tenant export", "location", location, "error", err)
+		return nil, ErrTenantExportWrite
+	}
+
+	return &TenantExportResult{Location: location}, nil
+}
+
+func (e *TenantExports) compile(ctx context.Context, id string) (*TenantExport, error) {
+	tenant, err := getTenant(ctx, e.repo, id)
+	if err != nil {
+		return nil, err
+	}
+
+	export := &TenantExport{
+		ExportedAt: e.now().UTC(),
+		Tenant:     e.exportTenant(tenant),
+	}
+
+	export.Systems, err = e.exportSystems(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	export.SystemGroups, err = e.exportSystemGroups(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	export.Auths, err = e.exportAuths(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	externalIDs := make([]string, 0, len(export.Auths)+1)
+	externalIDs = append(externalIDs, id)
+	for _, auth := range export.Auths {
+		externalIDs = append(externalIDs, auth.ExternalID)
+	}
+
+	export.History, err = e.exportHistory(ctx, externalIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return export, nil
+}
+
+func (e *TenantExports) exportTenant(t *model.Tenant) ExportedTenant {
+	return ExportedTenant{
+		ID:              t.ID,
+		Name:            t.Name,
+		Region:          t.Region,
+		OwnerID:         t.OwnerID,
+		OwnerType:       t.OwnerType,
+		Status:          string(t.Status),
+		StatusUpdatedAt: t.StatusUpdatedAt,
+		Role:            t.Role,
+		Labels:          redact(t.Labels, e.cfg.RedactedKeys),
+		UserGroups:      t.UserGroups,
+		Contacts:        t.Contacts,
+		CreatedBy:       t.CreatedBy,
+		LastModifiedBy:  t.LastModifiedBy,
+		UpdatedAt:       t.UpdatedAt,
+		CreatedAt:       t.CreatedAt,
+	}
+}
+
+// exportSystems returns the systems linked to the tenant with their regional systems.
+func (e *TenantExports) exportSystems(ctx context.Context, tenantID string) ([]ExportedSystem, error) {
+	regionalSystems, err := listAll(ctx, e.repo, e.scan, func() *repository.Query {
+		query := repository.NewQuery(&model.RegionalSystem{}).Where(repository.NewCompositeKey().
+			Where(model.SystemFields.TenantID.Qualified(), tenantID))
+		query.Joins = []repository.Join{repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)}
+		query.Populate(repository.System)
+
+		return query
+	}, func(s *model.RegionalSystem) time.Time { return s.CreatedAt })
+	if err != nil {
+		slogctx.Error(ctx, "failed to list systems of tenant", "error", err)
+		return nil, ErrSystemSelect
+	}
+
+	systems := make([]ExportedSystem, 0)
+	indexes := make(map[string]int)
+	for _, rs := range regionalSystems {
+		if rs.System == nil {
+			continue
+		}
+
+		systemID := rs.System.ID.String()
+		i, ok := indexes[systemID]
+		if !ok {
+			i = len(systems)
+			indexes[systemID] = i
+			systems = append(systems, ExportedSystem{
+				ID:             systemID,
+				ExternalID:     rs.System.ExternalID,
+				Type:           rs.System.Type,
+				CreatedBy:      rs.System.CreatedBy,
+				LastModifiedBy: rs.System.LastModifiedBy,
+				UpdatedAt:      rs.System.UpdatedAt,
+				CreatedAt:      rs.System.CreatedAt,
+			})
+		}
+
+		systems[i].Regions = append(systems[i].Regions, ExportedRegionalSystem{
+			Region:         rs.Region,
+			Status:         rs.Status,
+			L2KeyID:        rs.L2KeyID,
+			HasL1KeyClaim:  rs.HasActiveL1KeyClaim(),
+			ApprovalStatus: rs.ApprovalStatus,
+			Labels:         redact(rs.Labels, e.cfg.RedactedKeys),
+			CreatedBy:      rs.CreatedBy,
+			LastModifiedBy: rs.LastModifiedBy,
+			UpdatedAt:      rs.UpdatedAt,
+			CreatedAt:      rs.CreatedAt,
+		})
+	}
+
+	return systems, nil
+}
+
+func (e *TenantExports) exportSystemGroups(ctx context.Context, tenantID string) ([]ExportedSystemGroup, error) {
+	groups, err := listAll(ctx, e.repo, e.scan, func() *repository.Query {
+		return repository.NewQuery(&model.SystemGroup{}).
+			Where(repository.NewCompositeKey().Where(repository.TenantIDField, tenantID))
+	}, func(g *model.SystemGroup) time.Time { return g.CreatedAt })
+	if err != nil {
+		slogctx.Error(ctx, "failed to list system groups of tenant", "error", err)
+		return nil, ErrSystemGroupSelect
+	}
+
+	exported := make([]ExportedSystemGroup, 0, len(groups))
+	for _, g := range groups {
+		exported = append(exported, ExportedSystemGroup{
+			ID:        g.ID.String(),
+			Name:      g.Name,
+			Members:   g.Members,
+			Labels:    redact(g.Labels, e.cfg.RedactedKeys),
+			UpdatedAt: g.UpdatedAt,
+			CreatedAt: g.CreatedAt,
+		})
+	}
+
+	return exported, nil
+}
+
+func (e *TenantExports) exportAuths(ctx context.Context, tenantID string) ([]ExportedAuth, error) {
+	auths, err := listAll(ctx, e.repo, e.scan, func() *repository.Query {
+		return repository.QueryOf(model.AuthFields.TenantID.Eq(tenantID))
+	}, func(a *model.Auth) time.Time { return a.CreatedAt })
+	if err != nil {
+		slogctx.Error(ctx, "failed to list auths of tenant", "error", err)
+		return nil, ErrAuthSelect
+	}
+
+	if err := resolveAuthPropertyBlobs(ctx, e.repo, authPointers(auths)...); err != nil {
+		return nil, ErrAuthSelect
+	}
+
+	exported := make([]ExportedAuth, 0, len(auths))
+	for _, a := range auths {
+		exported = append(exported, ExportedAuth{
+			ExternalID:     a.ExternalID,
+			Type:           a.Type,
+			Status:         a.Status,
+			ErrorMessage:   a.ErrorMessage,
+			Properties:     redact(a.Properties, e.cfg.RedactedKeys),
+			CreatedBy:      a.CreatedBy,
+			LastModifiedBy: a.LastModifiedBy,
+			UpdatedAt:      a.UpdatedAt,
+			CreatedAt:      a.CreatedAt,
+		})
+	}
+
+	return exported, nil
+}
+
+// exportHistory returns the orbital jobs of the given external IDs, oldest first.
+func (e *TenantExports) exportHistory(ctx context.Context, externalIDs []string) ([]ExportedJob, error) {
+	var jobs []model.Job
+	for chunk := range slices.Chunk(externalIDs, repository.MaxFilterValues) {
+		chunkJobs, err := listJobs(ctx, e.repo, repository.NewCompositeKey().Where(repository.ExternalIDField, chunk))
+		if err != nil {
+			slogctx.Error(ctx, "failed to list jobs of tenant", "error", err)
+			return nil, ErrTenantSelect
+		}
+
+		jobs = append(jobs, chunkJobs...)
+	}
+
+	slices.SortStableFunc(jobs, func(a, b model.Job) int {
+		return cmp.Compare(a.CreatedAt, b.CreatedAt)
+	})
+
+	history := make([]ExportedJob, 0, len(jobs))
+	for _, job := range jobs {
+		exported := ExportedJob{
+			ID:         job.ID,
+			ExternalID: job.ExternalID,
+			Type:       job.Type,
+			Status:     job.Status,
+			UpdatedAt:  time.Unix(0, job.UpdatedAt).UTC(),
+			CreatedAt:  time.Unix(0, job.CreatedAt).UTC(),
+		}
+		if job.ErrorMessage != nil {
+			exported.ErrorMessage = *job.ErrorMessage
+		}
+
+		history = append(history, exported)
+	}
+
+	return history, nil
+}
+
+// listAll lists all resources of the query page by page, see repository.Scanner.
+func listAll[T any, PT interface {
+	*T
+	repository.Resource
+}](ctx context.Context, r repository.Repository, scan config.TableScan, newQuery func() *repository.Query, createdAt func(*T) time.Time) ([]T, error) {
+	var all []T
+
+	_, err := newTableScanner[T, PT](scan, newQuery, createdAt).Scan(ctx, r, func(_ context.Context, page []T) error {
+		all = append(all, page...)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return all, nil
+}
+
+// redact returns a copy of the values with the values of keys matching any of the patterns replaced.
+func redact(values map[string]string, patterns []string) map[string]string {
+	if values == nil {
+		return nil
+	}
+
+	redacted := make(map[string]string, len(values))
+	for k, v := range values {
+		redacted[k] = v
+		if redactedKey(k, patterns) {
+			redacted[k] = RedactedValue
+		}
+	}
+
+	return redacted
+}
+
+func redactedKey(key string, patterns []string) bool {
+	key = strings.ToLower(key)
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(strings.ToLower(pattern), key); ok {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,235 @@
+package service
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/klauspost/compress/zstd"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+// Compressions of the chunks of streamed tenant exports.
+const (
+	ExportCompressionNone = "none"
+	ExportCompressionGzip = "gzip"
+	ExportCompressionZstd = "zstd"
+)
+
+// zstdEncoder compresses the chunks of exports with EncodeAll, which may be called concurrently.
+var zstdEncoder, _ = zstd.NewWriter(nil)
+
+type (
+	// TenantExportStream is the server side of a StreamTenantExport call.
+	// It is satisfied by a gRPC server stream of TenantExportChunk messages.
+	TenantExportStream interface {
+		Context() context.Context
+		Send(*TenantExportChunk) error
+	}
+
+	// TenantExportChunk is a part of a streamed export. Its data is compressed, the checksum is the hex
+	// encoded SHA-256 of the uncompressed data, so clients can detect corrupted chunks and request them again.
+	TenantExportChunk struct {
+		Location    string
+		Offset      int64
+		Data        []byte
+		Compression string
+		Checksum    string
+		Size        int64
+		Last        bool
+	}
+
+	// TenantExportStreamRequest selects the export to stream and the size and compression of its chunks.
+	// An export written to the export directory is resumed by its location and the offset to continue from.
+	TenantExportStreamRequest struct {
+		TenantID    string
+		Compression string
+		ChunkSize   int64
+		Location    string
+		Offset      int64
+	}
+
+	// exportSource is the uncompressed JSON document of an export.
+	exportSource struct {
+		data     io.ReaderAt
+		size     int64
+		location string
+		close    func() error
+	}
+)
+
+// StreamTenantExport sends the export of the tenant in chunks, either a new one or the one written to the location.
+// A new export is written to the export directory if one is configured, and its chunks carry its location.
+func (e *TenantExports) StreamTenantExport(stream TenantExportStream, req TenantExportStreamRequest) error {
+	ctx := stream.Context()
+	ctx = slogctx.With(ctx, "tenantId", req.TenantID, "location", req.Location, "offset", req.Offset)
+	slogctx.Debug(ctx, "StreamTenantExport called")
+
+	compression, compress, err := chunkCompressor(req.Compression)
+	if err != nil {
+		return err
+	}
+
+	src, err := e.openExport(ctx, req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := src.close(); err != nil {
+			slogctx.Warn(ctx, "failed to close tenant export", "error", err)
+		}
+	}()
+
+	if req.Offset < 0 || req.Offset > src.size {
+		return ErrTenantExportOffset
+	}
+
+	chunkSize := int64(e.cfg.ChunkSize)
+	if chunkSize <= 0 {
+		chunkSize = config.MaxExportChunkSize
+	}
+	if req.ChunkSize > 0 && req.ChunkSize < chunkSize {
+		chunkSize = req.ChunkSize
+	}
+
+	buf := make([]byte, chunkSize)
+	for offset := req.Offset; ; {
+		n, err := src.data.ReadAt(buf[:min(chunkSize, src.size-offset)], offset)
+		if err != nil && !errors.Is(err, io.EOF) {
+			slogctx.Error(ctx, "failed to read tenant export", "error", err)
+			return ErrTenantExportRead
+		}
+
+		data := buf[:n]
+		checksum := sha256.Sum256(data)
+
+		compressed, err := compress(data)
+		if err != nil {
+			slogctx.Error(ctx, "failed to compress tenant export", "error", err)
+			return ErrTenantEncoding
+		}
+
+		chunk := &TenantExportChunk{
+			Location:    src.location,
+			Offset:      offset,
+			Data:        compressed,
+			Compression: compression,
+			Checksum:    hex.EncodeToString(checksum[:]),
+			Size:        src.size,
+		}
+
+		offset += int64(n)
+		chunk.Last = offset >= src.size || n == 0
+
+		if err := stream.Send(chunk); err != nil {
+			return err
+		}
+
+		if chunk.Last {
+			return nil
+		}
+	}
+}
+
+// openExport opens the export written to the location of the request, or compiles a new one.
+func (e *TenantExports) openExport(ctx context.Context, req TenantExportStreamRequest) (*exportSource, error) {
+	if req.Location != "" {
+		return e.openWritten(ctx, req.TenantID, req.Location)
+	}
+
+	// a new export may differ from the one an interrupted stream was sending
+	if req.Offset != 0 {
+		return nil, ErrTenantExportResume
+	}
+
+	result, err := e.ExportTenantData(ctx, req.TenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Location != "" {
+		return e.openWritten(ctx, req.TenantID, result.Location)
+	}
+
+	return &exportSource{
+		data:  bytes.NewReader(result.Data),
+		size:  int64(len(result.Data)),
+		close: func() error { return nil },
+	}, nil
+}
+
+// openWritten opens the export of the tenant at the location, which must be in the export directory.
+func (e *TenantExports) openWritten(ctx context.Context, tenantID, location string) (*exportSource, error) {
+	location = filepath.Clean(location)
+	if e.cfg.Directory == "" || filepath.Dir(location) != filepath.Clean(e.cfg.Directory) ||
+		!strings.HasPrefix(filepath.Base(location), tenantID+"-") {
+		return nil, ErrTenantExportLocation
+	}
+
+	file, err := os.Open(location)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrTenantExportNotFound
+	}
+	if err != nil {
+		slogctx.Error(ctx, "failed to open tenant export", "error", err)
+		return nil, ErrTenantExportRead
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+		slogctx.Error(ctx, "failed to open tenant export", "error", err)
+		return nil, ErrTenantExportRead
+	}
+
+	return &exportSource{
+		data:     file,
+		size:     info.Size(),
+		location: location,
+		close:    file.Close,
+	}, nil
+}
+
+// chunkCompressor returns the normalized name of the compression and the function compressing chunks with it.
+func chunkCompressor(compression string) (string, func([]byte) ([]byte, error), error) {
+	switch strings.ToLower(compression) {
+	case "", ExportCompressionNone:
+		return ExportCompressionNone, func(data []byte) ([]byte, error) {
+			return slices.Clone(data), nil
+		}, nil
+	case ExportCompressionGzip:
+		return ExportCompressionGzip, gzipChunk, nil
+	case ExportCompressionZstd:
+		return ExportCompressionZstd, func(data []byte) ([]byte, error) {
+			return zstdEncoder.EncodeAll(data, nil), nil
+		}, nil
+	default:
+		return "", nil, ErrorWithParams(ErrExportCompressionUnsupported, "compression", compression)
+	}
+}
+
+func gzipChunk(data []byte) ([]byte, error) {
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(data); err != nil {
+		return nil, err
+	}
+
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
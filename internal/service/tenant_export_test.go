@@ -0,0 +1,218 @@
+package service_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestRedact(t *testing.T) {
+	patterns := []string{"*secret*", "*token*", "password"}
+
+	tests := []struct {
+		name      string
+		values    map[string]string
+		expValues map[string]string
+	}{
+		{
+			name:      "nil values",
+			values:    nil,
+			expValues: nil,
+		},
+		{
+			name:      "no matching keys",
+			values:    map[string]string{"issuer": "https://issuer", "env": "prod"},
+			expValues: map[string]string{"issuer": "https://issuer", "env": "prod"},
+		},
+		{
+			name:      "matching keys",
+			values:    map[string]string{"client_secret": "s3cr3t", "AccessToken": "abc", "password": "pw", "issuer": "https://issuer"},
+			expValues: map[string]string{"client_secret": service.RedactedValue, "AccessToken": service.RedactedValue, "password": service.RedactedValue, "issuer": "https://issuer"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			redacted := service.Redact(tt.values, patterns)
+
+			// then
+			assert.Equal(t, tt.expValues, redacted)
+		})
+	}
+}
+
+func TestRedactKeepsInput(t *testing.T) {
+	// given
+	values := map[string]string{"client_secret": "s3cr3t"}
+
+	// when
+	_ = service.Redact(values, []string{"*secret*"})
+
+	// then
+	assert.Equal(t, "s3cr3t", values["client_secret"])
+}
+
+type exportStream struct {
+	ctx    context.Context
+	chunks []*service.TenantExportChunk
+}
+
+func (s *exportStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *exportStream) Send(chunk *service.TenantExportChunk) error {
+	s.chunks = append(s.chunks, chunk)
+	return nil
+}
+
+func TestStreamTenantExport(t *testing.T) {
+	dir := t.TempDir()
+	location := filepath.Join(dir, "tenant-20260101T000000Z.json")
+	require.NoError(t, os.WriteFile(location, []byte(`{"tenant":"0123"}`), 0o600))
+
+	subj := service.NewTenantExports(nil, config.TenantExport{Directory: dir, ChunkSize: 8}, config.TableScan{})
+
+	decompress := map[string]func(t *testing.T, data []byte) []byte{
+		service.ExportCompressionNone: func(_ *testing.T, data []byte) []byte { return data },
+		service.ExportCompressionGzip: func(t *testing.T, data []byte) []byte {
+			t.Helper()
+			r, err := gzip.NewReader(bytes.NewReader(data))
+			require.NoError(t, err)
+			out, err := io.ReadAll(r)
+			require.NoError(t, err)
+			return out
+		},
+		service.ExportCompressionZstd: func(t *testing.T, data []byte) []byte {
+			t.Helper()
+			r, err := zstd.NewReader(nil)
+			require.NoError(t, err)
+			defer r.Close()
+			out, err := r.DecodeAll(data, nil)
+			require.NoError(t, err)
+			return out
+		},
+	}
+
+	for compression, decompress := range decompress {
+		t.Run("should stream the written export compressed with "+compression, func(t *testing.T) {
+			// given
+			stream := &exportStream{ctx: t.Context()}
+
+			// when
+			err := subj.StreamTenantExport(stream, service.TenantExportStreamRequest{
+				TenantID:    "tenant",
+				Compression: compression,
+				Location:    location,
+			})
+
+			// then
+			require.NoError(t, err)
+			require.Len(t, stream.chunks, 3)
+
+			var export []byte
+			for i, chunk := range stream.chunks {
+				data := decompress(t, chunk.Data)
+				checksum := sha256.Sum256(data)
+
+				assert.Equal(t, hex.EncodeToString(checksum[:]), chunk.Checksum)
+				assert.Equal(t, int64(len(export)), chunk.Offset)
+				assert.Equal(t, int64(17), chunk.Size)
+				assert.Equal(t, compression, chunk.Compression)
+				assert.Equal(t, location, chunk.Location)
+				assert.Equal(t, i == 2, chunk.Last)
+
+				export = append(export, data...)
+			}
+			assert.JSONEq(t, `{"tenant":"0123"}`, string(export))
+		})
+	}
+
+	t.Run("should resume from the offset with smaller chunks", func(t *testing.T) {
+		// given
+		stream := &exportStream{ctx: t.Context()}
+
+		// when
+		err := subj.StreamTenantExport(stream, service.TenantExportStreamRequest{
+			TenantID:  "tenant",
+			ChunkSize: 4,
+			Location:  location,
+			Offset:    10,
+		})
+
+		// then
+		require.NoError(t, err)
+		require.Len(t, stream.chunks, 2)
+		assert.Equal(t, "\"012", string(stream.chunks[0].Data))
+		assert.Equal(t, int64(10), stream.chunks[0].Offset)
+		assert.Equal(t, "3\"}", string(stream.chunks[1].Data))
+		assert.True(t, stream.chunks[1].Last)
+	})
+
+	tests := []struct {
+		name    string
+		req     service.TenantExportStreamRequest
+		expCode codes.Code
+	}{
+		{
+			name:    "unsupported compression",
+			req:     service.TenantExportStreamRequest{TenantID: "tenant", Compression: "brotli", Location: location},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "location outside the export directory",
+			req:     service.TenantExportStreamRequest{TenantID: "tenant", Location: "/etc/tenant-20260101T000000Z.json"},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "location of another tenant",
+			req:     service.TenantExportStreamRequest{TenantID: "other", Location: location},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "missing export",
+			req:     service.TenantExportStreamRequest{TenantID: "tenant", Location: filepath.Join(dir, "tenant-20250101T000000Z.json")},
+			expCode: codes.NotFound,
+		},
+		{
+			name:    "offset beyond the end",
+			req:     service.TenantExportStreamRequest{TenantID: "tenant", Location: location, Offset: 18},
+			expCode: codes.InvalidArgument,
+		},
+		{
+			name:    "offset without location",
+			req:     service.TenantExportStreamRequest{TenantID: "tenant", Offset: 8},
+			expCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run("should reject "+tt.name, func(t *testing.T) {
+			// given
+			stream := &exportStream{ctx: t.Context()}
+
+			// when
+			err := subj.StreamTenantExport(stream, tt.req)
+
+			// then
+			assert.Equal(t, tt.expCode, status.Code(err))
+			assert.Empty(t, stream.chunks)
+		})
+	}
+}
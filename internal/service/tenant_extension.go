@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/model"
+)
+
+// TenantExtension implements the procedure calls on tenants defined in api/extension/v1/extension.proto,
+// which are not defined by api-sdk yet.
+type TenantExtension struct {
+	extensiongrpc.UnimplementedTenantServiceServer
+
+	services TenantExtensionServices
+}
+
+// TenantExtensionServices holds the services the procedure calls of TenantExtension delegate to.
+type TenantExtensionServices struct {
+	Placement         *TenantPlacement
+	Auths             *Auth
+	Tenants           *Tenant
+	IdentityProviders *TenantIdentityProvider
+	Blocks            *TenantBlocks
+	Notifications     *TenantNotifications
+}
+
+// NewTenantExtension creates and returns a new instance of TenantExtension.
+func NewTenantExtension(services TenantExtensionServices) *TenantExtension {
+	return &TenantExtension{
+		services: services,
+	}
+}
+
+// SuggestTenantPlacement returns whether a tenant can be placed in the region, and if not the alternative regions.
+func (t *TenantExtension) SuggestTenantPlacement(ctx context.Context, in *extensiongrpc.SuggestTenantPlacementRequest) (*extensiongrpc.SuggestTenantPlacementResponse, error) {
+	hint, err := t.services.Placement.SuggestTenantPlacement(ctx, in.GetRegion())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.SuggestTenantPlacementResponse{
+		Accepted:     hint.Accepted,
+		Reason:       hint.Reason,
+		Alternatives: hint.Alternatives,
+	}, nil
+}
+
+// GetTenantAuths returns the auths of the tenant filtered by status and type, e.g. the OIDC auth currently applied.
+func (t *TenantExtension) GetTenantAuths(ctx context.Context, in *extensiongrpc.GetTenantAuthsRequest) (*extensiongrpc.GetTenantAuthsResponse, error) {
+	tenantAuths, err := t.services.Auths.GetTenantAuths(ctx, in.GetTenantId(), in.GetStatus(), in.GetType())
+	if err != nil {
+		return nil, err
+	}
+
+	auths := make([]*extensiongrpc.Auth, 0, len(tenantAuths.Auths))
+	for _, auth := range tenantAuths.Auths {
+		auths = append(auths, authToExtensionProto(auth))
+	}
+
+	return &extensiongrpc.GetTenantAuthsResponse{
+		TenantId:     tenantAuths.TenantID,
+		TenantName:   tenantAuths.TenantName,
+		TenantRegion: tenantAuths.TenantRegion,
+		TenantStatus: tenantAuths.TenantStatus,
+		Auths:        auths,
+	}, nil
+}
+
+// GetAuth returns the auth like the GetAuth of api-sdk, with the acknowledgments of the regions it is applied to.
+func (t *TenantExtension) GetAuth(ctx context.Context, in *extensiongrpc.GetAuthRequest) (*extensiongrpc.GetAuthResponse, error) {
+	auth, err := t.services.Auths.GetAuthRecord(ctx, in.GetExternalId())
+	if err != nil {
+		return nil, err
+	}
+
+	regions := slices.Sorted(maps.Keys(auth.RegionAcks))
+
+	acks := make([]*extensiongrpc.AuthRegionAck, 0, len(regions))
+	for _, region := range regions {
+		ack := auth.RegionAcks[region]
+		acks = append(acks, &extensiongrpc.AuthRegionAck{
+			Region:       region,
+			Outcome:      ack.Outcome,
+			ErrorMessage: ack.ErrorMessage,
+			At:           timestamppb.New(ack.At),
+		})
+	}
+
+	return &extensiongrpc.GetAuthResponse{
+		Auth:       authToExtensionProto(auth.ToProto()),
+		RegionAcks: acks,
+	}, nil
+}
+
+// GetAuthProperty streams the value of a property of the auth in chunks.
+func (t *TenantExtension) GetAuthProperty(in *extensiongrpc.GetAuthPropertyRequest, stream extensiongrpc.TenantService_GetAuthPropertyServer) error {
+	return t.services.Auths.StreamAuthProperty(&authPropertyServer{stream: stream}, in.GetExternalId(), in.GetKey())
+}
+
+// authPropertyServer adapts the gRPC stream of GetAuthProperty to AuthPropertyStream.
+type authPropertyServer struct {
+	stream extensiongrpc.TenantService_GetAuthPropertyServer
+}
+
+func (s *authPropertyServer) Context() context.Context {
+	return s.stream.Context()
+}
+
+func (s *authPropertyServer) Send(chunk *AuthPropertyChunk) error {
+	return s.stream.Send(&extensiongrpc.GetAuthPropertyResponse{
+		Offset: int64(chunk.Offset),
+		Data:   chunk.Data,
+		Size:   int64(chunk.Size),
+		Digest: chunk.Digest,
+	})
+}
+
+// RegisterTenantFromTemplate registers a tenant like RegisterTenant with a configured onboarding template.
+func (t *TenantExtension) RegisterTenantFromTemplate(ctx context.Context, in *extensiongrpc.RegisterTenantFromTemplateRequest) (*extensiongrpc.RegisterTenantFromTemplateResponse, error) {
+	resp, err := t.services.Tenants.RegisterTenantFromTemplate(ctx, &tenantgrpc.RegisterTenantRequest{
+		Id:        in.GetId(),
+		Name:      in.GetName(),
+		Region:    in.GetRegion(),
+		OwnerId:   in.GetOwnerId(),
+		OwnerType: in.GetOwnerType(),
+		Role:      tenantgrpc.Role(tenantgrpc.Role_value[in.GetRole()]),
+		Labels:    in.GetLabels(),
+	}, in.GetTemplate())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.RegisterTenantFromTemplateResponse{Id: resp.GetId()}, nil
+}
+
+// GetTenantFeatureFlags returns the feature flags of the tenant, which are set by its template.
+func (t *TenantExtension) GetTenantFeatureFlags(ctx context.Context, in *extensiongrpc.GetTenantFeatureFlagsRequest) (*extensiongrpc.GetTenantFeatureFlagsResponse, error) {
+	flags, err := t.services.Tenants.GetTenantFeatureFlags(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.GetTenantFeatureFlagsResponse{FeatureFlags: flags}, nil
+}
+
+// CancelTenantTermination cancels the termination of a tenant PENDING_TERMINATION before it takes effect.
+func (t *TenantExtension) CancelTenantTermination(ctx context.Context, in *extensiongrpc.CancelTenantTerminationRequest) (*extensiongrpc.CancelTenantTerminationResponse, error) {
+	err := t.services.Tenants.CancelTermination(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.CancelTenantTerminationResponse{Success: true}, nil
+}
+
+// BlockTenantUntil blocks the tenant like BlockTenant for a period, after which it is unblocked automatically.
+func (t *TenantExtension) BlockTenantUntil(ctx context.Context, in *extensiongrpc.BlockTenantUntilRequest) (*extensiongrpc.BlockTenantUntilResponse, error) {
+	var until time.Time
+	if in.GetUntil() != nil {
+		until = in.GetUntil().AsTime()
+	}
+
+	blockedUntil, err := t.services.Blocks.BlockTenantUntil(ctx, in.GetId(), until, in.GetDuration().AsDuration())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.BlockTenantUntilResponse{Success: true, BlockedUntil: timestamppb.New(blockedUntil)}, nil
+}
+
+// GetTenantBlock returns the status of the tenant and when its block expires, if it is blocked for a period.
+func (t *TenantExtension) GetTenantBlock(ctx context.Context, in *extensiongrpc.GetTenantBlockRequest) (*extensiongrpc.GetTenantBlockResponse, error) {
+	tenant, err := t.services.Blocks.GetTenantBlock(ctx, in.GetId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.GetTenantBlockResponse{
+		Id:     tenant.ID,
+		Status: string(tenant.Status),
+	}
+	if tenant.BlockedUntil != nil {
+		resp.BlockedUntil = timestamppb.New(*tenant.BlockedUntil)
+	}
+
+	return resp, nil
+}
+
+// SetTenantMaintenanceWindows replaces the maintenance windows of the tenant. Empty windows remove the restriction.
+func (t *TenantExtension) SetTenantMaintenanceWindows(ctx context.Context, in *extensiongrpc.SetTenantMaintenanceWindowsRequest) (*extensiongrpc.SetTenantMaintenanceWindowsResponse, error) {
+	windows := make(model.MaintenanceWindows, 0, len(in.GetWindows()))
+	for _, window := range in.GetWindows() {
+		windows = append(windows, maintenanceWindowFromProto(window))
+	}
+
+	err := t.services.Tenants.SetTenantMaintenanceWindows(ctx, in.GetTenantId(), windows)
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.SetTenantMaintenanceWindowsResponse{Success: true}, nil
+}
+
+// GetTenantMaintenanceWindows returns the maintenance windows of the tenant.
+func (t *TenantExtension) GetTenantMaintenanceWindows(ctx context.Context, in *extensiongrpc.GetTenantMaintenanceWindowsRequest) (*extensiongrpc.GetTenantMaintenanceWindowsResponse, error) {
+	windows, err := t.services.Tenants.GetTenantMaintenanceWindows(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.GetTenantMaintenanceWindowsResponse{
+		Windows: make([]*extensiongrpc.MaintenanceWindow, 0, len(windows)),
+	}
+	for _, window := range windows {
+		resp.Windows = append(resp.Windows, maintenanceWindowToProto(window))
+	}
+
+	return resp, nil
+}
+
+// SetTenantIdentityProvider sets the identity provider of the tenant and starts a job applying it.
+func (t *TenantExtension) SetTenantIdentityProvider(ctx context.Context, in *extensiongrpc.SetTenantIdentityProviderRequest) (*extensiongrpc.SetTenantIdentityProviderResponse, error) {
+	err := t.services.IdentityProviders.SetTenantIdentityProvider(ctx, in.GetTenantId(), in.GetIssuer(), in.GetMetadataUrl())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.SetTenantIdentityProviderResponse{Success: true}, nil
+}
+
+// GetTenantIdentityProvider returns the identity provider of the tenant with the status of its propagation.
+func (t *TenantExtension) GetTenantIdentityProvider(ctx context.Context, in *extensiongrpc.GetTenantIdentityProviderRequest) (*extensiongrpc.GetTenantIdentityProviderResponse, error) {
+	provider, err := t.services.IdentityProviders.GetTenantIdentityProvider(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.GetTenantIdentityProviderResponse{IdentityProvider: identityProviderToProto(provider)}, nil
+}
+
+// RemoveTenantIdentityProvider starts a job removing the identity provider of the tenant.
+func (t *TenantExtension) RemoveTenantIdentityProvider(ctx context.Context, in *extensiongrpc.RemoveTenantIdentityProviderRequest) (*extensiongrpc.RemoveTenantIdentityProviderResponse, error) {
+	err := t.services.IdentityProviders.RemoveTenantIdentityProvider(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.RemoveTenantIdentityProviderResponse{Success: true}, nil
+}
+
+// SetTenantNotificationPreferences replaces the notification preferences of the tenant.
+func (t *TenantExtension) SetTenantNotificationPreferences(ctx context.Context, in *extensiongrpc.SetTenantNotificationPreferencesRequest) (*extensiongrpc.SetTenantNotificationPreferencesResponse, error) {
+	err := t.services.Notifications.SetTenantNotificationPreferences(ctx, in.GetTenantId(), notificationEventsFromProto(in.GetEvents()), in.GetChannels())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.SetTenantNotificationPreferencesResponse{Success: true}, nil
+}
+
+// GetTenantNotificationPreferences returns the notification preferences of the tenant.
+func (t *TenantExtension) GetTenantNotificationPreferences(ctx context.Context, in *extensiongrpc.GetTenantNotificationPreferencesRequest) (*extensiongrpc.GetTenantNotificationPreferencesResponse, error) {
+	preferences, err := t.services.Notifications.GetTenantNotificationPreferences(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.GetTenantNotificationPreferencesResponse{Preferences: notificationPreferencesToProto(preferences)}, nil
+}
+
+// SetTenantContacts replaces the contact and escalation information of the tenant.
+func (t *TenantExtension) SetTenantContacts(ctx context.Context, in *extensiongrpc.SetTenantContactsRequest) (*extensiongrpc.SetTenantContactsResponse, error) {
+	err := t.services.Tenants.SetTenantContacts(ctx, in.GetTenantId(), tenantContactsFromProto(in.GetContacts()))
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.SetTenantContactsResponse{Success: true}, nil
+}
+
+// GetTenantContacts returns the contact and escalation information of the tenant.
+func (t *TenantExtension) GetTenantContacts(ctx context.Context, in *extensiongrpc.GetTenantContactsRequest) (*extensiongrpc.GetTenantContactsResponse, error) {
+	contacts, err := t.services.Tenants.GetTenantContacts(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.GetTenantContactsResponse{Contacts: tenantContactsToProto(contacts)}, nil
+}
+
+func tenantContactsFromProto(contacts *extensiongrpc.TenantContacts) model.TenantContacts {
+	return model.TenantContacts{
+		TechnicalContact:  contacts.GetTechnicalContact(),
+		SecurityContact:   contacts.GetSecurityContact(),
+		EscalationChannel: contacts.GetEscalationChannel(),
+	}
+}
+
+func tenantContactsToProto(contacts model.TenantContacts) *extensiongrpc.TenantContacts {
+	return &extensiongrpc.TenantContacts{
+		TechnicalContact:  contacts.TechnicalContact,
+		SecurityContact:   contacts.SecurityContact,
+		EscalationChannel: contacts.EscalationChannel,
+	}
+}
+
+func maintenanceWindowFromProto(window *extensiongrpc.MaintenanceWindow) model.MaintenanceWindow {
+	resp := model.MaintenanceWindow{
+		Weekdays:  window.GetWeekdays(),
+		StartTime: window.GetStartTime(),
+		Duration:  window.GetDuration(),
+	}
+	if window.GetStart() != nil {
+		start := window.GetStart().AsTime()
+		resp.Start = &start
+	}
+	if window.GetEnd() != nil {
+		end := window.GetEnd().AsTime()
+		resp.End = &end
+	}
+
+	return resp
+}
+
+func maintenanceWindowToProto(window model.MaintenanceWindow) *extensiongrpc.MaintenanceWindow {
+	resp := &extensiongrpc.MaintenanceWindow{
+		Weekdays:  window.Weekdays,
+		StartTime: window.StartTime,
+		Duration:  window.Duration,
+	}
+	if window.Start != nil {
+		resp.Start = timestamppb.New(*window.Start)
+	}
+	if window.End != nil {
+		resp.End = timestamppb.New(*window.End)
+	}
+
+	return resp
+}
+
+func authToExtensionProto(auth *authgrpc.Auth) *extensiongrpc.Auth {
+	return &extensiongrpc.Auth{
+		ExternalId:   auth.GetExternalId(),
+		TenantId:     auth.GetTenantId(),
+		Type:         auth.GetType(),
+		Properties:   auth.GetProperties(),
+		Status:       auth.GetStatus().String(),
+		ErrorMessage: auth.GetErrorMessage(),
+		UpdatedAt:    auth.GetUpdatedAt(),
+		CreatedAt:    auth.GetCreatedAt(),
+	}
+}
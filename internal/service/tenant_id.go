@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"encoding/hex"
+	"regexp"
+	"strings"
+
+	"github.com/gofrs/uuid/v5"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+)
+
+type (
+	// TenantIDGenerator generates new tenant IDs.
+	TenantIDGenerator interface {
+		Generate() (string, error)
+		// Matches returns true if the ID follows the format of the generated IDs.
+		Matches(id string) bool
+	}
+
+	// UUIDv7Generator generates tenant IDs as canonical UUIDv7 strings.
+	UUIDv7Generator struct{}
+
+	// PrefixedGenerator generates tenant IDs of the form <prefix><32 lowercase hex characters>.
+	// The hex part is derived from a UUIDv7 so generated IDs remain sortable by creation time.
+	PrefixedGenerator struct {
+		prefix  string
+		pattern *regexp.Regexp
+	}
+
+	// TenantIDs applies the configured generation, format validation and normalization to tenant IDs.
+	TenantIDs struct {
+		cfg       config.TenantID
+		generator TenantIDGenerator
+	}
+)
+
+var (
+	_ TenantIDGenerator = UUIDv7Generator{}
+	_ TenantIDGenerator = &PrefixedGenerator{}
+)
+
+// NewTenantIDs creates and returns a new instance of TenantIDs.
+// The generator is selected based on the configured format.
+func NewTenantIDs(cfg config.TenantID) *TenantIDs {
+	ids := &TenantIDs{
+		cfg: cfg,
+	}
+
+	switch cfg.Format {
+	case config.TenantIDFormatUUIDv7:
+		ids.generator = UUIDv7Generator{}
+	case config.TenantIDFormatPrefixed:
+		ids.generator = NewPrefixedGenerator(cfg.Prefix)
+	}
+
+	return ids
+}
+
+// NewPrefixedGenerator creates and returns a new instance of PrefixedGenerator.
+func NewPrefixedGenerator(prefix string) *PrefixedGenerator {
+	return &PrefixedGenerator{
+		prefix:  prefix,
+		pattern: regexp.MustCompile("^" + regexp.QuoteMeta(prefix) + "[0-9a-f]{32}$"),
+	}
+}
+
+// Generate returns a new UUIDv7.
+func (UUIDv7Generator) Generate() (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+
+	return id.String(), nil
+}
+
+// Matches returns true if the ID is a UUIDv7 in canonical form.
+func (UUIDv7Generator) Matches(id string) bool {
+	parsed, err := uuid.FromString(id)
+	if err != nil {
+		return false
+	}
+
+	return parsed.Version() == uuid.V7 && parsed.String() == id
+}
+
+// Generate returns a new prefixed ID.
+func (g *PrefixedGenerator) Generate() (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+
+	return g.prefix + hex.EncodeToString(id.Bytes()), nil
+}
+
+// Matches returns true if the ID consists of the prefix followed by 32 lowercase hex characters.
+func (g *PrefixedGenerator) Matches(id string) bool {
+	return g.pattern.MatchString(id)
+}
+
+// Normalizes returns true if normalization is enabled.
+func (i *TenantIDs) Normalizes() bool {
+	return i != nil && i.cfg.Normalize
+}
+
+// Normalize trims and lowercases the ID if normalization is enabled.
+func (i *TenantIDs) Normalize(id string) string {
+	if !i.Normalizes() {
+		return id
+	}
+
+	return strings.ToLower(strings.TrimSpace(id))
+}
+
+// resolve returns the ID to register a tenant with.
+// An empty ID is replaced by a generated one if generation is enabled,
+// otherwise the ID is normalized and checked against the configured format.
+func (i *TenantIDs) resolve(ctx context.Context, id string) (string, error) {
+	if i == nil {
+		return id, nil
+	}
+
+	id = i.Normalize(id)
+
+	if id == "" && i.cfg.Generate {
+		generated, err := i.generator.Generate()
+		if err != nil {
+			slogctx.Error(ctx, "failed to generate tenant ID", "error", err)
+			return "", ErrTenantIDGeneration
+		}
+		return generated, nil
+	}
+
+	if i.generator != nil && id != "" && !i.generator.Matches(id) {
+		return "", ErrorWithParams(ErrTenantIDFormat, "format", string(i.cfg.Format))
+	}
+
+	return id, nil
+}
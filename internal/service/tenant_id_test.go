@@ -0,0 +1,112 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gofrs/uuid/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestTenantIDsResolve(t *testing.T) {
+	validUUIDv7 := uuid.Must(uuid.NewV7()).String()
+
+	t.Run("should generate a UUIDv7 if no ID is supplied", func(t *testing.T) {
+		// given
+		ids := service.NewTenantIDs(config.TenantID{Format: config.TenantIDFormatUUIDv7, Generate: true})
+
+		// when
+		id, err := ids.Resolve(context.Background(), "")
+
+		// then
+		require.NoError(t, err)
+		assert.True(t, service.UUIDv7Generator{}.Matches(id))
+	})
+
+	t.Run("should generate a prefixed ID if no ID is supplied", func(t *testing.T) {
+		// given
+		ids := service.NewTenantIDs(config.TenantID{Format: config.TenantIDFormatPrefixed, Prefix: "t-", Generate: true})
+
+		// when
+		id, err := ids.Resolve(context.Background(), "")
+
+		// then
+		require.NoError(t, err)
+		assert.Regexp(t, "^t-[0-9a-f]{32}$", id)
+	})
+
+	tests := []struct {
+		name   string
+		cfg    config.TenantID
+		id     string
+		expID  string
+		expErr error
+	}{
+		{
+			name:  "should accept any ID without format",
+			cfg:   config.TenantID{},
+			id:    " Any-ID ",
+			expID: " Any-ID ",
+		},
+		{
+			name:  "should normalize the ID",
+			cfg:   config.TenantID{Normalize: true},
+			id:    " Any-ID ",
+			expID: "any-id",
+		},
+		{
+			name:  "should accept a supplied UUIDv7",
+			cfg:   config.TenantID{Format: config.TenantIDFormatUUIDv7, Generate: true},
+			id:    validUUIDv7,
+			expID: validUUIDv7,
+		},
+		{
+			name:   "should reject a supplied UUIDv4 for the uuidv7 format",
+			cfg:    config.TenantID{Format: config.TenantIDFormatUUIDv7},
+			id:     uuid.Must(uuid.NewV4()).String(),
+			expErr: service.ErrTenantIDFormat,
+		},
+		{
+			name:   "should reject a padded UUIDv7 without normalization",
+			cfg:    config.TenantID{Format: config.TenantIDFormatUUIDv7},
+			id:     "  " + validUUIDv7,
+			expErr: service.ErrTenantIDFormat,
+		},
+		{
+			name:  "should accept a normalized prefixed ID",
+			cfg:   config.TenantID{Format: config.TenantIDFormatPrefixed, Prefix: "t-", Normalize: true},
+			id:    "T-0192F1C2AB3C7D4E8F9A0B1C2D3E4F50",
+			expID: "t-0192f1c2ab3c7d4e8f9a0b1c2d3e4f50",
+		},
+		{
+			name:   "should reject an ID with a different prefix",
+			cfg:    config.TenantID{Format: config.TenantIDFormatPrefixed, Prefix: "t-"},
+			id:     "x-0192f1c2ab3c7d4e8f9a0b1c2d3e4f50",
+			expErr: service.ErrTenantIDFormat,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			ids := service.NewTenantIDs(tt.cfg)
+
+			// when
+			id, err := ids.Resolve(context.Background(), tt.id)
+
+			// then
+			if tt.expErr != nil {
+				assert.Equal(t, status.Code(tt.expErr), status.Code(err))
+				assert.ErrorContains(t, err, status.Convert(tt.expErr).Message())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expID, id)
+		})
+	}
+}
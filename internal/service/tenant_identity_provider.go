@@ -0,0 +1,414 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/openkcm/orbital"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// Fields of the identity providers which are cleared when an identity provider is set again.
+const (
+	metadataURLField  repository.QueryField = "metadata_url"
+	errorMessageField repository.QueryField = "error_message"
+)
+
+// TenantIdentityProvider manages the identity providers of tenants and propagates them to the regions of the tenants
+// with orbital jobs keyed by the tenant ID. Unlike auths, a tenant has at most one identity provider,
+// which is set again instead of being applied anew.
+type TenantIdentityProvider struct {
+	repo    repository.Repository
+	orbital *Orbital
+}
+
+// NewTenantIdentityProvider creates and returns a new instance of TenantIdentityProvider.
+// It also registers the job handlers to the Orbital instance.
+func NewTenantIdentityProvider(repo repository.Repository, orbital *Orbital) *TenantIdentityProvider {
+	p := &TenantIdentityProvider{
+		repo:    repo,
+		orbital: orbital,
+	}
+
+	for _, jobType := range []string{
+		model.IdentityProviderJobApply,
+		model.IdentityProviderJobRemove,
+	} {
+		orbital.RegisterJobHandler(jobType, p)
+	}
+
+	return p
+}
+
+// SetTenantIdentityProvider sets the identity provider of an active tenant and starts a job applying it.
+// It fails while a job of the current identity provider of the tenant is unfinished.
+func (p *TenantIdentityProvider) SetTenantIdentityProvider(ctx context.Context, tenantID, issuer, metadataURL string) error {
+	ctx = slogctx.With(ctx, "tenantId", tenantID, "issuer", issuer)
+	slogctx.Debug(ctx, "SetTenantIdentityProvider called")
+
+	if tenantID == "" {
+		return ErrNoTenantID
+	}
+
+	if err := validateIdentityProviderURLs(issuer, metadataURL); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := p.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		tenant, err := getTenant(ctx, r, tenantID)
+		if err != nil {
+			return err
+		}
+		if err := checkTenantActive(tenant); err != nil {
+			return err
+		}
+
+		provider := &model.IdentityProvider{
+			TenantID:    tenantID,
+			Issuer:      issuer,
+			MetadataURL: metadataURL,
+			Status:      model.IdentityProviderStatusApplying,
+		}
+
+		current, err := getIdentityProvider(ctx, r, tenantID)
+		switch {
+		case errors.Is(err, ErrIdentityProviderNotFound):
+			err = r.Create(ctx, provider)
+			if isUniqueConstraintError(err) {
+				return ErrIdentityProviderBusy
+			}
+			if err != nil {
+				slogctx.Error(ctx, "failed to create identity provider", "error", err)
+				return ErrIdentityProviderUpdate
+			}
+		case err != nil:
+			return err
+		case current.InTransition():
+			return ErrorWithParams(ErrIdentityProviderBusy, "status", current.Status)
+		default:
+			err = patchIdentityProvider(ctx, r, provider, clearedIdentityProviderFields(current, provider))
+			if err != nil {
+				return err
+			}
+		}
+
+		return p.prepareJob(ctx, provider, model.IdentityProviderJobApply)
+	})
+
+	return mapError(err)
+}
+
+// GetTenantIdentityProvider returns the identity provider of the tenant.
+func (p *TenantIdentityProvider) GetTenantIdentityProvider(ctx context.Context, tenantID string) (*model.IdentityProvider, error) {
+	slogctx.Debug(ctx, "GetTenantIdentityProvider called", "tenantId", tenantID)
+
+	if tenantID == "" {
+		return nil, ErrNoTenantID
+	}
+
+	return getIdentityProvider(ctx, p.repo, tenantID)
+}
+
+// RemoveTenantIdentityProvider marks the identity provider of an active tenant for removal
+// and starts a job removing it. The identity provider is deleted once the job is done.
+func (p *TenantIdentityProvider) RemoveTenantIdentityProvider(ctx context.Context, tenantID string) error {
+	ctx = slogctx.With(ctx, "tenantId", tenantID)
+	slogctx.Debug(ctx, "RemoveTenantIdentityProvider called")
+
+	if tenantID == "" {
+		return ErrNoTenantID
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := p.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		tenant, err := getTenant(ctx, r, tenantID)
+		if err != nil {
+			return err
+		}
+		if err := checkTenantActive(tenant); err != nil {
+			return err
+		}
+
+		provider, err := getIdentityProvider(ctx, r, tenantID)
+		if err != nil {
+			return err
+		}
+
+		if provider.InTransition() {
+			return ErrorWithParams(ErrIdentityProviderBusy, "status", provider.Status)
+		}
+
+		provider.Status = model.IdentityProviderStatusRemoving
+		if err := patchIdentityProvider(ctx, r, provider, nil); err != nil {
+			return err
+		}
+
+		return p.prepareJob(ctx, provider, model.IdentityProviderJobRemove)
+	})
+
+	return mapError(err)
+}
+
+// ConfirmJob confirms that the identity provider of the job is still in the status the job expects.
+func (p *TenantIdentityProvider) ConfirmJob(ctx context.Context, job orbital.Job) (orbital.JobConfirmerResult, error) {
+	provider, err := getIdentityProvider(ctx, p.repo, job.ExternalID)
+	if errors.Is(err, ErrIdentityProviderNotFound) {
+		return orbital.CancelJobConfirmer("identity provider not found"), nil
+	}
+	if err != nil {
+		slogctx.Error(ctx, "failed to get identity provider for job confirmation", "error", err)
+		return nil, err
+	}
+
+	expected, ok := identityProviderJobStatus(job.Type)
+	if !ok {
+		return orbital.CancelJobConfirmer(fmt.Sprintf("%s: %s", ErrUnexpectedJobType, job.Type)), nil
+	}
+
+	if provider.Status != expected {
+		return orbital.CancelJobConfirmer(fmt.Sprintf("identity provider is %s", provider.Status)), nil
+	}
+
+	return orbital.CompleteJobConfirmer(), nil
+}
+
+// ResolveTasks targets the region of the tenant of the identity provider.
+func (p *TenantIdentityProvider) ResolveTasks(ctx context.Context, job orbital.Job,
+	targetsByRegion map[string]orbital.TargetManager) (
+	orbital.TaskResolverResult, error) {
+	provider := &extensiongrpc.IdentityProvider{}
+	data, err := identityProviderJobPayload.decode(job.Data, provider)
+	if errors.Is(err, ErrJobPayloadTooNew) {
+		slogctx.Warn(ctx, "identity provider job payload is newer than supported, retrying", "error", err)
+		return nil, err
+	}
+	if err != nil {
+		slogctx.Error(ctx, "failed to decode identity provider proto", "error", err)
+		return orbital.CancelTaskResolver(fmt.Sprintf("failed to decode identity provider proto: %v", err)), nil
+	}
+	ctx = slogctx.With(ctx, "tenantId", provider.GetTenantId())
+
+	tenant, err := getTenant(ctx, p.repo, provider.GetTenantId())
+	if errors.Is(err, ErrTenantNotFound) {
+		return orbital.CancelTaskResolver("tenant not found"), nil
+	}
+	if err != nil {
+		slogctx.Error(ctx, "failed to get tenant for resolving tasks for identity provider", "error", err)
+		return nil, err
+	}
+
+	if _, ok := targetsByRegion[tenant.Region]; !ok {
+		slogctx.Error(ctx, "no target for region", "region", tenant.Region)
+		return orbital.CancelTaskResolver("no target for region: " + tenant.Region), nil
+	}
+
+	return orbital.CompleteTaskResolver().WithTaskInfo(
+		[]orbital.TaskInfo{
+			{
+				Data:   data,
+				Type:   job.Type,
+				Target: tenant.Region,
+			},
+		},
+	), nil
+}
+
+// HandleJobDone marks the applied identity provider as APPLIED and deletes the removed one.
+func (p *TenantIdentityProvider) HandleJobDone(ctx context.Context, job orbital.Job) error {
+	switch job.Type {
+	case model.IdentityProviderJobApply:
+		err := patchIdentityProvider(ctx, p.repo, &model.IdentityProvider{
+			TenantID: job.ExternalID,
+			Status:   model.IdentityProviderStatusApplied,
+		}, nil)
+		if errors.Is(err, ErrIdentityProviderNotFound) {
+			slogctx.Warn(ctx, "identity provider not found for job done")
+			return nil
+		}
+		return err
+	case model.IdentityProviderJobRemove:
+		_, err := p.repo.Delete(ctx, &model.IdentityProvider{TenantID: job.ExternalID})
+		if err != nil {
+			slogctx.Error(ctx, "failed to delete identity provider", "error", err)
+			return ErrIdentityProviderDelete
+		}
+		return nil
+	default:
+		slogctx.Error(ctx, ErrUnexpectedJobType.Error())
+		return nil
+	}
+}
+
+// HandleJobCanceled records the error of the job on the identity provider.
+func (p *TenantIdentityProvider) HandleJobCanceled(ctx context.Context, job orbital.Job) error {
+	return p.handleJobAborted(ctx, job)
+}
+
+// HandleJobFailed records the error of the job on the identity provider.
+func (p *TenantIdentityProvider) HandleJobFailed(ctx context.Context, job orbital.Job) error {
+	return p.handleJobAborted(ctx, job)
+}
+
+func (p *TenantIdentityProvider) handleJobAborted(ctx context.Context, job orbital.Job) error {
+	var status model.IdentityProviderStatus
+	switch job.Type {
+	case model.IdentityProviderJobApply:
+		status = model.IdentityProviderStatusApplyingError
+	case model.IdentityProviderJobRemove:
+		status = model.IdentityProviderStatusRemovingError
+	default:
+		slogctx.Error(ctx, ErrUnexpectedJobType.Error())
+		return nil
+	}
+
+	err := patchIdentityProvider(ctx, p.repo, &model.IdentityProvider{
+		TenantID:     job.ExternalID,
+		Status:       status,
+		ErrorMessage: job.ErrorMessage,
+	}, nil)
+	if errors.Is(err, ErrIdentityProviderNotFound) {
+		slogctx.Warn(ctx, "identity provider not found for job aborted")
+		return nil
+	}
+	return err
+}
+
+func (p *TenantIdentityProvider) prepareJob(ctx context.Context, provider *model.IdentityProvider, jobType string) error {
+	data, err := identityProviderJobPayload.encode(identityProviderToProto(provider))
+	if err != nil {
+		slogctx.Error(ctx, "failed to marshal identity provider proto", "error", err)
+		return ErrIdentityProviderJob
+	}
+
+	if err := p.orbital.PrepareJob(ctx, data, provider.TenantID, jobType); err != nil {
+		slogctx.Error(ctx, "failed to prepare job", "error", err)
+		return ErrIdentityProviderJob
+	}
+
+	return nil
+}
+
+// identityProviderJobStatus returns the status of the identity provider while a job of the type is unfinished.
+func identityProviderJobStatus(jobType string) (model.IdentityProviderStatus, bool) {
+	switch jobType {
+	case model.IdentityProviderJobApply:
+		return model.IdentityProviderStatusApplying, true
+	case model.IdentityProviderJobRemove:
+		return model.IdentityProviderStatusRemoving, true
+	default:
+		return "", false
+	}
+}
+
+// validateIdentityProviderURLs requires the issuer and the optional metadata URL to be absolute https URLs.
+func validateIdentityProviderURLs(issuer, metadataURL string) error {
+	if err := validateHTTPSURL(issuer); err != nil {
+		return ErrorWithParams(ErrIdentityProviderInvalid, "issuer", issuer, "error", err)
+	}
+
+	if metadataURL == "" {
+		return nil
+	}
+
+	if err := validateHTTPSURL(metadataURL); err != nil {
+		return ErrorWithParams(ErrIdentityProviderInvalid, "metadataUrl", metadataURL, "error", err)
+	}
+
+	return nil
+}
+
+var errNotHTTPSURL = errors.New("not an absolute https URL")
+
+func validateHTTPSURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil {
+		return err
+	}
+
+	if u.Scheme != "https" || u.Host == "" {
+		return errNotHTTPSURL
+	}
+
+	return nil
+}
+
+// clearedIdentityProviderFields returns the fields of the current identity provider which are empty once it is set.
+func clearedIdentityProviderFields(current, provider *model.IdentityProvider) []repository.QueryField {
+	var fields []repository.QueryField
+	if current.MetadataURL != "" && provider.MetadataURL == "" {
+		fields = append(fields, metadataURLField)
+	}
+	if current.ErrorMessage != "" {
+		fields = append(fields, errorMessageField)
+	}
+
+	return fields
+}
+
+func getIdentityProvider(ctx context.Context, r repository.Repository, tenantID string) (*model.IdentityProvider, error) {
+	provider := &model.IdentityProvider{TenantID: tenantID}
+
+	found, err := r.Find(ctx, provider)
+	if err != nil {
+		slogctx.Error(ctx, "failed to select identity provider", "error", err)
+		return nil, ErrIdentityProviderSelect
+	}
+
+	if !found {
+		return nil, ErrIdentityProviderNotFound
+	}
+
+	return provider, nil
+}
+
+// patchIdentityProvider updates the non-empty fields of the identity provider and clears the given fields.
+func patchIdentityProvider(ctx context.Context, r repository.Repository, provider *model.IdentityProvider, cleared []repository.QueryField) error {
+	found, err := r.Patch(ctx, provider)
+	if err != nil {
+		slogctx.Error(ctx, "failed to update identity provider", "error", err)
+		return ErrIdentityProviderUpdate
+	}
+
+	if !found {
+		return ErrIdentityProviderNotFound
+	}
+
+	if len(cleared) == 0 {
+		return nil
+	}
+
+	var providers []model.IdentityProvider
+	_, err = r.ClearAll(ctx, &providers, *repository.NewQuery(&model.IdentityProvider{}).
+		Where(repository.NewCompositeKey().Where(repository.TenantIDField, provider.TenantID)), cleared...)
+	if err != nil {
+		slogctx.Error(ctx, "failed to update identity provider", "error", err)
+		return ErrIdentityProviderUpdate
+	}
+
+	return nil
+}
+
+func identityProviderToProto(provider *model.IdentityProvider) *extensiongrpc.IdentityProvider {
+	return &extensiongrpc.IdentityProvider{
+		TenantId:     provider.TenantID,
+		Issuer:       provider.Issuer,
+		MetadataUrl:  provider.MetadataURL,
+		Status:       string(provider.Status),
+		ErrorMessage: provider.ErrorMessage,
+		UpdatedAt:    timestamppb.New(provider.UpdatedAt),
+		CreatedAt:    timestamppb.New(provider.CreatedAt),
+	}
+}
@@ -0,0 +1,93 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestValidateIdentityProviderURLs(t *testing.T) {
+	tests := []struct {
+		name        string
+		issuer      string
+		metadataURL string
+		valid       bool
+	}{
+		{
+			name:   "issuer without metadata URL",
+			issuer: "https://idp.example.org",
+			valid:  true,
+		},
+		{
+			name:        "issuer with metadata URL",
+			issuer:      "https://idp.example.org/realms/tenant",
+			metadataURL: "https://idp.example.org/realms/tenant/.well-known/openid-configuration",
+			valid:       true,
+		},
+		{
+			name:   "empty issuer",
+			issuer: "",
+		},
+		{
+			name:   "http issuer",
+			issuer: "http://idp.example.org",
+		},
+		{
+			name:   "relative issuer",
+			issuer: "idp.example.org",
+		},
+		{
+			name:        "http metadata URL",
+			issuer:      "https://idp.example.org",
+			metadataURL: "http://idp.example.org/.well-known/openid-configuration",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := service.ValidateIdentityProviderURLs(tt.issuer, tt.metadataURL)
+
+			// then
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestClearedIdentityProviderFields(t *testing.T) {
+	t.Run("should clear the metadata URL and the error of the current identity provider", func(t *testing.T) {
+		// given
+		current := &model.IdentityProvider{
+			MetadataURL:  "https://idp.example.org/.well-known/openid-configuration",
+			ErrorMessage: "region unreachable",
+		}
+
+		// when
+		fields := service.ClearedIdentityProviderFields(current, &model.IdentityProvider{})
+
+		// then
+		assert.Equal(t, []repository.QueryField{"metadata_url", "error_message"}, fields)
+	})
+
+	t.Run("should keep the fields which are set again", func(t *testing.T) {
+		// given
+		current := &model.IdentityProvider{MetadataURL: "https://idp.example.org/.well-known/openid-configuration"}
+		provider := &model.IdentityProvider{MetadataURL: "https://idp.example.org/metadata"}
+
+		// when
+		fields := service.ClearedIdentityProviderFields(current, provider)
+
+		// then
+		assert.Empty(t, fields)
+	})
+}
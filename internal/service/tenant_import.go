@@ -0,0 +1,130 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// TenantImports imports tenants migrated from a legacy registry. Imported tenants keep their creation time,
+// status and status history, and they are not provisioned, as they are already provisioned by the legacy registry.
+type TenantImports struct {
+	repo    repository.Repository
+	tenants *Tenant
+	cfg     config.TenantImport
+	now     func() time.Time
+}
+
+// NewTenantImports creates and returns a new instance of TenantImports.
+func NewTenantImports(repo repository.Repository, tenants *Tenant, cfg config.TenantImport) *TenantImports {
+	return &TenantImports{
+		repo:    repo,
+		tenants: tenants,
+		cfg:     cfg,
+		now:     time.Now,
+	}
+}
+
+// ImportTenant creates the tenant with its creation time and status as given, bypassing the provisioning job.
+// The history holds the past statuses of the tenant, oldest first, and ends with its current status unless empty.
+// The import is logged with the importing caller and the reason, and the history records the importing caller.
+func (i *TenantImports) ImportTenant(ctx context.Context, tenant *model.Tenant, history []model.TenantStatusChange, reason string) error {
+	slogctx.Debug(ctx, "ImportTenant called", "tenantId", tenant.ID, "status", tenant.Status)
+
+	if !i.cfg.Enabled {
+		return ErrTenantImportDisabled
+	}
+
+	if err := i.tenants.validateIDNonEmpty(tenant.ID); err != nil {
+		return err
+	}
+	tenant.ID = i.tenants.ids.Normalize(tenant.ID)
+
+	if err := i.validate(tenant, history); err != nil {
+		return err
+	}
+
+	if err := i.tenants.validateTenant(tenant); err != nil {
+		return err
+	}
+
+	if err := i.tenants.labels.checkLimits(tenant.Labels); err != nil {
+		return err
+	}
+
+	tenant.StatusUpdatedAt = tenant.CreatedAt
+	if len(history) > 0 {
+		tenant.StatusUpdatedAt = history[len(history)-1].ChangedAt
+	}
+	for j := range history {
+		history[j].TenantID = tenant.ID
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := i.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		err := r.Create(ctx, tenant)
+		if isUniqueConstraintError(err) {
+			return ErrorAlreadyExists(ResourceTypeTenant, tenant.ID)
+		}
+		if err != nil {
+			slogctx.Error(ctx, "failed to insert imported tenant", "error", err)
+			return ErrTenantImportInsert
+		}
+
+		if len(history) > 0 {
+			if err := r.CreateAll(ctx, &history); err != nil {
+				slogctx.Error(ctx, "failed to insert status history of imported tenant", "error", err)
+				return ErrTenantStatusChangeInsert
+			}
+		}
+
+		return syncTenantUserGroups(ctx, r, tenant.ID, tenant.UserGroups)
+	})
+
+	err = mapError(err)
+	if err != nil {
+		return err
+	}
+
+	slogctx.Warn(ctx, "tenant imported", "tenantId", tenant.ID, "caller", repository.CallerFromContext(ctx),
+		"status", tenant.Status, "createdAt", tenant.CreatedAt, "statusChanges", len(history), "reason", reason)
+
+	return nil
+}
+
+// validate checks the status and the creation time of the imported tenant and its status history.
+func (i *TenantImports) validate(tenant *model.Tenant, history []model.TenantStatusChange) error {
+	if !isKnownTenantStatus(tenant.Status) {
+		return ErrorWithParams(ErrImportedStatusInvalid, "status", tenant.Status)
+	}
+
+	now := i.now()
+	if tenant.CreatedAt.IsZero() || tenant.CreatedAt.After(now) {
+		return ErrImportedCreatedAtInvalid
+	}
+
+	previous := tenant.CreatedAt
+	for _, change := range history {
+		if !isKnownTenantStatus(change.Status) {
+			return ErrorWithParams(ErrImportedStatusInvalid, "status", change.Status)
+		}
+
+		if change.ChangedAt.Before(previous) || change.ChangedAt.After(now) {
+			return ErrorWithParams(ErrImportedStatusHistoryOrder, "changedAt", change.ChangedAt.Format(time.RFC3339))
+		}
+		previous = change.ChangedAt
+	}
+
+	if len(history) > 0 && history[len(history)-1].Status != tenant.Status {
+		return ErrImportedStatusHistoryStatus
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestTenantImportValidate(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	createdAt := now.Add(-30 * 24 * time.Hour)
+	subj := service.NewTenantImportsAt(config.TenantImport{Enabled: true}, now)
+
+	active := model.TenantStatus("STATUS_ACTIVE")
+	blocked := model.TenantStatus("STATUS_BLOCKED")
+
+	t.Run("should reject imports if disabled", func(t *testing.T) {
+		// given
+		disabled := service.NewTenantImportsAt(config.TenantImport{}, now)
+
+		// when
+		err := disabled.ImportTenant(t.Context(), &model.Tenant{ID: "tenant", Status: active, CreatedAt: createdAt}, nil, "")
+
+		// then
+		assert.ErrorIs(t, err, service.ErrTenantImportDisabled)
+	})
+
+	tests := []struct {
+		name      string
+		status    model.TenantStatus
+		createdAt time.Time
+		history   []model.TenantStatusChange
+		expErr    error
+	}{
+		{
+			name:      "tenant without history",
+			status:    active,
+			createdAt: createdAt,
+		},
+		{
+			name:      "tenant with history",
+			status:    blocked,
+			createdAt: createdAt,
+			history: []model.TenantStatusChange{
+				{Status: active, ChangedAt: createdAt.Add(time.Hour)},
+				{Status: blocked, ChangedAt: now.Add(-time.Hour)},
+			},
+		},
+		{
+			name:      "unknown status",
+			status:    "STATUS_UNKNOWN",
+			createdAt: createdAt,
+			expErr:    service.ErrImportedStatusInvalid,
+		},
+		{
+			name:      "unspecified status",
+			status:    "STATUS_UNSPECIFIED",
+			createdAt: createdAt,
+			expErr:    service.ErrImportedStatusInvalid,
+		},
+		{
+			name:   "missing creation time",
+			status: active,
+			expErr: service.ErrImportedCreatedAtInvalid,
+		},
+		{
+			name:      "future creation time",
+			status:    active,
+			createdAt: now.Add(time.Hour),
+			expErr:    service.ErrImportedCreatedAtInvalid,
+		},
+		{
+			name:      "unknown status in history",
+			status:    active,
+			createdAt: createdAt,
+			history:   []model.TenantStatusChange{{Status: "STATUS_UNKNOWN", ChangedAt: createdAt}},
+			expErr:    service.ErrImportedStatusInvalid,
+		},
+		{
+			name:      "history before creation",
+			status:    active,
+			createdAt: createdAt,
+			history:   []model.TenantStatusChange{{Status: active, ChangedAt: createdAt.Add(-time.Hour)}},
+			expErr:    service.ErrImportedStatusHistoryOrder,
+		},
+		{
+			name:      "history out of order",
+			status:    active,
+			createdAt: createdAt,
+			history: []model.TenantStatusChange{
+				{Status: blocked, ChangedAt: createdAt.Add(2 * time.Hour)},
+				{Status: active, ChangedAt: createdAt.Add(time.Hour)},
+			},
+			expErr: service.ErrImportedStatusHistoryOrder,
+		},
+		{
+			name:      "history in the future",
+			status:    active,
+			createdAt: createdAt,
+			history:   []model.TenantStatusChange{{Status: active, ChangedAt: now.Add(time.Hour)}},
+			expErr:    service.ErrImportedStatusHistoryOrder,
+		},
+		{
+			name:      "history not ending with the status",
+			status:    blocked,
+			createdAt: createdAt,
+			history:   []model.TenantStatusChange{{Status: active, ChangedAt: createdAt.Add(time.Hour)}},
+			expErr:    service.ErrImportedStatusHistoryStatus,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := subj.Validate(&model.Tenant{ID: "tenant", Status: tt.status, CreatedAt: tt.createdAt}, tt.history)
+
+			// then
+			if tt.expErr != nil {
+				assert.Equal(t, codes.InvalidArgument, status.Code(err))
+				assert.Contains(t, err.Error(), status.Convert(tt.expErr).Message())
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
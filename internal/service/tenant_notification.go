@@ -0,0 +1,258 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/mail"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// maxNotificationChannels is the maximum number of delivery channels of a tenant.
+const maxNotificationChannels = 10
+
+// mailtoScheme is the prefix of the channels delivering notifications by mail.
+const mailtoScheme = "mailto:"
+
+var ErrTenantNotificationWebhookStatus = errors.New("tenant notification webhook returned unexpected status")
+
+type (
+	// TenantNotifier delivers the notifications of the lifecycle events of tenants to their channels.
+	TenantNotifier interface {
+		NotifyTenantEvent(ctx context.Context, notification TenantNotification) error
+	}
+
+	// TenantNotification is a lifecycle event of a tenant with the channels of the tenant opted into it.
+	TenantNotification struct {
+		TenantID     string                  `json:"tenantId"`
+		Event        model.NotificationEvent `json:"event"`
+		Channels     []string                `json:"channels"`
+		OccurredAt   time.Time               `json:"occurredAt"`
+		ErrorMessage string                  `json:"errorMessage,omitempty"`
+	}
+
+	// TenantNotifications manages the notification preferences of tenants and notifies the tenants
+	// of the lifecycle events they opted into.
+	TenantNotifications struct {
+		repo     repository.Repository
+		cfg      config.TenantNotifications
+		notifier TenantNotifier
+		now      func() time.Time
+	}
+
+	// WebhookTenantNotifier posts the notifications as JSON to the webhook of the notification service.
+	WebhookTenantNotifier struct {
+		client *http.Client
+		url    string
+	}
+)
+
+// NewTenantNotifications creates and returns a new instance of TenantNotifications.
+func NewTenantNotifications(repo repository.Repository, cfg config.TenantNotifications, notifier TenantNotifier) *TenantNotifications {
+	return &TenantNotifications{
+		repo:     repo,
+		cfg:      cfg,
+		notifier: notifier,
+		now:      time.Now,
+	}
+}
+
+// NewWebhookTenantNotifier creates and returns a new instance of WebhookTenantNotifier.
+func NewWebhookTenantNotifier(cfg config.Webhook) *WebhookTenantNotifier {
+	return &WebhookTenantNotifier{
+		client: &http.Client{Timeout: cfg.Timeout},
+		url:    cfg.URL,
+	}
+}
+
+// NotifyTenantEvent sends the notification to the webhook.
+// Any non 2xx response is treated as an error.
+func (w *WebhookTenantNotifier) NotifyTenantEvent(ctx context.Context, notification TenantNotification) error {
+	return postJSON(ctx, w.client, w.url, notification, ErrTenantNotificationWebhookStatus)
+}
+
+// SetTenantNotificationPreferences replaces the events the tenant is notified of and the channels it is notified by.
+// No events or no channels remove the preferences, so the tenant is not notified anymore.
+func (n *TenantNotifications) SetTenantNotificationPreferences(ctx context.Context, tenantID string, events []model.NotificationEvent, channels []string) error {
+	ctx = slogctx.With(ctx, "tenantId", tenantID)
+	slogctx.Debug(ctx, "SetTenantNotificationPreferences called", "events", events)
+
+	if tenantID == "" {
+		return ErrNoTenantID
+	}
+
+	if err := validateNotificationPreferences(events, channels); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := n.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		if _, err := getTenant(ctx, r, tenantID); err != nil {
+			return err
+		}
+
+		if len(events) == 0 || len(channels) == 0 {
+			_, err := r.Delete(ctx, &model.NotificationPreferences{TenantID: tenantID})
+			if err != nil {
+				slogctx.Error(ctx, "failed to delete notification preferences", "error", err)
+				return ErrNotificationPreferencesUpdate
+			}
+			return nil
+		}
+
+		preferences := &model.NotificationPreferences{
+			TenantID: tenantID,
+			Events:   slices.Compact(slices.Sorted(slices.Values(events))),
+			Channels: slices.Compact(slices.Sorted(slices.Values(channels))),
+		}
+
+		found, err := r.Patch(ctx, preferences)
+		if err == nil && !found {
+			err = r.Create(ctx, preferences)
+		}
+		if err != nil {
+			slogctx.Error(ctx, "failed to update notification preferences", "error", err)
+			return ErrNotificationPreferencesUpdate
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// GetTenantNotificationPreferences returns the notification preferences of the tenant.
+func (n *TenantNotifications) GetTenantNotificationPreferences(ctx context.Context, tenantID string) (*model.NotificationPreferences, error) {
+	slogctx.Debug(ctx, "GetTenantNotificationPreferences called", "tenantId", tenantID)
+
+	if tenantID == "" {
+		return nil, ErrNoTenantID
+	}
+
+	preferences := &model.NotificationPreferences{TenantID: tenantID}
+	found, err := n.repo.Find(ctx, preferences)
+	if err != nil {
+		slogctx.Error(ctx, "failed to select notification preferences", "error", err, "tenantId", tenantID)
+		return nil, ErrNotificationPreferencesSelect
+	}
+
+	if !found {
+		return nil, ErrNotificationPreferencesNotFound
+	}
+
+	return preferences, nil
+}
+
+// notify notifies the tenant of the event if it opted into it.
+// Failures are logged only, as the event already happened.
+func (n *TenantNotifications) notify(ctx context.Context, tenantID string, event model.NotificationEvent, errorMessage string) {
+	if n == nil || !n.cfg.Enabled || n.notifier == nil {
+		return
+	}
+
+	ctx = slogctx.With(ctx, "tenantId", tenantID, "event", event)
+
+	preferences, err := n.GetTenantNotificationPreferences(ctx, tenantID)
+	if errors.Is(err, ErrNotificationPreferencesNotFound) {
+		return
+	}
+	if err != nil {
+		slogctx.Error(ctx, "failed to notify tenant", "error", err)
+		return
+	}
+
+	if !preferences.OptedInto(event) {
+		return
+	}
+
+	err = n.notifier.NotifyTenantEvent(ctx, TenantNotification{
+		TenantID:     tenantID,
+		Event:        event,
+		Channels:     preferences.Channels,
+		OccurredAt:   n.now().UTC(),
+		ErrorMessage: errorMessage,
+	})
+	if err != nil {
+		slogctx.Error(ctx, "failed to notify tenant", "error", err)
+	}
+}
+
+// validateNotificationPreferences requires known events and channels which are mailto:<address> or https URLs.
+func validateNotificationPreferences(events []model.NotificationEvent, channels []string) error {
+	for _, event := range events {
+		if !slices.Contains(model.NotificationEvents, event) {
+			return ErrorWithParams(ErrNotificationPreferencesInvalid, "event", event)
+		}
+	}
+
+	if len(channels) > maxNotificationChannels {
+		return ErrorWithParams(ErrNotificationPreferencesInvalid, "channels", len(channels), "maxChannels", maxNotificationChannels)
+	}
+
+	for _, channel := range channels {
+		if err := validateNotificationChannel(channel); err != nil {
+			return ErrorWithParams(ErrNotificationPreferencesInvalid, "channel", channel, "error", err)
+		}
+	}
+
+	return nil
+}
+
+var errNotMailAddress = errors.New("not a plain mail address")
+
+// validateNotificationChannel requires a mailto:<address> channel without display name or an https URL.
+func validateNotificationChannel(channel string) error {
+	address, ok := strings.CutPrefix(channel, mailtoScheme)
+	if !ok {
+		return validateHTTPSURL(channel)
+	}
+
+	parsed, err := mail.ParseAddress(address)
+	if err != nil {
+		return err
+	}
+
+	if parsed.Address != address {
+		return errNotMailAddress
+	}
+
+	return nil
+}
+
+// notificationEventsFromProto returns the events of the request.
+func notificationEventsFromProto(events []string) []model.NotificationEvent {
+	result := make([]model.NotificationEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, model.NotificationEvent(event))
+	}
+
+	return result
+}
+
+func notificationPreferencesToProto(preferences *model.NotificationPreferences) *extensiongrpc.NotificationPreferences {
+	events := make([]string, 0, len(preferences.Events))
+	for _, event := range preferences.Events {
+		events = append(events, string(event))
+	}
+
+	return &extensiongrpc.NotificationPreferences{
+		TenantId:  preferences.TenantID,
+		Events:    events,
+		Channels:  preferences.Channels,
+		UpdatedAt: timestamppb.New(preferences.UpdatedAt),
+		CreatedAt: timestamppb.New(preferences.CreatedAt),
+	}
+}
@@ -0,0 +1,125 @@
+package service_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestValidateNotificationPreferences(t *testing.T) {
+	tests := []struct {
+		name     string
+		events   []model.NotificationEvent
+		channels []string
+		valid    bool
+	}{
+		{
+			name:     "mail and webhook channels",
+			events:   []model.NotificationEvent{model.NotificationEventBlocked, model.NotificationEventTerminated},
+			channels: []string{"mailto:admins@example.org", "https://hooks.example.org/tenant"},
+			valid:    true,
+		},
+		{
+			name:  "no preferences",
+			valid: true,
+		},
+		{
+			name:     "unknown event",
+			events:   []model.NotificationEvent{"TENANT_RENAMED"},
+			channels: []string{"mailto:admins@example.org"},
+		},
+		{
+			name:     "mail address with display name",
+			events:   []model.NotificationEvent{model.NotificationEventBlocked},
+			channels: []string{"mailto:Admins <admins@example.org>"},
+		},
+		{
+			name:     "invalid mail address",
+			events:   []model.NotificationEvent{model.NotificationEventBlocked},
+			channels: []string{"mailto:admins"},
+		},
+		{
+			name:     "http webhook",
+			events:   []model.NotificationEvent{model.NotificationEventBlocked},
+			channels: []string{"http://hooks.example.org/tenant"},
+		},
+		{
+			name:   "too many channels",
+			events: []model.NotificationEvent{model.NotificationEventBlocked},
+			channels: []string{"mailto:a@example.org", "mailto:b@example.org", "mailto:c@example.org", "mailto:d@example.org",
+				"mailto:e@example.org", "mailto:f@example.org", "mailto:g@example.org", "mailto:h@example.org",
+				"mailto:i@example.org", "mailto:j@example.org", "mailto:k@example.org"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := service.ValidateNotificationPreferences(tt.events, tt.channels)
+
+			// then
+			if tt.valid {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		})
+	}
+}
+
+func TestWebhookTenantNotifier(t *testing.T) {
+	notification := service.TenantNotification{
+		TenantID:   "tenant-id",
+		Event:      model.NotificationEventBlocked,
+		Channels:   []string{"mailto:admins@example.org"},
+		OccurredAt: time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC),
+	}
+
+	t.Run("should post the notification as JSON", func(t *testing.T) {
+		// given
+		var received service.TenantNotification
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&received))
+			w.WriteHeader(http.StatusAccepted)
+		}))
+		t.Cleanup(srv.Close)
+
+		notifier := service.NewWebhookTenantNotifier(config.Webhook{URL: srv.URL, Timeout: time.Second})
+
+		// when
+		err := notifier.NotifyTenantEvent(t.Context(), notification)
+
+		// then
+		require.NoError(t, err)
+		assert.Equal(t, notification, received)
+	})
+
+	t.Run("should return an error for non 2xx responses", func(t *testing.T) {
+		// given
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		t.Cleanup(srv.Close)
+
+		notifier := service.NewWebhookTenantNotifier(config.Webhook{URL: srv.URL, Timeout: time.Second})
+
+		// when
+		err := notifier.NotifyTenantEvent(t.Context(), notification)
+
+		// then
+		assert.ErrorIs(t, err, service.ErrTenantNotificationWebhookStatus)
+	})
+}
@@ -0,0 +1,61 @@
+package service
+
+import (
+	"context"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// TenantStatuses looks up the status of tenants and of the tenants systems are linked to,
+// e.g. to restrict operations on systems of blocked tenants.
+type TenantStatuses struct {
+	repo repository.Repository
+}
+
+// NewTenantStatuses creates and returns a new instance of TenantStatuses.
+func NewTenantStatuses(repo repository.Repository) *TenantStatuses {
+	return &TenantStatuses{repo: repo}
+}
+
+// TenantStatus returns the status of the tenant as defined by the tenant proto, which the policies refer to.
+// found is false if the tenant does not exist.
+func (t *TenantStatuses) TenantStatus(ctx context.Context, tenantID string) (string, bool, error) {
+	tenant := &model.Tenant{ID: tenantID}
+
+	found, err := t.repo.Find(ctx, tenant)
+	if err != nil || !found {
+		return "", false, err
+	}
+
+	return tenant.Status.ToProto().String(), true, nil
+}
+
+// LinkedTenantID returns the ID of the tenant the system is linked to.
+// found is false if the system does not exist or is not linked to a tenant.
+// Without system type, the first system with the external ID linked to a tenant is used.
+func (t *TenantStatuses) LinkedTenantID(ctx context.Context, externalID, systemType string) (string, bool, error) {
+	var systems []model.System
+
+	if systemType != "" {
+		system, found, err := getSystem(ctx, t.repo, externalID, systemType)
+		if err != nil || !found {
+			return "", false, err
+		}
+		systems = append(systems, *system)
+	} else {
+		query := repository.QueryOf(model.SystemFields.ExternalID.Eq(externalID))
+
+		if err := t.repo.List(ctx, &systems, *query); err != nil {
+			return "", false, err
+		}
+	}
+
+	for _, system := range systems {
+		if system.IsLinkedToTenant() {
+			return *system.TenantID, true, nil
+		}
+	}
+
+	return "", false, nil
+}
@@ -0,0 +1,122 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// TenantTemplates expands the configured onboarding templates of tenants server-side.
+type TenantTemplates struct {
+	cfg config.TenantTemplates
+}
+
+// NewTenantTemplates creates and returns a new instance of TenantTemplates.
+func NewTenantTemplates(cfg config.TenantTemplates) *TenantTemplates {
+	return &TenantTemplates{
+		cfg: cfg,
+	}
+}
+
+// lookup returns an error if the template with the name is not configured.
+func (t *TenantTemplates) lookup(name string) error {
+	if _, ok := t.cfg[name]; !ok {
+		return ErrorWithParams(ErrTenantTemplateNotFound, "template", name)
+	}
+
+	return nil
+}
+
+// expand sets the values of the template on the new tenant. Labels and the role of the request,
+// which are already set on the tenant, take precedence over those of the template.
+func (t *TenantTemplates) expand(tenant *model.Tenant, name string) {
+	template := t.cfg[name]
+
+	tenant.Template = name
+	tenant.Labels = mergeLabels(template.Labels, tenant.Labels)
+	tenant.UserGroups = slices.Clone(template.UserGroups)
+	tenant.FeatureFlags = maps.Clone(template.FeatureFlags)
+
+	if template.Role != "" && tenant.Role == tenantgrpc.Role_ROLE_UNSPECIFIED.String() {
+		tenant.Role = template.Role
+	}
+}
+
+// applyAuth applies the auth of the template of the provisioned tenant and starts its job.
+// Tenants without a template or with a template without auth are skipped, as are tenants
+// whose auth already exists, so the provisioning can be completed more than once,
+// and tenants lacking the user groups required by the auth.
+func (t *TenantTemplates) applyAuth(ctx context.Context, r repository.Repository, orbital *Orbital, tenant *model.Tenant) error {
+	if tenant.Template == "" {
+		return nil
+	}
+
+	template, ok := t.cfg[tenant.Template]
+	if !ok {
+		slogctx.Warn(ctx, "tenant template is no longer configured, skipping its auth", "template", tenant.Template)
+		return nil
+	}
+
+	if template.Auth == nil {
+		return nil
+	}
+
+	auth := &model.Auth{
+		ExternalID: tenant.ID + "-" + tenant.Template,
+		TenantID:   tenant.ID,
+		Type:       template.Auth.Type,
+		Properties: maps.Clone(template.Auth.Properties),
+		Status:     authgrpc.AuthStatus_AUTH_STATUS_APPLYING.String(),
+	}
+	auth.ExtractRequiredUserGroups()
+	auth.ExtractServiceAccount()
+
+	_, err := getAuth(ctx, r, auth.ExternalID)
+	if err == nil {
+		return nil
+	}
+	if !errors.Is(err, ErrAuthNotFound) {
+		return err
+	}
+
+	if err := checkUserGroupsExist(tenant, auth.RequiredUserGroups); err != nil {
+		// the tenant is provisioned nevertheless, the auth can be applied once the user groups exist
+		slogctx.Error(ctx, "skipping auth of tenant template", "template", tenant.Template, "error", err)
+		return nil
+	}
+
+	if err := checkServiceAccountActive(ctx, r, tenant.ID, auth.ServiceAccountID); err != nil {
+		// likewise, the auth can be applied once the service account is created
+		slogctx.Error(ctx, "skipping auth of tenant template", "template", tenant.Template, "error", err)
+		return nil
+	}
+
+	if err := r.Create(ctx, auth); err != nil {
+		slogctx.Error(ctx, "failed to create auth of tenant template", "error", err)
+		return ErrTenantTemplateAuth
+	}
+
+	data, err := authJobPayload.encode(auth.ToProto())
+	if err != nil {
+		return ErrTenantTemplateAuth
+	}
+
+	err = orbital.PrepareJob(ctx, data, auth.ExternalID, authgrpc.AuthAction_AUTH_ACTION_APPLY_AUTH.String())
+	if err != nil {
+		slogctx.Error(ctx, "failed to prepare auth job of tenant template", "error", err)
+		return ErrTenantTemplateAuth
+	}
+
+	slogctx.Info(ctx, "applied auth of tenant template", "template", tenant.Template, "externalId", auth.ExternalID)
+
+	return nil
+}
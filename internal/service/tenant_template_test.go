@@ -0,0 +1,73 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestTenantTemplatesLookup(t *testing.T) {
+	subj := service.NewTenantTemplates(config.TenantTemplates{"live": {}})
+
+	t.Run("should accept a configured template", func(t *testing.T) {
+		// when
+		err := subj.Lookup("live")
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject a template which is not configured", func(t *testing.T) {
+		// when
+		err := subj.Lookup("unknown")
+
+		// then
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestTenantTemplatesExpand(t *testing.T) {
+	subj := service.NewTenantTemplates(config.TenantTemplates{"live": {
+		Labels:       map[string]string{"ring": "1", "env": "prod"},
+		UserGroups:   []string{"admins"},
+		Role:         tenantgrpc.Role_ROLE_LIVE.String(),
+		FeatureFlags: map[string]bool{"keyRotation": true},
+	}})
+
+	t.Run("should expand the template", func(t *testing.T) {
+		// given
+		tenant := &model.Tenant{
+			Role:   tenantgrpc.Role_ROLE_UNSPECIFIED.String(),
+			Labels: map[string]string{"env": "dev"},
+		}
+
+		// when
+		subj.Expand(tenant, "live")
+
+		// then
+		assert.Equal(t, "live", tenant.Template)
+		assert.Equal(t, map[string]string{"ring": "1", "env": "dev"}, tenant.Labels)
+		assert.Equal(t, []string{"admins"}, tenant.UserGroups)
+		assert.Equal(t, tenantgrpc.Role_ROLE_LIVE.String(), tenant.Role)
+		assert.Equal(t, map[string]bool{"keyRotation": true}, tenant.FeatureFlags)
+	})
+
+	t.Run("should keep the role of the request", func(t *testing.T) {
+		// given
+		tenant := &model.Tenant{Role: tenantgrpc.Role_ROLE_TEST.String()}
+
+		// when
+		subj.Expand(tenant, "live")
+
+		// then
+		assert.Equal(t, tenantgrpc.Role_ROLE_TEST.String(), tenant.Role)
+	})
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/openkcm/orbital"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+)
+
+// TenantTerminations delays the terminate jobs of tenants by the configured grace period,
+// during which a tenant is PENDING_TERMINATION and its termination can be canceled.
+type TenantTerminations struct {
+	cfg config.TenantTermination
+	now func() time.Time
+}
+
+// NewTenantTerminations creates and returns a new instance of TenantTerminations.
+func NewTenantTerminations(cfg config.TenantTermination) *TenantTerminations {
+	return &TenantTerminations{
+		cfg: cfg,
+		now: time.Now,
+	}
+}
+
+// effectiveAt returns when a termination requested now takes effect.
+func (t *TenantTerminations) effectiveAt() time.Time {
+	return t.now().Add(t.cfg.GracePeriod)
+}
+
+// statusAt returns the status of a tenant whose termination takes effect at effectiveAt,
+// which is PENDING_TERMINATION until then, or TERMINATING if it takes effect immediately.
+func (t *TenantTerminations) statusAt(effectiveAt time.Time) model.TenantStatus {
+	if t.now().Before(effectiveAt) {
+		return model.TenantStatusPendingTermination
+	}
+
+	return model.TenantStatus(tenantgrpc.Status_STATUS_TERMINATING.String())
+}
+
+// validateCancel returns ErrTerminationNotPending if the termination of the tenant can not be canceled anymore.
+func (t *TenantTerminations) validateCancel(tenant *model.Tenant) error {
+	if !tenant.IsPendingTermination() {
+		return ErrorWithParams(ErrTerminationNotPending, "status", string(tenant.Status), "effectiveAt", tenant.TerminationEffectiveAt)
+	}
+
+	return nil
+}
+
+// CancelTermination cancels the termination of the Tenant identified by its ID before it takes effect.
+// The tenant and its auths being removed are BLOCKED again. The delays of its terminate jobs are released,
+// so the jobs are canceled right away, as the tenant is no longer PENDING_TERMINATION.
+func (t *Tenant) CancelTermination(ctx context.Context, id string) error {
+	slogctx.Debug(ctx, "CancelTermination called", "tenantId", id)
+
+	err := t.validateIDNonEmpty(id)
+	if err != nil {
+		return err
+	}
+
+	id = t.ids.Normalize(id)
+	err = t.patchTenant(ctx, patchTenantOpts{
+		id: id,
+		updateFunc: func(tenant *model.Tenant) {
+			tenant.SetStatus(model.TenantStatus(tenantgrpc.Status_STATUS_BLOCKED.String()))
+		},
+		validateFunc: t.terminations.validateCancel,
+		patchAuthOpts: patchAuthOpts{
+			skipUpdateFn: func(auth *model.Auth) bool {
+				return auth.Status != authgrpc.AuthStatus_AUTH_STATUS_REMOVING.String()
+			},
+			updateFn: newAuthUpdateFn(authgrpc.AuthStatus_AUTH_STATUS_BLOCKED),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// the delays are released after the commit, otherwise a job could be confirmed while the tenant is still PENDING_TERMINATION;
+	// a job whose delay is not released is canceled once the delay expires
+	err = t.releaseTerminateJobs(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	slogctx.Info(ctx, "tenant termination canceled", "tenantId", id)
+	return nil
+}
+
+// releaseTerminateJobs releases the delays of the unfinished terminate jobs of the tenant.
+func (t *Tenant) releaseTerminateJobs(ctx context.Context, id string) error {
+	jobs, err := listJobs(ctx, t.repo, repository.NewCompositeKey().
+		Where(repository.ExternalIDField, id).
+		Where(repository.TypeField, tenantgrpc.ACTION_ACTION_TERMINATE_TENANT.String()).
+		Where(repository.StatusField, repository.Not{Value: terminalJobStatuses}))
+	if err != nil {
+		slogctx.Error(ctx, "failed to list terminate jobs", "error", err, "tenantId", id)
+		return ErrJobDelayDelete
+	}
+
+	for _, job := range jobs {
+		if err := t.orbital.ReleaseJobDelay(ctx, job.ID); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// confirmTermination sets the tenant PENDING_TERMINATION to TERMINATING once its terminate job is confirmed,
+// so the termination can not be canceled anymore. The job is canceled if the termination was canceled meanwhile.
+func (t *Tenant) confirmTermination(ctx context.Context, id string) (orbital.JobConfirmerResult, error) {
+	err := t.patchTenant(ctx, patchTenantOpts{
+		id: id,
+		updateFunc: func(tenant *model.Tenant) {
+			tenant.SetStatus(model.TenantStatus(tenantgrpc.Status_STATUS_TERMINATING.String()))
+		},
+		validateFunc: func(tenant *model.Tenant) error {
+			if !tenant.IsPendingTermination() {
+				return ErrTerminationNotPending
+			}
+			return nil
+		},
+	})
+	if errors.Is(err, ErrTerminationNotPending) {
+		return orbital.CancelJobConfirmer("termination canceled"), nil
+	}
+	if err != nil {
+		slogctx.Error(ctx, "failed to confirm tenant termination", "error", err, "tenantId", id)
+		return nil, err
+	}
+
+	return orbital.CompleteJobConfirmer(), nil
+}
@@ -0,0 +1,137 @@
+package service_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tenantgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/tenant/v1"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestTenantTerminations(t *testing.T) {
+	now := time.Date(2026, 1, 10, 12, 0, 0, 0, time.UTC)
+	terminating := model.TenantStatus(tenantgrpc.Status_STATUS_TERMINATING.String())
+	pending := model.TenantStatusPendingTermination
+
+	t.Run("should take effect after the grace period", func(t *testing.T) {
+		// given
+		subj := service.NewTenantTerminationsAt(config.TenantTermination{GracePeriod: 72 * time.Hour}, now)
+
+		// when
+		effectiveAt := subj.EffectiveAt()
+
+		// then
+		assert.Equal(t, now.Add(72*time.Hour), effectiveAt)
+	})
+
+	t.Run("should take effect immediately without grace period", func(t *testing.T) {
+		// given
+		subj := service.NewTenantTerminationsAt(config.TenantTermination{}, now)
+
+		// when
+		effectiveAt := subj.EffectiveAt()
+
+		// then
+		assert.Equal(t, now, effectiveAt)
+	})
+
+	t.Run("should be pending within the grace period", func(t *testing.T) {
+		// given
+		subj := service.NewTenantTerminationsAt(config.TenantTermination{GracePeriod: time.Hour}, now)
+
+		// when
+		status := subj.StatusAt(subj.EffectiveAt())
+
+		// then
+		assert.Equal(t, pending, status)
+	})
+
+	t.Run("should be terminating without grace period", func(t *testing.T) {
+		// given
+		subj := service.NewTenantTerminationsAt(config.TenantTermination{}, now)
+
+		// when
+		status := subj.StatusAt(subj.EffectiveAt())
+
+		// then
+		assert.Equal(t, terminating, status)
+	})
+
+	t.Run("should allow canceling a pending termination", func(t *testing.T) {
+		// given
+		subj := service.NewTenantTerminationsAt(config.TenantTermination{GracePeriod: time.Hour}, now)
+		tenant := &model.Tenant{Status: pending, TerminationEffectiveAt: now.Add(time.Minute)}
+
+		// when
+		err := subj.ValidateCancel(tenant)
+
+		// then
+		assert.NoError(t, err)
+	})
+
+	t.Run("should reject canceling a termination which took effect", func(t *testing.T) {
+		// given
+		subj := service.NewTenantTerminationsAt(config.TenantTermination{GracePeriod: time.Hour}, now)
+		tenant := &model.Tenant{Status: terminating, TerminationEffectiveAt: now}
+
+		// when
+		err := subj.ValidateCancel(tenant)
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+
+	t.Run("should reject canceling a tenant which is not terminating", func(t *testing.T) {
+		// given
+		subj := service.NewTenantTerminationsAt(config.TenantTermination{GracePeriod: time.Hour}, now)
+		tenant := &model.Tenant{
+			Status:                 model.TenantStatus(tenantgrpc.Status_STATUS_BLOCKED.String()),
+			TerminationEffectiveAt: now.Add(time.Minute),
+		}
+
+		// when
+		err := subj.ValidateCancel(tenant)
+
+		// then
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
+
+func TestValidateJobStatus(t *testing.T) {
+	terminate := tenantgrpc.ACTION_ACTION_TERMINATE_TENANT.String()
+
+	tests := map[string]struct {
+		status model.TenantStatus
+		expErr error
+	}{
+		"terminating": {
+			status: model.TenantStatus(tenantgrpc.Status_STATUS_TERMINATING.String()),
+		},
+		"blocked after the termination is canceled": {
+			status: model.TenantStatus(tenantgrpc.Status_STATUS_BLOCKED.String()),
+			expErr: service.ErrTenantJobOutdated,
+		},
+		"active after the termination is canceled": {
+			status: model.TenantStatus(tenantgrpc.Status_STATUS_ACTIVE.String()),
+			expErr: service.ErrTenantJobOutdated,
+		},
+		"pending termination": {
+			status: model.TenantStatusPendingTermination,
+			expErr: service.ErrTenantJobOutdated,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := service.ValidateJobStatus(terminate)(&model.Tenant{Status: tt.status})
+			assert.ErrorIs(t, err, tt.expErr)
+		})
+	}
+}
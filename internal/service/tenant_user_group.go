@@ -0,0 +1,320 @@
+package service
+
+import (
+	"context"
+	"slices"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	authgrpc "github.com/openkcm/api-sdk/proto/kms/api/cmk/registry/auth/v1"
+
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// maxUserGroupDescriptionLength is the maximum length of the description of a user group.
+const maxUserGroupDescriptionLength = 1024
+
+// TenantUserGroup manages the user groups of a tenant with their metadata.
+// The names of the groups are kept in the user groups of the tenant, so SetTenantUserGroups and the tenant proto
+// see the same groups. A group required by an auth can not be deleted, see Auth.RequiredUserGroups.
+type TenantUserGroup struct {
+	repo       repository.Repository
+	validation *validation.Validation
+}
+
+// NewTenantUserGroup creates and returns a new instance of TenantUserGroup.
+func NewTenantUserGroup(repo repository.Repository, validation *validation.Validation) *TenantUserGroup {
+	return &TenantUserGroup{
+		repo:       repo,
+		validation: validation,
+	}
+}
+
+// CreateTenantUserGroup adds the group to an existing tenant.
+func (g *TenantUserGroup) CreateTenantUserGroup(ctx context.Context, group *model.TenantUserGroup) error {
+	slogctx.Debug(ctx, "CreateTenantUserGroup called", "tenantId", group.TenantID, "name", group.Name)
+
+	if err := g.validateUserGroup(group); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := g.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		tenant, err := getTenant(ctx, r, group.TenantID)
+		if err != nil {
+			return err
+		}
+
+		err = r.Create(ctx, group)
+		if isUniqueConstraintError(err) {
+			return ErrorAlreadyExists(ResourceTypeUserGroup, group.TenantID+"/"+group.Name)
+		}
+		if err != nil {
+			return ErrUserGroupCreate
+		}
+
+		if slices.Contains(tenant.UserGroups, group.Name) {
+			return nil
+		}
+
+		return patchTenantUserGroupNames(ctx, r, tenant.ID, append(slices.Clone(tenant.UserGroups), group.Name))
+	})
+
+	return mapError(err)
+}
+
+// GetTenantUserGroup returns the group identified by its tenant and name.
+func (g *TenantUserGroup) GetTenantUserGroup(ctx context.Context, tenantID, name string) (*model.TenantUserGroup, error) {
+	slogctx.Debug(ctx, "GetTenantUserGroup called", "tenantId", tenantID, "name", name)
+
+	if err := g.validateGroupKey(tenantID, name); err != nil {
+		return nil, err
+	}
+
+	return getTenantUserGroup(ctx, g.repo, tenantID, name)
+}
+
+// ListTenantUserGroups returns all groups of the tenant.
+func (g *TenantUserGroup) ListTenantUserGroups(ctx context.Context, tenantID string) ([]model.TenantUserGroup, error) {
+	slogctx.Debug(ctx, "ListTenantUserGroups called", "tenantId", tenantID)
+
+	if tenantID == "" {
+		return nil, ErrNoTenantID
+	}
+
+	groups, err := listTenantUserGroups(ctx, g.repo, tenantID)
+	if err != nil {
+		return nil, err
+	}
+
+	return groups, nil
+}
+
+// UpdateTenantUserGroup updates the description and source identity provider of the group.
+// Empty values keep the current values.
+func (g *TenantUserGroup) UpdateTenantUserGroup(ctx context.Context, tenantID, name, description, sourceIdP string) error {
+	slogctx.Debug(ctx, "UpdateTenantUserGroup called", "tenantId", tenantID, "name", name)
+
+	update := &model.TenantUserGroup{
+		TenantID:    tenantID,
+		Name:        name,
+		Description: description,
+		SourceIdP:   sourceIdP,
+	}
+	if err := g.validateUserGroup(update); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := g.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		if _, err := getTenantUserGroup(ctx, r, tenantID, name); err != nil {
+			return err
+		}
+
+		isPatched, err := r.Patch(ctx, update)
+		if err != nil || !isPatched {
+			return ErrUserGroupUpdate
+		}
+
+		return nil
+	})
+
+	return mapError(err)
+}
+
+// DeleteTenantUserGroup deletes the group and removes it from the user groups of the tenant.
+// It fails if an auth of the tenant requires the group.
+func (g *TenantUserGroup) DeleteTenantUserGroup(ctx context.Context, tenantID, name string) error {
+	slogctx.Debug(ctx, "DeleteTenantUserGroup called", "tenantId", tenantID, "name", name)
+
+	if err := g.validateGroupKey(tenantID, name); err != nil {
+		return err
+	}
+
+	ctxTimeout, cancel := context.WithTimeout(ctx, defaultTranTimeout)
+	defer cancel()
+
+	err := g.repo.Transaction(ctxTimeout, func(ctx context.Context, r repository.Repository) error {
+		tenant, err := getTenant(ctx, r, tenantID)
+		if err != nil {
+			return err
+		}
+
+		group, err := getTenantUserGroup(ctx, r, tenantID, name)
+		if err != nil {
+			return err
+		}
+
+		kept := slices.DeleteFunc(slices.Clone(tenant.UserGroups), func(n string) bool {
+			return n == name
+		})
+		if err := checkUserGroupsKept(ctx, r, tenantID, kept); err != nil {
+			return err
+		}
+
+		if _, err := r.Delete(ctx, group); err != nil {
+			return ErrUserGroupDelete
+		}
+
+		return patchTenantUserGroupNames(ctx, r, tenantID, kept)
+	})
+
+	return mapError(err)
+}
+
+func (g *TenantUserGroup) validateUserGroup(group *model.TenantUserGroup) error {
+	if err := g.validateGroupKey(group.TenantID, group.Name); err != nil {
+		return err
+	}
+
+	if len(group.Description) > maxUserGroupDescriptionLength {
+		return ErrorWithParams(ErrValidationFailed, "err", "description of the user group is too long")
+	}
+
+	return nil
+}
+
+// validateGroupKey validates the tenant ID and the name of the group like the user groups of the tenant.
+func (g *TenantUserGroup) validateGroupKey(tenantID, name string) error {
+	if tenantID == "" {
+		return ErrNoTenantID
+	}
+
+	if name == "" {
+		return ErrorWithParams(ErrValidationFailed, "err", "name of the user group must not be empty")
+	}
+
+	if err := g.validation.Validate(model.TenantUserGroupsValidationID, []string{name}); err != nil {
+		return validationFailed(err)
+	}
+
+	return nil
+}
+
+// syncTenantUserGroups replaces the groups of the tenant by the groups with the names.
+// Groups not listed are deleted, unless an auth requires them, listed groups not stored yet are created without metadata.
+func syncTenantUserGroups(ctx context.Context, r repository.Repository, tenantID string, names []string) error {
+	groups, err := listTenantUserGroups(ctx, r, tenantID)
+	if err != nil {
+		return err
+	}
+
+	var removed []string
+	for _, group := range groups {
+		if !slices.Contains(names, group.Name) {
+			removed = append(removed, group.Name)
+		}
+	}
+
+	if err := checkUserGroupsKept(ctx, r, tenantID, names); err != nil {
+		return err
+	}
+
+	for _, name := range removed {
+		if _, err := r.Delete(ctx, &model.TenantUserGroup{TenantID: tenantID, Name: name}); err != nil {
+			return ErrUserGroupDelete
+		}
+	}
+
+	for _, name := range names {
+		if slices.ContainsFunc(groups, func(g model.TenantUserGroup) bool { return g.Name == name }) {
+			continue
+		}
+
+		err := r.Create(ctx, &model.TenantUserGroup{TenantID: tenantID, Name: name})
+		if err != nil && !isUniqueConstraintError(err) {
+			return ErrUserGroupCreate
+		}
+	}
+
+	return nil
+}
+
+// checkUserGroupsKept returns ErrUserGroupRequired if an auth of the tenant, which is not removed,
+// requires a group which is not kept.
+func checkUserGroupsKept(ctx context.Context, r repository.Repository, tenantID string, kept []string) error {
+	query := repository.QueryOf(model.AuthFields.TenantID.Eq(tenantID))
+
+	var auths []model.Auth
+	if err := r.List(ctx, &auths, *query); err != nil {
+		return ErrAuthSelect
+	}
+
+	for _, auth := range auths {
+		if auth.Status == authgrpc.AuthStatus_AUTH_STATUS_REMOVED.String() {
+			continue
+		}
+
+		for _, name := range auth.RequiredUserGroups {
+			if !slices.Contains(kept, name) {
+				return ErrorWithParams(ErrUserGroupRequired, "name", name, "authExternalID", auth.ExternalID)
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkUserGroupsExist returns ErrUserGroupNotFound if the tenant does not have one of the groups.
+func checkUserGroupsExist(tenant *model.Tenant, names []string) error {
+	for _, name := range names {
+		if !slices.Contains(tenant.UserGroups, name) {
+			return ErrorWithParams(ErrUserGroupNotFound, "tenantID", tenant.ID, "name", name)
+		}
+	}
+
+	return nil
+}
+
+// patchTenantUserGroupNames sets the user groups of the tenant to the names.
+func patchTenantUserGroupNames(ctx context.Context, r repository.Repository, tenantID string, names []string) error {
+	if names == nil {
+		// a nil slice is not patched
+		names = []string{}
+	}
+
+	isPatched, err := r.Patch(ctx, &model.Tenant{ID: tenantID, UserGroups: names})
+	if err != nil || !isPatched {
+		return ErrTenantUpdate
+	}
+
+	return nil
+}
+
+// getTenantUserGroup fetches the group by its tenant and name.
+func getTenantUserGroup(ctx context.Context, r repository.Repository, tenantID, name string) (*model.TenantUserGroup, error) {
+	group := &model.TenantUserGroup{
+		TenantID: tenantID,
+		Name:     name,
+	}
+
+	found, err := r.Find(ctx, group)
+	if err != nil {
+		return nil, ErrUserGroupSelect
+	}
+
+	if !found {
+		return nil, ErrorWithParams(ErrUserGroupNotFound, "tenantID", tenantID, "name", name)
+	}
+
+	return group, nil
+}
+
+func listTenantUserGroups(ctx context.Context, r repository.Repository, tenantID string) ([]model.TenantUserGroup, error) {
+	query := repository.NewQuery(&model.TenantUserGroup{}).Where(
+		repository.NewCompositeKey().Where(repository.TenantIDField, tenantID),
+	)
+
+	var groups []model.TenantUserGroup
+	if err := r.List(ctx, &groups, *query); err != nil {
+		return nil, ErrUserGroupSelect
+	}
+
+	return groups, nil
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	extensiongrpc "github.com/openkcm/registry/api/extension/v1"
+	"github.com/openkcm/registry/internal/model"
+)
+
+// UserGroupExtension implements the procedure calls on user groups defined in api/extension/v1/extension.proto,
+// which are not defined by api-sdk yet.
+type UserGroupExtension struct {
+	extensiongrpc.UnimplementedUserGroupServiceServer
+
+	groups *TenantUserGroup
+}
+
+// NewUserGroupExtension creates and returns a new instance of UserGroupExtension.
+func NewUserGroupExtension(groups *TenantUserGroup) *UserGroupExtension {
+	return &UserGroupExtension{
+		groups: groups,
+	}
+}
+
+// CreateTenantUserGroup adds the group to an existing tenant.
+func (u *UserGroupExtension) CreateTenantUserGroup(ctx context.Context, in *extensiongrpc.CreateTenantUserGroupRequest) (*extensiongrpc.CreateTenantUserGroupResponse, error) {
+	err := u.groups.CreateTenantUserGroup(ctx, &model.TenantUserGroup{
+		TenantID:    in.GetTenantId(),
+		Name:        in.GetName(),
+		Description: in.GetDescription(),
+		SourceIdP:   in.GetSourceIdp(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.CreateTenantUserGroupResponse{Success: true}, nil
+}
+
+// GetTenantUserGroup returns the group identified by its tenant and name.
+func (u *UserGroupExtension) GetTenantUserGroup(ctx context.Context, in *extensiongrpc.GetTenantUserGroupRequest) (*extensiongrpc.GetTenantUserGroupResponse, error) {
+	group, err := u.groups.GetTenantUserGroup(ctx, in.GetTenantId(), in.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.GetTenantUserGroupResponse{Group: tenantUserGroupToProto(group)}, nil
+}
+
+// ListTenantUserGroups returns the groups of the tenant.
+func (u *UserGroupExtension) ListTenantUserGroups(ctx context.Context, in *extensiongrpc.ListTenantUserGroupsRequest) (*extensiongrpc.ListTenantUserGroupsResponse, error) {
+	groups, err := u.groups.ListTenantUserGroups(ctx, in.GetTenantId())
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &extensiongrpc.ListTenantUserGroupsResponse{
+		Groups: make([]*extensiongrpc.TenantUserGroup, 0, len(groups)),
+	}
+	for _, group := range groups {
+		resp.Groups = append(resp.Groups, tenantUserGroupToProto(&group))
+	}
+
+	return resp, nil
+}
+
+// UpdateTenantUserGroup updates the description and source identity provider of the group.
+// Empty values keep the current values.
+func (u *UserGroupExtension) UpdateTenantUserGroup(ctx context.Context, in *extensiongrpc.UpdateTenantUserGroupRequest) (*extensiongrpc.UpdateTenantUserGroupResponse, error) {
+	err := u.groups.UpdateTenantUserGroup(ctx, in.GetTenantId(), in.GetName(), in.GetDescription(), in.GetSourceIdp())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.UpdateTenantUserGroupResponse{Success: true}, nil
+}
+
+// DeleteTenantUserGroup deletes the group, unless it is required by an auth of the tenant.
+func (u *UserGroupExtension) DeleteTenantUserGroup(ctx context.Context, in *extensiongrpc.DeleteTenantUserGroupRequest) (*extensiongrpc.DeleteTenantUserGroupResponse, error) {
+	err := u.groups.DeleteTenantUserGroup(ctx, in.GetTenantId(), in.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &extensiongrpc.DeleteTenantUserGroupResponse{Success: true}, nil
+}
+
+func tenantUserGroupToProto(group *model.TenantUserGroup) *extensiongrpc.TenantUserGroup {
+	return &extensiongrpc.TenantUserGroup{
+		TenantId:    group.TenantID,
+		Name:        group.Name,
+		Description: group.Description,
+		SourceIdp:   group.SourceIdP,
+		UpdatedAt:   timestamppb.New(group.UpdatedAt),
+		CreatedAt:   timestamppb.New(group.CreatedAt),
+	}
+}
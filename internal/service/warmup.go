@@ -0,0 +1,201 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"slices"
+	"time"
+
+	slogctx "github.com/veqryn/slog-context"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/model"
+	"github.com/openkcm/registry/internal/repository"
+	"github.com/openkcm/registry/internal/validation"
+)
+
+// defaultAMQPPorts are the ports of the AMQP URLs without explicit port by scheme.
+var defaultAMQPPorts = map[string]string{
+	"amqp":  "5672",
+	"amqps": "5671",
+}
+
+var ErrWarmupPending = errors.New("warm-up has not finished")
+
+// WarmupStep is a named step of the warm-up, e.g. loading the records of hot tenants.
+type WarmupStep struct {
+	Name string
+	Run  func(ctx context.Context) error
+}
+
+// Warmup runs the warm-up steps of a starting instance and gates its readiness until they finished,
+// so load balancers only route requests to the instance once the first requests are served fast.
+// Failed steps are logged but do not keep the instance from becoming ready, neither does the timeout.
+type Warmup struct {
+	cfg  config.Warmup
+	done chan struct{}
+}
+
+// NewWarmup creates and returns a new instance of Warmup.
+func NewWarmup(cfg config.Warmup) *Warmup {
+	return &Warmup{
+		cfg:  cfg,
+		done: make(chan struct{}),
+	}
+}
+
+// Start runs the steps one after another in the background and finishes the warm-up afterwards.
+// The warm-up finishes immediately if it is disabled. Start must be called once.
+func (w *Warmup) Start(ctx context.Context, steps ...WarmupStep) {
+	if !w.cfg.Enabled {
+		close(w.done)
+		return
+	}
+
+	go func() {
+		defer close(w.done)
+
+		ctx, cancel := context.WithTimeout(ctx, w.cfg.Timeout)
+		defer cancel()
+
+		start := time.Now()
+		for _, step := range steps {
+			stepStart := time.Now()
+
+			err := step.Run(ctx)
+			if err != nil {
+				slogctx.Warn(ctx, "warm-up step failed", "step", step.Name, "error", err)
+				continue
+			}
+
+			slogctx.Debug(ctx, "warm-up step finished", "step", step.Name, "duration", time.Since(stepStart))
+		}
+
+		slogctx.Info(ctx, "warm-up finished", "duration", time.Since(start))
+	}()
+}
+
+// Check returns ErrWarmupPending until the warm-up finished, it is meant as readiness check.
+func (w *Warmup) Check(_ context.Context) error {
+	select {
+	case <-w.done:
+		return nil
+	default:
+		return ErrWarmupPending
+	}
+}
+
+// PreloadHotTenantsStep loads the systems of the most recently created tenants through every pool,
+// so the connections of the pools are established and the queries of the hot tenants are warm.
+func PreloadHotTenantsStep(repo repository.Repository, pools []repository.Pool, hotTenants int) WarmupStep {
+	return WarmupStep{
+		Name: "preload hot tenants",
+		Run: func(ctx context.Context) error {
+			if hotTenants == 0 {
+				return nil
+			}
+
+			for _, pool := range pools {
+				err := preloadHotTenants(repository.WithPool(ctx, pool), repo, hotTenants)
+				if err != nil {
+					return fmt.Errorf("failed to preload hot tenants through %s pool: %w", pool, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// preloadHotTenants loads the systems of the most recently created tenants.
+func preloadHotTenants(ctx context.Context, repo repository.Repository, hotTenants int) error {
+	var tenants []model.Tenant
+	if err := repo.List(ctx, &tenants, *repository.NewQuery(&model.Tenant{}).SetLimit(hotTenants)); err != nil {
+		return err
+	}
+
+	tenantIDs := make([]string, 0, len(tenants))
+	for _, tenant := range tenants {
+		tenantIDs = append(tenantIDs, tenant.ID)
+	}
+
+	for chunk := range slices.Chunk(tenantIDs, repository.MaxFilterValues) {
+		query := repository.NewQuery(&model.RegionalSystem{}).Where(repository.NewCompositeKey().
+			Where(model.SystemFields.TenantID.Qualified(), chunk))
+		query.Joins = []repository.Join{repository.JoinOn(model.RegionalSystemFields.SystemID, model.SystemFields.ID)}
+		query.Populate(repository.System)
+
+		var systems []model.RegionalSystem
+		if err := repo.List(ctx, &systems, *query); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ValidationStep extracts the validated values of every model once,
+// so the reflection of the models is done before the first request is validated.
+func ValidationStep(models ...validation.Model) WarmupStep {
+	return WarmupStep{
+		Name: "validation",
+		Run: func(_ context.Context) error {
+			for _, m := range models {
+				_, err := validation.GetValues(m)
+				if err != nil {
+					return fmt.Errorf("failed to get validated values of %T: %w", m, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// PingOrbitalTargetsStep opens a connection to the AMQP broker of every orbital target,
+// so unreachable targets are reported before the first job is sent.
+func PingOrbitalTargetsStep(targets []config.Target) WarmupStep {
+	return WarmupStep{
+		Name: "ping orbital targets",
+		Run: func(ctx context.Context) error {
+			return pingOrbitalTargets(ctx, targets)
+		},
+	}
+}
+
+// pingOrbitalTargets opens a connection to the AMQP broker of every orbital target.
+func pingOrbitalTargets(ctx context.Context, targets []config.Target) error {
+	var errs []error
+
+	for _, target := range targets {
+		err := pingAMQP(ctx, target.Connection.AMQP.URL)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to ping orbital target %s: %w", target.Region, err))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func pingAMQP(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	addr := u.Host
+	if u.Port() == "" {
+		addr = net.JoinHostPort(u.Hostname(), defaultAMQPPorts[u.Scheme])
+	}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return err
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,82 @@
+package service_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/config"
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestWarmup(t *testing.T) {
+	t.Run("disabled warm-up is finished immediately", func(t *testing.T) {
+		// given
+		subj := service.NewWarmup(config.Warmup{})
+
+		// when
+		subj.Start(t.Context())
+
+		// then
+		assert.NoError(t, subj.Check(t.Context()))
+	})
+
+	t.Run("warm-up is pending until all steps finished", func(t *testing.T) {
+		// given
+		subj := service.NewWarmup(config.Warmup{Enabled: true, Timeout: time.Minute})
+		release := make(chan struct{})
+		var ran []string
+
+		// when
+		subj.Start(t.Context(),
+			service.WarmupStep{Name: "failing", Run: func(_ context.Context) error {
+				ran = append(ran, "failing")
+				return assert.AnError
+			}},
+			service.WarmupStep{Name: "blocking", Run: func(_ context.Context) error {
+				<-release
+				ran = append(ran, "blocking")
+				return nil
+			}},
+		)
+
+		// then
+		assert.ErrorIs(t, subj.Check(t.Context()), service.ErrWarmupPending)
+
+		close(release)
+		assert.Eventually(t, func() bool { return subj.Check(t.Context()) == nil }, time.Second, time.Millisecond)
+		assert.Equal(t, []string{"failing", "blocking"}, ran)
+	})
+
+	t.Run("warm-up is finished after the timeout", func(t *testing.T) {
+		// given
+		subj := service.NewWarmup(config.Warmup{Enabled: true, Timeout: time.Millisecond})
+
+		// when
+		subj.Start(t.Context(), service.WarmupStep{Name: "slow", Run: func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}})
+
+		// then
+		assert.Eventually(t, func() bool { return subj.Check(t.Context()) == nil }, time.Second, time.Millisecond)
+	})
+}
+
+func TestPingOrbitalTargetsStep(t *testing.T) {
+	// given
+	targets := []config.Target{{
+		Region:     "region-a",
+		Connection: &config.Connection{AMQP: &config.AMQP{URL: "amqp://127.0.0.1:1"}},
+	}}
+	step := service.PingOrbitalTargetsStep(targets)
+
+	// when
+	err := step.Run(t.Context())
+
+	// then
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "region-a")
+}
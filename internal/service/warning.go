@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"sync"
+)
+
+// Codes of the warnings attached to successful responses.
+const (
+	WarningLabelKeysNotFound  = "LABEL_KEYS_NOT_FOUND"
+	WarningSystemLabelsDiffer = "SYSTEM_LABELS_DIFFER"
+	WarningTenantIDIgnored    = "TENANT_ID_IGNORED"
+)
+
+// Warning is a non-fatal issue of a successful call, which clients may surface to their users.
+type Warning struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+type warningsKey struct{}
+
+// warnings collects the warnings of a call. It is safe for concurrent use, e.g. by region fan-out.
+type warnings struct {
+	mu   sync.Mutex
+	list []Warning
+}
+
+// WithWarnings returns a context collecting the warnings of the call.
+func WithWarnings(ctx context.Context) context.Context {
+	return context.WithValue(ctx, warningsKey{}, &warnings{})
+}
+
+// WarningsFromContext returns the warnings collected in the context.
+func WarningsFromContext(ctx context.Context) []Warning {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+	if !ok {
+		return nil
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	return append([]Warning(nil), w.list...)
+}
+
+// AddWarning adds a warning to the call. It does nothing if the context does not collect warnings.
+func AddWarning(ctx context.Context, code, message string) {
+	w, ok := ctx.Value(warningsKey{}).(*warnings)
+	if !ok {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.list = append(w.list, Warning{Code: code, Message: message})
+}
+
+// addLabelKeysNotFoundWarning adds a warning for the keys which were removed but not set.
+func addLabelKeysNotFoundWarning(ctx context.Context, keys []string) {
+	if len(keys) == 0 {
+		return
+	}
+
+	AddWarning(ctx, WarningLabelKeysNotFound, "label keys not found and ignored: "+strings.Join(keys, ", "))
+}
@@ -0,0 +1,34 @@
+package service_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/openkcm/registry/internal/service"
+)
+
+func TestWarnings(t *testing.T) {
+	t.Run("should collect the warnings of the context", func(t *testing.T) {
+		// given
+		ctx := service.WithWarnings(t.Context())
+
+		// when
+		service.AddWarning(ctx, service.WarningLabelKeysNotFound, "first")
+		service.AddWarning(ctx, service.WarningTenantIDIgnored, "second")
+
+		// then
+		assert.Equal(t, []service.Warning{
+			{Code: service.WarningLabelKeysNotFound, Message: "first"},
+			{Code: service.WarningTenantIDIgnored, Message: "second"},
+		}, service.WarningsFromContext(ctx))
+	})
+
+	t.Run("should ignore warnings without collecting context", func(t *testing.T) {
+		// when
+		service.AddWarning(t.Context(), service.WarningLabelKeysNotFound, "ignored")
+
+		// then
+		assert.Empty(t, service.WarningsFromContext(t.Context()))
+	})
+}
@@ -12,6 +12,10 @@ const (
 	ConstraintTypeNonEmptyVals = "non-empty-vals"
 	ConstraintTypeRegex        = "regex"
 	ConstraintTypeMapKeys      = "map-keys"
+	ConstraintTypeEmail        = "email"
+	ConstraintTypeURL          = "url"
+	ConstraintTypeMaxLength    = "max-length"
+	ConstraintTypePrintable    = "printable"
 )
 
 var (
@@ -23,6 +27,7 @@ var (
 	ErrConstraintPatternMissing   = errors.New("constraint pattern is missing")
 	ErrConstraintKeysMissing      = errors.New("constraint keys are missing")
 	ErrConstraintKeyNameMissing   = errors.New("constraint key name is missing")
+	ErrConstraintMaxLengthMissing = errors.New("constraint max length is missing")
 )
 
 type (
@@ -46,6 +51,7 @@ type (
 		AllowList []string     `yaml:"allowList,omitempty"`
 		Pattern   string       `yaml:"pattern,omitempty"`
 		Keys      []MapKeySpec `yaml:"keys,omitempty"`
+		MaxLength int          `yaml:"maxLength,omitempty"`
 	}
 
 	// MapKeySpec holds the specification for a map key constraint.
@@ -85,6 +91,20 @@ func (c Constraint) getValidator() (Validator, error) {
 			return nil, ErrConstraintPatternMissing
 		}
 		return NewRegexConstraint(c.Spec.Pattern)
+	case ConstraintTypeEmail:
+		return EmailConstraint{}, nil
+	case ConstraintTypeURL:
+		return URLConstraint{}, nil
+	case ConstraintTypeMaxLength:
+		if c.Spec == nil {
+			return nil, ErrConstraintSpecMissing
+		}
+		if c.Spec.MaxLength <= 0 {
+			return nil, ErrConstraintMaxLengthMissing
+		}
+		return MaxLengthConstraint{MaxLength: c.Spec.MaxLength}, nil
+	case ConstraintTypePrintable:
+		return PrintableConstraint{}, nil
 	case ConstraintTypeMapKeys:
 		if c.Spec == nil {
 			return nil, ErrConstraintSpecMissing
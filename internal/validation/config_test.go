@@ -112,6 +112,24 @@ func TestGetValidator(t *testing.T) {
 			},
 			expValidator: &validation.RegexConstraint{},
 		},
+		{
+			name: "should return validator for valid max-length constraint",
+			constraint: validation.Constraint{
+				Type: validation.ConstraintTypeMaxLength,
+				Spec: &validation.ConstraintSpec{
+					MaxLength: 64,
+				},
+			},
+			expValidator: validation.MaxLengthConstraint{},
+		},
+		{
+			name: "should return an error when max length is missing for max-length validator",
+			constraint: validation.Constraint{
+				Type: validation.ConstraintTypeMaxLength,
+				Spec: &validation.ConstraintSpec{},
+			},
+			expErr: validation.ErrConstraintMaxLengthMissing,
+		},
 		{
 			name: "should return an error when pattern is empty for regex validator",
 			constraint: validation.Constraint{
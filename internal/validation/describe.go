@@ -0,0 +1,103 @@
+package validation
+
+import "strings"
+
+// ConstraintTypeCustom describes the validators which do not implement Describer.
+const ConstraintTypeCustom = "custom"
+
+// Describer is implemented by the validators which describe themselves as the constraint they check,
+// so the validations in effect can be published to clients.
+type Describer interface {
+	Describe() Constraint
+}
+
+// Describe returns the constraints in effect for the validation ID and the IDs nested below it,
+// e.g. the constraints of single label keys, mapped by their validation IDs.
+func (v *Validation) Describe(id ID) map[ID][]Constraint {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+
+	res := make(map[ID][]Constraint)
+	for specID, spec := range v.byID {
+		if specID != id && !strings.HasPrefix(string(specID), string(id)+".") {
+			continue
+		}
+
+		res[specID] = describeAll(spec.validators)
+	}
+
+	return res
+}
+
+func describeAll(validators []Validator) []Constraint {
+	constraints := make([]Constraint, 0, len(validators))
+	for _, validator := range validators {
+		if describer, ok := validator.(Describer); ok {
+			constraints = append(constraints, describer.Describe())
+			continue
+		}
+
+		constraints = append(constraints, Constraint{Type: ConstraintTypeCustom})
+	}
+
+	return constraints
+}
+
+// Describe returns the list constraint with its allow list.
+func (l ListConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypeList, Spec: &ConstraintSpec{AllowList: l.AllowList}}
+}
+
+// Describe returns the non-empty constraint.
+func (n NonEmptyConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypeNonEmpty}
+}
+
+// Describe returns the non-empty-keys constraint.
+func (n NonEmptyKeysConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypeNonEmptyKeys}
+}
+
+// Describe returns the non-empty-vals constraint.
+func (n NonEmptyValConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypeNonEmptyVals}
+}
+
+// Describe returns the email constraint.
+func (e EmailConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypeEmail}
+}
+
+// Describe returns the url constraint.
+func (u URLConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypeURL}
+}
+
+// Describe returns the max-length constraint with its maximum length.
+func (m MaxLengthConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypeMaxLength, Spec: &ConstraintSpec{MaxLength: m.MaxLength}}
+}
+
+// Describe returns the printable constraint.
+func (p PrintableConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypePrintable}
+}
+
+// Describe returns the regex constraint with its pattern.
+func (r *RegexConstraint) Describe() Constraint {
+	return Constraint{Type: ConstraintTypeRegex, Spec: &ConstraintSpec{Pattern: r.re.String()}}
+}
+
+// Describe returns the map-keys constraint with the constraints of its keys.
+func (m *MapKeysConstraint) Describe() Constraint {
+	keys := make([]MapKeySpec, 0, len(m.Keys))
+	for _, key := range m.Keys {
+		keys = append(keys, MapKeySpec{
+			Name:        key.Name,
+			Required:    key.Required,
+			Constraints: describeAll(key.Validators),
+		})
+	}
+
+	return Constraint{Type: ConstraintTypeMapKeys, Spec: &ConstraintSpec{Keys: keys}}
+}
@@ -1,11 +1,17 @@
 package validation
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sync"
 )
 
+// schemaVersionLength is the number of hex characters of the schema version.
+const schemaVersionLength = 12
+
 var (
 	ErrEmptyID           = errors.New("id is empty")
 	ErrValidatorsMissing = errors.New("no validators provided")
@@ -19,12 +25,23 @@ type (
 		Fields []ConfigField
 		// Models represents models to extract validations from and check for ID existence.
 		Models []Model
+		// MaxAllowedValues is the maximum number of allowed values listed by errors of values
+		// which are not allowed. Zero lists all allowed values.
+		MaxAllowedValues int
 	}
 
 	// Validation represents a map of validation specifications by their IDs.
 	Validation struct {
-		byID map[ID]Spec
-		mu   sync.RWMutex
+		byID             map[ID]Spec
+		mu               sync.RWMutex
+		maxAllowedValues int
+		schemaVersion    string
+	}
+
+	// FieldError is returned if the value of a validation ID is not valid.
+	FieldError struct {
+		ID  ID
+		Err error
 	}
 
 	// ID represents a validation identifier.
@@ -39,10 +56,17 @@ type (
 
 // New creates a new Validation instance with the provided configuration fields.
 func New(cfg Config) (*Validation, error) {
+	schemaVersion, err := getSchemaVersion(cfg.Fields)
+	if err != nil {
+		return nil, err
+	}
+
 	v := &Validation{
-		byID: make(map[ID]Spec),
+		byID:             make(map[ID]Spec),
+		maxAllowedValues: cfg.MaxAllowedValues,
+		schemaVersion:    schemaVersion,
 	}
-	err := v.registerConfig(cfg.Fields...)
+	err = v.registerConfig(cfg.Fields...)
 	if err != nil {
 		return nil, err
 	}
@@ -81,16 +105,48 @@ func (v *Validation) Validate(id ID, value any) error {
 		return nil
 	}
 
-	for _, v := range spec.validators {
-		err := v.Validate(value)
+	for _, validator := range spec.validators {
+		err := validator.Validate(value)
 		if err != nil {
-			return fmt.Errorf("validation failed for %s: %w", id, err)
+			if notAllowed, ok := errors.AsType[*NotAllowedError](err); ok {
+				notAllowed.truncate(v.maxAllowedValues)
+			}
+
+			return &FieldError{ID: id, Err: err}
 		}
 	}
 
 	return nil
 }
 
+// SchemaVersion returns the version of the configured validations,
+// which changes whenever the configured fields or their constraints change.
+func (v *Validation) SchemaVersion() string {
+	return v.schemaVersion
+}
+
+// Error returns the message of the validation ID and the cause.
+func (e *FieldError) Error() string {
+	return fmt.Sprintf("validation failed for %s: %v", e.ID, e.Err)
+}
+
+// Unwrap returns the cause of the error.
+func (e *FieldError) Unwrap() error {
+	return e.Err
+}
+
+// getSchemaVersion returns the truncated hash of the configured fields.
+func getSchemaVersion(fields []ConfigField) (string, error) {
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return "", err
+	}
+
+	hash := sha256.Sum256(data)
+
+	return hex.EncodeToString(hash[:])[:schemaVersionLength], nil
+}
+
 // registerConfig registers configuration fields into the Validation instance.
 func (v *Validation) registerConfig(fields ...ConfigField) error {
 	v.mu.Lock()
@@ -1,6 +1,7 @@
 package validation_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -496,3 +497,143 @@ func TestValidateAll(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateNotAllowed(t *testing.T) {
+	fieldName := validation.ID("Model.Field")
+	fields := []validation.ConfigField{
+		{
+			ID: fieldName,
+			Constraints: []validation.Constraint{
+				{
+					Type: validation.ConstraintTypeList,
+					Spec: &validation.ConstraintSpec{AllowList: []string{"value1", "value2", "value3"}},
+				},
+			},
+		},
+	}
+
+	models := []validation.Model{Model{}}
+
+	tests := []struct {
+		name         string
+		maxAllowed   int
+		expAllowed   []string
+		expTruncated int
+		expMsg       string
+	}{
+		{
+			name:       "should list all allowed values without limit",
+			expAllowed: []string{"value1", "value2", "value3"},
+			expMsg:     "validation failed for Model.Field: value is not allowed: value4, allowed values are value1, value2, value3",
+		},
+		{
+			name:         "should truncate the allowed values to the limit",
+			maxAllowed:   2,
+			expAllowed:   []string{"value1", "value2"},
+			expTruncated: 1,
+			expMsg:       "validation failed for Model.Field: value is not allowed: value4, allowed values are value1, value2 and 1 more",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// given
+			v, err := validation.New(validation.Config{Fields: fields, Models: models, MaxAllowedValues: tt.maxAllowed})
+			assert.NoError(t, err)
+
+			// when
+			err = v.Validate(fieldName, "value4")
+
+			// then
+			assert.ErrorIs(t, err, validation.ErrValueNotAllowed)
+			assert.EqualError(t, err, tt.expMsg)
+
+			fieldErr, ok := errors.AsType[*validation.FieldError](err)
+			assert.True(t, ok)
+			assert.Equal(t, fieldName, fieldErr.ID)
+
+			notAllowed, ok := errors.AsType[*validation.NotAllowedError](err)
+			assert.True(t, ok)
+			assert.Equal(t, tt.expAllowed, notAllowed.Allowed)
+			assert.Equal(t, tt.expTruncated, notAllowed.Truncated)
+		})
+	}
+}
+
+func TestSchemaVersion(t *testing.T) {
+	newFields := func(allowList ...string) []validation.ConfigField {
+		return []validation.ConfigField{
+			{
+				ID: "Model.Field",
+				Constraints: []validation.Constraint{
+					{Type: validation.ConstraintTypeList, Spec: &validation.ConstraintSpec{AllowList: allowList}},
+				},
+			},
+		}
+	}
+
+	models := []validation.Model{Model{}}
+
+	// given
+	v1, err := validation.New(validation.Config{Models: models, Fields: newFields("value1", "value2")})
+	assert.NoError(t, err)
+	v2, err := validation.New(validation.Config{Models: models, Fields: newFields("value1", "value2")})
+	assert.NoError(t, err)
+	v3, err := validation.New(validation.Config{Models: models, Fields: newFields("value1", "value2", "value3")})
+	assert.NoError(t, err)
+
+	// then
+	assert.Len(t, v1.SchemaVersion(), 12)
+	assert.Equal(t, v1.SchemaVersion(), v2.SchemaVersion())
+	assert.NotEqual(t, v1.SchemaVersion(), v3.SchemaVersion())
+}
+
+func TestDescribe(t *testing.T) {
+	// given
+	v, err := validation.New(validation.Config{
+		Models: []validation.Model{Model{}},
+		Fields: []validation.ConfigField{
+			{
+				ID: "Model.Field",
+				Constraints: []validation.Constraint{
+					{Type: validation.ConstraintTypeList, Spec: &validation.ConstraintSpec{AllowList: []string{"value1"}}},
+				},
+			},
+			{
+				ID:              "Model.Field.Nested",
+				SkipIfNotExists: true,
+				Constraints: []validation.Constraint{
+					{Type: validation.ConstraintTypeRegex, Spec: &validation.ConstraintSpec{Pattern: "^[a-z]+$"}},
+				},
+			},
+			{
+				ID:              "Model.FieldOther",
+				SkipIfNotExists: true,
+				Constraints:     []validation.Constraint{{Type: validation.ConstraintTypeNonEmpty}},
+			},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, v.Register(validation.Field{ID: "Model.Field", Validators: []validation.Validator{customValidator{}}}))
+
+	// when
+	described := v.Describe("Model.Field")
+
+	// then
+	assert.Equal(t, map[validation.ID][]validation.Constraint{
+		"Model.Field": {
+			{Type: validation.ConstraintTypeList, Spec: &validation.ConstraintSpec{AllowList: []string{"value1"}}},
+			{Type: validation.ConstraintTypeCustom},
+		},
+		"Model.Field.Nested": {
+			{Type: validation.ConstraintTypeRegex, Spec: &validation.ConstraintSpec{Pattern: "^[a-z]+$"}},
+		},
+	}, described)
+}
+
+// customValidator is a validator which does not describe itself.
+type customValidator struct{}
+
+func (customValidator) Validate(any) error {
+	return nil
+}
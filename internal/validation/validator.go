@@ -3,8 +3,13 @@ package validation
 import (
 	"errors"
 	"fmt"
+	"net/mail"
+	"net/url"
 	"regexp"
 	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
 )
 
 var (
@@ -13,8 +18,46 @@ var (
 	ErrValueEmpty      = errors.New("value is empty")
 	ErrKeyEmpty        = errors.New("key is empty")
 	ErrKeyMissing      = errors.New("required key is missing")
+	ErrInvalidEmail    = errors.New("value is not a valid email address")
+	ErrInvalidURL      = errors.New("value is not a valid URL")
+	ErrValueTooLong    = errors.New("value is too long")
+	ErrNotPrintable    = errors.New("value contains non-printable characters")
 )
 
+// NotAllowedError is returned for a value which is not one of the allowed values.
+// It lists the allowed values, so clients can correct the value without knowing the configuration.
+type NotAllowedError struct {
+	Value   string
+	Allowed []string
+	// Truncated is the number of allowed values which are omitted from Allowed.
+	Truncated int
+}
+
+// Error returns the message listing the allowed values.
+func (e *NotAllowedError) Error() string {
+	msg := fmt.Sprintf("%s: %s, allowed values are %s", ErrValueNotAllowed, e.Value, strings.Join(e.Allowed, ", "))
+	if e.Truncated > 0 {
+		msg += fmt.Sprintf(" and %d more", e.Truncated)
+	}
+
+	return msg
+}
+
+// Unwrap returns ErrValueNotAllowed.
+func (e *NotAllowedError) Unwrap() error {
+	return ErrValueNotAllowed
+}
+
+// truncate keeps at most maxAllowed allowed values, zero keeps all of them.
+func (e *NotAllowedError) truncate(maxAllowed int) {
+	if maxAllowed <= 0 || len(e.Allowed) <= maxAllowed {
+		return
+	}
+
+	e.Truncated += len(e.Allowed) - maxAllowed
+	e.Allowed = e.Allowed[:maxAllowed]
+}
+
 // Validator defines the interface for constraints.
 type Validator interface {
 	Validate(value any) error
@@ -33,7 +76,7 @@ func (l ListConstraint) Validate(value any) error {
 	}
 
 	if !slices.Contains(l.AllowList, strValue) {
-		return fmt.Errorf("%w: %s", ErrValueNotAllowed, strValue)
+		return &NotAllowedError{Value: strValue, Allowed: l.AllowList}
 	}
 
 	return nil
@@ -92,6 +135,96 @@ func (n NonEmptyValConstraint) Validate(value any) error {
 	return nil
 }
 
+// EmailConstraint validates that a string value is a plain email address.
+// Empty values are accepted, use NonEmptyConstraint to require a value.
+type EmailConstraint struct{}
+
+// Validate checks if the provided value is an email address without display name.
+func (e EmailConstraint) Validate(value any) error {
+	strValue, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrWrongType, value)
+	}
+
+	if strValue == "" {
+		return nil
+	}
+
+	addr, err := mail.ParseAddress(strValue)
+	if err != nil || addr.Address != strValue {
+		return fmt.Errorf("%w: %s", ErrInvalidEmail, strValue)
+	}
+
+	return nil
+}
+
+// URLConstraint validates that a string value is an absolute URL, e.g. https://... or mailto:... .
+// Empty values are accepted, use NonEmptyConstraint to require a value.
+type URLConstraint struct{}
+
+// Validate checks if the provided value is an absolute URL.
+func (u URLConstraint) Validate(value any) error {
+	strValue, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrWrongType, value)
+	}
+
+	if strValue == "" {
+		return nil
+	}
+
+	parsed, err := url.Parse(strValue)
+	if err != nil || parsed.Scheme == "" || (parsed.Host == "" && parsed.Opaque == "") {
+		return fmt.Errorf("%w: %s", ErrInvalidURL, strValue)
+	}
+
+	return nil
+}
+
+// MaxLengthConstraint validates that a string value has at most MaxLength characters.
+// The characters are counted as runes, so multibyte characters count once.
+type MaxLengthConstraint struct {
+	MaxLength int
+}
+
+// Validate checks if the provided value is a string of at most MaxLength characters.
+func (m MaxLengthConstraint) Validate(value any) error {
+	strValue, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrWrongType, value)
+	}
+
+	if length := utf8.RuneCountInString(strValue); length > m.MaxLength {
+		return fmt.Errorf("%w: %d characters, allowed are %d", ErrValueTooLong, length, m.MaxLength)
+	}
+
+	return nil
+}
+
+// PrintableConstraint validates that a string value is valid UTF-8 of printable characters and spaces,
+// so it can be shown to humans, e.g. no control characters or line breaks.
+type PrintableConstraint struct{}
+
+// Validate checks if the provided value is a string of printable characters.
+func (p PrintableConstraint) Validate(value any) error {
+	strValue, ok := value.(string)
+	if !ok {
+		return fmt.Errorf("%w: %T", ErrWrongType, value)
+	}
+
+	if !utf8.ValidString(strValue) {
+		return fmt.Errorf("%w: invalid UTF-8", ErrNotPrintable)
+	}
+
+	for _, r := range strValue {
+		if !unicode.IsPrint(r) && r != ' ' {
+			return fmt.Errorf("%w: %q", ErrNotPrintable, r)
+		}
+	}
+
+	return nil
+}
+
 // RegexConstraint validates that the string matches the configured regex patern.
 type RegexConstraint struct {
 	re *regexp.Regexp
@@ -186,6 +186,207 @@ func TestNonEmptyValConstraint(t *testing.T) {
 	}
 }
 
+func TestEmailConstraint(t *testing.T) {
+	// given
+	tests := []struct {
+		name   string
+		value  any
+		expErr error
+	}{
+		{
+			name:   "should return error for non-string value",
+			value:  123,
+			expErr: validation.ErrWrongType,
+		},
+		{
+			name:   "should return nil for empty string",
+			value:  "",
+			expErr: nil,
+		},
+		{
+			name:   "should return nil for email address",
+			value:  "oncall@example.com",
+			expErr: nil,
+		},
+		{
+			name:   "should return error for email address with display name",
+			value:  "On Call <oncall@example.com>",
+			expErr: validation.ErrInvalidEmail,
+		},
+		{
+			name:   "should return error for invalid email address",
+			value:  "oncall",
+			expErr: validation.ErrInvalidEmail,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := validation.EmailConstraint{}.Validate(tt.value)
+
+			// then
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestURLConstraint(t *testing.T) {
+	// given
+	tests := []struct {
+		name   string
+		value  any
+		expErr error
+	}{
+		{
+			name:   "should return error for non-string value",
+			value:  123,
+			expErr: validation.ErrWrongType,
+		},
+		{
+			name:   "should return nil for empty string",
+			value:  "",
+			expErr: nil,
+		},
+		{
+			name:   "should return nil for https URL",
+			value:  "https://chat.example.com/channels/kms-escalation",
+			expErr: nil,
+		},
+		{
+			name:   "should return nil for mailto URL",
+			value:  "mailto:escalation@example.com",
+			expErr: nil,
+		},
+		{
+			name:   "should return error for relative URL",
+			value:  "/channels/kms-escalation",
+			expErr: validation.ErrInvalidURL,
+		},
+		{
+			name:   "should return error for plain text",
+			value:  "kms escalation",
+			expErr: validation.ErrInvalidURL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := validation.URLConstraint{}.Validate(tt.value)
+
+			// then
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestMaxLengthConstraint(t *testing.T) {
+	// given
+	tests := []struct {
+		name   string
+		value  any
+		expErr error
+	}{
+		{
+			name:   "should return error for non-string value",
+			value:  123,
+			expErr: validation.ErrWrongType,
+		},
+		{
+			name:   "should return nil for empty string",
+			value:  "",
+			expErr: nil,
+		},
+		{
+			name:   "should return nil for string of max length",
+			value:  "Bücher",
+			expErr: nil,
+		},
+		{
+			name:   "should return error for string exceeding max length",
+			value:  "Büchers",
+			expErr: validation.ErrValueTooLong,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := validation.MaxLengthConstraint{MaxLength: 6}.Validate(tt.value)
+
+			// then
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestPrintableConstraint(t *testing.T) {
+	// given
+	tests := []struct {
+		name   string
+		value  any
+		expErr error
+	}{
+		{
+			name:   "should return error for non-string value",
+			value:  123,
+			expErr: validation.ErrWrongType,
+		},
+		{
+			name:   "should return nil for empty string",
+			value:  "",
+			expErr: nil,
+		},
+		{
+			name:   "should return nil for text with spaces and punctuation",
+			value:  "Payroll (EU) – Zürich",
+			expErr: nil,
+		},
+		{
+			name:   "should return error for line break",
+			value:  "Payroll\nEU",
+			expErr: validation.ErrNotPrintable,
+		},
+		{
+			name:   "should return error for control character",
+			value:  "Payroll\x00",
+			expErr: validation.ErrNotPrintable,
+		},
+		{
+			name:   "should return error for invalid UTF-8",
+			value:  "Payroll\xff",
+			expErr: validation.ErrNotPrintable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// when
+			err := validation.PrintableConstraint{}.Validate(tt.value)
+
+			// then
+			if tt.expErr != nil {
+				assert.ErrorIs(t, err, tt.expErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
 func TestRegExConstraint(t *testing.T) {
 	regExValidator, err := validation.NewRegexConstraint("^KMS_(TenantAdministrator|TenantAuditor)_[A-Za-z0-9-]+$")
 	assert.NotNil(t, regExValidator)